@@ -0,0 +1,24 @@
+import "../css/app.css";
+import "../js/app";
+
+// The policy is checked on the server; the button only re-indents the JSON for reading.
+document.addEventListener("DOMContentLoaded", () => {
+	const editor = document.querySelector<HTMLTextAreaElement>("[data-policy-editor]");
+	const status = document.querySelector<HTMLElement>("[data-policy-format-error]");
+	document
+		.querySelector<HTMLButtonElement>("[data-policy-format]")
+		?.addEventListener("click", () => {
+			if (!editor || editor.value.trim() === "") {
+				return;
+			}
+			try {
+				editor.value = JSON.stringify(JSON.parse(editor.value), null, 2);
+				status?.classList.add("hidden");
+			} catch (error) {
+				if (status) {
+					status.textContent = `The policy is not valid JSON: ${(error as Error).message}`;
+					status.classList.remove("hidden");
+				}
+			}
+		});
+});
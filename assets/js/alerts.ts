@@ -0,0 +1,4 @@
+import "../css/app.css";
+import "../js/app";
+
+// The alerts page is rendered on the server; delete confirmations come from app.ts.
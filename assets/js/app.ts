@@ -1 +1,14 @@
 // Base script imported on every page for shared behaviour.
+
+// Forms marked with data-confirm ask before submitting, e.g. destructive actions.
+document.addEventListener("DOMContentLoaded", () => {
+	document
+		.querySelectorAll<HTMLFormElement>("form[data-confirm]")
+		.forEach((form) => {
+			form.addEventListener("submit", (event) => {
+				if (!window.confirm(form.dataset.confirm ?? "Are you sure?")) {
+					event.preventDefault();
+				}
+			});
+		});
+});
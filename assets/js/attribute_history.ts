@@ -0,0 +1,4 @@
+import "../css/app.css";
+import "../js/app";
+
+// The attribute history page is rendered on the server; the unwatch confirmation comes from app.ts.
@@ -0,0 +1,8 @@
+import "../css/app.css";
+import "../js/app";
+import { followJobIn } from "./job";
+
+followJobIn(
+	"data-bulk-queues-job",
+	(job) => job.message ?? `${job.done} of ${job.total} queues done.`,
+);
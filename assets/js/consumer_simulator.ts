@@ -0,0 +1,6 @@
+import "../css/app.css";
+import "../js/app";
+import { followJobIn } from "./job";
+
+// The simulated consumer runs as a background job; its message carries the running totals.
+followJobIn("data-simulation-job", (job) => job.message ?? "Running…");
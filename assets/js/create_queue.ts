@@ -1,7 +1,15 @@
 import "../css/app.css";
 import "../js/app";
 
-// Helper script for managing FIFO suffixes and checkbox state on the create queue page.
+// Helper script for managing FIFO suffixes and the FIFO-only fields on the create queue page.
+// It also asks the server whether the typed name is valid and still free.
+
+type NameCheck = {
+	name: string;
+	valid: boolean;
+	available: boolean;
+	message?: string;
+};
 
 document.addEventListener("DOMContentLoaded", () => {
 	const nameInput = document.querySelector<HTMLInputElement>("#queue-name");
@@ -26,12 +34,74 @@ document.addEventListener("DOMContentLoaded", () => {
 		nameInput.value = nameInput.value.replace(/\.fifo$/i, "");
 	};
 
+	const throughputSelects = document.querySelectorAll<HTMLSelectElement>(
+		"[data-fifo-throughput] select",
+	);
+
 	const syncDeduplication = () => {
 		const isFifo = typeSelect.value === "fifo";
 		dedupCheckbox.disabled = !isFifo;
 		if (!isFifo) {
 			dedupCheckbox.checked = false;
 		}
+		for (const select of throughputSelects) {
+			select.disabled = !isFifo;
+			if (!isFifo) {
+				select.value = "";
+			}
+		}
+	};
+
+	const nameStatus = document.querySelector<HTMLElement>("[data-name-status]");
+	let checkTimer: number | undefined;
+	let checkController: AbortController | null = null;
+
+	const showNameStatus = (message: string, ok: boolean) => {
+		if (!nameStatus) {
+			return;
+		}
+		nameStatus.textContent = message;
+		nameStatus.classList.toggle("hidden", message === "");
+		nameStatus.classList.toggle("text-green-700", ok);
+		nameStatus.classList.toggle("text-red-700", !ok);
+	};
+
+	const checkName = async () => {
+		checkController?.abort();
+		const name = nameInput.value.trim();
+		if (name === "") {
+			showNameStatus("", true);
+			return;
+		}
+
+		checkController = new AbortController();
+		const params = new URLSearchParams({ name, type: typeSelect.value });
+		try {
+			const response = await fetch(`/api/v1/queues/check-name?${params}`, {
+				signal: checkController.signal,
+			});
+			if (!response.ok) {
+				showNameStatus("", true);
+				return;
+			}
+			const check = (await response.json()) as NameCheck;
+			if (check.valid && check.available) {
+				showNameStatus(`${check.name} is available.`, true);
+			} else {
+				showNameStatus(check.message ?? "This name cannot be used.", false);
+			}
+		} catch (error) {
+			if (!(error instanceof DOMException && error.name === "AbortError")) {
+				showNameStatus("", true);
+			}
+		}
+	};
+
+	const scheduleNameCheck = () => {
+		window.clearTimeout(checkTimer);
+		checkTimer = window.setTimeout(() => {
+			void checkName();
+		}, 300);
 	};
 
 	typeSelect.addEventListener("change", () => {
@@ -41,8 +111,11 @@ document.addEventListener("DOMContentLoaded", () => {
 			stripFifoSuffix();
 		}
 		syncDeduplication();
+		scheduleNameCheck();
 	});
 
+	nameInput.addEventListener("input", scheduleNameCheck);
+
 	nameInput.addEventListener("blur", () => {
 		if (typeSelect.value === "fifo") {
 			ensureFifoSuffix();
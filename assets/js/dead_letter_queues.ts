@@ -0,0 +1,97 @@
+import "../css/app.css";
+import "../js/app";
+
+// The dead-letter queue dashboard is rendered on the server; only the browser push subscription
+// runs here.
+
+const workerURL = "/push-worker.js";
+
+// applicationServerKey must be the raw key bytes, while the server hands out base64url.
+const decodeKey = (key: string) => {
+	const base64 = key.replace(/-/g, "+").replace(/_/g, "/");
+	const padded = base64 + "=".repeat((4 - (base64.length % 4)) % 4);
+	return Uint8Array.from(atob(padded), (char) => char.charCodeAt(0));
+};
+
+const sendSubscription = async (method: "POST" | "DELETE", body: unknown) => {
+	const response = await fetch("/api/v1/push/subscriptions", {
+		method,
+		headers: { "Content-Type": "application/json" },
+		body: JSON.stringify(body),
+	});
+	const data = await response.json();
+	if (!response.ok) {
+		throw new Error(
+			data?.error ?? `Request failed with status ${response.status}`,
+		);
+	}
+	return data.message as string;
+};
+
+document.addEventListener("DOMContentLoaded", async () => {
+	const panel = document.querySelector<HTMLElement>("[data-push]");
+	const button = panel?.querySelector<HTMLButtonElement>("[data-push-toggle]");
+	const status = panel?.querySelector<HTMLElement>("[data-push-status]");
+	const key = panel?.dataset.pushKey;
+	if (!panel || !button || !status || !key) {
+		return;
+	}
+
+	if (!("serviceWorker" in navigator) || !("PushManager" in window)) {
+		status.textContent = "This browser does not support push notifications.";
+		return;
+	}
+
+	let subscription: PushSubscription | null = null;
+	const render = () => {
+		button.textContent = subscription
+			? "Stop notifying this browser"
+			: "Notify this browser";
+		button.classList.remove("hidden");
+	};
+
+	let registration: ServiceWorkerRegistration;
+	try {
+		registration = await navigator.serviceWorker.register(workerURL);
+		subscription = await registration.pushManager.getSubscription();
+	} catch (error) {
+		status.textContent =
+			error instanceof Error
+				? `Could not start push notifications: ${error.message}`
+				: "Could not start push notifications.";
+		return;
+	}
+	render();
+
+	button.addEventListener("click", async () => {
+		button.disabled = true;
+		try {
+			if (subscription) {
+				const endpoint = subscription.endpoint;
+				await subscription.unsubscribe();
+				subscription = null;
+				status.textContent = await sendSubscription("DELETE", { endpoint });
+			} else {
+				if ((await Notification.requestPermission()) !== "granted") {
+					status.textContent =
+						"Notifications are blocked for this site in the browser settings.";
+					return;
+				}
+				subscription = await registration.pushManager.subscribe({
+					userVisibleOnly: true,
+					applicationServerKey: decodeKey(key),
+				});
+				status.textContent = await sendSubscription(
+					"POST",
+					subscription.toJSON(),
+				);
+			}
+		} catch (error) {
+			status.textContent =
+				error instanceof Error ? error.message : "The request failed.";
+		} finally {
+			button.disabled = false;
+			render();
+		}
+	});
+});
@@ -0,0 +1,14 @@
+import "../css/app.css";
+import "../js/app";
+import { followJobIn } from "./job";
+
+followJobIn("data-drain-to-file-job", (job) => {
+	const drained =
+		job.total > 0
+			? `Drained ${job.done} of about ${job.total} messages.`
+			: `Drained ${job.done} messages.`;
+	// A purge with backup reports its purge step in the message.
+	return job.message && !job.message.startsWith("Drained")
+		? `${drained} ${job.message}`
+		: drained;
+});
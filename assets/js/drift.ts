@@ -0,0 +1,4 @@
+import "../css/app.css";
+import "../js/app";
+
+// The drift page is rendered on the server; baseline confirmations come from app.ts.
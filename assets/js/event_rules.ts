@@ -0,0 +1,46 @@
+import "../css/app.css";
+import "../js/app";
+
+// Patterns are checked by EventBridge; the script only re-indents the JSON for reading.
+document.addEventListener("DOMContentLoaded", () => {
+	for (const pattern of document.querySelectorAll<HTMLElement>(
+		"[data-event-json]",
+	)) {
+		try {
+			pattern.textContent = JSON.stringify(
+				JSON.parse(pattern.textContent ?? ""),
+				null,
+				2,
+			);
+		} catch {
+			// Leave patterns that do not parse as they are.
+		}
+	}
+
+	const status = document.querySelector<HTMLElement>(
+		"[data-event-format-error]",
+	);
+	document
+		.querySelector<HTMLButtonElement>("[data-event-format]")
+		?.addEventListener("click", () => {
+			const errors: string[] = [];
+			for (const editor of document.querySelectorAll<HTMLTextAreaElement>(
+				"[data-event-editor]",
+			)) {
+				if (editor.value.trim() === "") {
+					continue;
+				}
+				try {
+					editor.value = JSON.stringify(JSON.parse(editor.value), null, 2);
+				} catch (error) {
+					errors.push(`${editor.name}: ${(error as Error).message}`);
+				}
+			}
+			if (status) {
+				status.textContent = errors.length
+					? `Not valid JSON: ${errors.join("; ")}`
+					: "";
+				status.classList.toggle("hidden", errors.length === 0);
+			}
+		});
+});
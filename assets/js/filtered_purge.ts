@@ -0,0 +1,8 @@
+import "../css/app.css";
+import "../js/app";
+import { followJobIn } from "./job";
+
+followJobIn(
+	"data-filtered-purge-job",
+	(job) => job.message ?? `Checked ${job.done} messages.`,
+);
@@ -0,0 +1,143 @@
+export type JobState = {
+	status: "running" | "succeeded" | "failed";
+	done: number;
+	total: number;
+	message?: string;
+	details?: string[];
+	error?: string;
+	download?: string;
+	items?: JobItem[];
+};
+
+export type JobItem = {
+	key: string;
+	name: string;
+	status: "pending" | "running" | "succeeded" | "failed";
+	error?: string;
+};
+
+const itemLabels: Record<JobItem["status"], string> = {
+	pending: "waiting",
+	running: "working…",
+	succeeded: "done",
+	failed: "failed",
+};
+
+const itemClasses: Record<JobItem["status"], string> = {
+	pending: "text-slate-500",
+	running: "text-slate-700",
+	succeeded: "text-emerald-700",
+	failed: "text-red-700",
+};
+
+// renderItems shows a progress bar and the outcome of every item after element, for jobs that
+// work through a list such as bulk queue operations. It updates the same nodes on every poll.
+const renderItems = (element: HTMLElement, job: JobState) => {
+	if (!job.items || job.items.length === 0) {
+		return;
+	}
+	let container = element.nextElementSibling as HTMLElement | null;
+	if (!container?.hasAttribute("data-job-items")) {
+		container = document.createElement("div");
+		container.className = "mt-2 space-y-2";
+		container.setAttribute("data-job-items", "");
+		element.after(container);
+	}
+
+	const progress = document.createElement("progress");
+	progress.className = "h-2 w-full";
+	progress.max = job.items.length;
+	progress.value = job.done;
+
+	const list = document.createElement("ul");
+	list.className = "list-disc space-y-1 pl-5 text-xs";
+	for (const item of job.items) {
+		const entry = document.createElement("li");
+		entry.className = itemClasses[item.status];
+		const outcome =
+			item.status === "failed"
+				? (item.error ?? "failed")
+				: itemLabels[item.status];
+		entry.textContent = `${item.name}: ${outcome}`;
+		list.append(entry);
+	}
+
+	container.replaceChildren(progress, list);
+};
+
+// appendDownload links the file a job wrote after element. Failed jobs link it too, since it
+// holds the work done before the failure.
+const appendDownload = (element: HTMLElement, job: JobState) => {
+	if (!job.download) {
+		return;
+	}
+	const link = document.createElement("a");
+	link.className = "mt-2 inline-block font-medium text-blue-700 underline";
+	link.href = job.download;
+	link.textContent = "Download the file";
+	element.after(link);
+};
+
+// followJob polls a background job once a second and shows its state in element until it ends.
+// describe renders the line shown while the job is running.
+export const followJob = async (
+	element: HTMLElement,
+	id: string,
+	describe: (job: JobState) => string,
+) => {
+	for (;;) {
+		const response = await fetch(`/api/v1/jobs/${encodeURIComponent(id)}`);
+		if (!response.ok) {
+			element.textContent = `Could not read the job status (${response.status}).`;
+			element.classList.add("text-red-700");
+			return;
+		}
+
+		const job = (await response.json()) as JobState;
+		renderItems(element, job);
+		if (job.status === "failed") {
+			element.textContent = `Stopped: ${job.error ?? "unknown error"}`;
+			element.classList.add("text-red-700");
+			appendDownload(element, job);
+			return;
+		}
+		if (job.status === "succeeded") {
+			element.textContent = `Done. ${job.message ?? ""}`;
+			if (job.details && job.details.length > 0) {
+				const list = document.createElement("ul");
+				list.className = "mt-2 list-disc space-y-1 pl-5 font-mono text-xs";
+				for (const detail of job.details) {
+					const item = document.createElement("li");
+					item.textContent = detail;
+					list.append(item);
+				}
+				element.after(list);
+			}
+			appendDownload(element, job);
+			return;
+		}
+
+		element.textContent = describe(job);
+		await new Promise((resolve) => window.setTimeout(resolve, 1000));
+	}
+};
+
+// followJobIn follows the job named by the data attribute of the first element that has it.
+export const followJobIn = (
+	attribute: string,
+	describe: (job: JobState) => string,
+) => {
+	document.addEventListener("DOMContentLoaded", () => {
+		const element = document.querySelector<HTMLElement>(`[${attribute}]`);
+		const id = element?.getAttribute(attribute);
+		if (!element || !id) {
+			return;
+		}
+
+		followJob(element, id, describe).catch((error: unknown) => {
+			element.textContent =
+				error instanceof Error ? error.message : "Could not follow the job.";
+			element.classList.add("text-red-700");
+		});
+	});
+};
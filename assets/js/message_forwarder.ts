@@ -0,0 +1,6 @@
+import "../css/app.css";
+import "../js/app";
+import { followJobIn } from "./job";
+
+// The forwarder runs as a background job; its message carries the running totals.
+followJobIn("data-forward-job", (job) => job.message ?? "Forwarding…");
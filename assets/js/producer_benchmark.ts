@@ -0,0 +1,8 @@
+import "../css/app.css";
+import "../js/app";
+import { followJobIn } from "./job";
+
+followJobIn(
+	"data-benchmark-job",
+	(job) => `Sending… ${job.done} messages sent so far.`,
+);
@@ -1,6 +1,335 @@
 import "../css/app.css";
 import "../js/app";
 
+type JobState = {
+	id: string;
+	status: "running" | "succeeded" | "failed";
+	done: number;
+	message?: string;
+	error?: string;
+	download?: string;
+};
+
+const readError = async (response: Response): Promise<string> => {
+	try {
+		const data = (await response.json()) as { error?: string };
+		if (typeof data.error === "string") {
+			return data.error;
+		}
+	} catch (_error) {
+		// fall through to the generic message
+	}
+	return `Request failed with status ${response.status}`;
+};
+
+// appendArchiveLink links the archive a purge with backup wrote after the status line. A failed
+// job links it too, since the messages in it are already gone from the queue.
+const appendArchiveLink = (status: HTMLElement | null, job: JobState) => {
+	if (!status || !job.download) {
+		return;
+	}
+	const link = document.createElement("a");
+	link.className = "mt-2 block font-medium text-blue-700 underline";
+	link.href = job.download;
+	link.textContent = "Download the archive";
+	status.append(link);
+};
+
+// Purges run as background jobs: the form starts one and polls it until it finishes,
+// so a purge waiting out the SQS cooldown does not hold the request open.
+const runPurgeJob = async (form: HTMLFormElement) => {
+	const status = form.querySelector<HTMLElement>("[data-job-status]");
+	const submitButton = form.querySelector<HTMLButtonElement>(
+		'button[type="submit"]',
+	);
+	const showStatus = (message: string, isError = false) => {
+		if (!status) {
+			return;
+		}
+		status.textContent = message;
+		status.classList.remove("hidden");
+		status.classList.toggle("text-red-700", isError);
+		status.classList.toggle("text-slate-700", !isError);
+	};
+
+	if (submitButton) {
+		submitButton.disabled = true;
+	}
+	showStatus("Starting purge…");
+
+	const fail = (message: string) => {
+		showStatus(message, true);
+		if (submitButton) {
+			submitButton.disabled = false;
+		}
+	};
+
+	const body = new URLSearchParams();
+	new FormData(form).forEach((value, key) => {
+		body.append(key, String(value));
+	});
+	const started = await fetch(form.dataset.purgeJob ?? "", {
+		method: "POST",
+		body,
+	});
+	if (!started.ok) {
+		fail(await readError(started));
+		return;
+	}
+
+	let job = (await started.json()) as JobState;
+	while (job.status === "running") {
+		showStatus(
+			job.message?.startsWith("Drained")
+				? `Archiving before the purge: ${job.done} messages so far…`
+				: (job.message ?? "Purging messages…"),
+		);
+		await new Promise((resolve) => window.setTimeout(resolve, 1000));
+		const response = await fetch(`/api/v1/jobs/${encodeURIComponent(job.id)}`);
+		if (!response.ok) {
+			fail(await readError(response));
+			return;
+		}
+		job = (await response.json()) as JobState;
+	}
+
+	if (job.status === "failed") {
+		fail(job.error ?? "Purge failed.");
+		appendArchiveLink(status, job);
+		return;
+	}
+	if (job.download) {
+		// Stay on the dialog so the archive can be downloaded before leaving.
+		showStatus(job.message ?? "Purge requested.");
+		appendArchiveLink(status, job);
+		return;
+	}
+	window.location.assign(form.dataset.purgeDone ?? window.location.href);
+};
+
+type LiveQueueStats = {
+	messagesAvailable: number;
+	messagesInFlight: number;
+	messagesDelayed: number;
+	readAt: string;
+};
+
+const liveStatsInterval = 5000;
+
+// The overview polls the stats endpoint while the tab is visible and the toggle is on,
+// so the counts follow the queue without reloading the page.
+const enableLiveStats = (container: HTMLElement) => {
+	const endpoint = container.dataset.liveStats;
+	if (!endpoint) {
+		return;
+	}
+	const toggle = container.querySelector<HTMLInputElement>(
+		"[data-live-stats-toggle]",
+	);
+	const status = container.querySelector<HTMLElement>(
+		"[data-live-stats-status]",
+	);
+	let timer: number | undefined;
+
+	const refresh = async () => {
+		const response = await fetch(endpoint, {
+			headers: { Accept: "application/json" },
+		});
+		if (!response.ok) {
+			throw new Error(await readError(response));
+		}
+		const stats = (await response.json()) as LiveQueueStats;
+		container
+			.querySelectorAll<HTMLElement>("[data-live-stat]")
+			.forEach((node) => {
+				const key = node.dataset.liveStat as keyof LiveQueueStats;
+				if (key in stats) {
+					node.textContent = String(stats[key]);
+				}
+			});
+		if (status) {
+			status.textContent = `Updated ${new Date(stats.readAt).toLocaleTimeString()}.`;
+			status.classList.remove("text-red-700");
+		}
+	};
+
+	const schedule = () => {
+		window.clearTimeout(timer);
+		timer = undefined;
+		if (document.hidden || (toggle && !toggle.checked)) {
+			return;
+		}
+		timer = window.setTimeout(() => {
+			refresh()
+				.catch((error: unknown) => {
+					if (status) {
+						status.textContent =
+							error instanceof Error ? error.message : "Refresh failed.";
+						status.classList.add("text-red-700");
+					}
+				})
+				.finally(schedule);
+		}, liveStatsInterval);
+	};
+
+	toggle?.addEventListener("change", schedule);
+	document.addEventListener("visibilitychange", schedule);
+	schedule();
+};
+
+type MessageMoveTask = {
+	taskHandle: string;
+	status: "RUNNING" | "COMPLETED" | "CANCELLING" | "CANCELLED" | "FAILED";
+	destinationName?: string;
+	messagesMoved: number;
+	messagesToMove: number;
+	failureReason?: string;
+	startedAt?: string;
+};
+
+// Redrive tasks are loaded when their section is first opened and refreshed while one is
+// running and the section stays open, so a redrive can be followed and cancelled from here.
+const enableMoveTasks = (container: HTMLDetailsElement) => {
+	const endpoint = container.dataset.moveTasks;
+	if (!endpoint) {
+		return;
+	}
+	const status = container.querySelector<HTMLElement>(
+		"[data-move-tasks-status]",
+	);
+	const list = container.querySelector<HTMLElement>("[data-move-tasks-list]");
+	let timer: number | undefined;
+
+	const showStatus = (message: string, isError = false) => {
+		if (!status) {
+			return;
+		}
+		status.textContent = message;
+		status.classList.toggle("hidden", message === "");
+		status.classList.toggle("text-red-700", isError);
+		status.classList.toggle("text-slate-500", !isError);
+	};
+
+	const cancel = async (task: MessageMoveTask, button: HTMLButtonElement) => {
+		button.disabled = true;
+		const response = await fetch(`${endpoint}/cancel`, {
+			method: "POST",
+			headers: { "Content-Type": "application/json" },
+			body: JSON.stringify({ taskHandle: task.taskHandle }),
+		});
+		if (!response.ok) {
+			button.disabled = false;
+			throw new Error(await readError(response));
+		}
+		const data = (await response.json()) as { message: string };
+		showStatus(data.message);
+		await refresh();
+	};
+
+	const renderTask = (task: MessageMoveTask): HTMLElement => {
+		const item = document.createElement("li");
+		item.className = "space-y-1 text-sm text-slate-700";
+
+		const heading = document.createElement("div");
+		heading.className = "flex flex-wrap items-center gap-2";
+		const label = document.createElement("span");
+		label.className = "font-medium";
+		label.textContent = `${task.status.toLowerCase()} → ${task.destinationName ?? "source queues"}`;
+		heading.append(label);
+		if (task.startedAt) {
+			const started = document.createElement("span");
+			started.className = "text-xs text-slate-500";
+			started.textContent = `started ${new Date(task.startedAt).toLocaleString()}`;
+			heading.append(started);
+		}
+		if (task.status === "RUNNING") {
+			const button = document.createElement("button");
+			button.type = "button";
+			button.className =
+				"rounded border border-red-300 px-2 py-0.5 text-xs font-medium text-red-700 hover:border-red-400 hover:text-red-800";
+			button.textContent = "Cancel";
+			button.addEventListener("click", () => {
+				cancel(task, button).catch((error: unknown) => {
+					showStatus(
+						error instanceof Error ? error.message : "Cancel failed.",
+						true,
+					);
+				});
+			});
+			heading.append(button);
+		}
+		item.append(heading);
+
+		const progress = document.createElement("progress");
+		progress.className = "w-full";
+		if (task.messagesToMove > 0) {
+			progress.max = task.messagesToMove;
+			progress.value = Math.min(task.messagesMoved, task.messagesToMove);
+		} else if (task.status !== "RUNNING") {
+			progress.max = 1;
+			progress.value = 1;
+		}
+		item.append(progress);
+
+		const counts = document.createElement("p");
+		counts.className = "text-xs text-slate-500";
+		counts.textContent =
+			task.messagesToMove > 0
+				? `About ${task.messagesMoved} of ${task.messagesToMove} messages moved.`
+				: `About ${task.messagesMoved} messages moved.`;
+		item.append(counts);
+
+		if (task.failureReason) {
+			const failure = document.createElement("p");
+			failure.className = "text-xs text-red-700";
+			failure.textContent = task.failureReason;
+			item.append(failure);
+		}
+		return item;
+	};
+
+	const refresh = async () => {
+		const response = await fetch(endpoint, {
+			headers: { Accept: "application/json" },
+		});
+		if (!response.ok) {
+			throw new Error(await readError(response));
+		}
+		const data = (await response.json()) as { tasks: MessageMoveTask[] };
+		list?.replaceChildren(...data.tasks.map(renderTask));
+		if (data.tasks.length === 0) {
+			showStatus("No redrive tasks in the last two weeks.");
+		} else if (status?.textContent === "Loading tasks…") {
+			showStatus("");
+		}
+		return data.tasks.some(
+			(task) => task.status === "RUNNING" || task.status === "CANCELLING",
+		);
+	};
+
+	const load = () => {
+		window.clearTimeout(timer);
+		timer = undefined;
+		if (!container.open) {
+			return;
+		}
+		refresh()
+			.then((active) => {
+				if (active && container.open) {
+					timer = window.setTimeout(load, liveStatsInterval);
+				}
+			})
+			.catch((error: unknown) => {
+				showStatus(
+					error instanceof Error ? error.message : "Loading tasks failed.",
+					true,
+				);
+			});
+	};
+
+	container.addEventListener("toggle", load);
+};
+
 document.addEventListener("DOMContentLoaded", () => {
 	const page = document.querySelector<HTMLElement>('[data-page="queue"]');
 	if (!page) {
@@ -14,6 +343,13 @@ document.addEventListener("DOMContentLoaded", () => {
 
 	let activeModal: ActiveModal | null = null;
 
+	page
+		.querySelectorAll<HTMLElement>("[data-live-stats]")
+		.forEach(enableLiveStats);
+	page
+		.querySelectorAll<HTMLDetailsElement>("details[data-move-tasks]")
+		.forEach(enableMoveTasks);
+
 	const showModal = (modal: HTMLElement) => {
 		if (activeModal?.element === modal) {
 			return;
@@ -72,14 +408,51 @@ document.addEventListener("DOMContentLoaded", () => {
 		modal.classList.remove("hidden");
 		modal.classList.add("flex");
 
-		const submitButton = modal.querySelector<HTMLButtonElement>(
-			'button[type="submit"]',
+		const confirmInput = modal.querySelector<HTMLInputElement>(
+			"[data-confirm-name]",
 		);
-		submitButton?.focus();
+		if (confirmInput) {
+			confirmInput.value = "";
+			confirmInput.dispatchEvent(new Event("input"));
+			confirmInput.focus();
+		}
 
 		activeModal = { element: modal, cleanup };
 	};
 
+	// Destructive forms stay disabled until the queue name is typed exactly;
+	// the server checks the same value.
+	const confirmInputs = page.querySelectorAll<HTMLInputElement>(
+		"[data-confirm-name]",
+	);
+	confirmInputs.forEach((input) => {
+		const submitButton = input.form?.querySelector<HTMLButtonElement>(
+			'button[type="submit"]',
+		);
+		input.addEventListener("input", () => {
+			if (submitButton) {
+				submitButton.disabled =
+					input.value.trim() !== input.dataset.confirmName;
+			}
+		});
+	});
+
+	page
+		.querySelectorAll<HTMLFormElement>("form[data-purge-job]")
+		.forEach((form) => {
+			form.addEventListener("submit", (event) => {
+				event.preventDefault();
+				runPurgeJob(form).catch((error: unknown) => {
+					const status = form.querySelector<HTMLElement>("[data-job-status]");
+					if (status) {
+						status.textContent =
+							error instanceof Error ? error.message : "Purge failed.";
+						status.classList.remove("hidden");
+					}
+				});
+			});
+		});
+
 	const triggers = page.querySelectorAll<HTMLElement>("[data-confirm-trigger]");
 	triggers.forEach((trigger) => {
 		const target = trigger.dataset.confirmTrigger;
@@ -0,0 +1,7 @@
+import "../css/app.css";
+import "../js/app";
+import { followJobIn } from "./job";
+
+// The sampled reports are rendered on the server; only counting every message runs as a job,
+// whose details list the counts once it is done.
+followJobIn("data-attribute-count-job", (job) => job.message ?? "Counting…");
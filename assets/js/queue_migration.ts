@@ -0,0 +1,84 @@
+import "../css/app.css";
+import "../js/app";
+
+type JobState = {
+	status: "running" | "succeeded" | "failed";
+	done: number;
+	total: number;
+	message?: string;
+	error?: string;
+};
+
+// Messages are moved by a background job; follow it until it finishes.
+const followJob = async (element: HTMLElement, id: string) => {
+	for (;;) {
+		const response = await fetch(`/api/v1/jobs/${encodeURIComponent(id)}`);
+		if (!response.ok) {
+			element.textContent = `Could not read the job status (${response.status}).`;
+			element.classList.add("text-red-700");
+			return;
+		}
+
+		const job = (await response.json()) as JobState;
+		const progress =
+			job.total > 0
+				? `Moved ${job.done} of about ${job.total} messages.`
+				: `Moved ${job.done} messages.`;
+		if (job.status === "failed") {
+			element.textContent = `Moving stopped: ${job.error ?? "unknown error"}`;
+			element.classList.add("text-red-700");
+			return;
+		}
+		if (job.status === "succeeded") {
+			element.textContent = `Done. ${job.message ?? progress}`;
+			return;
+		}
+
+		element.textContent = progress;
+		await new Promise((resolve) => window.setTimeout(resolve, 1000));
+	}
+};
+
+// A copy to another region keeps the name and type of the source, so the suggested name follows
+// the destination unless it was edited, and the message group of FIFO counterparts is hidden.
+const followDestination = () => {
+	const region = document.querySelector<HTMLSelectElement>(
+		"[data-migration-region]",
+	);
+	const name = document.querySelector<HTMLInputElement>(
+		"[data-counterpart-name]",
+	);
+	if (!region || !name) {
+		return;
+	}
+	const update = () => {
+		const counterpart = name.dataset.counterpartName ?? "";
+		const source = name.dataset.sourceName ?? "";
+		if ([counterpart, source].includes(name.value)) {
+			name.value = region.value === "" ? counterpart : source;
+		}
+		document
+			.querySelector<HTMLElement>("[data-migration-group]")
+			?.classList.toggle("hidden", region.value !== "");
+	};
+	region.addEventListener("change", update);
+	update();
+};
+
+document.addEventListener("DOMContentLoaded", () => {
+	followDestination();
+
+	const element = document.querySelector<HTMLElement>(
+		"[data-migration-job]",
+	);
+	const id = element?.dataset.migrationJob;
+	if (!element || !id) {
+		return;
+	}
+
+	followJob(element, id).catch((error: unknown) => {
+		element.textContent =
+			error instanceof Error ? error.message : "Could not follow the job.";
+		element.classList.add("text-red-700");
+	});
+});
@@ -0,0 +1,9 @@
+import "../css/app.css";
+import "../js/app";
+
+// The report is rendered on the server; the button only opens the print dialog.
+document.addEventListener("DOMContentLoaded", () => {
+	document
+		.querySelector<HTMLButtonElement>("[data-print]")
+		?.addEventListener("click", () => window.print());
+});
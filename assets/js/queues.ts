@@ -1,9 +1,159 @@
 import "../css/app.css";
 import "../js/app";
 
-// Helper script that enables client-side filtering on the queue list.
+// Helper script that enables client-side filtering, inline attribute edits and bulk selection on
+// the queue list.
+
+type UpdateAttributeResponse = {
+	name: string;
+	value: string;
+	message: string;
+};
+
+const patchAttribute = async (
+	escapedQueueURL: string,
+	name: string,
+	value: string,
+): Promise<UpdateAttributeResponse> => {
+	const response = await fetch(
+		`/api/v1/queues/${escapedQueueURL}/attributes`,
+		{
+			method: "PATCH",
+			headers: { "Content-Type": "application/json" },
+			body: JSON.stringify({ name, value }),
+		},
+	);
+
+	let data: unknown;
+	try {
+		data = await response.json();
+	} catch (_error) {
+		data = null;
+	}
+
+	if (!response.ok) {
+		const message =
+			typeof data === "object" &&
+			data !== null &&
+			"error" in data &&
+			typeof (data as { error: unknown }).error === "string"
+				? (data as { error: string }).error
+				: `Request failed with status ${response.status}`;
+		throw new Error(message);
+	}
+
+	return data as UpdateAttributeResponse;
+};
+
+// Clicking a value marked with data-attribute-edit swaps it for a number input.
+// Enter or leaving the field saves, Escape cancels.
+const enableInlineEdit = (button: HTMLButtonElement) => {
+	const queueURL = button.dataset.queueUrl ?? "";
+	const name = button.dataset.attributeName ?? "";
+	if (queueURL === "" || name === "") {
+		return;
+	}
+
+	button.addEventListener("click", () => {
+		const original = button.textContent?.trim() ?? "";
+		const input = document.createElement("input");
+		input.type = "number";
+		input.min = "0";
+		input.value = original;
+		input.className =
+			"w-24 rounded border border-slate-300 px-2 py-1 text-sm focus:border-blue-500 focus:outline-none focus:ring-2 focus:ring-blue-200";
+
+		let settled = false;
+		const finish = (text: string, error?: string) => {
+			settled = true;
+			button.textContent = text;
+			button.title = error ?? "Click to edit";
+			button.classList.toggle("text-red-700", error !== undefined);
+			input.replaceWith(button);
+			button.focus();
+		};
+
+		const save = async () => {
+			if (settled) {
+				return;
+			}
+			const value = input.value.trim();
+			if (value === original) {
+				finish(original);
+				return;
+			}
+			settled = true;
+			input.disabled = true;
+			try {
+				const result = await patchAttribute(queueURL, name, value);
+				finish(result.value);
+			} catch (error) {
+				finish(
+					original,
+					error instanceof Error ? error.message : "Update failed.",
+				);
+			}
+		};
+
+		input.addEventListener("keydown", (event) => {
+			if (event.key === "Enter") {
+				event.preventDefault();
+				void save();
+			} else if (event.key === "Escape") {
+				finish(original);
+			}
+		});
+		input.addEventListener("blur", () => {
+			void save();
+		});
+
+		button.replaceWith(input);
+		input.focus();
+		input.select();
+	});
+};
+
+// The header checkbox selects every queue row for the bulk form, which shows how many are picked.
+const enableBulkSelection = () => {
+	const selectAll = document.querySelector<HTMLInputElement>(
+		"[data-bulk-select-all]",
+	);
+	const boxes = Array.from(
+		document.querySelectorAll<HTMLInputElement>("[data-bulk-select]"),
+	);
+	const count = document.querySelector<HTMLElement>(
+		"[data-bulk-selected-count]",
+	);
+
+	const update = () => {
+		const selected = boxes.filter((box) => box.checked).length;
+		if (count) {
+			count.textContent = String(selected);
+		}
+		if (selectAll) {
+			selectAll.checked = boxes.length > 0 && selected === boxes.length;
+			selectAll.indeterminate = selected > 0 && selected < boxes.length;
+		}
+	};
+
+	selectAll?.addEventListener("change", () => {
+		for (const box of boxes) {
+			box.checked = selectAll.checked;
+		}
+		update();
+	});
+	for (const box of boxes) {
+		box.addEventListener("change", update);
+	}
+	update();
+};
 
 document.addEventListener("DOMContentLoaded", () => {
+	document
+		.querySelectorAll<HTMLButtonElement>("[data-attribute-edit]")
+		.forEach(enableInlineEdit);
+	enableBulkSelection();
+
 	const filterInput = document.querySelector<HTMLInputElement>("#queue-filter");
 	const rows = Array.from(
 		document.querySelectorAll<HTMLTableRowElement>("[data-queue-row]"),
@@ -12,8 +162,11 @@ document.addEventListener("DOMContentLoaded", () => {
 		return;
 	}
 
+	const columnCount = document.querySelectorAll(
+		"[data-queue-table] thead th",
+	).length;
 	const emptyState = document.createElement("tr");
-	emptyState.innerHTML = `<td class="px-4 py-6 text-center text-slate-500" colspan="8">No queues match the current filter.</td>`;
+	emptyState.innerHTML = `<td class="px-4 py-6 text-center text-slate-500" colspan="${columnCount}">No queues match the current filter.</td>`;
 
 	const tableBody =
 		document.querySelector<HTMLTableSectionElement>("#queue-table-body");
@@ -34,6 +187,27 @@ document.addEventListener("DOMContentLoaded", () => {
 			}
 		});
 
+		// A group heading is hidden along with the last of its rows.
+		let heading: HTMLTableRowElement | null = null;
+		let headingVisible = false;
+		for (const row of Array.from(tableBody.rows)) {
+			if (row.dataset.queueGroup !== undefined) {
+				if (heading) {
+					heading.style.display = headingVisible ? "" : "none";
+				}
+				heading = row;
+				headingVisible = false;
+			} else if (
+				row.dataset.queueRow !== undefined &&
+				row.style.display !== "none"
+			) {
+				headingVisible = true;
+			}
+		}
+		if (heading) {
+			heading.style.display = headingVisible ? "" : "none";
+		}
+
 		if (visibleCount === 0) {
 			if (!tableBody.contains(emptyState)) {
 				tableBody.appendChild(emptyState);
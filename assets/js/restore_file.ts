@@ -0,0 +1,8 @@
+import "../css/app.css";
+import "../js/app";
+import { followJobIn } from "./job";
+
+followJobIn(
+	"data-restore-file-job",
+	(job) => job.message ?? `Sent ${job.done} of ${job.total} messages.`,
+);
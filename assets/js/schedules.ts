@@ -0,0 +1,38 @@
+import "../css/app.css";
+import "../js/app";
+
+// Only shows the message fields on the schedules page when the send action is
+// selected, and the cron or run time field that matches the chosen timing.
+
+document.addEventListener("DOMContentLoaded", () => {
+	const actionSelect =
+		document.querySelector<HTMLSelectElement>("#schedule-action");
+	const sendFields = document.querySelector<HTMLElement>(
+		"[data-schedule-send-fields]",
+	);
+	if (actionSelect && sendFields) {
+		const syncSendFields = () => {
+			sendFields.hidden = actionSelect.value !== "send";
+		};
+		actionSelect.addEventListener("change", syncSendFields);
+		syncSendFields();
+	}
+
+	const timingSelect =
+		document.querySelector<HTMLSelectElement>("#schedule-timing");
+	const cronField = document.querySelector<HTMLElement>(
+		"[data-schedule-cron-field]",
+	);
+	const runAtField = document.querySelector<HTMLElement>(
+		"[data-schedule-run-at-field]",
+	);
+	if (timingSelect && cronField && runAtField) {
+		const syncTimingFields = () => {
+			const once = timingSelect.value === "once";
+			cronField.hidden = once;
+			runAtField.hidden = !once;
+		};
+		timingSelect.addEventListener("change", syncTimingFields);
+		syncTimingFields();
+	}
+});
@@ -0,0 +1,4 @@
+import "../css/app.css";
+import "../js/app";
+
+// The search page is rendered on the server.
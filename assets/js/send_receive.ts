@@ -4,6 +4,7 @@ import "../js/app";
 type MessageAttribute = {
 	name: string;
 	value: string;
+	dataType?: string;
 };
 
 type ReceivedMessage = {
@@ -11,21 +12,170 @@ type ReceivedMessage = {
 	body: string;
 	receiptHandle: string;
 	receiveCount: number;
+	bodyHash: string;
 	attributes: MessageAttribute[];
+	deadLetter?: DeadLetterContext;
+	// raw is the message as SQS returned it, MD5 digests and system attributes
+	// included.
+	raw?: unknown;
+	// projection holds the fields the poll asked for, keyed by JSON path.
+	projection?: Record<string, unknown>;
+};
+
+// DeadLetterContext is set on messages SQS moved to the queue after too many
+// receives.
+type DeadLetterContext = {
+	receiveCount: number;
+	sentAt?: string;
+	firstReceivedAt?: string;
+	sourceQueueArn: string;
+	sourceQueueName: string;
+	sourceQueueUrl?: string;
+	maxReceiveCount?: number;
 };
 
 type SendMessageResponse = {
 	message: string;
+	warning?: string;
+};
+
+type MessageGroup = {
+	messageGroupId: string;
+	inOrder: boolean;
+	messages: ReceivedMessage[];
 };
 
 type ReceiveMessagesResponse = {
 	messages: ReceivedMessage[];
+	groups?: MessageGroup[];
+	filteredOut?: number;
+};
+
+// DrainEvent is one line of the newline-delimited JSON stream of a drain.
+type DrainEvent = {
+	messages?: ReceivedMessage[];
+	received: number;
+	done?: boolean;
+	reason?: "empty" | "message_budget" | "time_budget";
+	error?: string;
+};
+
+// SearchEvent is one line of the newline-delimited JSON stream of a search.
+type SearchEvent = {
+	messages?: ReceivedMessage[];
+	scanned: number;
+	matched: number;
+	done?: boolean;
+	reason?: "matches" | "empty" | "message_budget" | "time_budget";
+	unrestored?: number;
+	error?: string;
+};
+
+type ErrorResponse = {
+	error?: string;
+};
+
+// readEventStream hands each line of a newline-delimited JSON response to
+// onEvent until it returns true for the closing event. It throws when the
+// request failed or the stream ends before that event.
+const readEventStream = async <T>(
+	response: Response,
+	onEvent: (event: T) => boolean,
+): Promise<void> => {
+	if (!response.ok || !response.body) {
+		const data = (await response
+			.json()
+			.catch(() => null)) as ErrorResponse | null;
+		throw new Error(
+			data?.error ?? `Request failed with status ${response.status}`,
+		);
+	}
+
+	// Each line is a complete event; a chunk may end in the middle of one.
+	const reader = response.body.pipeThrough(new TextDecoderStream()).getReader();
+	let buffered = "";
+	for (;;) {
+		const { value, done } = await reader.read();
+		if (done) {
+			throw new Error("The connection closed before the stream finished.");
+		}
+		buffered += value;
+		const lines = buffered.split("\n");
+		buffered = lines.pop() ?? "";
+		for (const line of lines) {
+			if (line.trim() !== "" && onEvent(JSON.parse(line) as T)) {
+				return;
+			}
+		}
+	}
 };
 
 type DeleteMessageResponse = {
 	message: string;
 };
 
+type SaveDraftResponse = {
+	message: string;
+	savedAt?: string;
+};
+
+type FanOutResponse = {
+	message: string;
+	queues: {
+		queueName: string;
+		sent: number;
+		failed: { code: string; message: string }[];
+		error?: string;
+	}[];
+};
+
+// ContractMismatch is one difference between a message and the contract of
+// its queue.
+type ContractMismatch = {
+	path: string;
+	kind: "missing" | "unexpected" | "type" | "value";
+	source: "sample" | "schema" | "body";
+	expected?: string;
+	actual?: string;
+	message: string;
+};
+
+type MessageComparison = {
+	matches: boolean;
+	mismatches: ContractMismatch[];
+	truncated?: boolean;
+};
+
+type SaveContractResponse = {
+	message: string;
+	savedAt: string;
+};
+
+type TransferMessagesResponse = {
+	message: string;
+	messages: {
+		messageId: string;
+		sent: boolean;
+		deleted: boolean;
+		error?: string;
+	}[];
+};
+
+type RedriveMessagesResponse = {
+	message: string;
+	messages: {
+		messageId: string;
+		sent: boolean;
+		deleted: boolean;
+		error?: string;
+		targetQueueName?: string;
+	}[];
+};
+
+type QueueListResponse = {
+	queues: { queueUrl: string; queueName: string; type: string }[];
+};
+
 document.addEventListener("DOMContentLoaded", () => {
 	const page = document.querySelector<HTMLElement>(
 		'[data-page="send-receive"]',
@@ -96,6 +246,12 @@ document.addEventListener("DOMContentLoaded", () => {
 	const messageTemplate = page.querySelector<HTMLTemplateElement>(
 		"#receive-message-template",
 	);
+	const groupTemplate = page.querySelector<HTMLTemplateElement>(
+		"#receive-group-template",
+	);
+	const groupByInput = receiveForm?.querySelector<HTMLInputElement>(
+		'[name="group_by_message_group"]',
+	);
 	const messageGroupInput = sendForm?.querySelector<HTMLInputElement>(
 		'input[name="message_group_id"]',
 	);
@@ -123,12 +279,32 @@ document.addEventListener("DOMContentLoaded", () => {
 	const statusVariantClasses = new Set(Object.values(statusVariants).flat());
 
 	let currentMessages: ReceivedMessage[] = [];
+	let currentGroups: MessageGroup[] | null = null;
+	// Receipt handles of the messages ticked for a copy or move.
+	const selectedHandles = new Set<string>();
+	const transferSelection = page.querySelector<HTMLElement>(
+		"[data-transfer-selection]",
+	);
+	const updateTransferSelection = () => {
+		if (transferSelection) {
+			const count = selectedHandles.size;
+			transferSelection.textContent =
+				count === 0
+					? "No messages selected."
+					: `${count} message${count === 1 ? "" : "s"} selected.`;
+		}
+	};
 
-	const postJSON = async <T>(path: string, payload: unknown): Promise<T> => {
+	const requestJSON = async <T>(
+		method: "POST" | "PUT" | "DELETE",
+		path: string,
+		payload?: unknown,
+		headers: Record<string, string> = {},
+	): Promise<T> => {
 		const response = await fetch(path, {
-			method: "POST",
-			headers: { "Content-Type": "application/json" },
-			body: JSON.stringify(payload),
+			method,
+			headers: { "Content-Type": "application/json", ...headers },
+			body: payload === undefined ? undefined : JSON.stringify(payload),
 		});
 
 		let data: unknown;
@@ -152,6 +328,12 @@ document.addEventListener("DOMContentLoaded", () => {
 		return data as T;
 	};
 
+	const postJSON = <T>(
+		path: string,
+		payload: unknown,
+		headers: Record<string, string> = {},
+	): Promise<T> => requestJSON<T>("POST", path, payload, headers);
+
 	const deleteMessageFromQueue = async (
 		message: ReceivedMessage,
 		button: HTMLButtonElement,
@@ -169,10 +351,16 @@ document.addEventListener("DOMContentLoaded", () => {
 			const successMessage =
 				response?.message ?? "Message deleted from the queue.";
 			setStatus("success", successMessage);
-			currentMessages = currentMessages.filter(
-				(candidate) => candidate.receiptHandle !== message.receiptHandle,
-			);
-			renderMessages(currentMessages);
+			const remaining = (candidate: ReceivedMessage) =>
+				candidate.receiptHandle !== message.receiptHandle;
+			currentGroups =
+				currentGroups
+					?.map((group) => ({
+						...group,
+						messages: group.messages.filter(remaining),
+					}))
+					.filter((group) => group.messages.length > 0) ?? null;
+			renderMessages(currentMessages.filter(remaining), currentGroups);
 		} catch (error) {
 			const messageText =
 				error instanceof Error ? error.message : "Failed to delete message.";
@@ -182,7 +370,7 @@ document.addEventListener("DOMContentLoaded", () => {
 		}
 	};
 
-	const createAttributeRow = () => {
+	const createAttributeRow = (initial?: MessageAttribute) => {
 		if (!attributeTemplate || !attributesContainer) {
 			return;
 		}
@@ -195,6 +383,28 @@ document.addEventListener("DOMContentLoaded", () => {
 			return;
 		}
 
+		if (initial) {
+			const nameInput = row.querySelector<HTMLInputElement>(
+				'input[name="attribute_name[]"]',
+			);
+			const valueInput = row.querySelector<HTMLInputElement>(
+				'input[name="attribute_value[]"]',
+			);
+			const typeSelect = row.querySelector<HTMLSelectElement>(
+				'select[name="attribute_type[]"]',
+			);
+			if (nameInput) {
+				nameInput.value = initial.name;
+			}
+			if (valueInput) {
+				valueInput.value = initial.value;
+			}
+			if (typeSelect && initial.dataType) {
+				// Custom types such as Number.float keep their base type in the form.
+				typeSelect.value = initial.dataType.split(".")[0];
+			}
+		}
+
 		const removeButton = row.querySelector<HTMLButtonElement>(
 			"[data-attribute-remove]",
 		);
@@ -237,6 +447,9 @@ document.addEventListener("DOMContentLoaded", () => {
 			const valueInput = row.querySelector<HTMLInputElement>(
 				'input[name="attribute_value[]"]',
 			);
+			const typeSelect = row.querySelector<HTMLSelectElement>(
+				'select[name="attribute_type[]"]',
+			);
 			if (!nameInput || !valueInput) {
 				return;
 			}
@@ -244,12 +457,39 @@ document.addEventListener("DOMContentLoaded", () => {
 			if (name === "") {
 				return;
 			}
-			attributes.push({ name, value: valueInput.value });
+			const dataType = typeSelect?.value ?? "String";
+			attributes.push(
+				dataType === "String"
+					? { name, value: valueInput.value }
+					: { name, value: valueInput.value, dataType },
+			);
 		});
 		return attributes;
 	};
 
-	resetAttributeRows();
+	const parseAttributes = (raw: string | undefined): MessageAttribute[] => {
+		try {
+			const parsed: unknown = JSON.parse(raw ?? "[]");
+			return Array.isArray(parsed) ? (parsed as MessageAttribute[]) : [];
+		} catch (_error) {
+			return [];
+		}
+	};
+
+	// A restored draft takes precedence over the last-used defaults.
+	const draftAttributes = parseAttributes(page.dataset.draftAttributes);
+	const defaultAttributes =
+		draftAttributes.length > 0
+			? draftAttributes
+			: parseAttributes(page.dataset.defaultAttributes);
+	if (defaultAttributes.length > 0 && attributesContainer) {
+		attributesContainer.replaceChildren();
+		defaultAttributes.forEach((attribute) => {
+			createAttributeRow(attribute);
+		});
+	} else {
+		resetAttributeRows();
+	}
 
 	addAttributeButton?.addEventListener("click", (event) => {
 		event.preventDefault();
@@ -299,12 +539,246 @@ document.addEventListener("DOMContentLoaded", () => {
 		statusBox.classList.add(...statusVariants[kind]);
 	};
 
-	const renderMessages = (messages: ReceivedMessage[]) => {
+	const buildGroupHeader = (group: MessageGroup): DocumentFragment | null => {
+		if (!groupTemplate) {
+			return null;
+		}
+
+		const content = groupTemplate.content.cloneNode(true) as DocumentFragment;
+		const idElement = content.querySelector<HTMLElement>("[data-group-id]");
+		const orderElement = content.querySelector<HTMLElement>(
+			"[data-group-order]",
+		);
+		if (idElement) {
+			idElement.textContent = group.messageGroupId || "(none)";
+		}
+		if (orderElement) {
+			orderElement.textContent = group.inOrder
+				? "Received in sequence order"
+				: "Received out of sequence order";
+			orderElement.classList.add(
+				...(group.inOrder
+					? ["bg-green-100", "text-green-700"]
+					: ["bg-amber-100", "text-amber-700"]),
+			);
+		}
+		return content;
+	};
+
+	const renderDeadLetter = (
+		content: DocumentFragment,
+		deadLetter: DeadLetterContext,
+	) => {
+		const panel = content.querySelector<HTMLElement>("[data-dead-letter]");
+		const fields = content.querySelector<HTMLElement>(
+			"[data-dead-letter-fields]",
+		);
+		if (!panel || !fields) {
+			return;
+		}
+
+		const formatTime = (value?: string) =>
+			value ? new Date(value).toLocaleString() : "Unknown";
+		const receives = deadLetter.maxReceiveCount
+			? `${deadLetter.receiveCount} (source allows ${deadLetter.maxReceiveCount})`
+			: `${deadLetter.receiveCount}`;
+		const addField = (label: string, value: string | Node) => {
+			const term = document.createElement("dt");
+			term.className = "text-xs tracking-wide text-slate-500";
+			term.textContent = label;
+			const definition = document.createElement("dd");
+			definition.className = "break-all text-slate-800";
+			definition.append(value);
+			fields.append(term, definition);
+		};
+
+		let source: string | Node = deadLetter.sourceQueueName;
+		if (deadLetter.sourceQueueUrl) {
+			const link = document.createElement("a");
+			link.className = "text-blue-600 hover:underline";
+			link.href = `/queues/${encodeURIComponent(deadLetter.sourceQueueUrl)}`;
+			link.textContent = deadLetter.sourceQueueName;
+			source = link;
+		}
+		addField("Source queue", source);
+		addField("Receives", receives);
+		addField("Originally sent", formatTime(deadLetter.sentAt));
+		addField("First received", formatTime(deadLetter.firstReceivedAt));
+		panel.classList.remove("hidden");
+	};
+
+	const contractPanel = page.querySelector<HTMLDetailsElement>(
+		"[data-contract]",
+	);
+	const contractStatus = contractPanel?.querySelector<HTMLElement>(
+		"[data-contract-status]",
+	);
+	let hasContract = contractPanel?.hasAttribute("data-contract-saved") ?? false;
+	const contractPath = `/api/v1/queues/${queuePath}/contract`;
+
+	const renderComparison = (
+		result: HTMLElement,
+		comparison: MessageComparison,
+	) => {
+		const summary = result.querySelector<HTMLElement>(
+			"[data-contract-summary]",
+		);
+		const list = result.querySelector<HTMLElement>(
+			"[data-contract-mismatches]",
+		);
+		if (!summary || !list) {
+			return;
+		}
+
+		result.classList.remove(
+			"hidden",
+			"border-green-200",
+			"bg-green-50",
+			"border-amber-200",
+			"bg-amber-50",
+		);
+		summary.classList.remove("text-green-700", "text-amber-800");
+		list.innerHTML = "";
+		if (comparison.matches) {
+			result.classList.add("border-green-200", "bg-green-50");
+			summary.classList.add("text-green-700");
+			summary.textContent = "Matches the contract";
+			return;
+		}
+
+		result.classList.add("border-amber-200", "bg-amber-50");
+		summary.classList.add("text-amber-800");
+		const count = comparison.mismatches.length;
+		summary.textContent = `${count}${comparison.truncated ? "+" : ""} ${
+			count === 1 ? "mismatch" : "mismatches"
+		}`;
+		comparison.mismatches.forEach((mismatch) => {
+			const item = document.createElement("li");
+			item.className = "text-slate-800";
+
+			const path = document.createElement("span");
+			path.className = "font-mono";
+			path.textContent = mismatch.path;
+
+			const details = [mismatch.message];
+			if (mismatch.expected) {
+				details.push(`expected ${mismatch.expected}`);
+			}
+			if (mismatch.actual) {
+				details.push(`got ${mismatch.actual}`);
+			}
+			item.append(path, ` – ${details.join("; ")} (${mismatch.source})`);
+			list.appendChild(item);
+		});
+	};
+
+	const compareMessage = async (
+		message: ReceivedMessage,
+		button: HTMLButtonElement,
+		result: HTMLElement,
+	) => {
+		button.disabled = true;
+		try {
+			const comparison = await postJSON<MessageComparison>(
+				`${contractPath}/compare`,
+				{ body: message.body },
+			);
+			renderComparison(result, comparison);
+		} catch (error) {
+			const messageText =
+				error instanceof Error ? error.message : "Failed to compare message.";
+			setStatus("error", messageText);
+		} finally {
+			button.disabled = false;
+		}
+	};
+
+	const setContractAvailable = (available: boolean) => {
+		hasContract = available;
+		receiveList
+			?.querySelectorAll<HTMLButtonElement>("[data-message-compare]")
+			.forEach((button) => {
+				button.classList.toggle("hidden", !available);
+				button.classList.toggle("inline-flex", available);
+			});
+	};
+
+	const saveContract = async () => {
+		const sample =
+			contractPanel?.querySelector<HTMLTextAreaElement>("#contract_sample")
+				?.value ?? "";
+		const schema =
+			contractPanel?.querySelector<HTMLTextAreaElement>("#contract_schema")
+				?.value ?? "";
+		try {
+			const response = await requestJSON<SaveContractResponse>(
+				"PUT",
+				contractPath,
+				{ sample, schema },
+			);
+			setContractAvailable(true);
+			if (contractStatus) {
+				contractStatus.textContent = response.message;
+			}
+		} catch (error) {
+			if (contractStatus) {
+				contractStatus.textContent =
+					error instanceof Error ? error.message : "Failed to save contract.";
+			}
+		}
+	};
+
+	const deleteContract = async () => {
+		try {
+			const response = await requestJSON<DeleteMessageResponse>(
+				"DELETE",
+				contractPath,
+			);
+			setContractAvailable(false);
+			if (contractStatus) {
+				contractStatus.textContent = response.message;
+			}
+		} catch (error) {
+			if (contractStatus) {
+				contractStatus.textContent =
+					error instanceof Error
+						? error.message
+						: "Failed to remove contract.";
+			}
+		}
+	};
+
+	contractPanel
+		?.querySelector<HTMLButtonElement>("[data-contract-save]")
+		?.addEventListener("click", () => {
+			void saveContract();
+		});
+	contractPanel
+		?.querySelector<HTMLButtonElement>("[data-contract-delete]")
+		?.addEventListener("click", () => {
+			void deleteContract();
+		});
+
+	const renderMessages = (
+		messages: ReceivedMessage[],
+		groups: MessageGroup[] | null = null,
+	) => {
 		if (!receiveList || !messageTemplate) {
 			return;
 		}
 
+		// Messages of a poll the server rendered for browsers without the
+		// script are replaced by the ones polled here.
+		page.querySelector("[data-fallback-results]")?.remove();
 		currentMessages = [...messages];
+		currentGroups = groups;
+		const listed = new Set(messages.map((message) => message.receiptHandle));
+		for (const handle of selectedHandles) {
+			if (!listed.has(handle)) {
+				selectedHandles.delete(handle);
+			}
+		}
+		updateTransferSelection();
 		receiveList.innerHTML = "";
 		if (messages.length === 0) {
 			receiveList.classList.add("hidden");
@@ -312,8 +786,17 @@ document.addEventListener("DOMContentLoaded", () => {
 			return;
 		}
 
-		const fragment = document.createDocumentFragment();
+		// Different message IDs with the same body point at producer retries or
+		// missing deduplication IDs.
+		const idsByHash = new Map<string, Set<string>>();
 		messages.forEach((message) => {
+			const ids = idsByHash.get(message.bodyHash) ?? new Set<string>();
+			ids.add(message.id);
+			idsByHash.set(message.bodyHash, ids);
+		});
+
+		const fragment = document.createDocumentFragment();
+		const appendMessage = (message: ReceivedMessage) => {
 			const content = messageTemplate.content.cloneNode(
 				true,
 			) as DocumentFragment;
@@ -331,6 +814,20 @@ document.addEventListener("DOMContentLoaded", () => {
 			if (idElement) {
 				idElement.textContent = message.id;
 			}
+			const selectInput = content.querySelector<HTMLInputElement>(
+				"[data-message-select]",
+			);
+			if (selectInput) {
+				selectInput.checked = selectedHandles.has(message.receiptHandle);
+				selectInput.addEventListener("change", () => {
+					if (selectInput.checked) {
+						selectedHandles.add(message.receiptHandle);
+					} else {
+						selectedHandles.delete(message.receiptHandle);
+					}
+					updateTransferSelection();
+				});
+			}
 			if (bodyElement) {
 				bodyElement.textContent = message.body;
 			}
@@ -338,6 +835,24 @@ document.addEventListener("DOMContentLoaded", () => {
 				const count = message.receiveCount;
 				countElement.textContent = `Received ×${count}`;
 			}
+			const duplicateElement = content.querySelector<HTMLElement>(
+				"[data-duplicate-body]",
+			);
+			const others = [...(idsByHash.get(message.bodyHash) ?? [])].filter(
+				(id) => id !== message.id,
+			);
+			if (duplicateElement && others.length > 0) {
+				duplicateElement.textContent =
+					others.length === 1
+						? "Same body as 1 other"
+						: `Same body as ${others.length} others`;
+				duplicateElement.title = others.join("\n");
+				duplicateElement.classList.remove("hidden");
+			}
+
+			if (message.deadLetter) {
+				renderDeadLetter(content, message.deadLetter);
+			}
 
 			const deleteButton = content.querySelector<HTMLButtonElement>(
 				"[data-message-delete]",
@@ -349,6 +864,20 @@ document.addEventListener("DOMContentLoaded", () => {
 				});
 			}
 
+			const compareButton = content.querySelector<HTMLButtonElement>(
+				"[data-message-compare]",
+			);
+			const contractResult = content.querySelector<HTMLElement>(
+				"[data-contract-result]",
+			);
+			if (compareButton && contractResult) {
+				compareButton.classList.toggle("hidden", !hasContract);
+				compareButton.classList.toggle("inline-flex", hasContract);
+				compareButton.addEventListener("click", () => {
+					void compareMessage(message, compareButton, contractResult);
+				});
+			}
+
 			if (attributesElement) {
 				attributesElement.innerHTML = "";
 				if (message.attributes.length === 0) {
@@ -363,7 +892,9 @@ document.addEventListener("DOMContentLoaded", () => {
 
 						const name = document.createElement("p");
 						name.className = "text-xs tracking-wide text-slate-500";
-						name.textContent = attribute.name;
+						name.textContent = attribute.dataType
+							? `${attribute.name} (${attribute.dataType})`
+							: attribute.name;
 
 						const value = document.createElement("p");
 						value.className = "break-all font-mono text-sm text-slate-800";
@@ -375,14 +906,329 @@ document.addEventListener("DOMContentLoaded", () => {
 				}
 			}
 
+			const projectionElement = content.querySelector<HTMLElement>(
+				"[data-message-projection]",
+			);
+			const projectionJSON = content.querySelector<HTMLElement>(
+				"[data-message-projection-json]",
+			);
+			if (projectionElement && projectionJSON && message.projection) {
+				projectionJSON.textContent = JSON.stringify(message.projection, null, 2);
+				projectionElement.classList.remove("hidden");
+			}
+
+			const rawElement = content.querySelector<HTMLElement>(
+				"[data-message-raw]",
+			);
+			const rawJSON = content.querySelector<HTMLElement>(
+				"[data-message-raw-json]",
+			);
+			if (rawElement && rawJSON && message.raw !== undefined) {
+				rawJSON.textContent = JSON.stringify(message.raw, null, 2);
+				rawElement.classList.remove("hidden");
+			}
+
 			fragment.appendChild(content);
-		});
+		};
+
+		if (groups) {
+			groups.forEach((group) => {
+				const header = buildGroupHeader(group);
+				if (header) {
+					fragment.appendChild(header);
+				}
+				group.messages.forEach(appendMessage);
+			});
+		} else {
+			messages.forEach(appendMessage);
+		}
 
 		receiveList.appendChild(fragment);
 		receiveList.classList.remove("hidden");
 		emptyState?.classList.add("hidden");
 	};
 
+	const draftStatus = page.querySelector<HTMLElement>("[data-draft-status]");
+	const draftBodyInput = sendForm?.querySelector<HTMLTextAreaElement>(
+		'textarea[name="message_body"]',
+	);
+	const draftIntervalMs = 5000;
+	// Sends are retried on network errors; the idempotency key keeps retries from duplicating messages.
+	const sendAttempts = 3;
+	let lastSavedDraft = JSON.stringify({
+		body: draftBodyInput?.value ?? "",
+		attributes: gatherAttributes(),
+	});
+
+	const saveDraft = async () => {
+		const draft = JSON.stringify({
+			body: draftBodyInput?.value ?? "",
+			attributes: gatherAttributes(),
+		});
+		if (draft === lastSavedDraft) {
+			return;
+		}
+
+		try {
+			const response = await postJSON<SaveDraftResponse>(
+				`/queues/${queuePath}/draft`,
+				JSON.parse(draft),
+			);
+			lastSavedDraft = draft;
+			if (draftStatus) {
+				draftStatus.textContent = response.savedAt
+					? `Draft saved at ${new Date(response.savedAt).toLocaleTimeString()}.`
+					: "Drafts are saved automatically while you type.";
+			}
+		} catch (error) {
+			if (draftStatus) {
+				draftStatus.textContent =
+					error instanceof Error
+						? `Draft not saved: ${error.message}`
+						: "Draft not saved.";
+			}
+		}
+	};
+
+	window.setInterval(() => {
+		void saveDraft();
+	}, draftIntervalMs);
+
+	const fanOut = sendForm?.querySelector<HTMLDetailsElement>("[data-fanout]");
+	const fanOutQueues = fanOut?.querySelector<HTMLElement>(
+		"[data-fanout-queues]",
+	);
+	const fanOutStatus = fanOut?.querySelector<HTMLElement>(
+		"[data-fanout-status]",
+	);
+	const currentQueueURL = decodeURIComponent(queuePath);
+	let fanOutLoaded = false;
+
+	const selectedFanOutQueues = () =>
+		Array.from(
+			fanOutQueues?.querySelectorAll<HTMLInputElement>(
+				'input[name="fanout_queue"]:checked',
+			) ?? [],
+		);
+
+	// The other queues are only listed once the section is opened, so the page stays cheap to load.
+	fanOut?.addEventListener("toggle", async () => {
+		if (!fanOut.open || fanOutLoaded || !fanOutQueues) {
+			return;
+		}
+		fanOutLoaded = true;
+
+		try {
+			const response = await fetch("/api/v1/queues?sort=name&limit=1000");
+			if (!response.ok) {
+				throw new Error(`Request failed with status ${response.status}`);
+			}
+			const data = (await response.json()) as QueueListResponse;
+			const others = data.queues.filter(
+				(queue) => queue.queueUrl !== currentQueueURL,
+			);
+			for (const queue of others) {
+				const label = document.createElement("label");
+				label.className = "flex items-center gap-2 text-sm text-slate-700";
+				const checkbox = document.createElement("input");
+				checkbox.type = "checkbox";
+				checkbox.name = "fanout_queue";
+				checkbox.value = queue.queueUrl;
+				checkbox.dataset.fifo = String(queue.type === "FIFO");
+				checkbox.addEventListener("change", () => {
+					// The server generates deduplication IDs for fan-out sends.
+					if (messageDedupInput) {
+						messageDedupInput.required =
+							requiresDedup && selectedFanOutQueues().length === 0;
+					}
+				});
+				label.append(checkbox, `${queue.queueName} (${queue.type})`);
+				fanOutQueues.append(label);
+			}
+			if (fanOutStatus) {
+				fanOutStatus.textContent =
+					others.length > 0
+						? "This queue is always included."
+						: "There are no other queues.";
+			}
+		} catch (error) {
+			fanOutLoaded = false;
+			if (fanOutStatus) {
+				fanOutStatus.textContent =
+					error instanceof Error
+						? `Could not load queues: ${error.message}`
+						: "Could not load queues.";
+			}
+		}
+	});
+
+	const transfer = page.querySelector<HTMLDetailsElement>("[data-transfer]");
+	const transferTarget = transfer?.querySelector<HTMLSelectElement>(
+		"[data-transfer-target]",
+	);
+	const transferGroupInput = transfer?.querySelector<HTMLInputElement>(
+		'[name="transfer_group_id"]',
+	);
+	let transferLoaded = false;
+
+	// Like the fan-out targets, the queues are only listed once the section is opened.
+	transfer?.addEventListener("toggle", async () => {
+		if (!transfer.open || transferLoaded || !transferTarget) {
+			return;
+		}
+		transferLoaded = true;
+
+		try {
+			const response = await fetch("/api/v1/queues?sort=name&limit=1000");
+			if (!response.ok) {
+				throw new Error(`Request failed with status ${response.status}`);
+			}
+			const data = (await response.json()) as QueueListResponse;
+			const others = data.queues.filter(
+				(queue) => queue.queueUrl !== currentQueueURL,
+			);
+			transferTarget.innerHTML = "";
+			const placeholder = document.createElement("option");
+			placeholder.value = "";
+			placeholder.textContent =
+				others.length > 0 ? "Choose a queue" : "There are no other queues";
+			transferTarget.append(placeholder);
+			for (const queue of others) {
+				const option = document.createElement("option");
+				option.value = queue.queueUrl;
+				option.textContent = queue.queueName;
+				transferTarget.append(option);
+			}
+		} catch (error) {
+			transferLoaded = false;
+			transferTarget.innerHTML = "";
+			const option = document.createElement("option");
+			option.value = "";
+			option.textContent =
+				error instanceof Error
+					? `Could not load queues: ${error.message}`
+					: "Could not load queues.";
+			transferTarget.append(option);
+		}
+	});
+
+	// Reports the outcome of a copy, move or redrive and drops the messages that were deleted
+	// from this queue from the list.
+	const showTransferResults = (response: TransferMessagesResponse) => {
+		const failed = response.messages.filter((result) => result.error);
+		if (failed.length === 0) {
+			setStatus("success", response.message);
+		} else {
+			const first = failed[0];
+			setStatus(
+				"error",
+				`${response.message} ${first.messageId}: ${first.error}`,
+			);
+		}
+
+		const deleted = new Set(
+			response.messages
+				.filter((result) => result.deleted)
+				.map((result) => result.messageId),
+		);
+		if (deleted.size > 0) {
+			const remaining = (candidate: ReceivedMessage) =>
+				!deleted.has(candidate.id);
+			currentGroups =
+				currentGroups
+					?.map((group) => ({
+						...group,
+						messages: group.messages.filter(remaining),
+					}))
+					.filter((group) => group.messages.length > 0) ?? null;
+			renderMessages(currentMessages.filter(remaining), currentGroups);
+		}
+	};
+
+	const selectedMessages = () =>
+		currentMessages
+			.filter((message) => selectedHandles.has(message.receiptHandle))
+			.map((message) => ({
+				id: message.id,
+				body: message.body,
+				receiptHandle: message.receiptHandle,
+				attributes: message.attributes,
+			}));
+
+	const transferMessages = async (
+		move: boolean,
+		button: HTMLButtonElement,
+	) => {
+		const targetQueueUrl = transferTarget?.value ?? "";
+		const messages = selectedMessages();
+		if (messages.length === 0) {
+			setStatus("error", "Select the messages to copy or move first.");
+			return;
+		}
+		if (targetQueueUrl === "") {
+			setStatus("error", "Choose the queue to send the messages to.");
+			return;
+		}
+
+		button.disabled = true;
+		setStatus("info", move ? "Moving messages…" : "Copying messages…");
+		try {
+			const response = await postJSON<TransferMessagesResponse>(
+				`/queues/${queuePath}/messages/transfer`,
+				{
+					targetQueueUrl,
+					move,
+					messageGroupId: transferGroupInput?.value.trim() ?? "",
+					messages,
+				},
+			);
+			showTransferResults(response);
+		} catch (error) {
+			setStatus(
+				"error",
+				error instanceof Error ? error.message : "Failed to transfer messages.",
+			);
+		} finally {
+			button.disabled = false;
+		}
+	};
+
+	transfer
+		?.querySelectorAll<HTMLButtonElement>("[data-transfer-action]")
+		.forEach((button) => {
+			button.addEventListener("click", () => {
+				void transferMessages(button.dataset.transferAction === "move", button);
+			});
+		});
+
+	const redriveButton = transfer?.querySelector<HTMLButtonElement>(
+		"[data-redrive-selected]",
+	);
+	redriveButton?.addEventListener("click", async () => {
+		const messages = selectedMessages();
+		if (messages.length === 0) {
+			setStatus("error", "Select the messages to redrive first.");
+			return;
+		}
+
+		redriveButton.disabled = true;
+		setStatus("info", "Redriving messages…");
+		try {
+			const response = await postJSON<RedriveMessagesResponse>(
+				`/queues/${queuePath}/messages/redrive`,
+				{ messages },
+			);
+			showTransferResults(response);
+		} catch (error) {
+			setStatus(
+				"error",
+				error instanceof Error ? error.message : "Failed to redrive messages.",
+			);
+		} finally {
+			redriveButton.disabled = false;
+		}
+	});
+
 	sendForm?.addEventListener("submit", async (event) => {
 		event.preventDefault();
 		if (!sendForm) {
@@ -423,7 +1269,15 @@ document.addEventListener("DOMContentLoaded", () => {
 
 		const attributes = gatherAttributes();
 
-		if (supportsGroups && messageGroupId === "") {
+		const fanOutTargets = selectedFanOutQueues();
+		const copies = Number(formData.get("fanout_copies") ?? "1") || 1;
+		const fanningOut = fanOutTargets.length > 0 || copies > 1;
+
+		if (
+			(supportsGroups ||
+				fanOutTargets.some((input) => input.dataset.fifo === "true")) &&
+			messageGroupId === ""
+		) {
 			setFeedback(
 				"error",
 				"Message group ID is required when sending to a FIFO queue.",
@@ -431,10 +1285,15 @@ document.addEventListener("DOMContentLoaded", () => {
 			return;
 		}
 
+		if (fanningOut && (copies < 1 || copies > 100)) {
+			setFeedback("error", "Copies per queue must be between 1 and 100.");
+			return;
+		}
+
 		const messageDeduplicationId =
 			(formData.get("message_deduplication_id") as string | null)?.trim() ?? "";
 
-		if (requiresDedup && messageDeduplicationId === "") {
+		if (!fanningOut && requiresDedup && messageDeduplicationId === "") {
 			setFeedback(
 				"error",
 				"Message deduplication ID is required when content-based deduplication is disabled.",
@@ -464,19 +1323,94 @@ document.addEventListener("DOMContentLoaded", () => {
 			payload.attributes = attributes;
 		}
 
+		if (fanningOut) {
+			try {
+				if (submitButton) {
+					submitButton.disabled = true;
+				}
+				setFeedback("info", "Sending to the selected queues…");
+				const response = await postJSON<FanOutResponse>(
+					"/api/v1/messages/fan-out",
+					{
+						queueUrls: [
+							currentQueueURL,
+							...fanOutTargets.map((input) => input.value),
+						],
+						message: payload,
+						copies,
+					},
+				);
+				const problems = response.queues
+					.filter((queue) => queue.error || queue.failed.length > 0)
+					.map(
+						(queue) =>
+							`${queue.queueName}: ${queue.error ?? `${queue.failed.length} not sent (${queue.failed[0].message})`}`,
+					);
+				if (problems.length > 0) {
+					setFeedback("error", `${response.message} ${problems.join("; ")}`);
+				} else {
+					setFeedback("success", response.message);
+				}
+			} catch (error) {
+				const message =
+					error instanceof Error ? error.message : "Failed to send message.";
+				setFeedback("error", message);
+			} finally {
+				if (submitButton) {
+					submitButton.disabled = false;
+				}
+			}
+			return;
+		}
+
 		try {
 			if (submitButton) {
 				submitButton.disabled = true;
 			}
 			setFeedback("info", "Sending message…");
-			const response = await postJSON<SendMessageResponse>(
-				`/queues/${queuePath}/messages`,
-				payload,
-			);
+			// One key per submission lets the server recognise our own retries after a lost response.
+			const idempotencyKey = crypto.randomUUID();
+			let response: SendMessageResponse | undefined;
+			for (let attempt = 1; ; attempt += 1) {
+				try {
+					response = await postJSON<SendMessageResponse>(
+						`/queues/${queuePath}/messages`,
+						payload,
+						{ "Idempotency-Key": idempotencyKey },
+					);
+					break;
+				} catch (error) {
+					// fetch only throws TypeError when the request never got an answer.
+					if (!(error instanceof TypeError) || attempt >= sendAttempts) {
+						throw error;
+					}
+					await new Promise((resolve) =>
+						setTimeout(resolve, 500 * attempt),
+					);
+				}
+			}
 			const message =
 				response?.message ?? "Message sent to the queue successfully.";
-			setFeedback("success", message);
-			sendForm.reset();
+			if (response?.warning) {
+				setFeedback("info", `${message} ${response.warning}`);
+			} else {
+				setFeedback("success", message);
+			}
+			// Keep group ID, delay, and attributes so repeated test sends only need a new body.
+			const bodyInput = sendForm.querySelector<HTMLTextAreaElement>(
+				'textarea[name="message_body"]',
+			);
+			if (bodyInput) {
+				bodyInput.value = "";
+			}
+			if (messageDedupInput) {
+				messageDedupInput.value = "";
+			}
+			// The server discarded the draft with the send; autosave starts again from here.
+			lastSavedDraft = JSON.stringify({
+				body: draftBodyInput?.value ?? "",
+				attributes: gatherAttributes(),
+			});
 		} catch (error) {
 			const message =
 				error instanceof Error ? error.message : "Failed to send message.";
@@ -499,6 +1433,8 @@ document.addEventListener("DOMContentLoaded", () => {
 			(formData.get("max_messages") as string | null)?.trim() ?? "";
 		const waitTimeRaw =
 			(formData.get("wait_time_seconds") as string | null)?.trim() ?? "";
+		const visibilityTimeoutRaw =
+			(formData.get("visibility_timeout") as string | null)?.trim() ?? "";
 
 		const fallbackMaxMessages = 10;
 		const fallbackWaitTime = 20;
@@ -543,9 +1479,38 @@ document.addEventListener("DOMContentLoaded", () => {
 			waitTimeInput.value = String(fallbackWaitTime);
 		}
 
+		let visibilityTimeout: number | undefined;
+		if (visibilityTimeoutRaw !== "") {
+			const parsed = Number(visibilityTimeoutRaw);
+			if (
+				Number.isNaN(parsed) ||
+				!Number.isInteger(parsed) ||
+				parsed < 0 ||
+				parsed > 43200
+			) {
+				setStatus(
+					"error",
+					"Visibility timeout must be a whole number between 0 and 43200 seconds.",
+				);
+				return;
+			}
+			visibilityTimeout = parsed;
+		}
+
+		const formText = (name: string) =>
+			(formData.get(name) as string | null)?.trim() ?? "";
 		const payload = {
 			maxMessages,
 			waitTimeSeconds,
+			groupByMessageGroup: groupByInput?.checked ?? false,
+			visibilityTimeout,
+			bodyPattern: formText("body_pattern"),
+			jsonPath: formText("json_path"),
+			jsonValue: formText("json_value"),
+			project: formText("project")
+				.split(",")
+				.map((path) => path.trim())
+				.filter((path) => path !== ""),
 		};
 
 		setPollButtonState(true);
@@ -555,17 +1520,28 @@ document.addEventListener("DOMContentLoaded", () => {
 		emptyState?.classList.add("hidden");
 
 		try {
-			const { messages } = await postJSON<ReceiveMessagesResponse>(
-				`/queues/${queuePath}/messages/poll`,
-				payload,
-			);
-			renderMessages(messages);
+			const { messages, groups, filteredOut } =
+				await postJSON<ReceiveMessagesResponse>(
+					`/queues/${queuePath}/messages/poll`,
+					payload,
+				);
+			renderMessages(messages, groups ?? null);
 			const count = messages.length;
+			let filteredNote = "";
+			if (filteredOut === 1) {
+				filteredNote =
+					" 1 message did not match the filter and is visible again.";
+			} else if (filteredOut) {
+				filteredNote = ` ${filteredOut} messages did not match the filter and are visible again.`;
+			}
 			if (count === 0) {
-				setStatus("success", "No messages were returned.");
+				setStatus("success", `No messages were returned.${filteredNote}`);
 			} else {
 				const suffix = count === 1 ? "" : "s";
-				setStatus("success", `Retrieved ${count} message${suffix}.`);
+				setStatus(
+					"success",
+					`Retrieved ${count} message${suffix}.${filteredNote}`,
+				);
 			}
 		} catch (error) {
 			const message =
@@ -577,4 +1553,206 @@ document.addEventListener("DOMContentLoaded", () => {
 			setPollButtonState(false);
 		}
 	});
+
+	const drainForm = page.querySelector<HTMLFormElement>("[data-drain-form]");
+	const drainButton =
+		drainForm?.querySelector<HTMLButtonElement>("[data-drain-button]");
+	const drainReasons = {
+		empty: "the queue is empty",
+		message_budget: "the message budget was reached",
+		time_budget: "the time budget ran out",
+	};
+
+	drainForm?.addEventListener("submit", async (event) => {
+		event.preventDefault();
+		if (!drainForm || !drainButton) {
+			return;
+		}
+
+		const formData = new FormData(drainForm);
+		const maxMessages = Number(formData.get("drain_max_messages") ?? "");
+		const maxSeconds = Number(formData.get("drain_max_seconds") ?? "");
+		if (
+			!Number.isInteger(maxMessages) ||
+			maxMessages < 1 ||
+			maxMessages > 1000
+		) {
+			setStatus(
+				"error",
+				"Message budget must be a whole number between 1 and 1000.",
+			);
+			return;
+		}
+		if (
+			!Number.isInteger(maxSeconds) ||
+			maxSeconds < 1 ||
+			maxSeconds > 300
+		) {
+			setStatus(
+				"error",
+				"Time budget must be a whole number between 1 and 300 seconds.",
+			);
+			return;
+		}
+
+		drainButton.disabled = true;
+		setPollButtonState(true);
+		renderMessages([]);
+		setStatus("info", "Receiving until the queue is empty…");
+
+		try {
+			const response = await fetch(`/queues/${queuePath}/messages/drain`, {
+				method: "POST",
+				headers: { "Content-Type": "application/json" },
+				body: JSON.stringify({ maxMessages, maxSeconds }),
+			});
+			await readEventStream<DrainEvent>(response, (drainEvent) => {
+				if (drainEvent.messages) {
+					renderMessages([...currentMessages, ...drainEvent.messages]);
+				}
+				if (drainEvent.error) {
+					throw new Error(
+						`${drainEvent.error} (${drainEvent.received} messages received)`,
+					);
+				}
+				if (!drainEvent.done) {
+					setStatus(
+						"info",
+						`Received ${drainEvent.received} messages so far…`,
+					);
+					return false;
+				}
+				const reason = drainEvent.reason
+					? drainReasons[drainEvent.reason]
+					: "the stream ended";
+				setStatus(
+					"success",
+					`Received ${drainEvent.received} messages; stopped because ${reason}.`,
+				);
+				return true;
+			});
+		} catch (error) {
+			const message =
+				error instanceof Error ? error.message : "Failed to drain messages.";
+			setStatus("error", message);
+		} finally {
+			drainButton.disabled = false;
+			setPollButtonState(false);
+		}
+	});
+
+	const searchForm = page.querySelector<HTMLFormElement>("[data-search-form]");
+	const searchButton =
+		searchForm?.querySelector<HTMLButtonElement>("[data-search-button]");
+	const searchReasons = {
+		...drainReasons,
+		matches: "enough messages matched",
+	};
+
+	searchForm?.addEventListener("submit", async (event) => {
+		event.preventDefault();
+		if (!searchForm || !searchButton) {
+			return;
+		}
+
+		const formData = new FormData(searchForm);
+		const query = String(formData.get("search_query") ?? "");
+		const attribute = String(formData.get("search_attribute") ?? "").trim();
+		const regex = formData.get("search_regex") === "on";
+		const maxMatches = Number(formData.get("search_max_matches") ?? "");
+		const maxMessages = Number(formData.get("search_max_messages") ?? "");
+		const maxSeconds = Number(formData.get("search_max_seconds") ?? "");
+		if (query === "") {
+			setStatus("error", "Enter the text to search for.");
+			return;
+		}
+		if (
+			!Number.isInteger(maxMatches) ||
+			maxMatches < 1 ||
+			maxMatches > 100
+		) {
+			setStatus(
+				"error",
+				"Matches must be a whole number between 1 and 100.",
+			);
+			return;
+		}
+		if (
+			!Number.isInteger(maxMessages) ||
+			maxMessages < 1 ||
+			maxMessages > 1000
+		) {
+			setStatus(
+				"error",
+				"Message budget must be a whole number between 1 and 1000.",
+			);
+			return;
+		}
+		if (
+			!Number.isInteger(maxSeconds) ||
+			maxSeconds < 1 ||
+			maxSeconds > 300
+		) {
+			setStatus(
+				"error",
+				"Time budget must be a whole number between 1 and 300 seconds.",
+			);
+			return;
+		}
+
+		searchButton.disabled = true;
+		setPollButtonState(true);
+		renderMessages([]);
+		setStatus("info", "Searching…");
+
+		try {
+			const response = await fetch(`/queues/${queuePath}/messages/search`, {
+				method: "POST",
+				headers: { "Content-Type": "application/json" },
+				body: JSON.stringify({
+					query,
+					regex,
+					attribute,
+					maxMatches,
+					maxMessages,
+					maxSeconds,
+				}),
+			});
+			await readEventStream<SearchEvent>(response, (searchEvent) => {
+				if (searchEvent.messages) {
+					renderMessages([...currentMessages, ...searchEvent.messages]);
+				}
+				if (searchEvent.error) {
+					throw new Error(
+						`${searchEvent.error} (${searchEvent.scanned} messages searched)`,
+					);
+				}
+				if (!searchEvent.done) {
+					setStatus(
+						"info",
+						`Searched ${searchEvent.scanned} messages, ${searchEvent.matched} matched so far…`,
+					);
+					return false;
+				}
+				const reason = searchEvent.reason
+					? searchReasons[searchEvent.reason]
+					: "the stream ended";
+				const unrestored = searchEvent.unrestored
+					? ` ${searchEvent.unrestored} messages that did not match could not be released and reappear later.`
+					: "";
+				setStatus(
+					searchEvent.unrestored ? "error" : "success",
+					`Searched ${searchEvent.scanned} messages and found ${searchEvent.matched}; stopped because ${reason}.${unrestored}`,
+				);
+				return true;
+			});
+		} catch (error) {
+			const message =
+				error instanceof Error ? error.message : "Failed to search messages.";
+			setStatus("error", message);
+		} finally {
+			searchButton.disabled = false;
+			setPollButtonState(false);
+		}
+	});
 });
@@ -0,0 +1,57 @@
+import "../css/app.css";
+import "../js/app";
+
+// The form works without the script; it only adds attribute rows and indents filter policies.
+document.addEventListener("DOMContentLoaded", () => {
+	const page = document.querySelector<HTMLElement>('[data-page="sns-publish"]');
+	if (!page) {
+		return;
+	}
+
+	for (const policy of page.querySelectorAll<HTMLElement>("[data-filter-policy]")) {
+		try {
+			policy.textContent = JSON.stringify(
+				JSON.parse(policy.textContent ?? ""),
+				null,
+				2,
+			);
+		} catch {
+			// SNS only stores valid policies; leave anything else as it is.
+		}
+	}
+
+	const rows = page.querySelector<HTMLElement>("[data-attribute-rows]");
+	const template = page.querySelector<HTMLTemplateElement>(
+		"#attribute-row-template",
+	);
+	if (!rows || !template) {
+		return;
+	}
+
+	const bindRemove = (row: HTMLElement) => {
+		row
+			.querySelector<HTMLButtonElement>("[data-attribute-remove]")
+			?.addEventListener("click", () => {
+				row.remove();
+				if (rows.querySelectorAll("[data-attribute-row]").length === 0) {
+					addRow();
+				}
+			});
+	};
+	const addRow = () => {
+		const row = template.content
+			.querySelector<HTMLElement>("[data-attribute-row]")
+			?.cloneNode(true) as HTMLElement | undefined;
+		if (row) {
+			rows.append(row);
+			bindRemove(row);
+		}
+	};
+
+	for (const row of rows.querySelectorAll<HTMLElement>("[data-attribute-row]")) {
+		bindRemove(row);
+	}
+	page
+		.querySelector<HTMLButtonElement>("[data-attribute-add]")
+		?.addEventListener("click", addRow);
+});
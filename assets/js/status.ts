@@ -0,0 +1,130 @@
+import "../css/app.css";
+import "../js/app";
+
+type QueueListResponse = {
+	queues: { queueUrl: string; queueName: string; type: string }[];
+};
+
+type LatencyResponse = {
+	queues: {
+		queueName: string;
+		received: number;
+		lost: number;
+		p50Ms: number;
+		p95Ms: number;
+		maxMs: number;
+		error?: string;
+	}[];
+};
+
+const formatMs = (value: number, received: number) =>
+	received > 0 ? `${value.toFixed(1)} ms` : "-";
+
+// The tables are rendered on the server; only the latency probe runs in the browser.
+document.addEventListener("DOMContentLoaded", async () => {
+	const probe = document.querySelector<HTMLElement>("[data-latency-probe]");
+	const form = probe?.querySelector<HTMLFormElement>("[data-latency-form]");
+	const queues = probe?.querySelector<HTMLElement>("[data-latency-queues]");
+	const status = probe?.querySelector<HTMLElement>("[data-latency-status]");
+	const results = probe?.querySelector<HTMLElement>("[data-latency-results]");
+	const rows = probe?.querySelector<HTMLElement>("[data-latency-rows]");
+	if (!form || !queues || !status || !results || !rows) {
+		return;
+	}
+
+	form.addEventListener("submit", async (event) => {
+		event.preventDefault();
+		const formData = new FormData(form);
+		const queueUrls = formData.getAll("queue_url") as string[];
+		if (queueUrls.length === 0) {
+			status.textContent = "Select at least one queue.";
+			return;
+		}
+
+		const button = form.querySelector<HTMLButtonElement>(
+			'button[type="submit"]',
+		);
+		if (button) {
+			button.disabled = true;
+		}
+		status.textContent = "Probing…";
+		try {
+			const response = await fetch("/api/v1/queues/latency", {
+				method: "POST",
+				headers: { "Content-Type": "application/json" },
+				body: JSON.stringify({
+					queueUrls,
+					samples: Number(formData.get("samples") ?? "5"),
+				}),
+			});
+			const data = await response.json();
+			if (!response.ok) {
+				throw new Error(
+					data?.error ?? `Request failed with status ${response.status}`,
+				);
+			}
+
+			rows.replaceChildren();
+			for (const queue of (data as LatencyResponse).queues) {
+				const row = document.createElement("tr");
+				const cells = queue.error
+					? [queue.queueName, `Failed: ${queue.error}`]
+					: [
+							queue.queueName,
+							String(queue.received),
+							String(queue.lost),
+							formatMs(queue.p50Ms, queue.received),
+							formatMs(queue.p95Ms, queue.received),
+							formatMs(queue.maxMs, queue.received),
+						];
+				for (const text of cells) {
+					const cell = document.createElement("td");
+					cell.className = "px-4 py-3 text-slate-700";
+					cell.textContent = text;
+					row.append(cell);
+				}
+				if (queue.error) {
+					row.lastElementChild?.setAttribute("colspan", "5");
+					row.lastElementChild?.classList.add("text-red-700");
+				}
+				rows.append(row);
+			}
+			results.classList.remove("hidden");
+			status.textContent = "Probe finished.";
+		} catch (error) {
+			status.textContent =
+				error instanceof Error ? error.message : "The probe failed.";
+		} finally {
+			if (button) {
+				button.disabled = false;
+			}
+		}
+	});
+
+	try {
+		const response = await fetch("/api/v1/queues?sort=name&limit=1000");
+		if (!response.ok) {
+			throw new Error(`Request failed with status ${response.status}`);
+		}
+		const data = (await response.json()) as QueueListResponse;
+		for (const queue of data.queues) {
+			const label = document.createElement("label");
+			label.className = "flex items-center gap-2 text-sm text-slate-700";
+			const checkbox = document.createElement("input");
+			checkbox.type = "checkbox";
+			checkbox.name = "queue_url";
+			checkbox.value = queue.queueUrl;
+			label.append(checkbox, `${queue.queueName} (${queue.type})`);
+			queues.append(label);
+		}
+		status.textContent =
+			data.queues.length > 0
+				? "Select up to 10 queues."
+				: "There are no queues to probe.";
+	} catch (error) {
+		status.textContent =
+			error instanceof Error
+				? `Could not load queues: ${error.message}`
+				: "Could not load queues.";
+	}
+});
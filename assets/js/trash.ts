@@ -0,0 +1,4 @@
+import "../css/app.css";
+import "../js/app";
+
+// The trash page is rendered on the server; discard confirmations come from app.ts.
@@ -6,10 +6,13 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/cockroachdb/errors"
 	"github.com/shigaichi/sqs-gui/internal"
@@ -19,17 +22,51 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer cancel()
 
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	logConfig, err := internal.LoadLogConfig(os.Getenv)
+	if err != nil {
+		slog.Error("failed to load logging configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	jsonHandler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: logConfig.Level})
+	logger := slog.New(internal.NewRedactingHandler(internal.NewRequestLogHandler(jsonHandler), logConfig.RevealSensitive))
 	slog.SetDefault(logger)
 
-	sqsClient, err := newSQSClient(ctx)
+	awsConfig, err := loadAWSConfig(ctx)
+	if err != nil {
+		slog.Error("failed to initialize AWS clients", slog.Any("error", err))
+		os.Exit(1)
+	}
+	sqsClient := newSQSClient(awsConfig)
+	snsClient := newSNSClient(awsConfig)
+	eventsClient := newEventBridgeClient(awsConfig)
+
+	store, err := internal.NewLocalStore(os.Getenv("SQS_GUI_STATE_FILE"))
+	if err != nil {
+		slog.Error("failed to initialize local state store", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	serviceConfig, err := internal.LoadServiceConfig(os.Getenv)
 	if err != nil {
-		slog.Error("failed to initialize SQS client", slog.Any("error", err))
+		slog.Error("failed to load configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	serverConfig, err := internal.LoadServerConfig(os.Getenv)
+	if err != nil {
+		slog.Error("failed to load server configuration", slog.Any("error", err))
 		os.Exit(1)
 	}
 
 	repo := internal.NewSqsRepository(sqsClient)
-	service := internal.NewSqsService(repo)
+	topics := internal.NewSnsRepository(snsClient)
+	events := internal.NewEventBridgeRepository(eventsClient)
+	regions := make(map[string]internal.SqsRepository, len(serviceConfig.MigrationRegions))
+	for _, region := range serviceConfig.MigrationRegions {
+		regions[region] = internal.NewSqsRepository(newSQSClient(regionalConfig(awsConfig, region)))
+	}
+	service := internal.NewSqsService(repo, topics, events, regions, store, serviceConfig)
 	handler := internal.NewHandler(service)
 
 	routerImpl := internal.NewRouteImpl(handler)
@@ -40,26 +77,109 @@ func main() {
 	}
 
 	srv := &http.Server{
-		Addr:              ":8080",
 		Handler:           router,
 		ReadHeaderTimeout: 3 * time.Minute,
-		ReadTimeout:       1 * time.Minute,
-		WriteTimeout:      1 * time.Minute,
-	}
-
-	serverErrCh := make(chan error, 1)
-	go func() {
-		serverErrCh <- srv.ListenAndServe()
-	}()
-
-	select {
-	case <-ctx.Done():
-		slog.Info("received SIGINT; shutting down server")
-	case err := <-serverErrCh:
-		if errors.Is(err, http.ErrServerClosed) {
-			slog.Info("server shut down gracefully")
-		} else if err != nil {
-			slog.Error("failed to start server", slog.Any("error", err))
+		ReadTimeout:       serverConfig.ReadTimeout,
+		WriteTimeout:      serverConfig.WriteTimeout,
+		IdleTimeout:       serverConfig.IdleTimeout,
+	}
+
+	if serviceConfig.Cleanup.Enabled() {
+		go internal.RunPeriodically(ctx, serviceConfig.Cleanup.Interval, func(ctx context.Context) {
+			if _, err := service.SweepTemporaryQueues(ctx); err != nil {
+				slog.Warn("temporary queue cleanup failed", slog.Any("error", err))
+			}
+		})
+	}
+
+	go internal.RunPeriodically(ctx, 30*time.Second, func(ctx context.Context) {
+		if err := service.RunDueSchedules(ctx); err != nil {
+			slog.Warn("failed to run due schedules", slog.Any("error", err))
+		}
+	})
+
+	go internal.RunPeriodically(ctx, serviceConfig.AlertInterval, func(ctx context.Context) {
+		if err := service.EvaluateAlerts(ctx); err != nil {
+			slog.Warn("failed to evaluate alert rules", slog.Any("error", err))
+		}
+	})
+
+	go internal.RunPeriodically(ctx, serviceConfig.DriftInterval, func(ctx context.Context) {
+		if err := service.CheckDrift(ctx); err != nil {
+			slog.Warn("failed to check configuration drift", slog.Any("error", err))
+		}
+	})
+
+	go internal.RunPeriodically(ctx, serviceConfig.HistoryInterval, func(ctx context.Context) {
+		if err := service.RecordAttributeHistory(ctx); err != nil {
+			slog.Warn("failed to record attribute history", slog.Any("error", err))
+		}
+	})
+
+	if serviceConfig.EmailReport.Enabled() {
+		go internal.RunPeriodically(ctx, time.Minute, func(ctx context.Context) {
+			if err := service.SendDueEmailReport(ctx); err != nil {
+				slog.Warn("failed to send email report", slog.Any("error", err))
+			}
+		})
+	}
+
+	if serviceConfig.WebPush.Enabled() {
+		go internal.RunPeriodically(ctx, serviceConfig.AlertInterval, func(ctx context.Context) {
+			if err := service.CheckDeadLetterArrivals(ctx); err != nil {
+				slog.Warn("failed to check dead-letter queues for new messages", slog.Any("error", err))
+			}
+		})
+	}
+
+	listeners, err := internal.Listen(os.Getenv, serverConfig.Listeners)
+	if err != nil {
+		slog.Error("failed to start server", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	serverErrCh, err := internal.Serve(srv, listeners, serverConfig.Listeners)
+	if err != nil {
+		slog.Error("failed to start server", slog.Any("error", err))
+		os.Exit(1)
+	}
+	if err := internal.NotifyReady(os.Getenv); err != nil {
+		slog.Warn("failed to notify the previous process", slog.Any("error", err))
+	}
+
+	restartCh := make(chan os.Signal, 1)
+	signal.Notify(restartCh, syscall.SIGHUP)
+
+	restarted := false
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("received SIGINT; shutting down server")
+		case err := <-serverErrCh:
+			if errors.Is(err, http.ErrServerClosed) {
+				slog.Info("server shut down gracefully")
+			} else if err != nil {
+				slog.Error("failed to start server", slog.Any("error", err))
+			}
+		case <-restartCh:
+			slog.Info("received SIGHUP; restarting")
+			if err := internal.Restart(listeners); err != nil {
+				slog.Error("failed to restart; the current process keeps serving", slog.Any("error", err))
+				continue
+			}
+			slog.Info("the new process is serving; finishing in-flight requests")
+			restarted = true
+		}
+		break
+	}
+	// Stop the periodic tasks; after a restart the new process runs them.
+	cancel()
+
+	if !restarted {
+		// Long polls can take 20 seconds to return on their own; cancel them so Shutdown does not wait.
+		// A restart lets them finish instead, since the new process already accepts connections.
+		if cancelled := service.DrainPolls(); cancelled > 0 {
+			slog.Info("cancelled in-flight long polls", slog.Int("count", cancelled))
 		}
 	}
 
@@ -73,24 +193,66 @@ func main() {
 	slog.Info("server stopped")
 }
 
-func newSQSClient(ctx context.Context) (*sqs.Client, error) {
+func loadAWSConfig(ctx context.Context) (aws.Config, error) {
 	region := os.Getenv("AWS_REGION")
 	if region == "" {
 		region = "us-east-1"
 	}
 
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+	if err != nil {
+		return aws.Config{}, errors.Wrap(err, "failed to load AWS configuration")
+	}
+	return cfg, nil
+}
+
+// regionalConfig returns cfg for another region, keeping its credentials, so queues can be copied
+// there.
+func regionalConfig(cfg aws.Config, region string) aws.Config {
+	regional := cfg.Copy()
+	regional.Region = region
+	return regional
+}
+
+func newSQSClient(cfg aws.Config) *sqs.Client {
 	endpoint := os.Getenv("AWS_SQS_ENDPOINT")
 
-	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+	return sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		o.APIOptions = append(o.APIOptions, internal.AddTraceHeader)
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+}
 
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to load AWS configuration")
+// newSNSClient talks to AWS_SNS_ENDPOINT, or to AWS_SQS_ENDPOINT when only that is set, since local
+// emulators such as LocalStack serve both on one endpoint.
+func newSNSClient(cfg aws.Config) *sns.Client {
+	endpoint := os.Getenv("AWS_SNS_ENDPOINT")
+	if endpoint == "" {
+		endpoint = os.Getenv("AWS_SQS_ENDPOINT")
+	}
+
+	return sns.NewFromConfig(cfg, func(o *sns.Options) {
+		o.APIOptions = append(o.APIOptions, internal.AddTraceHeader)
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+}
+
+// newEventBridgeClient talks to AWS_EVENTBRIDGE_ENDPOINT, or to AWS_SQS_ENDPOINT when only that is
+// set.
+func newEventBridgeClient(cfg aws.Config) *eventbridge.Client {
+	endpoint := os.Getenv("AWS_EVENTBRIDGE_ENDPOINT")
+	if endpoint == "" {
+		endpoint = os.Getenv("AWS_SQS_ENDPOINT")
 	}
 
-	client := sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+	return eventbridge.NewFromConfig(cfg, func(o *eventbridge.Options) {
+		o.APIOptions = append(o.APIOptions, internal.AddTraceHeader)
 		if endpoint != "" {
 			o.BaseEndpoint = aws.String(endpoint)
 		}
 	})
-	return client, nil
 }
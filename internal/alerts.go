@@ -0,0 +1,470 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// AlertMetric names the queue statistic an alert rule watches.
+type AlertMetric string
+
+const (
+	AlertMetricMessagesAvailable AlertMetric = "messages_available"
+	AlertMetricMessagesInFlight  AlertMetric = "messages_in_flight"
+)
+
+// AlertStatus is the evaluated state of an alert rule.
+type AlertStatus string
+
+const (
+	AlertStatusOK      AlertStatus = "ok"
+	AlertStatusPending AlertStatus = "pending"
+	AlertStatusFiring  AlertStatus = "firing"
+)
+
+// ErrAlertRuleNotFound is returned when an alert rule ID does not exist.
+var ErrAlertRuleNotFound = errors.New("alert rule not found")
+
+// AlertRule fires when Metric stays at or above Threshold for at least For, and resolves
+// once it drops to ResolveThreshold or below. A lower ResolveThreshold gives hysteresis so a
+// queue hovering around the threshold does not flap.
+type AlertRule struct {
+	ID               string         `json:"id"`
+	Name             string         `json:"name"`
+	QueueURL         string         `json:"queueUrl"`
+	Metric           AlertMetric    `json:"metric"`
+	Threshold        int64          `json:"threshold"`
+	ResolveThreshold int64          `json:"resolveThreshold"`
+	For              time.Duration  `json:"for"`
+	Silences         []AlertSilence `json:"silences,omitempty"`
+	// Channels are where the rule notifies; empty means every configured channel.
+	Channels  []NotificationChannel `json:"channels,omitempty"`
+	CreatedAt time.Time             `json:"createdAt"`
+}
+
+// AlertSilence suppresses notifications for a rule between Start and End.
+type AlertSilence struct {
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+// CreateAlertRuleInput carries the user supplied fields of a new alert rule.
+// A nil ResolveThreshold resolves as soon as the value drops below Threshold, and empty Channels
+// notify every configured channel.
+type CreateAlertRuleInput struct {
+	Name             string
+	QueueURL         string
+	Metric           AlertMetric
+	Threshold        int64
+	ResolveThreshold *int64
+	For              time.Duration
+	Channels         []NotificationChannel
+}
+
+// AlertRuleState pairs a rule with the outcome of its latest evaluation.
+type AlertRuleState struct {
+	Rule        AlertRule
+	Status      AlertStatus
+	Since       time.Time
+	Value       int64
+	EvaluatedAt time.Time
+	Error       string
+	Silence     *AlertSilence
+}
+
+// AlertFiring records a rule starting to fire. Silenced firings are recorded too, since the
+// silence only holds back the notification.
+type AlertFiring struct {
+	RuleID   string
+	RuleName string
+	QueueURL string
+	Metric   AlertMetric
+	Value    int64
+	At       time.Time
+	Silenced bool
+}
+
+// maxAlertFirings bounds the firings remembered for the email report; the oldest are dropped first.
+const maxAlertFirings = 500
+
+// alertTracker keeps evaluation state and recent firings in memory; rules start from ok after a
+// restart.
+type alertTracker struct {
+	mu     sync.Mutex
+	states map[string]AlertRuleState
+	fired  []AlertFiring
+}
+
+func newAlertTracker() *alertTracker {
+	return &alertTracker{states: make(map[string]AlertRuleState)}
+}
+
+// activeSilence returns the silence covering now, if any.
+func (r AlertRule) activeSilence(now time.Time) *AlertSilence {
+	for _, silence := range r.Silences {
+		if !now.Before(silence.Start) && now.Before(silence.End) {
+			return &silence
+		}
+	}
+	return nil
+}
+
+func (r AlertRule) metricValue(queue QueueSummary) int64 {
+	if r.Metric == AlertMetricMessagesInFlight {
+		return queue.MessagesInFlight
+	}
+	return queue.MessagesAvailable
+}
+
+// AlertRules returns every rule with its current state.
+func (s *SqsServiceImpl) AlertRules(_ context.Context) ([]AlertRuleState, error) {
+	if s.store == nil {
+		return []AlertRuleState{}, nil
+	}
+
+	rules, err := s.store.AlertRules()
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(rules, func(a, b AlertRule) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	now := s.now()
+	s.alerts.mu.Lock()
+	defer s.alerts.mu.Unlock()
+
+	states := make([]AlertRuleState, 0, len(rules))
+	for _, rule := range rules {
+		state, ok := s.alerts.states[rule.ID]
+		if !ok {
+			state = AlertRuleState{Status: AlertStatusOK}
+		}
+		state.Rule = rule
+		state.Silence = rule.activeSilence(now)
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+// CreateAlertRule validates and stores a new alert rule.
+func (s *SqsServiceImpl) CreateAlertRule(_ context.Context, input CreateAlertRuleInput) (AlertRule, error) {
+	if s.store == nil {
+		return AlertRule{}, errors.New("alert rules are not available without a state store")
+	}
+
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return AlertRule{}, errors.New("queue url is required")
+	}
+
+	resolveThreshold := input.Threshold - 1
+	if input.ResolveThreshold != nil {
+		resolveThreshold = *input.ResolveThreshold
+	}
+	if err := validateAlertCondition(input.Metric, input.Threshold, resolveThreshold, input.For); err != nil {
+		return AlertRule{}, err
+	}
+
+	channels, err := validateNotificationChannels(input.Channels)
+	if err != nil {
+		return AlertRule{}, err
+	}
+	for _, channel := range channels {
+		if _, ok := s.notifiers[channel]; !ok {
+			return AlertRule{}, errors.Newf("the %s notification channel is not configured", channel)
+		}
+	}
+
+	id, err := newRandomID()
+	if err != nil {
+		return AlertRule{}, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		name = fmt.Sprintf("%s %s >= %d", extractQueueName(queueURL), input.Metric, input.Threshold)
+	}
+
+	rule := AlertRule{
+		ID:               id,
+		Name:             name,
+		QueueURL:         queueURL,
+		Metric:           input.Metric,
+		Threshold:        input.Threshold,
+		ResolveThreshold: resolveThreshold,
+		For:              input.For,
+		Channels:         channels,
+		CreatedAt:        s.now().UTC(),
+	}
+	if err := s.store.SaveAlertRule(rule); err != nil {
+		return AlertRule{}, err
+	}
+
+	return rule, nil
+}
+
+// DeleteAlertRule removes a rule and forgets its state.
+func (s *SqsServiceImpl) DeleteAlertRule(_ context.Context, id string) error {
+	if s.store == nil {
+		return ErrAlertRuleNotFound
+	}
+	if err := s.store.DeleteAlertRule(id); err != nil {
+		return err
+	}
+
+	s.alerts.mu.Lock()
+	delete(s.alerts.states, id)
+	s.alerts.mu.Unlock()
+
+	return nil
+}
+
+// SilenceAlertRule suppresses notifications for the rule from now until now+duration.
+func (s *SqsServiceImpl) SilenceAlertRule(_ context.Context, id string, duration time.Duration, reason string) (AlertRule, error) {
+	if duration <= 0 {
+		return AlertRule{}, errors.New("silence duration must be positive")
+	}
+
+	rule, err := s.alertRule(id)
+	if err != nil {
+		return AlertRule{}, err
+	}
+
+	now := s.now().UTC()
+	rule.Silences = append(pruneSilences(rule.Silences, now), AlertSilence{
+		Start:  now,
+		End:    now.Add(duration),
+		Reason: strings.TrimSpace(reason),
+	})
+	if err := s.store.SaveAlertRule(rule); err != nil {
+		return AlertRule{}, err
+	}
+
+	return rule, nil
+}
+
+// UnsilenceAlertRule ends every active silence of the rule.
+func (s *SqsServiceImpl) UnsilenceAlertRule(_ context.Context, id string) (AlertRule, error) {
+	rule, err := s.alertRule(id)
+	if err != nil {
+		return AlertRule{}, err
+	}
+
+	now := s.now().UTC()
+	silences := make([]AlertSilence, 0, len(rule.Silences))
+	for _, silence := range pruneSilences(rule.Silences, now) {
+		if now.Before(silence.Start) {
+			silences = append(silences, silence)
+		}
+	}
+	rule.Silences = silences
+	if err := s.store.SaveAlertRule(rule); err != nil {
+		return AlertRule{}, err
+	}
+
+	return rule, nil
+}
+
+// EvaluateAlerts checks every rule against the current queue statistics and notifies on
+// transitions to firing and back to ok, unless the rule is silenced.
+func (s *SqsServiceImpl) EvaluateAlerts(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+
+	rules, err := s.store.AlertRules()
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	queues, err := s.Queues(ctx)
+	if err != nil {
+		return err
+	}
+	byURL := make(map[string]QueueSummary, len(queues))
+	for _, queue := range queues {
+		byURL[queue.URL] = queue
+	}
+
+	now := s.now().UTC()
+	s.alerts.mu.Lock()
+	defer s.alerts.mu.Unlock()
+
+	for _, rule := range rules {
+		state, ok := s.alerts.states[rule.ID]
+		if !ok {
+			state = AlertRuleState{Status: AlertStatusOK, Since: now}
+		}
+		state.EvaluatedAt = now
+
+		queue, found := byURL[rule.QueueURL]
+		if !found {
+			state.Error = "queue not found"
+			s.alerts.states[rule.ID] = state
+			continue
+		}
+		state.Error = ""
+		state.Value = rule.metricValue(queue)
+
+		previous := state.Status
+		state.Status, state.Since = nextAlertStatus(rule, state, now)
+		s.alerts.states[rule.ID] = state
+
+		if state.Status == AlertStatusFiring && previous != AlertStatusFiring {
+			s.alerts.fired = append(s.alerts.fired, AlertFiring{
+				RuleID:   rule.ID,
+				RuleName: rule.Name,
+				QueueURL: rule.QueueURL,
+				Metric:   rule.Metric,
+				Value:    state.Value,
+				At:       now,
+				Silenced: rule.activeSilence(now) != nil,
+			})
+			if len(s.alerts.fired) > maxAlertFirings {
+				s.alerts.fired = s.alerts.fired[len(s.alerts.fired)-maxAlertFirings:]
+			}
+		}
+
+		if state.Status == previous || rule.activeSilence(now) != nil {
+			continue
+		}
+		switch {
+		case state.Status == AlertStatusFiring:
+			s.notifyChannels(ctx, rule.Channels, Notification{
+				Title: fmt.Sprintf("Alert firing: %s", rule.Name),
+				Text:  fmt.Sprintf("%s is %d on %s (threshold %d).", rule.Metric, state.Value, rule.QueueURL, rule.Threshold),
+			})
+		case previous == AlertStatusFiring && state.Status == AlertStatusOK:
+			s.notifyChannels(ctx, rule.Channels, Notification{
+				Title: fmt.Sprintf("Alert resolved: %s", rule.Name),
+				Text:  fmt.Sprintf("%s is back to %d on %s.", rule.Metric, state.Value, rule.QueueURL),
+			})
+		}
+	}
+
+	for id := range s.alerts.states {
+		if !slices.ContainsFunc(rules, func(rule AlertRule) bool { return rule.ID == id }) {
+			delete(s.alerts.states, id)
+		}
+	}
+
+	return nil
+}
+
+// nextAlertStatus applies the threshold, for-duration and hysteresis rules to state.Value.
+func nextAlertStatus(rule AlertRule, state AlertRuleState, now time.Time) (AlertStatus, time.Time) {
+	breaching := state.Value >= rule.Threshold
+
+	switch state.Status {
+	case AlertStatusFiring:
+		if state.Value <= rule.ResolveThreshold {
+			return AlertStatusOK, now
+		}
+		return AlertStatusFiring, state.Since
+	case AlertStatusPending:
+		if !breaching {
+			return AlertStatusOK, now
+		}
+		if now.Sub(state.Since) >= rule.For {
+			return AlertStatusFiring, now
+		}
+		return AlertStatusPending, state.Since
+	default:
+		if !breaching {
+			return AlertStatusOK, state.Since
+		}
+		if rule.For == 0 {
+			return AlertStatusFiring, now
+		}
+		return AlertStatusPending, now
+	}
+}
+
+// alertFiringsSince returns the firings recorded at or after since, oldest first.
+func (s *SqsServiceImpl) alertFiringsSince(since time.Time) []AlertFiring {
+	s.alerts.mu.Lock()
+	defer s.alerts.mu.Unlock()
+
+	var firings []AlertFiring
+	for _, firing := range s.alerts.fired {
+		if !firing.At.Before(since) {
+			firings = append(firings, firing)
+		}
+	}
+	return firings
+}
+
+func (s *SqsServiceImpl) alertRule(id string) (AlertRule, error) {
+	if s.store == nil {
+		return AlertRule{}, ErrAlertRuleNotFound
+	}
+
+	rule, ok, err := s.store.AlertRule(id)
+	if err != nil {
+		return AlertRule{}, err
+	}
+	if !ok {
+		return AlertRule{}, ErrAlertRuleNotFound
+	}
+	return rule, nil
+}
+
+// validateAlertCondition checks the parts of a rule that decide when it fires and resolves.
+func validateAlertCondition(metric AlertMetric, threshold, resolveThreshold int64, forDuration time.Duration) error {
+	switch metric {
+	case AlertMetricMessagesAvailable, AlertMetricMessagesInFlight:
+	default:
+		return errors.Newf("unsupported alert metric %q", metric)
+	}
+
+	if threshold < 1 {
+		return errors.New("threshold must be at least 1")
+	}
+	if resolveThreshold < 0 || resolveThreshold >= threshold {
+		return errors.New("resolve threshold must be between 0 and the threshold minus one")
+	}
+	if forDuration < 0 {
+		return errors.New("for duration must not be negative")
+	}
+	return nil
+}
+
+// validateNotificationChannels checks that every channel exists and returns them in delivery order
+// without repeats.
+func validateNotificationChannels(channels []NotificationChannel) ([]NotificationChannel, error) {
+	var valid []NotificationChannel
+	for _, channel := range notificationChannels {
+		if slices.Contains(channels, channel) {
+			valid = append(valid, channel)
+		}
+	}
+	for _, channel := range channels {
+		if !slices.Contains(notificationChannels, channel) {
+			return nil, errors.Newf("unsupported notification channel %q", channel)
+		}
+	}
+	return valid, nil
+}
+
+// pruneSilences drops silences that ended before now.
+func pruneSilences(silences []AlertSilence, now time.Time) []AlertSilence {
+	kept := make([]AlertSilence, 0, len(silences))
+	for _, silence := range silences {
+		if now.Before(silence.End) {
+			kept = append(kept, silence)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,277 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+type alertsPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	Flash        *pageFlash
+	ErrorMessage string
+	Rules        []alertRuleView
+	Queues       []queueOption
+	Metrics      []selectOption
+	Channels     []alertChannelOption
+	Form         alertRuleForm
+}
+
+// alertChannelOption is a configured notification channel offered for a new rule.
+type alertChannelOption struct {
+	Value   string
+	Label   string
+	Checked bool
+}
+
+// notificationChannelLabels name the notification channels on the alerts page.
+var notificationChannelLabels = map[NotificationChannel]string{
+	NotificationChannelWebhook: "Webhook",
+	NotificationChannelSlack:   "Slack",
+	NotificationChannelTeams:   "Microsoft Teams",
+}
+
+type alertRuleView struct {
+	ID               string
+	Name             string
+	QueueName        string
+	QueueURL         string
+	Metric           string
+	Threshold        int64
+	ResolveThreshold int64
+	For              string
+	Status           string
+	Value            string
+	Since            string
+	SilencedUntil    string
+	SilenceReason    string
+	Error            string
+	Channels         string
+}
+
+type alertRuleForm struct {
+	Name             string
+	QueueURL         string
+	Metric           string
+	Threshold        string
+	ResolveThreshold string
+	For              string
+	Channels         []string
+}
+
+// AlertsHandler renders alert rules together with their current state.
+func (h *HandlerImpl) AlertsHandler(w http.ResponseWriter, r *http.Request) {
+	var flash *pageFlash
+	switch r.URL.Query().Get("done") {
+	case "created":
+		flash = &pageFlash{Message: "Alert rule was created successfully.", Kind: "success"}
+	case "deleted":
+		flash = &pageFlash{Message: "Alert rule was deleted successfully.", Kind: "success"}
+	case "silenced":
+		flash = &pageFlash{Message: "Alert rule was silenced.", Kind: "success"}
+	case "unsilenced":
+		flash = &pageFlash{Message: "Alert rule silence was lifted.", Kind: "success"}
+	}
+
+	h.renderAlerts(w, r, alertsPageData{
+		Flash: flash,
+		Form:  alertRuleForm{Metric: string(AlertMetricMessagesAvailable), For: "5m"},
+	})
+}
+
+// PostAlertRuleHandler creates an alert rule from the form on the alerts page.
+func (h *HandlerImpl) PostAlertRuleHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	form := alertRuleForm{
+		Name:             strings.TrimSpace(r.FormValue("name")),
+		QueueURL:         strings.TrimSpace(r.FormValue("queue_url")),
+		Metric:           r.FormValue("metric"),
+		Threshold:        strings.TrimSpace(r.FormValue("threshold")),
+		ResolveThreshold: strings.TrimSpace(r.FormValue("resolve_threshold")),
+		For:              strings.TrimSpace(r.FormValue("for")),
+		Channels:         r.Form["channel"],
+	}
+
+	input, err := form.toInput()
+	if err == nil {
+		_, err = h.s.CreateAlertRule(r.Context(), input)
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create alert rule", slog.String("queue_url", form.QueueURL), slog.Any("error", err))
+		w.WriteHeader(http.StatusBadRequest)
+		h.renderAlerts(w, r, alertsPageData{ErrorMessage: err.Error(), Form: form})
+		return
+	}
+
+	http.Redirect(w, r, "/alerts?done=created", http.StatusSeeOther)
+}
+
+// DeleteAlertRuleHandler removes an alert rule.
+func (h *HandlerImpl) DeleteAlertRuleHandler(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if err := h.s.DeleteAlertRule(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete alert rule", slog.String("rule_id", id), slog.Any("error", err))
+		http.Error(w, "failed to delete alert rule", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/alerts?done=deleted", http.StatusSeeOther)
+}
+
+// SilenceAlertRuleHandler silences an alert rule for the submitted duration.
+func (h *HandlerImpl) SilenceAlertRuleHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	duration, err := time.ParseDuration(r.FormValue("duration"))
+	if err != nil {
+		http.Error(w, "invalid silence duration", http.StatusBadRequest)
+		return
+	}
+
+	id := r.PathValue("id")
+	if _, err := h.s.SilenceAlertRule(r.Context(), id, duration, r.FormValue("reason")); err != nil {
+		slog.ErrorContext(r.Context(), "failed to silence alert rule", slog.String("rule_id", id), slog.Any("error", err))
+		http.Error(w, "failed to silence alert rule", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/alerts?done=silenced", http.StatusSeeOther)
+}
+
+// UnsilenceAlertRuleHandler lifts the active silences of an alert rule.
+func (h *HandlerImpl) UnsilenceAlertRuleHandler(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, err := h.s.UnsilenceAlertRule(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "failed to unsilence alert rule", slog.String("rule_id", id), slog.Any("error", err))
+		http.Error(w, "failed to unsilence alert rule", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/alerts?done=unsilenced", http.StatusSeeOther)
+}
+
+func (f alertRuleForm) toInput() (CreateAlertRuleInput, error) {
+	input := CreateAlertRuleInput{
+		Name:     f.Name,
+		QueueURL: f.QueueURL,
+		Metric:   AlertMetric(f.Metric),
+	}
+	for _, channel := range f.Channels {
+		input.Channels = append(input.Channels, NotificationChannel(channel))
+	}
+
+	threshold, err := strconv.ParseInt(f.Threshold, 10, 64)
+	if err != nil {
+		return CreateAlertRuleInput{}, errors.New("threshold must be a whole number")
+	}
+	input.Threshold = threshold
+
+	if f.ResolveThreshold != "" {
+		resolve, err := strconv.ParseInt(f.ResolveThreshold, 10, 64)
+		if err != nil {
+			return CreateAlertRuleInput{}, errors.New("resolve threshold must be a whole number")
+		}
+		input.ResolveThreshold = &resolve
+	}
+
+	if f.For != "" {
+		input.For, err = time.ParseDuration(f.For)
+		if err != nil {
+			return CreateAlertRuleInput{}, errors.New("for duration must look like 30s, 5m or 1h")
+		}
+	}
+
+	return input, nil
+}
+
+func (h *HandlerImpl) renderAlerts(w http.ResponseWriter, r *http.Request, data alertsPageData) {
+	data.Title = "Alerts"
+	data.ViteTags = fragments["assets/js/alerts.ts"].Tags
+	data.Metrics = []selectOption{
+		{Value: string(AlertMetricMessagesAvailable), Label: "Messages available"},
+		{Value: string(AlertMetricMessagesInFlight), Label: "Messages in flight"},
+	}
+	for _, channel := range h.s.NotificationChannels(r.Context()) {
+		data.Channels = append(data.Channels, alertChannelOption{
+			Value:   string(channel),
+			Label:   notificationChannelLabels[channel],
+			Checked: slices.Contains(data.Form.Channels, string(channel)),
+		})
+	}
+
+	states, err := h.s.AlertRules(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load alert rules", slog.Any("error", err))
+		data.ErrorMessage = "Failed to load alert rules."
+	}
+	for _, state := range states {
+		data.Rules = append(data.Rules, newAlertRuleView(state))
+	}
+
+	queues, err := h.s.Queues(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load queue list", slog.Any("error", err))
+		data.ErrorMessage = "Failed to load queues."
+	}
+	for _, queue := range queues {
+		data.Queues = append(data.Queues, queueOption{Name: queue.Name, URL: queue.URL})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates["alerts"].Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "failed to render alerts template", slog.Any("error", err))
+		http.Error(w, "template error", http.StatusInternalServerError)
+	}
+}
+
+func newAlertRuleView(state AlertRuleState) alertRuleView {
+	rule := state.Rule
+	view := alertRuleView{
+		ID:               url.PathEscape(rule.ID),
+		Name:             rule.Name,
+		QueueName:        extractQueueName(rule.QueueURL),
+		QueueURL:         url.QueryEscape(rule.QueueURL),
+		Metric:           string(rule.Metric),
+		Threshold:        rule.Threshold,
+		ResolveThreshold: rule.ResolveThreshold,
+		For:              rule.For.String(),
+		Status:           string(state.Status),
+		Value:            "-",
+		Since:            "-",
+		Error:            state.Error,
+		Channels:         "every channel",
+	}
+	if len(rule.Channels) > 0 {
+		labels := make([]string, 0, len(rule.Channels))
+		for _, channel := range rule.Channels {
+			labels = append(labels, notificationChannelLabels[channel])
+		}
+		view.Channels = strings.Join(labels, ", ")
+	}
+	if !state.EvaluatedAt.IsZero() && state.Error == "" {
+		view.Value = strconv.FormatInt(state.Value, 10)
+	}
+	if !state.Since.IsZero() {
+		view.Since = state.Since.Local().Format(displayTimeLayout)
+	}
+	if state.Silence != nil {
+		view.SilencedUntil = state.Silence.End.Local().Format(displayTimeLayout)
+		view.SilenceReason = state.Silence.Reason
+	}
+	return view
+}
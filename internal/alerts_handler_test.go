@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_AlertsHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/alerts?done=silenced", nil)
+	rr := httptest.NewRecorder()
+
+	var captured alertsPageData
+	captureTemplate(t, "alerts", func(data alertsPageData) { captured = data })
+	installFragment(t, "assets/js/alerts.ts", template.HTML(`<script data-test="alerts"></script>`))
+
+	evaluated := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockService.EXPECT().
+		AlertRules(mock.Anything).
+		Return([]AlertRuleState{{
+			Rule: AlertRule{
+				ID:               "depth",
+				Name:             "orders backlog",
+				QueueURL:         "https://sqs.local/orders",
+				Metric:           AlertMetricMessagesAvailable,
+				Threshold:        100,
+				ResolveThreshold: 50,
+				For:              5 * time.Minute,
+				Channels:         []NotificationChannel{NotificationChannelTeams},
+			},
+			Status:      AlertStatusFiring,
+			Value:       130,
+			Since:       evaluated,
+			EvaluatedAt: evaluated,
+			Silence:     &AlertSilence{End: evaluated.Add(time.Hour), Reason: "load test"},
+		}}, nil).
+		Once()
+	mockService.EXPECT().Queues(mock.Anything).Return([]QueueSummary{{Name: "orders", URL: "https://sqs.local/orders"}}, nil).Once()
+	mockService.EXPECT().NotificationChannels(mock.Anything).Return([]NotificationChannel{NotificationChannelWebhook, NotificationChannelTeams}).Once()
+
+	handler.AlertsHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "Alerts", captured.Title)
+	assert.Equal(t, &pageFlash{Message: "Alert rule was silenced.", Kind: "success"}, captured.Flash)
+	if assert.Len(t, captured.Rules, 1) {
+		view := captured.Rules[0]
+		assert.Equal(t, "firing", view.Status)
+		assert.Equal(t, "130", view.Value)
+		assert.Equal(t, "5m0s", view.For)
+		assert.Equal(t, url.QueryEscape("https://sqs.local/orders"), view.QueueURL)
+		assert.Equal(t, "load test", view.SilenceReason)
+		assert.NotEmpty(t, view.SilencedUntil)
+		assert.Equal(t, "Microsoft Teams", view.Channels)
+	}
+	assert.Equal(t, []alertChannelOption{
+		{Value: "webhook", Label: "Webhook"},
+		{Value: "teams", Label: "Microsoft Teams"},
+	}, captured.Channels)
+}
+
+func TestHandlerImpl_PostAlertRuleHandler(t *testing.T) {
+	newRequest := func(values url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/alerts", strings.NewReader(values.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	t.Run("creates the rule", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		resolve := int64(20)
+		mockService.EXPECT().
+			CreateAlertRule(mock.Anything, CreateAlertRuleInput{
+				Name:             "backlog",
+				QueueURL:         "https://sqs.local/orders",
+				Metric:           AlertMetricMessagesInFlight,
+				Threshold:        50,
+				ResolveThreshold: &resolve,
+				For:              10 * time.Minute,
+				Channels:         []NotificationChannel{NotificationChannelSlack, NotificationChannelTeams},
+			}).
+			Return(AlertRule{ID: "depth"}, nil).
+			Once()
+
+		handler.PostAlertRuleHandler(rr, newRequest(url.Values{
+			"name":              {"backlog"},
+			"queue_url":         {"https://sqs.local/orders"},
+			"metric":            {"messages_in_flight"},
+			"threshold":         {"50"},
+			"resolve_threshold": {"20"},
+			"for":               {"10m"},
+			"channel":           {"slack", "teams"},
+		}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/alerts?done=created", rr.Header().Get("Location"))
+	})
+
+	t.Run("re-renders invalid input", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured alertsPageData
+		captureTemplate(t, "alerts", func(data alertsPageData) { captured = data })
+		installFragment(t, "assets/js/alerts.ts", "")
+
+		mockService.EXPECT().AlertRules(mock.Anything).Return([]AlertRuleState{}, nil).Once()
+		mockService.EXPECT().Queues(mock.Anything).Return([]QueueSummary{}, nil).Once()
+		mockService.EXPECT().NotificationChannels(mock.Anything).Return([]NotificationChannel{NotificationChannelSlack, NotificationChannelTeams}).Once()
+
+		handler.PostAlertRuleHandler(rr, newRequest(url.Values{
+			"queue_url": {"https://sqs.local/orders"},
+			"metric":    {"messages_available"},
+			"threshold": {"10"},
+			"for":       {"soon"},
+			"channel":   {"teams"},
+		}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "for duration must look like 30s, 5m or 1h", captured.ErrorMessage)
+		assert.Equal(t, "soon", captured.Form.For)
+		assert.Equal(t, []alertChannelOption{
+			{Value: "slack", Label: "Slack"},
+			{Value: "teams", Label: "Microsoft Teams", Checked: true},
+		}, captured.Channels)
+	})
+}
+
+func TestHandlerImpl_AlertRuleActions(t *testing.T) {
+	t.Run("silence", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/alerts/depth/silence", strings.NewReader("duration=8h&reason=deploy"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("id", "depth")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().SilenceAlertRule(mock.Anything, "depth", 8*time.Hour, "deploy").Return(AlertRule{}, nil).Once()
+
+		handler.SilenceAlertRuleHandler(rr, req)
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/alerts?done=silenced", rr.Header().Get("Location"))
+	})
+
+	t.Run("silence rejects invalid durations", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+
+		req := httptest.NewRequest(http.MethodPost, "/alerts/depth/silence", strings.NewReader("duration=forever"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("id", "depth")
+		rr := httptest.NewRecorder()
+
+		handler.SilenceAlertRuleHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "invalid silence duration\n", rr.Body.String())
+	})
+
+	t.Run("unsilence", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/alerts/depth/unsilence", nil)
+		req.SetPathValue("id", "depth")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().UnsilenceAlertRule(mock.Anything, "depth").Return(AlertRule{}, nil).Once()
+
+		handler.UnsilenceAlertRuleHandler(rr, req)
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/alerts?done=unsilenced", rr.Header().Get("Location"))
+	})
+
+	t.Run("delete failure", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/alerts/depth/delete", nil)
+		req.SetPathValue("id", "depth")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().DeleteAlertRule(mock.Anything, "depth").Return(errors.New("boom")).Once()
+
+		handler.DeleteAlertRuleHandler(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, "failed to delete alert rule\n", rr.Body.String())
+	})
+}
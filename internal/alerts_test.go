@@ -0,0 +1,306 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_CreateAlertRule(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("defaults the name and resolve threshold", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return created }}
+
+		store.EXPECT().SaveAlertRule(mock.Anything).Return(nil).Once()
+
+		rule, err := service.CreateAlertRule(ctx, CreateAlertRuleInput{
+			QueueURL:  "https://sqs.local/orders",
+			Metric:    AlertMetricMessagesAvailable,
+			Threshold: 100,
+			For:       5 * time.Minute,
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, rule.ID)
+		assert.Equal(t, "orders messages_available >= 100", rule.Name)
+		assert.Equal(t, int64(99), rule.ResolveThreshold)
+		assert.Equal(t, created, rule.CreatedAt)
+	})
+
+	t.Run("keeps channels in delivery order", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return created }, notifiers: map[NotificationChannel]Notifier{
+			NotificationChannelWebhook: NewMockNotifier(t),
+			NotificationChannelTeams:   NewMockNotifier(t),
+		}}
+
+		store.EXPECT().SaveAlertRule(mock.Anything).Return(nil).Once()
+
+		rule, err := service.CreateAlertRule(ctx, CreateAlertRuleInput{
+			QueueURL:  "https://sqs.local/orders",
+			Metric:    AlertMetricMessagesAvailable,
+			Threshold: 100,
+			Channels:  []NotificationChannel{NotificationChannelTeams, NotificationChannelWebhook, NotificationChannelTeams},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []NotificationChannel{NotificationChannelWebhook, NotificationChannelTeams}, rule.Channels)
+	})
+
+	resolve := func(v int64) *int64 { return &v }
+	testCases := []struct {
+		name    string
+		input   CreateAlertRuleInput
+		wantErr string
+	}{
+		{
+			name:    "missing queue",
+			input:   CreateAlertRuleInput{Metric: AlertMetricMessagesAvailable, Threshold: 1},
+			wantErr: "queue url is required",
+		},
+		{
+			name:    "unknown metric",
+			input:   CreateAlertRuleInput{QueueURL: "https://sqs.local/orders", Metric: "age", Threshold: 1},
+			wantErr: `unsupported alert metric "age"`,
+		},
+		{
+			name:    "zero threshold",
+			input:   CreateAlertRuleInput{QueueURL: "https://sqs.local/orders", Metric: AlertMetricMessagesAvailable},
+			wantErr: "threshold must be at least 1",
+		},
+		{
+			name: "resolve threshold above threshold",
+			input: CreateAlertRuleInput{
+				QueueURL:         "https://sqs.local/orders",
+				Metric:           AlertMetricMessagesInFlight,
+				Threshold:        10,
+				ResolveThreshold: resolve(10),
+			},
+			wantErr: "resolve threshold must be between 0 and the threshold minus one",
+		},
+		{
+			name: "negative for",
+			input: CreateAlertRuleInput{
+				QueueURL:  "https://sqs.local/orders",
+				Metric:    AlertMetricMessagesAvailable,
+				Threshold: 10,
+				For:       -time.Second,
+			},
+			wantErr: "for duration must not be negative",
+		},
+		{
+			name: "unknown channel",
+			input: CreateAlertRuleInput{
+				QueueURL:  "https://sqs.local/orders",
+				Metric:    AlertMetricMessagesAvailable,
+				Threshold: 10,
+				Channels:  []NotificationChannel{"pager"},
+			},
+			wantErr: `unsupported notification channel "pager"`,
+		},
+		{
+			name: "unconfigured channel",
+			input: CreateAlertRuleInput{
+				QueueURL:  "https://sqs.local/orders",
+				Metric:    AlertMetricMessagesAvailable,
+				Threshold: 10,
+				Channels:  []NotificationChannel{NotificationChannelSlack},
+			},
+			wantErr: "the slack notification channel is not configured",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &SqsServiceImpl{store: NewMockLocalStore(t)}
+
+			_, err := service.CreateAlertRule(ctx, tc.input)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestSqsServiceImpl_EvaluateAlerts(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	rule := AlertRule{
+		ID:               "depth",
+		Name:             "orders backlog",
+		QueueURL:         "https://sqs.local/orders",
+		Metric:           AlertMetricMessagesAvailable,
+		Threshold:        100,
+		ResolveThreshold: 50,
+		For:              5 * time.Minute,
+	}
+
+	type step struct {
+		after      time.Duration
+		value      int64
+		wantStatus AlertStatus
+		notify     string
+	}
+
+	testCases := []struct {
+		name     string
+		silences []AlertSilence
+		steps    []step
+		// wantSilenced holds the Silenced flag of each recorded firing.
+		wantSilenced []bool
+	}{
+		{
+			name: "fires after the for duration and resolves with hysteresis",
+			steps: []step{
+				{after: 0, value: 150, wantStatus: AlertStatusPending},
+				{after: 3 * time.Minute, value: 120, wantStatus: AlertStatusPending},
+				{after: 5 * time.Minute, value: 130, wantStatus: AlertStatusFiring, notify: "Alert firing: orders backlog"},
+				{after: 6 * time.Minute, value: 80, wantStatus: AlertStatusFiring},
+				{after: 7 * time.Minute, value: 40, wantStatus: AlertStatusOK, notify: "Alert resolved: orders backlog"},
+			},
+			wantSilenced: []bool{false},
+		},
+		{
+			name: "a dip below the threshold resets pending",
+			steps: []step{
+				{after: 0, value: 150, wantStatus: AlertStatusPending},
+				{after: 3 * time.Minute, value: 90, wantStatus: AlertStatusOK},
+				{after: 6 * time.Minute, value: 150, wantStatus: AlertStatusPending},
+			},
+		},
+		{
+			name:     "silenced rules change state without notifying",
+			silences: []AlertSilence{{Start: start, End: start.Add(time.Hour)}},
+			steps: []step{
+				{after: 0, value: 150, wantStatus: AlertStatusPending},
+				{after: 5 * time.Minute, value: 150, wantStatus: AlertStatusFiring},
+			},
+			wantSilenced: []bool{true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewMockLocalStore(t)
+			repo := NewMockSqsRepository(t)
+			notifier := NewMockNotifier(t)
+			now := start
+			service := &SqsServiceImpl{
+				repo:      repo,
+				store:     store,
+				notifiers: map[NotificationChannel]Notifier{NotificationChannelWebhook: notifier},
+				clock:     func() time.Time { return now },
+				alerts:    newAlertTracker(),
+			}
+
+			silenced := rule
+			silenced.Silences = tc.silences
+			store.EXPECT().AlertRules().Return([]AlertRule{silenced}, nil)
+
+			for _, st := range tc.steps {
+				now = start.Add(st.after)
+				repo.EXPECT().
+					ListQueues(ctx).
+					Return([]QueueSummary{{URL: "https://sqs.local/orders", MessagesAvailable: st.value}}, nil).
+					Once()
+				if st.notify != "" {
+					notifier.EXPECT().
+						Notify(ctx, mock.MatchedBy(func(n Notification) bool { return n.Title == st.notify })).
+						Return(nil).
+						Once()
+				}
+
+				require.NoError(t, service.EvaluateAlerts(ctx))
+
+				states, err := service.AlertRules(ctx)
+				require.NoError(t, err)
+				require.Len(t, states, 1)
+				assert.Equal(t, st.wantStatus, states[0].Status, "after %s", st.after)
+				assert.Equal(t, st.value, states[0].Value)
+			}
+
+			var firedSilenced []bool
+			for _, firing := range service.alertFiringsSince(start) {
+				assert.Equal(t, rule.ID, firing.RuleID)
+				firedSilenced = append(firedSilenced, firing.Silenced)
+			}
+			assert.Equal(t, tc.wantSilenced, firedSilenced)
+		})
+	}
+
+	t.Run("reports missing queues", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, store: store, alerts: newAlertTracker()}
+
+		store.EXPECT().AlertRules().Return([]AlertRule{rule}, nil)
+		repo.EXPECT().ListQueues(ctx).Return([]QueueSummary{}, nil).Once()
+
+		require.NoError(t, service.EvaluateAlerts(ctx))
+
+		states, err := service.AlertRules(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "queue not found", states[0].Error)
+	})
+}
+
+func TestSqsServiceImpl_SilenceAlertRule(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	expired := AlertSilence{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)}
+	rule := AlertRule{ID: "depth", Silences: []AlertSilence{expired}}
+
+	t.Run("adds a silence and drops expired ones", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return now }}
+
+		store.EXPECT().AlertRule("depth").Return(rule, true, nil).Once()
+		store.EXPECT().
+			SaveAlertRule(mock.MatchedBy(func(saved AlertRule) bool {
+				return assert.Equal(t, []AlertSilence{{Start: now, End: now.Add(time.Hour), Reason: "load test"}}, saved.Silences)
+			})).
+			Return(nil).
+			Once()
+
+		_, err := service.SilenceAlertRule(ctx, "depth", time.Hour, " load test ")
+		require.NoError(t, err)
+	})
+
+	t.Run("unsilence keeps future silences only", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return now }}
+
+		future := AlertSilence{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)}
+		active := AlertSilence{Start: now.Add(-time.Minute), End: now.Add(time.Hour)}
+		store.EXPECT().AlertRule("depth").Return(AlertRule{ID: "depth", Silences: []AlertSilence{expired, active, future}}, true, nil).Once()
+		store.EXPECT().
+			SaveAlertRule(mock.MatchedBy(func(saved AlertRule) bool {
+				return assert.Equal(t, []AlertSilence{future}, saved.Silences)
+			})).
+			Return(nil).
+			Once()
+
+		_, err := service.UnsilenceAlertRule(ctx, "depth")
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects non-positive durations", func(t *testing.T) {
+		service := &SqsServiceImpl{store: NewMockLocalStore(t)}
+
+		_, err := service.SilenceAlertRule(ctx, "depth", 0, "")
+		assert.EqualError(t, err, "silence duration must be positive")
+	})
+
+	t.Run("reports unknown rules", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store}
+
+		store.EXPECT().AlertRule("missing").Return(AlertRule{}, false, nil).Once()
+
+		_, err := service.SilenceAlertRule(ctx, "missing", time.Hour, "")
+		assert.ErrorIs(t, err, ErrAlertRuleNotFound)
+	})
+}
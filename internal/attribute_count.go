@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// attributeNotSet is the value reported for messages without the chosen attribute.
+	attributeNotSet = "(not set)"
+	// maxAttributeCountMessages bounds how many messages one drain-and-restore count holds in flight.
+	// SQS allows about 120,000 in-flight messages per standard queue.
+	maxAttributeCountMessages = 100_000
+	// attributeCountVisibility hides counted messages until the count ends. They are made visible
+	// again right after; the timeout only matters if restoring them fails.
+	attributeCountVisibility int32 = 15 * 60
+)
+
+// AttributeValueCount is the number of messages carrying one value of the counted attribute.
+type AttributeValueCount struct {
+	Value    string
+	Messages int
+}
+
+// AttributeCountReport groups sampled messages by the value of one message attribute. Values are
+// ordered from the most to the least common.
+type AttributeCountReport struct {
+	QueueURL  string
+	Attribute string
+	Requested int
+	Sampled   int
+	Values    []AttributeValueCount
+}
+
+// CountMessagesByAttribute samples up to samples messages from queueURL and counts them by the value
+// of attribute. System attributes such as MessageGroupId can be counted as well.
+func (s *SqsServiceImpl) CountMessagesByAttribute(ctx context.Context, queueURL, attribute string, samples int) (AttributeCountReport, error) {
+	attribute = strings.TrimSpace(attribute)
+	if attribute == "" {
+		return AttributeCountReport{}, errors.New("attribute name is required")
+	}
+
+	messages, requested, err := s.sampleMessages(ctx, queueURL, samples)
+	if err != nil {
+		return AttributeCountReport{}, err
+	}
+
+	counts := make(map[string]int)
+	for _, message := range messages {
+		counts[attributeCountKey(message, attribute)]++
+	}
+	return AttributeCountReport{
+		QueueURL:  queueURL,
+		Attribute: attribute,
+		Requested: requested,
+		Sampled:   len(messages),
+		Values:    sortedAttributeCounts(counts),
+	}, nil
+}
+
+// StartAttributeCount counts every message of a queue by the value of attribute in the background.
+// It receives the whole queue, keeping the messages in flight so none is counted twice, and makes
+// them visible again once the count ends. The job details list the counts.
+func (s *SqsServiceImpl) StartAttributeCount(ctx context.Context, queueURL, attribute string) (Job, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return Job{}, errors.New("queue url is required")
+	}
+	attribute = strings.TrimSpace(attribute)
+	if attribute == "" {
+		return Job{}, errors.New("attribute name is required")
+	}
+
+	return s.jobs.start(ctx, "attribute-count", queueURL, func(ctx context.Context, progress *JobProgress) error {
+		if stats, err := s.repo.GetQueueStats(ctx, queueURL); err == nil {
+			progress.SetTotal(stats.MessagesAvailable)
+		}
+		return s.countAllByAttribute(ctx, queueURL, attribute, progress)
+	})
+}
+
+func (s *SqsServiceImpl) countAllByAttribute(ctx context.Context, queueURL, attribute string, progress *JobProgress) (err error) {
+	counts := make(map[string]int)
+	handles := make(map[string]string)
+	defer func() {
+		// Restore even when the job was cancelled, or the messages would stay hidden.
+		if failed := s.restoreVisibility(context.WithoutCancel(ctx), queueURL, handles); failed > 0 {
+			message := fmt.Sprintf("%d of %d messages could not be made visible again and reappear after %d seconds", failed, len(handles), attributeCountVisibility)
+			if err != nil {
+				err = errors.Wrap(err, message)
+			} else {
+				err = errors.New(message)
+			}
+		}
+	}()
+
+	for len(handles) < maxAttributeCountMessages {
+		messages, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       migrationReceiveBatch,
+			WaitTimeSeconds:   migrationReceiveWait,
+			VisibilityTimeout: attributeCountVisibility,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "counted %d messages", len(handles))
+		}
+		if len(messages) == 0 {
+			break
+		}
+
+		for _, message := range messages {
+			if _, ok := handles[message.ID]; ok {
+				continue
+			}
+			handles[message.ID] = message.ReceiptHandle
+			counts[attributeCountKey(message, attribute)]++
+			progress.Advance(1)
+		}
+		progress.SetMessage(fmt.Sprintf("Counted %d messages.", len(handles)))
+	}
+
+	values := sortedAttributeCounts(counts)
+	for _, value := range values {
+		progress.AddDetail(fmt.Sprintf("%s: %d", value.Value, value.Messages))
+	}
+	progress.SetMessage(fmt.Sprintf("Counted %d messages in %d groups by %s.", len(handles), len(values), attribute))
+	return nil
+}
+
+// restoreVisibility makes received messages visible again and returns how many could not be.
+func (s *SqsServiceImpl) restoreVisibility(ctx context.Context, queueURL string, handles map[string]string) int {
+	failed := 0
+	for id, handle := range handles {
+		err := s.repo.ChangeMessageVisibility(ctx, ChangeMessageVisibilityRepositoryInput{QueueURL: queueURL, ReceiptHandle: handle})
+		if err != nil {
+			failed++
+			slog.WarnContext(ctx, "failed to restore message visibility", slog.String("queue_url", queueURL), slog.String("message_id", id), slog.Any("error", err))
+		}
+	}
+	return failed
+}
+
+func attributeCountKey(message ReceivedMessage, attribute string) string {
+	if value := messageAttributeValue(message, attribute); value != "" {
+		return value
+	}
+	return attributeNotSet
+}
+
+func sortedAttributeCounts(counts map[string]int) []AttributeValueCount {
+	values := make([]AttributeValueCount, 0, len(counts))
+	for value, messages := range counts {
+		values = append(values, AttributeValueCount{Value: value, Messages: messages})
+	}
+	slices.SortFunc(values, func(a, b AttributeValueCount) int {
+		return cmp.Or(b.Messages-a.Messages, strings.Compare(a.Value, b.Value))
+	})
+	return values
+}
@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_CountMessagesByAttribute(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("counts sampled messages by value", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "1", Attributes: []MessageAttribute{{Name: "eventType", Value: "created"}}},
+			{ID: "2", Attributes: []MessageAttribute{{Name: "eventType", Value: "updated"}}},
+			{ID: "3", Attributes: []MessageAttribute{{Name: "eventType", Value: "created"}}},
+			{ID: "4"},
+		}, nil).Once()
+		repo.EXPECT().ChangeMessageVisibility(mock.Anything, mock.Anything).Return(nil).Times(4)
+
+		report, err := service.CountMessagesByAttribute(ctx, queueURL, " eventType ", 4)
+		require.NoError(t, err)
+		assert.Equal(t, "eventType", report.Attribute)
+		assert.Equal(t, 4, report.Sampled)
+		assert.Equal(t, []AttributeValueCount{
+			{Value: "created", Messages: 2},
+			{Value: attributeNotSet, Messages: 1},
+			{Value: "updated", Messages: 1},
+		}, report.Values)
+	})
+
+	t.Run("requires an attribute", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.CountMessagesByAttribute(ctx, queueURL, " ", 10)
+		assert.EqualError(t, err, "attribute name is required")
+	})
+}
+
+func TestSqsServiceImpl_StartAttributeCount(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository) {
+		repo := NewMockSqsRepository(t)
+		repo.EXPECT().GetQueueStats(mock.Anything, queueURL).Return(QueueStats{MessagesAvailable: 3}, nil).Once()
+		return &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}, repo
+	}
+
+	t.Run("counts every message and makes them visible again", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       migrationReceiveBatch,
+			WaitTimeSeconds:   migrationReceiveWait,
+			VisibilityTimeout: attributeCountVisibility,
+		}).Return([]ReceivedMessage{
+			{ID: "1", ReceiptHandle: "r-1", Attributes: []MessageAttribute{{Name: "eventType", Value: "created"}}},
+			{ID: "2", ReceiptHandle: "r-2", Attributes: []MessageAttribute{{Name: "eventType", Value: "deleted"}}},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "3", ReceiptHandle: "r-3", Attributes: []MessageAttribute{{Name: "eventType", Value: "created"}}},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{}, nil).Once()
+		for _, handle := range []string{"r-1", "r-2", "r-3"} {
+			repo.EXPECT().ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: queueURL, ReceiptHandle: handle}).Return(nil).Once()
+		}
+
+		job, err := service.StartAttributeCount(ctx, queueURL, "eventType")
+		require.NoError(t, err)
+		assert.Equal(t, "attribute-count", job.Kind)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(3), job.Done)
+		assert.Equal(t, []string{"created: 2", "deleted: 1"}, job.Details)
+		assert.Equal(t, "Counted 3 messages in 2 groups by eventType.", job.Message)
+	})
+
+	t.Run("restores the received messages when receiving fails", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{{ID: "1", ReceiptHandle: "r-1"}}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return(nil, errors.New("throttled")).Once()
+		repo.EXPECT().ChangeMessageVisibility(mock.Anything, mock.Anything).Return(errors.New("expired")).Once()
+
+		job, err := service.StartAttributeCount(ctx, queueURL, "eventType")
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusFailed, job.Status)
+		assert.Equal(t, "1 of 1 messages could not be made visible again and reappear after 900 seconds: counted 1 messages: throttled", job.Error)
+	})
+}
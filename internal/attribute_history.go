@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrQueueNotWatched is returned when a queue's attributes are not being recorded.
+var ErrQueueNotWatched = errors.New("the queue's attribute history is not being recorded")
+
+// maxAttributeHistoryEntries bounds the history kept per queue; the oldest entries are dropped first.
+const maxAttributeHistoryEntries = 200
+
+// AttributeHistory is the recorded configuration history of a watched queue. Attributes and Tags
+// hold the latest snapshot; Entries list what changed between snapshots, oldest first.
+type AttributeHistory struct {
+	QueueURL     string                  `json:"queueUrl"`
+	WatchedSince time.Time               `json:"watchedSince"`
+	CheckedAt    time.Time               `json:"checkedAt"`
+	Attributes   map[string]string       `json:"attributes,omitempty"`
+	Tags         map[string]string       `json:"tags,omitempty"`
+	Entries      []AttributeHistoryEntry `json:"entries,omitempty"`
+}
+
+// AttributeHistoryEntry records the changes found by one snapshot. The change happened between
+// the previous snapshot and ObservedAt; LastModifiedAt is when SQS last changed an attribute, which
+// narrows that down unless only tags changed.
+type AttributeHistoryEntry struct {
+	ObservedAt     time.Time     `json:"observedAt"`
+	LastModifiedAt time.Time     `json:"lastModifiedAt,omitzero"`
+	Changes        []DriftChange `json:"changes"`
+}
+
+// WatchQueueAttributes starts recording the attribute and tag history of a queue with a first
+// snapshot. Watching a queue again keeps its history.
+func (s *SqsServiceImpl) WatchQueueAttributes(ctx context.Context, queueURL string) (AttributeHistory, error) {
+	if s.store == nil {
+		return AttributeHistory{}, errors.New("attribute history is not available without a state store")
+	}
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return AttributeHistory{}, errors.New("queue url is required")
+	}
+
+	history, ok, err := s.store.AttributeHistory(queueURL)
+	if err != nil {
+		return AttributeHistory{}, err
+	}
+	if ok {
+		return history, nil
+	}
+
+	detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+	if err != nil {
+		return AttributeHistory{}, err
+	}
+	now := s.now().UTC()
+	history = AttributeHistory{
+		QueueURL:     queueURL,
+		WatchedSince: now,
+		CheckedAt:    now,
+		Attributes:   configurationAttributes(detail.Attributes),
+		Tags:         maps.Clone(detail.Tags),
+	}
+	if err := s.store.SaveAttributeHistory(history); err != nil {
+		return AttributeHistory{}, err
+	}
+	return history, nil
+}
+
+// UnwatchQueueAttributes stops recording a queue and forgets its history.
+func (s *SqsServiceImpl) UnwatchQueueAttributes(_ context.Context, queueURL string) error {
+	if s.store == nil {
+		return ErrQueueNotWatched
+	}
+	return s.store.DeleteAttributeHistory(queueURL)
+}
+
+// QueueAttributeHistory returns the recorded history of a queue, newest entry first.
+func (s *SqsServiceImpl) QueueAttributeHistory(_ context.Context, queueURL string) (AttributeHistory, error) {
+	if s.store == nil {
+		return AttributeHistory{}, ErrQueueNotWatched
+	}
+
+	history, ok, err := s.store.AttributeHistory(queueURL)
+	if err != nil {
+		return AttributeHistory{}, err
+	}
+	if !ok {
+		return AttributeHistory{}, ErrQueueNotWatched
+	}
+	slices.Reverse(history.Entries)
+	return history, nil
+}
+
+// RecordAttributeHistory snapshots every watched queue and appends an entry when its attributes
+// or tags changed since the previous snapshot. A queue that cannot be read is skipped until the
+// next run.
+func (s *SqsServiceImpl) RecordAttributeHistory(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+
+	histories, err := s.store.AttributeHistories()
+	if err != nil {
+		return err
+	}
+
+	for _, history := range histories {
+		detail, err := s.repo.GetQueueDetail(ctx, history.QueueURL)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to snapshot queue attributes", slog.String("queue_url", history.QueueURL), slog.Any("error", err))
+			continue
+		}
+
+		previous := QueueBaseline{Attributes: history.Attributes, Tags: history.Tags}
+		now := s.now().UTC()
+		if changes := queueDriftChanges(previous, detail); len(changes) > 0 {
+			history.Entries = append(history.Entries, AttributeHistoryEntry{
+				ObservedAt:     now,
+				LastModifiedAt: detail.LastModifiedAt,
+				Changes:        changes,
+			})
+			if len(history.Entries) > maxAttributeHistoryEntries {
+				history.Entries = history.Entries[len(history.Entries)-maxAttributeHistoryEntries:]
+			}
+		}
+		history.CheckedAt = now
+		history.Attributes = configurationAttributes(detail.Attributes)
+		history.Tags = maps.Clone(detail.Tags)
+		if err := s.store.SaveAttributeHistory(history); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+)
+
+type attributeHistoryPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	QueueName    string
+	EscapedURL   string
+	Watched      bool
+	WatchedSince string
+	CheckedAt    string
+	Current      []queueAttributeView
+	Entries      []attributeHistoryEntryView
+}
+
+type attributeHistoryEntryView struct {
+	ObservedAt     string
+	LastModifiedAt string
+	Changes        []driftChangeView
+}
+
+// AttributeHistoryHandler shows when the attributes and tags of a watched queue changed.
+func (h *HandlerImpl) AttributeHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	data := attributeHistoryPageData{
+		Title:      "Attribute history",
+		ViteTags:   fragments["assets/js/attribute_history.ts"].Tags,
+		QueueName:  extractQueueName(queueURL),
+		EscapedURL: url.QueryEscape(queueURL),
+	}
+	history, err := h.s.QueueAttributeHistory(r.Context(), queueURL)
+	switch {
+	case errors.Is(err, ErrQueueNotWatched):
+	case err != nil:
+		slog.ErrorContext(r.Context(), "failed to load attribute history", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = "Failed to load the attribute history."
+	default:
+		fillAttributeHistoryPageData(&data, history)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates["attribute-history"].Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "failed to render attribute-history template", slog.Any("error", err))
+		http.Error(w, "template error", http.StatusInternalServerError)
+	}
+}
+
+// WatchAttributesHandler starts recording the attribute history of a queue.
+func (h *HandlerImpl) WatchAttributesHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	if _, err := h.s.WatchQueueAttributes(r.Context(), queueURL); err != nil {
+		slog.ErrorContext(r.Context(), "failed to watch queue attributes", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeServiceError(w, err, "failed to watch queue attributes", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/queues/"+url.QueryEscape(queueURL)+"/history", http.StatusSeeOther)
+}
+
+// UnwatchAttributesHandler stops recording a queue and forgets its history.
+func (h *HandlerImpl) UnwatchAttributesHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	if err := h.s.UnwatchQueueAttributes(r.Context(), queueURL); err != nil {
+		slog.ErrorContext(r.Context(), "failed to unwatch queue attributes", slog.String("queue_url", queueURL), slog.Any("error", err))
+		if errors.Is(err, ErrQueueNotWatched) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, "failed to stop recording the attribute history", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/queues/"+url.QueryEscape(queueURL)+"/history", http.StatusSeeOther)
+}
+
+func fillAttributeHistoryPageData(data *attributeHistoryPageData, history AttributeHistory) {
+	data.Watched = true
+	data.WatchedSince = history.WatchedSince.Format(displayTimeLayout)
+	data.CheckedAt = history.CheckedAt.Format(displayTimeLayout)
+
+	for _, key := range slices.Sorted(maps.Keys(history.Attributes)) {
+		data.Current = append(data.Current, queueAttributeView{Key: key, Value: history.Attributes[key]})
+	}
+	for _, key := range slices.Sorted(maps.Keys(history.Tags)) {
+		data.Current = append(data.Current, queueAttributeView{Key: "tag: " + key, Value: history.Tags[key]})
+	}
+
+	for _, entry := range history.Entries {
+		view := attributeHistoryEntryView{ObservedAt: entry.ObservedAt.Format(displayTimeLayout)}
+		if !entry.LastModifiedAt.IsZero() {
+			view.LastModifiedAt = entry.LastModifiedAt.Format(displayTimeLayout)
+		}
+		// The drift page renders the same changes; only the states compared differ.
+		view.Changes = newQueueDriftView(QueueDriftState{Changes: entry.Changes}).Changes
+		data.Entries = append(data.Entries, view)
+	}
+}
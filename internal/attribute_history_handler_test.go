@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_AttributeHistoryHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/history", nil)
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("lists the recorded changes", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured attributeHistoryPageData
+		captureTemplate(t, "attribute-history", func(data attributeHistoryPageData) { captured = data })
+		installFragment(t, "assets/js/attribute_history.ts", "")
+
+		since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		mockService.EXPECT().QueueAttributeHistory(mock.Anything, queueURL).Return(AttributeHistory{
+			QueueURL:     queueURL,
+			WatchedSince: since,
+			CheckedAt:    since.Add(time.Hour),
+			Attributes:   map[string]string{"VisibilityTimeout": "90", "DelaySeconds": "0"},
+			Tags:         map[string]string{"team": "payments"},
+			Entries: []AttributeHistoryEntry{{
+				ObservedAt:     since.Add(30 * time.Minute),
+				LastModifiedAt: since.Add(20 * time.Minute),
+				Changes:        []DriftChange{{Name: "VisibilityTimeout", Change: DriftChanged, Baseline: "30", Current: "90"}},
+			}},
+		}, nil).Once()
+
+		handler.AttributeHistoryHandler(rr, newRequest())
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.True(t, captured.Watched)
+		assert.Equal(t, "orders", captured.QueueName)
+		assert.Equal(t, "2024-05-01 12:00:00 UTC", captured.WatchedSince)
+		assert.Equal(t, []queueAttributeView{
+			{Key: "DelaySeconds", Value: "0"},
+			{Key: "VisibilityTimeout", Value: "90"},
+			{Key: "tag: team", Value: "payments"},
+		}, captured.Current)
+		assert.Equal(t, []attributeHistoryEntryView{{
+			ObservedAt:     "2024-05-01 12:30:00 UTC",
+			LastModifiedAt: "2024-05-01 12:20:00 UTC",
+			Changes:        []driftChangeView{{Name: "VisibilityTimeout", Change: "changed", Baseline: "30", Current: "90"}},
+		}}, captured.Entries)
+	})
+
+	t.Run("offers to watch an unwatched queue", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured attributeHistoryPageData
+		captureTemplate(t, "attribute-history", func(data attributeHistoryPageData) { captured = data })
+		installFragment(t, "assets/js/attribute_history.ts", "")
+
+		mockService.EXPECT().QueueAttributeHistory(mock.Anything, queueURL).Return(AttributeHistory{}, ErrQueueNotWatched).Once()
+
+		handler.AttributeHistoryHandler(rr, newRequest())
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.False(t, captured.Watched)
+		assert.Empty(t, captured.ErrorMessage)
+	})
+}
+
+func TestHandlerImpl_WatchAttributesHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/history/watch", nil)
+	req.SetPathValue("url", escaped)
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().WatchQueueAttributes(mock.Anything, queueURL).Return(AttributeHistory{QueueURL: queueURL}, nil).Once()
+
+	handler.WatchAttributesHandler(rr, req)
+
+	assert.Equal(t, http.StatusSeeOther, rr.Code)
+	assert.Equal(t, "/queues/"+escaped+"/history", rr.Header().Get("Location"))
+}
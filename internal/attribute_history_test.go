@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_AttributeHistory(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository, func(time.Duration)) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		repo := NewMockSqsRepository(t)
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		service := &SqsServiceImpl{repo: repo, store: store, clock: func() time.Time { return now }}
+		return service, repo, func(d time.Duration) { now = now.Add(d) }
+	}
+	initial := QueueDetail{
+		Attributes: map[string]string{"VisibilityTimeout": "30", "ApproximateNumberOfMessages": "4"},
+		Tags:       map[string]string{"team": "payments"},
+	}
+
+	t.Run("records changes between snapshots", func(t *testing.T) {
+		service, repo, advance := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(initial, nil).Once()
+		history, err := service.WatchQueueAttributes(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"VisibilityTimeout": "30"}, history.Attributes)
+
+		// Only the message count changed, which is not configuration.
+		advance(15 * time.Minute)
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{
+			Attributes: map[string]string{"VisibilityTimeout": "30", "ApproximateNumberOfMessages": "9"},
+			Tags:       map[string]string{"team": "payments"},
+		}, nil).Once()
+		require.NoError(t, service.RecordAttributeHistory(ctx))
+
+		advance(15 * time.Minute)
+		modified := time.Date(2024, 5, 1, 12, 20, 0, 0, time.UTC)
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{
+			LastModifiedAt: modified,
+			Attributes:     map[string]string{"VisibilityTimeout": "90"},
+			Tags:           map[string]string{"team": "payments"},
+		}, nil).Once()
+		require.NoError(t, service.RecordAttributeHistory(ctx))
+
+		advance(15 * time.Minute)
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{
+			LastModifiedAt: modified,
+			Attributes:     map[string]string{"VisibilityTimeout": "90"},
+		}, nil).Once()
+		require.NoError(t, service.RecordAttributeHistory(ctx))
+
+		history, err = service.QueueAttributeHistory(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 12, 45, 0, 0, time.UTC), history.CheckedAt)
+		assert.Equal(t, []AttributeHistoryEntry{
+			{
+				ObservedAt:     time.Date(2024, 5, 1, 12, 45, 0, 0, time.UTC),
+				LastModifiedAt: modified,
+				Changes:        []DriftChange{{Name: "team", Tag: true, Change: DriftRemoved, Baseline: "payments"}},
+			},
+			{
+				ObservedAt:     time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+				LastModifiedAt: modified,
+				Changes:        []DriftChange{{Name: "VisibilityTimeout", Change: DriftChanged, Baseline: "30", Current: "90"}},
+			},
+		}, history.Entries)
+	})
+
+	t.Run("keeps the history when a snapshot fails", func(t *testing.T) {
+		service, repo, _ := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(initial, nil).Once()
+		_, err := service.WatchQueueAttributes(ctx, queueURL)
+		require.NoError(t, err)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{}, errors.New("throttled")).Once()
+		require.NoError(t, service.RecordAttributeHistory(ctx))
+
+		history, err := service.QueueAttributeHistory(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Empty(t, history.Entries)
+		assert.Equal(t, map[string]string{"team": "payments"}, history.Tags)
+	})
+
+	t.Run("forgets an unwatched queue", func(t *testing.T) {
+		service, repo, _ := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(initial, nil).Once()
+		_, err := service.WatchQueueAttributes(ctx, queueURL)
+		require.NoError(t, err)
+		// Watching again keeps the existing history without a new snapshot.
+		_, err = service.WatchQueueAttributes(ctx, queueURL)
+		require.NoError(t, err)
+
+		require.NoError(t, service.UnwatchQueueAttributes(ctx, queueURL))
+		_, err = service.QueueAttributeHistory(ctx, queueURL)
+		require.ErrorIs(t, err, ErrQueueNotWatched)
+		require.ErrorIs(t, service.UnwatchQueueAttributes(ctx, queueURL), ErrQueueNotWatched)
+	})
+}
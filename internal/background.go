@@ -0,0 +1,22 @@
+package internal
+
+import (
+	"context"
+	"time"
+)
+
+// RunPeriodically calls fn every interval until ctx is cancelled.
+// The first call happens after one interval so startup is not delayed by background work.
+func RunPeriodically(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
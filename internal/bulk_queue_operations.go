@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Bulk operations on several queues.
+const (
+	BulkActionDelete = "delete"
+	BulkActionPurge  = "purge"
+	BulkActionTag    = "tag"
+)
+
+// maxBulkQueues bounds how many queues one bulk operation works through.
+const maxBulkQueues = 100
+
+// BulkQueueInput applies Action to every queue in QueueURLs. Tags holds the tags to set with
+// BulkActionTag.
+type BulkQueueInput struct {
+	Action    string
+	QueueURLs []string
+	Tags      map[string]string
+}
+
+// StartBulkQueueOperation deletes, purges or tags several queues in the background, one after the
+// other. Each queue is an item of the job, so its progress and the error of every queue that
+// failed can be followed through the jobs API; a failure does not stop the other queues. Deleted
+// queues go to the trash like single deletes. A purge SQS refuses because the queue was purged
+// in the last 60 seconds fails for that queue instead of holding up the rest.
+func (s *SqsServiceImpl) StartBulkQueueOperation(ctx context.Context, input BulkQueueInput) (Job, error) {
+	var apply func(ctx context.Context, queueURL string) error
+	var verb string
+	switch input.Action {
+	case BulkActionDelete:
+		apply = func(ctx context.Context, queueURL string) error {
+			_, err := s.DeleteQueue(ctx, queueURL)
+			return err
+		}
+		verb = "deleted"
+	case BulkActionPurge:
+		apply = s.PurgeQueue
+		verb = "purged"
+	case BulkActionTag:
+		if len(input.Tags) == 0 {
+			return Job{}, errors.New("at least one tag is required")
+		}
+		for key, value := range input.Tags {
+			if err := validateQueueTag(key, value); err != nil {
+				return Job{}, err
+			}
+		}
+		if !s.EndpointCapabilities(ctx).Tags {
+			return Job{}, ErrTagsUnsupported
+		}
+		apply = func(ctx context.Context, queueURL string) error {
+			return s.TagQueue(ctx, queueURL, input.Tags)
+		}
+		verb = "tagged"
+	default:
+		return Job{}, errors.Newf("action must be %s, %s or %s", BulkActionDelete, BulkActionPurge, BulkActionTag)
+	}
+
+	queueURLs := make([]string, 0, len(input.QueueURLs))
+	seen := make(map[string]struct{}, len(input.QueueURLs))
+	for _, raw := range input.QueueURLs {
+		queueURL := strings.TrimSpace(raw)
+		if queueURL == "" {
+			continue
+		}
+		if _, ok := seen[queueURL]; ok {
+			continue
+		}
+		seen[queueURL] = struct{}{}
+		queueURLs = append(queueURLs, queueURL)
+	}
+	if len(queueURLs) == 0 {
+		return Job{}, errors.New("at least one queue url is required")
+	}
+	if len(queueURLs) > maxBulkQueues {
+		return Job{}, errors.Newf("at most %d queues can be changed at once", maxBulkQueues)
+	}
+
+	return s.jobs.start(ctx, "bulk-"+input.Action, "", func(ctx context.Context, progress *JobProgress) error {
+		progress.SetItems(queueURLs)
+		failed := 0
+		for i, queueURL := range queueURLs {
+			progress.SetMessage(fmt.Sprintf("Working on %s (%d of %d).", extractQueueName(queueURL), i+1, len(queueURLs)))
+			progress.StartItem(queueURL)
+			err := apply(ctx, queueURL)
+			progress.FinishItem(queueURL, err)
+			if err != nil {
+				failed++
+			}
+		}
+
+		summary := fmt.Sprintf("%d of %d queues %s.", len(queueURLs)-failed, len(queueURLs), verb)
+		progress.SetMessage(summary)
+		if failed > 0 {
+			return errors.Newf("%s %d failed; see the queues for their errors", summary, failed)
+		}
+		return nil
+	})
+}
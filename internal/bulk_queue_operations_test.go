@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_StartBulkQueueOperation(t *testing.T) {
+	ctx := context.Background()
+	orders := "https://sqs.local/000000000000/orders"
+	billing := "https://sqs.local/000000000000/billing"
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository) {
+		repo := NewMockSqsRepository(t)
+		return &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}, repo
+	}
+
+	t.Run("purges every queue and reports each", func(t *testing.T) {
+		service, repo := newService(t)
+		repo.EXPECT().PurgeQueue(mock.Anything, orders).Return(nil).Once()
+		repo.EXPECT().PurgeQueue(mock.Anything, billing).Return(nil).Once()
+
+		started, err := service.StartBulkQueueOperation(ctx, BulkQueueInput{Action: BulkActionPurge, QueueURLs: []string{orders, " ", billing, orders}})
+		require.NoError(t, err)
+		assert.Equal(t, "bulk-purge", started.Kind)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(2), job.Done)
+		assert.Equal(t, int64(2), job.Total)
+		assert.Equal(t, "2 of 2 queues purged.", job.Message)
+		assert.Equal(t, []JobItem{
+			{Key: orders, Status: JobItemSucceeded},
+			{Key: billing, Status: JobItemSucceeded},
+		}, job.Items)
+	})
+
+	t.Run("keeps going after a queue fails", func(t *testing.T) {
+		service, repo := newService(t)
+		repo.EXPECT().DeleteQueue(mock.Anything, orders).Return(errors.New("access denied")).Once()
+		repo.EXPECT().DeleteQueue(mock.Anything, billing).Return(nil).Once()
+
+		started, err := service.StartBulkQueueOperation(ctx, BulkQueueInput{Action: BulkActionDelete, QueueURLs: []string{orders, billing}})
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusFailed, job.Status)
+		assert.Equal(t, "1 of 2 queues deleted. 1 failed; see the queues for their errors", job.Error)
+		assert.Equal(t, int64(2), job.Done)
+		assert.Equal(t, []JobItem{
+			{Key: orders, Status: JobItemFailed, Error: "access denied"},
+			{Key: billing, Status: JobItemSucceeded},
+		}, job.Items)
+	})
+
+	t.Run("tags every queue", func(t *testing.T) {
+		service, repo := newService(t)
+		service.capabilities = &capabilityCache{conclusive: true, caps: EndpointCapabilities{Tags: true}}
+		tags := map[string]string{"team": "payments"}
+		repo.EXPECT().ListQueueTags(mock.Anything, orders).Return(nil, nil).Once()
+		repo.EXPECT().TagQueue(mock.Anything, orders, tags).Return(nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, billing).Return(map[string]string{"team": "billing"}, nil).Once()
+		repo.EXPECT().TagQueue(mock.Anything, billing, tags).Return(nil).Once()
+
+		started, err := service.StartBulkQueueOperation(ctx, BulkQueueInput{Action: BulkActionTag, QueueURLs: []string{orders, billing}, Tags: tags})
+		require.NoError(t, err)
+		assert.Equal(t, "bulk-tag", started.Kind)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, "2 of 2 queues tagged.", job.Message)
+	})
+
+	t.Run("checks the tags before starting", func(t *testing.T) {
+		service, _ := newService(t)
+		service.capabilities = &capabilityCache{conclusive: true, caps: EndpointCapabilities{Tags: true}}
+
+		_, err := service.StartBulkQueueOperation(ctx, BulkQueueInput{Action: BulkActionTag, QueueURLs: []string{orders}})
+		require.EqualError(t, err, "at least one tag is required")
+
+		_, err = service.StartBulkQueueOperation(ctx, BulkQueueInput{Action: BulkActionTag, QueueURLs: []string{orders}, Tags: map[string]string{"aws:team": "x"}})
+		require.EqualError(t, err, "tag keys starting with aws: are reserved")
+
+		service.capabilities = &capabilityCache{conclusive: true}
+		_, err = service.StartBulkQueueOperation(ctx, BulkQueueInput{Action: BulkActionTag, QueueURLs: []string{orders}, Tags: map[string]string{"team": "x"}})
+		require.ErrorIs(t, err, ErrTagsUnsupported)
+	})
+
+	t.Run("validates the input", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.StartBulkQueueOperation(ctx, BulkQueueInput{Action: "move", QueueURLs: []string{orders}})
+		require.EqualError(t, err, "action must be delete, purge or tag")
+
+		_, err = service.StartBulkQueueOperation(ctx, BulkQueueInput{Action: BulkActionPurge})
+		require.EqualError(t, err, "at least one queue url is required")
+
+		queueURLs := make([]string, maxBulkQueues+1)
+		for i := range queueURLs {
+			queueURLs[i] = orders + string(rune('a'+i%26)) + string(rune('a'+i/26))
+		}
+		_, err = service.StartBulkQueueOperation(ctx, BulkQueueInput{Action: BulkActionPurge, QueueURLs: queueURLs})
+		require.EqualError(t, err, "at most 100 queues can be changed at once")
+	})
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type bulkQueuesPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	Action       string
+	QueueNames   []string
+	ReturnURL    string
+	JobID        string
+}
+
+// PostBulkQueuesHandler starts a bulk operation on the queues selected on the queue list and shows
+// the outcome of each queue as the job works through them. Deleting and purging have to be
+// confirmed by typing the number of selected queues; tagging sets tag_key to tag_value.
+func (h *HandlerImpl) PostBulkQueuesHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	queueURLs := r.PostForm["queue_url"]
+	data := bulkQueuesPageData{
+		Title:     "Bulk queue operation",
+		ViteTags:  fragments["assets/js/bulk_queues.ts"].Tags,
+		Action:    r.PostForm.Get("action"),
+		ReturnURL: queueListReturnTarget(r.PostForm.Get("return")),
+	}
+	for _, queueURL := range queueURLs {
+		data.QueueNames = append(data.QueueNames, extractQueueName(queueURL))
+	}
+
+	input := BulkQueueInput{Action: data.Action, QueueURLs: queueURLs}
+	switch data.Action {
+	case BulkActionDelete, BulkActionPurge:
+		if strings.TrimSpace(r.PostForm.Get("confirm_count")) != strconv.Itoa(len(queueURLs)) {
+			data.ErrorMessage = fmt.Sprintf("Type %d, the number of selected queues, to confirm.", len(queueURLs))
+			h.renderBulkQueues(w, http.StatusBadRequest, data)
+			return
+		}
+	case BulkActionTag:
+		input.Tags = map[string]string{strings.TrimSpace(r.PostForm.Get("tag_key")): r.PostForm.Get("tag_value")}
+	}
+
+	job, err := h.s.StartBulkQueueOperation(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start bulk queue operation", slog.String("action", data.Action), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderBulkQueues(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.JobID = job.ID
+	h.renderBulkQueues(w, http.StatusOK, data)
+}
+
+func (h *HandlerImpl) renderBulkQueues(w http.ResponseWriter, status int, data bulkQueuesPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["bulk-queues"].Execute(w, data); err != nil {
+		slog.Error("failed to render bulk-queues template", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_PostBulkQueuesHandler(t *testing.T) {
+	queueURLs := []string{"https://sqs.local/orders", "https://sqs.local/billing"}
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/bulk", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	t.Run("tags the selected queues", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured bulkQueuesPageData
+		captureTemplate(t, "bulk-queues", func(data bulkQueuesPageData) { captured = data })
+		installFragment(t, "assets/js/bulk_queues.ts", "")
+
+		mockService.EXPECT().
+			StartBulkQueueOperation(mock.Anything, BulkQueueInput{
+				Action:    BulkActionTag,
+				QueueURLs: queueURLs,
+				Tags:      map[string]string{"team": "payments"},
+			}).
+			Return(Job{ID: "bulk1"}, nil).
+			Once()
+
+		handler.PostBulkQueuesHandler(rr, newRequest(url.Values{
+			"action":    {"tag"},
+			"queue_url": queueURLs,
+			"tag_key":   {" team "},
+			"tag_value": {"payments"},
+			"return":    {"/queues?q=o"},
+		}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "bulk1", captured.JobID)
+		assert.Equal(t, []string{"orders", "billing"}, captured.QueueNames)
+		assert.Equal(t, "/queues?q=o", captured.ReturnURL)
+	})
+
+	t.Run("purges once the queue count is confirmed", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		captureTemplate(t, "bulk-queues", func(bulkQueuesPageData) {})
+		installFragment(t, "assets/js/bulk_queues.ts", "")
+
+		mockService.EXPECT().
+			StartBulkQueueOperation(mock.Anything, BulkQueueInput{Action: BulkActionPurge, QueueURLs: queueURLs}).
+			Return(Job{ID: "bulk2"}, nil).
+			Once()
+
+		handler.PostBulkQueuesHandler(rr, newRequest(url.Values{
+			"action":        {"purge"},
+			"queue_url":     queueURLs,
+			"confirm_count": {"2"},
+		}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("asks to confirm a delete", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured bulkQueuesPageData
+		captureTemplate(t, "bulk-queues", func(data bulkQueuesPageData) { captured = data })
+		installFragment(t, "assets/js/bulk_queues.ts", "")
+
+		handler.PostBulkQueuesHandler(rr, newRequest(url.Values{
+			"action":        {"delete"},
+			"queue_url":     queueURLs,
+			"confirm_count": {"1"},
+			"return":        {"https://example.com/"},
+		}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "Type 2, the number of selected queues, to confirm.", captured.ErrorMessage)
+		assert.Equal(t, "/queues", captured.ReturnURL)
+		assert.Empty(t, captured.JobID)
+	})
+}
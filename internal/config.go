@@ -0,0 +1,627 @@
+package internal
+
+import (
+	"cmp"
+	"log/slog"
+	"net"
+	"net/mail"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ServiceConfig carries the optional behaviour switches of the service layer.
+type ServiceConfig struct {
+	// Endpoint is AWS_SQS_ENDPOINT, empty when the regional AWS endpoint is used.
+	Endpoint         string
+	Cleanup          CleanupPolicy
+	NotifyWebhookURL string
+	// NotifySlackWebhookURL and NotifyTeamsWebhookURL are notification channels besides the generic
+	// webhook.
+	NotifySlackWebhookURL string
+	NotifyTeamsWebhookURL string
+	AlertInterval         time.Duration
+	// DriftInterval is how often queues with a configuration baseline are checked for drift.
+	DriftInterval time.Duration
+	// HistoryInterval is how often watched queues are snapshotted for their attribute history.
+	HistoryInterval time.Duration
+	QueuePolicy     QueuePolicy
+	QueueURLs       QueueURLRule
+	// DefaultTags are added to every queue created through the GUI.
+	DefaultTags map[string]string
+	// MigrationRegions are the other AWS regions queues can be copied to.
+	MigrationRegions []string
+	// IngestRoutes maps the aliases of /ingest/{alias} to a queue name or URL.
+	IngestRoutes map[string]string
+	// Objectives are the service level objectives the SQS calls are measured against.
+	Objectives []OperationObjective
+	// EmailReport is the summary of watched queues mailed on a schedule.
+	EmailReport EmailReportConfig
+	// Slack enables the /sqs slash command.
+	Slack SlackConfig
+	// WebPush enables browser notifications for watched dead-letter queues.
+	WebPush WebPushConfig
+}
+
+// WebPushConfig holds the VAPID key pair browsers subscribe with, given by its private key, and
+// the contact push services reach the operator at, a mailto: or https: URL.
+type WebPushConfig struct {
+	PrivateKey string
+	Subject    string
+}
+
+// Enabled reports whether a VAPID private key was configured.
+func (c WebPushConfig) Enabled() bool {
+	return c.PrivateKey != ""
+}
+
+// SlackConfig holds the signing secret of the Slack app and the role of each Slack user ID. The
+// user ID "*" gives the role of everyone not listed.
+type SlackConfig struct {
+	SigningSecret string
+	Roles         map[string]SlackRole
+}
+
+// Enabled reports whether a signing secret was configured.
+func (c SlackConfig) Enabled() bool {
+	return c.SigningSecret != ""
+}
+
+// EmailReportConfig describes where and when the summary of watched queues is mailed. Schedule is
+// a cron expression such as @daily or @weekly.
+type EmailReportConfig struct {
+	SMTP       SMTPConfig
+	From       string
+	Recipients []string
+	Schedule   string
+}
+
+// Enabled reports whether any recipients were configured.
+func (c EmailReportConfig) Enabled() bool {
+	return len(c.Recipients) > 0
+}
+
+// SMTPConfig is the mail server the reports are sent through. Username and Password are optional;
+// STARTTLS is used whenever the server offers it.
+type SMTPConfig struct {
+	Addr     string
+	Username string
+	Password string
+}
+
+// CleanupPolicy describes which temporary queues are deleted automatically once they sit empty.
+type CleanupPolicy struct {
+	Pattern  string
+	IdleFor  time.Duration
+	Interval time.Duration
+	DryRun   bool
+}
+
+// Enabled reports whether a cleanup pattern was configured.
+func (p CleanupPolicy) Enabled() bool {
+	return p.Pattern != ""
+}
+
+// LoadServiceConfig reads the service configuration from environment variables via getenv.
+func LoadServiceConfig(getenv func(string) string) (ServiceConfig, error) {
+	cfg := ServiceConfig{
+		Endpoint:              strings.TrimSpace(getenv("AWS_SQS_ENDPOINT")),
+		NotifyWebhookURL:      strings.TrimSpace(getenv("SQS_GUI_NOTIFY_WEBHOOK_URL")),
+		NotifySlackWebhookURL: strings.TrimSpace(getenv("SQS_GUI_NOTIFY_SLACK_WEBHOOK_URL")),
+		NotifyTeamsWebhookURL: strings.TrimSpace(getenv("SQS_GUI_NOTIFY_TEAMS_WEBHOOK_URL")),
+	}
+
+	cleanup := CleanupPolicy{
+		Pattern:  strings.TrimSpace(getenv("SQS_GUI_CLEANUP_PATTERN")),
+		IdleFor:  time.Hour,
+		Interval: 5 * time.Minute,
+		DryRun:   true,
+	}
+	if cleanup.Pattern != "" {
+		if _, err := path.Match(cleanup.Pattern, ""); err != nil {
+			return ServiceConfig{}, errors.Wrap(err, "invalid SQS_GUI_CLEANUP_PATTERN")
+		}
+	}
+
+	var err error
+	if cleanup.IdleFor, err = durationEnv(getenv, "SQS_GUI_CLEANUP_IDLE", cleanup.IdleFor); err != nil {
+		return ServiceConfig{}, err
+	}
+	if cleanup.Interval, err = durationEnv(getenv, "SQS_GUI_CLEANUP_INTERVAL", cleanup.Interval); err != nil {
+		return ServiceConfig{}, err
+	}
+	if cleanup.DryRun, err = boolEnv(getenv, "SQS_GUI_CLEANUP_DRY_RUN", cleanup.DryRun); err != nil {
+		return ServiceConfig{}, err
+	}
+	cfg.Cleanup = cleanup
+
+	if cfg.AlertInterval, err = durationEnv(getenv, "SQS_GUI_ALERT_INTERVAL", time.Minute); err != nil {
+		return ServiceConfig{}, err
+	}
+	if cfg.DriftInterval, err = durationEnv(getenv, "SQS_GUI_DRIFT_INTERVAL", 5*time.Minute); err != nil {
+		return ServiceConfig{}, err
+	}
+	if cfg.HistoryInterval, err = durationEnv(getenv, "SQS_GUI_HISTORY_INTERVAL", 15*time.Minute); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	if cfg.QueuePolicy.Allow, err = patternListEnv(getenv, "SQS_GUI_QUEUE_ALLOW"); err != nil {
+		return ServiceConfig{}, err
+	}
+	if cfg.QueuePolicy.Deny, err = patternListEnv(getenv, "SQS_GUI_QUEUE_DENY"); err != nil {
+		return ServiceConfig{}, err
+	}
+	if cfg.QueuePolicy.Protect, err = patternListEnv(getenv, "SQS_GUI_QUEUE_PROTECT"); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	if cfg.QueueURLs, err = loadQueueURLRule(getenv); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	if cfg.DefaultTags, err = tagsEnv(getenv, "SQS_GUI_DEFAULT_TAGS"); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	if cfg.MigrationRegions, err = regionListEnv(getenv, "SQS_GUI_MIGRATION_REGIONS"); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	if cfg.IngestRoutes, err = ingestRoutesEnv(getenv, "SQS_GUI_INGEST_ROUTES"); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	if cfg.Objectives, err = objectivesEnv(getenv, "SQS_GUI_SLOS"); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	if cfg.EmailReport, err = loadEmailReportConfig(getenv); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	if cfg.Slack, err = loadSlackConfig(getenv); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	if cfg.WebPush, err = loadWebPushConfig(getenv); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	return cfg, nil
+}
+
+// LogConfig controls the application logger.
+type LogConfig struct {
+	Level slog.Level
+	// RevealSensitive lets debug records include message bodies, attribute values and credentials.
+	RevealSensitive bool
+}
+
+// LoadLogConfig reads the logging configuration from environment variables via getenv.
+func LoadLogConfig(getenv func(string) string) (LogConfig, error) {
+	cfg := LogConfig{Level: slog.LevelInfo}
+
+	if raw := strings.TrimSpace(getenv("SQS_GUI_LOG_LEVEL")); raw != "" {
+		if err := cfg.Level.UnmarshalText([]byte(raw)); err != nil {
+			return LogConfig{}, errors.New("SQS_GUI_LOG_LEVEL must be one of debug, info, warn or error")
+		}
+	}
+
+	var err error
+	if cfg.RevealSensitive, err = boolEnv(getenv, "SQS_GUI_LOG_SENSITIVE", false); err != nil {
+		return LogConfig{}, err
+	}
+
+	return cfg, nil
+}
+
+// ServerConfig holds the listen addresses and HTTP server timeouts. As in net/http, a zero read or
+// write timeout disables it and a zero idle timeout falls back to the read timeout.
+type ServerConfig struct {
+	Listeners    []ListenerConfig
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+}
+
+// ListenerConfig is one address the server listens on. The listener serves HTTPS when CertFile and
+// KeyFile are set, and plain HTTP otherwise.
+type ListenerConfig struct {
+	Addr     string
+	CertFile string
+	KeyFile  string
+}
+
+// TLS reports whether the listener serves HTTPS.
+func (l ListenerConfig) TLS() bool {
+	return l.CertFile != ""
+}
+
+// minWriteTimeout leaves room for a 20 second long poll plus the SQS round trip.
+const minWriteTimeout = 30 * time.Second
+
+// LoadServerConfig reads the HTTP server configuration from environment variables via getenv.
+func LoadServerConfig(getenv func(string) string) (ServerConfig, error) {
+	cfg := ServerConfig{}
+
+	var err error
+	if cfg.Listeners, err = listenersEnv(getenv, "SQS_GUI_LISTEN"); err != nil {
+		return ServerConfig{}, err
+	}
+	if cfg.ReadTimeout, err = timeoutEnv(getenv, "SQS_GUI_READ_TIMEOUT", time.Minute); err != nil {
+		return ServerConfig{}, err
+	}
+	if cfg.WriteTimeout, err = timeoutEnv(getenv, "SQS_GUI_WRITE_TIMEOUT", time.Minute); err != nil {
+		return ServerConfig{}, err
+	}
+	if cfg.WriteTimeout > 0 && cfg.WriteTimeout < minWriteTimeout {
+		return ServerConfig{}, errors.Newf("SQS_GUI_WRITE_TIMEOUT must be 0 or at least %s so long polls can finish", minWriteTimeout)
+	}
+	if cfg.IdleTimeout, err = timeoutEnv(getenv, "SQS_GUI_IDLE_TIMEOUT", 0); err != nil {
+		return ServerConfig{}, err
+	}
+
+	return cfg, nil
+}
+
+func durationEnv(getenv func(string) string, key string, fallback time.Duration) (time.Duration, error) {
+	raw := strings.TrimSpace(getenv(key))
+	if raw == "" {
+		return fallback, nil
+	}
+
+	value, err := time.ParseDuration(raw)
+	if err != nil || value <= 0 {
+		return 0, errors.Newf("%s must be a positive duration such as 30m", key)
+	}
+
+	return value, nil
+}
+
+// timeoutEnv is like durationEnv but accepts 0 to turn the timeout off.
+func timeoutEnv(getenv func(string) string, key string, fallback time.Duration) (time.Duration, error) {
+	raw := strings.TrimSpace(getenv(key))
+	if raw == "" {
+		return fallback, nil
+	}
+
+	value, err := time.ParseDuration(raw)
+	if err != nil || value < 0 {
+		return 0, errors.Newf("%s must be a duration such as 2m, or 0 to disable it", key)
+	}
+
+	return value, nil
+}
+
+// listenersEnv reads comma-separated listeners, each an address optionally followed by
+// |certFile|keyFile to serve HTTPS, such as 127.0.0.1:8080,[::1]:8443|cert.pem|key.pem. It
+// defaults to :8080, which accepts IPv4 and IPv6 connections.
+func listenersEnv(getenv func(string) string, key string) ([]ListenerConfig, error) {
+	entries := listEnv(getenv, key)
+	if len(entries) == 0 {
+		return []ListenerConfig{{Addr: ":8080"}}, nil
+	}
+
+	listeners := make([]ListenerConfig, 0, len(entries))
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		parts := strings.Split(entry, "|")
+		listener := ListenerConfig{Addr: strings.TrimSpace(parts[0])}
+		switch len(parts) {
+		case 1:
+		case 3:
+			listener.CertFile = strings.TrimSpace(parts[1])
+			listener.KeyFile = strings.TrimSpace(parts[2])
+			if listener.CertFile == "" || listener.KeyFile == "" {
+				return nil, errors.Newf("%s entry %q needs both a certificate and a key file", key, entry)
+			}
+		default:
+			return nil, errors.Newf("%s entry %q must be address or address|certFile|keyFile", key, entry)
+		}
+		if _, _, err := net.SplitHostPort(listener.Addr); err != nil {
+			return nil, errors.Newf("%s entry %q must start with host:port, such as 127.0.0.1:8080 or [::1]:8080", key, entry)
+		}
+		if seen[listener.Addr] {
+			return nil, errors.Newf("%s lists %s more than once", key, listener.Addr)
+		}
+		seen[listener.Addr] = true
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+// loadQueueURLRule trusts the host of AWS_SQS_ENDPOINT, or the regional AWS endpoints when it is
+// unset, plus any hosts listed in SQS_GUI_QUEUE_URL_HOSTS.
+func loadQueueURLRule(getenv func(string) string) (QueueURLRule, error) {
+	var rule QueueURLRule
+
+	if endpoint := strings.TrimSpace(getenv("AWS_SQS_ENDPOINT")); endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Host == "" {
+			return QueueURLRule{}, errors.New("AWS_SQS_ENDPOINT must be an absolute URL such as http://localhost:4566")
+		}
+		rule.Hosts = append(rule.Hosts, strings.ToLower(u.Host))
+	} else {
+		region := strings.TrimSpace(getenv("AWS_REGION"))
+		if region == "" {
+			region = "us-east-1"
+		}
+		rule.Hosts = append(rule.Hosts, "sqs."+region+".amazonaws.com", region+".queue.amazonaws.com")
+	}
+
+	for _, host := range listEnv(getenv, "SQS_GUI_QUEUE_URL_HOSTS") {
+		rule.Hosts = append(rule.Hosts, strings.ToLower(host))
+	}
+	rule.AccountIDs = listEnv(getenv, "SQS_GUI_QUEUE_ACCOUNT_IDS")
+
+	return rule, nil
+}
+
+// listEnv reads a comma-separated list, skipping empty entries.
+func listEnv(getenv func(string) string, key string) []string {
+	var values []string
+	for _, value := range strings.Split(getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// patternListEnv reads a comma-separated list of glob patterns, skipping empty entries.
+func patternListEnv(getenv func(string) string, key string) ([]string, error) {
+	patterns := listEnv(getenv, key)
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s pattern %q", key, pattern)
+		}
+	}
+	return patterns, nil
+}
+
+// SQS limits on the tags of a queue.
+const (
+	maxQueueTags           = 50
+	maxQueueTagKeyLength   = 128
+	maxQueueTagValueLength = 256
+)
+
+// tagsEnv reads a comma-separated list of key=value queue tags within the SQS tag limits.
+func tagsEnv(getenv func(string) string, key string) (map[string]string, error) {
+	entries := listEnv(getenv, key)
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	if len(entries) > maxQueueTags {
+		return nil, errors.Newf("%s must have at most %d tags", key, maxQueueTags)
+	}
+
+	tags := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		name, value, ok := strings.Cut(entry, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" {
+			return nil, errors.Newf("%s entries must look like key=value, got %q", key, entry)
+		}
+		if len(name) > maxQueueTagKeyLength || len(value) > maxQueueTagValueLength {
+			return nil, errors.Newf("%s tag %q exceeds %d characters for keys or %d for values", key, name, maxQueueTagKeyLength, maxQueueTagValueLength)
+		}
+		if _, dup := tags[name]; dup {
+			return nil, errors.Newf("%s sets tag %q more than once", key, name)
+		}
+		tags[name] = value
+	}
+	return tags, nil
+}
+
+// awsRegionPattern matches region names such as us-east-1 or ap-southeast-2.
+var awsRegionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+
+// regionListEnv reads a comma-separated list of AWS regions other than AWS_REGION, skipping empty
+// and repeated entries.
+func regionListEnv(getenv func(string) string, key string) ([]string, error) {
+	current := cmp.Or(strings.TrimSpace(getenv("AWS_REGION")), "us-east-1")
+	var regions []string
+	for _, region := range listEnv(getenv, key) {
+		region = strings.ToLower(region)
+		if !awsRegionPattern.MatchString(region) {
+			return nil, errors.Newf("%s entries must be AWS regions such as eu-west-1, got %q", key, region)
+		}
+		if region == current {
+			return nil, errors.Newf("%s must not list AWS_REGION %s", key, region)
+		}
+		if !slices.Contains(regions, region) {
+			regions = append(regions, region)
+		}
+	}
+	return regions, nil
+}
+
+// ingestRoutesEnv reads a comma-separated list of alias=queue entries, where queue is a queue name
+// or URL. Aliases are used in URL paths, so they are limited to letters, digits, '-' and '_'.
+func ingestRoutesEnv(getenv func(string) string, key string) (map[string]string, error) {
+	entries := listEnv(getenv, key)
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	routes := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		alias, queue, ok := strings.Cut(entry, "=")
+		alias, queue = strings.TrimSpace(alias), strings.TrimSpace(queue)
+		if !ok || alias == "" || queue == "" {
+			return nil, errors.Newf("%s entries must look like alias=queue, got %q", key, entry)
+		}
+		if strings.IndexFunc(alias, func(r rune) bool {
+			return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_')
+		}) >= 0 {
+			return nil, errors.Newf("%s alias %q may only contain letters, digits, '-' and '_'", key, alias)
+		}
+		if _, dup := routes[alias]; dup {
+			return nil, errors.Newf("%s sets alias %q more than once", key, alias)
+		}
+		routes[alias] = queue
+	}
+	return routes, nil
+}
+
+// objectivesEnv reads a comma-separated list of objectives such as
+// "ReceiveMessage=99%<2.5s,SendMessage=99.9%". The latency must be one of the histogram bounds.
+func objectivesEnv(getenv func(string) string, key string) ([]OperationObjective, error) {
+	entries := listEnv(getenv, key)
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	objectives := make([]OperationObjective, 0, len(entries))
+	for _, entry := range entries {
+		operation, spec, ok := strings.Cut(entry, "=")
+		operation, spec = strings.TrimSpace(operation), strings.TrimSpace(spec)
+		if !ok || operation == "" || spec == "" {
+			return nil, errors.Newf("%s entries must look like Operation=99.9%% or Operation=99%%<2.5s, got %q", key, entry)
+		}
+		if slices.ContainsFunc(objectives, func(o OperationObjective) bool { return o.Operation == operation }) {
+			return nil, errors.Newf("%s sets an objective for %s more than once", key, operation)
+		}
+
+		objective := OperationObjective{Operation: operation}
+		rawTarget, rawLatency, hasLatency := strings.Cut(spec, "<")
+		// Parsing with the exponent, rather than dividing by 100, keeps 99.9% at exactly 0.999.
+		target, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(rawTarget), "%")+"e-2", 64)
+		if err != nil || target <= 0 || target >= 1 {
+			return nil, errors.Newf("%s target for %s must be a percentage above 0 and below 100", key, operation)
+		}
+		objective.Target = target
+
+		if hasLatency {
+			latency, err := time.ParseDuration(strings.TrimSpace(rawLatency))
+			if err != nil || !slices.Contains(latencyBuckets, latency) {
+				return nil, errors.Newf("%s latency for %s must be one of %s", key, operation, formatLatencyBuckets())
+			}
+			objective.Latency = latency
+		}
+		objectives = append(objectives, objective)
+	}
+	return objectives, nil
+}
+
+// loadEmailReportConfig reads the scheduled email report settings. They are only read, and the
+// SMTP server and sender only required, when SQS_GUI_REPORT_RECIPIENTS lists someone.
+func loadEmailReportConfig(getenv func(string) string) (EmailReportConfig, error) {
+	recipients := listEnv(getenv, "SQS_GUI_REPORT_RECIPIENTS")
+	if len(recipients) == 0 {
+		return EmailReportConfig{}, nil
+	}
+	for _, recipient := range recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return EmailReportConfig{}, errors.Newf("SQS_GUI_REPORT_RECIPIENTS entry %q is not an email address", recipient)
+		}
+	}
+
+	cfg := EmailReportConfig{
+		SMTP: SMTPConfig{
+			Addr:     strings.TrimSpace(getenv("SQS_GUI_SMTP_ADDR")),
+			Username: strings.TrimSpace(getenv("SQS_GUI_SMTP_USERNAME")),
+			Password: getenv("SQS_GUI_SMTP_PASSWORD"),
+		},
+		From:       strings.TrimSpace(getenv("SQS_GUI_SMTP_FROM")),
+		Recipients: recipients,
+		Schedule:   strings.TrimSpace(getenv("SQS_GUI_REPORT_SCHEDULE")),
+	}
+	if _, _, err := net.SplitHostPort(cfg.SMTP.Addr); err != nil {
+		return EmailReportConfig{}, errors.New("SQS_GUI_SMTP_ADDR must be host:port, such as smtp.example.com:587, when report recipients are set")
+	}
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return EmailReportConfig{}, errors.New("SQS_GUI_SMTP_FROM must be an email address when report recipients are set")
+	}
+	if cfg.Schedule == "" {
+		cfg.Schedule = "@daily"
+	}
+	if _, err := parseCron(cfg.Schedule); err != nil {
+		return EmailReportConfig{}, errors.Wrap(err, "invalid SQS_GUI_REPORT_SCHEDULE")
+	}
+	return cfg, nil
+}
+
+// loadSlackConfig reads the slash command settings. SQS_GUI_SLACK_ROLES lists user=role entries
+// such as U012AB3CD=operator,*=viewer and is required once a signing secret is set, so that
+// enabling the command never grants access by accident.
+func loadSlackConfig(getenv func(string) string) (SlackConfig, error) {
+	cfg := SlackConfig{SigningSecret: strings.TrimSpace(getenv("SQS_GUI_SLACK_SIGNING_SECRET"))}
+	entries := listEnv(getenv, "SQS_GUI_SLACK_ROLES")
+	if !cfg.Enabled() {
+		if len(entries) > 0 {
+			return SlackConfig{}, errors.New("SQS_GUI_SLACK_ROLES needs SQS_GUI_SLACK_SIGNING_SECRET")
+		}
+		return SlackConfig{}, nil
+	}
+	if len(entries) == 0 {
+		return SlackConfig{}, errors.New("SQS_GUI_SLACK_ROLES must give at least one Slack user a role when SQS_GUI_SLACK_SIGNING_SECRET is set")
+	}
+
+	cfg.Roles = make(map[string]SlackRole, len(entries))
+	for _, entry := range entries {
+		user, role, ok := strings.Cut(entry, "=")
+		user, role = strings.TrimSpace(user), strings.TrimSpace(role)
+		if !ok || user == "" {
+			return SlackConfig{}, errors.Newf("SQS_GUI_SLACK_ROLES entries must look like user=role, got %q", entry)
+		}
+		if !slices.Contains(slackRoles, SlackRole(role)) {
+			return SlackConfig{}, errors.Newf("SQS_GUI_SLACK_ROLES role %q of %s must be viewer or operator", role, user)
+		}
+		if _, dup := cfg.Roles[user]; dup {
+			return SlackConfig{}, errors.Newf("SQS_GUI_SLACK_ROLES sets the role of %s more than once", user)
+		}
+		cfg.Roles[user] = SlackRole(role)
+	}
+	return cfg, nil
+}
+
+func loadWebPushConfig(getenv func(string) string) (WebPushConfig, error) {
+	cfg := WebPushConfig{
+		PrivateKey: strings.TrimSpace(getenv("SQS_GUI_VAPID_PRIVATE_KEY")),
+		Subject:    strings.TrimSpace(getenv("SQS_GUI_VAPID_SUBJECT")),
+	}
+	if !cfg.Enabled() {
+		if cfg.Subject != "" {
+			return WebPushConfig{}, errors.New("SQS_GUI_VAPID_SUBJECT needs SQS_GUI_VAPID_PRIVATE_KEY")
+		}
+		return WebPushConfig{}, nil
+	}
+
+	if _, err := parseVAPIDPrivateKey(cfg.PrivateKey); err != nil {
+		return WebPushConfig{}, errors.Wrap(err, "invalid SQS_GUI_VAPID_PRIVATE_KEY")
+	}
+	if !strings.HasPrefix(cfg.Subject, "mailto:") && !strings.HasPrefix(cfg.Subject, "https://") {
+		return WebPushConfig{}, errors.New("SQS_GUI_VAPID_SUBJECT must be a mailto: or https:// URL when SQS_GUI_VAPID_PRIVATE_KEY is set")
+	}
+	return cfg, nil
+}
+
+func formatLatencyBuckets() string {
+	bounds := make([]string, 0, len(latencyBuckets))
+	for _, bound := range latencyBuckets {
+		bounds = append(bounds, bound.String())
+	}
+	return strings.Join(bounds, ", ")
+}
+
+func boolEnv(getenv func(string) string, key string, fallback bool) (bool, error) {
+	raw := strings.TrimSpace(getenv(key))
+	if raw == "" {
+		return fallback, nil
+	}
+
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, errors.Newf("%s must be true or false", key)
+	}
+
+	return value, nil
+}
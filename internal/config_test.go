@@ -0,0 +1,553 @@
+package internal
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadServiceConfig(t *testing.T) {
+	awsHosts := QueueURLRule{Hosts: []string{"sqs.us-east-1.amazonaws.com", "us-east-1.queue.amazonaws.com"}}
+
+	testCases := []struct {
+		name    string
+		env     map[string]string
+		want    ServiceConfig
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+			},
+		},
+		{
+			name: "cleanup policy",
+			env: map[string]string{
+				"SQS_GUI_CLEANUP_PATTERN":  "tmp-*",
+				"SQS_GUI_CLEANUP_IDLE":     "30m",
+				"SQS_GUI_CLEANUP_INTERVAL": "1m",
+				"SQS_GUI_CLEANUP_DRY_RUN":  "false",
+			},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{Pattern: "tmp-*", IdleFor: 30 * time.Minute, Interval: time.Minute},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+			},
+		},
+		{
+			name: "notification webhook",
+			env:  map[string]string{"SQS_GUI_NOTIFY_WEBHOOK_URL": " https://hooks.local/sqs "},
+			want: ServiceConfig{
+				Cleanup:          CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				NotifyWebhookURL: "https://hooks.local/sqs",
+				AlertInterval:    time.Minute,
+				DriftInterval:    5 * time.Minute,
+				HistoryInterval:  15 * time.Minute,
+				QueueURLs:        awsHosts,
+			},
+		},
+		{
+			name: "slack and teams webhooks",
+			env: map[string]string{
+				"SQS_GUI_NOTIFY_SLACK_WEBHOOK_URL": "https://hooks.slack.com/services/T/B/x",
+				"SQS_GUI_NOTIFY_TEAMS_WEBHOOK_URL": " https://example.webhook.office.com/webhookb2/x ",
+			},
+			want: ServiceConfig{
+				Cleanup:               CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				NotifySlackWebhookURL: "https://hooks.slack.com/services/T/B/x",
+				NotifyTeamsWebhookURL: "https://example.webhook.office.com/webhookb2/x",
+				AlertInterval:         time.Minute,
+				DriftInterval:         5 * time.Minute,
+				HistoryInterval:       15 * time.Minute,
+				QueueURLs:             awsHosts,
+			},
+		},
+		{
+			name: "alert interval",
+			env:  map[string]string{"SQS_GUI_ALERT_INTERVAL": "15s"},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   15 * time.Second,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+			},
+		},
+		{
+			name: "drift interval",
+			env:  map[string]string{"SQS_GUI_DRIFT_INTERVAL": "1h"},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   time.Hour,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+			},
+		},
+		{
+			name: "history interval",
+			env:  map[string]string{"SQS_GUI_HISTORY_INTERVAL": "1h"},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: time.Hour,
+				QueueURLs:       awsHosts,
+			},
+		},
+		{
+			name: "queue policy",
+			env: map[string]string{
+				"SQS_GUI_QUEUE_ALLOW":   "dev-*, prod-*",
+				"SQS_GUI_QUEUE_DENY":    "prod-billing",
+				"SQS_GUI_QUEUE_PROTECT": "prod-*,",
+			},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueuePolicy: QueuePolicy{
+					Allow:   []string{"dev-*", "prod-*"},
+					Deny:    []string{"prod-billing"},
+					Protect: []string{"prod-*"},
+				},
+				QueueURLs: awsHosts,
+			},
+		},
+		{
+			name:    "invalid queue policy pattern",
+			env:     map[string]string{"SQS_GUI_QUEUE_PROTECT": "prod-["},
+			wantErr: `invalid SQS_GUI_QUEUE_PROTECT pattern "prod-[": syntax error in pattern`,
+		},
+		{
+			name: "queue urls for a custom endpoint",
+			env: map[string]string{
+				"AWS_SQS_ENDPOINT":          "http://ElasticMQ:9324",
+				"SQS_GUI_QUEUE_URL_HOSTS":   "localhost:9324",
+				"SQS_GUI_QUEUE_ACCOUNT_IDS": "000000000000",
+			},
+			want: ServiceConfig{
+				Endpoint:        "http://ElasticMQ:9324",
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs: QueueURLRule{
+					Hosts:      []string{"elasticmq:9324", "localhost:9324"},
+					AccountIDs: []string{"000000000000"},
+				},
+			},
+		},
+		{
+			name: "queue urls for a region",
+			env:  map[string]string{"AWS_REGION": "ap-northeast-1"},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       QueueURLRule{Hosts: []string{"sqs.ap-northeast-1.amazonaws.com", "ap-northeast-1.queue.amazonaws.com"}},
+			},
+		},
+		{
+			name: "default tags",
+			env:  map[string]string{"SQS_GUI_DEFAULT_TAGS": "created-by=sqs-gui, environment = dev,team="},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+				DefaultTags:     map[string]string{"created-by": "sqs-gui", "environment": "dev", "team": ""},
+			},
+		},
+		{
+			name:    "default tag without a value",
+			env:     map[string]string{"SQS_GUI_DEFAULT_TAGS": "created-by"},
+			wantErr: `SQS_GUI_DEFAULT_TAGS entries must look like key=value, got "created-by"`,
+		},
+		{
+			name:    "duplicate default tag",
+			env:     map[string]string{"SQS_GUI_DEFAULT_TAGS": "team=a,team=b"},
+			wantErr: `SQS_GUI_DEFAULT_TAGS sets tag "team" more than once`,
+		},
+		{
+			name: "migration regions",
+			env:  map[string]string{"SQS_GUI_MIGRATION_REGIONS": "eu-west-1, AP-NORTHEAST-1,eu-west-1"},
+			want: ServiceConfig{
+				Cleanup:          CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:    time.Minute,
+				DriftInterval:    5 * time.Minute,
+				HistoryInterval:  15 * time.Minute,
+				QueueURLs:        awsHosts,
+				MigrationRegions: []string{"eu-west-1", "ap-northeast-1"},
+			},
+		},
+		{
+			name:    "migration region that is not a region",
+			env:     map[string]string{"SQS_GUI_MIGRATION_REGIONS": "europe"},
+			wantErr: `SQS_GUI_MIGRATION_REGIONS entries must be AWS regions such as eu-west-1, got "europe"`,
+		},
+		{
+			name:    "migration region of the connection",
+			env:     map[string]string{"SQS_GUI_MIGRATION_REGIONS": "us-east-1"},
+			wantErr: "SQS_GUI_MIGRATION_REGIONS must not list AWS_REGION us-east-1",
+		},
+		{
+			name: "ingest routes",
+			env:  map[string]string{"SQS_GUI_INGEST_ROUTES": "github=webhooks, stripe = https://sqs.us-east-1.amazonaws.com/000000000000/payments"},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+				IngestRoutes:    map[string]string{"github": "webhooks", "stripe": "https://sqs.us-east-1.amazonaws.com/000000000000/payments"},
+			},
+		},
+		{
+			name:    "ingest alias with a slash",
+			env:     map[string]string{"SQS_GUI_INGEST_ROUTES": "a/b=webhooks"},
+			wantErr: `SQS_GUI_INGEST_ROUTES alias "a/b" may only contain letters, digits, '-' and '_'`,
+		},
+		{
+			name: "service level objectives",
+			env:  map[string]string{"SQS_GUI_SLOS": "ReceiveMessage=99%<2.5s, SendMessage=99.9%"},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+				Objectives: []OperationObjective{
+					{Operation: "ReceiveMessage", Target: 0.99, Latency: 2500 * time.Millisecond},
+					{Operation: "SendMessage", Target: 0.999},
+				},
+			},
+		},
+		{
+			name:    "objective latency between histogram bounds",
+			env:     map[string]string{"SQS_GUI_SLOS": "ReceiveMessage=99%<2s"},
+			wantErr: "SQS_GUI_SLOS latency for ReceiveMessage must be one of 10ms, 25ms, 50ms, 100ms, 250ms, 500ms, 1s, 2.5s, 5s, 10s, 25s",
+		},
+		{
+			name:    "objective target of 100%",
+			env:     map[string]string{"SQS_GUI_SLOS": "SendMessage=100%"},
+			wantErr: "SQS_GUI_SLOS target for SendMessage must be a percentage above 0 and below 100",
+		},
+		{
+			name:    "duplicate objective",
+			env:     map[string]string{"SQS_GUI_SLOS": "SendMessage=99%,SendMessage=99.9%"},
+			wantErr: "SQS_GUI_SLOS sets an objective for SendMessage more than once",
+		},
+		{
+			name: "email report",
+			env: map[string]string{
+				"SQS_GUI_REPORT_RECIPIENTS": "ops@example.com, Dev Team <dev@example.com>",
+				"SQS_GUI_SMTP_ADDR":         "smtp.example.com:587",
+				"SQS_GUI_SMTP_USERNAME":     "sqs-gui",
+				"SQS_GUI_SMTP_PASSWORD":     "secret",
+				"SQS_GUI_SMTP_FROM":         "sqs-gui@example.com",
+				"SQS_GUI_REPORT_SCHEDULE":   "@weekly",
+			},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+				EmailReport: EmailReportConfig{
+					SMTP:       SMTPConfig{Addr: "smtp.example.com:587", Username: "sqs-gui", Password: "secret"},
+					From:       "sqs-gui@example.com",
+					Recipients: []string{"ops@example.com", "Dev Team <dev@example.com>"},
+					Schedule:   "@weekly",
+				},
+			},
+		},
+		{
+			name: "email report defaults to daily",
+			env: map[string]string{
+				"SQS_GUI_REPORT_RECIPIENTS": "ops@example.com",
+				"SQS_GUI_SMTP_ADDR":         "localhost:25",
+				"SQS_GUI_SMTP_FROM":         "sqs-gui@example.com",
+			},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+				EmailReport: EmailReportConfig{
+					SMTP:       SMTPConfig{Addr: "localhost:25"},
+					From:       "sqs-gui@example.com",
+					Recipients: []string{"ops@example.com"},
+					Schedule:   "@daily",
+				},
+			},
+		},
+		{
+			name:    "invalid report recipient",
+			env:     map[string]string{"SQS_GUI_REPORT_RECIPIENTS": "ops"},
+			wantErr: `SQS_GUI_REPORT_RECIPIENTS entry "ops" is not an email address`,
+		},
+		{
+			name:    "email report without smtp server",
+			env:     map[string]string{"SQS_GUI_REPORT_RECIPIENTS": "ops@example.com", "SQS_GUI_SMTP_FROM": "sqs-gui@example.com"},
+			wantErr: "SQS_GUI_SMTP_ADDR must be host:port, such as smtp.example.com:587, when report recipients are set",
+		},
+		{
+			name:    "email report without sender",
+			env:     map[string]string{"SQS_GUI_REPORT_RECIPIENTS": "ops@example.com", "SQS_GUI_SMTP_ADDR": "localhost:25"},
+			wantErr: "SQS_GUI_SMTP_FROM must be an email address when report recipients are set",
+		},
+		{
+			name: "invalid report schedule",
+			env: map[string]string{
+				"SQS_GUI_REPORT_RECIPIENTS": "ops@example.com",
+				"SQS_GUI_SMTP_ADDR":         "localhost:25",
+				"SQS_GUI_SMTP_FROM":         "sqs-gui@example.com",
+				"SQS_GUI_REPORT_SCHEDULE":   "weekly",
+			},
+			wantErr: `invalid SQS_GUI_REPORT_SCHEDULE: invalid cron expression "weekly"`,
+		},
+		{
+			name: "slack command",
+			env: map[string]string{
+				"SQS_GUI_SLACK_SIGNING_SECRET": "8f742231b10e8888abcd99yyyzzz85a5",
+				"SQS_GUI_SLACK_ROLES":          "U012AB3CD=operator, *=viewer",
+			},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+				Slack: SlackConfig{
+					SigningSecret: "8f742231b10e8888abcd99yyyzzz85a5",
+					Roles:         map[string]SlackRole{"U012AB3CD": SlackRoleOperator, "*": SlackRoleViewer},
+				},
+			},
+		},
+		{
+			name:    "slack secret without roles",
+			env:     map[string]string{"SQS_GUI_SLACK_SIGNING_SECRET": "secret"},
+			wantErr: "SQS_GUI_SLACK_ROLES must give at least one Slack user a role when SQS_GUI_SLACK_SIGNING_SECRET is set",
+		},
+		{
+			name:    "slack roles without secret",
+			env:     map[string]string{"SQS_GUI_SLACK_ROLES": "U1=viewer"},
+			wantErr: "SQS_GUI_SLACK_ROLES needs SQS_GUI_SLACK_SIGNING_SECRET",
+		},
+		{
+			name:    "unknown slack role",
+			env:     map[string]string{"SQS_GUI_SLACK_SIGNING_SECRET": "secret", "SQS_GUI_SLACK_ROLES": "U1=admin"},
+			wantErr: `SQS_GUI_SLACK_ROLES role "admin" of U1 must be viewer or operator`,
+		},
+		{
+			name: "web push",
+			env: map[string]string{
+				"SQS_GUI_VAPID_PRIVATE_KEY": "AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYGRobHB0eHyA",
+				"SQS_GUI_VAPID_SUBJECT":     "mailto:ops@example.com",
+			},
+			want: ServiceConfig{
+				Cleanup:         CleanupPolicy{IdleFor: time.Hour, Interval: 5 * time.Minute, DryRun: true},
+				AlertInterval:   time.Minute,
+				DriftInterval:   5 * time.Minute,
+				HistoryInterval: 15 * time.Minute,
+				QueueURLs:       awsHosts,
+				WebPush:         WebPushConfig{PrivateKey: "AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYGRobHB0eHyA", Subject: "mailto:ops@example.com"},
+			},
+		},
+		{
+			name:    "invalid vapid key",
+			env:     map[string]string{"SQS_GUI_VAPID_PRIVATE_KEY": "not a key!", "SQS_GUI_VAPID_SUBJECT": "mailto:ops@example.com"},
+			wantErr: "invalid SQS_GUI_VAPID_PRIVATE_KEY: must be base64url encoded",
+		},
+		{
+			name:    "vapid key without subject",
+			env:     map[string]string{"SQS_GUI_VAPID_PRIVATE_KEY": "AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYGRobHB0eHyA"},
+			wantErr: "SQS_GUI_VAPID_SUBJECT must be a mailto: or https:// URL when SQS_GUI_VAPID_PRIVATE_KEY is set",
+		},
+		{
+			name:    "vapid subject without key",
+			env:     map[string]string{"SQS_GUI_VAPID_SUBJECT": "mailto:ops@example.com"},
+			wantErr: "SQS_GUI_VAPID_SUBJECT needs SQS_GUI_VAPID_PRIVATE_KEY",
+		},
+		{
+			name:    "invalid endpoint",
+			env:     map[string]string{"AWS_SQS_ENDPOINT": "localhost:4566"},
+			wantErr: "AWS_SQS_ENDPOINT must be an absolute URL such as http://localhost:4566",
+		},
+		{
+			name:    "invalid pattern",
+			env:     map[string]string{"SQS_GUI_CLEANUP_PATTERN": "tmp-["},
+			wantErr: "invalid SQS_GUI_CLEANUP_PATTERN: syntax error in pattern",
+		},
+		{
+			name:    "invalid duration",
+			env:     map[string]string{"SQS_GUI_CLEANUP_IDLE": "-1h"},
+			wantErr: "SQS_GUI_CLEANUP_IDLE must be a positive duration such as 30m",
+		},
+		{
+			name:    "invalid bool",
+			env:     map[string]string{"SQS_GUI_CLEANUP_DRY_RUN": "maybe"},
+			wantErr: "SQS_GUI_CLEANUP_DRY_RUN must be true or false",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := LoadServiceConfig(func(key string) string { return tc.env[key] })
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cfg)
+		})
+	}
+}
+
+func TestLoadLogConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		env     map[string]string
+		want    LogConfig
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{},
+			want: LogConfig{Level: slog.LevelInfo},
+		},
+		{
+			name: "debug with sensitive values",
+			env:  map[string]string{"SQS_GUI_LOG_LEVEL": "DEBUG", "SQS_GUI_LOG_SENSITIVE": "true"},
+			want: LogConfig{Level: slog.LevelDebug, RevealSensitive: true},
+		},
+		{
+			name:    "invalid level",
+			env:     map[string]string{"SQS_GUI_LOG_LEVEL": "verbose"},
+			wantErr: "SQS_GUI_LOG_LEVEL must be one of debug, info, warn or error",
+		},
+		{
+			name:    "invalid sensitive flag",
+			env:     map[string]string{"SQS_GUI_LOG_SENSITIVE": "sometimes"},
+			wantErr: "SQS_GUI_LOG_SENSITIVE must be true or false",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := LoadLogConfig(func(key string) string { return tc.env[key] })
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cfg)
+		})
+	}
+}
+
+func TestLoadServerConfig(t *testing.T) {
+	defaultListeners := []ListenerConfig{{Addr: ":8080"}}
+	testCases := []struct {
+		name    string
+		env     map[string]string
+		want    ServerConfig
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{},
+			want: ServerConfig{Listeners: defaultListeners, ReadTimeout: time.Minute, WriteTimeout: time.Minute},
+		},
+		{
+			name: "custom timeouts",
+			env: map[string]string{
+				"SQS_GUI_READ_TIMEOUT":  "30s",
+				"SQS_GUI_WRITE_TIMEOUT": "5m",
+				"SQS_GUI_IDLE_TIMEOUT":  "2m",
+			},
+			want: ServerConfig{Listeners: defaultListeners, ReadTimeout: 30 * time.Second, WriteTimeout: 5 * time.Minute, IdleTimeout: 2 * time.Minute},
+		},
+		{
+			name: "zero disables a timeout",
+			env:  map[string]string{"SQS_GUI_WRITE_TIMEOUT": "0"},
+			want: ServerConfig{Listeners: defaultListeners, ReadTimeout: time.Minute},
+		},
+		{
+			name: "listeners with and without tls",
+			env:  map[string]string{"SQS_GUI_LISTEN": "127.0.0.1:8080, [::1]:8080, 10.0.0.5:8443|/etc/sqs-gui/cert.pem|/etc/sqs-gui/key.pem"},
+			want: ServerConfig{
+				Listeners: []ListenerConfig{
+					{Addr: "127.0.0.1:8080"},
+					{Addr: "[::1]:8080"},
+					{Addr: "10.0.0.5:8443", CertFile: "/etc/sqs-gui/cert.pem", KeyFile: "/etc/sqs-gui/key.pem"},
+				},
+				ReadTimeout:  time.Minute,
+				WriteTimeout: time.Minute,
+			},
+		},
+		{
+			name:    "listener without a port",
+			env:     map[string]string{"SQS_GUI_LISTEN": "localhost"},
+			wantErr: `SQS_GUI_LISTEN entry "localhost" must start with host:port, such as 127.0.0.1:8080 or [::1]:8080`,
+		},
+		{
+			name:    "listener with a certificate but no key",
+			env:     map[string]string{"SQS_GUI_LISTEN": ":8443|cert.pem|"},
+			wantErr: `SQS_GUI_LISTEN entry ":8443|cert.pem|" needs both a certificate and a key file`,
+		},
+		{
+			name:    "listener with only a certificate",
+			env:     map[string]string{"SQS_GUI_LISTEN": ":8443|cert.pem"},
+			wantErr: `SQS_GUI_LISTEN entry ":8443|cert.pem" must be address or address|certFile|keyFile`,
+		},
+		{
+			name:    "duplicate listener",
+			env:     map[string]string{"SQS_GUI_LISTEN": ":8080,:8080"},
+			wantErr: "SQS_GUI_LISTEN lists :8080 more than once",
+		},
+		{
+			name:    "invalid duration",
+			env:     map[string]string{"SQS_GUI_IDLE_TIMEOUT": "soon"},
+			wantErr: "SQS_GUI_IDLE_TIMEOUT must be a duration such as 2m, or 0 to disable it",
+		},
+		{
+			name:    "negative duration",
+			env:     map[string]string{"SQS_GUI_READ_TIMEOUT": "-1s"},
+			wantErr: "SQS_GUI_READ_TIMEOUT must be a duration such as 2m, or 0 to disable it",
+		},
+		{
+			name:    "write timeout shorter than a long poll",
+			env:     map[string]string{"SQS_GUI_WRITE_TIMEOUT": "10s"},
+			wantErr: "SQS_GUI_WRITE_TIMEOUT must be 0 or at least 30s so long polls can finish",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := LoadServerConfig(func(key string) string { return tc.env[key] })
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cfg)
+		})
+	}
+}
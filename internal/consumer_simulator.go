@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultSimulationRate       = 1.0
+	maxSimulationRate           = 100.0
+	defaultSimulationDuration   = time.Minute
+	maxSimulationDuration       = 30 * time.Minute
+	defaultSimulationRetryAfter = 5 * time.Second
+	maxSimulationRetryAfter     = 12 * time.Hour
+	// simulationReceiveWait keeps each receive short so the simulation stops close to its end.
+	simulationReceiveWait int32 = 1
+)
+
+// SimulateConsumerInput configures a simulated consumer. Rate is in messages per second and
+// FailPercent is the share of received messages that are not deleted. A failed message becomes
+// visible again after RetryAfter, so it is received again until SQS moves it to the dead-letter
+// queue once it exceeds maxReceiveCount. Zero values pick the defaults.
+type SimulateConsumerInput struct {
+	QueueURL    string
+	Rate        float64
+	FailPercent int
+	Duration    time.Duration
+	RetryAfter  time.Duration
+}
+
+// SimulateConsumer runs a consumer in the background that receives messages at the given rate and
+// deletes all but a random share of them, so dead-letter and redrive settings can be checked end
+// to end. The job reports how many failed messages have reached maxReceiveCount; SQS moves those
+// on their next receive.
+func (s *SqsServiceImpl) SimulateConsumer(ctx context.Context, input SimulateConsumerInput) (Job, error) {
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return Job{}, errors.New("queue url is required")
+	}
+
+	rate := input.Rate
+	if rate == 0 {
+		rate = defaultSimulationRate
+	}
+	if rate <= 0 || rate > maxSimulationRate {
+		return Job{}, errors.Newf("rate must be above 0 and at most %g messages per second", maxSimulationRate)
+	}
+	if input.FailPercent < 0 || input.FailPercent > 100 {
+		return Job{}, errors.New("failure percentage must be between 0 and 100")
+	}
+	duration := input.Duration
+	if duration == 0 {
+		duration = defaultSimulationDuration
+	}
+	if duration < time.Second || duration > maxSimulationDuration {
+		return Job{}, errors.Newf("duration must be between 1s and %s", maxSimulationDuration)
+	}
+	retryAfter := input.RetryAfter
+	if retryAfter == 0 {
+		retryAfter = defaultSimulationRetryAfter
+	}
+	if retryAfter < time.Second || retryAfter > maxSimulationRetryAfter {
+		return Job{}, errors.Newf("retry delay must be between 1s and %s", maxSimulationRetryAfter)
+	}
+
+	detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+	if err != nil {
+		return Job{}, err
+	}
+	maxReceiveCount := 0
+	if policy := parseRedrivePolicy(detail.Attributes["RedrivePolicy"]); policy != nil {
+		maxReceiveCount = policy.MaxReceiveCount
+	}
+
+	return s.jobs.start(ctx, "simulate", queueURL, func(ctx context.Context, progress *JobProgress) error {
+		return s.simulateConsumer(ctx, queueURL, rate, input.FailPercent, duration, retryAfter, maxReceiveCount, progress)
+	})
+}
+
+func (s *SqsServiceImpl) simulateConsumer(ctx context.Context, queueURL string, rate float64, failPercent int, duration, retryAfter time.Duration, maxReceiveCount int, progress *JobProgress) error {
+	deadline := s.now().Add(duration)
+	interval := time.Duration(float64(time.Second) / rate)
+	// Receiving about one second of work at a time keeps messages from waiting in flight for long.
+	batch := int32(min(math.Ceil(rate), float64(migrationReceiveBatch)))
+
+	var processed, deleted, failed, exhausted int64
+	summary := func() string {
+		message := fmt.Sprintf("Processed %d messages: %d deleted, %d failed.", processed, deleted, failed)
+		if maxReceiveCount > 0 {
+			message += fmt.Sprintf(" %d failed messages reached maxReceiveCount %d and go to the dead-letter queue on their next receive.", exhausted, maxReceiveCount)
+		} else {
+			message += " The queue has no redrive policy, so failed messages stay in it."
+		}
+		return message
+	}
+
+	for s.now().Before(deadline) {
+		progress.SetMessage(summary())
+		messages, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       batch,
+			WaitTimeSeconds:   simulationReceiveWait,
+			VisibilityTimeout: int32(retryAfter / time.Second),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "processed %d messages", processed)
+		}
+
+		for _, message := range messages {
+			if rand.IntN(100) < failPercent {
+				// Leaving the message alone is the failure: it comes back once the visibility timeout ends.
+				failed++
+				if maxReceiveCount > 0 && int(message.ReceiveCount) >= maxReceiveCount {
+					exhausted++
+				}
+			} else {
+				if err := s.repo.DeleteMessage(ctx, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: message.ReceiptHandle}); err != nil {
+					return errors.Wrapf(err, "processed %d messages", processed)
+				}
+				deleted++
+			}
+			processed++
+			progress.Advance(1)
+
+			if err := waitFor(ctx, interval); err != nil {
+				return err
+			}
+		}
+	}
+
+	progress.SetMessage(summary())
+	return nil
+}
@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+type consumerSimulatorPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	QueueName    string
+	EscapedURL   string
+	Rate         string
+	FailPercent  string
+	Duration     string
+	RetryAfter   string
+	JobID        string
+}
+
+// ConsumerSimulatorHandler renders the form that starts a simulated consumer on a queue.
+func (h *HandlerImpl) ConsumerSimulatorHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	h.renderConsumerSimulator(w, http.StatusOK, newConsumerSimulatorPageData(queueURL))
+}
+
+// PostConsumerSimulatorHandler starts a simulated consumer. The page then follows its job.
+func (h *HandlerImpl) PostConsumerSimulatorHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	data := newConsumerSimulatorPageData(queueURL)
+	data.Rate = strings.TrimSpace(r.FormValue("rate"))
+	data.FailPercent = strings.TrimSpace(r.FormValue("fail_percent"))
+	data.Duration = strings.TrimSpace(r.FormValue("duration"))
+	data.RetryAfter = strings.TrimSpace(r.FormValue("retry_after"))
+
+	input, err := parseConsumerSimulatorForm(queueURL, data)
+	if err != nil {
+		data.ErrorMessage = err.Error()
+		h.renderConsumerSimulator(w, http.StatusBadRequest, data)
+		return
+	}
+
+	job, err := h.s.SimulateConsumer(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start consumer simulation", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderConsumerSimulator(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.JobID = job.ID
+	h.renderConsumerSimulator(w, http.StatusOK, data)
+}
+
+func parseConsumerSimulatorForm(queueURL string, data consumerSimulatorPageData) (SimulateConsumerInput, error) {
+	input := SimulateConsumerInput{QueueURL: queueURL}
+	if data.Rate != "" {
+		rate, err := strconv.ParseFloat(data.Rate, 64)
+		if err != nil {
+			return input, errors.New("rate must be a number")
+		}
+		input.Rate = rate
+	}
+	if data.FailPercent != "" {
+		percent, err := strconv.Atoi(data.FailPercent)
+		if err != nil {
+			return input, errors.New("failure percentage must be a whole number")
+		}
+		input.FailPercent = percent
+	}
+	if data.Duration != "" {
+		duration, err := time.ParseDuration(data.Duration)
+		if err != nil {
+			return input, errors.New("duration must look like 30s, 5m or 1h")
+		}
+		input.Duration = duration
+	}
+	if data.RetryAfter != "" {
+		retryAfter, err := time.ParseDuration(data.RetryAfter)
+		if err != nil {
+			return input, errors.New("retry delay must look like 5s or 1m")
+		}
+		input.RetryAfter = retryAfter
+	}
+	return input, nil
+}
+
+func newConsumerSimulatorPageData(queueURL string) consumerSimulatorPageData {
+	return consumerSimulatorPageData{
+		Title:       "Simulate a consumer",
+		ViteTags:    fragments["assets/js/consumer_simulator.ts"].Tags,
+		QueueName:   extractQueueName(queueURL),
+		EscapedURL:  url.QueryEscape(queueURL),
+		Rate:        strconv.FormatFloat(defaultSimulationRate, 'f', -1, 64),
+		FailPercent: "0",
+		Duration:    defaultSimulationDuration.String(),
+		RetryAfter:  defaultSimulationRetryAfter.String(),
+	}
+}
+
+func (h *HandlerImpl) renderConsumerSimulator(w http.ResponseWriter, status int, data consumerSimulatorPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["consumer-simulator"].Execute(w, data); err != nil {
+		slog.Error("failed to render consumer-simulator template", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_PostConsumerSimulatorHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/simulate", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("starts the simulation and follows its job", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured consumerSimulatorPageData
+		captureTemplate(t, "consumer-simulator", func(data consumerSimulatorPageData) { captured = data })
+		installFragment(t, "assets/js/consumer_simulator.ts", "")
+
+		mockService.EXPECT().
+			SimulateConsumer(mock.Anything, SimulateConsumerInput{
+				QueueURL:    queueURL,
+				Rate:        2.5,
+				FailPercent: 20,
+				Duration:    5 * time.Minute,
+				RetryAfter:  10 * time.Second,
+			}).
+			Return(Job{ID: "job-1"}, nil).
+			Once()
+
+		handler.PostConsumerSimulatorHandler(rr, newRequest(url.Values{
+			"rate":         {"2.5"},
+			"fail_percent": {"20"},
+			"duration":     {"5m"},
+			"retry_after":  {"10s"},
+		}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "job-1", captured.JobID)
+		assert.Equal(t, "orders", captured.QueueName)
+	})
+
+	t.Run("keeps the form values on invalid input", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured consumerSimulatorPageData
+		captureTemplate(t, "consumer-simulator", func(data consumerSimulatorPageData) { captured = data })
+		installFragment(t, "assets/js/consumer_simulator.ts", "")
+
+		handler.PostConsumerSimulatorHandler(rr, newRequest(url.Values{"rate": {"2"}, "duration": {"soon"}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "duration must look like 30s, 5m or 1h", captured.ErrorMessage)
+		assert.Equal(t, "2", captured.Rate)
+		assert.Empty(t, captured.JobID)
+	})
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_SimulateConsumer(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+	redrive := `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:000000000000:orders-dlq","maxReceiveCount":"3"}`
+
+	// newService returns a service whose clock passes the end of the simulation once the first
+	// batch has been received.
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository, func()) {
+		repo := NewMockSqsRepository(t)
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		service := &SqsServiceImpl{repo: repo, jobs: newJobRegistry(), clock: func() time.Time { return now }}
+		return service, repo, func() { now = now.Add(time.Hour) }
+	}
+
+	t.Run("deletes every message without failures", func(t *testing.T) {
+		service, repo, finish := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       10,
+			WaitTimeSeconds:   simulationReceiveWait,
+			VisibilityTimeout: 5,
+		}).
+			Run(func(context.Context, ReceiveMessagesRepositoryInput) { finish() }).
+			Return([]ReceivedMessage{{ID: "m-1", ReceiptHandle: "r-1"}, {ID: "m-2", ReceiptHandle: "r-2"}}, nil).
+			Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-1"}).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-2"}).Return(nil).Once()
+
+		job, err := service.SimulateConsumer(ctx, SimulateConsumerInput{QueueURL: queueURL, Rate: 100})
+		require.NoError(t, err)
+		assert.Equal(t, "simulate", job.Kind)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(2), job.Done)
+		assert.Equal(t, "Processed 2 messages: 2 deleted, 0 failed. The queue has no redrive policy, so failed messages stay in it.", job.Message)
+	})
+
+	t.Run("leaves failed messages and counts the ones at maxReceiveCount", func(t *testing.T) {
+		service, repo, finish := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{
+			Attributes: map[string]string{"RedrivePolicy": redrive},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       2,
+			WaitTimeSeconds:   simulationReceiveWait,
+			VisibilityTimeout: 30,
+		}).
+			Run(func(context.Context, ReceiveMessagesRepositoryInput) { finish() }).
+			Return([]ReceivedMessage{{ID: "m-1", ReceiveCount: 1}, {ID: "m-2", ReceiveCount: 3}}, nil).
+			Once()
+
+		job, err := service.SimulateConsumer(ctx, SimulateConsumerInput{
+			QueueURL:    queueURL,
+			Rate:        1.5,
+			FailPercent: 100,
+			RetryAfter:  30 * time.Second,
+		})
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, "Processed 2 messages: 0 deleted, 2 failed. 1 failed messages reached maxReceiveCount 3 and go to the dead-letter queue on their next receive.", job.Message)
+	})
+
+	t.Run("validates the input", func(t *testing.T) {
+		service, _, _ := newService(t)
+
+		_, err := service.SimulateConsumer(ctx, SimulateConsumerInput{QueueURL: queueURL, Rate: 500})
+		require.EqualError(t, err, "rate must be above 0 and at most 100 messages per second")
+
+		_, err = service.SimulateConsumer(ctx, SimulateConsumerInput{QueueURL: queueURL, FailPercent: 101})
+		require.EqualError(t, err, "failure percentage must be between 0 and 100")
+
+		_, err = service.SimulateConsumer(ctx, SimulateConsumerInput{QueueURL: queueURL, Duration: time.Hour})
+		require.EqualError(t, err, "duration must be between 1s and 30m0s")
+	})
+}
@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// DeadLetterContext explains why a message sits in a dead-letter queue: how often it was received,
+// when it was first received and originally sent, and the queue SQS moved it from. SourceQueueURL
+// and MaxReceiveCount are empty when the source queue can no longer be looked up.
+type DeadLetterContext struct {
+	ReceiveCount    int32
+	SentAt          time.Time
+	FirstReceivedAt time.Time
+	SourceQueueArn  string
+	SourceQueueName string
+	SourceQueueURL  string
+	MaxReceiveCount int
+}
+
+// deadLetterContexts builds a DeadLetterContext for each message that SQS moved to a dead-letter
+// queue, keyed by message ID. Redriven messages carry the DeadLetterQueueSourceArn attribute; each
+// source queue is looked up once for its redrive policy.
+func (s *SqsServiceImpl) deadLetterContexts(ctx context.Context, messages []ReceivedMessage) map[string]DeadLetterContext {
+	var contexts map[string]DeadLetterContext
+	sources := make(map[string]DeadLetterContext)
+	for _, message := range messages {
+		arn := messageAttributeValue(message, "DeadLetterQueueSourceArn")
+		if arn == "" {
+			continue
+		}
+
+		source, ok := sources[arn]
+		if !ok {
+			source = s.deadLetterSource(ctx, arn)
+			sources[arn] = source
+		}
+
+		if contexts == nil {
+			contexts = make(map[string]DeadLetterContext)
+		}
+		source.ReceiveCount = message.ReceiveCount
+		source.SentAt = messageSentAt(message)
+		source.FirstReceivedAt = messageTimestamp(message, "ApproximateFirstReceiveTimestamp")
+		contexts[message.ID] = source
+	}
+	return contexts
+}
+
+// deadLetterSource resolves the source queue named by arn and reads its maxReceiveCount. Lookup
+// failures are logged and leave the URL and count empty, so the rest of the context still shows.
+func (s *SqsServiceImpl) deadLetterSource(ctx context.Context, arn string) DeadLetterContext {
+	source := DeadLetterContext{SourceQueueArn: arn, SourceQueueName: arn[strings.LastIndex(arn, ":")+1:]}
+
+	queueURL, exists, err := s.repo.QueueURL(ctx, source.SourceQueueName)
+	if err != nil || !exists {
+		slog.DebugContext(ctx, "dead-letter source queue not found", slog.String("source_arn", arn), slog.Any("error", err))
+		return source
+	}
+	source.SourceQueueURL = queueURL
+
+	detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to read dead-letter source queue", slog.String("queue_url", queueURL), slog.Any("error", err))
+		return source
+	}
+	if detail.RedrivePolicy != nil {
+		source.MaxReceiveCount = detail.RedrivePolicy.MaxReceiveCount
+	}
+	return source
+}
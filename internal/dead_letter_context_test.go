@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_ReceiveMessages_DeadLetterContext(t *testing.T) {
+	ctx := context.Background()
+	dlqURL := "https://sqs.local/orders-dlq"
+	sourceURL := "https://sqs.local/orders"
+	sourceArn := "arn:aws:sqs:us-east-1:000000000000:orders"
+
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo}
+
+	redriven := func(id string, receives int32) ReceivedMessage {
+		return ReceivedMessage{ID: id, ReceiveCount: receives, Attributes: []MessageAttribute{
+			{Name: "ApproximateFirstReceiveTimestamp", Value: "2026-10-01T10:00:05Z"},
+			{Name: "DeadLetterQueueSourceArn", Value: sourceArn},
+			{Name: "SentTimestamp", Value: "2026-10-01T10:00:00Z"},
+		}}
+	}
+	repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+		redriven("m-1", 4),
+		redriven("m-2", 5),
+		{ID: "m-3", ReceiveCount: 1},
+	}, nil).Once()
+	// The source queue is looked up once for both messages.
+	repo.EXPECT().QueueURL(mock.Anything, "orders").Return(sourceURL, true, nil).Once()
+	repo.EXPECT().GetQueueDetail(mock.Anything, sourceURL).Return(QueueDetail{QueueSummary: QueueSummary{
+		RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", MaxReceiveCount: 4},
+	}}, nil).Once()
+
+	result, err := service.ReceiveMessages(ctx, ReceiveMessagesInput{QueueURL: dlqURL})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]DeadLetterContext{
+		"m-1": {
+			ReceiveCount:    4,
+			SentAt:          time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC),
+			FirstReceivedAt: time.Date(2026, 10, 1, 10, 0, 5, 0, time.UTC),
+			SourceQueueArn:  sourceArn,
+			SourceQueueName: "orders",
+			SourceQueueURL:  sourceURL,
+			MaxReceiveCount: 4,
+		},
+		"m-2": {
+			ReceiveCount:    5,
+			SentAt:          time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC),
+			FirstReceivedAt: time.Date(2026, 10, 1, 10, 0, 5, 0, time.UTC),
+			SourceQueueArn:  sourceArn,
+			SourceQueueName: "orders",
+			SourceQueueURL:  sourceURL,
+			MaxReceiveCount: 4,
+		},
+	}, result.DeadLetter)
+}
+
+func TestSqsServiceImpl_deadLetterSource(t *testing.T) {
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo}
+
+	repo.EXPECT().QueueURL(mock.Anything, "deleted").Return("", false, nil).Once()
+
+	source := service.deadLetterSource(context.Background(), "arn:aws:sqs:us-east-1:000000000000:deleted")
+	assert.Equal(t, DeadLetterContext{SourceQueueArn: "arn:aws:sqs:us-east-1:000000000000:deleted", SourceQueueName: "deleted"}, source)
+}
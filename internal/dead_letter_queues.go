@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// dlqAgeSampleSize is the number of messages received when estimating the oldest message age.
+const dlqAgeSampleSize = 10
+
+// DeadLetterQueueSummary describes a queue that other queues redrive into.
+type DeadLetterQueueSummary struct {
+	QueueSummary
+	SourceQueues []DeadLetterSource
+	// OldestSampledAt is the earliest SentTimestamp among sampled messages. It is only set
+	// when sampling was requested, because sampling receives messages from the queue.
+	OldestSampledAt time.Time
+	SampleError     string
+	// Watched is set when the queue's attribute history is recorded, which also subscribes browsers
+	// to its first message arrivals.
+	Watched bool
+}
+
+// DeadLetterSource is a queue whose redrive policy targets a dead-letter queue.
+type DeadLetterSource struct {
+	Name            string
+	URL             string
+	MaxReceiveCount int
+}
+
+// DeadLetterQueues finds every queue referenced by another queue's redrive policy.
+// When sampleAge is set, each non-empty DLQ is sampled to estimate its oldest message.
+func (s *SqsServiceImpl) DeadLetterQueues(ctx context.Context, sampleAge bool) ([]DeadLetterQueueSummary, error) {
+	queues, err := s.Queues(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byArn := make(map[string]int, len(queues))
+	for i, queue := range queues {
+		if queue.Arn != "" {
+			byArn[queue.Arn] = i
+		}
+	}
+
+	sources := make(map[int][]DeadLetterSource)
+	for _, queue := range queues {
+		if queue.RedrivePolicy == nil {
+			continue
+		}
+		target, ok := byArn[queue.RedrivePolicy.DeadLetterTargetArn]
+		if !ok {
+			slog.DebugContext(ctx, "redrive target is not visible to this account", slog.String("queue_url", queue.URL), slog.String("target_arn", queue.RedrivePolicy.DeadLetterTargetArn))
+			continue
+		}
+		sources[target] = append(sources[target], DeadLetterSource{
+			Name:            queue.Name,
+			URL:             queue.URL,
+			MaxReceiveCount: queue.RedrivePolicy.MaxReceiveCount,
+		})
+	}
+
+	watched := make(map[string]bool)
+	if s.store != nil {
+		histories, err := s.store.AttributeHistories()
+		if err != nil {
+			return nil, err
+		}
+		for _, history := range histories {
+			watched[history.QueueURL] = true
+		}
+	}
+
+	dlqs := make([]DeadLetterQueueSummary, 0, len(sources))
+	for index, queueSources := range sources {
+		slices.SortFunc(queueSources, func(a, b DeadLetterSource) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		dlq := DeadLetterQueueSummary{QueueSummary: queues[index], SourceQueues: queueSources, Watched: watched[queues[index].URL]}
+
+		if sampleAge && dlq.MessagesAvailable > 0 {
+			oldest, err := s.sampleOldestMessage(ctx, dlq.URL)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to sample dead-letter queue", slog.String("queue_url", dlq.URL), slog.Any("error", err))
+				dlq.SampleError = err.Error()
+			}
+			dlq.OldestSampledAt = oldest
+		}
+
+		dlqs = append(dlqs, dlq)
+	}
+
+	slices.SortFunc(dlqs, func(a, b DeadLetterQueueSummary) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return dlqs, nil
+}
+
+// DeadLetterSourceQueues asks SQS which queues use the given queue as their dead-letter queue,
+// ordered by name. Unlike DeadLetterQueues it needs no listing of every queue, but it leaves
+// MaxReceiveCount unset.
+func (s *SqsServiceImpl) DeadLetterSourceQueues(ctx context.Context, queueURL string) ([]DeadLetterSource, error) {
+	if strings.TrimSpace(queueURL) == "" {
+		return nil, errors.New("queue url is required")
+	}
+	urls, err := s.repo.ListDeadLetterSourceQueues(ctx, queueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]DeadLetterSource, 0, len(urls))
+	for _, sourceURL := range urls {
+		sources = append(sources, DeadLetterSource{Name: extractQueueName(sourceURL), URL: sourceURL})
+	}
+	slices.SortFunc(sources, func(a, b DeadLetterSource) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return sources, nil
+}
+
+// sampleOldestMessage receives a batch of messages and returns the earliest SentTimestamp.
+// SQS has no queue attribute for the oldest message age, so this is only an estimate. The sampled
+// messages are made visible again right away, though their receive counts still go up.
+func (s *SqsServiceImpl) sampleOldestMessage(ctx context.Context, queueURL string) (time.Time, error) {
+	messages, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+		QueueURL:    queueURL,
+		MaxMessages: dlqAgeSampleSize,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	handles := make(map[string]string, len(messages))
+	var oldest time.Time
+	for _, message := range messages {
+		handles[message.ID] = message.ReceiptHandle
+		sentAt := messageSentAt(message)
+		if sentAt.IsZero() {
+			continue
+		}
+		if oldest.IsZero() || sentAt.Before(oldest) {
+			oldest = sentAt
+		}
+	}
+	// Receiving applied the queue's visibility timeout, which would hide the sample from a redrive.
+	s.restoreVisibility(context.WithoutCancel(ctx), queueURL, handles)
+
+	return oldest, nil
+}
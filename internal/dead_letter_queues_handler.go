@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type deadLetterQueuesPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	Sampled      bool
+	// PushKey is the VAPID public key browsers subscribe to arrival notifications with, empty when
+	// browser push notifications are not configured.
+	PushKey string
+	Queues  []deadLetterQueueView
+}
+
+type deadLetterQueueView struct {
+	Name              string
+	URL               string
+	MessagesAvailable string
+	MessagesInFlight  string
+	OldestMessageAge  string
+	SampleError       string
+	Watched           bool
+	Sources           []deadLetterSourceView
+}
+
+type deadLetterSourceView struct {
+	Name            string
+	URL             string
+	MaxReceiveCount string
+}
+
+// DeadLetterQueuesHandler renders every dead-letter queue with its depth and source queues.
+func (h *HandlerImpl) DeadLetterQueuesHandler(w http.ResponseWriter, r *http.Request) {
+	sample := r.URL.Query().Get("sample") == "1"
+	data := deadLetterQueuesPageData{
+		Title:    "Dead-letter queues",
+		ViteTags: fragments["assets/js/dead_letter_queues.ts"].Tags,
+		Sampled:  sample,
+		PushKey:  h.s.WebPushKey(r.Context()),
+	}
+
+	dlqs, err := h.s.DeadLetterQueues(r.Context(), sample)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load dead-letter queues", slog.Any("error", err))
+		data.ErrorMessage = "Failed to load dead-letter queues."
+	}
+
+	now := time.Now()
+	for _, dlq := range dlqs {
+		view := deadLetterQueueView{
+			Name:              dlq.Name,
+			URL:               url.QueryEscape(dlq.URL),
+			MessagesAvailable: strconv.FormatInt(dlq.MessagesAvailable, 10),
+			MessagesInFlight:  strconv.FormatInt(dlq.MessagesInFlight, 10),
+			OldestMessageAge:  "-",
+			SampleError:       dlq.SampleError,
+			Watched:           dlq.Watched,
+		}
+		if !dlq.OldestSampledAt.IsZero() {
+			view.OldestMessageAge = now.Sub(dlq.OldestSampledAt).Truncate(time.Second).String()
+		}
+		for _, source := range dlq.SourceQueues {
+			maxReceiveCount := "-"
+			if source.MaxReceiveCount > 0 {
+				maxReceiveCount = strconv.Itoa(source.MaxReceiveCount)
+			}
+			view.Sources = append(view.Sources, deadLetterSourceView{
+				Name:            source.Name,
+				URL:             url.QueryEscape(source.URL),
+				MaxReceiveCount: maxReceiveCount,
+			})
+		}
+		data.Queues = append(data.Queues, view)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates["dead-letter-queues"].Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "failed to render dead-letter-queues template", slog.Any("error", err))
+		http.Error(w, "template error", http.StatusInternalServerError)
+	}
+}
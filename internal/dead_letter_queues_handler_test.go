@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_DeadLetterQueuesHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/dead-letter-queues?sample=1", nil)
+	rr := httptest.NewRecorder()
+
+	var captured deadLetterQueuesPageData
+	captureTemplate(t, "dead-letter-queues", func(data deadLetterQueuesPageData) { captured = data })
+	installFragment(t, "assets/js/dead_letter_queues.ts", template.HTML(`<script data-test="dlq"></script>`))
+
+	mockService.EXPECT().
+		DeadLetterQueues(mock.Anything, true).
+		Return([]DeadLetterQueueSummary{{
+			QueueSummary:    QueueSummary{Name: "orders-dlq", URL: "https://sqs.local/orders-dlq", MessagesAvailable: 4},
+			SourceQueues:    []DeadLetterSource{{Name: "orders", URL: "https://sqs.local/orders", MaxReceiveCount: 5}},
+			OldestSampledAt: time.Now().Add(-2 * time.Hour),
+			Watched:         true,
+		}}, nil).
+		Once()
+	mockService.EXPECT().WebPushKey(mock.Anything).Return("BPublicKey").Once()
+
+	handler.DeadLetterQueuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.True(t, captured.Sampled)
+	assert.Equal(t, "BPublicKey", captured.PushKey)
+	assert.Equal(t, template.HTML(`<script data-test="dlq"></script>`), captured.ViteTags)
+	if assert.Len(t, captured.Queues, 1) {
+		view := captured.Queues[0]
+		assert.Equal(t, url.QueryEscape("https://sqs.local/orders-dlq"), view.URL)
+		assert.Equal(t, "4", view.MessagesAvailable)
+		assert.True(t, view.Watched)
+		assert.Regexp(t, `^2h0m\d+s$`, view.OldestMessageAge)
+		assert.Equal(t, []deadLetterSourceView{{Name: "orders", URL: url.QueryEscape("https://sqs.local/orders"), MaxReceiveCount: "5"}}, view.Sources)
+	}
+}
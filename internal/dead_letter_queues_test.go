@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_DeadLetterQueues(t *testing.T) {
+	ctx := context.Background()
+	queues := []QueueSummary{
+		{Name: "orders", URL: "https://sqs.local/orders", Arn: "arn:orders", RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:orders-dlq", MaxReceiveCount: 5}},
+		{Name: "orders-dlq", URL: "https://sqs.local/orders-dlq", Arn: "arn:orders-dlq", MessagesAvailable: 3},
+		{Name: "billing", URL: "https://sqs.local/billing", Arn: "arn:billing", RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:orders-dlq", MaxReceiveCount: 3}},
+		{Name: "payments", URL: "https://sqs.local/payments", Arn: "arn:payments", RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:other-account-dlq"}},
+		{Name: "empty-dlq", URL: "https://sqs.local/empty-dlq", Arn: "arn:empty-dlq"},
+		{Name: "audit", URL: "https://sqs.local/audit", Arn: "arn:audit", RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:empty-dlq"}},
+	}
+
+	t.Run("groups source queues under their dlq", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().ListQueues(ctx).Return(queues, nil).Once()
+
+		dlqs, err := service.DeadLetterQueues(ctx, false)
+		require.NoError(t, err)
+		require.Len(t, dlqs, 2)
+
+		assert.Equal(t, "empty-dlq", dlqs[0].Name)
+		assert.Equal(t, []DeadLetterSource{{Name: "audit", URL: "https://sqs.local/audit"}}, dlqs[0].SourceQueues)
+
+		assert.Equal(t, "orders-dlq", dlqs[1].Name)
+		assert.Equal(t, []DeadLetterSource{
+			{Name: "billing", URL: "https://sqs.local/billing", MaxReceiveCount: 3},
+			{Name: "orders", URL: "https://sqs.local/orders", MaxReceiveCount: 5},
+		}, dlqs[1].SourceQueues)
+		assert.True(t, dlqs[1].OldestSampledAt.IsZero())
+	})
+
+	t.Run("samples non-empty dlqs for the oldest message", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().ListQueues(ctx).Return(queues, nil).Once()
+		repo.EXPECT().
+			ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{QueueURL: "https://sqs.local/orders-dlq", MaxMessages: dlqAgeSampleSize}).
+			Return([]ReceivedMessage{
+				{ID: "1", ReceiptHandle: "h1", Attributes: []MessageAttribute{{Name: "SentTimestamp", Value: "2024-05-01T12:00:00Z"}}},
+				{ID: "2", ReceiptHandle: "h2", Attributes: []MessageAttribute{{Name: "SentTimestamp", Value: "2024-05-01T10:00:00Z"}}},
+				{ID: "3", ReceiptHandle: "h3"},
+			}, nil).
+			Once()
+		for _, handle := range []string{"h1", "h2", "h3"} {
+			repo.EXPECT().
+				ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: "https://sqs.local/orders-dlq", ReceiptHandle: handle}).
+				Return(nil).
+				Once()
+		}
+
+		dlqs, err := service.DeadLetterQueues(ctx, true)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), dlqs[1].OldestSampledAt)
+	})
+
+	t.Run("keeps going when sampling fails", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().ListQueues(ctx).Return(queues, nil).Once()
+		repo.EXPECT().ReceiveMessages(ctx, mock.Anything).Return(nil, errors.New("denied")).Once()
+
+		dlqs, err := service.DeadLetterQueues(ctx, true)
+		require.NoError(t, err)
+		assert.Equal(t, "denied", dlqs[1].SampleError)
+	})
+}
+
+func TestSqsServiceImpl_DeadLetterSourceQueues(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo}
+
+	repo.EXPECT().
+		ListDeadLetterSourceQueues(ctx, "https://sqs.local/000000000000/orders-dlq").
+		Return([]string{"https://sqs.local/000000000000/payments", "https://sqs.local/000000000000/orders"}, nil).
+		Once()
+
+	sources, err := service.DeadLetterSourceQueues(ctx, "https://sqs.local/000000000000/orders-dlq")
+	require.NoError(t, err)
+	assert.Equal(t, []DeadLetterSource{
+		{Name: "orders", URL: "https://sqs.local/000000000000/orders"},
+		{Name: "payments", URL: "https://sqs.local/000000000000/payments"},
+	}, sources)
+}
@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// RedrivenMessage is the outcome of sending one dead-letter message back. TargetQueueURL is the
+// queue it was sent to and is empty when its source queue could not be told.
+type RedrivenMessage struct {
+	TransferredMessage
+	TargetQueueURL string
+}
+
+// RedriveMessages sends selected messages received from a dead-letter queue back to the queues
+// they failed in and deletes them from the dead-letter queue once they were sent, unlike a
+// message move task, which redrives the whole queue. The source of each message is the queue
+// named by its DeadLetterQueueSourceArn attribute; a message without one goes to the only queue
+// that redrives into the dead-letter queue, and fails when there are several. Messages are sent
+// as TransferMessages moves them, and the results are in the order of messages.
+func (s *SqsServiceImpl) RedriveMessages(ctx context.Context, queueURL string, messages []ReceivedMessage) ([]RedrivenMessage, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return nil, errors.New("queue url is required")
+	}
+	if len(messages) == 0 {
+		return nil, errors.New("at least one message is required")
+	}
+	if len(messages) > maxTransferMessages {
+		return nil, errors.Newf("at most %d messages can be redriven at once", maxTransferMessages)
+	}
+
+	targets := make(map[string]string)
+	var onlySource string
+	var sourceErr error
+	sourcesListed := false
+	sourceOf := func(message ReceivedMessage) (string, error) {
+		arn := messageAttributeValue(message, "DeadLetterQueueSourceArn")
+		if arn == "" {
+			if !sourcesListed {
+				sourcesListed = true
+				onlySource, sourceErr = s.onlyDeadLetterSource(ctx, queueURL)
+			}
+			return onlySource, sourceErr
+		}
+		if target, ok := targets[arn]; ok {
+			return target, nil
+		}
+		name, err := queueNameFromArn(arn)
+		if err != nil {
+			return "", err
+		}
+		target, exists, err := s.repo.QueueURL(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return "", errors.Newf("the source queue %s no longer exists", name)
+		}
+		targets[arn] = target
+		return target, nil
+	}
+
+	results := make([]RedrivenMessage, len(messages))
+	batches := make(map[string][]int)
+	var order []string
+	for i, message := range messages {
+		results[i].MessageID = message.ID
+		target, err := sourceOf(message)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if target == queueURL {
+			results[i].Error = "the message came from this queue"
+			continue
+		}
+		results[i].TargetQueueURL = target
+		if _, ok := batches[target]; !ok {
+			order = append(order, target)
+		}
+		batches[target] = append(batches[target], i)
+	}
+
+	for _, target := range order {
+		indexes := batches[target]
+		batch := make([]ReceivedMessage, 0, len(indexes))
+		for _, i := range indexes {
+			batch = append(batch, messages[i])
+		}
+		transferred, err := s.TransferMessages(ctx, TransferMessagesInput{
+			SourceQueueURL: queueURL,
+			TargetQueueURL: target,
+			Messages:       batch,
+			Move:           true,
+		})
+		for n, i := range indexes {
+			if err != nil {
+				results[i].Error = err.Error()
+				continue
+			}
+			results[i].TransferredMessage = transferred[n]
+		}
+	}
+	return results, nil
+}
+
+// onlyDeadLetterSource returns the URL of the one queue that redrives into queueURL.
+func (s *SqsServiceImpl) onlyDeadLetterSource(ctx context.Context, queueURL string) (string, error) {
+	sources, err := s.repo.ListDeadLetterSourceQueues(ctx, queueURL)
+	if err != nil {
+		return "", err
+	}
+	switch len(sources) {
+	case 0:
+		return "", errors.New("the message has no source queue and no queue redrives into this one")
+	case 1:
+		return sources[0], nil
+	default:
+		return "", errors.New("the message has no source queue and several queues redrive into this one")
+	}
+}
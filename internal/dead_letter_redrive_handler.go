@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+type redriveMessagesRequest struct {
+	Messages []transferMessageRequestItem `json:"messages"`
+}
+
+type redrivenMessageItem struct {
+	transferredMessageItem
+	TargetQueueURL  string `json:"targetQueueUrl,omitempty"`
+	TargetQueueName string `json:"targetQueueName,omitempty"`
+}
+
+type redriveMessagesResponse struct {
+	Message  string                `json:"message"`
+	Messages []redrivenMessageItem `json:"messages"`
+}
+
+// RedriveMessagesAPI sends messages received from the dead-letter queue in the path back to their
+// source queues and deletes them from it. Messages that fail are reported one by one; the request
+// itself succeeds.
+func (h *HandlerImpl) RedriveMessagesAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	var payload redriveMessagesRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	results, err := h.s.RedriveMessages(r.Context(), queueURL, receivedMessagesFromRequest(payload.Messages))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to redrive messages", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	redriven := 0
+	response := redriveMessagesResponse{Messages: make([]redrivenMessageItem, 0, len(results))}
+	for _, result := range results {
+		if result.Deleted {
+			redriven++
+		}
+		item := redrivenMessageItem{transferredMessageItem: transferredMessageItem(result.TransferredMessage), TargetQueueURL: result.TargetQueueURL}
+		if result.TargetQueueURL != "" {
+			item.TargetQueueName = extractQueueName(result.TargetQueueURL)
+		}
+		response.Messages = append(response.Messages, item)
+	}
+	response.Message = fmt.Sprintf("Redrove %d of %d messages to their source queues.", redriven, len(results))
+
+	writeJSON(w, http.StatusOK, response)
+}
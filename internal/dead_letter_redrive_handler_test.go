@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_RedriveMessagesAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders-dlq"
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	body := `{"messages":[
+		{"id":"m1","body":"one","receiptHandle":"rh-1","attributes":[{"name":"DeadLetterQueueSourceArn","value":"arn:aws:sqs:us-east-1:000000000000:orders"}]},
+		{"id":"m2","body":"two","receiptHandle":"rh-2","attributes":[]}
+	]}`
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/messages/redrive", strings.NewReader(body))
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		RedriveMessages(mock.Anything, queueURL, []ReceivedMessage{
+			{ID: "m1", Body: "one", ReceiptHandle: "rh-1", Attributes: []MessageAttribute{{Name: "DeadLetterQueueSourceArn", Value: "arn:aws:sqs:us-east-1:000000000000:orders"}}},
+			{ID: "m2", Body: "two", ReceiptHandle: "rh-2"},
+		}).
+		Return([]RedrivenMessage{
+			{TransferredMessage: TransferredMessage{MessageID: "m1", Sent: true, Deleted: true}, TargetQueueURL: "https://sqs.local/orders"},
+			{TransferredMessage: TransferredMessage{MessageID: "m2", Error: "the message has no source queue and no queue redrives into this one"}},
+		}, nil).
+		Once()
+
+	handler.RedriveMessagesAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{
+		"message": "Redrove 1 of 2 messages to their source queues.",
+		"messages": [
+			{"messageId":"m1","sent":true,"deleted":true,"targetQueueUrl":"https://sqs.local/orders","targetQueueName":"orders"},
+			{"messageId":"m2","sent":false,"deleted":false,"error":"the message has no source queue and no queue redrives into this one"}
+		]
+	}`, rr.Body.String())
+}
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_RedriveMessages(t *testing.T) {
+	ctx := context.Background()
+	dlqURL := "https://sqs.local/000000000000/orders-dlq"
+	fromOrders := func(id string) ReceivedMessage {
+		return ReceivedMessage{ID: id, Body: id, ReceiptHandle: "rh-" + id, Attributes: []MessageAttribute{
+			{Name: "DeadLetterQueueSourceArn", Value: "arn:aws:sqs:us-east-1:000000000000:orders"},
+		}}
+	}
+
+	t.Run("sends each message back to the queue it came from", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().QueueURL(mock.Anything, "orders").Return("https://sqs.local/000000000000/orders", true, nil).Once()
+		repo.EXPECT().ListDeadLetterSourceQueues(mock.Anything, dlqURL).Return([]string{"https://sqs.local/000000000000/payments"}, nil).Once()
+		for _, target := range []struct{ queueURL, body string }{
+			{"https://sqs.local/000000000000/orders", "m1"},
+			{"https://sqs.local/000000000000/orders", "m3"},
+			{"https://sqs.local/000000000000/payments", "m2"},
+		} {
+			repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{QueueURL: target.queueURL, Body: target.body}).Return(nil).Once()
+			repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: dlqURL, ReceiptHandle: "rh-" + target.body}).Return(nil).Once()
+		}
+
+		results, err := service.RedriveMessages(ctx, dlqURL, []ReceivedMessage{
+			fromOrders("m1"),
+			{ID: "m2", Body: "m2", ReceiptHandle: "rh-m2"},
+			fromOrders("m3"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []RedrivenMessage{
+			{TransferredMessage: TransferredMessage{MessageID: "m1", Sent: true, Deleted: true}, TargetQueueURL: "https://sqs.local/000000000000/orders"},
+			{TransferredMessage: TransferredMessage{MessageID: "m2", Sent: true, Deleted: true}, TargetQueueURL: "https://sqs.local/000000000000/payments"},
+			{TransferredMessage: TransferredMessage{MessageID: "m3", Sent: true, Deleted: true}, TargetQueueURL: "https://sqs.local/000000000000/orders"},
+		}, results)
+	})
+
+	t.Run("reports messages whose source cannot be told", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().QueueURL(mock.Anything, "orders").Return("", false, nil).Once()
+		repo.EXPECT().ListDeadLetterSourceQueues(mock.Anything, dlqURL).Return([]string{
+			"https://sqs.local/000000000000/orders",
+			"https://sqs.local/000000000000/payments",
+		}, nil).Once()
+
+		results, err := service.RedriveMessages(ctx, dlqURL, []ReceivedMessage{
+			fromOrders("m1"),
+			{ID: "m2", ReceiptHandle: "rh-m2"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []RedrivenMessage{
+			{TransferredMessage: TransferredMessage{MessageID: "m1", Error: "the source queue orders no longer exists"}},
+			{TransferredMessage: TransferredMessage{MessageID: "m2", Error: "the message has no source queue and several queues redrive into this one"}},
+		}, results)
+	})
+
+	t.Run("requires messages", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.RedriveMessages(ctx, dlqURL, nil)
+		assert.EqualError(t, err, "at least one message is required")
+	})
+}
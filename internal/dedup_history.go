@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// fifoDeduplicationWindow is the interval during which SQS discards FIFO messages that reuse a deduplication ID.
+const fifoDeduplicationWindow = 5 * time.Minute
+
+// dedupHistory remembers the deduplication IDs recently sent to each FIFO queue with the message
+// group they were sent to. SQS accepts a send that reuses an ID within the window but silently
+// drops the message, so the history lets the service explain why a message never showed up.
+type dedupHistory struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entries map[string]map[dedupKey]time.Time
+}
+
+// dedupKey is a deduplication ID sent to one message group.
+type dedupKey struct {
+	id    string
+	group string
+}
+
+func newDedupHistory() *dedupHistory {
+	return &dedupHistory{
+		now:     time.Now,
+		entries: make(map[string]map[dedupKey]time.Time),
+	}
+}
+
+// lastUsed reports when dedupID was first sent to messageGroupID of queueURL within the current
+// deduplication window. An empty messageGroupID looks at every group, as SQS does for queues
+// whose DeduplicationScope is the whole queue.
+func (h *dedupHistory) lastUsed(queueURL, dedupID, messageGroupID string) (time.Time, bool) {
+	if h == nil {
+		return time.Time{}, false
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.pruneLocked()
+	var first time.Time
+	for key, sentAt := range h.entries[queueURL] {
+		if key.id != dedupID || (messageGroupID != "" && key.group != messageGroupID) {
+			continue
+		}
+		if first.IsZero() || sentAt.Before(first) {
+			first = sentAt
+		}
+	}
+	return first, !first.IsZero()
+}
+
+// record stores a successful send. A reused ID keeps its original timestamp because
+// SQS measures the deduplication window from the first accepted message.
+func (h *dedupHistory) record(queueURL, dedupID, messageGroupID string) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ids, ok := h.entries[queueURL]
+	if !ok {
+		ids = make(map[dedupKey]time.Time)
+		h.entries[queueURL] = ids
+	}
+	key := dedupKey{id: dedupID, group: messageGroupID}
+	if _, exists := ids[key]; !exists {
+		ids[key] = h.now()
+	}
+}
+
+func (h *dedupHistory) pruneLocked() {
+	cutoff := h.now().Add(-fifoDeduplicationWindow)
+	for queueURL, ids := range h.entries {
+		for id, sentAt := range ids {
+			if sentAt.Before(cutoff) {
+				delete(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			delete(h.entries, queueURL)
+		}
+	}
+}
+
+// contentDeduplicationID mirrors the ID SQS derives when content-based deduplication is enabled.
+func contentDeduplicationID(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(sum[:])
+}
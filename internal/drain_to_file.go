@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// maxDrainToFileMessages bounds how many messages one drain to file removes.
+const maxDrainToFileMessages = 100_000
+
+// drainedMessage is one line of a drain file. It keeps what is needed to send the message again.
+type drainedMessage struct {
+	MessageID              string            `json:"messageId"`
+	Body                   string            `json:"body"`
+	Attributes             map[string]string `json:"attributes,omitempty"`
+	AttributeTypes         map[string]string `json:"attributeTypes,omitempty"`
+	MessageGroupID         string            `json:"messageGroupId,omitempty"`
+	MessageDeduplicationID string            `json:"messageDeduplicationId,omitempty"`
+	SentTimestamp          string            `json:"sentTimestamp,omitempty"`
+	ReceiveCount           int32             `json:"receiveCount"`
+}
+
+// StartDrainToFile empties a queue in the background, writing every message to a newline-delimited
+// JSON file before deleting it, to archive the output of a test run. The file is offered as a job
+// download, also when the job fails part way, since the messages in it are already gone. Queues
+// the queue policy protects are refused before anything is received.
+func (s *SqsServiceImpl) StartDrainToFile(ctx context.Context, queueURL string) (Job, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return Job{}, errors.New("queue url is required")
+	}
+	if err := s.checkBulkDelete(queueURL); err != nil {
+		return Job{}, err
+	}
+
+	return s.jobs.start(ctx, "drain-to-file", queueURL, func(ctx context.Context, progress *JobProgress) error {
+		if stats, err := s.repo.GetQueueStats(ctx, queueURL); err == nil {
+			progress.SetTotal(stats.MessagesAvailable)
+		}
+
+		file, err := os.CreateTemp("", "sqs-gui-drain-*.ndjson")
+		if err != nil {
+			return errors.Wrap(err, "failed to create the drain file")
+		}
+		progress.SetFile(file.Name())
+
+		_, drainErr := s.drainToFile(ctx, queueURL, file, progress)
+		if err := file.Close(); err != nil && drainErr == nil {
+			return errors.Wrap(err, "failed to close the drain file")
+		}
+		return drainErr
+	})
+}
+
+// drainToFile writes each batch to file and syncs it before the batch is deleted, so a message is
+// never removed from the queue without being on disk. It returns how many messages it removed.
+func (s *SqsServiceImpl) drainToFile(ctx context.Context, queueURL string, file *os.File, progress *JobProgress) (int, error) {
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	drained := 0
+	summary := func() string {
+		return fmt.Sprintf("Drained %d messages to the file.", drained)
+	}
+
+	for drained < maxDrainToFileMessages {
+		progress.SetMessage(summary())
+		messages, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+			QueueURL:        queueURL,
+			MaxMessages:     migrationReceiveBatch,
+			WaitTimeSeconds: migrationReceiveWait,
+		})
+		if err != nil {
+			return drained, errors.Wrap(err, summary())
+		}
+		if len(messages) == 0 {
+			break
+		}
+
+		for _, message := range messages {
+			if err := encoder.Encode(newDrainedMessage(message)); err != nil {
+				return drained, errors.Wrap(err, "failed to write the drain file")
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			return drained, errors.Wrap(err, "failed to write the drain file")
+		}
+		if err := file.Sync(); err != nil {
+			return drained, errors.Wrap(err, "failed to write the drain file")
+		}
+
+		for _, message := range messages {
+			if err := s.repo.DeleteMessage(ctx, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: message.ReceiptHandle, Bulk: true}); err != nil {
+				// The message is already in the file; it stays in the queue as well.
+				return drained, errors.Wrapf(err, "%s Message %s was written but could not be deleted", summary(), message.ID)
+			}
+			drained++
+			progress.Advance(1)
+		}
+	}
+
+	progress.SetMessage(summary())
+	return drained, nil
+}
+
+func newDrainedMessage(message ReceivedMessage) drainedMessage {
+	return drainedMessage{
+		MessageID:              message.ID,
+		Body:                   message.Body,
+		Attributes:             customMessageAttributes(message),
+		AttributeTypes:         customMessageAttributeTypes(message),
+		MessageGroupID:         messageAttributeValue(message, "MessageGroupId"),
+		MessageDeduplicationID: messageAttributeValue(message, "MessageDeduplicationId"),
+		SentTimestamp:          messageAttributeValue(message, "SentTimestamp"),
+		ReceiveCount:           message.ReceiveCount,
+	}
+}
@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+type drainToFilePageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	QueueName    string
+	EscapedURL   string
+	JobID        string
+	// Purge is set when the job purges the queue once the messages are archived.
+	Purge bool
+}
+
+// DrainToFileHandler renders the form that empties a queue into a downloadable file. With ?job= it
+// also follows a drain or purge with backup of the queue that was started elsewhere.
+func (h *HandlerImpl) DrainToFileHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	data := newDrainToFilePageData(queueURL)
+	if id := r.URL.Query().Get("job"); id != "" {
+		job, err := h.s.Job(r.Context(), id)
+		if err == nil && job.QueueURL == queueURL && (job.Kind == "drain-to-file" || job.Kind == "purge-with-backup") {
+			data.JobID = job.ID
+			data.Purge = job.Kind == "purge-with-backup"
+		}
+	}
+	h.renderDrainToFile(w, http.StatusOK, data)
+}
+
+// PostDrainToFileHandler starts draining a queue to a file, and with purge set purging it once the
+// messages are archived. It removes every message, so it has to be confirmed by typing the queue
+// name, like a purge.
+func (h *HandlerImpl) PostDrainToFileHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	data := newDrainToFilePageData(queueURL)
+	if err := checkConfirmName(r, queueURL); err != nil {
+		data.ErrorMessage = err.Error()
+		h.renderDrainToFile(w, http.StatusBadRequest, data)
+		return
+	}
+
+	data.Purge = r.PostForm.Get("purge") != ""
+	start := h.s.StartDrainToFile
+	if data.Purge {
+		start = h.s.StartPurgeWithBackup
+	}
+	job, err := start(r.Context(), queueURL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start drain to file", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderDrainToFile(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.JobID = job.ID
+	h.renderDrainToFile(w, http.StatusOK, data)
+}
+
+func newDrainToFilePageData(queueURL string) drainToFilePageData {
+	return drainToFilePageData{
+		Title:      "Drain to file",
+		ViteTags:   fragments["assets/js/drain_to_file.ts"].Tags,
+		QueueName:  extractQueueName(queueURL),
+		EscapedURL: url.QueryEscape(queueURL),
+	}
+}
+
+func (h *HandlerImpl) renderDrainToFile(w http.ResponseWriter, status int, data drainToFilePageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["drain-to-file"].Execute(w, data); err != nil {
+		slog.Error("failed to render drain-to-file template", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_PostDrainToFileHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/drain-to-file", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("starts the drain", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured drainToFilePageData
+		captureTemplate(t, "drain-to-file", func(data drainToFilePageData) { captured = data })
+		installFragment(t, "assets/js/drain_to_file.ts", "")
+
+		mockService.EXPECT().StartDrainToFile(mock.Anything, queueURL).Return(Job{ID: "job-1"}, nil).Once()
+
+		handler.PostDrainToFileHandler(rr, newRequest(url.Values{"confirm_name": {"orders"}}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "job-1", captured.JobID)
+	})
+
+	t.Run("purges once the messages are archived", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured drainToFilePageData
+		captureTemplate(t, "drain-to-file", func(data drainToFilePageData) { captured = data })
+		installFragment(t, "assets/js/drain_to_file.ts", "")
+
+		mockService.EXPECT().StartPurgeWithBackup(mock.Anything, queueURL).Return(Job{ID: "job-1"}, nil).Once()
+
+		handler.PostDrainToFileHandler(rr, newRequest(url.Values{"confirm_name": {"orders"}, "purge": {"on"}}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "job-1", captured.JobID)
+		assert.True(t, captured.Purge)
+	})
+
+	t.Run("requires the queue name", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured drainToFilePageData
+		captureTemplate(t, "drain-to-file", func(data drainToFilePageData) { captured = data })
+		installFragment(t, "assets/js/drain_to_file.ts", "")
+
+		handler.PostDrainToFileHandler(rr, newRequest(url.Values{"confirm_name": {"other"}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "type the queue name to confirm", captured.ErrorMessage)
+		assert.Empty(t, captured.JobID)
+	})
+}
+
+func TestHandlerImpl_DrainToFileHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(job string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/drain-to-file?job="+job, nil)
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("follows a purge with backup of the queue", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		var captured drainToFilePageData
+		captureTemplate(t, "drain-to-file", func(data drainToFilePageData) { captured = data })
+		installFragment(t, "assets/js/drain_to_file.ts", "")
+
+		mockService.EXPECT().Job(mock.Anything, "job-1").Return(Job{ID: "job-1", Kind: "purge-with-backup", QueueURL: queueURL}, nil).Once()
+
+		handler.DrainToFileHandler(httptest.NewRecorder(), newRequest("job-1"))
+
+		assert.Equal(t, "job-1", captured.JobID)
+		assert.True(t, captured.Purge)
+	})
+
+	t.Run("ignores the jobs of other queues and kinds", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		var captured drainToFilePageData
+		captureTemplate(t, "drain-to-file", func(data drainToFilePageData) { captured = data })
+		installFragment(t, "assets/js/drain_to_file.ts", "")
+
+		mockService.EXPECT().Job(mock.Anything, "job-2").Return(Job{ID: "job-2", Kind: "purge-with-backup", QueueURL: "https://sqs.local/other"}, nil).Once()
+		mockService.EXPECT().Job(mock.Anything, "job-3").Return(Job{ID: "job-3", Kind: "purge", QueueURL: queueURL}, nil).Once()
+
+		handler.DrainToFileHandler(httptest.NewRecorder(), newRequest("job-2"))
+		assert.Empty(t, captured.JobID)
+
+		handler.DrainToFileHandler(httptest.NewRecorder(), newRequest("job-3"))
+		assert.Empty(t, captured.JobID)
+	})
+}
@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_StartDrainToFile(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders.fifo"
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository) {
+		t.Setenv("TMPDIR", t.TempDir())
+		repo := NewMockSqsRepository(t)
+		repo.EXPECT().GetQueueStats(mock.Anything, queueURL).Return(QueueStats{MessagesAvailable: 2}, nil).Once()
+		return &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}, repo
+	}
+
+	t.Run("writes every message before deleting it", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "m-1", Body: "one", ReceiptHandle: "r-1", ReceiveCount: 1, Attributes: []MessageAttribute{
+				{Name: "kind", Value: "order"},
+				{Name: "total", Value: "12.5", DataType: "Number"},
+				{Name: "MessageGroupId", Value: "customer-7"},
+			}},
+			{ID: "m-2", Body: "two", ReceiptHandle: "r-2", ReceiveCount: 2},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		for _, handle := range []string{"r-1", "r-2"} {
+			repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: handle, Bulk: true}).Return(nil).Once()
+		}
+
+		job, err := service.StartDrainToFile(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, "drain-to-file", job.Kind)
+
+		service.jobs.wg.Wait()
+		job, file, err := service.OpenJobFile(ctx, job.ID)
+		require.NoError(t, err)
+		defer func() { _ = file.Close() }()
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(2), job.Done)
+		assert.Equal(t, "Drained 2 messages to the file.", job.Message)
+
+		content, err := os.ReadFile(job.File)
+		require.NoError(t, err)
+		assert.Equal(t,
+			`{"messageId":"m-1","body":"one","attributes":{"kind":"order","total":"12.5"},"attributeTypes":{"total":"Number"},"messageGroupId":"customer-7","receiveCount":1}`+"\n"+
+				`{"messageId":"m-2","body":"two","receiveCount":2}`+"\n",
+			string(content))
+	})
+
+	t.Run("keeps the file when deleting fails", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{{ID: "m-1", Body: "one", ReceiptHandle: "r-1"}}, nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, mock.Anything).Return(errors.New("denied")).Once()
+
+		job, err := service.StartDrainToFile(ctx, queueURL)
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, file, err := service.OpenJobFile(ctx, job.ID)
+		require.NoError(t, err)
+		defer func() { _ = file.Close() }()
+		assert.Equal(t, JobStatusFailed, job.Status)
+		assert.Equal(t, "Drained 0 messages to the file. Message m-1 was written but could not be deleted: denied", job.Error)
+	})
+
+	t.Run("refuses a protected queue before receiving anything", func(t *testing.T) {
+		service := &SqsServiceImpl{
+			repo:   NewMockSqsRepository(t),
+			jobs:   newJobRegistry(),
+			config: ServiceConfig{QueuePolicy: QueuePolicy{Protect: []string{"orders*"}}},
+		}
+
+		_, err := service.StartDrainToFile(ctx, queueURL)
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+	})
+}
+
+func TestSqsServiceImpl_OpenJobFile(t *testing.T) {
+	ctx := context.Background()
+	service := &SqsServiceImpl{jobs: newJobRegistry()}
+	service.jobs.jobs["running"] = &Job{ID: "running", Status: JobStatusRunning, File: "/tmp/x"}
+	service.jobs.jobs["plain"] = &Job{ID: "plain", Status: JobStatusSucceeded}
+
+	_, _, err := service.OpenJobFile(ctx, "missing")
+	assert.ErrorIs(t, err, ErrJobNotFound)
+	_, _, err = service.OpenJobFile(ctx, "running")
+	assert.ErrorIs(t, err, ErrJobFileUnavailable)
+	_, _, err = service.OpenJobFile(ctx, "plain")
+	assert.ErrorIs(t, err, ErrJobFileUnavailable)
+}
@@ -0,0 +1,213 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// emailReportTracker remembers when the email report last went out. It starts when the process
+// does, so a restart neither resends a report nor replays the ones missed while it was down.
+type emailReportTracker struct {
+	mu         sync.Mutex
+	lastSentAt time.Time
+}
+
+// EmailReport summarises the watched queues and the alerts of one report period.
+type EmailReport struct {
+	Since  time.Time
+	Until  time.Time
+	Queues []EmailReportQueue
+	// Firing are the alert rules firing when the report was built.
+	Firing []AlertRuleState
+	// Fired are the alert rules that started firing during the period, oldest first.
+	Fired []AlertFiring
+}
+
+// EmailReportQueue is one watched queue of an email report. Error is set when SQS no longer lists
+// it. DeadLetterQueue is the queue its redrive policy moves failed messages to, nil when there is
+// no policy or the target is not listed, and DeadLetterSources name the queues redriving into it.
+type EmailReportQueue struct {
+	QueueSummary
+	Error             string
+	DeadLetterQueue   *QueueSummary
+	DeadLetterSources []string
+}
+
+// SendDueEmailReport mails the summary of watched queues to the configured recipients once the
+// report schedule comes due. A failed delivery is retried on the next call.
+func (s *SqsServiceImpl) SendDueEmailReport(ctx context.Context) error {
+	cfg := s.config.EmailReport
+	if s.mailer == nil || !cfg.Enabled() {
+		return nil
+	}
+	schedule, err := parseCron(cfg.Schedule)
+	if err != nil {
+		return err
+	}
+
+	s.emailReports.mu.Lock()
+	defer s.emailReports.mu.Unlock()
+
+	now := s.now()
+	if now.Before(schedule.Next(s.emailReports.lastSentAt)) {
+		return nil
+	}
+
+	report, err := s.emailReport(ctx, s.emailReports.lastSentAt, now)
+	if err != nil {
+		return err
+	}
+	message := EmailMessage{To: cfg.Recipients, Subject: report.Subject(), Body: report.Text()}
+	if err := s.mailer.Send(ctx, message); err != nil {
+		return errors.Wrap(err, "failed to send email report")
+	}
+
+	s.emailReports.lastSentAt = now
+	return nil
+}
+
+// emailReport builds the report of the period from since to until. The queues are those whose
+// attribute history is recorded, in name order.
+func (s *SqsServiceImpl) emailReport(ctx context.Context, since, until time.Time) (EmailReport, error) {
+	report := EmailReport{Since: since, Until: until, Fired: s.alertFiringsSince(since)}
+
+	rules, err := s.AlertRules(ctx)
+	if err != nil {
+		return EmailReport{}, err
+	}
+	for _, state := range rules {
+		if state.Status == AlertStatusFiring {
+			report.Firing = append(report.Firing, state)
+		}
+	}
+
+	if s.store == nil {
+		return report, nil
+	}
+	histories, err := s.store.AttributeHistories()
+	if err != nil {
+		return EmailReport{}, err
+	}
+	if len(histories) == 0 {
+		return report, nil
+	}
+
+	queues, err := s.Queues(ctx)
+	if err != nil {
+		return EmailReport{}, err
+	}
+	byURL := make(map[string]QueueSummary, len(queues))
+	byArn := make(map[string]QueueSummary, len(queues))
+	for _, queue := range queues {
+		byURL[queue.URL] = queue
+		if queue.Arn != "" {
+			byArn[queue.Arn] = queue
+		}
+	}
+
+	for _, history := range histories {
+		queue, ok := byURL[history.QueueURL]
+		if !ok {
+			report.Queues = append(report.Queues, EmailReportQueue{
+				QueueSummary: QueueSummary{URL: history.QueueURL, Name: extractQueueName(history.QueueURL)},
+				Error:        "queue not found",
+			})
+			continue
+		}
+
+		entry := EmailReportQueue{QueueSummary: queue}
+		if queue.RedrivePolicy != nil {
+			if target, ok := byArn[queue.RedrivePolicy.DeadLetterTargetArn]; ok {
+				entry.DeadLetterQueue = &target
+			}
+		}
+		if queue.Arn != "" {
+			for _, source := range queues {
+				if source.RedrivePolicy != nil && source.RedrivePolicy.DeadLetterTargetArn == queue.Arn {
+					entry.DeadLetterSources = append(entry.DeadLetterSources, source.Name)
+				}
+			}
+			slices.Sort(entry.DeadLetterSources)
+		}
+		report.Queues = append(report.Queues, entry)
+	}
+	slices.SortFunc(report.Queues, func(a, b EmailReportQueue) int { return strings.Compare(a.Name, b.Name) })
+
+	return report, nil
+}
+
+// emailReportTimeLayout is the time format of email reports, which are read outside the GUI and
+// so always name the time zone.
+const emailReportTimeLayout = "2006-01-02 15:04 MST"
+
+// Subject is the subject line of the report email.
+func (r EmailReport) Subject() string {
+	subject := "SQS queue report for " + r.Until.Format("2006-01-02")
+	if n := len(r.Firing); n > 0 {
+		subject += fmt.Sprintf(" (alerts firing: %d)", n)
+	}
+	return subject
+}
+
+// Text renders the report as the plain text body of the email.
+func (r EmailReport) Text() string {
+	var b strings.Builder
+
+	if r.Since.IsZero() {
+		fmt.Fprintf(&b, "Queue report as of %s.\n", r.Until.Format(emailReportTimeLayout))
+	} else {
+		fmt.Fprintf(&b, "Queue report from %s to %s.\n", r.Since.Format(emailReportTimeLayout), r.Until.Format(emailReportTimeLayout))
+	}
+
+	b.WriteString("\nWatched queues\n")
+	if len(r.Queues) == 0 {
+		b.WriteString("No queues are watched. Watch a queue from its attribute history page to include it here.\n")
+	}
+	for _, queue := range r.Queues {
+		if queue.Error != "" {
+			fmt.Fprintf(&b, "- %s: %s\n", queue.Name, queue.Error)
+			continue
+		}
+		fmt.Fprintf(&b, "- %s: %d available, %d in flight, %d delayed\n",
+			queue.Name, queue.MessagesAvailable, queue.MessagesInFlight, queue.MessagesDelayed)
+		switch {
+		case queue.DeadLetterQueue != nil:
+			fmt.Fprintf(&b, "  Dead-letter queue %s: %d available\n", queue.DeadLetterQueue.Name, queue.DeadLetterQueue.MessagesAvailable)
+		case queue.RedrivePolicy != nil:
+			fmt.Fprintf(&b, "  Dead-letter queue %s is not listed\n", queue.RedrivePolicy.DeadLetterTargetArn)
+		}
+		if len(queue.DeadLetterSources) > 0 {
+			fmt.Fprintf(&b, "  Dead-letter queue of %s\n", strings.Join(queue.DeadLetterSources, ", "))
+		}
+	}
+
+	b.WriteString("\nAlerts firing now\n")
+	if len(r.Firing) == 0 {
+		b.WriteString("None.\n")
+	}
+	for _, state := range r.Firing {
+		fmt.Fprintf(&b, "- %s: %s is %d on %s, firing since %s\n",
+			state.Rule.Name, state.Rule.Metric, state.Value, extractQueueName(state.Rule.QueueURL), state.Since.Format(emailReportTimeLayout))
+	}
+
+	b.WriteString("\nAlerts fired in this period\n")
+	if len(r.Fired) == 0 {
+		b.WriteString("None.\n")
+	}
+	for _, firing := range r.Fired {
+		fmt.Fprintf(&b, "- %s %s: %s reached %d on %s",
+			firing.At.Format(emailReportTimeLayout), firing.RuleName, firing.Metric, firing.Value, extractQueueName(firing.QueueURL))
+		if firing.Silenced {
+			b.WriteString(" (silenced)")
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
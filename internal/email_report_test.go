@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_SendDueEmailReport(t *testing.T) {
+	ctx := context.Background()
+	lastSentAt := time.Date(2024, time.April, 30, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, time.May, 1, 0, 0, 30, 0, time.UTC)
+	reportConfig := EmailReportConfig{
+		SMTP:       SMTPConfig{Addr: "smtp.local:25"},
+		From:       "sqs-gui@example.com",
+		Recipients: []string{"ops@example.com"},
+		Schedule:   "@daily",
+	}
+
+	const (
+		ordersURL = "https://sqs.local/000000000000/orders"
+		dlqURL    = "https://sqs.local/000000000000/orders-dlq"
+		goneURL   = "https://sqs.local/000000000000/gone"
+	)
+	queues := []QueueSummary{
+		{
+			URL: ordersURL, Name: "orders", Arn: "arn:aws:sqs:us-east-1:000000000000:orders", MessagesAvailable: 12, MessagesInFlight: 3,
+			RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", MaxReceiveCount: 5},
+		},
+		{URL: dlqURL, Name: "orders-dlq", Arn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", MessagesAvailable: 4},
+	}
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository, *MockMailer) {
+		t.Helper()
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		for _, queueURL := range []string{ordersURL, dlqURL, goneURL} {
+			require.NoError(t, store.SaveAttributeHistory(AttributeHistory{QueueURL: queueURL}))
+		}
+		require.NoError(t, store.SaveAlertRule(AlertRule{ID: "r1", Name: "orders backlog", QueueURL: ordersURL, Metric: AlertMetricMessagesAvailable, Threshold: 10}))
+
+		repo := NewMockSqsRepository(t)
+		mailer := NewMockMailer(t)
+		service := &SqsServiceImpl{
+			repo:         repo,
+			store:        store,
+			config:       ServiceConfig{EmailReport: reportConfig},
+			mailer:       mailer,
+			clock:        func() time.Time { return now },
+			alerts:       newAlertTracker(),
+			emailReports: &emailReportTracker{lastSentAt: lastSentAt},
+		}
+		service.alerts.states["r1"] = AlertRuleState{Status: AlertStatusFiring, Value: 12, Since: now.Add(-2 * time.Hour)}
+		service.alerts.fired = []AlertFiring{
+			{RuleID: "r1", RuleName: "orders backlog", QueueURL: ordersURL, Metric: AlertMetricMessagesAvailable, Value: 9, At: lastSentAt.Add(-time.Hour)},
+			{RuleID: "r1", RuleName: "orders backlog", QueueURL: ordersURL, Metric: AlertMetricMessagesAvailable, Value: 11, At: now.Add(-2 * time.Hour), Silenced: true},
+		}
+		return service, repo, mailer
+	}
+
+	t.Run("mails the watched queues and alerts once due", func(t *testing.T) {
+		service, repo, mailer := newService(t)
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues, nil).Once()
+		mailer.EXPECT().Send(mock.Anything, EmailMessage{
+			To:      []string{"ops@example.com"},
+			Subject: "SQS queue report for 2024-05-01 (alerts firing: 1)",
+			Body: `Queue report from 2024-04-30 00:00 UTC to 2024-05-01 00:00 UTC.
+
+Watched queues
+- gone: queue not found
+- orders: 12 available, 3 in flight, 0 delayed
+  Dead-letter queue orders-dlq: 4 available
+- orders-dlq: 4 available, 0 in flight, 0 delayed
+  Dead-letter queue of orders
+
+Alerts firing now
+- orders backlog: messages_available is 12 on orders, firing since 2024-04-30 22:00 UTC
+
+Alerts fired in this period
+- 2024-04-30 22:00 UTC orders backlog: messages_available reached 11 on orders (silenced)
+`,
+		}).Return(nil).Once()
+
+		require.NoError(t, service.SendDueEmailReport(ctx))
+		assert.Equal(t, now, service.emailReports.lastSentAt)
+
+		// The next report is due the following midnight.
+		require.NoError(t, service.SendDueEmailReport(ctx))
+	})
+
+	t.Run("retries a failed delivery", func(t *testing.T) {
+		service, repo, mailer := newService(t)
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues, nil).Twice()
+		mailer.EXPECT().Send(mock.Anything, mock.Anything).Return(errors.New("connection refused")).Once()
+		mailer.EXPECT().Send(mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := service.SendDueEmailReport(ctx)
+		assert.EqualError(t, err, "failed to send email report: connection refused")
+		assert.Equal(t, lastSentAt, service.emailReports.lastSentAt)
+
+		require.NoError(t, service.SendDueEmailReport(ctx))
+		assert.Equal(t, now, service.emailReports.lastSentAt)
+	})
+
+	t.Run("waits for the schedule", func(t *testing.T) {
+		service, _, _ := newService(t)
+		service.emailReports.lastSentAt = now.Add(-10 * time.Second)
+
+		require.NoError(t, service.SendDueEmailReport(ctx))
+	})
+
+	t.Run("does nothing without recipients", func(t *testing.T) {
+		service := &SqsServiceImpl{}
+
+		require.NoError(t, service.SendDueEmailReport(ctx))
+	})
+}
@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/cockroachdb/errors"
+)
+
+// Kinds of SQS endpoint the GUI can talk to.
+const (
+	EndpointAWS           = "aws"
+	EndpointElasticMQ     = "elasticmq"
+	EndpointLocalStack    = "localstack"
+	EndpointSQSCompatible = "sqs-compatible"
+)
+
+const (
+	// endpointProbeTimeout bounds each HTTP request made to identify the endpoint.
+	endpointProbeTimeout = 2 * time.Second
+	// endpointTagProbeTimeout bounds the SQS calls that check for tag support.
+	endpointTagProbeTimeout = 5 * time.Second
+	// endpointProbeRetry is how long an inconclusive probe is reused before the endpoint is
+	// probed again, so an endpoint that was still starting up is recognised later.
+	endpointProbeRetry = time.Minute
+)
+
+// EndpointCapabilities describes the SQS endpoint and the features it supports, so pages can hide
+// what the endpoint would reject instead of failing on it.
+type EndpointCapabilities struct {
+	Kind     string `json:"kind"`
+	Endpoint string `json:"endpoint,omitempty"`
+	// Tags reports whether the endpoint implements queue tags.
+	Tags bool `json:"tags"`
+	// CostEstimates reports whether the AWS list price applies to the requests made.
+	CostEstimates bool      `json:"costEstimates"`
+	CheckedAt     time.Time `json:"checkedAt"`
+}
+
+// capabilityCache keeps the probed capabilities. Conclusive results are kept for the life of the
+// process; inconclusive ones until endpointProbeRetry passes.
+type capabilityCache struct {
+	mu         sync.Mutex
+	caps       EndpointCapabilities
+	conclusive bool
+}
+
+// EndpointCapabilities identifies the configured endpoint and probes what it supports. Without
+// AWS_SQS_ENDPOINT, or with an amazonaws.com endpoint, the GUI talks to SQS and every feature is
+// available. Otherwise LocalStack is recognised by its health endpoint, ElasticMQ by the server
+// that answers, and tag support by listing the tags of a queue. Until a probe succeeds features
+// are assumed to be available.
+func (s *SqsServiceImpl) EndpointCapabilities(ctx context.Context) EndpointCapabilities {
+	s.capabilities.mu.Lock()
+	defer s.capabilities.mu.Unlock()
+
+	cached := s.capabilities.caps
+	if s.capabilities.conclusive || (!cached.CheckedAt.IsZero() && s.now().Sub(cached.CheckedAt) < endpointProbeRetry) {
+		return cached
+	}
+
+	caps, conclusive := s.probeEndpoint(ctx)
+	s.capabilities.caps = caps
+	s.capabilities.conclusive = conclusive
+	return caps
+}
+
+func (s *SqsServiceImpl) probeEndpoint(ctx context.Context) (EndpointCapabilities, bool) {
+	caps := EndpointCapabilities{
+		Kind:      EndpointAWS,
+		Endpoint:  s.config.Endpoint,
+		Tags:      true,
+		CheckedAt: s.now().UTC(),
+	}
+	if isAWSEndpoint(s.config.Endpoint) {
+		caps.CostEstimates = true
+		return caps, true
+	}
+
+	client := &http.Client{Timeout: endpointProbeTimeout}
+	kind, err := identifyEndpoint(ctx, client, s.config.Endpoint)
+	caps.Kind = kind
+	if err != nil {
+		// The SQS calls would only wait for their retries to run out.
+		return caps, false
+	}
+
+	tagsCtx, cancel := context.WithTimeout(ctx, endpointTagProbeTimeout)
+	defer cancel()
+	tags, err := s.probeTags(tagsCtx)
+	caps.Tags = tags
+
+	return caps, err == nil
+}
+
+// isAWSEndpoint reports whether requests go to SQS itself.
+func isAWSEndpoint(endpoint string) bool {
+	if endpoint == "" {
+		return true
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return strings.HasSuffix(host, ".amazonaws.com") || strings.HasSuffix(host, ".amazonaws.com.cn")
+}
+
+// identifyEndpoint tells LocalStack and ElasticMQ apart from other SQS compatible servers. An
+// error means the endpoint could not be reached, so the answer may change later.
+func identifyEndpoint(ctx context.Context, client *http.Client, endpoint string) (string, error) {
+	base := strings.TrimSuffix(endpoint, "/")
+
+	health, err := probeGet(ctx, client, base+"/_localstack/health")
+	if err != nil {
+		return EndpointSQSCompatible, err
+	}
+	defer func() { _ = health.Body.Close() }()
+	if health.StatusCode == http.StatusOK {
+		var body struct {
+			Services map[string]any `json:"services"`
+		}
+		if json.NewDecoder(health.Body).Decode(&body) == nil && body.Services != nil {
+			return EndpointLocalStack, nil
+		}
+	}
+
+	root, err := probeGet(ctx, client, base+"/")
+	if err != nil {
+		return EndpointSQSCompatible, err
+	}
+	_ = root.Body.Close()
+	// ElasticMQ runs on Pekko HTTP, Akka HTTP in older releases.
+	server := strings.ToLower(root.Header.Get("Server"))
+	for _, name := range []string{"elasticmq", "pekko-http", "akka-http"} {
+		if strings.Contains(server, name) {
+			return EndpointElasticMQ, nil
+		}
+	}
+	return EndpointSQSCompatible, nil
+}
+
+func probeGet(ctx context.Context, client *http.Client, target string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(request)
+}
+
+// probeTags lists the tags of the first queue. An error answer from the endpoint means tags are
+// not implemented; without a queue to ask, or when the call fails otherwise, tags are assumed to
+// work and the returned error asks for another probe later.
+func (s *SqsServiceImpl) probeTags(ctx context.Context) (bool, error) {
+	queues, err := s.repo.ListQueues(ctx)
+	if err != nil {
+		return true, err
+	}
+	if len(queues) == 0 {
+		return true, errors.New("there is no queue to probe tags with")
+	}
+
+	if _, err := s.repo.ListQueueTags(ctx, queues[0].URL); err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && !isQueueDoesNotExist(err) {
+			return false, nil
+		}
+		return true, err
+	}
+	return true, nil
+}
+
+// creatableTags drops tags the endpoint would reject when creating a queue.
+func (s *SqsServiceImpl) creatableTags(ctx context.Context, tags map[string]string) map[string]string {
+	if len(tags) == 0 || s.EndpointCapabilities(ctx).Tags {
+		return tags
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_EndpointCapabilities(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	queues := []QueueSummary{{URL: "http://localhost/000000000000/orders"}}
+
+	newService := func(t *testing.T, endpoint string) (*SqsServiceImpl, *MockSqsRepository, *time.Time) {
+		repo := NewMockSqsRepository(t)
+		clock := now
+		return &SqsServiceImpl{
+			repo:         repo,
+			config:       ServiceConfig{Endpoint: endpoint},
+			capabilities: &capabilityCache{},
+			clock:        func() time.Time { return clock },
+		}, repo, &clock
+	}
+	newServer := func(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	t.Run("supports everything on aws without probing", func(t *testing.T) {
+		for _, endpoint := range []string{"", "https://sqs.ap-northeast-1.amazonaws.com"} {
+			service, _, _ := newService(t, endpoint)
+
+			assert.Equal(t, EndpointCapabilities{
+				Kind:          EndpointAWS,
+				Endpoint:      endpoint,
+				Tags:          true,
+				CostEstimates: true,
+				CheckedAt:     now,
+			}, service.EndpointCapabilities(ctx))
+		}
+	})
+
+	t.Run("recognises localstack by its health endpoint", func(t *testing.T) {
+		server := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/_localstack/health" {
+				_, _ = w.Write([]byte(`{"services":{"sqs":"running"}}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+		service, repo, _ := newService(t, server.URL)
+
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, queues[0].URL).Return(map[string]string{}, nil).Once()
+
+		caps := service.EndpointCapabilities(ctx)
+		assert.Equal(t, EndpointCapabilities{Kind: EndpointLocalStack, Endpoint: server.URL, Tags: true, CheckedAt: now}, caps)
+		// A conclusive probe is not repeated.
+		assert.Equal(t, caps, service.EndpointCapabilities(ctx))
+	})
+
+	t.Run("recognises elasticmq without tag support", func(t *testing.T) {
+		server := newServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Server", "pekko-http/1.0.1")
+			w.WriteHeader(http.StatusNotFound)
+		})
+		service, repo, _ := newService(t, server.URL)
+
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, queues[0].URL).
+			Return(nil, errors.Wrap(&smithy.GenericAPIError{Code: "InvalidAction", Message: "not implemented"}, "failed to call ListQueueTags API")).
+			Once()
+
+		caps := service.EndpointCapabilities(ctx)
+		assert.Equal(t, EndpointElasticMQ, caps.Kind)
+		assert.False(t, caps.Tags)
+		assert.False(t, caps.CostEstimates)
+	})
+
+	t.Run("probes again after an inconclusive result", func(t *testing.T) {
+		server := newServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+		service, repo, clock := newService(t, server.URL)
+
+		repo.EXPECT().ListQueues(mock.Anything).Return(nil, nil).Once()
+
+		caps := service.EndpointCapabilities(ctx)
+		assert.Equal(t, EndpointCapabilities{Kind: EndpointSQSCompatible, Endpoint: server.URL, Tags: true, CheckedAt: now}, caps)
+		assert.Equal(t, caps, service.EndpointCapabilities(ctx))
+
+		*clock = now.Add(endpointProbeRetry)
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, queues[0].URL).Return(nil, nil).Once()
+
+		caps = service.EndpointCapabilities(ctx)
+		assert.Equal(t, now.Add(endpointProbeRetry), caps.CheckedAt)
+	})
+
+	t.Run("assumes support while the endpoint is unreachable", func(t *testing.T) {
+		server := newServer(t, func(http.ResponseWriter, *http.Request) {})
+		endpoint := server.URL
+		server.Close()
+		// The repository is not asked, since its calls would only time out.
+		service, _, _ := newService(t, endpoint)
+
+		caps := service.EndpointCapabilities(ctx)
+		assert.Equal(t, EndpointSQSCompatible, caps.Kind)
+		assert.True(t, caps.Tags)
+	})
+}
+
+func TestSqsServiceImpl_CreateQueue_SkipsUnsupportedTags(t *testing.T) {
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{
+		repo:         repo,
+		config:       ServiceConfig{DefaultTags: map[string]string{"created-by": "sqs-gui"}},
+		capabilities: &capabilityCache{conclusive: true, caps: EndpointCapabilities{Kind: EndpointElasticMQ}},
+	}
+
+	repo.EXPECT().
+		CreateQueue(mock.Anything, CreateQueueRepositoryInput{Name: "orders", Attributes: map[string]string{}}).
+		Return("http://localhost/000000000000/orders", nil).
+		Once()
+
+	_, err := service.CreateQueue(context.Background(), CreateQueueInput{Name: "orders"})
+	require.NoError(t, err)
+}
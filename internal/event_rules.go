@@ -0,0 +1,260 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// DefaultEventBus is the event bus rules are read from and created on when none is given.
+const DefaultEventBus = "default"
+
+// ErrEventBridgeUnavailable is returned by the EventBridge features when the service was built
+// without an EventBridge client.
+var ErrEventBridgeUnavailable = errors.New("EventBridge is not configured")
+
+// eventRuleNamePattern matches the names EventBridge accepts for rules.
+var eventRuleNamePattern = regexp.MustCompile(`^[.\-_A-Za-z0-9]{1,64}$`)
+
+// QueueEventRules lists the rules of an event bus that send events to a queue.
+type QueueEventRules struct {
+	QueueName string
+	QueueArn  string
+	EventBus  string
+	Rules     []EventRule
+}
+
+// CreateEventRuleInput describes a rule that sends the events matching EventPattern to a queue.
+// MessageGroupID is required for FIFO queues and ignored otherwise.
+type CreateEventRuleInput struct {
+	QueueURL       string
+	EventBus       string
+	Name           string
+	Description    string
+	EventPattern   string
+	MessageGroupID string
+}
+
+// QueueEventRules lists the rules of an event bus, the default one when eventBus is blank, that
+// have the queue as a target.
+func (s *SqsServiceImpl) QueueEventRules(ctx context.Context, queueURL, eventBus string) (QueueEventRules, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return QueueEventRules{}, errors.New("queue url is required")
+	}
+	if s.events == nil {
+		return QueueEventRules{}, ErrEventBridgeUnavailable
+	}
+	eventBus = normalizeEventBus(eventBus)
+	attributes, err := s.repo.GetQueueAttributes(ctx, queueURL, []string{"QueueArn"})
+	if err != nil {
+		return QueueEventRules{}, err
+	}
+	queueArn := attributes["QueueArn"]
+	rules, err := s.events.RulesTargeting(ctx, eventBus, queueArn)
+	if err != nil {
+		return QueueEventRules{}, err
+	}
+	return QueueEventRules{QueueName: extractQueueName(queueURL), QueueArn: queueArn, EventBus: eventBus, Rules: rules}, nil
+}
+
+// CreateEventRule creates an enabled EventBridge rule with the queue as its target, and adds the
+// statement EventBridge needs to the access policy of the queue: events.amazonaws.com may send
+// messages when the source is the new rule. Creating a rule again updates it and replaces its
+// statement. A queue whose access policy has errors is left alone, and no rule is created.
+func (s *SqsServiceImpl) CreateEventRule(ctx context.Context, input CreateEventRuleInput) (EventRule, error) {
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return EventRule{}, errors.New("queue url is required")
+	}
+	name := strings.TrimSpace(input.Name)
+	if !eventRuleNamePattern.MatchString(name) {
+		return EventRule{}, errors.New("rule name must be 1 to 64 letters, digits, periods, hyphens or underscores")
+	}
+	pattern, err := compactEventJSON(input.EventPattern, "event pattern")
+	if err != nil {
+		return EventRule{}, err
+	}
+	messageGroupID := strings.TrimSpace(input.MessageGroupID)
+	if !strings.HasSuffix(queueURL, ".fifo") {
+		messageGroupID = ""
+	} else if messageGroupID == "" {
+		return EventRule{}, errors.New("message group id is required for fifo queues")
+	}
+	if s.events == nil {
+		return EventRule{}, ErrEventBridgeUnavailable
+	}
+
+	detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+	if err != nil {
+		return EventRule{}, err
+	}
+	policy := detail.Attributes["Policy"]
+	if policy != "" {
+		for _, finding := range lintAccessPolicy(policy, detail.Arn) {
+			if finding.Severity == PolicyFindingError {
+				return EventRule{}, errors.Wrap(ErrInvalidAccessPolicy, "fix the access policy of the queue before adding an EventBridge rule")
+			}
+		}
+	}
+
+	rule := EventRule{
+		Name:         name,
+		EventBus:     normalizeEventBus(input.EventBus),
+		Description:  strings.TrimSpace(input.Description),
+		EventPattern: pattern,
+		State:        "ENABLED",
+	}
+	rule.Arn, err = s.events.PutRule(ctx, PutRuleRepositoryInput{
+		Name:         rule.Name,
+		EventBus:     rule.EventBus,
+		Description:  rule.Description,
+		EventPattern: rule.EventPattern,
+	})
+	if err != nil {
+		return EventRule{}, err
+	}
+
+	merged, err := withEventRuleStatement(policy, detail.Arn, name, rule.Arn)
+	if err != nil {
+		return EventRule{}, errors.Wrapf(err, "rule %s was created, but the queue policy could not be updated", name)
+	}
+	if err := s.repo.SetQueueAttributes(ctx, queueURL, map[string]string{"Policy": merged}); err != nil {
+		return EventRule{}, errors.Wrapf(err, "rule %s was created, but the queue policy could not be updated", name)
+	}
+
+	if err := s.events.PutQueueTarget(ctx, PutQueueTargetRepositoryInput{
+		Rule:           rule.Name,
+		EventBus:       rule.EventBus,
+		TargetID:       eventRuleTargetID(detail.Name),
+		QueueArn:       detail.Arn,
+		MessageGroupID: messageGroupID,
+	}); err != nil {
+		return EventRule{}, errors.Wrapf(err, "rule %s was created, but the queue could not be added as its target", name)
+	}
+
+	slog.InfoContext(ctx, "created EventBridge rule for queue",
+		slog.String("queue_url", queueURL),
+		slog.String("rule_arn", rule.Arn),
+	)
+	return rule, nil
+}
+
+// TestEventPattern asks EventBridge whether a sample event matches an event pattern.
+func (s *SqsServiceImpl) TestEventPattern(ctx context.Context, pattern, event string) (bool, error) {
+	pattern, err := compactEventJSON(pattern, "event pattern")
+	if err != nil {
+		return false, err
+	}
+	event, err = compactEventJSON(event, "sample event")
+	if err != nil {
+		return false, err
+	}
+	if s.events == nil {
+		return false, ErrEventBridgeUnavailable
+	}
+	return s.events.TestEventPattern(ctx, pattern, event)
+}
+
+func normalizeEventBus(eventBus string) string {
+	if eventBus = strings.TrimSpace(eventBus); eventBus == "" {
+		return DefaultEventBus
+	}
+	return eventBus
+}
+
+// compactEventJSON checks that raw is a JSON object and returns it without insignificant
+// whitespace. what names the value in errors.
+func compactEventJSON(raw, what string) (string, error) {
+	var object map[string]any
+	if strings.TrimSpace(raw) == "" {
+		return "", errors.Newf("%s is required", what)
+	}
+	if err := json.Unmarshal([]byte(raw), &object); err != nil || object == nil {
+		return "", errors.Newf("%s must be a JSON object", what)
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, []byte(raw)); err != nil {
+		return "", errors.Wrapf(err, "failed to compact %s", what)
+	}
+	return compact.String(), nil
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^A-Za-z0-9]`)
+
+// withEventRuleStatement adds the statement that lets the rule with the given ARN send events to
+// the queue to its access policy, and returns the compact policy. A statement left from an
+// earlier rule with the same ARN is replaced; the Sid is made unique among the other statements.
+// An empty policy becomes one with only the statement.
+func withEventRuleStatement(policy, queueArn, ruleName, ruleArn string) (string, error) {
+	document := map[string]any{"Version": "2012-10-17"}
+	if strings.TrimSpace(policy) != "" {
+		decoder := json.NewDecoder(strings.NewReader(policy))
+		decoder.UseNumber()
+		if err := decoder.Decode(&document); err != nil {
+			return "", errors.Wrap(err, "the access policy is not valid JSON")
+		}
+	}
+
+	var statements []any
+	switch existing := document["Statement"].(type) {
+	case []any:
+		statements = existing
+	case map[string]any:
+		statements = []any{existing}
+	}
+	merged := make([]any, 0, len(statements)+1)
+	sids := make(map[any]bool)
+	for _, existing := range statements {
+		object, _ := existing.(map[string]any)
+		if eventRuleSourceArn(object) == ruleArn {
+			continue
+		}
+		sids[object["Sid"]] = true
+		merged = append(merged, existing)
+	}
+
+	base := "EventBridge" + nonAlphanumeric.ReplaceAllString(ruleName, "")
+	sid := base
+	for n := 2; sids[sid]; n++ {
+		sid = base + strconv.Itoa(n)
+	}
+	document["Statement"] = append(merged, map[string]any{
+		"Sid":       sid,
+		"Effect":    "Allow",
+		"Principal": map[string]any{"Service": "events.amazonaws.com"},
+		"Action":    "sqs:SendMessage",
+		"Resource":  queueArn,
+		"Condition": map[string]any{"ArnEquals": map[string]any{"aws:SourceArn": ruleArn}},
+	})
+
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode the access policy")
+	}
+	return string(encoded), nil
+}
+
+// eventRuleSourceArn returns the aws:SourceArn an ArnEquals condition of a statement restricts it
+// to, if any.
+func eventRuleSourceArn(statement map[string]any) any {
+	condition, _ := statement["Condition"].(map[string]any)
+	arnEquals, _ := condition["ArnEquals"].(map[string]any)
+	return arnEquals["aws:SourceArn"]
+}
+
+// eventRuleTargetID is the ID of the target that sends a rule's events to a queue. Queue names only
+// use characters EventBridge allows in target IDs.
+func eventRuleTargetID(queueName string) string {
+	id := "sqs-gui-" + queueName
+	if len(id) > 64 {
+		id = id[:64]
+	}
+	return id
+}
@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type eventRulesPageData struct {
+	Title      string
+	ViteTags   template.HTML
+	QueueName  string
+	QueueArn   string
+	EscapedURL string
+	IsFIFO     bool
+	EventBus   string
+	Rules      []EventRule
+	Form       CreateEventRuleInput
+	// SampleEvent and PatternMatched hold the last pattern test; PatternMatched is nil before one.
+	SampleEvent    string
+	PatternMatched *bool
+	FlashMessage   string
+	ErrorMessage   string
+}
+
+// EventRulesHandler renders the EventBridge rules that send events to a queue on the event bus
+// in the bus query parameter, with a form to create one.
+func (h *HandlerImpl) EventRulesHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	query := r.URL.Query()
+	data := eventRulesPageData{Form: CreateEventRuleInput{QueueURL: queueURL, EventBus: query.Get("bus")}}
+	if created := query.Get("created"); created != "" {
+		data.FlashMessage = fmt.Sprintf("Rule %s was created and sends matching events to this queue.", created)
+	}
+	h.renderEventRules(w, r, http.StatusOK, data)
+}
+
+// PostEventRulesHandler creates the rule of the submitted form, or with action=test asks
+// EventBridge whether the sample event matches its pattern and shows the page again.
+func (h *HandlerImpl) PostEventRulesHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form submission", http.StatusBadRequest)
+		return
+	}
+
+	form := r.PostForm
+	input := CreateEventRuleInput{
+		QueueURL:       queueURL,
+		EventBus:       strings.TrimSpace(form.Get("event_bus")),
+		Name:           strings.TrimSpace(form.Get("name")),
+		Description:    strings.TrimSpace(form.Get("description")),
+		EventPattern:   form.Get("event_pattern"),
+		MessageGroupID: strings.TrimSpace(form.Get("message_group_id")),
+	}
+	data := eventRulesPageData{Form: input, SampleEvent: form.Get("sample_event")}
+
+	if form.Get("action") == "test" {
+		matched, err := h.s.TestEventPattern(r.Context(), input.EventPattern, data.SampleEvent)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "failed to test event pattern", slog.String("queue_url", queueURL), slog.Any("error", err))
+			data.ErrorMessage = err.Error()
+			h.renderEventRules(w, r, serviceErrorStatus(err), data)
+			return
+		}
+		data.PatternMatched = &matched
+		h.renderEventRules(w, r, http.StatusOK, data)
+		return
+	}
+
+	rule, err := h.s.CreateEventRule(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create EventBridge rule", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderEventRules(w, r, serviceErrorStatus(err), data)
+		return
+	}
+
+	query := url.Values{"created": {rule.Name}, "bus": {rule.EventBus}}
+	http.Redirect(w, r, "/queues/"+url.QueryEscape(queueURL)+"/event-rules?"+query.Encode(), http.StatusSeeOther)
+}
+
+// renderEventRules fills in the rules targeting the queue and renders the page. When they cannot
+// be listed, for example because the endpoint has no EventBridge, the page explains why instead.
+func (h *HandlerImpl) renderEventRules(w http.ResponseWriter, r *http.Request, status int, data eventRulesPageData) {
+	queueURL := data.Form.QueueURL
+	data.Title = "EventBridge rules"
+	data.ViteTags = fragments["assets/js/event_rules.ts"].Tags
+	data.QueueName = extractQueueName(queueURL)
+	data.EscapedURL = url.QueryEscape(queueURL)
+	data.IsFIFO = strings.HasSuffix(queueURL, ".fifo")
+
+	rules, err := h.s.QueueEventRules(r.Context(), queueURL, data.Form.EventBus)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list EventBridge rules", slog.String("queue_url", queueURL), slog.Any("error", err))
+		if data.ErrorMessage == "" {
+			data.ErrorMessage = "Failed to list the EventBridge rules of this queue: " + err.Error()
+			status = http.StatusInternalServerError
+		}
+		data.EventBus = normalizeEventBus(data.Form.EventBus)
+	} else {
+		data.QueueArn = rules.QueueArn
+		data.EventBus = rules.EventBus
+		data.Rules = rules.Rules
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["event-rules"].Execute(w, data); err != nil {
+		slog.Error("failed to render event-rules template", slog.Any("error", err))
+	}
+}
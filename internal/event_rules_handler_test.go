@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func captureEventRulesTemplate(t *testing.T, captured *eventRulesPageData) {
+	t.Helper()
+	captureTemplate(t, "event-rules", func(data eventRulesPageData) { *captured = data })
+	installFragment(t, "assets/js/event_rules.ts", "")
+}
+
+func TestHandlerImpl_EventRulesHandler(t *testing.T) {
+	queueURL := "https://sqs.local/000000000000/orders"
+	escaped := url.QueryEscape(queueURL)
+	rules := []EventRule{{Name: "order-placed", Arn: testRuleArn, EventBus: "audit", State: "ENABLED"}}
+
+	newRequest := func(query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/event-rules"+query, nil)
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("lists the rules of the requested event bus", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		var captured eventRulesPageData
+		captureEventRulesTemplate(t, &captured)
+		mockService.EXPECT().QueueEventRules(mock.Anything, queueURL, "audit").
+			Return(QueueEventRules{QueueName: "orders", QueueArn: testQueueArn, EventBus: "audit", Rules: rules}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.EventRulesHandler(rr, newRequest("?bus=audit&created=order-placed"))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "orders", captured.QueueName)
+		assert.Equal(t, testQueueArn, captured.QueueArn)
+		assert.Equal(t, "audit", captured.EventBus)
+		assert.Equal(t, rules, captured.Rules)
+		assert.Equal(t, "Rule order-placed was created and sends matching events to this queue.", captured.FlashMessage)
+	})
+
+	t.Run("explains why the rules cannot be listed", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		var captured eventRulesPageData
+		captureEventRulesTemplate(t, &captured)
+		mockService.EXPECT().QueueEventRules(mock.Anything, queueURL, "").Return(QueueEventRules{}, errors.New("failed to call ListRuleNamesByTarget API")).Once()
+
+		rr := httptest.NewRecorder()
+		handler.EventRulesHandler(rr, newRequest(""))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, "Failed to list the EventBridge rules of this queue: failed to call ListRuleNamesByTarget API", captured.ErrorMessage)
+		assert.Equal(t, DefaultEventBus, captured.EventBus)
+	})
+}
+
+func TestHandlerImpl_PostEventRulesHandler(t *testing.T) {
+	queueURL := "https://sqs.local/000000000000/orders"
+	escaped := url.QueryEscape(queueURL)
+	form := url.Values{
+		"event_bus":     {" audit "},
+		"name":          {" order-placed "},
+		"description":   {"Orders"},
+		"event_pattern": {`{"source":["shop"]}`},
+		"sample_event":  {`{"source":"shop"}`},
+	}
+	input := CreateEventRuleInput{QueueURL: queueURL, EventBus: "audit", Name: "order-placed", Description: "Orders", EventPattern: `{"source":["shop"]}`}
+
+	newRequest := func(action string) *http.Request {
+		values := url.Values{"action": {action}}
+		for key, value := range form {
+			values[key] = value
+		}
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/event-rules", strings.NewReader(values.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("creates the rule and redirects", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().CreateEventRule(mock.Anything, input).Return(EventRule{Name: "order-placed", EventBus: "audit"}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.PostEventRulesHandler(rr, newRequest("create"))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		want := url.Values{"created": {"order-placed"}, "bus": {"audit"}}
+		assert.Equal(t, "/queues/"+escaped+"/event-rules?"+want.Encode(), rr.Header().Get("Location"))
+	})
+
+	t.Run("shows whether the sample event matches", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		var captured eventRulesPageData
+		captureEventRulesTemplate(t, &captured)
+		mockService.EXPECT().TestEventPattern(mock.Anything, `{"source":["shop"]}`, `{"source":"shop"}`).Return(false, nil).Once()
+		mockService.EXPECT().QueueEventRules(mock.Anything, queueURL, "audit").Return(QueueEventRules{EventBus: "audit"}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.PostEventRulesHandler(rr, newRequest("test"))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		if assert.NotNil(t, captured.PatternMatched) {
+			assert.False(t, *captured.PatternMatched)
+		}
+		assert.Equal(t, input, captured.Form)
+		assert.Equal(t, `{"source":"shop"}`, captured.SampleEvent)
+	})
+
+	t.Run("keeps the form when the policy has errors", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		var captured eventRulesPageData
+		captureEventRulesTemplate(t, &captured)
+		err := errors.Wrap(ErrInvalidAccessPolicy, "fix the access policy of the queue before adding an EventBridge rule")
+		mockService.EXPECT().CreateEventRule(mock.Anything, input).Return(EventRule{}, err).Once()
+		mockService.EXPECT().QueueEventRules(mock.Anything, queueURL, "audit").Return(QueueEventRules{EventBus: "audit"}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.PostEventRulesHandler(rr, newRequest("create"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, err.Error(), captured.ErrorMessage)
+		assert.Equal(t, input, captured.Form)
+	})
+}
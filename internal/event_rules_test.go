@@ -0,0 +1,219 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testRuleArn = "arn:aws:events:us-east-1:000000000000:rule/order-placed"
+
+func TestWithEventRuleStatement(t *testing.T) {
+	statement := func(sid, ruleArn string) string {
+		return `{"Action":"sqs:SendMessage","Condition":{"ArnEquals":{"aws:SourceArn":"` + ruleArn + `"}},"Effect":"Allow","Principal":{"Service":"events.amazonaws.com"},"Resource":"` + testQueueArn + `","Sid":"` + sid + `"}`
+	}
+	sns := `{"Sid":"sns","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"` + testQueueArn + `"}`
+	tests := []struct {
+		name   string
+		policy string
+		want   string
+	}{
+		{
+			name: "creates a policy for a queue without one",
+			want: `{"Statement":[` + statement("EventBridgeorderplaced", testRuleArn) + `],"Version":"2012-10-17"}`,
+		},
+		{
+			name:   "keeps the other statements",
+			policy: `{"Version":"2012-10-17","Statement":` + sns + `}`,
+			want:   `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Resource":"` + testQueueArn + `","Sid":"sns"},` + statement("EventBridgeorderplaced", testRuleArn) + `],"Version":"2012-10-17"}`,
+		},
+		{
+			name:   "replaces the statement of the same rule",
+			policy: `{"Version":"2012-10-17","Statement":[` + statement("old", testRuleArn) + `]}`,
+			want:   `{"Statement":[` + statement("EventBridgeorderplaced", testRuleArn) + `],"Version":"2012-10-17"}`,
+		},
+		{
+			name:   "gives the statement a Sid no other statement uses",
+			policy: `{"Version":"2012-10-17","Statement":[` + statement("EventBridgeorderplaced", "arn:aws:events:us-east-1:000000000000:rule/audit/order-placed") + `]}`,
+			want: `{"Statement":[` + statement("EventBridgeorderplaced", "arn:aws:events:us-east-1:000000000000:rule/audit/order-placed") + `,` +
+				statement("EventBridgeorderplaced2", testRuleArn) + `],"Version":"2012-10-17"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := withEventRuleStatement(tt.policy, testQueueArn, "order-placed", testRuleArn)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, got)
+			for _, finding := range lintAccessPolicy(got, testQueueArn) {
+				assert.NotEqual(t, PolicyFindingError, finding.Severity, finding.Message)
+			}
+		})
+	}
+
+	t.Run("rejects a policy that is not JSON", func(t *testing.T) {
+		_, err := withEventRuleStatement(`{"Statement":`, testQueueArn, "order-placed", testRuleArn)
+		assert.Error(t, err)
+	})
+}
+
+func TestSqsServiceImpl_QueueEventRules(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+
+	t.Run("lists the rules of the default event bus", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		events := NewMockEventBridgeRepository(t)
+		service := &SqsServiceImpl{repo: repo, events: events}
+		rules := []EventRule{{Name: "order-placed", Arn: testRuleArn, EventBus: DefaultEventBus, State: "ENABLED"}}
+		repo.EXPECT().GetQueueAttributes(mock.Anything, queueURL, []string{"QueueArn"}).Return(map[string]string{"QueueArn": testQueueArn}, nil).Once()
+		events.EXPECT().RulesTargeting(mock.Anything, DefaultEventBus, testQueueArn).Return(rules, nil).Once()
+
+		got, err := service.QueueEventRules(ctx, queueURL, " ")
+		require.NoError(t, err)
+		assert.Equal(t, QueueEventRules{QueueName: "orders", QueueArn: testQueueArn, EventBus: DefaultEventBus, Rules: rules}, got)
+	})
+
+	t.Run("fails without an EventBridge client", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.QueueEventRules(ctx, queueURL, "")
+		assert.ErrorIs(t, err, ErrEventBridgeUnavailable)
+	})
+}
+
+func TestSqsServiceImpl_CreateEventRule(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+	detail := QueueDetail{QueueSummary: QueueSummary{URL: queueURL, Name: "orders", Arn: testQueueArn}, Attributes: map[string]string{}}
+
+	t.Run("creates the rule, allows it in the policy and targets the queue", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		events := NewMockEventBridgeRepository(t)
+		service := &SqsServiceImpl{repo: repo, events: events}
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(detail, nil).Once()
+		events.EXPECT().PutRule(mock.Anything, PutRuleRepositoryInput{
+			Name:         "order-placed",
+			EventBus:     DefaultEventBus,
+			Description:  "Orders",
+			EventPattern: `{"source":["shop"]}`,
+		}).Return(testRuleArn, nil).Once()
+		repo.EXPECT().SetQueueAttributes(mock.Anything, queueURL, mock.MatchedBy(func(attributes map[string]string) bool {
+			return eventRuleSourceArn(mustDecodeStatement(t, attributes["Policy"])) == testRuleArn
+		})).Return(nil).Once()
+		events.EXPECT().PutQueueTarget(mock.Anything, PutQueueTargetRepositoryInput{
+			Rule:     "order-placed",
+			EventBus: DefaultEventBus,
+			TargetID: "sqs-gui-orders",
+			QueueArn: testQueueArn,
+		}).Return(nil).Once()
+
+		rule, err := service.CreateEventRule(ctx, CreateEventRuleInput{
+			QueueURL:       queueURL,
+			Name:           " order-placed ",
+			Description:    "Orders",
+			EventPattern:   "{\n  \"source\": [\"shop\"]\n}",
+			MessageGroupID: "ignored",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, EventRule{
+			Name:         "order-placed",
+			Arn:          testRuleArn,
+			EventBus:     DefaultEventBus,
+			Description:  "Orders",
+			EventPattern: `{"source":["shop"]}`,
+			State:        "ENABLED",
+		}, rule)
+	})
+
+	t.Run("sends events to a fifo queue in the message group", func(t *testing.T) {
+		fifoURL := queueURL + ".fifo"
+		fifoDetail := QueueDetail{QueueSummary: QueueSummary{URL: fifoURL, Name: "orders.fifo", Arn: testQueueArn + ".fifo"}}
+		repo := NewMockSqsRepository(t)
+		events := NewMockEventBridgeRepository(t)
+		service := &SqsServiceImpl{repo: repo, events: events}
+		repo.EXPECT().GetQueueDetail(mock.Anything, fifoURL).Return(fifoDetail, nil).Once()
+		events.EXPECT().PutRule(mock.Anything, mock.Anything).Return(testRuleArn, nil).Once()
+		repo.EXPECT().SetQueueAttributes(mock.Anything, fifoURL, mock.Anything).Return(nil).Once()
+		events.EXPECT().PutQueueTarget(mock.Anything, PutQueueTargetRepositoryInput{
+			Rule:           "order-placed",
+			EventBus:       "audit",
+			TargetID:       "sqs-gui-orders.fifo",
+			QueueArn:       testQueueArn + ".fifo",
+			MessageGroupID: "orders",
+		}).Return(nil).Once()
+
+		_, err := service.CreateEventRule(ctx, CreateEventRuleInput{
+			QueueURL:       fifoURL,
+			EventBus:       "audit",
+			Name:           "order-placed",
+			EventPattern:   `{"source":["shop"]}`,
+			MessageGroupID: "orders",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("validates the input before calling AWS", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t), events: NewMockEventBridgeRepository(t)}
+		tests := []struct {
+			input CreateEventRuleInput
+			want  string
+		}{
+			{CreateEventRuleInput{QueueURL: queueURL, Name: "order placed", EventPattern: `{}`}, "rule name must be 1 to 64 letters, digits, periods, hyphens or underscores"},
+			{CreateEventRuleInput{QueueURL: queueURL, Name: "order-placed"}, "event pattern is required"},
+			{CreateEventRuleInput{QueueURL: queueURL, Name: "order-placed", EventPattern: `["shop"]`}, "event pattern must be a JSON object"},
+			{CreateEventRuleInput{QueueURL: queueURL + ".fifo", Name: "order-placed", EventPattern: `{}`}, "message group id is required for fifo queues"},
+		}
+		for _, tt := range tests {
+			_, err := service.CreateEventRule(ctx, tt.input)
+			assert.EqualError(t, err, tt.want)
+		}
+	})
+
+	t.Run("leaves a queue with an invalid policy alone", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, events: NewMockEventBridgeRepository(t)}
+		invalid := detail
+		invalid.Attributes = map[string]string{"Policy": `{"Version":"2012-10-17",}`}
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(invalid, nil).Once()
+
+		_, err := service.CreateEventRule(ctx, CreateEventRuleInput{QueueURL: queueURL, Name: "order-placed", EventPattern: `{}`})
+		assert.ErrorIs(t, err, ErrInvalidAccessPolicy)
+	})
+
+	t.Run("fails without an EventBridge client", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.CreateEventRule(ctx, CreateEventRuleInput{QueueURL: queueURL, Name: "order-placed", EventPattern: `{}`})
+		assert.ErrorIs(t, err, ErrEventBridgeUnavailable)
+	})
+}
+
+func TestSqsServiceImpl_TestEventPattern(t *testing.T) {
+	ctx := context.Background()
+	events := NewMockEventBridgeRepository(t)
+	service := &SqsServiceImpl{events: events}
+	events.EXPECT().TestEventPattern(mock.Anything, `{"source":["shop"]}`, `{"source":"shop"}`).Return(true, nil).Once()
+
+	matched, err := service.TestEventPattern(ctx, `{ "source": ["shop"] }`, `{ "source": "shop" }`)
+	require.NoError(t, err)
+	assert.True(t, matched)
+
+	_, err = service.TestEventPattern(ctx, `{"source":["shop"]}`, "")
+	assert.EqualError(t, err, "sample event is required")
+}
+
+// mustDecodeStatement returns the last statement of an access policy.
+func mustDecodeStatement(t *testing.T, policy string) map[string]any {
+	t.Helper()
+	var document struct {
+		Statement []map[string]any
+	}
+	require.NoError(t, json.Unmarshal([]byte(policy), &document))
+	require.NotEmpty(t, document.Statement)
+	return document.Statement[len(document.Statement)-1]
+}
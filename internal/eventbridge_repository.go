@@ -0,0 +1,156 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/cockroachdb/errors"
+)
+
+type eventBridgeAPI interface {
+	PutRule(ctx context.Context, params *eventbridge.PutRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutRuleOutput, error)
+	PutTargets(ctx context.Context, params *eventbridge.PutTargetsInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutTargetsOutput, error)
+	DescribeRule(ctx context.Context, params *eventbridge.DescribeRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DescribeRuleOutput, error)
+	ListRuleNamesByTarget(ctx context.Context, params *eventbridge.ListRuleNamesByTargetInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListRuleNamesByTargetOutput, error)
+	TestEventPattern(ctx context.Context, params *eventbridge.TestEventPatternInput, optFns ...func(*eventbridge.Options)) (*eventbridge.TestEventPatternOutput, error)
+}
+
+// EventBridgeRepository centralises access to the EventBridge APIs used to route events to queues.
+type EventBridgeRepository interface {
+	RulesTargeting(ctx context.Context, eventBus, targetArn string) ([]EventRule, error)
+	PutRule(ctx context.Context, input PutRuleRepositoryInput) (string, error)
+	PutQueueTarget(ctx context.Context, input PutQueueTargetRepositoryInput) error
+	TestEventPattern(ctx context.Context, pattern, event string) (bool, error)
+}
+
+// EventBridgeRepositoryImpl uses the AWS SDK to talk to EventBridge.
+type EventBridgeRepositoryImpl struct {
+	eventsClient eventBridgeAPI
+}
+
+// EventRule is an EventBridge rule with an event pattern.
+type EventRule struct {
+	Name         string
+	Arn          string
+	EventBus     string
+	Description  string
+	EventPattern string
+	// State is ENABLED or DISABLED.
+	State string
+}
+
+// PutRuleRepositoryInput carries the parameters of a PutRule call for an enabled rule.
+type PutRuleRepositoryInput struct {
+	Name         string
+	EventBus     string
+	Description  string
+	EventPattern string
+}
+
+// PutQueueTargetRepositoryInput adds a queue as the target of a rule. MessageGroupID is required
+// for FIFO queues.
+type PutQueueTargetRepositoryInput struct {
+	Rule           string
+	EventBus       string
+	TargetID       string
+	QueueArn       string
+	MessageGroupID string
+}
+
+// NewEventBridgeRepository creates a repository backed by the provided EventBridge client.
+func NewEventBridgeRepository(c eventBridgeAPI) EventBridgeRepository {
+	return &EventBridgeRepositoryImpl{eventsClient: c}
+}
+
+// RulesTargeting lists the rules of an event bus that have the resource with the given ARN as a
+// target.
+func (s *EventBridgeRepositoryImpl) RulesTargeting(ctx context.Context, eventBus, targetArn string) ([]EventRule, error) {
+	input := &eventbridge.ListRuleNamesByTargetInput{TargetArn: aws.String(targetArn), EventBusName: aws.String(eventBus)}
+	rules := make([]EventRule, 0)
+	for {
+		resp, err := s.eventsClient.ListRuleNamesByTarget(ctx, input)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call ListRuleNamesByTarget API")
+		}
+		for _, name := range resp.RuleNames {
+			rule, err := s.eventsClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{Name: aws.String(name), EventBusName: aws.String(eventBus)})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to call DescribeRule API")
+			}
+			rules = append(rules, EventRule{
+				Name:         aws.ToString(rule.Name),
+				Arn:          aws.ToString(rule.Arn),
+				EventBus:     eventBus,
+				Description:  aws.ToString(rule.Description),
+				EventPattern: aws.ToString(rule.EventPattern),
+				State:        string(rule.State),
+			})
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return rules, nil
+}
+
+// PutRule creates or updates an enabled rule and returns its ARN.
+func (s *EventBridgeRepositoryImpl) PutRule(ctx context.Context, input PutRuleRepositoryInput) (string, error) {
+	req := &eventbridge.PutRuleInput{
+		Name:         aws.String(input.Name),
+		EventBusName: aws.String(input.EventBus),
+		EventPattern: aws.String(input.EventPattern),
+		State:        types.RuleStateEnabled,
+	}
+	if input.Description != "" {
+		req.Description = aws.String(input.Description)
+	}
+
+	resp, err := s.eventsClient.PutRule(ctx, req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to call PutRule API")
+	}
+	return aws.ToString(resp.RuleArn), nil
+}
+
+// PutQueueTarget adds a queue as a target of a rule, or updates the target with the same ID.
+func (s *EventBridgeRepositoryImpl) PutQueueTarget(ctx context.Context, input PutQueueTargetRepositoryInput) error {
+	target := types.Target{Id: aws.String(input.TargetID), Arn: aws.String(input.QueueArn)}
+	if input.MessageGroupID != "" {
+		target.SqsParameters = &types.SqsParameters{MessageGroupId: aws.String(input.MessageGroupID)}
+	}
+
+	resp, err := s.eventsClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
+		Rule:         aws.String(input.Rule),
+		EventBusName: aws.String(input.EventBus),
+		Targets:      []types.Target{target},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to call PutTargets API")
+	}
+	if resp.FailedEntryCount > 0 {
+		failures := make([]string, 0, len(resp.FailedEntries))
+		for _, failed := range resp.FailedEntries {
+			failures = append(failures, fmt.Sprintf("%s: %s", aws.ToString(failed.ErrorCode), aws.ToString(failed.ErrorMessage)))
+		}
+		return errors.Newf("EventBridge did not add the queue as a target: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// TestEventPattern reports whether EventBridge matches the event against the pattern.
+func (s *EventBridgeRepositoryImpl) TestEventPattern(ctx context.Context, pattern, event string) (bool, error) {
+	resp, err := s.eventsClient.TestEventPattern(ctx, &eventbridge.TestEventPatternInput{
+		EventPattern: aws.String(pattern),
+		Event:        aws.String(event),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to call TestEventPattern API")
+	}
+	return resp.Result, nil
+}
@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBridgeRepositoryImpl_RulesTargeting(t *testing.T) {
+	ctx := context.Background()
+	api := newMockeventBridgeAPI(t)
+	repo := &EventBridgeRepositoryImpl{eventsClient: api}
+
+	api.EXPECT().ListRuleNamesByTarget(mock.Anything, &eventbridge.ListRuleNamesByTargetInput{TargetArn: aws.String(testQueueArn), EventBusName: aws.String("audit")}).
+		Return(&eventbridge.ListRuleNamesByTargetOutput{RuleNames: []string{"order-placed"}, NextToken: aws.String("page-2")}, nil).Once()
+	api.EXPECT().ListRuleNamesByTarget(mock.Anything, &eventbridge.ListRuleNamesByTargetInput{TargetArn: aws.String(testQueueArn), EventBusName: aws.String("audit"), NextToken: aws.String("page-2")}).
+		Return(&eventbridge.ListRuleNamesByTargetOutput{RuleNames: []string{"order-shipped"}}, nil).Once()
+	api.EXPECT().DescribeRule(mock.Anything, &eventbridge.DescribeRuleInput{Name: aws.String("order-placed"), EventBusName: aws.String("audit")}).
+		Return(&eventbridge.DescribeRuleOutput{
+			Name:         aws.String("order-placed"),
+			Arn:          aws.String(testRuleArn),
+			Description:  aws.String("Orders"),
+			EventPattern: aws.String(`{"source":["shop"]}`),
+			State:        types.RuleStateEnabled,
+		}, nil).Once()
+	api.EXPECT().DescribeRule(mock.Anything, &eventbridge.DescribeRuleInput{Name: aws.String("order-shipped"), EventBusName: aws.String("audit")}).
+		Return(&eventbridge.DescribeRuleOutput{Name: aws.String("order-shipped"), State: types.RuleStateDisabled}, nil).Once()
+
+	rules, err := repo.RulesTargeting(ctx, "audit", testQueueArn)
+	require.NoError(t, err)
+	assert.Equal(t, []EventRule{
+		{Name: "order-placed", Arn: testRuleArn, EventBus: "audit", Description: "Orders", EventPattern: `{"source":["shop"]}`, State: "ENABLED"},
+		{Name: "order-shipped", EventBus: "audit", State: "DISABLED"},
+	}, rules)
+}
+
+func TestEventBridgeRepositoryImpl_PutRule(t *testing.T) {
+	api := newMockeventBridgeAPI(t)
+	repo := &EventBridgeRepositoryImpl{eventsClient: api}
+
+	api.EXPECT().PutRule(mock.Anything, &eventbridge.PutRuleInput{
+		Name:         aws.String("order-placed"),
+		EventBusName: aws.String(DefaultEventBus),
+		EventPattern: aws.String(`{"source":["shop"]}`),
+		State:        types.RuleStateEnabled,
+	}).Return(&eventbridge.PutRuleOutput{RuleArn: aws.String(testRuleArn)}, nil).Once()
+
+	arn, err := repo.PutRule(context.Background(), PutRuleRepositoryInput{Name: "order-placed", EventBus: DefaultEventBus, EventPattern: `{"source":["shop"]}`})
+	require.NoError(t, err)
+	assert.Equal(t, testRuleArn, arn)
+}
+
+func TestEventBridgeRepositoryImpl_PutQueueTarget(t *testing.T) {
+	ctx := context.Background()
+	input := PutQueueTargetRepositoryInput{Rule: "order-placed", EventBus: DefaultEventBus, TargetID: "sqs-gui-orders.fifo", QueueArn: testQueueArn + ".fifo", MessageGroupID: "orders"}
+	request := &eventbridge.PutTargetsInput{
+		Rule:         aws.String("order-placed"),
+		EventBusName: aws.String(DefaultEventBus),
+		Targets: []types.Target{{
+			Id:            aws.String("sqs-gui-orders.fifo"),
+			Arn:           aws.String(testQueueArn + ".fifo"),
+			SqsParameters: &types.SqsParameters{MessageGroupId: aws.String("orders")},
+		}},
+	}
+
+	t.Run("adds the queue with its message group", func(t *testing.T) {
+		api := newMockeventBridgeAPI(t)
+		repo := &EventBridgeRepositoryImpl{eventsClient: api}
+		api.EXPECT().PutTargets(mock.Anything, request).Return(&eventbridge.PutTargetsOutput{}, nil).Once()
+
+		assert.NoError(t, repo.PutQueueTarget(ctx, input))
+	})
+
+	t.Run("reports the entries EventBridge rejected", func(t *testing.T) {
+		api := newMockeventBridgeAPI(t)
+		repo := &EventBridgeRepositoryImpl{eventsClient: api}
+		api.EXPECT().PutTargets(mock.Anything, request).Return(&eventbridge.PutTargetsOutput{
+			FailedEntryCount: 1,
+			FailedEntries:    []types.PutTargetsResultEntry{{ErrorCode: aws.String("ValidationException"), ErrorMessage: aws.String("bad target")}},
+		}, nil).Once()
+
+		assert.EqualError(t, repo.PutQueueTarget(ctx, input), "EventBridge did not add the queue as a target: ValidationException: bad target")
+	})
+}
+
+func TestEventBridgeRepositoryImpl_TestEventPattern(t *testing.T) {
+	api := newMockeventBridgeAPI(t)
+	repo := &EventBridgeRepositoryImpl{eventsClient: api}
+	api.EXPECT().TestEventPattern(mock.Anything, &eventbridge.TestEventPatternInput{EventPattern: aws.String(`{"source":["shop"]}`), Event: aws.String(`{"source":"shop"}`)}).
+		Return(&eventbridge.TestEventPatternOutput{Result: true}, nil).Once()
+
+	matched, err := repo.TestEventPattern(context.Background(), `{"source":["shop"]}`, `{"source":"shop"}`)
+	require.NoError(t, err)
+	assert.True(t, matched)
+}
@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Values of the DeduplicationScope and FifoThroughputLimit attributes of a FIFO queue. High
+// throughput mode is DeduplicationScopeMessageGroup with FifoThroughputLimitPerMessageGroupID.
+const (
+	DeduplicationScopeMessageGroup       = "messageGroup"
+	DeduplicationScopeQueue              = "queue"
+	FifoThroughputLimitPerQueue          = "perQueue"
+	FifoThroughputLimitPerMessageGroupID = "perMessageGroupId"
+)
+
+// FifoThroughput is the throughput mode of a FIFO queue. An empty field leaves the SQS default,
+// which is queue and perQueue.
+type FifoThroughput struct {
+	DeduplicationScope  string
+	FifoThroughputLimit string
+}
+
+// SetFifoThroughput changes the throughput mode of a FIFO queue. Both settings are required.
+func (s *SqsServiceImpl) SetFifoThroughput(ctx context.Context, queueURL string, throughput FifoThroughput) error {
+	if strings.TrimSpace(queueURL) == "" {
+		return errors.New("queue url is required")
+	}
+	if queueTypeOfName(extractQueueName(queueURL)) != QueueTypeFIFO {
+		return errors.New("throughput settings are only available for FIFO queues")
+	}
+	if throughput.DeduplicationScope == "" || throughput.FifoThroughputLimit == "" {
+		return errors.New("deduplication scope and throughput limit are required")
+	}
+	if err := validateFifoThroughput(throughput); err != nil {
+		return err
+	}
+
+	return s.repo.SetQueueAttributes(ctx, queueURL, map[string]string{
+		"DeduplicationScope":  throughput.DeduplicationScope,
+		"FifoThroughputLimit": throughput.FifoThroughputLimit,
+	})
+}
+
+// validateFifoThroughput checks the values of throughput. SQS only applies a per message group
+// limit when deduplication is per message group as well.
+func validateFifoThroughput(throughput FifoThroughput) error {
+	switch throughput.DeduplicationScope {
+	case "", DeduplicationScopeMessageGroup, DeduplicationScopeQueue:
+	default:
+		return errors.Newf("deduplication scope must be %s or %s", DeduplicationScopeMessageGroup, DeduplicationScopeQueue)
+	}
+	switch throughput.FifoThroughputLimit {
+	case "", FifoThroughputLimitPerQueue, FifoThroughputLimitPerMessageGroupID:
+	default:
+		return errors.Newf("throughput limit must be %s or %s", FifoThroughputLimitPerQueue, FifoThroughputLimitPerMessageGroupID)
+	}
+	if throughput.FifoThroughputLimit == FifoThroughputLimitPerMessageGroupID && throughput.DeduplicationScope != DeduplicationScopeMessageGroup {
+		return errors.Newf("a %s throughput limit requires the %s deduplication scope", FifoThroughputLimitPerMessageGroupID, DeduplicationScopeMessageGroup)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// PostFifoThroughputHandler handles the throughput form on the page of a FIFO queue, which
+// switches it between the default mode and high throughput.
+func (h *HandlerImpl) PostFifoThroughputHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form submission", http.StatusBadRequest)
+		return
+	}
+
+	throughput := FifoThroughput{
+		DeduplicationScope:  r.PostForm.Get("deduplication_scope"),
+		FifoThroughputLimit: r.PostForm.Get("fifo_throughput_limit"),
+	}
+	if err := h.s.SetFifoThroughput(r.Context(), queueURL, throughput); err != nil {
+		slog.ErrorContext(r.Context(), "failed to set fifo throughput", slog.String("queue_url", queueURL), slog.Any("error", err))
+		h.renderQueueError(w, r, queueURL, serviceErrorStatus(err), err)
+		return
+	}
+
+	redirectURL := fmt.Sprintf("/queues/%s?throughput=saved", url.QueryEscape(queueURL))
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
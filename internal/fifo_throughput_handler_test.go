@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_PostFifoThroughputHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders.fifo"
+	escaped := url.QueryEscape(queueURL)
+
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+	rr := httptest.NewRecorder()
+
+	form := url.Values{"deduplication_scope": {"messageGroup"}, "fifo_throughput_limit": {"perMessageGroupId"}}
+	req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/fifo-throughput", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("url", escaped)
+
+	mockService.EXPECT().
+		SetFifoThroughput(mock.Anything, queueURL, FifoThroughput{DeduplicationScope: "messageGroup", FifoThroughputLimit: "perMessageGroupId"}).
+		Return(nil).
+		Once()
+
+	handler.PostFifoThroughputHandler(rr, req)
+
+	assert.Equal(t, http.StatusSeeOther, rr.Code)
+	assert.Equal(t, "/queues/"+escaped+"?throughput=saved", rr.Header().Get("Location"))
+}
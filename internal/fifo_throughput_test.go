@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_SetFifoThroughput(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders.fifo"
+
+	t.Run("switches the queue to high throughput", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().
+			SetQueueAttributes(mock.Anything, queueURL, map[string]string{
+				"DeduplicationScope":  "messageGroup",
+				"FifoThroughputLimit": "perMessageGroupId",
+			}).
+			Return(nil).
+			Once()
+
+		err := service.SetFifoThroughput(ctx, queueURL, FifoThroughput{
+			DeduplicationScope:  DeduplicationScopeMessageGroup,
+			FifoThroughputLimit: FifoThroughputLimitPerMessageGroupID,
+		})
+		require.NoError(t, err)
+	})
+
+	testCases := []struct {
+		name       string
+		queueURL   string
+		throughput FifoThroughput
+		wantErr    string
+	}{
+		{name: "standard queue", queueURL: "https://sqs.local/000000000000/orders", throughput: FifoThroughput{DeduplicationScope: "queue", FifoThroughputLimit: "perQueue"}, wantErr: "throughput settings are only available for FIFO queues"},
+		{name: "missing setting", queueURL: queueURL, throughput: FifoThroughput{DeduplicationScope: "queue"}, wantErr: "deduplication scope and throughput limit are required"},
+		{name: "unknown scope", queueURL: queueURL, throughput: FifoThroughput{DeduplicationScope: "group", FifoThroughputLimit: "perQueue"}, wantErr: "deduplication scope must be messageGroup or queue"},
+		{name: "unknown limit", queueURL: queueURL, throughput: FifoThroughput{DeduplicationScope: "queue", FifoThroughputLimit: "perGroup"}, wantErr: "throughput limit must be perQueue or perMessageGroupId"},
+		{name: "per group limit with queue scope", queueURL: queueURL, throughput: FifoThroughput{DeduplicationScope: "queue", FifoThroughputLimit: "perMessageGroupId"}, wantErr: "a perMessageGroupId throughput limit requires the messageGroup deduplication scope"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+			err := service.SetFifoThroughput(ctx, tc.queueURL, tc.throughput)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
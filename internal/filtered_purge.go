@@ -0,0 +1,177 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// maxFilteredPurgeMessages bounds how many messages one filtered purge looks at.
+	maxFilteredPurgeMessages = 100_000
+	// maxFilteredPurgePreview is how many matching messages a dry run lists.
+	maxFilteredPurgePreview = 20
+	// filteredPurgePreviewChars is how much of a message body the preview shows.
+	filteredPurgePreviewChars = 200
+)
+
+// FilteredPurgeInput describes a selective purge: messages matching Filter are deleted and the
+// others are sent again. With DryRun set nothing is deleted or sent; the job only counts and lists
+// the messages that would be removed.
+type FilteredPurgeInput struct {
+	QueueURL string
+	Filter   MessageFilter
+	DryRun   bool
+}
+
+// StartFilteredPurge drains a queue in the background, deleting the messages that match the filter
+// and sending the others back. Sending them again, rather than leaving them in flight, keeps the
+// drain from pushing them towards the dead-letter queue. Queues the queue policy protects can only
+// be checked with a dry run.
+func (s *SqsServiceImpl) StartFilteredPurge(ctx context.Context, input FilteredPurgeInput) (Job, error) {
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return Job{}, errors.New("queue url is required")
+	}
+	matches, err := compileMessageFilter(input.Filter)
+	if err != nil {
+		return Job{}, err
+	}
+	if !input.DryRun {
+		if err := s.checkBulkDelete(queueURL); err != nil {
+			return Job{}, err
+		}
+	}
+
+	kind := "filtered-purge"
+	if input.DryRun {
+		kind = "filtered-purge-dry-run"
+	}
+	return s.jobs.start(ctx, kind, queueURL, func(ctx context.Context, progress *JobProgress) error {
+		if stats, err := s.repo.GetQueueStats(ctx, queueURL); err == nil {
+			progress.SetTotal(stats.MessagesAvailable)
+		}
+		if input.DryRun {
+			return s.previewFilteredPurge(ctx, queueURL, matches, progress)
+		}
+		return s.filteredPurge(ctx, queueURL, matches, progress)
+	})
+}
+
+// previewFilteredPurge receives every visible message once and lists the ones that match. The
+// received messages become visible again after the queue's visibility timeout.
+func (s *SqsServiceImpl) previewFilteredPurge(ctx context.Context, queueURL string, matches func(string) bool, progress *JobProgress) error {
+	seen := make(map[string]bool)
+	matched := 0
+	for len(seen) < maxFilteredPurgeMessages {
+		messages, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+			QueueURL:        queueURL,
+			MaxMessages:     migrationReceiveBatch,
+			WaitTimeSeconds: migrationReceiveWait,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "checked %d messages", len(seen))
+		}
+		if len(messages) == 0 {
+			break
+		}
+
+		for _, message := range messages {
+			if seen[message.ID] {
+				continue
+			}
+			seen[message.ID] = true
+			progress.Advance(1)
+			if !matches(message.Body) {
+				continue
+			}
+			matched++
+			if matched <= maxFilteredPurgePreview {
+				progress.AddDetail(fmt.Sprintf("%s: %s", message.ID, truncateRunes(message.Body, filteredPurgePreviewChars)))
+			}
+		}
+		progress.SetMessage(fmt.Sprintf("%d of %d messages match so far.", matched, len(seen)))
+	}
+
+	progress.SetMessage(fmt.Sprintf("%d of %d messages match and would be deleted. Nothing was changed; the checked messages become visible again after the visibility timeout.", matched, len(seen)))
+	return nil
+}
+
+// filteredPurge deletes matching messages and replaces the others with copies. Copies are told
+// apart by their body: the filter only looks at bodies, so any message with the body of a kept
+// message is kept as well and can be left alone.
+func (s *SqsServiceImpl) filteredPurge(ctx context.Context, queueURL string, matches func(string) bool, progress *JobProgress) error {
+	fifo := strings.HasSuffix(queueURL, ".fifo")
+	kept := make(map[[sha256.Size]byte]bool)
+	var processed, deleted, resent int64
+	summary := func() string {
+		return fmt.Sprintf("Deleted %d matching messages and sent %d others back.", deleted, resent)
+	}
+
+	idle := 0
+	for processed < maxFilteredPurgeMessages && idle < maxIdleSampleBatches {
+		progress.SetMessage(summary())
+		messages, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+			QueueURL:        queueURL,
+			MaxMessages:     migrationReceiveBatch,
+			WaitTimeSeconds: migrationReceiveWait,
+		})
+		if err != nil {
+			return errors.Wrap(err, summary())
+		}
+		if len(messages) == 0 {
+			break
+		}
+
+		fresh := 0
+		for _, message := range messages {
+			sum := sha256.Sum256([]byte(message.Body))
+			if kept[sum] {
+				continue
+			}
+			fresh++
+
+			if matches(message.Body) {
+				if err := s.repo.DeleteMessage(ctx, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: message.ReceiptHandle, Bulk: true}); err != nil {
+					return errors.Wrap(err, summary())
+				}
+				deleted++
+			} else {
+				send := SendMessageRepositoryInput{
+					QueueURL:       queueURL,
+					Body:           message.Body,
+					Attributes:     customMessageAttributes(message),
+					AttributeTypes: customMessageAttributeTypes(message),
+				}
+				if fifo {
+					send.MessageGroupID = messageAttributeValue(message, "MessageGroupId")
+					send.MessageDeduplicationID = message.ID
+				}
+				if err := s.repo.SendMessage(ctx, send); err != nil {
+					return errors.Wrap(err, summary())
+				}
+				kept[sum] = true
+				if err := s.repo.DeleteMessage(ctx, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: message.ReceiptHandle}); err != nil {
+					// The copy is already in the queue, so the original would be kept twice.
+					return errors.Wrapf(err, "%s Message %s was sent back but its original is still in the queue", summary(), message.ID)
+				}
+				resent++
+			}
+			processed++
+			progress.Advance(1)
+		}
+
+		// Batches of nothing but copies mean the original messages have all been seen.
+		if fresh == 0 {
+			idle++
+		} else {
+			idle = 0
+		}
+	}
+
+	progress.SetMessage(summary())
+	return nil
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type filteredPurgePageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	QueueName    string
+	EscapedURL   string
+	BodyPattern  string
+	JSONPath     string
+	JSONValue    string
+	DryRun       bool
+	JobID        string
+}
+
+// FilteredPurgeHandler renders the form that deletes only the messages matching a filter.
+func (h *HandlerImpl) FilteredPurgeHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	h.renderFilteredPurge(w, http.StatusOK, newFilteredPurgePageData(queueURL))
+}
+
+// PostFilteredPurgeHandler starts a filtered purge or its dry run. A real run has to be confirmed
+// by typing the queue name, like a full purge.
+func (h *HandlerImpl) PostFilteredPurgeHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	data := newFilteredPurgePageData(queueURL)
+	data.BodyPattern = r.FormValue("body_pattern")
+	data.JSONPath = strings.TrimSpace(r.FormValue("json_path"))
+	data.JSONValue = r.FormValue("json_value")
+	data.DryRun = r.FormValue("dry_run") == "on"
+
+	if !data.DryRun {
+		if err := checkConfirmName(r, queueURL); err != nil {
+			data.ErrorMessage = err.Error()
+			h.renderFilteredPurge(w, http.StatusBadRequest, data)
+			return
+		}
+	}
+
+	job, err := h.s.StartFilteredPurge(r.Context(), FilteredPurgeInput{
+		QueueURL: queueURL,
+		Filter: MessageFilter{
+			BodyPattern: data.BodyPattern,
+			JSONPath:    data.JSONPath,
+			JSONValue:   data.JSONValue,
+		},
+		DryRun: data.DryRun,
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start filtered purge", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderFilteredPurge(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.JobID = job.ID
+	h.renderFilteredPurge(w, http.StatusOK, data)
+}
+
+func newFilteredPurgePageData(queueURL string) filteredPurgePageData {
+	return filteredPurgePageData{
+		Title:      "Filtered purge",
+		ViteTags:   fragments["assets/js/filtered_purge.ts"].Tags,
+		QueueName:  extractQueueName(queueURL),
+		EscapedURL: url.QueryEscape(queueURL),
+		DryRun:     true,
+	}
+}
+
+func (h *HandlerImpl) renderFilteredPurge(w http.ResponseWriter, status int, data filteredPurgePageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["filtered-purge"].Execute(w, data); err != nil {
+		slog.Error("failed to render filtered-purge template", slog.Any("error", err))
+	}
+}
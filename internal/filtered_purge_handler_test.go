@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_PostFilteredPurgeHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/filtered-purge", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("starts a dry run without confirmation", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured filteredPurgePageData
+		captureTemplate(t, "filtered-purge", func(data filteredPurgePageData) { captured = data })
+		installFragment(t, "assets/js/filtered_purge.ts", "")
+
+		mockService.EXPECT().
+			StartFilteredPurge(mock.Anything, FilteredPurgeInput{
+				QueueURL: queueURL,
+				Filter:   MessageFilter{BodyPattern: "test-", JSONPath: "$.kind", JSONValue: "seed"},
+				DryRun:   true,
+			}).
+			Return(Job{ID: "job-1"}, nil).
+			Once()
+
+		handler.PostFilteredPurgeHandler(rr, newRequest(url.Values{
+			"body_pattern": {"test-"},
+			"json_path":    {" $.kind "},
+			"json_value":   {"seed"},
+			"dry_run":      {"on"},
+		}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "job-1", captured.JobID)
+		assert.True(t, captured.DryRun)
+	})
+
+	t.Run("requires the queue name for a real run", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured filteredPurgePageData
+		captureTemplate(t, "filtered-purge", func(data filteredPurgePageData) { captured = data })
+		installFragment(t, "assets/js/filtered_purge.ts", "")
+
+		handler.PostFilteredPurgeHandler(rr, newRequest(url.Values{"body_pattern": {"test-"}, "confirm_name": {"other"}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "type the queue name to confirm", captured.ErrorMessage)
+		assert.False(t, captured.DryRun)
+		assert.Equal(t, "test-", captured.BodyPattern)
+	})
+}
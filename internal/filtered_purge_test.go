@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_StartFilteredPurge(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders.fifo"
+	filter := MessageFilter{JSONPath: "$.test", JSONValue: "true"}
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository) {
+		repo := NewMockSqsRepository(t)
+		repo.EXPECT().GetQueueStats(mock.Anything, queueURL).Return(QueueStats{MessagesAvailable: 3}, nil).Once()
+		return &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}, repo
+	}
+
+	t.Run("deletes matching messages and sends the others back", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "m-1", Body: `{"test":true}`, ReceiptHandle: "r-1"},
+			{ID: "m-2", Body: `{"test":false}`, ReceiptHandle: "r-2", Attributes: []MessageAttribute{
+				{Name: "kind", Value: "order"},
+				{Name: "MessageGroupId", Value: "customer-7"},
+			}},
+		}, nil).Once()
+		// The copy of m-2 comes back and is left alone.
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "m-3", Body: `{"test":false}`, ReceiptHandle: "r-3"},
+		}, nil).Times(maxIdleSampleBatches)
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-1", Bulk: true}).Return(nil).Once()
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{
+			QueueURL:               queueURL,
+			Body:                   `{"test":false}`,
+			MessageGroupID:         "customer-7",
+			MessageDeduplicationID: "m-2",
+			Attributes:             map[string]string{"kind": "order"},
+		}).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-2"}).Return(nil).Once()
+
+		job, err := service.StartFilteredPurge(ctx, FilteredPurgeInput{QueueURL: queueURL, Filter: filter})
+		require.NoError(t, err)
+		assert.Equal(t, "filtered-purge", job.Kind)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(2), job.Done)
+		assert.Equal(t, "Deleted 1 matching messages and sent 1 others back.", job.Message)
+	})
+
+	t.Run("lists the matches of a dry run without changing anything", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "m-1", Body: `{"test":true}`},
+			{ID: "m-2", Body: `{"test":false}`},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "m-1", Body: `{"test":true}`},
+			{ID: "m-3", Body: `{"test":true,"id":3}`},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{}, nil).Once()
+
+		job, err := service.StartFilteredPurge(ctx, FilteredPurgeInput{QueueURL: queueURL, Filter: filter, DryRun: true})
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, []string{`m-1: {"test":true}`, `m-3: {"test":true,"id":3}`}, job.Details)
+		assert.Contains(t, job.Message, "2 of 3 messages match and would be deleted.")
+	})
+
+	t.Run("refuses to purge a protected queue but allows a dry run", func(t *testing.T) {
+		service, repo := newService(t)
+		service.config.QueuePolicy = QueuePolicy{Protect: []string{"orders*"}}
+
+		_, err := service.StartFilteredPurge(ctx, FilteredPurgeInput{QueueURL: queueURL, Filter: filter})
+		require.ErrorIs(t, err, ErrQueueAccessDenied)
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{}, nil).Once()
+		_, err = service.StartFilteredPurge(ctx, FilteredPurgeInput{QueueURL: queueURL, Filter: filter, DryRun: true})
+		require.NoError(t, err)
+		service.jobs.wg.Wait()
+	})
+
+	t.Run("rejects an invalid filter", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t), jobs: newJobRegistry()}
+
+		_, err := service.StartFilteredPurge(ctx, FilteredPurgeInput{QueueURL: queueURL})
+		require.EqualError(t, err, "a body pattern or a JSON path is required")
+	})
+}
@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// listenFDEnv passes the inherited listening sockets to the process started by Restart, as a
+	// comma-separated list of descriptors in the order of the configured listeners.
+	listenFDEnv = "SQS_GUI_LISTEN_FD"
+	// readyFDEnv passes the pipe the new process writes to once it serves requests.
+	readyFDEnv = "SQS_GUI_READY_FD"
+	// restartReadyTimeout is how long Restart waits for the new process before giving up on it.
+	restartReadyTimeout = 30 * time.Second
+)
+
+// NotifyReady tells the process that restarted into this one that requests are being served, so
+// it can stop accepting connections. It does nothing when the process was started normally.
+func NotifyReady(getenv func(string) string) error {
+	raw := strings.TrimSpace(getenv(readyFDEnv))
+	if raw == "" {
+		return nil
+	}
+
+	fd, err := strconv.Atoi(raw)
+	if err != nil || fd < 3 {
+		return errors.Newf("%s must be a file descriptor number of at least 3", readyFDEnv)
+	}
+	file := os.NewFile(uintptr(fd), "ready")
+	defer func() { _ = file.Close() }()
+	if _, err := file.Write([]byte{1}); err != nil {
+		return errors.Wrap(err, "failed to report readiness")
+	}
+	return nil
+}
+
+// Restart starts the executable again, by the path the process was started with so that an
+// upgraded binary is picked up, and hands it the listening sockets. It returns once the new
+// process serves requests; the caller then shuts down its server, which stops accepting
+// connections and lets in-flight requests finish. When the new process fails to start or does not
+// become ready in time, it is stopped and the caller keeps serving.
+func Restart(listeners []net.Listener) error {
+	files := make([]*os.File, 0, len(listeners)+1)
+	defer func() {
+		for _, file := range files {
+			_ = file.Close()
+		}
+	}()
+	fds := make([]string, 0, len(listeners))
+	for _, listener := range listeners {
+		tcp, ok := listener.(*net.TCPListener)
+		if !ok {
+			return errors.New("only TCP listeners can be handed over")
+		}
+		file, err := tcp.File()
+		if err != nil {
+			return errors.Wrap(err, "failed to duplicate the listener")
+		}
+		// ExtraFiles start at descriptor 3.
+		fds = append(fds, strconv.Itoa(3+len(files)))
+		files = append(files, file)
+	}
+
+	path, err := exec.LookPath(os.Args[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to find the executable")
+	}
+
+	readyReader, readyWriter, err := os.Pipe()
+	if err != nil {
+		return errors.Wrap(err, "failed to create the readiness pipe")
+	}
+	defer func() { _ = readyReader.Close() }()
+	readyFD := strconv.Itoa(3 + len(files))
+
+	cmd := exec.Command(path, os.Args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.ExtraFiles = append(files, readyWriter)
+	cmd.Env = append(restartEnv(os.Environ()), listenFDEnv+"="+strings.Join(fds, ","), readyFDEnv+"="+readyFD)
+
+	err = cmd.Start()
+	// The new process holds its own copy; closing ours lets the read below see it exit.
+	_ = readyWriter.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to start the new process")
+	}
+
+	if err := waitReady(readyReader); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return err
+	}
+	return cmd.Process.Release()
+}
+
+// waitReady reads the byte the new process writes once it serves requests.
+func waitReady(ready *os.File) error {
+	if err := ready.SetReadDeadline(time.Now().Add(restartReadyTimeout)); err != nil {
+		return errors.Wrap(err, "failed to wait for the new process")
+	}
+	buf := make([]byte, 1)
+	if _, err := ready.Read(buf); err != nil {
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return errors.Newf("the new process was not ready within %s", restartReadyTimeout)
+		}
+		return errors.New("the new process exited before it was ready")
+	}
+	return nil
+}
+
+// restartEnv drops the descriptors this process inherited, which mean nothing to the next one.
+func restartEnv(environ []string) []string {
+	env := make([]string, 0, len(environ))
+	for _, entry := range environ {
+		if strings.HasPrefix(entry, listenFDEnv+"=") || strings.HasPrefix(entry, readyFDEnv+"=") {
+			continue
+		}
+		env = append(env, entry)
+	}
+	return env
+}
@@ -0,0 +1,75 @@
+//go:build unix
+
+package internal
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListen_InheritedSockets(t *testing.T) {
+	configs := []ListenerConfig{{Addr: "127.0.0.1:0"}, {Addr: "[::1]:0"}}
+
+	t.Run("takes over the sockets in order", func(t *testing.T) {
+		first, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = first.Close() })
+		second, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = second.Close() })
+		fds := strconv.Itoa(dupFD(t, first.(*net.TCPListener).File)) + "," + strconv.Itoa(dupFD(t, second.(*net.TCPListener).File))
+
+		listeners, err := Listen(func(string) string { return fds }, configs)
+		require.NoError(t, err)
+		t.Cleanup(func() { closeListeners(listeners) })
+		require.Len(t, listeners, 2)
+		assert.Equal(t, first.Addr().String(), listeners[0].Addr().String())
+		assert.Equal(t, second.Addr().String(), listeners[1].Addr().String())
+	})
+
+	t.Run("rejects a different number of listeners", func(t *testing.T) {
+		_, err := Listen(func(string) string { return "3" }, configs)
+		assert.EqualError(t, err, "inherited 1 listeners but 2 are configured")
+	})
+
+	t.Run("rejects an invalid descriptor", func(t *testing.T) {
+		_, err := Listen(func(string) string { return "stdin,4" }, configs)
+		assert.EqualError(t, err, "SQS_GUI_LISTEN_FD must list file descriptor numbers of at least 3")
+	})
+}
+
+func TestNotifyReady(t *testing.T) {
+	require.NoError(t, NotifyReady(func(string) string { return "" }))
+
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = reader.Close() })
+	fd := dupFD(t, func() (*os.File, error) { return writer, nil })
+
+	require.NoError(t, NotifyReady(func(string) string { return strconv.Itoa(fd) }))
+	require.NoError(t, waitReady(reader))
+}
+
+func TestRestartEnv(t *testing.T) {
+	assert.Equal(t,
+		[]string{"AWS_REGION=us-east-1", "SQS_GUI_LISTEN_FDS=1"},
+		restartEnv([]string{"AWS_REGION=us-east-1", "SQS_GUI_LISTEN_FD=3", "SQS_GUI_READY_FD=4", "SQS_GUI_LISTEN_FDS=1"}),
+	)
+}
+
+// dupFD returns a descriptor no *os.File owns, as an inherited one would be, and closes the file.
+func dupFD(t *testing.T, open func() (*os.File, error)) int {
+	t.Helper()
+	file, err := open()
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	fd, err := syscall.Dup(int(file.Fd()))
+	require.NoError(t, err)
+	return fd
+}
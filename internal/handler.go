@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"github.com/cockroachdb/errors"
@@ -12,51 +13,227 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Handler defines the HTTP handlers exposed by the service.
 type Handler interface {
 	QueuesHandler(w http.ResponseWriter, r *http.Request)
+	PostQueueColumnsHandler(w http.ResponseWriter, r *http.Request)
+	PostFavoriteQueueHandler(w http.ResponseWriter, r *http.Request)
+	ExportQueuesCSVHandler(w http.ResponseWriter, r *http.Request)
+	QueueReportHandler(w http.ResponseWriter, r *http.Request)
+	ListQueuesAPI(w http.ResponseWriter, r *http.Request)
 	GetCreateQueueHandler(w http.ResponseWriter, r *http.Request)
 	PostCreateQueueHandler(w http.ResponseWriter, r *http.Request)
+	ImportQueuesHandler(w http.ResponseWriter, r *http.Request)
+	PostImportQueuesHandler(w http.ResponseWriter, r *http.Request)
 	QueueHandler(w http.ResponseWriter, r *http.Request)
 	DeleteQueueHandler(w http.ResponseWriter, r *http.Request)
 	PurgeQueueHandler(w http.ResponseWriter, r *http.Request)
+	PostRedrivePolicyHandler(w http.ResponseWriter, r *http.Request)
+	PostFifoThroughputHandler(w http.ResponseWriter, r *http.Request)
+	AccessPolicyHandler(w http.ResponseWriter, r *http.Request)
+	PostAccessPolicyHandler(w http.ResponseWriter, r *http.Request)
+	SnsPublishHandler(w http.ResponseWriter, r *http.Request)
+	PostSnsPublishHandler(w http.ResponseWriter, r *http.Request)
+	EventRulesHandler(w http.ResponseWriter, r *http.Request)
+	PostEventRulesHandler(w http.ResponseWriter, r *http.Request)
+	PostQueueTagHandler(w http.ResponseWriter, r *http.Request)
+	DeleteQueueTagHandler(w http.ResponseWriter, r *http.Request)
+	StartPurgeAPI(w http.ResponseWriter, r *http.Request)
+	BulkQueueOperationAPI(w http.ResponseWriter, r *http.Request)
+	PostBulkQueuesHandler(w http.ResponseWriter, r *http.Request)
+	FilteredPurgeHandler(w http.ResponseWriter, r *http.Request)
+	PostFilteredPurgeHandler(w http.ResponseWriter, r *http.Request)
+	DrainToFileHandler(w http.ResponseWriter, r *http.Request)
+	PostDrainToFileHandler(w http.ResponseWriter, r *http.Request)
+	RestoreFileHandler(w http.ResponseWriter, r *http.Request)
+	PostRestoreFileHandler(w http.ResponseWriter, r *http.Request)
+	IngestAPI(w http.ResponseWriter, r *http.Request)
+	SlackCommandHandler(w http.ResponseWriter, r *http.Request)
+	SubscribePushAPI(w http.ResponseWriter, r *http.Request)
+	UnsubscribePushAPI(w http.ResponseWriter, r *http.Request)
+	JobAPI(w http.ResponseWriter, r *http.Request)
+	JobFileAPI(w http.ResponseWriter, r *http.Request)
 	SendReceive(w http.ResponseWriter, r *http.Request)
 	SendMessageAPI(w http.ResponseWriter, r *http.Request)
+	SendMessageBatchAPI(w http.ResponseWriter, r *http.Request)
+	FanOutMessageAPI(w http.ResponseWriter, r *http.Request)
+	TransferMessagesAPI(w http.ResponseWriter, r *http.Request)
+	RedriveMessagesAPI(w http.ResponseWriter, r *http.Request)
 	ReceiveMessagesAPI(w http.ResponseWriter, r *http.Request)
+	DrainReceiveAPI(w http.ResponseWriter, r *http.Request)
+	SearchMessagesAPI(w http.ResponseWriter, r *http.Request)
 	DeleteMessageAPI(w http.ResponseWriter, r *http.Request)
+	ReceiveMergedMessagesAPI(w http.ResponseWriter, r *http.Request)
+	SaveDraftAPI(w http.ResponseWriter, r *http.Request)
+	PostSendMessageFormHandler(w http.ResponseWriter, r *http.Request)
+	PostReceiveMessagesFormHandler(w http.ResponseWriter, r *http.Request)
+	PostDeleteMessageFormHandler(w http.ResponseWriter, r *http.Request)
+	GetMessageContractAPI(w http.ResponseWriter, r *http.Request)
+	PutMessageContractAPI(w http.ResponseWriter, r *http.Request)
+	DeleteMessageContractAPI(w http.ResponseWriter, r *http.Request)
+	CompareMessageAPI(w http.ResponseWriter, r *http.Request)
+	CleanupReportAPI(w http.ResponseWriter, r *http.Request)
+	CheckQueueNameAPI(w http.ResponseWriter, r *http.Request)
+	QueueStatsAPI(w http.ResponseWriter, r *http.Request)
+	QueueLiveStatsAPI(w http.ResponseWriter, r *http.Request)
+	MessageMoveTasksAPI(w http.ResponseWriter, r *http.Request)
+	CancelMessageMoveTaskAPI(w http.ResponseWriter, r *http.Request)
+	ProbeLatencyAPI(w http.ResponseWriter, r *http.Request)
+	UpdateQueueAttributeAPI(w http.ResponseWriter, r *http.Request)
+	SchedulesHandler(w http.ResponseWriter, r *http.Request)
+	PostScheduleHandler(w http.ResponseWriter, r *http.Request)
+	DeleteScheduleHandler(w http.ResponseWriter, r *http.Request)
+	ToggleScheduleHandler(w http.ResponseWriter, r *http.Request)
+	ListSchedulesAPI(w http.ResponseWriter, r *http.Request)
+	GetScheduleAPI(w http.ResponseWriter, r *http.Request)
+	CreateScheduleAPI(w http.ResponseWriter, r *http.Request)
+	UpdateScheduleAPI(w http.ResponseWriter, r *http.Request)
+	DeleteScheduleAPI(w http.ResponseWriter, r *http.Request)
+	TrashHandler(w http.ResponseWriter, r *http.Request)
+	RestoreQueueHandler(w http.ResponseWriter, r *http.Request)
+	DiscardTrashedQueueHandler(w http.ResponseWriter, r *http.Request)
+	DriftHandler(w http.ResponseWriter, r *http.Request)
+	PostDriftCheckHandler(w http.ResponseWriter, r *http.Request)
+	SaveBaselineHandler(w http.ResponseWriter, r *http.Request)
+	DeleteBaselineHandler(w http.ResponseWriter, r *http.Request)
+	AttributeHistoryHandler(w http.ResponseWriter, r *http.Request)
+	WatchAttributesHandler(w http.ResponseWriter, r *http.Request)
+	UnwatchAttributesHandler(w http.ResponseWriter, r *http.Request)
+	DeadLetterQueuesHandler(w http.ResponseWriter, r *http.Request)
+	AlertsHandler(w http.ResponseWriter, r *http.Request)
+	PostAlertRuleHandler(w http.ResponseWriter, r *http.Request)
+	DeleteAlertRuleHandler(w http.ResponseWriter, r *http.Request)
+	SilenceAlertRuleHandler(w http.ResponseWriter, r *http.Request)
+	UnsilenceAlertRuleHandler(w http.ResponseWriter, r *http.Request)
+	QueueAnalysisHandler(w http.ResponseWriter, r *http.Request)
+	PostAttributeCountHandler(w http.ResponseWriter, r *http.Request)
+	QueueMigrationHandler(w http.ResponseWriter, r *http.Request)
+	PostQueueMigrationHandler(w http.ResponseWriter, r *http.Request)
+	QueueDefinitionHandler(w http.ResponseWriter, r *http.Request)
+	ConsumerSimulatorHandler(w http.ResponseWriter, r *http.Request)
+	PostConsumerSimulatorHandler(w http.ResponseWriter, r *http.Request)
+	ForwarderHandler(w http.ResponseWriter, r *http.Request)
+	PostForwarderHandler(w http.ResponseWriter, r *http.Request)
+	ProducerBenchmarkHandler(w http.ResponseWriter, r *http.Request)
+	PostProducerBenchmarkHandler(w http.ResponseWriter, r *http.Request)
+	ExportSettingsAPI(w http.ResponseWriter, r *http.Request)
+	ImportSettingsAPI(w http.ResponseWriter, r *http.Request)
+	StatusHandler(w http.ResponseWriter, r *http.Request)
+	MetricsHandler(w http.ResponseWriter, r *http.Request)
+	CapabilitiesAPI(w http.ResponseWriter, r *http.Request)
+	SearchHandler(w http.ResponseWriter, r *http.Request)
+	SearchAPI(w http.ResponseWriter, r *http.Request)
 }
 
 // HandlerImpl implements the HTTP handlers.
 type HandlerImpl struct {
-	s SqsService
+	s     SqsService
+	polls *formPollCache
 }
 
 // NewHandler creates a new HandlerImpl instance.
 func NewHandler(s SqsService) *HandlerImpl {
-	return &HandlerImpl{s: s}
+	return &HandlerImpl{s: s, polls: newFormPollCache()}
 }
 
 type queueView struct {
-	Name                      string
+	Name string
+	// URL is escaped for paths; QueueURL is the queue URL itself, for form values.
 	URL                       string
+	QueueURL                  string
 	Type                      string
 	CreatedAt                 string
 	MessagesAvailable         string
 	MessagesInFlight          string
+	MessagesDelayed           string
+	OldestAge                 string
 	Encryption                string
 	ContentBasedDeduplication string
+	VisibilityTimeout         string
+	Arn                       string
+	Tags                      string
+	// Attention describes the depth anomalies of the queue; the row is highlighted when set.
+	Attention string
+	// Favorite is set for starred queues.
+	Favorite bool
+}
+
+// newQueueView formats a queue for the queue list. Tags are left to the caller, since they are
+// only read when the column is shown.
+func newQueueView(queue QueueSummary) queueView {
+	created := "-"
+	if !queue.CreatedAt.IsZero() {
+		created = queue.CreatedAt.Format("2006-01-02 15:04:05 MST")
+	}
+
+	return queueView{
+		Name:                      queue.Name,
+		URL:                       url.QueryEscape(queue.URL),
+		QueueURL:                  queue.URL,
+		Type:                      strings.ToUpper(string(queue.Type)),
+		CreatedAt:                 created,
+		MessagesAvailable:         strconv.FormatInt(queue.MessagesAvailable, 10),
+		MessagesInFlight:          strconv.FormatInt(queue.MessagesInFlight, 10),
+		MessagesDelayed:           strconv.FormatInt(queue.MessagesDelayed, 10),
+		OldestAge:                 backlogAgeLabel(queue),
+		Encryption:                queue.Encryption,
+		ContentBasedDeduplication: boolLabel(queue.ContentBasedDeduplication),
+		VisibilityTimeout:         strconv.FormatInt(queue.VisibilityTimeout, 10),
+		Arn:                       cmp.Or(queue.Arn, "-"),
+		Attention:                 anomalyLabels(queue.Anomalies),
+	}
+}
+
+// queueGroupView is a group of rows on the queue list. Label heads the group; it is empty when
+// the list is not grouped, and the rows are shown without a heading.
+type queueGroupView struct {
+	Label  string
+	Queues []queueView
 }
 
 type pageFlash struct {
 	Message string
 	Kind    string
+	// RestoreID offers an undo button for a queue that was just moved to the trash.
+	RestoreID string
+}
+
+// queueListingView echoes the list parameters back to the queue list form and links to the
+// neighbouring pages when the list is paged.
+type queueListingView struct {
+	Query      string
+	Type       string
+	Encryption string
+	Sort       string
+	Order      string
+	GroupBy    string
+	GroupTag   string
+	Limit      int
+	Total      int
+	PrevURL    string
+	NextURL    string
 }
 
 type queuesPageData struct {
-	Title        string
-	Queues       []queueView
+	Title       string
+	Queues      []queueView
+	Groups      []queueGroupView
+	Favorites   []queueView
+	Listing     queueListingView
+	SortOptions []selectOption
+	// Columns offers every optional column on the column form; Shown holds the chosen ones.
+	Columns []queueColumnOption
+	Shown   map[string]bool
+	// ReturnURL brings the column form back to the list as it is filtered now.
+	ReturnURL string
+	// ExportURL downloads the whole list as filtered and sorted now as CSV.
+	ExportURL string
+	// ReportURL opens the printable report of the queues on this page; it is empty when there are
+	// none or more than one report covers.
+	ReportURL    string
 	ViteTags     template.HTML
 	Flash        *pageFlash
 	ErrorMessage string
@@ -67,6 +244,11 @@ type queuePageData struct {
 	Queue        queueDetailView
 	ViteTags     template.HTML
 	FlashMessage string
+	ErrorMessage string
+	// TagsSupported is false when the endpoint does not implement queue tags.
+	TagsSupported bool
+	// DeadLetterOptions are the queues the redrive policy form offers.
+	DeadLetterOptions []deadLetterOption
 }
 
 type queueDetailView struct {
@@ -79,10 +261,31 @@ type queueDetailView struct {
 	LastModifiedAt            string
 	MessagesAvailable         string
 	MessagesInFlight          string
+	MessagesDelayed           string
 	Encryption                string
 	ContentBasedDeduplication string
-	Attributes                []queueAttributeView
-	Tags                      []queueTagView
+	// Throughput is the throughput mode of a FIFO queue as stored, for the throughput form.
+	Throughput      FifoThroughput
+	Attributes      []queueAttributeView
+	Tags            []queueTagView
+	DeadLetterQueue *deadLetterTargetView
+	// DeadLetterSources are the queues that use this queue as their dead-letter queue.
+	DeadLetterSources []deadLetterSourceView
+}
+
+// deadLetterTargetView is the dead-letter queue named by a queue's redrive policy.
+type deadLetterTargetView struct {
+	Name            string
+	Arn             string
+	EscapedURL      string
+	MaxReceiveCount int
+}
+
+// deadLetterOption is a queue offered as a dead-letter queue in the create and edit forms.
+type deadLetterOption struct {
+	Name string
+	Arn  string
+	Type string
 }
 
 type queueAttributeView struct {
@@ -106,21 +309,69 @@ type createQueueForm struct {
 	DelaySeconds           string
 	MessageRetentionPeriod string
 	VisibilityTimeout      string
+	MaximumMessageSize     string
+	ReceiveWaitTime        string
 	ContentBasedDedup      bool
+	DeduplicationScope     string
+	FifoThroughputLimit    string
+	DeadLetterTargetArn    string
+	MaxReceiveCount        string
+	KmsMasterKeyID         string
+	KmsDataKeyReusePeriod  string
+	// Advanced is set when the queue is described by raw attribute and tag JSON instead.
+	Advanced       bool
+	AttributesJSON string
+	TagsJSON       string
 }
 
 type createQueuePageData struct {
-	Title        string
-	ViteTags     template.HTML
-	Form         createQueueForm
-	QueueTypes   []queueTypeOption
-	ErrorMessage string
+	Title            string
+	ViteTags         template.HTML
+	Form             createQueueForm
+	QueueTypes       []queueTypeOption
+	DeadLetterQueues []deadLetterOption
+	ErrorMessage     string
 }
 
 type sendReceivePageData struct {
 	Title    string
 	Queue    sendReceiveQueueView
-	ViteTags template.HTML
+	Defaults sendDefaultsView
+	Draft    *messageDraftView
+	Contract messageContractView
+	// AttributeRows pre-fill the attribute inputs of the send form for browsers without JavaScript,
+	// which cannot add rows. The script replaces them with its own rows.
+	AttributeRows []MessageAttribute
+	// Received lists the messages of a poll made through the receive form without JavaScript.
+	Received     *receivedPollView
+	Flash        *pageFlash
+	ErrorMessage string
+	ViteTags     template.HTML
+}
+
+// receivedPollView is a poll kept by the server; Token lets the delete forms return to it.
+type receivedPollView struct {
+	Token    string
+	Messages []receiveMessageItem
+}
+
+type sendDefaultsView struct {
+	MessageGroupID string
+	DelaySeconds   string
+	AttributesJSON string
+}
+
+// messageContractView fills the contract editor; SavedAt is empty when the queue has no contract.
+type messageContractView struct {
+	Sample  string
+	Schema  string
+	SavedAt string
+}
+
+type messageDraftView struct {
+	Body           string
+	AttributesJSON string
+	SavedAt        string
 }
 
 type sendReceiveQueueView struct {
@@ -133,8 +384,9 @@ type sendReceiveQueueView struct {
 }
 
 type messageAttributePayload struct {
-	Name  string `json:"name"`
-	Value string `json:"value"`
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	DataType string `json:"dataType,omitempty"`
 }
 
 type sendMessageRequest struct {
@@ -145,17 +397,64 @@ type sendMessageRequest struct {
 	Attributes             []messageAttributePayload `json:"attributes"`
 }
 
+type saveDraftRequest struct {
+	Body       string                    `json:"body"`
+	Attributes []messageAttributePayload `json:"attributes"`
+}
+
+type saveDraftResponse struct {
+	Message string `json:"message"`
+	SavedAt string `json:"savedAt,omitempty"`
+}
+
+type cleanupReportResponse struct {
+	Enabled    bool                   `json:"enabled"`
+	Pattern    string                 `json:"pattern,omitempty"`
+	IdleFor    string                 `json:"idleFor,omitempty"`
+	DryRun     bool                   `json:"dryRun"`
+	RanAt      string                 `json:"ranAt,omitempty"`
+	Candidates []cleanupCandidateItem `json:"candidates"`
+}
+
+type cleanupCandidateItem struct {
+	QueueURL   string `json:"queueUrl"`
+	Name       string `json:"name"`
+	Action     string `json:"action"`
+	EmptySince string `json:"emptySince,omitempty"`
+	EligibleAt string `json:"eligibleAt,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
 type sendMessageResponse struct {
 	Message string `json:"message"`
+	Warning string `json:"warning,omitempty"`
 }
 
 type receiveMessagesRequest struct {
-	MaxMessages     *int32 `json:"maxMessages"`
-	WaitTimeSeconds *int32 `json:"waitTimeSeconds"`
+	MaxMessages         *int32 `json:"maxMessages"`
+	WaitTimeSeconds     *int32 `json:"waitTimeSeconds"`
+	GroupByMessageGroup bool   `json:"groupByMessageGroup"`
+	// VisibilityTimeout is 0 to peek or the seconds to lock the messages for; without it the
+	// queue's visibility timeout applies.
+	VisibilityTimeout *int32 `json:"visibilityTimeout"`
+	// BodyPattern, JSONPath and JSONValue keep only the matching messages, as a filtered purge
+	// selects them. Project lists JSON paths to pick out of each body.
+	BodyPattern string   `json:"bodyPattern"`
+	JSONPath    string   `json:"jsonPath"`
+	JSONValue   string   `json:"jsonValue"`
+	Project     []string `json:"project"`
 }
 
 type receiveMessagesResponse struct {
-	Messages []receiveMessageItem `json:"messages"`
+	Messages    []receiveMessageItem `json:"messages"`
+	Groups      []messageGroupItem   `json:"groups,omitempty"`
+	FilteredOut int                  `json:"filteredOut,omitempty"`
+}
+
+type messageGroupItem struct {
+	MessageGroupID string               `json:"messageGroupId"`
+	InOrder        bool                 `json:"inOrder"`
+	Messages       []receiveMessageItem `json:"messages"`
 }
 
 type deleteMessageRequest struct {
@@ -171,40 +470,115 @@ type receiveMessageItem struct {
 	Body          string                     `json:"body"`
 	ReceiptHandle string                     `json:"receiptHandle"`
 	ReceiveCount  int32                      `json:"receiveCount"`
+	BodyHash      string                     `json:"bodyHash"`
 	Attributes    []messageAttributeResponse `json:"attributes"`
+	DeadLetter    *deadLetterContextItem     `json:"deadLetter,omitempty"`
+	Raw           json.RawMessage            `json:"raw,omitempty"`
+	Projection    json.RawMessage            `json:"projection,omitempty"`
+}
+
+// deadLetterContextItem is the "why is this here" panel of a message SQS moved to a dead-letter queue.
+type deadLetterContextItem struct {
+	ReceiveCount    int32  `json:"receiveCount"`
+	SentAt          string `json:"sentAt,omitempty"`
+	FirstReceivedAt string `json:"firstReceivedAt,omitempty"`
+	SourceQueueArn  string `json:"sourceQueueArn"`
+	SourceQueueName string `json:"sourceQueueName"`
+	SourceQueueURL  string `json:"sourceQueueUrl,omitempty"`
+	MaxReceiveCount int    `json:"maxReceiveCount,omitempty"`
 }
 
 type messageAttributeResponse struct {
-	Name  string `json:"name"`
-	Value string `json:"value"`
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	DataType string `json:"dataType,omitempty"`
+}
+
+type receiveMergedMessagesRequest struct {
+	QueueURLs       []string `json:"queueUrls"`
+	MaxMessages     *int32   `json:"maxMessages"`
+	WaitTimeSeconds *int32   `json:"waitTimeSeconds"`
+}
+
+type receiveMergedMessagesResponse struct {
+	Messages []mergedMessageItem `json:"messages"`
+	Failures []queueFailureItem  `json:"failures"`
+}
+
+type mergedMessageItem struct {
+	QueueURL  string `json:"queueUrl"`
+	QueueName string `json:"queueName"`
+	receiveMessageItem
+}
+
+type queueFailureItem struct {
+	QueueURL string `json:"queueUrl"`
+	Error    string `json:"error"`
 }
 
 // QueuesHandler renders the queue listing page.
 func (h *HandlerImpl) QueuesHandler(w http.ResponseWriter, r *http.Request) {
-	queues, err := h.s.Queues(r.Context())
+	opts, err := queueListOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	columns, err := h.s.QueueListColumns(r.Context())
 	if err != nil {
-		slog.Error("failed to load queue list", slog.Any("error", err))
+		slog.ErrorContext(r.Context(), "failed to load queue list columns", slog.Any("error", err))
+		columns = defaultQueueColumns
+	}
+	shown := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		shown[column] = true
+	}
+	opts.Tags = shown[QueueColumnTags]
+	opts.Favorites = true
+
+	page, err := h.s.FindQueues(r.Context(), opts)
+	if errors.Is(err, ErrTagsUnsupported) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load queue list", slog.Any("error", err))
 		http.Error(w, "failed to load queues", http.StatusInternalServerError)
 		return
 	}
 
-	viewQueues := make([]queueView, 0, len(queues))
-	for _, queue := range queues {
-		created := "-"
-		if !queue.CreatedAt.IsZero() {
-			created = queue.CreatedAt.Format("2006-01-02 15:04:05 MST")
+	favorites := make([]queueView, 0, len(page.Favorites))
+	starred := make(map[string]bool, len(page.Favorites))
+	for _, queue := range page.Favorites {
+		view := newQueueView(queue)
+		view.Favorite = true
+		favorites = append(favorites, view)
+		starred[queue.URL] = true
+	}
+
+	newRow := func(queue QueueSummary) queueView {
+		view := newQueueView(queue)
+		view.Favorite = starred[queue.URL]
+		if shown[QueueColumnTags] {
+			view.Tags = queueTagsLabel(page.Tags, queue.URL)
 		}
+		return view
+	}
+	viewQueues := make([]queueView, 0, len(page.Queues))
+	for _, queue := range page.Queues {
+		viewQueues = append(viewQueues, newRow(queue))
+	}
 
-		viewQueues = append(viewQueues, queueView{
-			Name:                      queue.Name,
-			URL:                       url.QueryEscape(queue.URL),
-			Type:                      strings.ToUpper(string(queue.Type)),
-			CreatedAt:                 created,
-			MessagesAvailable:         strconv.FormatInt(queue.MessagesAvailable, 10),
-			MessagesInFlight:          strconv.FormatInt(queue.MessagesInFlight, 10),
-			Encryption:                queue.Encryption,
-			ContentBasedDeduplication: boolLabel(queue.ContentBasedDeduplication),
-		})
+	groups := []queueGroupView{{Queues: viewQueues}}
+	if len(page.Groups) > 0 {
+		groups = make([]queueGroupView, 0, len(page.Groups))
+		for _, group := range page.Groups {
+			view := queueGroupView{Label: queueGroupLabel(opts, group.Name)}
+			for _, queue := range group.Queues {
+				view.Queues = append(view.Queues, newRow(queue))
+			}
+			groups = append(groups, view)
+		}
 	}
 
 	var flash *pageFlash
@@ -216,33 +590,49 @@ func (h *HandlerImpl) QueuesHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	} else if deleted := strings.TrimSpace(query.Get("deleted")); deleted != "" {
 		flash = &pageFlash{
-			Message: fmt.Sprintf("Queue \"%s\" was deleted successfully.", deleted),
+			Message:   fmt.Sprintf("Queue \"%s\" was deleted successfully.", deleted),
+			Kind:      "success",
+			RestoreID: strings.TrimSpace(query.Get("trash")),
+		}
+	} else if restored := strings.TrimSpace(query.Get("restored")); restored != "" {
+		flash = &pageFlash{
+			Message: fmt.Sprintf("Queue \"%s\" was restored. It starts without messages.", restored),
 			Kind:    "success",
 		}
 	}
 
 	data := queuesPageData{
-		Title:    "Queues",
-		Queues:   viewQueues,
-		ViteTags: fragments["assets/js/queues.ts"].Tags,
-		Flash:    flash,
+		Title:       "Queues",
+		Queues:      viewQueues,
+		Groups:      groups,
+		Favorites:   favorites,
+		Listing:     newQueueListingView(r.URL, opts, page.Total),
+		SortOptions: queueSortOptions,
+		Columns:     queueColumnOptions(shown),
+		Shown:       shown,
+		ReturnURL:   queueListReturnURL(r.URL),
+		ExportURL:   queueListExportURL(r.URL),
+		ReportURL:   queueReportURL(page.Queues),
+		ViteTags:    fragments["assets/js/queues.ts"].Tags,
+		Flash:       flash,
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 
 	if err := templates["queues"].Execute(w, data); err != nil {
-		slog.Error("failed to render queue template", slog.Any("error", err))
+		slog.ErrorContext(r.Context(), "failed to render queue template", slog.Any("error", err))
 		http.Error(w, "template error", http.StatusInternalServerError)
 	}
 }
 
 // GetCreateQueueHandler serves the queue creation page.
-func (h *HandlerImpl) GetCreateQueueHandler(w http.ResponseWriter, _ *http.Request) {
+func (h *HandlerImpl) GetCreateQueueHandler(w http.ResponseWriter, r *http.Request) {
 	h.renderCreateQueue(w, createQueuePageData{
-		Title:      "Create Queue",
-		ViteTags:   fragments["assets/js/create_queue.ts"].Tags,
-		Form:       h.defaultCreateQueueForm(),
-		QueueTypes: queueTypeOptions(),
+		Title:            "Create Queue",
+		ViteTags:         fragments["assets/js/create_queue.ts"].Tags,
+		Form:             h.defaultCreateQueueForm(),
+		QueueTypes:       queueTypeOptions(),
+		DeadLetterQueues: h.deadLetterOptions(r, ""),
 	})
 }
 
@@ -256,6 +646,10 @@ func (h *HandlerImpl) handleCreateQueuePost(w http.ResponseWriter, r *http.Reque
 		http.Error(w, "invalid form", http.StatusBadRequest)
 		return
 	}
+	if r.FormValue("mode") == "advanced" {
+		h.handleAdvancedCreateQueuePost(w, r)
+		return
+	}
 
 	form := createQueueForm{
 		Name:                   strings.TrimSpace(r.FormValue("queue_name")),
@@ -263,33 +657,59 @@ func (h *HandlerImpl) handleCreateQueuePost(w http.ResponseWriter, r *http.Reque
 		DelaySeconds:           strings.TrimSpace(r.FormValue("delay_seconds")),
 		MessageRetentionPeriod: strings.TrimSpace(r.FormValue("message_retention_period")),
 		VisibilityTimeout:      strings.TrimSpace(r.FormValue("visibility_timeout")),
+		MaximumMessageSize:     strings.TrimSpace(r.FormValue("maximum_message_size")),
+		ReceiveWaitTime:        strings.TrimSpace(r.FormValue("receive_wait_time")),
 		ContentBasedDedup:      r.FormValue("content_deduplication") == "on",
+		DeduplicationScope:     r.FormValue("deduplication_scope"),
+		FifoThroughputLimit:    r.FormValue("fifo_throughput_limit"),
+		DeadLetterTargetArn:    strings.TrimSpace(r.FormValue("dead_letter_target_arn")),
+		MaxReceiveCount:        strings.TrimSpace(r.FormValue("max_receive_count")),
+		KmsMasterKeyID:         strings.TrimSpace(r.FormValue("kms_master_key_id")),
+		KmsDataKeyReusePeriod:  strings.TrimSpace(r.FormValue("kms_data_key_reuse_period")),
 	}
 
 	input := CreateQueueInput{
 		Name:                      form.Name,
 		Type:                      QueueType(form.Type),
 		ContentBasedDeduplication: form.ContentBasedDedup,
+		Throughput:                FifoThroughput{DeduplicationScope: form.DeduplicationScope, FifoThroughputLimit: form.FifoThroughputLimit},
+		KmsMasterKeyID:            form.KmsMasterKeyID,
 	}
 
 	var err error
 	if input.DelaySeconds, err = parseOptionalInt32(form.DelaySeconds, 0, 900, "Delay seconds must be between 0 and 900."); err != nil {
-		h.renderCreateQueue(w, h.createQueueErrorData(form, err))
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, err))
 		return
 	}
 	if input.MessageRetentionPeriod, err = parseOptionalInt32(form.MessageRetentionPeriod, 60, 1209600, "Message retention period must be between 60 and 1209600."); err != nil {
-		h.renderCreateQueue(w, h.createQueueErrorData(form, err))
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, err))
 		return
 	}
 	if input.VisibilityTimeout, err = parseOptionalInt32(form.VisibilityTimeout, 0, 43200, "Visibility timeout must be between 0 and 43200."); err != nil {
-		h.renderCreateQueue(w, h.createQueueErrorData(form, err))
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, err))
+		return
+	}
+	if input.MaximumMessageSize, err = parseOptionalInt32(form.MaximumMessageSize, 1024, 262144, "Maximum message size must be between 1024 and 262144."); err != nil {
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, err))
+		return
+	}
+	if input.ReceiveMessageWaitTimeSeconds, err = parseOptionalInt32(form.ReceiveWaitTime, 0, 20, "Receive wait time must be between 0 and 20."); err != nil {
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, err))
+		return
+	}
+	if input.KmsDataKeyReusePeriodSeconds, err = parseOptionalInt32(form.KmsDataKeyReusePeriod, 60, 86400, "Data key reuse period must be between 60 and 86400."); err != nil {
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, err))
+		return
+	}
+	if input.RedrivePolicy, err = parseRedrivePolicyForm(form.DeadLetterTargetArn, form.MaxReceiveCount); err != nil {
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, err))
 		return
 	}
 
 	result, err := h.s.CreateQueue(r.Context(), input)
 	if err != nil {
-		slog.Error("failed to create queue", slog.Any("error", err))
-		h.renderCreateQueue(w, h.createQueueErrorData(form, err))
+		slog.ErrorContext(r.Context(), "failed to create queue", slog.Any("error", err))
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, err))
 		return
 	}
 
@@ -310,13 +730,14 @@ func (h *HandlerImpl) defaultCreateQueueForm() createQueueForm {
 	return createQueueForm{Type: string(QueueTypeStandard)}
 }
 
-func (h *HandlerImpl) createQueueErrorData(form createQueueForm, err error) createQueuePageData {
+func (h *HandlerImpl) createQueueErrorData(r *http.Request, form createQueueForm, err error) createQueuePageData {
 	return createQueuePageData{
-		Title:        "Create Queue",
-		ViteTags:     fragments["assets/js/create_queue.ts"].Tags,
-		Form:         form,
-		QueueTypes:   queueTypeOptions(),
-		ErrorMessage: err.Error(),
+		Title:            "Create Queue",
+		ViteTags:         fragments["assets/js/create_queue.ts"].Tags,
+		Form:             form,
+		QueueTypes:       queueTypeOptions(),
+		DeadLetterQueues: h.deadLetterOptions(r, ""),
+		ErrorMessage:     err.Error(),
 	}
 }
 
@@ -330,13 +751,39 @@ func (h *HandlerImpl) QueueHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	queueDetail, err := h.s.QueueDetail(r.Context(), queueURL)
+	data, err := h.newQueuePageData(r, queueURL)
 	if err != nil {
-		slog.Error("failed to load queue detail", slog.String("queue_url", queueURL), slog.Any("error", err))
-		http.Error(w, "failed to load queue detail", http.StatusInternalServerError)
+		writeServiceError(w, err, "failed to load queue detail", http.StatusInternalServerError)
 		return
 	}
 
+	query := r.URL.Query()
+	switch {
+	case query.Get("purged") == "1":
+		data.FlashMessage = fmt.Sprintf("All messages in \"%s\" were purged successfully.", data.Queue.Name)
+	case query.Get("tagged") != "":
+		data.FlashMessage = fmt.Sprintf("Tag \"%s\" was saved.", query.Get("tagged"))
+	case query.Get("untagged") != "":
+		data.FlashMessage = fmt.Sprintf("Tag \"%s\" was removed.", query.Get("untagged"))
+	case query.Get("redrive") == "saved":
+		data.FlashMessage = "The dead-letter queue was saved."
+	case query.Get("redrive") == "removed":
+		data.FlashMessage = "The redrive policy was removed."
+	case query.Get("throughput") == "saved":
+		data.FlashMessage = "The FIFO throughput settings were saved."
+	}
+
+	h.renderQueue(w, r, http.StatusOK, data)
+}
+
+// newQueuePageData loads the queue shown on its detail page.
+func (h *HandlerImpl) newQueuePageData(r *http.Request, queueURL string) (queuePageData, error) {
+	queueDetail, err := h.s.QueueDetail(r.Context(), queueURL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load queue detail", slog.String("queue_url", queueURL), slog.Any("error", err))
+		return queuePageData{}, err
+	}
+
 	attributes := make([]queueAttributeView, 0, len(queueDetail.Attributes))
 	for key, value := range queueDetail.Attributes {
 		attributes = append(attributes, queueAttributeView{
@@ -366,7 +813,7 @@ func (h *HandlerImpl) QueueHandler(w http.ResponseWriter, r *http.Request) {
 		lastModified = queueDetail.LastModifiedAt.Format("2006-01-02 15:04:05 MST")
 	}
 
-	data := queuePageData{
+	return queuePageData{
 		Title: fmt.Sprintf("Queue %s", queueDetail.Name),
 		Queue: queueDetailView{
 			Name:                      queueDetail.Name,
@@ -378,23 +825,29 @@ func (h *HandlerImpl) QueueHandler(w http.ResponseWriter, r *http.Request) {
 			LastModifiedAt:            lastModified,
 			MessagesAvailable:         strconv.FormatInt(queueDetail.MessagesAvailable, 10),
 			MessagesInFlight:          strconv.FormatInt(queueDetail.MessagesInFlight, 10),
+			MessagesDelayed:           strconv.FormatInt(queueDetail.MessagesDelayed, 10),
 			Encryption:                queueDetail.Encryption,
 			ContentBasedDeduplication: boolLabel(queueDetail.ContentBasedDeduplication),
-			Attributes:                attributes,
-			Tags:                      tags,
+			Throughput: FifoThroughput{
+				DeduplicationScope:  queueDetail.Attributes["DeduplicationScope"],
+				FifoThroughputLimit: queueDetail.Attributes["FifoThroughputLimit"],
+			},
+			Attributes:        attributes,
+			Tags:              tags,
+			DeadLetterQueue:   deadLetterTarget(queueURL, queueDetail.RedrivePolicy),
+			DeadLetterSources: h.deadLetterSources(r, queueURL),
 		},
-		ViteTags: fragments["assets/js/queue.ts"].Tags,
-	}
-
-	if r.URL.Query().Get("purged") == "1" {
-		data.FlashMessage = fmt.Sprintf("All messages in \"%s\" were purged successfully.", queueDetail.Name)
-	}
+		ViteTags:          fragments["assets/js/queue.ts"].Tags,
+		TagsSupported:     h.s.EndpointCapabilities(r.Context()).Tags,
+		DeadLetterOptions: h.deadLetterOptions(r, queueURL),
+	}, nil
+}
 
+func (h *HandlerImpl) renderQueue(w http.ResponseWriter, r *http.Request, status int, data queuePageData) {
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-
+	w.WriteHeader(status)
 	if err := templates["queue"].Execute(w, data); err != nil {
-		slog.Error("failed to render queue template", slog.Any("error", err))
-		http.Error(w, "template error", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "failed to render queue template", slog.Any("error", err))
 	}
 }
 
@@ -409,14 +862,23 @@ func (h *HandlerImpl) DeleteQueueHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := h.s.DeleteQueue(r.Context(), queueURL); err != nil {
-		slog.Error("failed to delete queue", slog.String("queue_url", queueURL), slog.Any("error", err))
-		http.Error(w, "failed to delete queue", http.StatusInternalServerError)
+	if err := checkConfirmName(r, queueURL); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	trashed, err := h.s.DeleteQueue(r.Context(), queueURL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete queue", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeServiceError(w, err, "failed to delete queue", http.StatusInternalServerError)
 		return
 	}
 
 	queueName := extractQueueName(queueURL)
 	redirectURL := fmt.Sprintf("/queues?deleted=%s", url.QueryEscape(queueName))
+	if trashed.ID != "" {
+		redirectURL += "&trash=" + url.QueryEscape(trashed.ID)
+	}
 	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
 }
 
@@ -431,9 +893,27 @@ func (h *HandlerImpl) PurgeQueueHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if err := checkConfirmName(r, queueURL); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if purgeBackupRequested(r) {
+		job, err := h.s.StartPurgeWithBackup(r.Context(), queueURL)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "failed to start purge with backup", slog.String("queue_url", queueURL), slog.Any("error", err))
+			writeServiceError(w, err, "failed to purge queue", http.StatusInternalServerError)
+			return
+		}
+		// The drain to file page follows the job and links the archive once it ends.
+		redirectURL := fmt.Sprintf("/queues/%s/drain-to-file?job=%s", url.QueryEscape(queueURL), url.QueryEscape(job.ID))
+		http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+		return
+	}
+
 	if err := h.s.PurgeQueue(r.Context(), queueURL); err != nil {
-		slog.Error("failed to purge queue", slog.String("queue_url", queueURL), slog.Any("error", err))
-		http.Error(w, "failed to purge queue", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "failed to purge queue", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeServiceError(w, err, "failed to purge queue", http.StatusInternalServerError)
 		return
 	}
 
@@ -441,6 +921,25 @@ func (h *HandlerImpl) PurgeQueueHandler(w http.ResponseWriter, r *http.Request)
 	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
 }
 
+// checkConfirmName requires the confirm_name form field to repeat the queue name, so a destructive
+// action only runs when someone typed the name rather than from a stray or forged submission.
+func checkConfirmName(r *http.Request, queueURL string) error {
+	if err := r.ParseForm(); err != nil {
+		return fmt.Errorf("invalid form submission")
+	}
+	if strings.TrimSpace(r.PostForm.Get("confirm_name")) != extractQueueName(queueURL) {
+		return fmt.Errorf("type the queue name to confirm")
+	}
+	return nil
+}
+
+// purgeBackupRequested reports whether a purge form asked to archive the messages first. The form
+// has to be parsed already.
+func purgeBackupRequested(r *http.Request) bool {
+	backup, _ := strconv.ParseBool(r.PostForm.Get("backup"))
+	return backup || r.PostForm.Get("backup") == "on"
+}
+
 func (h *HandlerImpl) queueURLFromRequest(r *http.Request) (string, int, error) {
 	encodedURL := r.PathValue("url")
 	if encodedURL == "" {
@@ -456,6 +955,13 @@ func (h *HandlerImpl) queueURLFromRequest(r *http.Request) (string, int, error)
 		return "", http.StatusBadRequest, fmt.Errorf("queue url is required")
 	}
 
+	// The service checks the host against the configured endpoint; this only rejects values that
+	// are not absolute http(s) URLs at all.
+	parsed, err := url.Parse(queueURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || parsed.User != nil {
+		return "", http.StatusBadRequest, fmt.Errorf("invalid queue url")
+	}
+
 	return queueURL, 0, nil
 }
 
@@ -508,14 +1014,59 @@ func (h *HandlerImpl) SendReceive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	queueDetail, err := h.s.QueueDetail(r.Context(), queueURL)
+	data, err := h.newSendReceivePageData(r, queueURL)
 	if err != nil {
-		slog.Error("failed to load queue detail for send/receive", slog.String("queue_url", queueURL), slog.Any("error", err))
-		http.Error(w, "failed to load queue detail", http.StatusInternalServerError)
+		writeServiceError(w, err, "failed to load queue detail", http.StatusInternalServerError)
 		return
 	}
 
-	data := sendReceivePageData{
+	query := r.URL.Query()
+	if token := query.Get("poll"); token != "" {
+		data.Received = h.receivedPollView(token, queueURL)
+	}
+	data.Flash = sendReceiveFlash(query, data.Received)
+
+	h.renderSendReceive(w, r, http.StatusOK, data)
+}
+
+// newSendReceivePageData loads the queue and the saved form state the send/receive page starts from.
+func (h *HandlerImpl) newSendReceivePageData(r *http.Request, queueURL string) (sendReceivePageData, error) {
+	queueDetail, err := h.s.QueueDetail(r.Context(), queueURL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load queue detail for send/receive", slog.String("queue_url", queueURL), slog.Any("error", err))
+		return sendReceivePageData{}, err
+	}
+
+	defaults, err := h.s.SendDefaults(r.Context(), queueURL)
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to load send defaults", slog.String("queue_url", queueURL), slog.Any("error", err))
+	}
+	attributeRows := defaults.Attributes
+
+	var draftView *messageDraftView
+	draft, hasDraft, err := h.s.Draft(r.Context(), queueURL)
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to load message draft", slog.String("queue_url", queueURL), slog.Any("error", err))
+	} else if hasDraft {
+		draftView = newMessageDraftView(draft)
+		if len(draft.Attributes) > 0 {
+			attributeRows = draft.Attributes
+		}
+	}
+
+	var contractView messageContractView
+	contract, hasContract, err := h.s.MessageContract(r.Context(), queueURL)
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to load message contract", slog.String("queue_url", queueURL), slog.Any("error", err))
+	} else if hasContract {
+		contractView = messageContractView{
+			Sample:  contract.Sample,
+			Schema:  contract.Schema,
+			SavedAt: contract.SavedAt.Format("2006-01-02 15:04:05 MST"),
+		}
+	}
+
+	return sendReceivePageData{
 		Title: fmt.Sprintf("Send and receive messages · %s", queueDetail.Name),
 		Queue: sendReceiveQueueView{
 			Name:                         queueDetail.Name,
@@ -525,15 +1076,205 @@ func (h *HandlerImpl) SendReceive(w http.ResponseWriter, r *http.Request) {
 			SupportsMessageGroups:        queueDetail.Type == QueueTypeFIFO,
 			RequiresMessageDeduplication: queueDetail.Type == QueueTypeFIFO && !queueDetail.ContentBasedDeduplication,
 		},
-		ViteTags: fragments["assets/js/send_receive.ts"].Tags,
-	}
+		Defaults:      newSendDefaultsView(defaults),
+		Draft:         draftView,
+		Contract:      contractView,
+		AttributeRows: attributeRows,
+		ViteTags:      fragments["assets/js/send_receive.ts"].Tags,
+	}, nil
+}
 
+func (h *HandlerImpl) renderSendReceive(w http.ResponseWriter, r *http.Request, status int, data sendReceivePageData) {
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-
+	w.WriteHeader(status)
 	if err := templates["send-receive"].Execute(w, data); err != nil {
-		slog.Error("failed to render send-receive template", slog.Any("error", err))
-		http.Error(w, "template error", http.StatusInternalServerError)
+		slog.ErrorContext(r.Context(), "failed to render send-receive template", slog.Any("error", err))
+	}
+}
+
+func newSendDefaultsView(defaults SendDefaults) sendDefaultsView {
+	view := sendDefaultsView{
+		MessageGroupID: defaults.MessageGroupID,
+		DelaySeconds:   "0",
+		AttributesJSON: "[]",
+	}
+	if defaults.DelaySeconds != nil {
+		view.DelaySeconds = strconv.FormatInt(int64(*defaults.DelaySeconds), 10)
+	}
+	if len(defaults.Attributes) > 0 {
+		if raw, err := json.Marshal(defaults.Attributes); err == nil {
+			view.AttributesJSON = string(raw)
+		}
+	}
+	return view
+}
+
+func newMessageDraftView(draft MessageDraft) *messageDraftView {
+	view := &messageDraftView{
+		Body:           draft.Body,
+		AttributesJSON: "[]",
+		SavedAt:        draft.SavedAt.Format("2006-01-02 15:04:05 MST"),
+	}
+	if len(draft.Attributes) > 0 {
+		if raw, err := json.Marshal(draft.Attributes); err == nil {
+			view.AttributesJSON = string(raw)
+		}
+	}
+	return view
+}
+
+// SaveDraftAPI autosaves the in-progress send form so it can be restored on the next page load.
+func (h *HandlerImpl) SaveDraftAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	defer func() { _ = r.Body.Close() }()
+
+	var payload saveDraftRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&payload); err != nil {
+		if errors.Is(err, io.EOF) {
+			writeJSONError(w, http.StatusBadRequest, "request body is required")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	attributes := make([]MessageAttribute, 0, len(payload.Attributes))
+	for _, attr := range payload.Attributes {
+		attributes = append(attributes, MessageAttribute(attr))
+	}
+
+	saved, err := h.s.SaveDraft(r.Context(), queueURL, MessageDraft{Body: payload.Body, Attributes: attributes})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to save message draft", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if saved.SavedAt.IsZero() {
+		writeJSON(w, http.StatusOK, saveDraftResponse{Message: "Draft cleared."})
+		return
 	}
+
+	writeJSON(w, http.StatusOK, saveDraftResponse{
+		Message: "Draft saved.",
+		SavedAt: saved.SavedAt.Format(time.RFC3339),
+	})
+}
+
+type checkQueueNameResponse struct {
+	Name      string `json:"name"`
+	Valid     bool   `json:"valid"`
+	Available bool   `json:"available"`
+	Message   string `json:"message,omitempty"`
+}
+
+// CheckQueueNameAPI reports whether the name in the query string can be used for a new queue.
+func (h *HandlerImpl) CheckQueueNameAPI(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	check, err := h.s.CheckQueueName(r.Context(), query.Get("name"), QueueType(query.Get("type")))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to check queue name", slog.Any("error", err))
+		writeJSONError(w, http.StatusBadGateway, "failed to check queue name")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, checkQueueNameResponse{
+		Name:      check.Name,
+		Valid:     check.Valid,
+		Available: check.Available,
+		Message:   check.Message,
+	})
+}
+
+type updateQueueAttributeRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type updateQueueAttributeResponse struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Message string `json:"message"`
+}
+
+// UpdateQueueAttributeAPI changes a single queue attribute, for inline edits on the queue list.
+func (h *HandlerImpl) UpdateQueueAttributeAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	defer func() { _ = r.Body.Close() }()
+
+	var payload updateQueueAttributeRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	value, err := h.s.UpdateQueueAttribute(r.Context(), queueURL, payload.Name, payload.Value)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to update queue attribute", slog.String("queue_url", queueURL), slog.String("attribute", payload.Name), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, updateQueueAttributeResponse{
+		Name:    payload.Name,
+		Value:   value,
+		Message: fmt.Sprintf("%s updated.", payload.Name),
+	})
+}
+
+// CleanupReportAPI returns the result of the most recent temporary queue cleanup sweep.
+func (h *HandlerImpl) CleanupReportAPI(w http.ResponseWriter, r *http.Request) {
+	report, err := h.s.CleanupReport(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load cleanup report", slog.Any("error", err))
+		writeJSONError(w, http.StatusInternalServerError, "failed to load cleanup report")
+		return
+	}
+
+	response := cleanupReportResponse{
+		Enabled:    report.Enabled,
+		Pattern:    report.Pattern,
+		DryRun:     report.DryRun,
+		Candidates: make([]cleanupCandidateItem, 0, len(report.Candidates)),
+	}
+	if report.IdleFor > 0 {
+		response.IdleFor = report.IdleFor.String()
+	}
+	if !report.RanAt.IsZero() {
+		response.RanAt = report.RanAt.Format(time.RFC3339)
+	}
+	for _, candidate := range report.Candidates {
+		item := cleanupCandidateItem{
+			QueueURL: candidate.QueueURL,
+			Name:     candidate.Name,
+			Action:   candidate.Action,
+			Error:    candidate.Error,
+		}
+		if !candidate.EmptySince.IsZero() {
+			item.EmptySince = candidate.EmptySince.Format(time.RFC3339)
+			item.EligibleAt = candidate.EligibleAt.Format(time.RFC3339)
+		}
+		response.Candidates = append(response.Candidates, item)
+	}
+
+	writeJSON(w, http.StatusOK, response)
 }
 
 func (h *HandlerImpl) SendMessageAPI(w http.ResponseWriter, r *http.Request) {
@@ -567,15 +1308,27 @@ func (h *HandlerImpl) SendMessageAPI(w http.ResponseWriter, r *http.Request) {
 		MessageDeduplicationID: payload.MessageDeduplicationID,
 		DelaySeconds:           payload.DelaySeconds,
 		Attributes:             convertPayloadAttributes(payload.Attributes),
+		IdempotencyKey:         r.Header.Get("Idempotency-Key"),
 	}
 
-	if err := h.s.SendMessage(r.Context(), input); err != nil {
-		slog.Error("failed to send message", slog.String("queue_url", queueURL), slog.Any("error", err))
-		writeJSONError(w, http.StatusBadRequest, err.Error())
+	result, err := h.s.SendMessage(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to send message", slog.String("queue_url", queueURL), slog.Any("error", err))
+		status := serviceErrorStatus(err)
+		switch {
+		case errors.Is(err, ErrIdempotencyKeyInUse):
+			status = http.StatusConflict
+		case errors.Is(err, ErrIdempotencyKeyReused):
+			status = http.StatusUnprocessableEntity
+		}
+		writeJSONError(w, status, err.Error())
 		return
 	}
 
-	writeJSON(w, http.StatusOK, sendMessageResponse{Message: "Message sent successfully."})
+	if result.Replayed {
+		w.Header().Set("Idempotent-Replayed", "true")
+	}
+	writeJSON(w, http.StatusOK, sendMessageResponse{Message: "Message sent successfully.", Warning: result.Warning})
 }
 
 func (h *HandlerImpl) ReceiveMessagesAPI(w http.ResponseWriter, r *http.Request) {
@@ -598,7 +1351,12 @@ func (h *HandlerImpl) ReceiveMessagesAPI(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	input := ReceiveMessagesInput{QueueURL: queueURL}
+	input := ReceiveMessagesInput{
+		QueueURL:            queueURL,
+		GroupByMessageGroup: payload.GroupByMessageGroup,
+		Filter:              MessageFilter{BodyPattern: payload.BodyPattern, JSONPath: payload.JSONPath, JSONValue: payload.JSONValue},
+		Projection:          payload.Project,
+	}
 	if payload.MaxMessages != nil {
 		input.MaxMessages = *payload.MaxMessages
 		input.MaxMessagesProvided = true
@@ -607,32 +1365,135 @@ func (h *HandlerImpl) ReceiveMessagesAPI(w http.ResponseWriter, r *http.Request)
 		input.WaitTimeSeconds = *payload.WaitTimeSeconds
 		input.WaitTimeProvided = true
 	}
+	if payload.VisibilityTimeout != nil {
+		input.VisibilityTimeout = *payload.VisibilityTimeout
+		input.VisibilityTimeoutProvided = true
+	}
 
 	result, err := h.s.ReceiveMessages(r.Context(), input)
 	if err != nil {
-		slog.Error("failed to receive messages", slog.String("queue_url", queueURL), slog.Any("error", err))
-		writeJSONError(w, http.StatusBadRequest, err.Error())
+		slog.ErrorContext(r.Context(), "failed to receive messages", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
 		return
 	}
 
-	response := receiveMessagesResponse{Messages: make([]receiveMessageItem, 0, len(result.Messages))}
+	response := receiveMessagesResponse{
+		Messages:    make([]receiveMessageItem, 0, len(result.Messages)),
+		FilteredOut: result.FilteredOut,
+	}
 	for _, message := range result.Messages {
-		item := receiveMessageItem{
-			ID:            message.ID,
-			Body:          message.Body,
-			ReceiptHandle: message.ReceiptHandle,
-			ReceiveCount:  message.ReceiveCount,
-			Attributes:    make([]messageAttributeResponse, 0, len(message.Attributes)),
+		response.Messages = append(response.Messages, newReceiveResultItem(result, message))
+	}
+	for _, group := range result.Groups {
+		item := messageGroupItem{
+			MessageGroupID: group.GroupID,
+			InOrder:        group.InOrder,
+			Messages:       make([]receiveMessageItem, 0, len(group.Messages)),
 		}
-		for _, attribute := range message.Attributes {
-			item.Attributes = append(item.Attributes, messageAttributeResponse(attribute))
+		for _, message := range group.Messages {
+			item.Messages = append(item.Messages, newReceiveResultItem(result, message))
 		}
-		response.Messages = append(response.Messages, item)
+		response.Groups = append(response.Groups, item)
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// ReceiveMergedMessagesAPI polls several queues at once and returns a merged, source-annotated message list.
+func (h *HandlerImpl) ReceiveMergedMessagesAPI(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	var payload receiveMergedMessagesRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&payload); err != nil {
+		if errors.Is(err, io.EOF) {
+			writeJSONError(w, http.StatusBadRequest, "request body is required")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	input := MergedReceiveInput{QueueURLs: payload.QueueURLs}
+	if payload.MaxMessages != nil {
+		input.MaxMessages = *payload.MaxMessages
+		input.MaxMessagesProvided = true
+	}
+	if payload.WaitTimeSeconds != nil {
+		input.WaitTimeSeconds = *payload.WaitTimeSeconds
+		input.WaitTimeProvided = true
+	}
+
+	result, err := h.s.ReceiveMergedMessages(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to receive merged messages", slog.Any("error", err))
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response := receiveMergedMessagesResponse{
+		Messages: make([]mergedMessageItem, 0, len(result.Messages)),
+		Failures: make([]queueFailureItem, 0, len(result.Failures)),
+	}
+	for _, message := range result.Messages {
+		response.Messages = append(response.Messages, mergedMessageItem{
+			QueueURL:           message.QueueURL,
+			QueueName:          message.QueueName,
+			receiveMessageItem: newReceiveMessageItem(message.ReceivedMessage),
+		})
+	}
+	for _, failure := range result.Failures {
+		response.Failures = append(response.Failures, queueFailureItem(failure))
 	}
 
 	writeJSON(w, http.StatusOK, response)
 }
 
+func newReceiveMessageItem(message ReceivedMessage) receiveMessageItem {
+	item := receiveMessageItem{
+		ID:            message.ID,
+		Body:          message.Body,
+		ReceiptHandle: message.ReceiptHandle,
+		ReceiveCount:  message.ReceiveCount,
+		BodyHash:      bodyHash(message.Body),
+		Attributes:    make([]messageAttributeResponse, 0, len(message.Attributes)),
+		Raw:           message.Raw,
+	}
+	for _, attribute := range message.Attributes {
+		item.Attributes = append(item.Attributes, messageAttributeResponse(attribute))
+	}
+	return item
+}
+
+// newReceiveResultItem adds to a received message what the poll that returned it found out about
+// it: why a dead-letter message is here and the fields projected from its body.
+func newReceiveResultItem(result ReceiveMessagesResult, message ReceivedMessage) receiveMessageItem {
+	item := newReceiveMessageItem(message)
+	if deadLetter, ok := result.DeadLetter[message.ID]; ok {
+		item.DeadLetter = newDeadLetterContextItem(deadLetter)
+	}
+	item.Projection = result.Projections[message.ID]
+	return item
+}
+
+func newDeadLetterContextItem(deadLetter DeadLetterContext) *deadLetterContextItem {
+	item := &deadLetterContextItem{
+		ReceiveCount:    deadLetter.ReceiveCount,
+		SourceQueueArn:  deadLetter.SourceQueueArn,
+		SourceQueueName: deadLetter.SourceQueueName,
+		SourceQueueURL:  deadLetter.SourceQueueURL,
+		MaxReceiveCount: deadLetter.MaxReceiveCount,
+	}
+	if !deadLetter.SentAt.IsZero() {
+		item.SentAt = deadLetter.SentAt.Format(time.RFC3339)
+	}
+	if !deadLetter.FirstReceivedAt.IsZero() {
+		item.FirstReceivedAt = deadLetter.FirstReceivedAt.Format(time.RFC3339)
+	}
+	return item
+}
+
 func (h *HandlerImpl) DeleteMessageAPI(w http.ResponseWriter, r *http.Request) {
 	queueURL, status, err := h.queueURLFromRequest(r)
 	if err != nil {
@@ -664,8 +1525,8 @@ func (h *HandlerImpl) DeleteMessageAPI(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.s.DeleteMessage(r.Context(), DeleteMessageInput{QueueURL: queueURL, ReceiptHandle: receiptHandle}); err != nil {
-		slog.Error("failed to delete message", slog.String("queue_url", queueURL), slog.Any("error", err))
-		writeJSONError(w, http.StatusBadRequest, err.Error())
+		slog.ErrorContext(r.Context(), "failed to delete message", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
 		return
 	}
 
@@ -686,8 +1547,9 @@ func convertPayloadAttributes(attrs []messageAttributePayload) []MessageAttribut
 			continue
 		}
 		result = append(result, MessageAttribute{
-			Name:  name,
-			Value: value,
+			Name:     name,
+			Value:    value,
+			DataType: strings.TrimSpace(attr.DataType),
 		})
 	}
 
@@ -705,3 +1567,28 @@ func writeJSON(w http.ResponseWriter, status int, payload any) {
 func writeJSONError(w http.ResponseWriter, status int, message string) {
 	writeJSON(w, status, map[string]string{"error": message})
 }
+
+// writeServiceError writes message with status, unless the queue policy or URL check refused the
+// operation, in which case their reason is shown with 403 or 400.
+func writeServiceError(w http.ResponseWriter, err error, message string, status int) {
+	switch {
+	case errors.Is(err, ErrQueueAccessDenied):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, ErrInvalidQueueURL):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, message, status)
+	}
+}
+
+// serviceErrorStatus maps a service error from a JSON API to 403 for policy violations, 503 while
+// the server shuts down and 400 otherwise.
+func serviceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrQueueAccessDenied):
+		return http.StatusForbidden
+	case errors.Is(err, ErrShuttingDown):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadRequest
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"html/template"
 	"net/http"
 	"net/http/httptest"
@@ -40,6 +41,7 @@ func TestHandlerImpl_QueuesHandler_Success(t *testing.T) {
 				MessagesInFlight:          1,
 				Encryption:                "SSE",
 				ContentBasedDeduplication: true,
+				Anomalies:                 []QueueAnomaly{QueueAnomalyBacklogGrowing, QueueAnomalyInFlightStuck},
 			},
 		}
 	}
@@ -79,11 +81,12 @@ func TestHandlerImpl_QueuesHandler_Success(t *testing.T) {
 			req := httptest.NewRequest(http.MethodGet, tc.requestURL, nil)
 			queues := newQueueSummaries()
 
+			mockService.EXPECT().QueueListColumns(mock.Anything).Return(defaultQueueColumns, nil).Once()
 			mockService.EXPECT().
-				Queues(mock.MatchedBy(func(ctx context.Context) bool {
+				FindQueues(mock.MatchedBy(func(ctx context.Context) bool {
 					return ctx == req.Context()
-				})).
-				Return(queues, nil).
+				}), QueueListOptions{Favorites: true}).
+				Return(QueueListPage{Queues: queues, Total: len(queues)}, nil).
 				Once()
 
 			handler := NewHandler(mockService)
@@ -119,6 +122,7 @@ func TestHandlerImpl_QueuesHandler_Success(t *testing.T) {
 				assert.Equal(t, "2", first.MessagesInFlight)
 				assert.Equal(t, "SSE", first.Encryption)
 				assert.Equal(t, "Disabled", first.ContentBasedDeduplication)
+				assert.Empty(t, first.Attention)
 
 				second := captured.Queues[1]
 				assert.Equal(t, "events.fifo", second.Name)
@@ -129,6 +133,7 @@ func TestHandlerImpl_QueuesHandler_Success(t *testing.T) {
 				assert.Equal(t, "1", second.MessagesInFlight)
 				assert.Equal(t, "SSE", second.Encryption)
 				assert.Equal(t, "Enabled", second.ContentBasedDeduplication)
+				assert.Equal(t, "Backlog has grown on every recent sample; In-flight count has not changed for several minutes", second.Attention)
 			}
 		})
 	}
@@ -139,11 +144,12 @@ func TestHandlerImpl_QueuesHandler_ServiceError(t *testing.T) {
 	handler := NewHandler(mockService)
 
 	req := httptest.NewRequest(http.MethodGet, "/queues", nil)
+	mockService.EXPECT().QueueListColumns(mock.Anything).Return(defaultQueueColumns, nil).Once()
 	mockService.EXPECT().
-		Queues(mock.MatchedBy(func(ctx context.Context) bool {
+		FindQueues(mock.MatchedBy(func(ctx context.Context) bool {
 			return ctx == req.Context()
-		})).
-		Return(nil, errors.New("boom")).
+		}), QueueListOptions{Favorites: true}).
+		Return(QueueListPage{}, errors.New("boom")).
 		Once()
 
 	rr := httptest.NewRecorder()
@@ -162,6 +168,10 @@ func TestHandlerImpl_GetCreateQueueHandler(t *testing.T) {
 	captureCreateQueueTemplate(t, &captured)
 	installCreateQueueFragment(t, template.HTML(`<script data-test="create"></script>`))
 
+	mockService.EXPECT().DeadLetterCandidates(mock.Anything, "").
+		Return([]QueueSummary{{Name: "orders-dlq", Arn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", Type: QueueTypeStandard}}, nil).
+		Once()
+
 	req := httptest.NewRequest(http.MethodGet, "/create-queue", nil)
 	rr := httptest.NewRecorder()
 	handler.GetCreateQueueHandler(rr, req)
@@ -177,6 +187,7 @@ func TestHandlerImpl_GetCreateQueueHandler(t *testing.T) {
 		assert.Equal(t, queueTypeOption{Value: string(QueueTypeStandard), Label: "Standard"}, captured.QueueTypes[0])
 		assert.Equal(t, queueTypeOption{Value: string(QueueTypeFIFO), Label: "FIFO"}, captured.QueueTypes[1])
 	}
+	assert.Equal(t, []deadLetterOption{{Name: "orders-dlq", Arn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", Type: "standard"}}, captured.DeadLetterQueues)
 }
 
 func TestHandlerImpl_PostCreateQueueHandler_Success(t *testing.T) {
@@ -189,7 +200,11 @@ func TestHandlerImpl_PostCreateQueueHandler_Success(t *testing.T) {
 	form.Set("delay_seconds", "10")
 	form.Set("message_retention_period", "1200")
 	form.Set("visibility_timeout", "30")
+	form.Set("maximum_message_size", "65536")
+	form.Set("receive_wait_time", "20")
 	form.Set("content_deduplication", "on")
+	form.Set("kms_master_key_id", "alias/orders")
+	form.Set("kms_data_key_reuse_period", "600")
 
 	req := httptest.NewRequest(http.MethodPost, "/create-queue", strings.NewReader(form.Encode()))
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -216,6 +231,18 @@ func TestHandlerImpl_PostCreateQueueHandler_Success(t *testing.T) {
 				if !assert.NotNil(t, input.VisibilityTimeout) || !assert.Equal(t, int32(30), *input.VisibilityTimeout) {
 					return false
 				}
+				if !assert.NotNil(t, input.MaximumMessageSize) || !assert.Equal(t, int32(65536), *input.MaximumMessageSize) {
+					return false
+				}
+				if !assert.NotNil(t, input.ReceiveMessageWaitTimeSeconds) || !assert.Equal(t, int32(20), *input.ReceiveMessageWaitTimeSeconds) {
+					return false
+				}
+				if !assert.Equal(t, "alias/orders", input.KmsMasterKeyID) {
+					return false
+				}
+				if !assert.NotNil(t, input.KmsDataKeyReusePeriodSeconds) || !assert.Equal(t, int32(600), *input.KmsDataKeyReusePeriodSeconds) {
+					return false
+				}
 				return assert.True(t, input.ContentBasedDeduplication)
 			}),
 		).
@@ -251,6 +278,7 @@ func TestHandlerImpl_PostCreateQueueHandler_InvalidDelay(t *testing.T) {
 	form.Set("queue_name", "orders")
 	form.Set("queue_type", string(QueueTypeStandard))
 	form.Set("delay_seconds", "901")
+	mockService.EXPECT().DeadLetterCandidates(mock.Anything, "").Return(nil, nil).Once()
 
 	req := httptest.NewRequest(http.MethodPost, "/create-queue", strings.NewReader(form.Encode()))
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -270,6 +298,33 @@ func TestHandlerImpl_PostCreateQueueHandler_InvalidDelay(t *testing.T) {
 	assert.Equal(t, "901", captured.Form.DelaySeconds)
 }
 
+func TestHandlerImpl_PostCreateQueueHandler_InvalidReceiveWaitTime(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	form := url.Values{}
+	form.Set("queue_name", "orders")
+	form.Set("queue_type", string(QueueTypeStandard))
+	form.Set("maximum_message_size", "2048")
+	form.Set("receive_wait_time", "21")
+	mockService.EXPECT().DeadLetterCandidates(mock.Anything, "").Return(nil, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/create-queue", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+
+	var captured createQueuePageData
+	captureCreateQueueTemplate(t, &captured)
+	installCreateQueueFragment(t, template.HTML(`<script data-test="create"></script>`))
+
+	handler.PostCreateQueueHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "Receive wait time must be between 0 and 20.", captured.ErrorMessage)
+	assert.Equal(t, "2048", captured.Form.MaximumMessageSize)
+	assert.Equal(t, "21", captured.Form.ReceiveWaitTime)
+}
+
 func TestHandlerImpl_PostCreateQueueHandler_ServiceError(t *testing.T) {
 	mockService := NewMockSqsService(t)
 	handler := NewHandler(mockService)
@@ -295,6 +350,7 @@ func TestHandlerImpl_PostCreateQueueHandler_ServiceError(t *testing.T) {
 		).
 		Return(CreateQueueResult{}, errors.New("boom")).
 		Once()
+	mockService.EXPECT().DeadLetterCandidates(mock.Anything, "").Return(nil, errors.New("list failed")).Once()
 
 	handler.PostCreateQueueHandler(rr, req)
 
@@ -304,6 +360,97 @@ func TestHandlerImpl_PostCreateQueueHandler_ServiceError(t *testing.T) {
 	assert.Equal(t, "events", captured.Form.Name)
 }
 
+func TestHandlerImpl_CheckQueueNameAPI(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/queues/check-name?name=orders&type=fifo", nil)
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CheckQueueName(mock.Anything, "orders", QueueTypeFIFO).
+		Return(QueueNameCheck{Name: "orders.fifo", Valid: true, Message: `a queue named "orders.fifo" already exists`}, nil).
+		Once()
+
+	handler.CheckQueueNameAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"name":"orders.fifo","valid":true,"available":false,"message":"a queue named \"orders.fifo\" already exists"}`, rr.Body.String())
+}
+
+func TestHandlerImpl_CheckQueueNameAPI_ServiceError(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/queues/check-name?name=orders", nil)
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CheckQueueName(mock.Anything, "orders", QueueType("")).
+		Return(QueueNameCheck{}, errors.New("boom")).
+		Once()
+
+	handler.CheckQueueNameAPI(rr, req)
+
+	assert.Equal(t, http.StatusBadGateway, rr.Code)
+	assert.JSONEq(t, `{"error":"failed to check queue name"}`, rr.Body.String())
+}
+
+func TestHandlerImpl_UpdateQueueAttributeAPI(t *testing.T) {
+	queueURL := "https://sqs.local/queues/orders"
+
+	t.Run("updates attribute", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/queues/{url}/attributes", strings.NewReader(`{"name":"VisibilityTimeout","value":" 45"}`))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			UpdateQueueAttribute(mock.Anything, queueURL, "VisibilityTimeout", " 45").
+			Return("45", nil).
+			Once()
+
+		handler.UpdateQueueAttributeAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"name":"VisibilityTimeout","value":"45","message":"VisibilityTimeout updated."}`, rr.Body.String())
+	})
+
+	t.Run("reports validation error", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/queues/{url}/attributes", strings.NewReader(`{"name":"VisibilityTimeout","value":"-1"}`))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			UpdateQueueAttribute(mock.Anything, queueURL, "VisibilityTimeout", "-1").
+			Return("", errors.New("VisibilityTimeout must be between 0 and 43200")).
+			Once()
+
+		handler.UpdateQueueAttributeAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"VisibilityTimeout must be between 0 and 43200"}`, rr.Body.String())
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/queues/{url}/attributes", strings.NewReader(`{"attribute":"VisibilityTimeout"}`))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		handler.UpdateQueueAttributeAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"invalid request body"}`, rr.Body.String())
+	})
+}
+
 func TestHandlerImpl_QueueHandler_Success(t *testing.T) {
 	mockService := NewMockSqsService(t)
 	handler := NewHandler(mockService)
@@ -325,8 +472,8 @@ func TestHandlerImpl_QueueHandler_Success(t *testing.T) {
 			MessagesInFlight:          5,
 			Encryption:                "SSE",
 			ContentBasedDeduplication: true,
+			Arn:                       "arn:aws:sqs:us-east-1:000000000000:orders.fifo",
 		},
-		Arn:            "arn:aws:sqs:us-east-1:000000000000:orders.fifo",
 		LastModifiedAt: modifiedAt,
 		Attributes: map[string]string{
 			"VisibilityTimeout": "30",
@@ -349,6 +496,13 @@ func TestHandlerImpl_QueueHandler_Success(t *testing.T) {
 		).
 		Return(queueDetail, nil).
 		Once()
+	mockService.EXPECT().EndpointCapabilities(mock.Anything).Return(EndpointCapabilities{Kind: EndpointAWS, Tags: true}).Once()
+	mockService.EXPECT().DeadLetterCandidates(mock.Anything, queueURL).
+		Return([]QueueSummary{{Name: "orders-dlq.fifo", Arn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo", Type: QueueTypeFIFO}}, nil).
+		Once()
+	mockService.EXPECT().DeadLetterSourceQueues(mock.Anything, queueURL).
+		Return([]DeadLetterSource{{Name: "payments.fifo", URL: "https://sqs.local/000000000000/payments.fifo"}}, nil).
+		Once()
 
 	var captured queuePageData
 	captureQueueTemplate(t, &captured)
@@ -377,6 +531,9 @@ func TestHandlerImpl_QueueHandler_Success(t *testing.T) {
 		assert.Equal(t, queueTagView{Key: "env", Value: "prod"}, captured.Queue.Tags[0])
 		assert.Equal(t, queueTagView{Key: "team", Value: "payments"}, captured.Queue.Tags[1])
 	}
+	assert.True(t, captured.TagsSupported)
+	assert.Equal(t, []deadLetterOption{{Name: "orders-dlq.fifo", Arn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo", Type: "fifo"}}, captured.DeadLetterOptions)
+	assert.Equal(t, []deadLetterSourceView{{Name: "payments.fifo", URL: url.QueryEscape("https://sqs.local/000000000000/payments.fifo")}}, captured.Queue.DeadLetterSources)
 }
 
 func TestHandlerImpl_QueueHandler_BadQueueURL(t *testing.T) {
@@ -404,6 +561,20 @@ func TestHandlerImpl_QueueHandler_BadQueueURL(t *testing.T) {
 			},
 			expectBody: "queue url is required\n",
 		},
+		{
+			name: "not an http url",
+			setup: func(req *http.Request) {
+				req.SetPathValue("url", url.QueryEscape("file:///etc/passwd"))
+			},
+			expectBody: "invalid queue url\n",
+		},
+		{
+			name: "relative url",
+			setup: func(req *http.Request) {
+				req.SetPathValue("url", url.QueryEscape("orders"))
+			},
+			expectBody: "invalid queue url\n",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -448,19 +619,20 @@ func TestHandlerImpl_DeleteQueueHandler_Success(t *testing.T) {
 	handler := NewHandler(mockService)
 
 	queueURL := "https://sqs.local/queues/orders"
-	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/delete", nil)
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/delete", strings.NewReader("confirm_name=orders"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.SetPathValue("url", url.QueryEscape(queueURL))
 	rr := httptest.NewRecorder()
 
 	mockService.EXPECT().
 		DeleteQueue(mock.Anything, queueURL).
-		Return(nil).
+		Return(TrashedQueue{ID: "abc123", Name: "orders"}, nil).
 		Once()
 
 	handler.DeleteQueueHandler(rr, req)
 
 	assert.Equal(t, http.StatusSeeOther, rr.Code)
-	assert.Equal(t, "/queues?deleted=orders", rr.Header().Get("Location"))
+	assert.Equal(t, "/queues?deleted=orders&trash=abc123", rr.Header().Get("Location"))
 }
 
 func TestHandlerImpl_DeleteQueueHandler_BadQueueURL(t *testing.T) {
@@ -505,13 +677,14 @@ func TestHandlerImpl_DeleteQueueHandler_ServiceError(t *testing.T) {
 	handler := NewHandler(mockService)
 
 	queueURL := "https://sqs.local/queues/orders"
-	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/delete", nil)
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/delete", strings.NewReader("confirm_name=orders"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.SetPathValue("url", url.QueryEscape(queueURL))
 	rr := httptest.NewRecorder()
 
 	mockService.EXPECT().
 		DeleteQueue(mock.Anything, queueURL).
-		Return(errors.New("boom")).
+		Return(TrashedQueue{}, errors.New("boom")).
 		Once()
 
 	handler.DeleteQueueHandler(rr, req)
@@ -520,12 +693,60 @@ func TestHandlerImpl_DeleteQueueHandler_ServiceError(t *testing.T) {
 	assert.Equal(t, "failed to delete queue\n", rr.Body.String())
 }
 
+func TestHandlerImpl_DeleteQueueHandler_AccessDenied(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	queueURL := "https://sqs.local/queues/prod-orders"
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/delete", strings.NewReader("confirm_name=prod-orders"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		DeleteQueue(mock.Anything, queueURL).
+		Return(TrashedQueue{}, fmt.Errorf("queue \"prod-orders\" is protected from delete and purge: %w", ErrQueueAccessDenied)).
+		Once()
+
+	handler.DeleteQueueHandler(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Equal(t, "queue \"prod-orders\" is protected from delete and purge: queue access denied by policy\n", rr.Body.String())
+}
+
+func TestHandlerImpl_DestructiveHandlers_RequireConfirmName(t *testing.T) {
+	queueURL := "https://sqs.local/queues/orders"
+	handlers := map[string]func(h *HandlerImpl) http.HandlerFunc{
+		"delete": func(h *HandlerImpl) http.HandlerFunc { return h.DeleteQueueHandler },
+		"purge":  func(h *HandlerImpl) http.HandlerFunc { return h.PurgeQueueHandler },
+	}
+
+	for action, handlerFor := range handlers {
+		for name, body := range map[string]string{"missing": "", "mismatched": "confirm_name=order"} {
+			t.Run(action+" "+name, func(t *testing.T) {
+				handler := NewHandler(NewMockSqsService(t))
+
+				req := httptest.NewRequest(http.MethodPost, "/queues/{url}/"+action, strings.NewReader(body))
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				req.SetPathValue("url", url.QueryEscape(queueURL))
+				rr := httptest.NewRecorder()
+
+				handlerFor(handler)(rr, req)
+
+				assert.Equal(t, http.StatusBadRequest, rr.Code)
+				assert.Equal(t, "type the queue name to confirm\n", rr.Body.String())
+			})
+		}
+	}
+}
+
 func TestHandlerImpl_PurgeQueueHandler_Success(t *testing.T) {
 	mockService := NewMockSqsService(t)
 	handler := NewHandler(mockService)
 
 	queueURL := "https://sqs.local/queues/orders"
-	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/purge", nil)
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/purge", strings.NewReader("confirm_name=orders"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.SetPathValue("url", url.QueryEscape(queueURL))
 	rr := httptest.NewRecorder()
 
@@ -540,6 +761,27 @@ func TestHandlerImpl_PurgeQueueHandler_Success(t *testing.T) {
 	assert.Equal(t, "/queues/"+url.QueryEscape(queueURL)+"?purged=1", rr.Header().Get("Location"))
 }
 
+func TestHandlerImpl_PurgeQueueHandler_WithBackup(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	queueURL := "https://sqs.local/queues/orders"
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/purge", strings.NewReader("confirm_name=orders&backup=on"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		StartPurgeWithBackup(mock.Anything, queueURL).
+		Return(Job{ID: "job-1"}, nil).
+		Once()
+
+	handler.PurgeQueueHandler(rr, req)
+
+	assert.Equal(t, http.StatusSeeOther, rr.Code)
+	assert.Equal(t, "/queues/"+url.QueryEscape(queueURL)+"/drain-to-file?job=job-1", rr.Header().Get("Location"))
+}
+
 func TestHandlerImpl_PurgeQueueHandler_BadQueueURL(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -582,7 +824,8 @@ func TestHandlerImpl_PurgeQueueHandler_ServiceError(t *testing.T) {
 	handler := NewHandler(mockService)
 
 	queueURL := "https://sqs.local/queues/orders"
-	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/purge", nil)
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/purge", strings.NewReader("confirm_name=orders"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.SetPathValue("url", url.QueryEscape(queueURL))
 	rr := httptest.NewRecorder()
 
@@ -618,6 +861,29 @@ func TestHandlerImpl_SendReceive_Success(t *testing.T) {
 		QueueDetail(mock.Anything, queueURL).
 		Return(detail, nil).
 		Once()
+	mockService.EXPECT().
+		SendDefaults(mock.Anything, queueURL).
+		Return(SendDefaults{
+			MessageGroupID: "group-1",
+			DelaySeconds:   ptrInt32(30),
+			Attributes:     []MessageAttribute{{Name: "traceId", Value: "abc"}},
+		}, nil).
+		Once()
+	mockService.EXPECT().
+		Draft(mock.Anything, queueURL).
+		Return(MessageDraft{
+			Body:    "draft body",
+			SavedAt: time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC),
+		}, true, nil).
+		Once()
+	mockService.EXPECT().
+		MessageContract(mock.Anything, queueURL).
+		Return(MessageContract{
+			QueueURL: queueURL,
+			Sample:   `{"orderId":"o-1"}`,
+			SavedAt:  time.Date(2024, time.May, 2, 9, 30, 0, 0, time.UTC),
+		}, true, nil).
+		Once()
 
 	var captured sendReceivePageData
 	captureSendReceiveTemplate(t, &captured)
@@ -634,6 +900,20 @@ func TestHandlerImpl_SendReceive_Success(t *testing.T) {
 	assert.Equal(t, url.QueryEscape(queueURL), captured.Queue.EscapedURL)
 	assert.Equal(t, "FIFO", captured.Queue.Type)
 	assert.True(t, captured.Queue.SupportsMessageGroups)
+	assert.Equal(t, sendDefaultsView{
+		MessageGroupID: "group-1",
+		DelaySeconds:   "30",
+		AttributesJSON: `[{"name":"traceId","value":"abc"}]`,
+	}, captured.Defaults)
+	assert.Equal(t, &messageDraftView{
+		Body:           "draft body",
+		AttributesJSON: "[]",
+		SavedAt:        "2024-05-01 10:00:00 UTC",
+	}, captured.Draft)
+	assert.Equal(t, messageContractView{
+		Sample:  `{"orderId":"o-1"}`,
+		SavedAt: "2024-05-02 09:30:00 UTC",
+	}, captured.Contract)
 }
 
 func TestHandlerImpl_SendReceive_BadQueueURL(t *testing.T) {
@@ -743,7 +1023,7 @@ func TestHandlerImpl_SendMessageAPI_Success(t *testing.T) {
 				return true
 			}),
 		).
-		Return(nil).
+		Return(SendMessageResult{}, nil).
 		Once()
 
 	handler.SendMessageAPI(rr, req)
@@ -753,6 +1033,84 @@ func TestHandlerImpl_SendMessageAPI_Success(t *testing.T) {
 	assert.Equal(t, "{\"message\":\"Message sent successfully.\"}\n", rr.Body.String())
 }
 
+func TestHandlerImpl_SendMessageAPI_Warning(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	queueURL := "https://sqs.local/queues/orders.fifo"
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/messages", strings.NewReader(`{"body":"hello","messageGroupId":"g","messageDeduplicationId":"d"}`))
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		SendMessage(mock.Anything, mock.Anything).
+		Return(SendMessageResult{Warning: "duplicate"}, nil).
+		Once()
+
+	handler.SendMessageAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "{\"message\":\"Message sent successfully.\",\"warning\":\"duplicate\"}\n", rr.Body.String())
+}
+
+func TestHandlerImpl_SendMessageAPI_IdempotencyKey(t *testing.T) {
+	queueURL := "https://sqs.local/queues/orders"
+
+	testCases := []struct {
+		name       string
+		result     SendMessageResult
+		err        error
+		wantStatus int
+		wantHeader string
+		wantBody   string
+	}{
+		{
+			name:       "replayed",
+			result:     SendMessageResult{Replayed: true},
+			wantStatus: http.StatusOK,
+			wantHeader: "true",
+			wantBody:   `{"message":"Message sent successfully."}`,
+		},
+		{
+			name:       "still in progress",
+			err:        ErrIdempotencyKeyInUse,
+			wantStatus: http.StatusConflict,
+			wantBody:   `{"error":"a request with this idempotency key is still in progress"}`,
+		},
+		{
+			name:       "reused for another message",
+			err:        ErrIdempotencyKeyReused,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"error":"this idempotency key was already used for a different message"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSqsService(t)
+			handler := NewHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/queues/{url}/messages", strings.NewReader(`{"body":"hello"}`))
+			req.SetPathValue("url", url.QueryEscape(queueURL))
+			req.Header.Set("Idempotency-Key", "key-1")
+			rr := httptest.NewRecorder()
+
+			mockService.EXPECT().
+				SendMessage(mock.Anything, mock.MatchedBy(func(input SendMessageInput) bool {
+					return input.IdempotencyKey == "key-1"
+				})).
+				Return(tc.result, tc.err).
+				Once()
+
+			handler.SendMessageAPI(rr, req)
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+			assert.Equal(t, tc.wantHeader, rr.Header().Get("Idempotent-Replayed"))
+			assert.JSONEq(t, tc.wantBody, rr.Body.String())
+		})
+	}
+}
+
 func TestHandlerImpl_SendMessageAPI_BadRequests(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -828,7 +1186,7 @@ func TestHandlerImpl_SendMessageAPI_ServiceError(t *testing.T) {
 
 	mockService.EXPECT().
 		SendMessage(mock.Anything, mock.Anything).
-		Return(errors.New("boom")).
+		Return(SendMessageResult{}, errors.New("boom")).
 		Once()
 
 	handler.SendMessageAPI(rr, req)
@@ -861,7 +1219,21 @@ func TestHandlerImpl_ReceiveMessagesAPI_Success(t *testing.T) {
 				ReceiveCount:  2,
 				Attributes: []MessageAttribute{
 					{Name: "key", Value: "value"},
+					{Name: "retries", Value: "3", DataType: "Number"},
 				},
+				Raw: json.RawMessage(`{
+  "MessageId": "id-1",
+  "MD5OfBody": "5d41402abc4b2a76b9719d911017c592"
+}`),
+			},
+		},
+		DeadLetter: map[string]DeadLetterContext{
+			"id-1": {
+				ReceiveCount:    2,
+				SentAt:          time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC),
+				SourceQueueArn:  "arn:aws:sqs:us-east-1:000000000000:source",
+				SourceQueueName: "source",
+				MaxReceiveCount: 2,
 			},
 		},
 	}
@@ -901,7 +1273,18 @@ func TestHandlerImpl_ReceiveMessagesAPI_Success(t *testing.T) {
 		assert.Equal(t, "hello", msg.Body)
 		assert.Equal(t, "rh", msg.ReceiptHandle)
 		assert.Equal(t, int32(2), msg.ReceiveCount)
-		assert.Equal(t, []messageAttributeResponse{{Name: "key", Value: "value"}}, msg.Attributes)
+		assert.Equal(t, []messageAttributeResponse{
+			{Name: "key", Value: "value"},
+			{Name: "retries", Value: "3", DataType: "Number"},
+		}, msg.Attributes)
+		assert.JSONEq(t, `{"MessageId":"id-1","MD5OfBody":"5d41402abc4b2a76b9719d911017c592"}`, string(msg.Raw))
+		assert.Equal(t, &deadLetterContextItem{
+			ReceiveCount:    2,
+			SentAt:          "2026-10-01T10:00:00Z",
+			SourceQueueArn:  "arn:aws:sqs:us-east-1:000000000000:source",
+			SourceQueueName: "source",
+			MaxReceiveCount: 2,
+		}, msg.DeadLetter)
 	}
 }
 
@@ -929,6 +1312,109 @@ func TestHandlerImpl_ReceiveMessagesAPI_Defaults(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 }
 
+func TestHandlerImpl_ReceiveMessagesAPI_VisibilityTimeout(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	queueURL := "https://sqs.local/queues/orders"
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/messages/poll", strings.NewReader(`{"visibilityTimeout":0}`))
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ReceiveMessages(mock.Anything, ReceiveMessagesInput{QueueURL: queueURL, VisibilityTimeoutProvided: true}).
+		Return(ReceiveMessagesResult{}, nil).
+		Once()
+
+	handler.ReceiveMessagesAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestHandlerImpl_ReceiveMessagesAPI_FilterAndProjection(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	queueURL := "https://sqs.local/queues/orders"
+	body := `{"jsonPath":"$.type","jsonValue":"order","project":["$.order.id"]}`
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/messages/poll", strings.NewReader(body))
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ReceiveMessages(mock.Anything, ReceiveMessagesInput{
+			QueueURL:   queueURL,
+			Filter:     MessageFilter{JSONPath: "$.type", JSONValue: "order"},
+			Projection: []string{"$.order.id"},
+		}).
+		Return(ReceiveMessagesResult{
+			Messages:    []ReceivedMessage{{ID: "m-1", Body: `{"type":"order","order":{"id":42}}`, ReceiptHandle: "rh-1"}},
+			Projections: map[string]json.RawMessage{"m-1": json.RawMessage(`{"$.order.id": 42}`)},
+			FilteredOut: 3,
+		}, nil).
+		Once()
+
+	handler.ReceiveMessagesAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{
+		"messages": [{
+			"id": "m-1",
+			"body": "{\"type\":\"order\",\"order\":{\"id\":42}}",
+			"receiptHandle": "rh-1",
+			"receiveCount": 0,
+			"bodyHash": "`+bodyHash(`{"type":"order","order":{"id":42}}`)+`",
+			"attributes": [],
+			"projection": {"$.order.id": 42}
+		}],
+		"filteredOut": 3
+	}`, rr.Body.String())
+}
+
+func TestHandlerImpl_ReceiveMessagesAPI_GroupByMessageGroup(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	queueURL := "https://sqs.local/queues/orders.fifo"
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/messages/poll", strings.NewReader(`{"groupByMessageGroup":true}`))
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	rr := httptest.NewRecorder()
+
+	first := ReceivedMessage{ID: "id-1", Body: "first"}
+	second := ReceivedMessage{ID: "id-2", Body: "second"}
+
+	mockService.EXPECT().
+		ReceiveMessages(
+			mock.Anything,
+			ReceiveMessagesInput{QueueURL: queueURL, GroupByMessageGroup: true},
+		).
+		Return(ReceiveMessagesResult{
+			Messages: []ReceivedMessage{second, first},
+			Groups:   []MessageGroup{{GroupID: "group-1", Messages: []ReceivedMessage{first, second}, InOrder: false}},
+		}, nil).
+		Once()
+
+	handler.ReceiveMessagesAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var response receiveMessagesResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+
+	assert.Len(t, response.Messages, 2)
+	if assert.Len(t, response.Groups, 1) {
+		group := response.Groups[0]
+		assert.Equal(t, "group-1", group.MessageGroupID)
+		assert.False(t, group.InOrder)
+		if assert.Len(t, group.Messages, 2) {
+			assert.Equal(t, "id-1", group.Messages[0].ID)
+			assert.Equal(t, "id-2", group.Messages[1].ID)
+		}
+	}
+}
+
 func TestHandlerImpl_ReceiveMessagesAPI_BadRequests(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -997,6 +1483,26 @@ func TestHandlerImpl_ReceiveMessagesAPI_ServiceError(t *testing.T) {
 	assert.Equal(t, "{\"error\":\"boom\"}\n", rr.Body.String())
 }
 
+func TestHandlerImpl_ReceiveMessagesAPI_ShuttingDown(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	queueURL := "https://sqs.local/queues/orders"
+	req := httptest.NewRequest(http.MethodPost, "/queues/{url}/messages/poll", bytes.NewReader([]byte(`{}`)))
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ReceiveMessages(mock.Anything, mock.Anything).
+		Return(ReceiveMessagesResult{}, ErrShuttingDown).
+		Once()
+
+	handler.ReceiveMessagesAPI(rr, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "{\"error\":\"the server is shutting down; poll again in a moment\"}\n", rr.Body.String())
+}
+
 func TestHandlerImpl_DeleteMessageAPI_Success(t *testing.T) {
 	mockService := NewMockSqsService(t)
 	handler := NewHandler(mockService)
@@ -1112,6 +1618,160 @@ func TestHandlerImpl_DeleteMessageAPI_ServiceError(t *testing.T) {
 	assert.Equal(t, "{\"error\":\"boom\"}\n", rr.Body.String())
 }
 
+func TestHandlerImpl_ReceiveMergedMessagesAPI_Success(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	body := `{"queueUrls":["https://sqs.local/orders","https://sqs.local/broken"],"maxMessages":3}`
+	req := httptest.NewRequest(http.MethodPost, "/messages/poll", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ReceiveMergedMessages(
+			mock.MatchedBy(func(ctx context.Context) bool { return ctx == req.Context() }),
+			MergedReceiveInput{
+				QueueURLs:           []string{"https://sqs.local/orders", "https://sqs.local/broken"},
+				MaxMessages:         3,
+				MaxMessagesProvided: true,
+			},
+		).
+		Return(MergedReceiveResult{
+			Messages: []SourcedMessage{
+				{
+					ReceivedMessage: ReceivedMessage{ID: "id-1", Body: "hello", ReceiptHandle: "rh", ReceiveCount: 1},
+					QueueURL:        "https://sqs.local/orders",
+					QueueName:       "orders",
+				},
+			},
+			Failures: []QueueReceiveFailure{{QueueURL: "https://sqs.local/broken", Error: "boom"}},
+		}, nil).
+		Once()
+
+	handler.ReceiveMergedMessagesAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var response receiveMergedMessagesResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+
+	if assert.Len(t, response.Messages, 1) {
+		msg := response.Messages[0]
+		assert.Equal(t, "orders", msg.QueueName)
+		assert.Equal(t, "https://sqs.local/orders", msg.QueueURL)
+		assert.Equal(t, "id-1", msg.ID)
+		assert.Equal(t, "hello", msg.Body)
+		assert.Equal(t, "rh", msg.ReceiptHandle)
+	}
+	assert.Equal(t, []queueFailureItem{{QueueURL: "https://sqs.local/broken", Error: "boom"}}, response.Failures)
+}
+
+func TestHandlerImpl_ReceiveMergedMessagesAPI_Errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		arrange  func(s *MockSqsService)
+		wantBody string
+	}{
+		{
+			name:     "missing body",
+			body:     "",
+			wantBody: "{\"error\":\"request body is required\"}\n",
+		},
+		{
+			name:     "unknown field",
+			body:     `{"queueUrl":"x"}`,
+			wantBody: "{\"error\":\"invalid request body\"}\n",
+		},
+		{
+			name: "service error",
+			body: `{"queueUrls":[]}`,
+			arrange: func(s *MockSqsService) {
+				s.EXPECT().
+					ReceiveMergedMessages(mock.Anything, mock.Anything).
+					Return(MergedReceiveResult{}, errors.New("at least one queue url is required")).
+					Once()
+			},
+			wantBody: "{\"error\":\"at least one queue url is required\"}\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSqsService(t)
+			if tc.arrange != nil {
+				tc.arrange(mockService)
+			}
+			handler := NewHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/messages/poll", strings.NewReader(tc.body))
+			rr := httptest.NewRecorder()
+
+			handler.ReceiveMergedMessagesAPI(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.Equal(t, tc.wantBody, rr.Body.String())
+		})
+	}
+}
+
+func TestHandlerImpl_SaveDraftAPI(t *testing.T) {
+	queueURL := "https://sqs.local/queues/orders"
+
+	t.Run("saves draft", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/queues/{url}/draft", strings.NewReader(`{"body":"hello","attributes":[{"name":"k","value":"v"}]}`))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			SaveDraft(mock.Anything, queueURL, MessageDraft{Body: "hello", Attributes: []MessageAttribute{{Name: "k", Value: "v"}}}).
+			Return(MessageDraft{Body: "hello", SavedAt: time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)}, nil).
+			Once()
+
+		handler.SaveDraftAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"message\":\"Draft saved.\",\"savedAt\":\"2024-05-01T10:00:00Z\"}\n", rr.Body.String())
+	})
+
+	t.Run("reports cleared draft", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/queues/{url}/draft", strings.NewReader(`{"body":""}`))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			SaveDraft(mock.Anything, queueURL, mock.Anything).
+			Return(MessageDraft{}, nil).
+			Once()
+
+		handler.SaveDraftAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"message\":\"Draft cleared.\"}\n", rr.Body.String())
+	})
+
+	t.Run("rejects invalid body", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/queues/{url}/draft", strings.NewReader(`{"unknown":true}`))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		handler.SaveDraftAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"invalid request body\"}\n", rr.Body.String())
+	})
+}
+
 func captureQueuesTemplate(t *testing.T, captured *queuesPageData) {
 	t.Helper()
 	captureTemplate(t, "queues", func(data queuesPageData) { *captured = data })
@@ -1192,3 +1852,59 @@ func installFragment(t *testing.T, entry string, tags template.HTML) {
 func ptrInt32(v int32) *int32 {
 	return &v
 }
+
+func TestHandlerImpl_CleanupReportAPI(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	ranAt := time.Date(2024, 5, 1, 13, 0, 0, 0, time.UTC)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cleanup/report", nil)
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CleanupReport(mock.Anything).
+		Return(CleanupReport{
+			Enabled: true,
+			RanAt:   ranAt,
+			Pattern: "tmp-*",
+			IdleFor: time.Hour,
+			DryRun:  true,
+			Candidates: []CleanupCandidate{
+				{
+					QueueURL:   "https://sqs.local/tmp-a",
+					Name:       "tmp-a",
+					EmptySince: ranAt.Add(-time.Hour),
+					EligibleAt: ranAt,
+					Action:     CleanupActionWouldDelete,
+				},
+				{QueueURL: "https://sqs.local/tmp-b", Name: "tmp-b", Action: CleanupActionInUse},
+			},
+		}, nil).
+		Once()
+
+	handler.CleanupReportAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var response cleanupReportResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	assert.Equal(t, cleanupReportResponse{
+		Enabled: true,
+		Pattern: "tmp-*",
+		IdleFor: "1h0m0s",
+		DryRun:  true,
+		RanAt:   "2024-05-01T13:00:00Z",
+		Candidates: []cleanupCandidateItem{
+			{
+				QueueURL:   "https://sqs.local/tmp-a",
+				Name:       "tmp-a",
+				Action:     CleanupActionWouldDelete,
+				EmptySince: "2024-05-01T12:00:00Z",
+				EligibleAt: "2024-05-01T13:00:00Z",
+			},
+			{QueueURL: "https://sqs.local/tmp-b", Name: "tmp-b", Action: CleanupActionInUse},
+		},
+	}, response)
+}
@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// idempotencyWindow is how long a completed send is remembered under its idempotency key.
+	idempotencyWindow = 10 * time.Minute
+	// maxIdempotencyKeyLength bounds the size of client supplied keys.
+	maxIdempotencyKeyLength = 255
+)
+
+// ErrIdempotencyKeyInUse is returned while another request with the same idempotency key is
+// still being sent.
+var ErrIdempotencyKeyInUse = errors.New("a request with this idempotency key is still in progress")
+
+// ErrIdempotencyKeyReused is returned when an idempotency key is sent again with a different
+// message or queue.
+var ErrIdempotencyKeyReused = errors.New("this idempotency key was already used for a different message")
+
+type idempotencyEntry struct {
+	fingerprint string
+	done        bool
+	result      SendMessageResult
+	storedAt    time.Time
+}
+
+// idempotencyCache remembers recent sends by the Idempotency-Key the client attached, so a request
+// the client retries after a lost response is answered from memory instead of being sent twice.
+// Failed sends are forgotten so they can be retried with the same key.
+type idempotencyCache struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entries map[string]idempotencyEntry
+}
+
+func newIdempotencyCache() *idempotencyCache {
+	return &idempotencyCache{
+		now:     time.Now,
+		entries: make(map[string]idempotencyEntry),
+	}
+}
+
+// begin reserves key for a send of message. When the key already completed a send of the same
+// message, that result is returned with replay set.
+func (c *idempotencyCache) begin(key string, message SendMessageRepositoryInput) (SendMessageResult, bool, error) {
+	if c == nil || key == "" {
+		return SendMessageResult{}, false, nil
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return SendMessageResult{}, false, errors.Newf("idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+
+	fingerprint, err := sendFingerprint(message)
+	if err != nil {
+		return SendMessageResult{}, false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pruneLocked()
+	if entry, ok := c.entries[key]; ok {
+		if entry.fingerprint != fingerprint {
+			return SendMessageResult{}, false, ErrIdempotencyKeyReused
+		}
+		if !entry.done {
+			return SendMessageResult{}, false, ErrIdempotencyKeyInUse
+		}
+		return entry.result, true, nil
+	}
+
+	c.entries[key] = idempotencyEntry{fingerprint: fingerprint, storedAt: c.now()}
+	return SendMessageResult{}, false, nil
+}
+
+// complete stores the result of a successful send under key.
+func (c *idempotencyCache) complete(key string, result SendMessageResult) {
+	if c == nil || key == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	entry.done = true
+	entry.result = result
+	entry.storedAt = c.now()
+	c.entries[key] = entry
+}
+
+// abandon releases key after a failed send.
+func (c *idempotencyCache) abandon(key string) {
+	if c == nil || key == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+func (c *idempotencyCache) pruneLocked() {
+	cutoff := c.now().Add(-idempotencyWindow)
+	for key, entry := range c.entries {
+		if entry.done && entry.storedAt.Before(cutoff) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// sendFingerprint identifies the queue and content of a send. Map keys are marshalled in sorted
+// order, so equal messages always produce the same fingerprint.
+func sendFingerprint(message SendMessageRepositoryInput) (string, error) {
+	encoded, err := json.Marshal(message)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to fingerprint message")
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
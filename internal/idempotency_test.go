@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_SendMessage_IdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("replays a repeated send without sending again", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, idempotency: newIdempotencyCache()}
+
+		repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil).Once()
+
+		input := SendMessageInput{QueueURL: queueURL, Body: "hello", IdempotencyKey: "key-1"}
+		first, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.False(t, first.Replayed)
+
+		second, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.True(t, second.Replayed)
+	})
+
+	t.Run("rejects the key for a different message", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, idempotency: newIdempotencyCache()}
+
+		repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := service.SendMessage(ctx, SendMessageInput{QueueURL: queueURL, Body: "hello", IdempotencyKey: "key-1"})
+		require.NoError(t, err)
+
+		_, err = service.SendMessage(ctx, SendMessageInput{QueueURL: queueURL, Body: "other", IdempotencyKey: "key-1"})
+		assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	})
+
+	t.Run("allows a retry after a failed send", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, idempotency: newIdempotencyCache()}
+
+		repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(errors.New("boom")).Once()
+		repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil).Once()
+
+		input := SendMessageInput{QueueURL: queueURL, Body: "hello", IdempotencyKey: "key-1"}
+		_, err := service.SendMessage(ctx, input)
+		require.Error(t, err)
+
+		result, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.False(t, result.Replayed)
+	})
+
+	t.Run("rejects overly long keys", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t), idempotency: newIdempotencyCache()}
+
+		_, err := service.SendMessage(ctx, SendMessageInput{QueueURL: queueURL, Body: "hello", IdempotencyKey: strings.Repeat("k", 256)})
+		assert.EqualError(t, err, "idempotency key must be at most 255 characters")
+	})
+}
+
+func TestIdempotencyCache(t *testing.T) {
+	message := SendMessageRepositoryInput{QueueURL: "https://sqs.local/orders", Body: "hello"}
+
+	t.Run("reports a send that is still in progress", func(t *testing.T) {
+		cache := newIdempotencyCache()
+
+		_, replay, err := cache.begin("key-1", message)
+		require.NoError(t, err)
+		assert.False(t, replay)
+
+		_, _, err = cache.begin("key-1", message)
+		assert.ErrorIs(t, err, ErrIdempotencyKeyInUse)
+	})
+
+	t.Run("forgets completed sends after the window", func(t *testing.T) {
+		now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+		cache := newIdempotencyCache()
+		cache.now = func() time.Time { return now }
+
+		_, _, err := cache.begin("key-1", message)
+		require.NoError(t, err)
+		cache.complete("key-1", SendMessageResult{Warning: "duplicate"})
+
+		result, replay, err := cache.begin("key-1", message)
+		require.NoError(t, err)
+		assert.True(t, replay)
+		assert.Equal(t, "duplicate", result.Warning)
+
+		now = now.Add(idempotencyWindow + time.Second)
+		_, replay, err = cache.begin("key-1", message)
+		require.NoError(t, err)
+		assert.False(t, replay)
+	})
+}
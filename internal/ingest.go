@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// maxMessageAttributes is how many message attributes SQS accepts on one message.
+const maxMessageAttributes = 10
+
+// ErrIngestRouteNotFound is returned when no ingest route has the requested alias.
+var ErrIngestRouteNotFound = errors.New("no ingest route with this alias")
+
+// ingestSkippedHeaders are request headers that describe the HTTP exchange or carry credentials
+// rather than the webhook, so they are not copied onto the message.
+var ingestSkippedHeaders = map[string]bool{
+	"Accept-Encoding":     true,
+	"Authorization":       true,
+	"Connection":          true,
+	"Content-Length":      true,
+	"Cookie":              true,
+	"Expect":              true,
+	"Host":                true,
+	"Keep-Alive":          true,
+	"Proxy-Authorization": true,
+	"Te":                  true,
+	"Trailer":             true,
+	"Transfer-Encoding":   true,
+	"Upgrade":             true,
+}
+
+// IngestInput is one webhook delivery to forward. Headers uses canonical header names, as in
+// http.Header. MessageGroupID defaults to the alias on FIFO queues.
+type IngestInput struct {
+	Alias                  string
+	Body                   string
+	Headers                map[string][]string
+	MessageGroupID         string
+	MessageDeduplicationID string
+}
+
+// IngestResult tells where a webhook was sent and which headers became message attributes.
+// DroppedHeaders lists the headers that did not fit in the SQS attribute limit or have names SQS
+// does not accept.
+type IngestResult struct {
+	QueueURL       string
+	Attributes     []string
+	DroppedHeaders []string
+}
+
+// IngestMessage forwards a webhook body to the queue mapped to its alias, with the request headers
+// as string message attributes. SQS allows ten attributes, so Content-Type goes first, then X-
+// headers, then the rest, each in name order. Like scheduled sends, deliveries go straight to the
+// repository so they neither overwrite the send form defaults and draft of the queue nor trigger
+// the interactive duplicate warnings.
+func (s *SqsServiceImpl) IngestMessage(ctx context.Context, input IngestInput) (IngestResult, error) {
+	target, ok := s.config.IngestRoutes[input.Alias]
+	if !ok {
+		return IngestResult{}, errors.WithStack(ErrIngestRouteNotFound)
+	}
+
+	queueURL := target
+	if !strings.HasPrefix(target, "http://") && !strings.HasPrefix(target, "https://") {
+		resolved, exists, err := s.repo.QueueURL(ctx, target)
+		if err != nil {
+			return IngestResult{}, err
+		}
+		if !exists {
+			return IngestResult{}, errors.Newf("queue %s of ingest route %s does not exist", target, input.Alias)
+		}
+		queueURL = resolved
+	}
+
+	result := IngestResult{QueueURL: queueURL}
+	var attributes []MessageAttribute
+	for _, name := range ingestHeaderOrder(input.Headers) {
+		if ingestSkippedHeaders[name] {
+			continue
+		}
+		if len(attributes) == maxMessageAttributes || !validAttributeName(name) {
+			result.DroppedHeaders = append(result.DroppedHeaders, name)
+			continue
+		}
+		attributes = append(attributes, MessageAttribute{Name: name, Value: strings.Join(input.Headers[name], ", ")})
+		result.Attributes = append(result.Attributes, name)
+	}
+
+	send := SendMessageInput{
+		QueueURL:               queueURL,
+		Body:                   input.Body,
+		MessageDeduplicationID: input.MessageDeduplicationID,
+		Attributes:             attributes,
+	}
+	if strings.HasSuffix(queueURL, ".fifo") {
+		send.MessageGroupID = cmp.Or(strings.TrimSpace(input.MessageGroupID), input.Alias)
+	}
+	message, _, err := prepareMessage(queueURL, send)
+	if err != nil {
+		return IngestResult{}, err
+	}
+	if err := s.repo.SendMessage(ctx, message); err != nil {
+		return IngestResult{}, err
+	}
+	return result, nil
+}
+
+// ingestHeaderOrder sorts header names by how useful they are as attributes.
+func ingestHeaderOrder(headers map[string][]string) []string {
+	rank := func(name string) int {
+		switch {
+		case name == "Content-Type":
+			return 0
+		case strings.HasPrefix(name, "X-"):
+			return 1
+		default:
+			return 2
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return cmp.Or(cmp.Compare(rank(a), rank(b)), strings.Compare(a, b))
+	})
+	return names
+}
+
+// validAttributeName reports whether SQS accepts name as a message attribute name: letters, digits,
+// '-', '_' and '.', no reserved AWS. or Amazon. prefix, at most 256 characters.
+func validAttributeName(name string) bool {
+	if name == "" || len(name) > 256 {
+		return false
+	}
+	lower := strings.ToLower(name)
+	if strings.HasPrefix(lower, "aws.") || strings.HasPrefix(lower, "amazon.") {
+		return false
+	}
+	return strings.IndexFunc(name, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.')
+	}) < 0
+}
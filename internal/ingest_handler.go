@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+type ingestResponse struct {
+	QueueURL       string   `json:"queueUrl"`
+	Attributes     []string `json:"attributes"`
+	DroppedHeaders []string `json:"droppedHeaders,omitempty"`
+}
+
+// IngestAPI forwards the body of any POST to the queue configured for the alias in
+// SQS_GUI_INGEST_ROUTES, so webhooks can be pointed at a queue during development. FIFO queues take
+// the messageGroupId and deduplicationId query parameters.
+func (h *HandlerImpl) IngestAPI(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxMessageBodyBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, "request body exceeds the 256 KB SQS message limit")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+
+	query := r.URL.Query()
+	alias := r.PathValue("alias")
+	result, err := h.s.IngestMessage(r.Context(), IngestInput{
+		Alias:                  alias,
+		Body:                   string(body),
+		Headers:                r.Header,
+		MessageGroupID:         strings.TrimSpace(query.Get("messageGroupId")),
+		MessageDeduplicationID: strings.TrimSpace(query.Get("deduplicationId")),
+	})
+	if err != nil {
+		if errors.Is(err, ErrIngestRouteNotFound) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		slog.ErrorContext(r.Context(), "failed to ingest message", slog.String("alias", alias), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	response := ingestResponse{
+		QueueURL:       result.QueueURL,
+		Attributes:     result.Attributes,
+		DroppedHeaders: result.DroppedHeaders,
+	}
+	if response.Attributes == nil {
+		response.Attributes = []string{}
+	}
+	writeJSON(w, http.StatusAccepted, response)
+}
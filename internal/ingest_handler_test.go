@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_IngestAPI(t *testing.T) {
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/ingest/github?messageGroupId=repo-1", strings.NewReader(body))
+		req.Header.Set("X-Github-Event", "push")
+		req.SetPathValue("alias", "github")
+		return req
+	}
+
+	t.Run("forwards the request", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().IngestMessage(mock.Anything, mock.MatchedBy(func(input IngestInput) bool {
+			return input.Alias == "github" && input.Body == `{"ref":"main"}` &&
+				input.MessageGroupID == "repo-1" && input.Headers["X-Github-Event"][0] == "push"
+		})).Return(IngestResult{QueueURL: "https://sqs.local/webhooks", Attributes: []string{"X-Github-Event"}}, nil).Once()
+
+		handler.IngestAPI(rr, newRequest(`{"ref":"main"}`))
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.JSONEq(t, `{"queueUrl":"https://sqs.local/webhooks","attributes":["X-Github-Event"]}`, rr.Body.String())
+	})
+
+	t.Run("answers unknown aliases with not found", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().IngestMessage(mock.Anything, mock.Anything).Return(IngestResult{}, ErrIngestRouteNotFound).Once()
+
+		handler.IngestAPI(rr, newRequest("ping"))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("rejects bodies over the message limit", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		handler.IngestAPI(rr, newRequest(strings.Repeat("a", maxMessageBodyBytes+1)))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+	})
+}
@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_IngestMessage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sends the body with headers as attributes", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, config: ServiceConfig{IngestRoutes: map[string]string{"github": "webhooks"}}}
+
+		repo.EXPECT().QueueURL(mock.Anything, "webhooks").Return("https://sqs.local/webhooks", true, nil).Once()
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{
+			QueueURL: "https://sqs.local/webhooks",
+			Body:     `{"action":"opened"}`,
+			Attributes: map[string]string{
+				"Content-Type":   "application/json",
+				"X-Github-Event": "pull_request",
+				"Accept":         "*/*, text/plain",
+			},
+		}).Return(nil).Once()
+
+		result, err := service.IngestMessage(ctx, IngestInput{
+			Alias: "github",
+			Body:  `{"action":"opened"}`,
+			Headers: map[string][]string{
+				"Accept":         {"*/*", "text/plain"},
+				"Authorization":  {"Bearer secret"},
+				"Content-Type":   {"application/json"},
+				"X-Github-Event": {"pull_request"},
+				"X-Odd!Name":     {"1"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, IngestResult{
+			QueueURL:       "https://sqs.local/webhooks",
+			Attributes:     []string{"Content-Type", "X-Github-Event", "Accept"},
+			DroppedHeaders: []string{"X-Odd!Name"},
+		}, result)
+	})
+
+	t.Run("keeps ten headers and groups fifo messages by alias", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		queueURL := "https://sqs.local/events.fifo"
+		service := &SqsServiceImpl{repo: repo, config: ServiceConfig{IngestRoutes: map[string]string{"events": queueURL}}}
+
+		headers := map[string][]string{"User-Agent": {"curl"}}
+		for i := range 10 {
+			headers[fmt.Sprintf("X-H%d", i)] = []string{"v"}
+		}
+		repo.EXPECT().SendMessage(mock.Anything, mock.MatchedBy(func(input SendMessageRepositoryInput) bool {
+			return input.MessageGroupID == "events" && input.MessageDeduplicationID == "d-1" && len(input.Attributes) == 10
+		})).Return(nil).Once()
+
+		result, err := service.IngestMessage(ctx, IngestInput{Alias: "events", Body: "ping", Headers: headers, MessageDeduplicationID: "d-1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"User-Agent"}, result.DroppedHeaders)
+	})
+
+	t.Run("leaves the draft and send defaults of the queue alone", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		queueURL := "https://sqs.local/webhooks"
+		service := &SqsServiceImpl{repo: repo, store: store, config: ServiceConfig{IngestRoutes: map[string]string{"github": queueURL}}}
+		draft := MessageDraft{Body: `{"half":"typed"}`}
+		require.NoError(t, store.SaveDraft(queueURL, draft))
+
+		repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err = service.IngestMessage(ctx, IngestInput{Alias: "github", Body: "ping", Headers: map[string][]string{"X-Github-Event": {"ping"}}})
+		require.NoError(t, err)
+		saved, ok, err := store.Draft(queueURL)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, draft.Body, saved.Body)
+		_, remembered, err := store.SendDefaults(queueURL)
+		require.NoError(t, err)
+		assert.False(t, remembered, "webhook headers must not become the send form defaults")
+	})
+
+	t.Run("rejects unknown aliases", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.IngestMessage(ctx, IngestInput{Alias: "missing", Body: "ping"})
+		assert.ErrorIs(t, err, ErrIngestRouteNotFound)
+	})
+
+	t.Run("reports a missing queue", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, config: ServiceConfig{IngestRoutes: map[string]string{"github": "webhooks"}}}
+
+		repo.EXPECT().QueueURL(mock.Anything, "webhooks").Return("", false, nil).Once()
+
+		_, err := service.IngestMessage(ctx, IngestInput{Alias: "github", Body: "ping"})
+		assert.EqualError(t, err, "queue webhooks of ingest route github does not exist")
+	})
+}
@@ -0,0 +1,349 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrJobNotFound is returned when a job ID is unknown or has been forgotten.
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrJobFileUnavailable is returned when a job has no file to download, or not yet.
+var ErrJobFileUnavailable = errors.New("the job has no file to download yet")
+
+// ErrPurgeInProgress is returned when SQS refuses a purge because the queue was purged less than
+// 60 seconds ago.
+var ErrPurgeInProgress = errors.New("the queue was purged less than 60 seconds ago")
+
+// maxFinishedJobs bounds how many completed jobs are remembered for polling.
+const maxFinishedJobs = 100
+
+// JobStatus is the lifecycle state of a background job.
+type JobStatus string
+
+const (
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// Job is a long-running operation executed in the background. Done and Total report progress in
+// the unit the job works in (messages, queues, attempts); Total is zero when it is not known.
+type Job struct {
+	ID         string
+	Kind       string
+	QueueURL   string
+	Status     JobStatus
+	Done       int64
+	Total      int64
+	Message    string
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt time.Time
+	// Details are extra lines a job reports besides Message, such as the messages a dry run
+	// would delete.
+	Details []string
+	// File is the path of a file the job wrote, such as drained messages, offered as a download.
+	// It is removed when the job is forgotten.
+	File string
+	// Items track the parts of a job that works through a list, such as the queues of a bulk
+	// operation, each with its own outcome.
+	Items []JobItem
+}
+
+// JobItemStatus is the state of one item of a job.
+type JobItemStatus string
+
+const (
+	JobItemPending   JobItemStatus = "pending"
+	JobItemRunning   JobItemStatus = "running"
+	JobItemSucceeded JobItemStatus = "succeeded"
+	JobItemFailed    JobItemStatus = "failed"
+)
+
+// JobItem is one part of a job, identified by Key, such as a queue URL.
+type JobItem struct {
+	Key    string
+	Status JobItemStatus
+	Error  string
+}
+
+// JobProgress lets a running job publish its progress.
+type JobProgress struct {
+	registry *jobRegistry
+	id       string
+}
+
+// SetTotal records how much work the job expects to do.
+func (p *JobProgress) SetTotal(total int64) {
+	p.registry.update(p.id, func(job *Job) { job.Total = total })
+}
+
+// Advance adds n to the amount of work done.
+func (p *JobProgress) Advance(n int64) {
+	p.registry.update(p.id, func(job *Job) { job.Done += n })
+}
+
+// SetMessage replaces the human readable status line.
+func (p *JobProgress) SetMessage(message string) {
+	p.registry.update(p.id, func(job *Job) { job.Message = message })
+}
+
+// AddDetail appends a line to the job's details.
+func (p *JobProgress) AddDetail(detail string) {
+	p.registry.update(p.id, func(job *Job) { job.Details = append(job.Details, detail) })
+}
+
+// SetItems lists the items the job works through, all pending, and sets the total to their number.
+func (p *JobProgress) SetItems(keys []string) {
+	p.registry.update(p.id, func(job *Job) {
+		job.Items = make([]JobItem, 0, len(keys))
+		for _, key := range keys {
+			job.Items = append(job.Items, JobItem{Key: key, Status: JobItemPending})
+		}
+		job.Total = int64(len(keys))
+	})
+}
+
+// StartItem marks the item with key as being worked on.
+func (p *JobProgress) StartItem(key string) {
+	p.registry.update(p.id, func(job *Job) { job.setItem(key, JobItemRunning, nil) })
+}
+
+// FinishItem records the outcome of the item with key and counts it as done.
+func (p *JobProgress) FinishItem(key string, err error) {
+	p.registry.update(p.id, func(job *Job) {
+		if err != nil {
+			job.setItem(key, JobItemFailed, err)
+		} else {
+			job.setItem(key, JobItemSucceeded, nil)
+		}
+		job.Done++
+	})
+}
+
+func (j *Job) setItem(key string, status JobItemStatus, err error) {
+	for i := range j.Items {
+		if j.Items[i].Key == key {
+			j.Items[i].Status = status
+			if err != nil {
+				j.Items[i].Error = err.Error()
+			}
+			return
+		}
+	}
+}
+
+// SetFile records the file the job writes its output to.
+func (p *JobProgress) SetFile(path string) {
+	p.registry.update(p.id, func(job *Job) { job.File = path })
+}
+
+// jobRegistry keeps jobs in memory; they do not survive a restart.
+type jobRegistry struct {
+	mu   sync.Mutex
+	now  func() time.Time
+	jobs map[string]*Job
+	// retryDelay is how long jobs wait before retrying an operation SQS asked them to repeat later.
+	retryDelay time.Duration
+	wg         sync.WaitGroup
+}
+
+func newJobRegistry() *jobRegistry {
+	return &jobRegistry{
+		now:        time.Now,
+		jobs:       make(map[string]*Job),
+		retryDelay: 10 * time.Second,
+	}
+}
+
+// start registers a job and runs it in its own goroutine. The job outlives the request that
+// started it, so ctx only contributes its values, not its cancellation.
+func (r *jobRegistry) start(ctx context.Context, kind, queueURL string, run func(ctx context.Context, progress *JobProgress) error) (Job, error) {
+	id, err := newRandomID()
+	if err != nil {
+		return Job{}, err
+	}
+
+	now := r.now()
+	job := &Job{
+		ID:        id,
+		Kind:      kind,
+		QueueURL:  queueURL,
+		Status:    JobStatusRunning,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	r.mu.Lock()
+	r.jobs[id] = job
+	snapshot := *job
+	r.pruneLocked()
+	r.mu.Unlock()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		err := run(context.WithoutCancel(ctx), &JobProgress{registry: r, id: id})
+		r.update(id, func(job *Job) {
+			job.FinishedAt = job.UpdatedAt
+			if err != nil {
+				slog.WarnContext(ctx, "background job failed", slog.String("job_id", id), slog.String("kind", kind), slog.Any("error", err))
+				job.Status = JobStatusFailed
+				job.Error = err.Error()
+				return
+			}
+			job.Status = JobStatusSucceeded
+		})
+	}()
+
+	return snapshot, nil
+}
+
+func (r *jobRegistry) get(id string) (Job, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return Job{}, false
+	}
+	snapshot := *job
+	snapshot.Details = slices.Clone(job.Details)
+	snapshot.Items = slices.Clone(job.Items)
+	return snapshot, true
+}
+
+// list returns the jobs still remembered, newest first.
+func (r *jobRegistry) list() []Job {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	jobs := make([]Job, 0, len(r.jobs))
+	for _, job := range r.jobs {
+		snapshot := *job
+		snapshot.Details = slices.Clone(job.Details)
+		snapshot.Items = slices.Clone(job.Items)
+		jobs = append(jobs, snapshot)
+	}
+	slices.SortFunc(jobs, func(a, b Job) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return jobs
+}
+
+func (r *jobRegistry) update(id string, apply func(job *Job)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return
+	}
+	job.UpdatedAt = r.now()
+	apply(job)
+}
+
+// wait blocks for the retry delay or until ctx is done.
+func (r *jobRegistry) wait(ctx context.Context) error {
+	return waitFor(ctx, r.retryDelay)
+}
+
+// pruneLocked forgets the oldest finished jobs beyond maxFinishedJobs. Running jobs are kept.
+func (r *jobRegistry) pruneLocked() {
+	finished := make([]*Job, 0, len(r.jobs))
+	for _, job := range r.jobs {
+		if job.Status != JobStatusRunning {
+			finished = append(finished, job)
+		}
+	}
+	if len(finished) <= maxFinishedJobs {
+		return
+	}
+
+	slices.SortFunc(finished, func(a, b *Job) int {
+		return a.FinishedAt.Compare(b.FinishedAt)
+	})
+	for _, job := range finished[:len(finished)-maxFinishedJobs] {
+		delete(r.jobs, job.ID)
+		if job.File != "" {
+			if err := os.Remove(job.File); err != nil && !errors.Is(err, os.ErrNotExist) {
+				slog.Warn("failed to remove job file", slog.String("job_id", job.ID), slog.String("path", job.File), slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// Job returns the current state of a background job.
+func (s *SqsServiceImpl) Job(_ context.Context, id string) (Job, error) {
+	job, ok := s.jobs.get(id)
+	if !ok {
+		return Job{}, ErrJobNotFound
+	}
+	return job, nil
+}
+
+// OpenJobFile opens the file a finished job wrote. The caller closes it.
+func (s *SqsServiceImpl) OpenJobFile(_ context.Context, id string) (Job, *os.File, error) {
+	job, ok := s.jobs.get(id)
+	if !ok {
+		return Job{}, nil, ErrJobNotFound
+	}
+	if job.File == "" || job.Status == JobStatusRunning {
+		return job, nil, ErrJobFileUnavailable
+	}
+	file, err := os.Open(job.File)
+	if err != nil {
+		return job, nil, errors.Wrap(err, "failed to open job file")
+	}
+	return job, file, nil
+}
+
+// purgeMaxAttempts covers SQS's 60 second purge cooldown with the default retry delay.
+const purgeMaxAttempts = 8
+
+// StartPurge purges a queue in the background. When SQS reports that a purge is still in
+// progress the job waits and tries again instead of failing.
+func (s *SqsServiceImpl) StartPurge(ctx context.Context, queueURL string) (Job, error) {
+	if strings.TrimSpace(queueURL) == "" {
+		return Job{}, errors.New("queue url is required")
+	}
+
+	return s.jobs.start(ctx, "purge", queueURL, func(ctx context.Context, progress *JobProgress) error {
+		progress.SetTotal(1)
+		if err := s.purgeWithRetry(ctx, queueURL, progress); err != nil {
+			return err
+		}
+		progress.Advance(1)
+		progress.SetMessage("Purge requested. SQS may take up to 60 seconds to delete every message.")
+		return nil
+	})
+}
+
+// purgeWithRetry purges a queue from a job, waiting and trying again while SQS reports that the
+// previous purge is still in progress.
+func (s *SqsServiceImpl) purgeWithRetry(ctx context.Context, queueURL string, progress *JobProgress) error {
+	for attempt := 1; ; attempt++ {
+		progress.SetMessage("Purging messages.")
+		err := s.repo.PurgeQueue(ctx, queueURL)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrPurgeInProgress) || attempt == purgeMaxAttempts {
+			return err
+		}
+
+		progress.SetMessage("Waiting for the previous purge to finish; SQS allows one purge per queue every 60 seconds.")
+		if err := s.jobs.wait(ctx); err != nil {
+			return err
+		}
+	}
+}
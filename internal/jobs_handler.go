@@ -0,0 +1,174 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+type jobResponse struct {
+	ID         string   `json:"id"`
+	Kind       string   `json:"kind"`
+	QueueURL   string   `json:"queueUrl,omitempty"`
+	Status     string   `json:"status"`
+	Done       int64    `json:"done"`
+	Total      int64    `json:"total"`
+	Message    string   `json:"message,omitempty"`
+	Details    []string `json:"details,omitempty"`
+	Error      string   `json:"error,omitempty"`
+	CreatedAt  string   `json:"createdAt"`
+	UpdatedAt  string   `json:"updatedAt"`
+	FinishedAt string   `json:"finishedAt,omitempty"`
+	Download   string   `json:"download,omitempty"`
+	// Items are present for jobs that work through a list, such as bulk queue operations.
+	Items []jobItemResponse `json:"items,omitempty"`
+}
+
+type jobItemResponse struct {
+	Key    string `json:"key"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type bulkQueueRequest struct {
+	Action    string   `json:"action"`
+	QueueURLs []string `json:"queueUrls"`
+	// Tags are the tags to set with the tag action.
+	Tags map[string]string `json:"tags"`
+	// ConfirmCount repeats the number of queues, so a stale selection is not changed by mistake.
+	ConfirmCount int `json:"confirmCount"`
+}
+
+// JobAPI reports the state of a background job so pages can poll it.
+func (h *HandlerImpl) JobAPI(w http.ResponseWriter, r *http.Request) {
+	job, err := h.s.Job(r.Context(), r.PathValue("id"))
+	if err != nil {
+		if errors.Is(err, ErrJobNotFound) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		slog.ErrorContext(r.Context(), "failed to load job", slog.String("job_id", r.PathValue("id")), slog.Any("error", err))
+		writeJSONError(w, http.StatusInternalServerError, "failed to load job")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newJobResponse(job))
+}
+
+// JobFileAPI downloads the file a finished job wrote, such as the messages of a drain to file.
+func (h *HandlerImpl) JobFileAPI(w http.ResponseWriter, r *http.Request) {
+	job, file, err := h.s.OpenJobFile(r.Context(), r.PathValue("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrJobNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, ErrJobFileUnavailable):
+			writeJSONError(w, http.StatusConflict, err.Error())
+		default:
+			slog.ErrorContext(r.Context(), "failed to open job file", slog.String("job_id", r.PathValue("id")), slog.Any("error", err))
+			writeJSONError(w, http.StatusInternalServerError, "failed to open job file")
+		}
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	filename := fmt.Sprintf("%s-%s%s", extractQueueName(job.QueueURL), job.CreatedAt.Format("20060102-150405"), filepath.Ext(job.File))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	if filepath.Ext(job.File) == ".ndjson" {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	http.ServeContent(w, r, filename, job.FinishedAt, file)
+}
+
+// StartPurgeAPI starts purging a queue in the background and returns the job to poll. Like the
+// form endpoint it requires confirm_name to repeat the queue name, and with backup set it archives
+// the messages to a file first.
+func (h *HandlerImpl) StartPurgeAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	if err := checkConfirmName(r, queueURL); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	start := h.s.StartPurge
+	if purgeBackupRequested(r) {
+		start = h.s.StartPurgeWithBackup
+	}
+	job, err := start(r.Context(), queueURL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start purge", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, newJobResponse(job))
+}
+
+// BulkQueueOperationAPI starts deleting, purging or tagging the queues in the request body and returns the
+// job to poll for the progress and outcome of each queue.
+func (h *HandlerImpl) BulkQueueOperationAPI(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	var payload bulkQueueRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+	if payload.ConfirmCount != len(payload.QueueURLs) {
+		writeJSONError(w, http.StatusBadRequest, "confirmCount must repeat the number of queues")
+		return
+	}
+
+	job, err := h.s.StartBulkQueueOperation(r.Context(), BulkQueueInput{Action: payload.Action, QueueURLs: payload.QueueURLs, Tags: payload.Tags})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start bulk queue operation", slog.String("action", payload.Action), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, newJobResponse(job))
+}
+
+func newJobResponse(job Job) jobResponse {
+	response := jobResponse{
+		ID:        job.ID,
+		Kind:      job.Kind,
+		QueueURL:  job.QueueURL,
+		Status:    string(job.Status),
+		Done:      job.Done,
+		Total:     job.Total,
+		Message:   job.Message,
+		Details:   job.Details,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: job.UpdatedAt.Format(time.RFC3339),
+	}
+	if !job.FinishedAt.IsZero() {
+		response.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+		if job.File != "" {
+			response.Download = "/api/v1/jobs/" + url.PathEscape(job.ID) + "/file"
+		}
+	}
+	for _, item := range job.Items {
+		response.Items = append(response.Items, jobItemResponse{
+			Key:    item.Key,
+			Name:   extractQueueName(item.Key),
+			Status: string(item.Status),
+			Error:  item.Error,
+		})
+	}
+	return response
+}
@@ -0,0 +1,247 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerImpl_JobAPI(t *testing.T) {
+	t.Run("returns job state", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs/abc123", nil)
+		req.SetPathValue("id", "abc123")
+		rr := httptest.NewRecorder()
+
+		created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		mockService.EXPECT().Job(mock.Anything, "abc123").Return(Job{
+			ID:        "abc123",
+			Kind:      "purge",
+			QueueURL:  "https://sqs.local/orders",
+			Status:    JobStatusRunning,
+			Total:     1,
+			Message:   "Purging messages.",
+			CreatedAt: created,
+			UpdatedAt: created.Add(time.Second),
+		}, nil).Once()
+
+		handler.JobAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"id": "abc123",
+			"kind": "purge",
+			"queueUrl": "https://sqs.local/orders",
+			"status": "running",
+			"done": 0,
+			"total": 1,
+			"message": "Purging messages.",
+			"createdAt": "2024-05-01T12:00:00Z",
+			"updatedAt": "2024-05-01T12:00:01Z"
+		}`, rr.Body.String())
+	})
+
+	t.Run("reports the items of a bulk job", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs/bulk1", nil)
+		req.SetPathValue("id", "bulk1")
+		rr := httptest.NewRecorder()
+
+		created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		mockService.EXPECT().Job(mock.Anything, "bulk1").Return(Job{
+			ID:        "bulk1",
+			Kind:      "bulk-delete",
+			Status:    JobStatusRunning,
+			Done:      1,
+			Total:     2,
+			CreatedAt: created,
+			UpdatedAt: created,
+			Items: []JobItem{
+				{Key: "https://sqs.local/orders", Status: JobItemFailed, Error: "access denied"},
+				{Key: "https://sqs.local/billing", Status: JobItemRunning},
+			},
+		}, nil).Once()
+
+		handler.JobAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"id": "bulk1",
+			"kind": "bulk-delete",
+			"status": "running",
+			"done": 1,
+			"total": 2,
+			"createdAt": "2024-05-01T12:00:00Z",
+			"updatedAt": "2024-05-01T12:00:00Z",
+			"items": [
+				{"key": "https://sqs.local/orders", "name": "orders", "status": "failed", "error": "access denied"},
+				{"key": "https://sqs.local/billing", "name": "billing", "status": "running"}
+			]
+		}`, rr.Body.String())
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs/missing", nil)
+		req.SetPathValue("id", "missing")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().Job(mock.Anything, "missing").Return(Job{}, ErrJobNotFound).Once()
+
+		handler.JobAPI(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.JSONEq(t, `{"error":"job not found"}`, rr.Body.String())
+	})
+}
+
+func TestHandlerImpl_JobFileAPI(t *testing.T) {
+	newRequest := func(id string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/jobs/"+id+"/file", nil)
+		req.SetPathValue("id", id)
+		return req
+	}
+
+	t.Run("downloads the file", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		path := filepath.Join(t.TempDir(), "sqs-gui-drain-1.ndjson")
+		require.NoError(t, os.WriteFile(path, []byte(`{"messageId":"m-1"}`+"\n"), 0o600))
+		file, err := os.Open(path)
+		require.NoError(t, err)
+
+		created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		mockService.EXPECT().OpenJobFile(mock.Anything, "abc123").Return(Job{
+			ID:        "abc123",
+			QueueURL:  "https://sqs.local/orders",
+			Status:    JobStatusSucceeded,
+			File:      path,
+			CreatedAt: created,
+		}, file, nil).Once()
+
+		handler.JobFileAPI(rr, newRequest("abc123"))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, `attachment; filename="orders-20240501-120000.ndjson"`, rr.Header().Get("Content-Disposition"))
+		assert.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+		assert.Equal(t, `{"messageId":"m-1"}`+"\n", rr.Body.String())
+	})
+
+	t.Run("refuses running jobs", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().OpenJobFile(mock.Anything, "abc123").Return(Job{}, nil, ErrJobFileUnavailable).Once()
+
+		handler.JobFileAPI(rr, newRequest("abc123"))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestHandlerImpl_StartPurgeAPI(t *testing.T) {
+	queueURL := "https://sqs.local/queues/orders"
+
+	t.Run("starts a purge job", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/queues/{url}/purge", strings.NewReader("confirm_name=orders"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().StartPurge(mock.Anything, queueURL).Return(Job{ID: "abc123", Kind: "purge", Status: JobStatusRunning}, nil).Once()
+
+		handler.StartPurgeAPI(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"id":"abc123"`)
+		assert.Contains(t, rr.Body.String(), `"status":"running"`)
+	})
+
+	t.Run("archives the messages first when asked to", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/queues/{url}/purge", strings.NewReader("confirm_name=orders&backup=on"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().StartPurgeWithBackup(mock.Anything, queueURL).Return(Job{ID: "abc123", Kind: "purge-with-backup", Status: JobStatusRunning}, nil).Once()
+
+		handler.StartPurgeAPI(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"id":"abc123"`)
+	})
+
+	t.Run("requires the queue name", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/queues/{url}/purge", strings.NewReader("confirm_name=order"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		handler.StartPurgeAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"type the queue name to confirm"}`, rr.Body.String())
+	})
+}
+
+func TestHandlerImpl_BulkQueueOperationAPI(t *testing.T) {
+	queueURLs := []string{"https://sqs.local/orders", "https://sqs.local/billing"}
+
+	t.Run("starts a bulk job", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		body := `{"action":"purge","queueUrls":["https://sqs.local/orders","https://sqs.local/billing"],"confirmCount":2}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/queues/bulk", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			StartBulkQueueOperation(mock.Anything, BulkQueueInput{Action: BulkActionPurge, QueueURLs: queueURLs}).
+			Return(Job{ID: "bulk1", Kind: "bulk-purge", Status: JobStatusRunning}, nil).
+			Once()
+
+		handler.BulkQueueOperationAPI(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"id":"bulk1"`)
+	})
+
+	t.Run("requires the queue count", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+
+		body := `{"action":"delete","queueUrls":["https://sqs.local/orders","https://sqs.local/billing"],"confirmCount":1}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/queues/bulk", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		handler.BulkQueueOperationAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"confirmCount must repeat the number of queues"}`, rr.Body.String())
+	})
+}
@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_StartPurge(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository) {
+		repo := NewMockSqsRepository(t)
+		jobs := newJobRegistry()
+		jobs.retryDelay = time.Millisecond
+		return &SqsServiceImpl{repo: repo, jobs: jobs}, repo
+	}
+
+	t.Run("purges in the background", func(t *testing.T) {
+		service, repo := newService(t)
+		repo.EXPECT().PurgeQueue(mock.Anything, queueURL).Return(nil).Once()
+
+		started, err := service.StartPurge(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusRunning, started.Status)
+		assert.Equal(t, "purge", started.Kind)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(1), job.Done)
+		assert.Equal(t, int64(1), job.Total)
+		assert.False(t, job.FinishedAt.IsZero())
+	})
+
+	t.Run("waits out the purge cooldown", func(t *testing.T) {
+		service, repo := newService(t)
+		repo.EXPECT().PurgeQueue(mock.Anything, queueURL).Return(ErrPurgeInProgress).Twice()
+		repo.EXPECT().PurgeQueue(mock.Anything, queueURL).Return(nil).Once()
+
+		started, err := service.StartPurge(ctx, queueURL)
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+	})
+
+	t.Run("gives up after the last attempt", func(t *testing.T) {
+		service, repo := newService(t)
+		repo.EXPECT().PurgeQueue(mock.Anything, queueURL).Return(ErrPurgeInProgress).Times(purgeMaxAttempts)
+
+		started, err := service.StartPurge(ctx, queueURL)
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusFailed, job.Status)
+		assert.Equal(t, "the queue was purged less than 60 seconds ago", job.Error)
+	})
+
+	t.Run("reports other errors without retrying", func(t *testing.T) {
+		service, repo := newService(t)
+		repo.EXPECT().
+			PurgeQueue(mock.Anything, queueURL).
+			Return(fmt.Errorf("queue \"orders\" is protected from delete and purge: %w", ErrQueueAccessDenied)).
+			Once()
+
+		started, err := service.StartPurge(ctx, queueURL)
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusFailed, job.Status)
+		assert.Equal(t, "queue \"orders\" is protected from delete and purge: queue access denied by policy", job.Error)
+	})
+
+	t.Run("requires a queue url", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.StartPurge(ctx, " ")
+		assert.EqualError(t, err, "queue url is required")
+	})
+}
+
+func TestSqsServiceImpl_Job_NotFound(t *testing.T) {
+	service := &SqsServiceImpl{jobs: newJobRegistry()}
+
+	_, err := service.Job(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
+
+func TestJobRegistry_PrunesFinishedJobs(t *testing.T) {
+	registry := newJobRegistry()
+	tick := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	registry.now = func() time.Time {
+		tick = tick.Add(time.Second)
+		return tick
+	}
+	ids := make([]string, 0, maxFinishedJobs+1)
+	for range maxFinishedJobs + 1 {
+		job, err := registry.start(context.Background(), "test", "", func(context.Context, *JobProgress) error {
+			return errors.New("boom")
+		})
+		require.NoError(t, err)
+		registry.wg.Wait()
+		ids = append(ids, job.ID)
+	}
+
+	release := make(chan struct{})
+	running, err := registry.start(context.Background(), "test", "", func(context.Context, *JobProgress) error {
+		<-release
+		return nil
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		close(release)
+		registry.wg.Wait()
+	})
+
+	_, ok := registry.get(ids[0])
+	assert.False(t, ok)
+	_, ok = registry.get(ids[len(ids)-1])
+	assert.True(t, ok)
+	_, ok = registry.get(running.ID)
+	assert.True(t, ok)
+}
@@ -0,0 +1,184 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultProbeSamples = 5
+	maxProbeSamples     = 20
+	maxProbeQueues      = 10
+	// probeTimeout is how long one canary may take before it counts as lost.
+	probeTimeout = 20 * time.Second
+	// probeAttribute marks canary messages; its value tells the canaries of a probe apart.
+	probeAttribute = "sqs-gui-canary"
+	probeBody      = "sqs-gui latency probe"
+	// probeVisibilityTimeout hides other messages the probe receives by accident only briefly.
+	probeVisibilityTimeout int32 = 1
+	probeReceiveWait       int32 = 1
+)
+
+// LatencyProbeInput lists the queues to probe and how many canaries to send to each.
+type LatencyProbeInput struct {
+	QueueURLs []string
+	Samples   int
+}
+
+// QueueLatency is the round-trip latency of one queue: the time from sending a canary until it
+// was received. Lost counts canaries that did not arrive within the probe timeout. Error is set
+// when the queue could not be probed at all.
+type QueueLatency struct {
+	QueueURL string
+	Received int
+	Lost     int
+	P50      time.Duration
+	P95      time.Duration
+	Max      time.Duration
+	Error    string
+}
+
+// ProbeLatency sends canary messages one at a time to every queue and measures how long each
+// takes to come back, to compare the delivery latency of ElasticMQ, LocalStack and SQS. Queues
+// are probed concurrently. Other messages the probe receives stay invisible for a second, so it
+// is best run against idle queues.
+func (s *SqsServiceImpl) ProbeLatency(ctx context.Context, input LatencyProbeInput) ([]QueueLatency, error) {
+	unique := make([]string, 0, len(input.QueueURLs))
+	seen := make(map[string]struct{}, len(input.QueueURLs))
+	for _, raw := range input.QueueURLs {
+		queueURL := strings.TrimSpace(raw)
+		if queueURL == "" {
+			continue
+		}
+		if _, ok := seen[queueURL]; ok {
+			continue
+		}
+		seen[queueURL] = struct{}{}
+		unique = append(unique, queueURL)
+	}
+	if len(unique) == 0 {
+		return nil, errors.New("at least one queue url is required")
+	}
+	if len(unique) > maxProbeQueues {
+		return nil, errors.Newf("at most %d queues can be probed at once", maxProbeQueues)
+	}
+
+	samples := input.Samples
+	if samples == 0 {
+		samples = defaultProbeSamples
+	}
+	if samples < 1 || samples > maxProbeSamples {
+		return nil, errors.Newf("samples must be between 1 and %d", maxProbeSamples)
+	}
+
+	results := make([]QueueLatency, len(unique))
+	var wg sync.WaitGroup
+	for i, queueURL := range unique {
+		wg.Add(1)
+		go func(i int, queueURL string) {
+			defer wg.Done()
+			results[i] = s.probeQueue(ctx, queueURL, samples)
+		}(i, queueURL)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+func (s *SqsServiceImpl) probeQueue(ctx context.Context, queueURL string, samples int) QueueLatency {
+	result := QueueLatency{QueueURL: queueURL}
+	latencies := make([]time.Duration, 0, samples)
+	for range samples {
+		latency, ok, err := s.probeOnce(ctx, queueURL)
+		if err != nil {
+			result.Error = err.Error()
+			break
+		}
+		if !ok {
+			result.Lost++
+			continue
+		}
+		latencies = append(latencies, latency)
+	}
+
+	result.Received = len(latencies)
+	if len(latencies) > 0 {
+		slices.Sort(latencies)
+		result.P50 = durationPercentile(latencies, 50)
+		result.P95 = durationPercentile(latencies, 95)
+		result.Max = latencies[len(latencies)-1]
+	}
+	return result
+}
+
+// probeOnce sends one canary and receives until it arrives. Canaries left over from earlier
+// probes are deleted on the way.
+func (s *SqsServiceImpl) probeOnce(ctx context.Context, queueURL string) (time.Duration, bool, error) {
+	id, err := newRandomID()
+	if err != nil {
+		return 0, false, err
+	}
+	send := SendMessageRepositoryInput{
+		QueueURL:   queueURL,
+		Body:       probeBody,
+		Attributes: map[string]string{probeAttribute: id},
+	}
+	if strings.HasSuffix(queueURL, ".fifo") {
+		send.MessageGroupID = probeAttribute
+		send.MessageDeduplicationID = id
+	}
+
+	sentAt := s.now()
+	if err := s.repo.SendMessage(ctx, send); err != nil {
+		return 0, false, err
+	}
+
+	for s.now().Sub(sentAt) < probeTimeout {
+		messages, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       migrationReceiveBatch,
+			WaitTimeSeconds:   probeReceiveWait,
+			VisibilityTimeout: probeVisibilityTimeout,
+		})
+		if err != nil {
+			return 0, false, err
+		}
+		receivedAt := s.now()
+
+		found := false
+		for _, message := range messages {
+			canary, ok := probeCanaryID(message)
+			if !ok {
+				continue
+			}
+			if err := s.repo.DeleteMessage(ctx, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: message.ReceiptHandle}); err != nil {
+				return 0, false, err
+			}
+			found = found || canary == id
+		}
+		if found {
+			return receivedAt.Sub(sentAt), true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func probeCanaryID(message ReceivedMessage) (string, bool) {
+	for _, attribute := range message.Attributes {
+		if attribute.Name == probeAttribute {
+			return attribute.Value, true
+		}
+	}
+	return "", false
+}
+
+// durationPercentile is the nearest-rank percentile of sorted latencies.
+func durationPercentile(sorted []time.Duration, p int) time.Duration {
+	index := (p*len(sorted)+99)/100 - 1
+	return sorted[max(index, 0)]
+}
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type latencyProbeRequest struct {
+	QueueURLs []string `json:"queueUrls"`
+	Samples   int      `json:"samples"`
+}
+
+type queueLatencyItem struct {
+	QueueURL  string  `json:"queueUrl"`
+	QueueName string  `json:"queueName"`
+	Received  int     `json:"received"`
+	Lost      int     `json:"lost"`
+	P50Ms     float64 `json:"p50Ms"`
+	P95Ms     float64 `json:"p95Ms"`
+	MaxMs     float64 `json:"maxMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type latencyProbeResponse struct {
+	Queues []queueLatencyItem `json:"queues"`
+}
+
+// ProbeLatencyAPI sends canary messages to the queues in the request body and returns the
+// round-trip latency percentiles of each, in milliseconds.
+func (h *HandlerImpl) ProbeLatencyAPI(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	var payload latencyProbeRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	results, err := h.s.ProbeLatency(r.Context(), LatencyProbeInput{QueueURLs: payload.QueueURLs, Samples: payload.Samples})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to probe queue latency", slog.Int("queues", len(payload.QueueURLs)), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	response := latencyProbeResponse{Queues: make([]queueLatencyItem, 0, len(results))}
+	for _, result := range results {
+		response.Queues = append(response.Queues, queueLatencyItem{
+			QueueURL:  result.QueueURL,
+			QueueName: extractQueueName(result.QueueURL),
+			Received:  result.Received,
+			Lost:      result.Lost,
+			P50Ms:     durationMillis(result.P50),
+			P95Ms:     durationMillis(result.P95),
+			MaxMs:     durationMillis(result.Max),
+			Error:     result.Error,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// durationMillis converts d to milliseconds, keeping the fraction so local endpoints do not all
+// report zero.
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
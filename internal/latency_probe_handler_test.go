@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_ProbeLatencyAPI(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/queues/latency", strings.NewReader(`{"queueUrls":["https://sqs.local/orders","https://sqs.local/audit"],"samples":3}`))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ProbeLatency(mock.Anything, LatencyProbeInput{QueueURLs: []string{"https://sqs.local/orders", "https://sqs.local/audit"}, Samples: 3}).
+		Return([]QueueLatency{
+			{QueueURL: "https://sqs.local/orders", Received: 2, Lost: 1, P50: 1500 * time.Microsecond, P95: 4 * time.Millisecond, Max: 4 * time.Millisecond},
+			{QueueURL: "https://sqs.local/audit", Error: "access denied"},
+		}, nil).
+		Once()
+
+	handler.ProbeLatencyAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"queues":[
+		{"queueUrl":"https://sqs.local/orders","queueName":"orders","received":2,"lost":1,"p50Ms":1.5,"p95Ms":4,"maxMs":4},
+		{"queueUrl":"https://sqs.local/audit","queueName":"audit","received":0,"lost":0,"p50Ms":0,"p95Ms":0,"maxMs":0,"error":"access denied"}
+	]}`, rr.Body.String())
+}
@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_ProbeLatency(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository, *time.Time) {
+		repo := NewMockSqsRepository(t)
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		return &SqsServiceImpl{repo: repo, clock: func() time.Time { return now }}, repo, &now
+	}
+
+	t.Run("measures the time until each canary is received", func(t *testing.T) {
+		service, repo, now := newService(t)
+
+		var canary string
+		repo.EXPECT().SendMessage(mock.Anything, mock.MatchedBy(func(input SendMessageRepositoryInput) bool {
+			return input.QueueURL == queueURL && input.Body == probeBody && input.MessageGroupID == ""
+		})).
+			Run(func(_ context.Context, input SendMessageRepositoryInput) { canary = input.Attributes[probeAttribute] }).
+			Return(nil).
+			Times(2)
+
+		// The first canary needs two receives, the second arrives on the first one.
+		receives := 0
+		repo.EXPECT().ReceiveMessages(mock.Anything, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       migrationReceiveBatch,
+			WaitTimeSeconds:   probeReceiveWait,
+			VisibilityTimeout: probeVisibilityTimeout,
+		}).
+			RunAndReturn(func(context.Context, ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error) {
+				receives++
+				*now = now.Add(10 * time.Millisecond)
+				if receives == 1 {
+					return []ReceivedMessage{
+						{ID: "other", ReceiptHandle: "r-other"},
+						{ID: "stale", ReceiptHandle: "r-stale", Attributes: []MessageAttribute{{Name: probeAttribute, Value: "old"}}},
+					}, nil
+				}
+				return []ReceivedMessage{{ID: "canary", ReceiptHandle: "r-canary", Attributes: []MessageAttribute{{Name: probeAttribute, Value: canary}}}}, nil
+			}).
+			Times(3)
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-stale"}).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-canary"}).Return(nil).Twice()
+
+		results, err := service.ProbeLatency(ctx, LatencyProbeInput{QueueURLs: []string{queueURL, queueURL}, Samples: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []QueueLatency{{
+			QueueURL: queueURL,
+			Received: 2,
+			P50:      10 * time.Millisecond,
+			P95:      20 * time.Millisecond,
+			Max:      20 * time.Millisecond,
+		}}, results)
+	})
+
+	t.Run("counts canaries that do not arrive in time as lost", func(t *testing.T) {
+		service, repo, now := newService(t)
+
+		repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).
+			RunAndReturn(func(context.Context, ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error) {
+				*now = now.Add(probeTimeout)
+				return []ReceivedMessage{}, nil
+			}).
+			Once()
+
+		results, err := service.ProbeLatency(ctx, LatencyProbeInput{QueueURLs: []string{queueURL}, Samples: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []QueueLatency{{QueueURL: queueURL, Lost: 1}}, results)
+	})
+
+	t.Run("reports a queue that cannot be probed", func(t *testing.T) {
+		service, repo, _ := newService(t)
+
+		repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(errors.New("access denied")).Once()
+
+		results, err := service.ProbeLatency(ctx, LatencyProbeInput{QueueURLs: []string{queueURL}})
+		require.NoError(t, err)
+		assert.Equal(t, []QueueLatency{{QueueURL: queueURL, Error: "access denied"}}, results)
+	})
+
+	t.Run("validates the input", func(t *testing.T) {
+		service, _, _ := newService(t)
+
+		_, err := service.ProbeLatency(ctx, LatencyProbeInput{})
+		require.EqualError(t, err, "at least one queue url is required")
+
+		_, err = service.ProbeLatency(ctx, LatencyProbeInput{QueueURLs: []string{queueURL}, Samples: 21})
+		require.EqualError(t, err, "samples must be between 1 and 20")
+	})
+}
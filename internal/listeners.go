@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"crypto/tls"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Listen opens a socket for every configured listener, or takes over the ones inherited from the
+// process that restarted into this one. The sockets are plain TCP; Serve adds TLS.
+func Listen(getenv func(string) string, configs []ListenerConfig) ([]net.Listener, error) {
+	raw := strings.TrimSpace(getenv(listenFDEnv))
+	if raw == "" {
+		listeners := make([]net.Listener, 0, len(configs))
+		for _, config := range configs {
+			listener, err := net.Listen("tcp", config.Addr)
+			if err != nil {
+				closeListeners(listeners)
+				return nil, errors.Wrapf(err, "failed to listen on %s", config.Addr)
+			}
+			listeners = append(listeners, listener)
+		}
+		return listeners, nil
+	}
+
+	fds := strings.Split(raw, ",")
+	if len(fds) != len(configs) {
+		return nil, errors.Newf("inherited %d listeners but %d are configured", len(fds), len(configs))
+	}
+	listeners := make([]net.Listener, 0, len(fds))
+	for _, value := range fds {
+		listener, err := inheritListener(value)
+		if err != nil {
+			closeListeners(listeners)
+			return nil, err
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+func inheritListener(value string) (net.Listener, error) {
+	fd, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || fd < 3 {
+		return nil, errors.Newf("%s must list file descriptor numbers of at least 3", listenFDEnv)
+	}
+	file := os.NewFile(uintptr(fd), "listener")
+	defer func() { _ = file.Close() }()
+	// FileListener duplicates the descriptor, so the inherited one is closed either way.
+	listener, err := net.FileListener(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to take over the inherited listener")
+	}
+	return listener, nil
+}
+
+func closeListeners(listeners []net.Listener) {
+	for _, listener := range listeners {
+		_ = listener.Close()
+	}
+}
+
+// Serve serves srv on every listener, with TLS where its configuration has a certificate. The
+// certificates are loaded before anything is served, so a bad one fails here. The returned channel
+// receives the error of every listener that stops, http.ErrServerClosed after a shutdown.
+func Serve(srv *http.Server, listeners []net.Listener, configs []ListenerConfig) (<-chan error, error) {
+	served := make([]net.Listener, 0, len(listeners))
+	for i, listener := range listeners {
+		config := configs[i]
+		if !config.TLS() {
+			served = append(served, listener)
+			continue
+		}
+		certificate, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load the certificate for %s", config.Addr)
+		}
+		served = append(served, tls.NewListener(listener, &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			NextProtos:   []string{"h2", "http/1.1"},
+			MinVersion:   tls.VersionTLS12,
+		}))
+	}
+
+	errCh := make(chan error, len(served))
+	for i, listener := range served {
+		slog.Info("listening", slog.String("addr", listener.Addr().String()), slog.Bool("tls", configs[i].TLS()))
+		go func() {
+			errCh <- srv.Serve(listener)
+		}()
+	}
+	return errCh, nil
+}
@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServe(t *testing.T) {
+	certFile, keyFile, pool := writeTestCertificate(t)
+	configs := []ListenerConfig{
+		{Addr: "127.0.0.1:0"},
+		{Addr: "127.0.0.1:0", CertFile: certFile, KeyFile: keyFile},
+	}
+	listeners, err := Listen(func(string) string { return "" }, configs)
+	require.NoError(t, err)
+
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.Proto)
+	})}
+	errCh, err := Serve(srv, listeners, configs)
+	require.NoError(t, err)
+
+	get := func(client *http.Client, target string) string {
+		t.Helper()
+		response, err := client.Get(target)
+		require.NoError(t, err)
+		defer func() { _ = response.Body.Close() }()
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+	assert.Equal(t, "HTTP/1.1", get(http.DefaultClient, "http://"+listeners[0].Addr().String()))
+	tlsClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}, ForceAttemptHTTP2: true}}
+	assert.Equal(t, "HTTP/2.0", get(tlsClient, "https://"+listeners[1].Addr().String()))
+
+	require.NoError(t, srv.Close())
+	assert.ErrorIs(t, <-errCh, http.ErrServerClosed)
+	assert.ErrorIs(t, <-errCh, http.ErrServerClosed)
+}
+
+func TestServe_BadCertificate(t *testing.T) {
+	configs := []ListenerConfig{{Addr: "127.0.0.1:0", CertFile: "missing.pem", KeyFile: "missing-key.pem"}}
+	listeners, err := Listen(func(string) string { return "" }, configs)
+	require.NoError(t, err)
+	t.Cleanup(func() { closeListeners(listeners) })
+
+	_, err = Serve(&http.Server{}, listeners, configs)
+	assert.ErrorContains(t, err, "failed to load the certificate for 127.0.0.1:0")
+}
+
+// writeTestCertificate writes a self-signed certificate for 127.0.0.1 and returns a pool that
+// trusts it.
+func writeTestCertificate(t *testing.T) (string, string, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+	return certFile, keyFile, pool
+}
@@ -0,0 +1,655 @@
+package internal
+
+import (
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// LocalStore persists GUI state that has no home in SQS itself.
+type LocalStore interface {
+	SendDefaults(queueURL string) (SendDefaults, bool, error)
+	SaveSendDefaults(queueURL string, defaults SendDefaults) error
+	Draft(queueURL string) (MessageDraft, bool, error)
+	SaveDraft(queueURL string, draft MessageDraft) error
+	DeleteDraft(queueURL string) error
+	Schedules() ([]Schedule, error)
+	Schedule(id string) (Schedule, bool, error)
+	SaveSchedule(schedule Schedule) error
+	UpdateSchedule(id string, update func(schedule *Schedule) error) error
+	DeleteSchedule(id string) error
+	AlertRules() ([]AlertRule, error)
+	AlertRule(id string) (AlertRule, bool, error)
+	SaveAlertRule(rule AlertRule) error
+	DeleteAlertRule(id string) error
+	TrashedQueues() ([]TrashedQueue, error)
+	TrashedQueue(id string) (TrashedQueue, bool, error)
+	SaveTrashedQueue(queue TrashedQueue) error
+	DeleteTrashedQueue(id string) error
+	Baselines() ([]QueueBaseline, error)
+	SaveBaseline(baseline QueueBaseline) error
+	DeleteBaseline(queueURL string) error
+	AttributeHistories() ([]AttributeHistory, error)
+	AttributeHistory(queueURL string) (AttributeHistory, bool, error)
+	SaveAttributeHistory(history AttributeHistory) error
+	DeleteAttributeHistory(queueURL string) error
+	PushSubscriptions() ([]PushSubscription, error)
+	SavePushSubscription(subscription PushSubscription) error
+	DeletePushSubscription(endpoint string) error
+	MessageContract(queueURL string) (MessageContract, bool, error)
+	SaveMessageContract(contract MessageContract) error
+	DeleteMessageContract(queueURL string) error
+	Preferences() (Preferences, error)
+	UpdatePreferences(update func(preferences *Preferences) error) error
+	Snapshot() (StateSnapshot, error)
+	Restore(snapshot StateSnapshot) error
+}
+
+// SendDefaults remembers the last values used on a queue's send form.
+type SendDefaults struct {
+	MessageGroupID string             `json:"messageGroupId,omitempty"`
+	DelaySeconds   *int32             `json:"delaySeconds,omitempty"`
+	Attributes     []MessageAttribute `json:"attributes,omitempty"`
+}
+
+// MessageDraft is an in-progress message saved from a queue's send form.
+type MessageDraft struct {
+	Body       string             `json:"body"`
+	Attributes []MessageAttribute `json:"attributes,omitempty"`
+	SavedAt    time.Time          `json:"savedAt"`
+}
+
+// StateSnapshot is the document written to the state file.
+type StateSnapshot struct {
+	SendDefaults map[string]SendDefaults `json:"sendDefaults,omitempty"`
+	Drafts       map[string]MessageDraft `json:"drafts,omitempty"`
+	Schedules    map[string]Schedule     `json:"schedules,omitempty"`
+	AlertRules   map[string]AlertRule    `json:"alertRules,omitempty"`
+	Trash        map[string]TrashedQueue `json:"trash,omitempty"`
+	// Baselines are keyed by queue URL.
+	Baselines map[string]QueueBaseline `json:"baselines,omitempty"`
+	// AttributeHistory is keyed by queue URL.
+	AttributeHistory map[string]AttributeHistory `json:"attributeHistory,omitempty"`
+	Preferences      *Preferences                `json:"preferences,omitempty"`
+	// PushSubscriptions are keyed by endpoint.
+	PushSubscriptions map[string]PushSubscription `json:"pushSubscriptions,omitempty"`
+	// MessageContracts are keyed by queue URL.
+	MessageContracts map[string]MessageContract `json:"messageContracts,omitempty"`
+}
+
+// LocalStoreImpl keeps state in memory and mirrors it to a JSON file when a path is configured.
+type LocalStoreImpl struct {
+	mu    sync.Mutex
+	path  string
+	state StateSnapshot
+}
+
+// NewLocalStore loads the state file at path. An empty path keeps state in memory only.
+func NewLocalStore(path string) (LocalStore, error) {
+	store := &LocalStoreImpl{path: path}
+	if path == "" {
+		return store, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read state file")
+	}
+
+	if err := json.Unmarshal(raw, &store.state); err != nil {
+		return nil, errors.Wrap(err, "failed to decode state file")
+	}
+
+	return store, nil
+}
+
+// SendDefaults returns the stored send form defaults for queueURL.
+func (s *LocalStoreImpl) SendDefaults(queueURL string) (SendDefaults, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	defaults, ok := s.state.SendDefaults[queueURL]
+	return defaults, ok, nil
+}
+
+// SaveSendDefaults replaces the send form defaults for queueURL.
+func (s *LocalStoreImpl) SaveSendDefaults(queueURL string, defaults SendDefaults) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.SendDefaults == nil {
+		s.state.SendDefaults = make(map[string]SendDefaults)
+	}
+	s.state.SendDefaults[queueURL] = defaults
+
+	return s.persistLocked()
+}
+
+// Draft returns the saved draft for queueURL.
+func (s *LocalStoreImpl) Draft(queueURL string) (MessageDraft, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	draft, ok := s.state.Drafts[queueURL]
+	return draft, ok, nil
+}
+
+// SaveDraft replaces the draft for queueURL.
+func (s *LocalStoreImpl) SaveDraft(queueURL string, draft MessageDraft) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.Drafts == nil {
+		s.state.Drafts = make(map[string]MessageDraft)
+	}
+	s.state.Drafts[queueURL] = draft
+
+	return s.persistLocked()
+}
+
+// DeleteDraft discards the draft for queueURL, if any.
+func (s *LocalStoreImpl) DeleteDraft(queueURL string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state.Drafts[queueURL]; !ok {
+		return nil
+	}
+	delete(s.state.Drafts, queueURL)
+
+	return s.persistLocked()
+}
+
+// Schedules returns all stored schedules in no particular order.
+func (s *LocalStoreImpl) Schedules() ([]Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedules := make([]Schedule, 0, len(s.state.Schedules))
+	for _, schedule := range s.state.Schedules {
+		schedule.History = slices.Clone(schedule.History)
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+// Schedule returns the schedule with id.
+func (s *LocalStoreImpl) Schedule(id string) (Schedule, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, ok := s.state.Schedules[id]
+	schedule.History = slices.Clone(schedule.History)
+	return schedule, ok, nil
+}
+
+// SaveSchedule inserts or replaces the schedule with the same ID.
+func (s *LocalStoreImpl) SaveSchedule(schedule Schedule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.Schedules == nil {
+		s.state.Schedules = make(map[string]Schedule)
+	}
+	s.state.Schedules[schedule.ID] = schedule
+
+	return s.persistLocked()
+}
+
+// UpdateSchedule changes the schedule with id with update while holding the store lock, so changes
+// made since the schedule was last read are kept. It returns ErrScheduleNotFound when the schedule
+// is gone, and nothing changes when update or writing the state file fails.
+func (s *LocalStoreImpl) UpdateSchedule(id string, update func(schedule *Schedule) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, ok := s.state.Schedules[id]
+	if !ok {
+		return ErrScheduleNotFound
+	}
+	schedule := previous
+	schedule.History = slices.Clone(schedule.History)
+	if err := update(&schedule); err != nil {
+		return err
+	}
+
+	s.state.Schedules[id] = schedule
+	if err := s.persistLocked(); err != nil {
+		s.state.Schedules[id] = previous
+		return err
+	}
+	return nil
+}
+
+// DeleteSchedule removes the schedule with id.
+func (s *LocalStoreImpl) DeleteSchedule(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state.Schedules[id]; !ok {
+		return ErrScheduleNotFound
+	}
+	delete(s.state.Schedules, id)
+
+	return s.persistLocked()
+}
+
+// AlertRules returns all stored alert rules in no particular order.
+func (s *LocalStoreImpl) AlertRules() ([]AlertRule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules := make([]AlertRule, 0, len(s.state.AlertRules))
+	for _, rule := range s.state.AlertRules {
+		rule.Silences = slices.Clone(rule.Silences)
+		rule.Channels = slices.Clone(rule.Channels)
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// AlertRule returns the alert rule with id.
+func (s *LocalStoreImpl) AlertRule(id string) (AlertRule, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rule, ok := s.state.AlertRules[id]
+	rule.Silences = slices.Clone(rule.Silences)
+	rule.Channels = slices.Clone(rule.Channels)
+	return rule, ok, nil
+}
+
+// SaveAlertRule inserts or replaces the alert rule with the same ID.
+func (s *LocalStoreImpl) SaveAlertRule(rule AlertRule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.AlertRules == nil {
+		s.state.AlertRules = make(map[string]AlertRule)
+	}
+	s.state.AlertRules[rule.ID] = rule
+
+	return s.persistLocked()
+}
+
+// DeleteAlertRule removes the alert rule with id.
+func (s *LocalStoreImpl) DeleteAlertRule(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state.AlertRules[id]; !ok {
+		return ErrAlertRuleNotFound
+	}
+	delete(s.state.AlertRules, id)
+
+	return s.persistLocked()
+}
+
+// TrashedQueues returns all deleted queues kept for restore in no particular order.
+func (s *LocalStoreImpl) TrashedQueues() ([]TrashedQueue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trashed := make([]TrashedQueue, 0, len(s.state.Trash))
+	for _, queue := range s.state.Trash {
+		trashed = append(trashed, queue.clone())
+	}
+	return trashed, nil
+}
+
+// TrashedQueue returns the deleted queue with id.
+func (s *LocalStoreImpl) TrashedQueue(id string) (TrashedQueue, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, ok := s.state.Trash[id]
+	return queue.clone(), ok, nil
+}
+
+// SaveTrashedQueue inserts or replaces the deleted queue with the same ID.
+func (s *LocalStoreImpl) SaveTrashedQueue(queue TrashedQueue) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.Trash == nil {
+		s.state.Trash = make(map[string]TrashedQueue)
+	}
+	s.state.Trash[queue.ID] = queue.clone()
+
+	return s.persistLocked()
+}
+
+// DeleteTrashedQueue removes the deleted queue with id.
+func (s *LocalStoreImpl) DeleteTrashedQueue(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state.Trash[id]; !ok {
+		return ErrTrashedQueueNotFound
+	}
+	delete(s.state.Trash, id)
+
+	return s.persistLocked()
+}
+
+// Baselines returns all saved queue baselines in no particular order.
+func (s *LocalStoreImpl) Baselines() ([]QueueBaseline, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	baselines := make([]QueueBaseline, 0, len(s.state.Baselines))
+	for _, baseline := range s.state.Baselines {
+		baselines = append(baselines, baseline.clone())
+	}
+	return baselines, nil
+}
+
+// SaveBaseline inserts or replaces the baseline of the same queue.
+func (s *LocalStoreImpl) SaveBaseline(baseline QueueBaseline) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.Baselines == nil {
+		s.state.Baselines = make(map[string]QueueBaseline)
+	}
+	s.state.Baselines[baseline.QueueURL] = baseline.clone()
+
+	return s.persistLocked()
+}
+
+// DeleteBaseline removes the baseline of queueURL.
+func (s *LocalStoreImpl) DeleteBaseline(queueURL string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state.Baselines[queueURL]; !ok {
+		return ErrBaselineNotFound
+	}
+	delete(s.state.Baselines, queueURL)
+
+	return s.persistLocked()
+}
+
+// AttributeHistories returns the history of every watched queue in no particular order.
+func (s *LocalStoreImpl) AttributeHistories() ([]AttributeHistory, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	histories := make([]AttributeHistory, 0, len(s.state.AttributeHistory))
+	for _, history := range s.state.AttributeHistory {
+		histories = append(histories, history.clone())
+	}
+	return histories, nil
+}
+
+// AttributeHistory returns the history of queueURL.
+func (s *LocalStoreImpl) AttributeHistory(queueURL string) (AttributeHistory, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history, ok := s.state.AttributeHistory[queueURL]
+	return history.clone(), ok, nil
+}
+
+// SaveAttributeHistory inserts or replaces the history of the same queue.
+func (s *LocalStoreImpl) SaveAttributeHistory(history AttributeHistory) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.AttributeHistory == nil {
+		s.state.AttributeHistory = make(map[string]AttributeHistory)
+	}
+	s.state.AttributeHistory[history.QueueURL] = history.clone()
+
+	return s.persistLocked()
+}
+
+// DeleteAttributeHistory removes the history of queueURL.
+func (s *LocalStoreImpl) DeleteAttributeHistory(queueURL string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state.AttributeHistory[queueURL]; !ok {
+		return ErrQueueNotWatched
+	}
+	delete(s.state.AttributeHistory, queueURL)
+
+	return s.persistLocked()
+}
+
+// Preferences returns the stored display preferences, or the zero value when none were saved.
+func (s *LocalStoreImpl) Preferences() (Preferences, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.Preferences == nil {
+		return Preferences{}, nil
+	}
+	return s.state.Preferences.clone(), nil
+}
+
+// UpdatePreferences changes the display preferences with update while holding the store lock, so
+// concurrent changes to different preferences are not lost. Nothing changes when update or writing
+// the state file fails.
+func (s *LocalStoreImpl) UpdatePreferences(update func(preferences *Preferences) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var preferences Preferences
+	if s.state.Preferences != nil {
+		preferences = s.state.Preferences.clone()
+	}
+	if err := update(&preferences); err != nil {
+		return err
+	}
+
+	previous := s.state.Preferences
+	s.state.Preferences = &preferences
+	if err := s.persistLocked(); err != nil {
+		s.state.Preferences = previous
+		return err
+	}
+	return nil
+}
+
+// PushSubscriptions returns every browser push subscription in no particular order.
+func (s *LocalStoreImpl) PushSubscriptions() ([]PushSubscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Collect(maps.Values(s.state.PushSubscriptions)), nil
+}
+
+// SavePushSubscription inserts or replaces the subscription with the same endpoint.
+func (s *LocalStoreImpl) SavePushSubscription(subscription PushSubscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.PushSubscriptions == nil {
+		s.state.PushSubscriptions = make(map[string]PushSubscription)
+	}
+	s.state.PushSubscriptions[subscription.Endpoint] = subscription
+
+	return s.persistLocked()
+}
+
+// DeletePushSubscription removes the subscription of endpoint.
+func (s *LocalStoreImpl) DeletePushSubscription(endpoint string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state.PushSubscriptions[endpoint]; !ok {
+		return ErrPushSubscriptionNotFound
+	}
+	delete(s.state.PushSubscriptions, endpoint)
+
+	return s.persistLocked()
+}
+
+// MessageContract returns the message contract of queueURL.
+func (s *LocalStoreImpl) MessageContract(queueURL string) (MessageContract, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contract, ok := s.state.MessageContracts[queueURL]
+	return contract, ok, nil
+}
+
+// SaveMessageContract inserts or replaces the contract of the same queue.
+func (s *LocalStoreImpl) SaveMessageContract(contract MessageContract) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.MessageContracts == nil {
+		s.state.MessageContracts = make(map[string]MessageContract)
+	}
+	s.state.MessageContracts[contract.QueueURL] = contract
+
+	return s.persistLocked()
+}
+
+// DeleteMessageContract removes the contract of queueURL.
+func (s *LocalStoreImpl) DeleteMessageContract(queueURL string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state.MessageContracts[queueURL]; !ok {
+		return ErrMessageContractNotFound
+	}
+	delete(s.state.MessageContracts, queueURL)
+
+	return s.persistLocked()
+}
+
+// Snapshot returns a copy of the whole state document.
+func (s *LocalStoreImpl) Snapshot() (StateSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.state.clone(), nil
+}
+
+// Restore replaces the whole state document with snapshot.
+func (s *LocalStoreImpl) Restore(snapshot StateSnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state = snapshot.clone()
+
+	return s.persistLocked()
+}
+
+// clone copies the maps and the slices nested in their values so callers never share memory with the store.
+func (st StateSnapshot) clone() StateSnapshot {
+	cloned := StateSnapshot{
+		SendDefaults:      maps.Clone(st.SendDefaults),
+		Drafts:            maps.Clone(st.Drafts),
+		Schedules:         maps.Clone(st.Schedules),
+		AlertRules:        maps.Clone(st.AlertRules),
+		Trash:             maps.Clone(st.Trash),
+		Baselines:         maps.Clone(st.Baselines),
+		AttributeHistory:  maps.Clone(st.AttributeHistory),
+		PushSubscriptions: maps.Clone(st.PushSubscriptions),
+		MessageContracts:  maps.Clone(st.MessageContracts),
+	}
+	for key, defaults := range cloned.SendDefaults {
+		defaults.Attributes = slices.Clone(defaults.Attributes)
+		cloned.SendDefaults[key] = defaults
+	}
+	for key, draft := range cloned.Drafts {
+		draft.Attributes = slices.Clone(draft.Attributes)
+		cloned.Drafts[key] = draft
+	}
+	for key, schedule := range cloned.Schedules {
+		schedule.History = slices.Clone(schedule.History)
+		cloned.Schedules[key] = schedule
+	}
+	for key, rule := range cloned.AlertRules {
+		rule.Silences = slices.Clone(rule.Silences)
+		rule.Channels = slices.Clone(rule.Channels)
+		cloned.AlertRules[key] = rule
+	}
+	for key, queue := range cloned.Trash {
+		cloned.Trash[key] = queue.clone()
+	}
+	for key, baseline := range cloned.Baselines {
+		cloned.Baselines[key] = baseline.clone()
+	}
+	for key, history := range cloned.AttributeHistory {
+		cloned.AttributeHistory[key] = history.clone()
+	}
+	if st.Preferences != nil {
+		preferences := st.Preferences.clone()
+		cloned.Preferences = &preferences
+	}
+	return cloned
+}
+
+func (q TrashedQueue) clone() TrashedQueue {
+	q.Attributes = maps.Clone(q.Attributes)
+	q.Tags = maps.Clone(q.Tags)
+	return q
+}
+
+func (b QueueBaseline) clone() QueueBaseline {
+	b.Attributes = maps.Clone(b.Attributes)
+	b.Tags = maps.Clone(b.Tags)
+	return b
+}
+
+func (h AttributeHistory) clone() AttributeHistory {
+	h.Attributes = maps.Clone(h.Attributes)
+	h.Tags = maps.Clone(h.Tags)
+	h.Entries = slices.Clone(h.Entries)
+	for i, entry := range h.Entries {
+		h.Entries[i].Changes = slices.Clone(entry.Changes)
+	}
+	return h
+}
+
+func (p Preferences) clone() Preferences {
+	p.QueueListColumns = slices.Clone(p.QueueListColumns)
+	p.FavoriteQueues = slices.Clone(p.FavoriteQueues)
+	return p
+}
+
+// persistLocked writes the state file atomically so a crash never leaves a truncated document behind.
+func (s *LocalStoreImpl) persistLocked() error {
+	if s.path == "" {
+		return nil
+	}
+
+	raw, err := json.MarshalIndent(s.state, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode state")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary state file")
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(err, "failed to write temporary state file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to close temporary state file")
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return errors.Wrap(err, "failed to replace state file")
+	}
+
+	return nil
+}
@@ -0,0 +1,396 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStoreImpl_SendDefaults(t *testing.T) {
+	t.Run("persists defaults across instances", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "state.json")
+
+		store, err := NewLocalStore(path)
+		require.NoError(t, err)
+
+		delay := int32(10)
+		want := SendDefaults{
+			MessageGroupID: "group",
+			DelaySeconds:   &delay,
+			Attributes:     []MessageAttribute{{Name: "traceId", Value: "abc"}},
+		}
+		require.NoError(t, store.SaveSendDefaults("https://sqs.local/orders", want))
+
+		reopened, err := NewLocalStore(path)
+		require.NoError(t, err)
+
+		got, ok, err := reopened.SendDefaults("https://sqs.local/orders")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, want, got)
+
+		_, ok, err = reopened.SendDefaults("https://sqs.local/unknown")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("keeps state in memory without a path", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+
+		require.NoError(t, store.SaveSendDefaults("https://sqs.local/orders", SendDefaults{MessageGroupID: "group"}))
+
+		got, ok, err := store.SendDefaults("https://sqs.local/orders")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "group", got.MessageGroupID)
+	})
+
+	t.Run("rejects a corrupt state file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "state.json")
+		require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+
+		_, err := NewLocalStore(path)
+		assert.ErrorContains(t, err, "failed to decode state file")
+	})
+}
+
+func TestLocalStoreImpl_Drafts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	store, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	draft := MessageDraft{
+		Body:       "hello",
+		Attributes: []MessageAttribute{{Name: "k", Value: "v"}},
+		SavedAt:    time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, store.SaveDraft("https://sqs.local/orders", draft))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+	got, ok, err := reopened.Draft("https://sqs.local/orders")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, draft, got)
+
+	require.NoError(t, reopened.DeleteDraft("https://sqs.local/orders"))
+	require.NoError(t, reopened.DeleteDraft("https://sqs.local/orders"))
+	_, ok, err = reopened.Draft("https://sqs.local/orders")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestLocalStoreImpl_Schedules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	schedule := Schedule{
+		ID:        "nightly",
+		Action:    ScheduleActionPurge,
+		QueueURL:  "https://sqs.local/tmp",
+		Cron:      "@daily",
+		CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		History:   []ScheduleRun{{StartedAt: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), Error: "boom"}},
+	}
+	require.NoError(t, store.SaveSchedule(schedule))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	schedules, err := reopened.Schedules()
+	require.NoError(t, err)
+	assert.Equal(t, []Schedule{schedule}, schedules)
+
+	got, ok, err := reopened.Schedule("nightly")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, schedule, got)
+
+	require.NoError(t, reopened.UpdateSchedule("nightly", func(s *Schedule) error {
+		s.Disabled = true
+		return nil
+	}))
+	assert.EqualError(t, reopened.UpdateSchedule("nightly", func(s *Schedule) error {
+		s.Disabled = false
+		return errors.New("boom")
+	}), "boom")
+	got, _, err = reopened.Schedule("nightly")
+	require.NoError(t, err)
+	assert.True(t, got.Disabled)
+
+	require.NoError(t, reopened.DeleteSchedule("nightly"))
+	schedules, err = reopened.Schedules()
+	require.NoError(t, err)
+	assert.Empty(t, schedules)
+
+	assert.ErrorIs(t, reopened.DeleteSchedule("nightly"), ErrScheduleNotFound)
+	assert.ErrorIs(t, reopened.UpdateSchedule("nightly", func(*Schedule) error { return nil }), ErrScheduleNotFound)
+}
+
+func TestLocalStoreImpl_AlertRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	rule := AlertRule{
+		ID:               "depth",
+		Name:             "orders backlog",
+		QueueURL:         "https://sqs.local/orders",
+		Metric:           AlertMetricMessagesAvailable,
+		Threshold:        100,
+		ResolveThreshold: 50,
+		For:              5 * time.Minute,
+		Silences:         []AlertSilence{{Start: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)}},
+		CreatedAt:        time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, store.SaveAlertRule(rule))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	rules, err := reopened.AlertRules()
+	require.NoError(t, err)
+	assert.Equal(t, []AlertRule{rule}, rules)
+
+	got, ok, err := reopened.AlertRule("depth")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, rule, got)
+
+	require.NoError(t, reopened.DeleteAlertRule("depth"))
+	assert.ErrorIs(t, reopened.DeleteAlertRule("depth"), ErrAlertRuleNotFound)
+}
+
+func TestLocalStoreImpl_Trash(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	queue := TrashedQueue{
+		ID:         "abc123",
+		Name:       "orders",
+		URL:        "https://sqs.local/000000000000/orders",
+		Attributes: map[string]string{"VisibilityTimeout": "45"},
+		Tags:       map[string]string{"team": "payments"},
+		DeletedAt:  time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, store.SaveTrashedQueue(queue))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	trashed, err := reopened.TrashedQueues()
+	require.NoError(t, err)
+	assert.Equal(t, []TrashedQueue{queue}, trashed)
+
+	// Mutating a returned entry must not leak back into the store.
+	trashed[0].Tags["team"] = "changed"
+	got, ok, err := reopened.TrashedQueue("abc123")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, queue, got)
+
+	require.NoError(t, reopened.DeleteTrashedQueue("abc123"))
+	assert.ErrorIs(t, reopened.DeleteTrashedQueue("abc123"), ErrTrashedQueueNotFound)
+}
+
+func TestLocalStoreImpl_Baselines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	baseline := QueueBaseline{
+		QueueURL:   "https://sqs.local/000000000000/orders",
+		Attributes: map[string]string{"VisibilityTimeout": "45"},
+		Tags:       map[string]string{"team": "payments"},
+		SavedAt:    time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, store.SaveBaseline(baseline))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	baselines, err := reopened.Baselines()
+	require.NoError(t, err)
+	assert.Equal(t, []QueueBaseline{baseline}, baselines)
+
+	// Mutating a returned baseline must not leak back into the store.
+	baselines[0].Attributes["VisibilityTimeout"] = "0"
+	baselines, err = reopened.Baselines()
+	require.NoError(t, err)
+	assert.Equal(t, []QueueBaseline{baseline}, baselines)
+
+	require.NoError(t, reopened.DeleteBaseline(baseline.QueueURL))
+	assert.ErrorIs(t, reopened.DeleteBaseline(baseline.QueueURL), ErrBaselineNotFound)
+}
+
+func TestLocalStoreImpl_AttributeHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	history := AttributeHistory{
+		QueueURL:     "https://sqs.local/000000000000/orders",
+		WatchedSince: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		CheckedAt:    time.Date(2024, 5, 1, 13, 0, 0, 0, time.UTC),
+		Attributes:   map[string]string{"VisibilityTimeout": "90"},
+		Entries: []AttributeHistoryEntry{{
+			ObservedAt: time.Date(2024, 5, 1, 13, 0, 0, 0, time.UTC),
+			Changes:    []DriftChange{{Name: "VisibilityTimeout", Change: DriftChanged, Baseline: "30", Current: "90"}},
+		}},
+	}
+	require.NoError(t, store.SaveAttributeHistory(history))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	got, ok, err := reopened.AttributeHistory(history.QueueURL)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, history, got)
+
+	// Mutating a returned history must not leak back into the store.
+	got.Entries[0].Changes[0].Current = "0"
+	histories, err := reopened.AttributeHistories()
+	require.NoError(t, err)
+	assert.Equal(t, []AttributeHistory{history}, histories)
+
+	require.NoError(t, reopened.DeleteAttributeHistory(history.QueueURL))
+	assert.ErrorIs(t, reopened.DeleteAttributeHistory(history.QueueURL), ErrQueueNotWatched)
+}
+
+func TestLocalStoreImpl_PushSubscriptions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	subscription := PushSubscription{
+		Endpoint:     "https://push.local/send/abc",
+		Keys:         PushSubscriptionKeys{P256dh: "BKey", Auth: "secret"},
+		SubscribedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, store.SavePushSubscription(subscription))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	subscriptions, err := reopened.PushSubscriptions()
+	require.NoError(t, err)
+	assert.Equal(t, []PushSubscription{subscription}, subscriptions)
+
+	require.NoError(t, reopened.DeletePushSubscription(subscription.Endpoint))
+	assert.ErrorIs(t, reopened.DeletePushSubscription(subscription.Endpoint), ErrPushSubscriptionNotFound)
+}
+
+func TestLocalStoreImpl_MessageContracts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	contract := MessageContract{
+		QueueURL: "https://sqs.local/orders",
+		Sample:   `{"orderId":"o-1"}`,
+		Schema:   `{"type":"object"}`,
+		SavedAt:  time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, store.SaveMessageContract(contract))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	got, ok, err := reopened.MessageContract(contract.QueueURL)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, contract, got)
+
+	require.NoError(t, reopened.DeleteMessageContract(contract.QueueURL))
+	_, ok, err = reopened.MessageContract(contract.QueueURL)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.ErrorIs(t, reopened.DeleteMessageContract(contract.QueueURL), ErrMessageContractNotFound)
+}
+
+func TestLocalStoreImpl_Preferences(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	empty, err := store.Preferences()
+	require.NoError(t, err)
+	assert.Equal(t, Preferences{}, empty)
+
+	preferences := Preferences{QueueListColumns: []string{QueueColumnAvailable, QueueColumnTags}}
+	require.NoError(t, store.UpdatePreferences(func(p *Preferences) error {
+		*p = preferences
+		return nil
+	}))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	got, err := reopened.Preferences()
+	require.NoError(t, err)
+	assert.Equal(t, preferences, got)
+
+	// Mutating the returned preferences must not leak back into the store.
+	got.QueueListColumns[0] = QueueColumnArn
+	again, err := reopened.Preferences()
+	require.NoError(t, err)
+	assert.Equal(t, preferences, again)
+
+	// A failed update leaves the preferences as they were.
+	err = reopened.UpdatePreferences(func(p *Preferences) error {
+		p.QueueListColumns = nil
+		return errors.New("boom")
+	})
+	assert.EqualError(t, err, "boom")
+	again, err = reopened.Preferences()
+	require.NoError(t, err)
+	assert.Equal(t, preferences, again)
+}
+
+func TestLocalStoreImpl_SnapshotRestore(t *testing.T) {
+	source, err := NewLocalStore("")
+	require.NoError(t, err)
+
+	require.NoError(t, source.SaveDraft("https://sqs.local/orders", MessageDraft{Body: "{}"}))
+	require.NoError(t, source.SaveSchedule(Schedule{ID: "nightly", Cron: "0 3 * * *", History: []ScheduleRun{{Error: "boom"}}}))
+
+	snapshot, err := source.Snapshot()
+	require.NoError(t, err)
+
+	// Mutating the snapshot must not leak back into the store.
+	snapshot.Schedules["nightly"].History[0].Error = "changed"
+	stored, _, err := source.Schedule("nightly")
+	require.NoError(t, err)
+	assert.Equal(t, "boom", stored.History[0].Error)
+
+	path := filepath.Join(t.TempDir(), "state.json")
+	target, err := NewLocalStore(path)
+	require.NoError(t, err)
+	require.NoError(t, target.SaveAlertRule(AlertRule{ID: "depth"}))
+	require.NoError(t, target.Restore(snapshot))
+
+	reopened, err := NewLocalStore(path)
+	require.NoError(t, err)
+
+	restored, err := reopened.Snapshot()
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, restored)
+	assert.Empty(t, restored.AlertRules)
+}
@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// EmailMessage is a plain text email.
+type EmailMessage struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers email.
+type Mailer interface {
+	Send(ctx context.Context, message EmailMessage) error
+}
+
+// SMTPMailer sends email through an SMTP server.
+type SMTPMailer struct {
+	config SMTPConfig
+	from   string
+	// timeout bounds a whole delivery, from dialling the server to the end of the message.
+	timeout time.Duration
+}
+
+// NewSMTPMailer creates a mailer that sends as from through the server in config.
+func NewSMTPMailer(config SMTPConfig, from string) *SMTPMailer {
+	return &SMTPMailer{config: config, from: from, timeout: 30 * time.Second}
+}
+
+// Send delivers the message, upgrading to TLS when the server offers STARTTLS. Credentials are
+// only sent over TLS or to a server on localhost.
+func (m *SMTPMailer) Send(ctx context.Context, message EmailMessage) error {
+	host, _, err := net.SplitHostPort(m.config.Addr)
+	if err != nil {
+		return errors.Wrap(err, "invalid smtp address")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.config.Addr)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to smtp server")
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return errors.Wrap(err, "failed to start smtp session")
+	}
+	defer func() { _ = client.Close() }()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return errors.Wrap(err, "failed to start tls")
+		}
+	}
+	if m.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.config.Username, m.config.Password, host)); err != nil {
+			return errors.Wrap(err, "failed to authenticate with smtp server")
+		}
+	}
+
+	if err := client.Mail(m.from); err != nil {
+		return errors.Wrap(err, "smtp server rejected the sender")
+	}
+	for _, recipient := range message.To {
+		if err := client.Rcpt(recipient); err != nil {
+			return errors.Wrapf(err, "smtp server rejected recipient %s", recipient)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to start message")
+	}
+	if _, err := writer.Write(m.compose(message)); err != nil {
+		return errors.Wrap(err, "failed to write message")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "smtp server rejected the message")
+	}
+
+	return client.Quit()
+}
+
+// compose builds the RFC 5322 message with CRLF line endings.
+func (m *SMTPMailer) compose(message EmailMessage) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", m.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(message.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	body := strings.ReplaceAll(message.Body, "\r\n", "\n")
+	buf.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return buf.Bytes()
+}
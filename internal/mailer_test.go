@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts one session and records the envelope and message it receives. It never
+// offers STARTTLS or AUTH.
+type fakeSMTPServer struct {
+	addr       string
+	rejectRcpt string
+	done       chan struct{}
+	from       string
+	recipients []string
+	data       string
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	server := &fakeSMTPServer{addr: listener.Addr().String(), done: make(chan struct{})}
+	go func() {
+		defer close(server.done)
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		server.serve(textproto.NewConn(conn))
+	}()
+	return server
+}
+
+func (s *fakeSMTPServer) serve(conn *textproto.Conn) {
+	_ = conn.PrintfLine("220 fake ESMTP")
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			_ = conn.PrintfLine("250 fake")
+		case "MAIL":
+			s.from = strings.TrimSuffix(strings.TrimPrefix(line, "MAIL FROM:<"), ">")
+			_ = conn.PrintfLine("250 OK")
+		case "RCPT":
+			recipient := strings.TrimSuffix(strings.TrimPrefix(line, "RCPT TO:<"), ">")
+			if recipient == s.rejectRcpt {
+				_ = conn.PrintfLine("550 no such user")
+				continue
+			}
+			s.recipients = append(s.recipients, recipient)
+			_ = conn.PrintfLine("250 OK")
+		case "DATA":
+			_ = conn.PrintfLine("354 go ahead")
+			lines, err := conn.ReadDotLines()
+			if err != nil {
+				return
+			}
+			s.data = strings.Join(lines, "\n")
+			_ = conn.PrintfLine("250 queued")
+		case "QUIT":
+			_ = conn.PrintfLine("221 bye")
+			return
+		default:
+			_ = conn.PrintfLine("250 OK")
+		}
+	}
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	t.Run("delivers a plain text message", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		mailer := NewSMTPMailer(SMTPConfig{Addr: server.addr}, "sqs-gui@example.com")
+
+		err := mailer.Send(context.Background(), EmailMessage{
+			To:      []string{"ops@example.com", "dev@example.com"},
+			Subject: "SQS queue report for 2024-05-01",
+			Body:    "Watched queues\n- orders: 3 available\n",
+		})
+		require.NoError(t, err)
+		<-server.done
+
+		assert.Equal(t, "sqs-gui@example.com", server.from)
+		assert.Equal(t, []string{"ops@example.com", "dev@example.com"}, server.recipients)
+		headers, body, ok := strings.Cut(server.data, "\n\n")
+		require.True(t, ok)
+		assert.Contains(t, headers, "To: ops@example.com, dev@example.com")
+		assert.Contains(t, headers, "Subject: SQS queue report for 2024-05-01")
+		assert.Contains(t, headers, "Content-Type: text/plain; charset=utf-8")
+		assert.Equal(t, "Watched queues\n- orders: 3 available", body)
+	})
+
+	t.Run("fails when a recipient is rejected", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		server.rejectRcpt = "gone@example.com"
+		mailer := NewSMTPMailer(SMTPConfig{Addr: server.addr}, "sqs-gui@example.com")
+
+		err := mailer.Send(context.Background(), EmailMessage{To: []string{"gone@example.com"}, Subject: "s", Body: "b"})
+		assert.ErrorContains(t, err, "smtp server rejected recipient gone@example.com")
+	})
+}
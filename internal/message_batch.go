@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// maxBatchMessages bounds how many messages one batch send may contain.
+	maxBatchMessages = 100
+	// sqsBatchSize is the most entries SendMessageBatch accepts per call.
+	sqsBatchSize = 10
+	// batchSendMaxAttempts is how often an entry is sent before its failure is reported.
+	batchSendMaxAttempts = 4
+)
+
+// SendMessageBatchInput carries several messages for one queue. The QueueURL of the individual
+// messages is ignored.
+type SendMessageBatchInput struct {
+	QueueURL string
+	Messages []SendMessageInput
+}
+
+// SendMessageBatchResult reports how many messages were accepted and which ones were not.
+type SendMessageBatchResult struct {
+	Sent   int
+	Failed []BatchSendFailure
+}
+
+// BatchSendFailure describes a message SQS still rejected after retrying. Index is the position of
+// the message in the request and Code is the SQS error code.
+type BatchSendFailure struct {
+	Index    int
+	Code     string
+	Message  string
+	Attempts int
+}
+
+// SendMessageBatch sends messages in groups of ten. Entries SQS rejects for reasons on its side,
+// such as throttling, are retried with exponential backoff; entries rejected as invalid are
+// reported straight away. Only the entries that ultimately fail are returned.
+func (s *SqsServiceImpl) SendMessageBatch(ctx context.Context, input SendMessageBatchInput) (SendMessageBatchResult, error) {
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return SendMessageBatchResult{}, errors.New("queue url is required")
+	}
+	if len(input.Messages) == 0 {
+		return SendMessageBatchResult{}, errors.New("at least one message is required")
+	}
+	if len(input.Messages) > maxBatchMessages {
+		return SendMessageBatchResult{}, errors.Newf("at most %d messages can be sent at once", maxBatchMessages)
+	}
+
+	entries := make([]SendMessageBatchEntry, 0, len(input.Messages))
+	for i, message := range input.Messages {
+		prepared, _, err := prepareMessage(queueURL, message)
+		if err != nil {
+			return SendMessageBatchResult{}, errors.Wrapf(err, "message %d", i+1)
+		}
+		entries = append(entries, SendMessageBatchEntry{
+			ID:                     strconv.Itoa(i),
+			Body:                   prepared.Body,
+			MessageGroupID:         prepared.MessageGroupID,
+			MessageDeduplicationID: prepared.MessageDeduplicationID,
+			DelaySeconds:           prepared.DelaySeconds,
+			Attributes:             prepared.Attributes,
+			AttributeTypes:         prepared.AttributeTypes,
+		})
+	}
+
+	slog.DebugContext(ctx, "sending message batch", slog.String("queue_url", queueURL), slog.Int("messages", len(entries)))
+
+	result := SendMessageBatchResult{Failed: []BatchSendFailure{}}
+	for start := 0; start < len(entries); start += sqsBatchSize {
+		chunk := entries[start:min(start+sqsBatchSize, len(entries))]
+		failed, err := s.sendBatchWithRetry(ctx, queueURL, chunk)
+		if err != nil {
+			return SendMessageBatchResult{}, errors.Wrapf(err, "sent %d of %d messages", result.Sent, len(entries))
+		}
+		result.Sent += len(chunk) - len(failed)
+		result.Failed = append(result.Failed, failed...)
+	}
+	slices.SortFunc(result.Failed, func(a, b BatchSendFailure) int {
+		return a.Index - b.Index
+	})
+
+	return result, nil
+}
+
+// sendBatchWithRetry sends one SendMessageBatch worth of entries and resends the ones that failed
+// on the SQS side until they succeed or batchSendMaxAttempts is reached.
+func (s *SqsServiceImpl) sendBatchWithRetry(ctx context.Context, queueURL string, entries []SendMessageBatchEntry) ([]BatchSendFailure, error) {
+	var failed []BatchSendFailure
+	pending := entries
+	delay := s.sendRetryDelay
+	for attempt := 1; len(pending) > 0; attempt++ {
+		failures, err := s.repo.SendMessageBatch(ctx, SendMessageBatchRepositoryInput{QueueURL: queueURL, Entries: pending})
+		if err != nil {
+			return nil, err
+		}
+
+		byID := make(map[string]SendMessageBatchEntry, len(pending))
+		for _, entry := range pending {
+			byID[entry.ID] = entry
+		}
+
+		var retry []SendMessageBatchEntry
+		for _, failure := range failures {
+			entry, ok := byID[failure.ID]
+			if !ok {
+				continue
+			}
+			if failure.SenderFault || attempt == batchSendMaxAttempts {
+				index, _ := strconv.Atoi(entry.ID)
+				failed = append(failed, BatchSendFailure{
+					Index:    index,
+					Code:     failure.Code,
+					Message:  failure.Message,
+					Attempts: attempt,
+				})
+				continue
+			}
+			retry = append(retry, entry)
+		}
+		if len(retry) == 0 {
+			break
+		}
+
+		slog.WarnContext(ctx, "retrying failed batch entries",
+			slog.String("queue_url", queueURL),
+			slog.Int("entries", len(retry)),
+			slog.Int("attempt", attempt),
+		)
+		if err := waitFor(ctx, delay); err != nil {
+			return nil, err
+		}
+		delay *= 2
+		pending = retry
+	}
+
+	return failed, nil
+}
+
+// waitFor blocks for d or until ctx is done.
+func waitFor(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
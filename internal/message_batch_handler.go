@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+type sendMessageBatchRequest struct {
+	Messages []sendMessageRequest `json:"messages"`
+}
+
+type batchSendFailureItem struct {
+	Index    int    `json:"index"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Attempts int    `json:"attempts"`
+}
+
+type sendMessageBatchResponse struct {
+	Message string                 `json:"message"`
+	Sent    int                    `json:"sent"`
+	Failed  []batchSendFailureItem `json:"failed"`
+}
+
+// SendMessageBatchAPI sends several messages to a queue. Entries that still fail after retrying are
+// listed in the response with their SQS error codes; the request itself succeeds.
+func (h *HandlerImpl) SendMessageBatchAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	defer func() { _ = r.Body.Close() }()
+
+	var payload sendMessageBatchRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	input := SendMessageBatchInput{QueueURL: queueURL, Messages: make([]SendMessageInput, 0, len(payload.Messages))}
+	for _, message := range payload.Messages {
+		input.Messages = append(input.Messages, SendMessageInput{
+			Body:                   message.Body,
+			MessageGroupID:         message.MessageGroupID,
+			MessageDeduplicationID: message.MessageDeduplicationID,
+			DelaySeconds:           message.DelaySeconds,
+			Attributes:             convertPayloadAttributes(message.Attributes),
+		})
+	}
+
+	result, err := h.s.SendMessageBatch(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to send message batch", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	response := sendMessageBatchResponse{
+		Message: fmt.Sprintf("Sent %d of %d messages.", result.Sent, len(input.Messages)),
+		Sent:    result.Sent,
+		Failed:  make([]batchSendFailureItem, 0, len(result.Failed)),
+	}
+	for _, failure := range result.Failed {
+		response.Failed = append(response.Failed, batchSendFailureItem{
+			Index:    failure.Index,
+			Code:     failure.Code,
+			Message:  failure.Message,
+			Attempts: failure.Attempts,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
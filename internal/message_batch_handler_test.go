@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_SendMessageBatchAPI(t *testing.T) {
+	queueURL := "https://sqs.local/000000000000/orders"
+
+	t.Run("returns the entries that failed", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/queues/x/messages/batch", strings.NewReader(`{"messages":[{"body":"a"},{"body":"b","attributes":[{"name":"kind","value":"test"}]}]}`))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			SendMessageBatch(mock.Anything, SendMessageBatchInput{
+				QueueURL: queueURL,
+				Messages: []SendMessageInput{
+					{Body: "a"},
+					{Body: "b", Attributes: []MessageAttribute{{Name: "kind", Value: "test"}}},
+				},
+			}).
+			Return(SendMessageBatchResult{
+				Sent:   1,
+				Failed: []BatchSendFailure{{Index: 1, Code: "InternalError", Message: "try again", Attempts: 4}},
+			}, nil).
+			Once()
+
+		handler.SendMessageBatchAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"message":"Sent 1 of 2 messages.","sent":1,"failed":[{"index":1,"code":"InternalError","message":"try again","attempts":4}]}`, rr.Body.String())
+	})
+
+	t.Run("reports validation errors", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/queues/x/messages/batch", strings.NewReader(`{"messages":[]}`))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			SendMessageBatch(mock.Anything, mock.Anything).
+			Return(SendMessageBatchResult{}, assert.AnError).
+			Once()
+
+		handler.SendMessageBatchAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"`+assert.AnError.Error()+`"}`, rr.Body.String())
+	})
+}
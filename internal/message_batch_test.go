@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_SendMessageBatch(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+	entryIDs := func(input SendMessageBatchRepositoryInput) []string {
+		ids := make([]string, 0, len(input.Entries))
+		for _, entry := range input.Entries {
+			ids = append(ids, entry.ID)
+		}
+		return ids
+	}
+
+	t.Run("retries entries that failed on the SQS side", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().
+			SendMessageBatch(mock.Anything, mock.MatchedBy(func(input SendMessageBatchRepositoryInput) bool {
+				return len(input.Entries) == 3
+			})).
+			Return([]SendMessageBatchFailure{
+				{ID: "1", Code: "InternalError", Message: "try again"},
+				{ID: "2", Code: "InvalidParameterValue", Message: "bad attribute", SenderFault: true},
+			}, nil).
+			Once()
+		repo.EXPECT().
+			SendMessageBatch(mock.Anything, mock.MatchedBy(func(input SendMessageBatchRepositoryInput) bool {
+				return assert.ObjectsAreEqual([]string{"1"}, entryIDs(input))
+			})).
+			Return([]SendMessageBatchFailure{}, nil).
+			Once()
+
+		result, err := service.SendMessageBatch(ctx, SendMessageBatchInput{
+			QueueURL: queueURL,
+			Messages: []SendMessageInput{{Body: "a"}, {Body: "b"}, {Body: "c"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, SendMessageBatchResult{
+			Sent:   2,
+			Failed: []BatchSendFailure{{Index: 2, Code: "InvalidParameterValue", Message: "bad attribute", Attempts: 1}},
+		}, result)
+	})
+
+	t.Run("reports entries that keep failing", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().
+			SendMessageBatch(mock.Anything, mock.Anything).
+			Return([]SendMessageBatchFailure{{ID: "0", Code: "ServiceUnavailable", Message: "busy"}}, nil).
+			Times(batchSendMaxAttempts)
+
+		result, err := service.SendMessageBatch(ctx, SendMessageBatchInput{
+			QueueURL: queueURL,
+			Messages: []SendMessageInput{{Body: "a"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Sent)
+		assert.Equal(t, []BatchSendFailure{{Index: 0, Code: "ServiceUnavailable", Message: "busy", Attempts: batchSendMaxAttempts}}, result.Failed)
+	})
+
+	t.Run("splits messages into batches of ten", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		messages := make([]SendMessageInput, 25)
+		for i := range messages {
+			messages[i] = SendMessageInput{Body: fmt.Sprintf("message %d", i)}
+		}
+
+		var sizes []int
+		repo.EXPECT().
+			SendMessageBatch(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, input SendMessageBatchRepositoryInput) {
+				sizes = append(sizes, len(input.Entries))
+			}).
+			Return([]SendMessageBatchFailure{}, nil).
+			Times(3)
+
+		result, err := service.SendMessageBatch(ctx, SendMessageBatchInput{QueueURL: queueURL, Messages: messages})
+		require.NoError(t, err)
+		assert.Equal(t, 25, result.Sent)
+		assert.Empty(t, result.Failed)
+		assert.Equal(t, []int{10, 10, 5}, sizes)
+	})
+
+	t.Run("validates every message before sending", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.SendMessageBatch(ctx, SendMessageBatchInput{
+			QueueURL: "https://sqs.local/orders.fifo",
+			Messages: []SendMessageInput{{Body: "a", MessageGroupID: "g"}, {Body: "b"}},
+		})
+		assert.EqualError(t, err, "message 2: message group id is required for fifo queues")
+	})
+
+	t.Run("limits the number of messages", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.SendMessageBatch(ctx, SendMessageBatchInput{
+			QueueURL: queueURL,
+			Messages: make([]SendMessageInput, maxBatchMessages+1),
+		})
+		assert.EqualError(t, err, "at most 100 messages can be sent at once")
+	})
+
+	t.Run("stops when a call fails", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().
+			SendMessageBatch(mock.Anything, mock.Anything).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		_, err := service.SendMessageBatch(ctx, SendMessageBatchInput{
+			QueueURL: queueURL,
+			Messages: []SendMessageInput{{Body: "a"}},
+		})
+		assert.EqualError(t, err, "sent 0 of 1 messages: boom")
+	})
+}
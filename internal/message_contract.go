@@ -0,0 +1,547 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrMessageContractNotFound is returned when no contract is saved for a queue.
+var ErrMessageContractNotFound = errors.New("no message contract is saved for this queue")
+
+// maxContractMismatches caps the mismatches reported for one message, so a long array of wrong
+// elements does not flood the page.
+const maxContractMismatches = 100
+
+// Kinds of ContractMismatch.
+const (
+	// ContractMissing is a field the contract expects that the message lacks.
+	ContractMissing = "missing"
+	// ContractUnexpected is a field of the message the contract does not know.
+	ContractUnexpected = "unexpected"
+	// ContractType is a value of another JSON type than expected.
+	ContractType = "type"
+	// ContractValue is a value of the right type that breaks a schema constraint.
+	ContractValue = "value"
+)
+
+// MessageContract is what the JSON bodies of a queue are expected to look like. Sample is a golden
+// message whose structure (keys and value types, not values) is compared; Schema is a JSON Schema.
+// Either may be empty, and a message is checked against both when both are set.
+type MessageContract struct {
+	QueueURL string    `json:"queueUrl"`
+	Sample   string    `json:"sample,omitempty"`
+	Schema   string    `json:"schema,omitempty"`
+	SavedAt  time.Time `json:"savedAt"`
+}
+
+// ContractMismatch is one difference between a message and the contract. Path points into the
+// message as in $.order.items[0].sku, and Source tells whether the sample or the schema reported it.
+type ContractMismatch struct {
+	Path     string `json:"path"`
+	Kind     string `json:"kind"`
+	Source   string `json:"source"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	Message  string `json:"message"`
+}
+
+// MessageComparison is the result of comparing a message against the contract of its queue.
+type MessageComparison struct {
+	Matches    bool               `json:"matches"`
+	Mismatches []ContractMismatch `json:"mismatches"`
+	// Truncated reports that more than maxContractMismatches mismatches were found.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// contractAnnotations are schema keywords that describe rather than constrain, so they are accepted
+// and ignored.
+var contractAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true,
+	"default": true, "examples": true, "format": true, "deprecated": true, "readOnly": true, "writeOnly": true,
+}
+
+var contractSchemaTypes = []string{"array", "boolean", "integer", "null", "number", "object", "string"}
+
+// MessageContract returns the contract saved for queueURL.
+func (s *SqsServiceImpl) MessageContract(_ context.Context, queueURL string) (MessageContract, bool, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return MessageContract{}, false, errors.New("queue url is required")
+	}
+	if s.store == nil {
+		return MessageContract{}, false, nil
+	}
+
+	return s.store.MessageContract(queueURL)
+}
+
+// SaveMessageContract checks and stores the contract of a queue, replacing the previous one. The
+// sample must be JSON, and the schema must only use the supported subset of JSON Schema.
+func (s *SqsServiceImpl) SaveMessageContract(_ context.Context, contract MessageContract) (MessageContract, error) {
+	contract.QueueURL = strings.TrimSpace(contract.QueueURL)
+	if contract.QueueURL == "" {
+		return MessageContract{}, errors.New("queue url is required")
+	}
+	if strings.TrimSpace(contract.Sample) == "" && strings.TrimSpace(contract.Schema) == "" {
+		return MessageContract{}, errors.New("a sample message or a JSON Schema is required")
+	}
+	if len(contract.Sample) > maxMessageBodyBytes || len(contract.Schema) > maxMessageBodyBytes {
+		return MessageContract{}, errors.New("the sample and the schema must each fit the 256 KB message size limit")
+	}
+	if s.store == nil {
+		return MessageContract{}, errors.New("local state store is not configured")
+	}
+
+	if strings.TrimSpace(contract.Sample) != "" {
+		if _, err := decodeContractJSON(contract.Sample); err != nil {
+			return MessageContract{}, errors.Wrap(err, "invalid sample")
+		}
+	}
+	if strings.TrimSpace(contract.Schema) != "" {
+		schema, err := decodeContractJSON(contract.Schema)
+		if err != nil {
+			return MessageContract{}, errors.Wrap(err, "invalid schema")
+		}
+		if err := validateContractSchema(schema, "#"); err != nil {
+			return MessageContract{}, errors.Wrap(err, "invalid schema")
+		}
+	}
+
+	contract.SavedAt = s.now().UTC()
+	if err := s.store.SaveMessageContract(contract); err != nil {
+		return MessageContract{}, err
+	}
+	return contract, nil
+}
+
+// DeleteMessageContract removes the contract of queueURL.
+func (s *SqsServiceImpl) DeleteMessageContract(_ context.Context, queueURL string) error {
+	if s.store == nil {
+		return errors.WithStack(ErrMessageContractNotFound)
+	}
+	return s.store.DeleteMessageContract(strings.TrimSpace(queueURL))
+}
+
+// CompareMessage compares a message body against the contract of queueURL. A body that is not JSON
+// is reported as a mismatch rather than an error, since it breaks the contract like any other.
+func (s *SqsServiceImpl) CompareMessage(ctx context.Context, queueURL, body string) (MessageComparison, error) {
+	contract, ok, err := s.MessageContract(ctx, queueURL)
+	if err != nil {
+		return MessageComparison{}, err
+	}
+	if !ok {
+		return MessageComparison{}, errors.WithStack(ErrMessageContractNotFound)
+	}
+
+	return compareWithContract(contract, body)
+}
+
+// compareWithContract checks body against the sample and the schema of contract.
+func compareWithContract(contract MessageContract, body string) (MessageComparison, error) {
+	var mismatches []ContractMismatch
+	value, err := decodeContractJSON(body)
+	if err != nil {
+		mismatches = append(mismatches, ContractMismatch{
+			Path: "$", Kind: ContractType, Source: "body", Expected: "JSON", Actual: "text",
+			Message: "the body is not JSON",
+		})
+		return MessageComparison{Mismatches: mismatches}, nil
+	}
+
+	if strings.TrimSpace(contract.Sample) != "" {
+		sample, err := decodeContractJSON(contract.Sample)
+		if err != nil {
+			return MessageComparison{}, errors.Wrap(err, "invalid sample")
+		}
+		compareWithSample("$", sample, value, &mismatches)
+	}
+	if strings.TrimSpace(contract.Schema) != "" {
+		schema, err := decodeContractJSON(contract.Schema)
+		if err != nil {
+			return MessageComparison{}, errors.Wrap(err, "invalid schema")
+		}
+		checkContractSchema("$", schema, value, &mismatches)
+	}
+
+	comparison := MessageComparison{Matches: len(mismatches) == 0, Mismatches: mismatches}
+	if comparison.Mismatches == nil {
+		comparison.Mismatches = []ContractMismatch{}
+	}
+	if len(comparison.Mismatches) > maxContractMismatches {
+		comparison.Mismatches = comparison.Mismatches[:maxContractMismatches]
+		comparison.Truncated = true
+	}
+	return comparison, nil
+}
+
+// decodeContractJSON decodes one JSON document, keeping numbers as json.Number so integers can be
+// told apart from fractions.
+func decodeContractJSON(raw string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, errors.New("must be valid JSON")
+	}
+	if decoder.More() {
+		return nil, errors.New("must be a single JSON document")
+	}
+	return value, nil
+}
+
+// compareWithSample reports where actual differs in structure from the sample expected. A null in
+// the sample allows any value, and every element of an array is compared with the sample's first.
+func compareWithSample(path string, expected, actual any, mismatches *[]ContractMismatch) {
+	if expected == nil {
+		return
+	}
+	expectedType, actualType := sampleJSONType(expected), sampleJSONType(actual)
+	if expectedType != actualType {
+		*mismatches = append(*mismatches, ContractMismatch{
+			Path: path, Kind: ContractType, Source: "sample", Expected: expectedType, Actual: actualType,
+			Message: fmt.Sprintf("expected %s, got %s", expectedType, actualType),
+		})
+		return
+	}
+
+	switch expectedValue := expected.(type) {
+	case map[string]any:
+		actualValue := actual.(map[string]any)
+		for _, key := range slices.Sorted(maps.Keys(expectedValue)) {
+			child, ok := actualValue[key]
+			if !ok {
+				*mismatches = append(*mismatches, ContractMismatch{
+					Path: contractFieldPath(path, key), Kind: ContractMissing, Source: "sample",
+					Expected: sampleJSONType(expectedValue[key]), Message: "field is missing",
+				})
+				continue
+			}
+			compareWithSample(contractFieldPath(path, key), expectedValue[key], child, mismatches)
+		}
+		for _, key := range slices.Sorted(maps.Keys(actualValue)) {
+			if _, ok := expectedValue[key]; !ok {
+				*mismatches = append(*mismatches, ContractMismatch{
+					Path: contractFieldPath(path, key), Kind: ContractUnexpected, Source: "sample",
+					Actual: sampleJSONType(actualValue[key]), Message: "field is not in the sample",
+				})
+			}
+		}
+	case []any:
+		if len(expectedValue) == 0 {
+			return
+		}
+		for i, element := range actual.([]any) {
+			compareWithSample(path+"["+strconv.Itoa(i)+"]", expectedValue[0], element, mismatches)
+		}
+	}
+}
+
+// validateContractSchema checks that schema only uses the keywords checkContractSchema knows, with
+// values of the right type. at is the JSON pointer of schema within the whole document.
+func validateContractSchema(schema any, at string) error {
+	if _, ok := schema.(bool); ok {
+		return nil
+	}
+	object, ok := schema.(map[string]any)
+	if !ok {
+		return errors.Newf("%s: a schema must be an object or a boolean", at)
+	}
+
+	for _, keyword := range slices.Sorted(maps.Keys(object)) {
+		value := object[keyword]
+		keywordAt := at + "/" + keyword
+		switch {
+		case contractAnnotations[keyword]:
+		case keyword == "type":
+			types, ok := contractSchemaTypeList(value)
+			if !ok {
+				return errors.Newf("%s: must be a type name or a list of them", keywordAt)
+			}
+			for _, name := range types {
+				if !slices.Contains(contractSchemaTypes, name) {
+					return errors.Newf("%s: unknown type %q", keywordAt, name)
+				}
+			}
+		case keyword == "properties":
+			properties, ok := value.(map[string]any)
+			if !ok {
+				return errors.Newf("%s: must be an object", keywordAt)
+			}
+			for _, name := range slices.Sorted(maps.Keys(properties)) {
+				if err := validateContractSchema(properties[name], keywordAt+"/"+name); err != nil {
+					return err
+				}
+			}
+		case keyword == "additionalProperties" || keyword == "items":
+			if err := validateContractSchema(value, keywordAt); err != nil {
+				return err
+			}
+		case keyword == "required":
+			names, ok := value.([]any)
+			if !ok {
+				return errors.Newf("%s: must be a list of field names", keywordAt)
+			}
+			for _, name := range names {
+				if _, ok := name.(string); !ok {
+					return errors.Newf("%s: must be a list of field names", keywordAt)
+				}
+			}
+		case keyword == "enum":
+			if _, ok := value.([]any); !ok {
+				return errors.Newf("%s: must be a list", keywordAt)
+			}
+		case keyword == "const":
+		case keyword == "minimum" || keyword == "maximum" || keyword == "exclusiveMinimum" || keyword == "exclusiveMaximum":
+			if _, ok := contractNumber(value); !ok {
+				return errors.Newf("%s: must be a number", keywordAt)
+			}
+		case keyword == "minLength" || keyword == "maxLength" || keyword == "minItems" || keyword == "maxItems":
+			if _, ok := contractCount(value); !ok {
+				return errors.Newf("%s: must be a non-negative integer", keywordAt)
+			}
+		case keyword == "pattern":
+			pattern, ok := value.(string)
+			if !ok {
+				return errors.Newf("%s: must be a string", keywordAt)
+			}
+			if _, err := regexp.Compile(pattern); err != nil {
+				return errors.Newf("%s: invalid pattern: %v", keywordAt, err)
+			}
+		default:
+			return errors.Newf("%s: unsupported JSON Schema keyword %q", at, keyword)
+		}
+	}
+	return nil
+}
+
+// checkContractSchema reports where value breaks schema, which validateContractSchema accepted.
+// As in JSON Schema, keywords for one type are ignored for values of another.
+func checkContractSchema(path string, schema, value any, mismatches *[]ContractMismatch) {
+	report := func(kind, expected, actual, message string) {
+		*mismatches = append(*mismatches, ContractMismatch{
+			Path: path, Kind: kind, Source: "schema", Expected: expected, Actual: actual, Message: message,
+		})
+	}
+
+	if allowed, ok := schema.(bool); ok {
+		if !allowed {
+			report(ContractUnexpected, "", contractJSONType(value), "no value is allowed here")
+		}
+		return
+	}
+	object, _ := schema.(map[string]any)
+
+	actualType := contractJSONType(value)
+	if types, ok := contractSchemaTypeList(object["type"]); ok && !contractTypeAllowed(types, actualType) {
+		expected := strings.Join(types, " or ")
+		report(ContractType, expected, actualType, fmt.Sprintf("expected %s, got %s", expected, actualType))
+		return
+	}
+	if options, ok := object["enum"].([]any); ok && !slices.ContainsFunc(options, func(option any) bool { return contractEqual(option, value) }) {
+		report(ContractValue, contractJSONText(options), contractJSONText(value), "value is not one of the allowed values")
+	}
+	if constant, ok := object["const"]; ok && !contractEqual(constant, value) {
+		report(ContractValue, contractJSONText(constant), contractJSONText(value), "value is not the expected constant")
+	}
+
+	switch actual := value.(type) {
+	case json.Number:
+		number, _ := contractNumber(actual)
+		text := actual.String()
+		if limit, ok := contractNumber(object["minimum"]); ok && number < limit {
+			report(ContractValue, ">= "+contractJSONText(object["minimum"]), text, "value is below the minimum")
+		}
+		if limit, ok := contractNumber(object["exclusiveMinimum"]); ok && number <= limit {
+			report(ContractValue, "> "+contractJSONText(object["exclusiveMinimum"]), text, "value is not above the exclusive minimum")
+		}
+		if limit, ok := contractNumber(object["maximum"]); ok && number > limit {
+			report(ContractValue, "<= "+contractJSONText(object["maximum"]), text, "value is above the maximum")
+		}
+		if limit, ok := contractNumber(object["exclusiveMaximum"]); ok && number >= limit {
+			report(ContractValue, "< "+contractJSONText(object["exclusiveMaximum"]), text, "value is not below the exclusive maximum")
+		}
+	case string:
+		length := utf8.RuneCountInString(actual)
+		if limit, ok := contractCount(object["minLength"]); ok && length < limit {
+			report(ContractValue, fmt.Sprintf("at least %d characters", limit), fmt.Sprintf("%d characters", length), "string is too short")
+		}
+		if limit, ok := contractCount(object["maxLength"]); ok && length > limit {
+			report(ContractValue, fmt.Sprintf("at most %d characters", limit), fmt.Sprintf("%d characters", length), "string is too long")
+		}
+		if pattern, ok := object["pattern"].(string); ok {
+			if compiled, err := regexp.Compile(pattern); err == nil && !compiled.MatchString(actual) {
+				report(ContractValue, pattern, actual, "string does not match the pattern")
+			}
+		}
+	case []any:
+		if limit, ok := contractCount(object["minItems"]); ok && len(actual) < limit {
+			report(ContractValue, fmt.Sprintf("at least %d items", limit), fmt.Sprintf("%d items", len(actual)), "array has too few items")
+		}
+		if limit, ok := contractCount(object["maxItems"]); ok && len(actual) > limit {
+			report(ContractValue, fmt.Sprintf("at most %d items", limit), fmt.Sprintf("%d items", len(actual)), "array has too many items")
+		}
+		if items, ok := object["items"]; ok {
+			for i, element := range actual {
+				checkContractSchema(path+"["+strconv.Itoa(i)+"]", items, element, mismatches)
+			}
+		}
+	case map[string]any:
+		properties, _ := object["properties"].(map[string]any)
+		required, _ := object["required"].([]any)
+		for _, name := range required {
+			key := name.(string)
+			if _, ok := actual[key]; !ok {
+				*mismatches = append(*mismatches, ContractMismatch{
+					Path: contractFieldPath(path, key), Kind: ContractMissing, Source: "schema",
+					Message: "required field is missing",
+				})
+			}
+		}
+		additional, hasAdditional := object["additionalProperties"]
+		for _, key := range slices.Sorted(maps.Keys(actual)) {
+			if property, ok := properties[key]; ok {
+				checkContractSchema(contractFieldPath(path, key), property, actual[key], mismatches)
+				continue
+			}
+			if !hasAdditional {
+				continue
+			}
+			if allowed, ok := additional.(bool); ok {
+				if !allowed {
+					*mismatches = append(*mismatches, ContractMismatch{
+						Path: contractFieldPath(path, key), Kind: ContractUnexpected, Source: "schema",
+						Actual: contractJSONType(actual[key]), Message: "field is not allowed by the schema",
+					})
+				}
+				continue
+			}
+			checkContractSchema(contractFieldPath(path, key), additional, actual[key], mismatches)
+		}
+	}
+}
+
+// contractJSONType names the JSON type of a decoded value; numbers without a fraction are integers.
+func contractJSONType(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if number, err := v.Float64(); err == nil && number == math.Trunc(number) {
+			return "integer"
+		}
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return "unknown"
+}
+
+// sampleJSONType is contractJSONType without integers: a sample shows a number, not whether it
+// must be whole.
+func sampleJSONType(value any) string {
+	if name := contractJSONType(value); name != "integer" {
+		return name
+	}
+	return "number"
+}
+
+// contractTypeAllowed reports whether a value of actualType satisfies one of types. Integers are
+// numbers too.
+func contractTypeAllowed(types []string, actualType string) bool {
+	return slices.Contains(types, actualType) || (actualType == "integer" && slices.Contains(types, "number"))
+}
+
+// contractSchemaTypeList reads the type keyword, which is a name or a list of names.
+func contractSchemaTypeList(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		types := make([]string, 0, len(v))
+		for _, item := range v {
+			name, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			types = append(types, name)
+		}
+		return types, len(types) > 0
+	}
+	return nil, false
+}
+
+func contractNumber(value any) (float64, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	parsed, err := number.Float64()
+	return parsed, err == nil
+}
+
+func contractCount(value any) (int, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	count, err := number.Int64()
+	if err != nil || count < 0 {
+		return 0, false
+	}
+	return int(count), true
+}
+
+// contractEqual compares decoded JSON values, treating 1 and 1.0 as the same number.
+func contractEqual(a, b any) bool {
+	x, xIsNumber := contractNumber(a)
+	y, yIsNumber := contractNumber(b)
+	if xIsNumber || yIsNumber {
+		return xIsNumber && yIsNumber && x == y
+	}
+	switch av := a.(type) {
+	case []any:
+		bv, ok := b.([]any)
+		return ok && slices.EqualFunc(av, bv, contractEqual)
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		return ok && maps.EqualFunc(av, bv, contractEqual)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// contractJSONText renders a decoded value as compact JSON for a mismatch report.
+func contractJSONText(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+var contractIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// contractFieldPath appends key to path as .key, or as ["key"] when it is not a plain identifier.
+func contractFieldPath(path, key string) string {
+	if contractIdentifier.MatchString(key) {
+		return path + "." + key
+	}
+	return path + "[" + strconv.Quote(key) + "]"
+}
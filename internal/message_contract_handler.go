@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// maxContractRequestBytes bounds a contract or a compared body, which are each limited to the size
+// of a message, plus the JSON around them.
+const maxContractRequestBytes = 2*maxMessageBodyBytes + 64<<10
+
+type saveMessageContractRequest struct {
+	Sample string `json:"sample"`
+	Schema string `json:"schema"`
+}
+
+type saveMessageContractResponse struct {
+	Message string `json:"message"`
+	SavedAt string `json:"savedAt"`
+}
+
+type compareMessageRequest struct {
+	Body string `json:"body"`
+}
+
+// GetMessageContractAPI returns the message contract of a queue.
+func (h *HandlerImpl) GetMessageContractAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	contract, ok, err := h.s.MessageContract(r.Context(), queueURL)
+	if err != nil {
+		writeContractError(w, err)
+		return
+	}
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, ErrMessageContractNotFound.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, contract)
+}
+
+// PutMessageContractAPI saves the golden sample and JSON Schema messages of a queue are compared with.
+func (h *HandlerImpl) PutMessageContractAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxContractRequestBytes)
+	var payload saveMessageContractRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	saved, err := h.s.SaveMessageContract(r.Context(), MessageContract{QueueURL: queueURL, Sample: payload.Sample, Schema: payload.Schema})
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to save message contract", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeContractError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, saveMessageContractResponse{Message: "Message contract saved.", SavedAt: saved.SavedAt.Format(time.RFC3339)})
+}
+
+// DeleteMessageContractAPI removes the message contract of a queue.
+func (h *HandlerImpl) DeleteMessageContractAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	if err := h.s.DeleteMessageContract(r.Context(), queueURL); err != nil {
+		writeContractError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deleteMessageResponse{Message: "Message contract removed."})
+}
+
+// CompareMessageAPI compares a received message body with the contract of its queue.
+func (h *HandlerImpl) CompareMessageAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxContractRequestBytes)
+	var payload compareMessageRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	comparison, err := h.s.CompareMessage(r.Context(), queueURL, payload.Body)
+	if err != nil {
+		writeContractError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, comparison)
+}
+
+func writeContractError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrMessageContractNotFound) {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSONError(w, serviceErrorStatus(err), err.Error())
+}
@@ -0,0 +1,173 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newContractRequest(method, queueURL, suffix, body string) *http.Request {
+	req := httptest.NewRequest(method, "/api/v1/queues/"+url.QueryEscape(queueURL)+"/contract"+suffix, strings.NewReader(body))
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	return req
+}
+
+func TestHandlerImpl_GetMessageContractAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("returns the contract", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().MessageContract(mock.Anything, queueURL).Return(MessageContract{
+			QueueURL: queueURL,
+			Sample:   `{"id":1}`,
+			SavedAt:  time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		}, true, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.GetMessageContractAPI(rr, newContractRequest(http.MethodGet, queueURL, "", ""))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"queueUrl":"https://sqs.local/orders","sample":"{\"id\":1}","savedAt":"2024-05-01T12:00:00Z"}`, rr.Body.String())
+	})
+
+	t.Run("no contract", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().MessageContract(mock.Anything, queueURL).Return(MessageContract{}, false, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.GetMessageContractAPI(rr, newContractRequest(http.MethodGet, queueURL, "", ""))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.JSONEq(t, `{"error":"no message contract is saved for this queue"}`, rr.Body.String())
+	})
+}
+
+func TestHandlerImpl_PutMessageContractAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	testCases := []struct {
+		name       string
+		body       string
+		setup      func(*MockSqsService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "saves the contract",
+			body: `{"sample":"{\"id\":1}","schema":""}`,
+			setup: func(m *MockSqsService) {
+				m.EXPECT().SaveMessageContract(mock.Anything, MessageContract{QueueURL: queueURL, Sample: `{"id":1}`}).
+					Return(MessageContract{QueueURL: queueURL, Sample: `{"id":1}`, SavedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"message":"Message contract saved.","savedAt":"2024-05-01T12:00:00Z"}`,
+		},
+		{
+			name: "invalid schema",
+			body: `{"schema":"{\"oneOf\":[]}"}`,
+			setup: func(m *MockSqsService) {
+				m.EXPECT().SaveMessageContract(mock.Anything, mock.Anything).
+					Return(MessageContract{}, errors.New(`invalid schema: #: unsupported JSON Schema keyword "oneOf"`)).Once()
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"invalid schema: #: unsupported JSON Schema keyword \"oneOf\""}`,
+		},
+		{
+			name:       "rejects unknown fields",
+			body:       `{"sample":"{}","examples":[]}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"invalid request body"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSqsService(t)
+			if tc.setup != nil {
+				tc.setup(mockService)
+			}
+			handler := NewHandler(mockService)
+
+			rr := httptest.NewRecorder()
+			handler.PutMessageContractAPI(rr, newContractRequest(http.MethodPut, queueURL, "", tc.body))
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+			assert.JSONEq(t, tc.wantBody, rr.Body.String())
+		})
+	}
+}
+
+func TestHandlerImpl_DeleteMessageContractAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("removes the contract", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().DeleteMessageContract(mock.Anything, queueURL).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.DeleteMessageContractAPI(rr, newContractRequest(http.MethodDelete, queueURL, "", ""))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"message":"Message contract removed."}`, rr.Body.String())
+	})
+
+	t.Run("no contract", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().DeleteMessageContract(mock.Anything, queueURL).Return(ErrMessageContractNotFound).Once()
+
+		rr := httptest.NewRecorder()
+		handler.DeleteMessageContractAPI(rr, newContractRequest(http.MethodDelete, queueURL, "", ""))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestHandlerImpl_CompareMessageAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("reports the mismatches", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().CompareMessage(mock.Anything, queueURL, `{"id":"x"}`).Return(MessageComparison{
+			Mismatches: []ContractMismatch{{Path: "$.id", Kind: ContractType, Source: "sample", Expected: "number", Actual: "string", Message: "expected number, got string"}},
+		}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.CompareMessageAPI(rr, newContractRequest(http.MethodPost, queueURL, "/compare", `{"body":"{\"id\":\"x\"}"}`))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"matches":false,"mismatches":[{"path":"$.id","kind":"type","source":"sample","expected":"number","actual":"string","message":"expected number, got string"}]}`, rr.Body.String())
+	})
+
+	t.Run("no contract", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().CompareMessage(mock.Anything, queueURL, "{}").Return(MessageComparison{}, errors.WithStack(ErrMessageContractNotFound)).Once()
+
+		rr := httptest.NewRecorder()
+		handler.CompareMessageAPI(rr, newContractRequest(http.MethodPost, queueURL, "/compare", `{"body":"{}"}`))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.JSONEq(t, `{"error":"no message contract is saved for this queue"}`, rr.Body.String())
+	})
+
+	t.Run("invalid queue url", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+
+		rr := httptest.NewRecorder()
+		handler.CompareMessageAPI(rr, httptest.NewRequest(http.MethodPost, "/api/v1/queues/%25/contract/compare", strings.NewReader(`{"body":"{}"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"queue url is required"}`, rr.Body.String())
+	})
+}
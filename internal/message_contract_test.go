@@ -0,0 +1,168 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_SaveMessageContract(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("saves the contract", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return now }}
+		want := MessageContract{
+			QueueURL: "https://sqs.local/orders",
+			Sample:   `{"orderId":"o-1"}`,
+			Schema:   `{"type":"object","title":"Order","required":["orderId"],"properties":{"orderId":{"type":"string","pattern":"^o-"}}}`,
+			SavedAt:  now,
+		}
+		store.EXPECT().SaveMessageContract(want).Return(nil).Once()
+
+		saved, err := service.SaveMessageContract(ctx, MessageContract{QueueURL: " https://sqs.local/orders ", Sample: want.Sample, Schema: want.Schema})
+		require.NoError(t, err)
+		assert.Equal(t, want, saved)
+	})
+
+	testCases := []struct {
+		name     string
+		contract MessageContract
+		wantErr  string
+	}{
+		{name: "empty", contract: MessageContract{QueueURL: "https://sqs.local/orders", Sample: " "}, wantErr: "a sample message or a JSON Schema is required"},
+		{name: "sample is not json", contract: MessageContract{QueueURL: "https://sqs.local/orders", Sample: "{"}, wantErr: "invalid sample: must be valid JSON"},
+		{name: "two documents", contract: MessageContract{QueueURL: "https://sqs.local/orders", Sample: "{} {}"}, wantErr: "invalid sample: must be a single JSON document"},
+		{name: "schema is not an object", contract: MessageContract{QueueURL: "https://sqs.local/orders", Schema: `"object"`}, wantErr: "invalid schema: #: a schema must be an object or a boolean"},
+		{name: "unsupported keyword", contract: MessageContract{QueueURL: "https://sqs.local/orders", Schema: `{"properties":{"a":{"oneOf":[]}}}`}, wantErr: `invalid schema: #/properties/a: unsupported JSON Schema keyword "oneOf"`},
+		{name: "unknown type", contract: MessageContract{QueueURL: "https://sqs.local/orders", Schema: `{"type":["string","date"]}`}, wantErr: `invalid schema: #/type: unknown type "date"`},
+		{name: "negative length", contract: MessageContract{QueueURL: "https://sqs.local/orders", Schema: `{"minLength":-1}`}, wantErr: "invalid schema: #/minLength: must be a non-negative integer"},
+		{name: "invalid pattern", contract: MessageContract{QueueURL: "https://sqs.local/orders", Schema: `{"pattern":"("}`}, wantErr: "invalid schema: #/pattern: invalid pattern"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &SqsServiceImpl{store: NewMockLocalStore(t)}
+
+			_, err := service.SaveMessageContract(ctx, tc.contract)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestSqsServiceImpl_CompareMessage(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("compares with the saved contract", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store}
+		store.EXPECT().MessageContract(queueURL).Return(MessageContract{QueueURL: queueURL, Sample: `{"id":1}`}, true, nil).Once()
+
+		comparison, err := service.CompareMessage(ctx, queueURL, `{"id":2}`)
+		require.NoError(t, err)
+		assert.Equal(t, MessageComparison{Matches: true, Mismatches: []ContractMismatch{}}, comparison)
+	})
+
+	t.Run("no contract", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store}
+		store.EXPECT().MessageContract(queueURL).Return(MessageContract{}, false, nil).Once()
+
+		_, err := service.CompareMessage(ctx, queueURL, `{}`)
+		assert.ErrorIs(t, err, ErrMessageContractNotFound)
+	})
+}
+
+func TestCompareWithContract(t *testing.T) {
+	testCases := []struct {
+		name     string
+		contract MessageContract
+		body     string
+		want     []ContractMismatch
+	}{
+		{
+			name:     "sample structure",
+			contract: MessageContract{Sample: `{"id":"o-1","total":10,"note":null,"items":[{"sku":"A","qty":1}],"customer":{"name":"x"}}`},
+			body:     `{"id":"o-2","total":12.5,"note":{"any":true},"items":[{"sku":"B","qty":2},{"sku":3}],"customer":{"name":"y","vip":true},"odd key":1}`,
+			want: []ContractMismatch{
+				{Path: `$.customer.vip`, Kind: ContractUnexpected, Source: "sample", Actual: "boolean", Message: "field is not in the sample"},
+				{Path: `$.items[1].qty`, Kind: ContractMissing, Source: "sample", Expected: "number", Message: "field is missing"},
+				{Path: `$.items[1].sku`, Kind: ContractType, Source: "sample", Expected: "string", Actual: "number", Message: "expected string, got number"},
+				{Path: `$["odd key"]`, Kind: ContractUnexpected, Source: "sample", Actual: "number", Message: "field is not in the sample"},
+			},
+		},
+		{
+			name: "schema constraints",
+			contract: MessageContract{Schema: `{
+				"type": "object",
+				"required": ["id", "status"],
+				"additionalProperties": false,
+				"properties": {
+					"id": {"type": "string", "pattern": "^o-", "maxLength": 4},
+					"total": {"type": "number", "minimum": 0, "exclusiveMaximum": 100},
+					"count": {"type": "integer"},
+					"status": {"enum": ["new", "paid"]},
+					"items": {"type": "array", "minItems": 1, "items": {"type": "object", "required": ["sku"]}}
+				}
+			}`},
+			body: `{"id":"x-12345","total":100,"count":1.5,"items":[{"qty":1}],"extra":true}`,
+			want: []ContractMismatch{
+				{Path: "$.status", Kind: ContractMissing, Source: "schema", Message: "required field is missing"},
+				{Path: "$.count", Kind: ContractType, Source: "schema", Expected: "integer", Actual: "number", Message: "expected integer, got number"},
+				{Path: "$.extra", Kind: ContractUnexpected, Source: "schema", Actual: "boolean", Message: "field is not allowed by the schema"},
+				{Path: "$.id", Kind: ContractValue, Source: "schema", Expected: "at most 4 characters", Actual: "7 characters", Message: "string is too long"},
+				{Path: "$.id", Kind: ContractValue, Source: "schema", Expected: "^o-", Actual: "x-12345", Message: "string does not match the pattern"},
+				{Path: "$.items[0].sku", Kind: ContractMissing, Source: "schema", Message: "required field is missing"},
+				{Path: "$.total", Kind: ContractValue, Source: "schema", Expected: "< 100", Actual: "100", Message: "value is not below the exclusive maximum"},
+			},
+		},
+		{
+			name:     "enum compares numbers by value",
+			contract: MessageContract{Schema: `{"properties":{"version":{"enum":[1,2]},"kind":{"const":"order"}}}`},
+			body:     `{"version":1.0,"kind":"refund"}`,
+			want: []ContractMismatch{
+				{Path: "$.kind", Kind: ContractValue, Source: "schema", Expected: `"order"`, Actual: `"refund"`, Message: "value is not the expected constant"},
+			},
+		},
+		{
+			name:     "sample and schema together",
+			contract: MessageContract{Sample: `{"id":"o-1"}`, Schema: `{"properties":{"id":{"minLength":5}}}`},
+			body:     `{"id":7}`,
+			want: []ContractMismatch{
+				{Path: "$.id", Kind: ContractType, Source: "sample", Expected: "string", Actual: "number", Message: "expected string, got number"},
+			},
+		},
+		{
+			name:     "body is not json",
+			contract: MessageContract{Sample: `{}`},
+			body:     "plain text",
+			want: []ContractMismatch{
+				{Path: "$", Kind: ContractType, Source: "body", Expected: "JSON", Actual: "text", Message: "the body is not JSON"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			comparison, err := compareWithContract(tc.contract, tc.body)
+			require.NoError(t, err)
+			assert.False(t, comparison.Matches)
+			assert.Equal(t, tc.want, comparison.Mismatches)
+		})
+	}
+
+	t.Run("caps the mismatches", func(t *testing.T) {
+		body := "[" + strings.Repeat(`1,`, maxContractMismatches) + "1]"
+
+		comparison, err := compareWithContract(MessageContract{Sample: `["a"]`}, body)
+		require.NoError(t, err)
+		assert.Len(t, comparison.Mismatches, maxContractMismatches)
+		assert.True(t, comparison.Truncated)
+	})
+}
@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+)
+
+// DuplicateBodyGroup is a set of distinct messages that share the same body.
+type DuplicateBodyGroup struct {
+	BodyHash   string
+	Body       string
+	MessageIDs []string
+}
+
+// DuplicateMessageReport lists the bodies that more than one sampled message carries. Groups are
+// ordered from the largest to the smallest. Duplicates counts the messages beyond the first of
+// each group, which is how many a consumer would process twice.
+type DuplicateMessageReport struct {
+	QueueURL   string
+	Requested  int
+	Sampled    int
+	Duplicates int
+	Groups     []DuplicateBodyGroup
+}
+
+// FindDuplicateMessages samples up to samples messages from queueURL and groups them by a hash of
+// their body. Several message IDs with the same body usually mean a producer retried a send, or a
+// FIFO producer sent without a stable deduplication ID.
+func (s *SqsServiceImpl) FindDuplicateMessages(ctx context.Context, queueURL string, samples int) (DuplicateMessageReport, error) {
+	messages, requested, err := s.sampleMessages(ctx, queueURL, samples)
+	if err != nil {
+		return DuplicateMessageReport{}, err
+	}
+
+	report := DuplicateMessageReport{QueueURL: queueURL, Requested: requested, Sampled: len(messages)}
+	report.Groups = duplicateBodyGroups(messages)
+	for _, group := range report.Groups {
+		report.Duplicates += len(group.MessageIDs) - 1
+	}
+	return report, nil
+}
+
+// duplicateBodyGroups groups messages with distinct IDs by body hash and keeps the groups with more
+// than one message. A message received twice under the same ID is not a duplicate.
+func duplicateBodyGroups(messages []ReceivedMessage) []DuplicateBodyGroup {
+	var groups []DuplicateBodyGroup
+	index := make(map[string]int)
+	seen := make(map[string]bool, len(messages))
+	for _, message := range messages {
+		if seen[message.ID] {
+			continue
+		}
+		seen[message.ID] = true
+
+		hash := bodyHash(message.Body)
+		i, ok := index[hash]
+		if !ok {
+			i = len(groups)
+			index[hash] = i
+			groups = append(groups, DuplicateBodyGroup{BodyHash: hash, Body: message.Body})
+		}
+		groups[i].MessageIDs = append(groups[i].MessageIDs, message.ID)
+	}
+
+	groups = slices.DeleteFunc(groups, func(group DuplicateBodyGroup) bool {
+		return len(group.MessageIDs) < 2
+	})
+	slices.SortStableFunc(groups, func(a, b DuplicateBodyGroup) int {
+		return cmp.Compare(len(b.MessageIDs), len(a.MessageIDs))
+	})
+	return groups
+}
+
+// bodyHash is the hex SHA-256 of a message body.
+func bodyHash(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(sum[:])
+}
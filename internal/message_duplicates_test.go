@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_FindDuplicateMessages(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo}
+
+	repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+		{ID: "1", Body: "order-1"},
+		{ID: "2", Body: "order-2"},
+		{ID: "3", Body: "order-1"},
+		{ID: "4", Body: "order-3"},
+		{ID: "5", Body: "order-3"},
+		{ID: "6", Body: "order-1"},
+	}, nil).Once()
+	repo.EXPECT().ChangeMessageVisibility(mock.Anything, mock.Anything).Return(nil).Times(6)
+
+	report, err := service.FindDuplicateMessages(ctx, queueURL, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 6, report.Sampled)
+	assert.Equal(t, 3, report.Duplicates)
+	assert.Equal(t, []DuplicateBodyGroup{
+		{BodyHash: bodyHash("order-1"), Body: "order-1", MessageIDs: []string{"1", "3", "6"}},
+		{BodyHash: bodyHash("order-3"), Body: "order-3", MessageIDs: []string{"4", "5"}},
+	}, report.Groups)
+}
+
+func TestDuplicateBodyGroups(t *testing.T) {
+	// A message received twice keeps its ID and is not a duplicate.
+	groups := duplicateBodyGroups([]ReceivedMessage{
+		{ID: "1", Body: "same"},
+		{ID: "1", Body: "same"},
+		{ID: "2", Body: "other"},
+	})
+	assert.Empty(t, groups)
+}
@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// maxFanOutQueues bounds how many queues one fan-out send may target.
+	maxFanOutQueues = 50
+	// fanOutConcurrency is how many queues are sent to at the same time.
+	fanOutConcurrency = 5
+)
+
+// FanOutInput sends Copies copies of Message to every queue in QueueURLs. The QueueURL of the
+// message is ignored. Copies defaults to one.
+type FanOutInput struct {
+	QueueURLs []string
+	Message   SendMessageInput
+	Copies    int
+}
+
+// FanOutQueueResult is the outcome for one queue. Error is set when nothing could be sent to it,
+// for example because a FIFO queue needs a message group ID.
+type FanOutQueueResult struct {
+	QueueURL string
+	Sent     int
+	Failed   []BatchSendFailure
+	Error    string
+}
+
+// FanOutMessage delivers the same message to several queues in one action, using batch sends per
+// queue. A queue that fails does not stop the others. Copies sent to FIFO queues get their own
+// deduplication IDs, so SQS does not drop them as duplicates of each other.
+func (s *SqsServiceImpl) FanOutMessage(ctx context.Context, input FanOutInput) ([]FanOutQueueResult, error) {
+	unique := make([]string, 0, len(input.QueueURLs))
+	seen := make(map[string]struct{}, len(input.QueueURLs))
+	for _, raw := range input.QueueURLs {
+		queueURL := strings.TrimSpace(raw)
+		if queueURL == "" {
+			continue
+		}
+		if _, ok := seen[queueURL]; ok {
+			continue
+		}
+		seen[queueURL] = struct{}{}
+		unique = append(unique, queueURL)
+	}
+	if len(unique) == 0 {
+		return nil, errors.New("at least one queue url is required")
+	}
+	if len(unique) > maxFanOutQueues {
+		return nil, errors.Newf("at most %d queues can be sent to at once", maxFanOutQueues)
+	}
+
+	copies := input.Copies
+	if copies == 0 {
+		copies = 1
+	}
+	if copies < 1 || copies > maxBatchMessages {
+		return nil, errors.Newf("copies must be between 1 and %d", maxBatchMessages)
+	}
+	if strings.TrimSpace(input.Message.Body) == "" {
+		return nil, errors.New("message body is required")
+	}
+
+	dedupID := strings.TrimSpace(input.Message.MessageDeduplicationID)
+	if dedupID == "" {
+		id, err := newRandomID()
+		if err != nil {
+			return nil, err
+		}
+		dedupID = id
+	}
+
+	results := make([]FanOutQueueResult, len(unique))
+	slots := make(chan struct{}, fanOutConcurrency)
+	var wg sync.WaitGroup
+	for i, queueURL := range unique {
+		wg.Add(1)
+		go func(i int, queueURL string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			messages := make([]SendMessageInput, copies)
+			for n := range messages {
+				message := input.Message
+				if strings.HasSuffix(queueURL, ".fifo") {
+					message.MessageDeduplicationID = dedupID
+					if copies > 1 {
+						message.MessageDeduplicationID += "-" + strconv.Itoa(n+1)
+					}
+				}
+				messages[n] = message
+			}
+
+			results[i] = FanOutQueueResult{QueueURL: queueURL, Failed: []BatchSendFailure{}}
+			sent, err := s.SendMessageBatch(ctx, SendMessageBatchInput{QueueURL: queueURL, Messages: messages})
+			if err != nil {
+				results[i].Error = err.Error()
+				return
+			}
+			results[i].Sent = sent.Sent
+			results[i].Failed = sent.Failed
+		}(i, queueURL)
+	}
+	wg.Wait()
+
+	return results, nil
+}
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+type fanOutMessageRequest struct {
+	QueueURLs []string           `json:"queueUrls"`
+	Message   sendMessageRequest `json:"message"`
+	Copies    int                `json:"copies"`
+}
+
+type fanOutQueueItem struct {
+	QueueURL  string                 `json:"queueUrl"`
+	QueueName string                 `json:"queueName"`
+	Sent      int                    `json:"sent"`
+	Failed    []batchSendFailureItem `json:"failed"`
+	Error     string                 `json:"error,omitempty"`
+}
+
+type fanOutMessageResponse struct {
+	Message string            `json:"message"`
+	Queues  []fanOutQueueItem `json:"queues"`
+}
+
+// FanOutMessageAPI sends the same message to every queue listed in the request body. Queues that
+// fail are reported per queue; the request itself succeeds.
+func (h *HandlerImpl) FanOutMessageAPI(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	var payload fanOutMessageRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	input := FanOutInput{
+		QueueURLs: payload.QueueURLs,
+		Copies:    payload.Copies,
+		Message: SendMessageInput{
+			Body:                   payload.Message.Body,
+			MessageGroupID:         payload.Message.MessageGroupID,
+			MessageDeduplicationID: payload.Message.MessageDeduplicationID,
+			DelaySeconds:           payload.Message.DelaySeconds,
+			Attributes:             convertPayloadAttributes(payload.Message.Attributes),
+		},
+	}
+
+	results, err := h.s.FanOutMessage(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to fan out message", slog.Int("queues", len(payload.QueueURLs)), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	sent, reached := 0, 0
+	response := fanOutMessageResponse{Queues: make([]fanOutQueueItem, 0, len(results))}
+	for _, result := range results {
+		item := fanOutQueueItem{
+			QueueURL:  result.QueueURL,
+			QueueName: extractQueueName(result.QueueURL),
+			Sent:      result.Sent,
+			Failed:    make([]batchSendFailureItem, 0, len(result.Failed)),
+			Error:     result.Error,
+		}
+		for _, failure := range result.Failed {
+			item.Failed = append(item.Failed, batchSendFailureItem{
+				Index:    failure.Index,
+				Code:     failure.Code,
+				Message:  failure.Message,
+				Attempts: failure.Attempts,
+			})
+		}
+		sent += result.Sent
+		if result.Sent > 0 {
+			reached++
+		}
+		response.Queues = append(response.Queues, item)
+	}
+	response.Message = fmt.Sprintf("Sent %d messages to %d of %d queues.", sent, reached, len(results))
+
+	writeJSON(w, http.StatusOK, response)
+}
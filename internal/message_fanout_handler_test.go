@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_FanOutMessageAPI(t *testing.T) {
+	t.Run("lists the outcome per queue", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		body := `{"queueUrls":["https://sqs.local/orders","https://sqs.local/orders.fifo"],"message":{"body":"seed","attributes":[{"name":"kind","value":"test"}]},"copies":3}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/messages/fan-out", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			FanOutMessage(mock.Anything, FanOutInput{
+				QueueURLs: []string{"https://sqs.local/orders", "https://sqs.local/orders.fifo"},
+				Message:   SendMessageInput{Body: "seed", Attributes: []MessageAttribute{{Name: "kind", Value: "test"}}},
+				Copies:    3,
+			}).
+			Return([]FanOutQueueResult{
+				{QueueURL: "https://sqs.local/orders", Sent: 2, Failed: []BatchSendFailure{{Index: 2, Code: "InternalError", Message: "try again", Attempts: 4}}},
+				{QueueURL: "https://sqs.local/orders.fifo", Failed: []BatchSendFailure{}, Error: "message group id is required for FIFO queues"},
+			}, nil).
+			Once()
+
+		handler.FanOutMessageAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"message": "Sent 2 messages to 1 of 2 queues.",
+			"queues": [
+				{"queueUrl":"https://sqs.local/orders","queueName":"orders","sent":2,"failed":[{"index":2,"code":"InternalError","message":"try again","attempts":4}]},
+				{"queueUrl":"https://sqs.local/orders.fifo","queueName":"orders.fifo","sent":0,"failed":[],"error":"message group id is required for FIFO queues"}
+			]
+		}`, rr.Body.String())
+	})
+
+	t.Run("reports validation errors", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/messages/fan-out", strings.NewReader(`{"queueUrls":[],"message":{"body":"seed"}}`))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			FanOutMessage(mock.Anything, mock.Anything).
+			Return(nil, assert.AnError).
+			Once()
+
+		handler.FanOutMessageAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
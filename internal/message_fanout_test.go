@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_FanOutMessage(t *testing.T) {
+	ctx := context.Background()
+	ordersURL := "https://sqs.local/000000000000/orders"
+	fifoURL := "https://sqs.local/000000000000/orders.fifo"
+	forQueue := func(queueURL string) any {
+		return mock.MatchedBy(func(input SendMessageBatchRepositoryInput) bool { return input.QueueURL == queueURL })
+	}
+
+	t.Run("sends copies to every queue once", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		var fifoEntries []SendMessageBatchEntry
+		repo.EXPECT().SendMessageBatch(mock.Anything, forQueue(ordersURL)).Return([]SendMessageBatchFailure{}, nil).Once()
+		repo.EXPECT().SendMessageBatch(mock.Anything, forQueue(fifoURL)).
+			Run(func(_ context.Context, input SendMessageBatchRepositoryInput) { fifoEntries = input.Entries }).
+			Return([]SendMessageBatchFailure{}, nil).
+			Once()
+
+		results, err := service.FanOutMessage(ctx, FanOutInput{
+			QueueURLs: []string{ordersURL, " " + fifoURL, ordersURL, ""},
+			Message:   SendMessageInput{Body: "seed", MessageGroupID: "tests", MessageDeduplicationID: "seed"},
+			Copies:    2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []FanOutQueueResult{
+			{QueueURL: ordersURL, Sent: 2, Failed: []BatchSendFailure{}},
+			{QueueURL: fifoURL, Sent: 2, Failed: []BatchSendFailure{}},
+		}, results)
+		require.Len(t, fifoEntries, 2)
+		assert.Equal(t, "seed-1", fifoEntries[0].MessageDeduplicationID)
+		assert.Equal(t, "seed-2", fifoEntries[1].MessageDeduplicationID)
+	})
+
+	t.Run("reports a failing queue without stopping the others", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().SendMessageBatch(mock.Anything, forQueue(ordersURL)).Return([]SendMessageBatchFailure{}, nil).Once()
+
+		results, err := service.FanOutMessage(ctx, FanOutInput{
+			QueueURLs: []string{fifoURL, ordersURL},
+			Message:   SendMessageInput{Body: "seed"},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.NotEmpty(t, results[0].Error)
+		assert.Equal(t, 0, results[0].Sent)
+		assert.Equal(t, FanOutQueueResult{QueueURL: ordersURL, Sent: 1, Failed: []BatchSendFailure{}}, results[1])
+	})
+
+	t.Run("validates the input", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.FanOutMessage(ctx, FanOutInput{QueueURLs: []string{" "}, Message: SendMessageInput{Body: "seed"}})
+		require.EqualError(t, err, "at least one queue url is required")
+
+		_, err = service.FanOutMessage(ctx, FanOutInput{QueueURLs: []string{ordersURL}, Message: SendMessageInput{Body: "seed"}, Copies: maxBatchMessages + 1})
+		require.EqualError(t, err, "copies must be between 1 and 100")
+
+		_, err = service.FanOutMessage(ctx, FanOutInput{QueueURLs: []string{ordersURL}})
+		require.EqualError(t, err, "message body is required")
+	})
+}
@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+)
+
+const (
+	// maxTrackedFieldValues caps how many distinct values are remembered per field.
+	maxTrackedFieldValues = 100
+	// maxReportedFields is how many of the most common keys a field report lists.
+	maxReportedFields = 50
+	// topFieldValues is how many of the most frequent scalar values are listed per field.
+	topFieldValues = 3
+)
+
+// MessageFieldReport summarises the top-level keys of JSON object bodies sampled from a queue.
+type MessageFieldReport struct {
+	QueueURL     string
+	Requested    int
+	Sampled      int
+	JSONMessages int
+	// Fields is ordered by how many messages contain the key, most common first.
+	Fields []FieldStats
+	// MoreFields counts keys left out of Fields.
+	MoreFields int
+}
+
+// FieldStats describes one top-level key across the sampled JSON bodies.
+type FieldStats struct {
+	Key      string
+	Messages int
+	Types    []string
+	// Distinct is the number of distinct values seen. DistinctCapped reports that
+	// tracking stopped at maxTrackedFieldValues, so the real number may be higher.
+	Distinct       int
+	DistinctCapped bool
+	TopValues      []FieldValueCount
+}
+
+// FieldValueCount is a scalar value, as compact JSON, and how often it was seen.
+type FieldValueCount struct {
+	Value string
+	Count int
+}
+
+type fieldAccumulator struct {
+	messages int
+	types    map[string]bool
+	values   map[string]int
+	capped   bool
+}
+
+// AnalyzeMessageFields samples up to samples messages from queueURL and reports which top-level
+// JSON keys their bodies use. Bodies that are not JSON objects are counted but otherwise skipped.
+func (s *SqsServiceImpl) AnalyzeMessageFields(ctx context.Context, queueURL string, samples int) (MessageFieldReport, error) {
+	messages, requested, err := s.sampleMessages(ctx, queueURL, samples)
+	if err != nil {
+		return MessageFieldReport{}, err
+	}
+
+	report := MessageFieldReport{QueueURL: queueURL, Requested: requested, Sampled: len(messages)}
+	fields := make(map[string]*fieldAccumulator)
+	for _, message := range messages {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(message.Body), &object); err != nil || object == nil {
+			continue
+		}
+		report.JSONMessages++
+
+		for key, raw := range object {
+			acc, ok := fields[key]
+			if !ok {
+				acc = &fieldAccumulator{types: make(map[string]bool), values: make(map[string]int)}
+				fields[key] = acc
+			}
+			acc.messages++
+			acc.types[jsonValueType(raw)] = true
+			acc.observe(raw)
+		}
+	}
+
+	for key, acc := range fields {
+		report.Fields = append(report.Fields, acc.stats(key))
+	}
+	slices.SortFunc(report.Fields, func(a, b FieldStats) int {
+		if a.Messages != b.Messages {
+			return b.Messages - a.Messages
+		}
+		return cmp.Compare(a.Key, b.Key)
+	})
+	if len(report.Fields) > maxReportedFields {
+		report.MoreFields = len(report.Fields) - maxReportedFields
+		report.Fields = report.Fields[:maxReportedFields]
+	}
+
+	return report, nil
+}
+
+// observe records raw as one value of the field, compacting it so formatting differences do not count as distinct.
+func (a *fieldAccumulator) observe(raw json.RawMessage) {
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, raw); err != nil {
+		return
+	}
+	value := compact.String()
+	if _, ok := a.values[value]; !ok && len(a.values) >= maxTrackedFieldValues {
+		a.capped = true
+		return
+	}
+	a.values[value]++
+}
+
+func (a *fieldAccumulator) stats(key string) FieldStats {
+	stats := FieldStats{
+		Key:            key,
+		Messages:       a.messages,
+		Distinct:       len(a.values),
+		DistinctCapped: a.capped,
+	}
+	for t := range a.types {
+		stats.Types = append(stats.Types, t)
+	}
+	slices.Sort(stats.Types)
+
+	for value, count := range a.values {
+		switch jsonValueType(json.RawMessage(value)) {
+		case "object", "array":
+			continue
+		}
+		stats.TopValues = append(stats.TopValues, FieldValueCount{Value: value, Count: count})
+	}
+	slices.SortFunc(stats.TopValues, func(x, y FieldValueCount) int {
+		if x.Count != y.Count {
+			return y.Count - x.Count
+		}
+		return cmp.Compare(x.Value, y.Value)
+	})
+	if len(stats.TopValues) > topFieldValues {
+		stats.TopValues = stats.TopValues[:topFieldValues]
+	}
+
+	return stats
+}
+
+// jsonValueType names the JSON type of raw from its first significant byte.
+func jsonValueType(raw json.RawMessage) string {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return "unknown"
+	}
+	switch trimmed[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	default:
+		return "number"
+	}
+}
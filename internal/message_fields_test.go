@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_AnalyzeMessageFields(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("reports keys, types and common values", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().
+			ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{QueueURL: queueURL, MaxMessages: 5, VisibilityTimeout: sampleVisibility}).
+			Return([]ReceivedMessage{
+				{ID: "1", Body: `{"type":"created","id":1,"items":[1]}`},
+				{ID: "2", Body: `{"type": "created", "id": 2}`},
+				{ID: "3", Body: `{"type":"deleted","id":"3","meta":{"a":1}}`},
+				{ID: "4", Body: `not json`},
+				{ID: "5", Body: `[1,2]`},
+			}, nil).
+			Once()
+		repo.EXPECT().ChangeMessageVisibility(mock.Anything, mock.Anything).Return(nil).Times(5)
+
+		report, err := service.AnalyzeMessageFields(ctx, queueURL, 5)
+		require.NoError(t, err)
+
+		assert.Equal(t, 5, report.Sampled)
+		assert.Equal(t, 3, report.JSONMessages)
+		assert.Equal(t, []FieldStats{
+			{
+				Key:       "id",
+				Messages:  3,
+				Types:     []string{"number", "string"},
+				Distinct:  3,
+				TopValues: []FieldValueCount{{Value: `"3"`, Count: 1}, {Value: "1", Count: 1}, {Value: "2", Count: 1}},
+			},
+			{
+				Key:       "type",
+				Messages:  3,
+				Types:     []string{"string"},
+				Distinct:  2,
+				TopValues: []FieldValueCount{{Value: `"created"`, Count: 2}, {Value: `"deleted"`, Count: 1}},
+			},
+			{Key: "items", Messages: 1, Types: []string{"array"}, Distinct: 1},
+			{Key: "meta", Messages: 1, Types: []string{"object"}, Distinct: 1},
+		}, report.Fields)
+	})
+
+	t.Run("caps tracked values", func(t *testing.T) {
+		acc := &fieldAccumulator{types: map[string]bool{}, values: map[string]int{}}
+		for i := range maxTrackedFieldValues + 1 {
+			acc.observe([]byte(fmt.Sprint(i)))
+		}
+		acc.observe([]byte("0"))
+
+		stats := acc.stats("n")
+		assert.Equal(t, maxTrackedFieldValues, stats.Distinct)
+		assert.True(t, stats.DistinctCapped)
+		assert.Equal(t, []FieldValueCount{{Value: "0", Count: 2}, {Value: "1", Count: 1}, {Value: "10", Count: 1}}, stats.TopValues)
+	})
+}
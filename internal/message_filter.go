@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// MessageFilter selects messages by their body. BodyPattern is a regular expression matched
+// against the whole body. JSONPath is a path such as $.order.items[0].sku into a JSON body; a
+// message matches when the path exists and, if JSONValue is set, the value there equals it.
+// Numbers, booleans and null compare by their JSON text. Every filter that is set must match.
+type MessageFilter struct {
+	BodyPattern string
+	JSONPath    string
+	JSONValue   string
+}
+
+// jsonPathStep is one step of a JSON path: an object field, or an array index when Field is empty.
+type jsonPathStep struct {
+	Field string
+	Index int
+}
+
+// compileMessageFilter validates filter and returns a predicate over message bodies.
+func compileMessageFilter(filter MessageFilter) (func(body string) bool, error) {
+	var pattern *regexp.Regexp
+	if filter.BodyPattern != "" {
+		compiled, err := regexp.Compile(filter.BodyPattern)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid body pattern")
+		}
+		pattern = compiled
+	}
+
+	var steps []jsonPathStep
+	if strings.TrimSpace(filter.JSONPath) != "" {
+		parsed, err := parseJSONPath(strings.TrimSpace(filter.JSONPath))
+		if err != nil {
+			return nil, err
+		}
+		steps = parsed
+	} else if filter.JSONValue != "" {
+		return nil, errors.New("a JSON value needs a JSON path")
+	}
+
+	if pattern == nil && steps == nil {
+		return nil, errors.New("a body pattern or a JSON path is required")
+	}
+
+	return func(body string) bool {
+		if pattern != nil && !pattern.MatchString(body) {
+			return false
+		}
+		if steps == nil {
+			return true
+		}
+		var document any
+		if err := json.Unmarshal([]byte(body), &document); err != nil {
+			return false
+		}
+		value, ok := lookupJSONPath(document, steps)
+		if !ok {
+			return false
+		}
+		return filter.JSONValue == "" || jsonValueText(value) == filter.JSONValue
+	}, nil
+}
+
+// maxJSONProjectionPaths bounds how many fields one poll can project out of each body.
+const maxJSONProjectionPaths = 20
+
+// compileJSONProjection validates paths and returns a function that picks their values out of a
+// JSON body as an indented object keyed by path, in the order of paths. Paths missing from the
+// body are left out, and a body that is not JSON or holds none of the paths projects to nil.
+func compileJSONProjection(paths []string) (func(body string) json.RawMessage, error) {
+	if len(paths) > maxJSONProjectionPaths {
+		return nil, errors.Newf("at most %d fields can be projected", maxJSONProjectionPaths)
+	}
+	names := make([]string, 0, len(paths))
+	steps := make([][]jsonPathStep, 0, len(paths))
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" || slices.Contains(names, path) {
+			continue
+		}
+		parsed, err := parseJSONPath(path)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, path)
+		steps = append(steps, parsed)
+	}
+	if len(names) == 0 {
+		return nil, errors.New("a JSON path to project is required")
+	}
+
+	return func(body string) json.RawMessage {
+		var document any
+		if err := json.Unmarshal([]byte(body), &document); err != nil {
+			return nil
+		}
+		var projection bytes.Buffer
+		for i, path := range names {
+			value, ok := lookupJSONPath(document, steps[i])
+			if !ok {
+				continue
+			}
+			key, _ := json.Marshal(path)
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				continue
+			}
+			if projection.Len() == 0 {
+				projection.WriteByte('{')
+			} else {
+				projection.WriteByte(',')
+			}
+			projection.Write(key)
+			projection.WriteByte(':')
+			projection.Write(encoded)
+		}
+		if projection.Len() == 0 {
+			return nil
+		}
+		projection.WriteByte('}')
+
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, projection.Bytes(), "", "  "); err != nil {
+			return nil
+		}
+		return indented.Bytes()
+	}, nil
+}
+
+// parseJSONPath accepts the dotted subset of JSONPath: $ followed by .field and [index] steps.
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, errors.Newf("JSON path %q must start with $", path)
+	}
+
+	steps := []jsonPathStep{}
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			field := rest[1 : end+1]
+			if field == "" {
+				return nil, errors.Newf("JSON path %q has an empty field name", path)
+			}
+			steps = append(steps, jsonPathStep{Field: field})
+			rest = rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, errors.Newf("JSON path %q has an unclosed [", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, errors.Newf("JSON path %q has an invalid array index", path)
+			}
+			steps = append(steps, jsonPathStep{Index: index})
+			rest = rest[end+1:]
+		default:
+			return nil, errors.Newf("JSON path %q is not of the form $.field[0].field", path)
+		}
+	}
+	return steps, nil
+}
+
+func lookupJSONPath(value any, steps []jsonPathStep) (any, bool) {
+	for _, step := range steps {
+		if step.Field != "" {
+			object, ok := value.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if value, ok = object[step.Field]; !ok {
+				return nil, false
+			}
+			continue
+		}
+		array, ok := value.([]any)
+		if !ok || step.Index >= len(array) {
+			return nil, false
+		}
+		value = array[step.Index]
+	}
+	return value, true
+}
+
+// jsonValueText renders strings as they are and everything else as JSON.
+func jsonValueText(value any) string {
+	if text, ok := value.(string); ok {
+		return text
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileMessageFilter(t *testing.T) {
+	body := `{"eventType":"OrderCreated","order":{"id":42,"test":true,"items":[{"sku":"A-1"}]}}`
+
+	tests := []struct {
+		name   string
+		filter MessageFilter
+		body   string
+		want   bool
+	}{
+		{name: "pattern matches", filter: MessageFilter{BodyPattern: `Order(Created|Updated)`}, body: body, want: true},
+		{name: "pattern does not match", filter: MessageFilter{BodyPattern: `^Order`}, body: body, want: false},
+		{name: "path exists", filter: MessageFilter{JSONPath: "$.order.items[0].sku"}, body: body, want: true},
+		{name: "path is missing", filter: MessageFilter{JSONPath: "$.order.items[1].sku"}, body: body, want: false},
+		{name: "string value", filter: MessageFilter{JSONPath: "$.eventType", JSONValue: "OrderCreated"}, body: body, want: true},
+		{name: "number value", filter: MessageFilter{JSONPath: "$.order.id", JSONValue: "42"}, body: body, want: true},
+		{name: "boolean value", filter: MessageFilter{JSONPath: "$.order.test", JSONValue: "false"}, body: body, want: false},
+		{name: "both must match", filter: MessageFilter{BodyPattern: "Order", JSONPath: "$.eventType", JSONValue: "OrderDeleted"}, body: body, want: false},
+		{name: "body is not json", filter: MessageFilter{JSONPath: "$.eventType"}, body: "plain text", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := compileMessageFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, matches(tt.body))
+		})
+	}
+
+	t.Run("rejects invalid filters", func(t *testing.T) {
+		_, err := compileMessageFilter(MessageFilter{})
+		require.EqualError(t, err, "a body pattern or a JSON path is required")
+
+		_, err = compileMessageFilter(MessageFilter{JSONValue: "x"})
+		require.EqualError(t, err, "a JSON value needs a JSON path")
+
+		_, err = compileMessageFilter(MessageFilter{BodyPattern: "("})
+		require.ErrorContains(t, err, "invalid body pattern")
+
+		_, err = compileMessageFilter(MessageFilter{JSONPath: "eventType"})
+		require.EqualError(t, err, `JSON path "eventType" must start with $`)
+
+		_, err = compileMessageFilter(MessageFilter{JSONPath: "$.items[x]"})
+		require.EqualError(t, err, `JSON path "$.items[x]" has an invalid array index`)
+	})
+}
+
+func TestCompileJSONProjection(t *testing.T) {
+	project, err := compileJSONProjection([]string{"$.order.id", "", "$.order.items[0]", "$.missing", "$.order.id"})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"$.order.id":42,"$.order.items[0]":{"sku":"A-1"}}`, string(project(`{"order":{"id":42,"items":[{"sku":"A-1"}]}}`)))
+	assert.Equal(t, "{\n  \"$.order.id\": 7\n}", string(project(`{"order":{"id":7}}`)))
+	assert.Nil(t, project(`{"other":true}`))
+	assert.Nil(t, project("plain text"))
+
+	t.Run("rejects invalid paths", func(t *testing.T) {
+		_, err := compileJSONProjection([]string{" "})
+		require.EqualError(t, err, "a JSON path to project is required")
+
+		_, err = compileJSONProjection([]string{"order.id"})
+		require.EqualError(t, err, `JSON path "order.id" must start with $`)
+
+		_, err = compileJSONProjection(make([]string, maxJSONProjectionPaths+1))
+		require.EqualError(t, err, "at most 20 fields can be projected")
+	})
+}
@@ -0,0 +1,285 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultForwardDuration    = 10 * time.Minute
+	maxForwardDuration        = 8 * time.Hour
+	defaultForwardMaxAttempts = 3
+	maxForwardMaxAttempts     = 10
+	// forwardRequestTimeout bounds one POST to the target.
+	forwardRequestTimeout = 10 * time.Second
+	// forwardReceiveWait keeps each receive short so the forwarder stops close to its end.
+	forwardReceiveWait int32 = 1
+	// forwardBatch is received at a time; messages are forwarded one after the other.
+	forwardBatch int32 = 5
+	// maxForwardFailureDetails is how many failed messages a forwarder lists.
+	maxForwardFailureDetails = 20
+	// maxForwardResponseBytes is how much of a failed response is quoted in the job details.
+	maxForwardResponseBytes = 200
+)
+
+// ForwardMessagesInput configures a forwarder. Each message is POSTed to TargetURL up to
+// MaxAttempts times. A message that still fails is sent to DeadLetterQueueURL and deleted when one
+// is given, and otherwise left in the queue so its own redrive policy applies. Zero values pick the
+// defaults.
+type ForwardMessagesInput struct {
+	QueueURL           string
+	TargetURL          string
+	Duration           time.Duration
+	MaxAttempts        int
+	DeadLetterQueueURL string
+}
+
+// forwardOutcome is what became of one forwarded message.
+type forwardOutcome int
+
+const (
+	forwardDelivered forwardOutcome = iota
+	forwardDeadLettered
+	forwardLeft
+)
+
+// StartForwarder polls a queue in the background and POSTs every message to an HTTP endpoint, so
+// a local service can be fed from a real queue without writing a consumer. The body is sent as is;
+// custom message attributes become request headers next to X-Sqs-Message-Id, X-Sqs-Queue-Name and
+// X-Sqs-Receive-Count. A 2xx answer deletes the message; anything else is retried with a doubling
+// backoff.
+func (s *SqsServiceImpl) StartForwarder(ctx context.Context, input ForwardMessagesInput) (Job, error) {
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return Job{}, errors.New("queue url is required")
+	}
+	targetURL := strings.TrimSpace(input.TargetURL)
+	if err := validateForwardTarget(targetURL); err != nil {
+		return Job{}, err
+	}
+	duration := input.Duration
+	if duration == 0 {
+		duration = defaultForwardDuration
+	}
+	if duration < time.Second || duration > maxForwardDuration {
+		return Job{}, errors.Newf("duration must be between 1s and %s", maxForwardDuration)
+	}
+	maxAttempts := input.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = defaultForwardMaxAttempts
+	}
+	if maxAttempts < 1 || maxAttempts > maxForwardMaxAttempts {
+		return Job{}, errors.Newf("attempts must be between 1 and %d", maxForwardMaxAttempts)
+	}
+	deadLetterURL := strings.TrimSpace(input.DeadLetterQueueURL)
+	if deadLetterURL == queueURL {
+		return Job{}, errors.New("the dead-letter queue must differ from the forwarded queue")
+	}
+	if deadLetterURL != "" && strings.HasSuffix(deadLetterURL, ".fifo") != strings.HasSuffix(queueURL, ".fifo") {
+		return Job{}, errors.New("the dead-letter queue must be FIFO exactly when the forwarded queue is")
+	}
+
+	forwarder := &messageForwarder{
+		service:       s,
+		client:        &http.Client{Timeout: forwardRequestTimeout},
+		queueURL:      queueURL,
+		queueName:     extractQueueName(queueURL),
+		targetURL:     targetURL,
+		maxAttempts:   maxAttempts,
+		deadLetterURL: deadLetterURL,
+	}
+	return s.jobs.start(ctx, "forward", queueURL, func(ctx context.Context, progress *JobProgress) error {
+		return forwarder.run(ctx, s.now().Add(duration), progress)
+	})
+}
+
+func validateForwardTarget(target string) error {
+	if target == "" {
+		return errors.New("target url is required")
+	}
+	parsed, err := url.Parse(target)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("target url must be an absolute http or https URL")
+	}
+	return nil
+}
+
+// messageForwarder holds the settings of one forwarder job.
+type messageForwarder struct {
+	service       *SqsServiceImpl
+	client        *http.Client
+	queueURL      string
+	queueName     string
+	targetURL     string
+	maxAttempts   int
+	deadLetterURL string
+}
+
+func (f *messageForwarder) run(ctx context.Context, deadline time.Time, progress *JobProgress) error {
+	var delivered, deadLettered, left, failures int
+	summary := func() string {
+		message := fmt.Sprintf("Forwarded %d messages to %s.", delivered, f.targetURL)
+		if deadLettered > 0 {
+			message += fmt.Sprintf(" %d failed and went to the dead-letter queue.", deadLettered)
+		}
+		if left > 0 {
+			message += fmt.Sprintf(" %d failed and were left in the queue.", left)
+		}
+		return message
+	}
+	visibility := f.attemptsVisibility()
+
+	for f.service.now().Before(deadline) {
+		progress.SetMessage(summary())
+		messages, err := f.service.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+			QueueURL:          f.queueURL,
+			MaxMessages:       forwardBatch,
+			WaitTimeSeconds:   forwardReceiveWait,
+			VisibilityTimeout: visibility,
+		})
+		if err != nil {
+			return errors.Wrap(err, summary())
+		}
+
+		for _, message := range messages {
+			outcome, reason, err := f.forward(ctx, message)
+			if err != nil {
+				return errors.Wrap(err, summary())
+			}
+			switch outcome {
+			case forwardDelivered:
+				delivered++
+			case forwardDeadLettered:
+				deadLettered++
+			case forwardLeft:
+				left++
+			}
+			if outcome != forwardDelivered {
+				failures++
+				if failures <= maxForwardFailureDetails {
+					progress.AddDetail(fmt.Sprintf("%s: %s", message.ID, reason))
+				}
+			}
+			progress.Advance(1)
+		}
+	}
+
+	progress.SetMessage(summary())
+	return nil
+}
+
+// attemptsVisibility is long enough for every message of a batch to have each attempt time out and
+// each backoff pass, one message after the other, so the last message of a batch is not received
+// again while the earlier ones are still being forwarded.
+func (f *messageForwarder) attemptsVisibility() int32 {
+	perMessage := time.Duration(f.maxAttempts)*forwardRequestTimeout + f.backoff(f.maxAttempts)
+	return int32(time.Duration(forwardBatch)*perMessage/time.Second) + 30
+}
+
+// backoff is the total time waited before attempt.
+func (f *messageForwarder) backoff(attempt int) time.Duration {
+	var total time.Duration
+	delay := f.service.forwardRetryDelay
+	for range attempt - 1 {
+		total += delay
+		delay *= 2
+	}
+	return total
+}
+
+// forward POSTs one message until the target accepts it or the attempts run out, then deletes it
+// or hands it to the dead-letter queue. The returned reason describes the last failure.
+func (f *messageForwarder) forward(ctx context.Context, message ReceivedMessage) (forwardOutcome, string, error) {
+	var reason string
+	delay := f.service.forwardRetryDelay
+	for attempt := 1; attempt <= f.maxAttempts; attempt++ {
+		if attempt > 1 {
+			if err := waitFor(ctx, delay); err != nil {
+				return forwardLeft, reason, err
+			}
+			delay *= 2
+		}
+		reason = f.post(ctx, message)
+		if reason == "" {
+			return forwardDelivered, "", f.delete(ctx, message)
+		}
+	}
+
+	reason = fmt.Sprintf("%s after %d attempts", reason, f.maxAttempts)
+	if f.deadLetterURL == "" {
+		// The message becomes visible again once its visibility timeout ends.
+		return forwardLeft, reason, nil
+	}
+
+	send := SendMessageRepositoryInput{
+		QueueURL:   f.deadLetterURL,
+		Body:       message.Body,
+		Attributes: customMessageAttributes(message),
+	}
+	if strings.HasSuffix(f.deadLetterURL, ".fifo") {
+		send.MessageGroupID = messageAttributeValue(message, "MessageGroupId")
+		send.MessageDeduplicationID = message.ID
+	}
+	if err := f.service.repo.SendMessage(ctx, send); err != nil {
+		return forwardLeft, reason, errors.Wrapf(err, "failed to move message %s to the dead-letter queue", message.ID)
+	}
+	if err := f.delete(ctx, message); err != nil {
+		return forwardDeadLettered, reason, errors.Wrapf(err, "message %s was sent to the dead-letter queue but could not be deleted", message.ID)
+	}
+	return forwardDeadLettered, reason, nil
+}
+
+func (f *messageForwarder) delete(ctx context.Context, message ReceivedMessage) error {
+	return f.service.repo.DeleteMessage(ctx, DeleteMessageRepositoryInput{QueueURL: f.queueURL, ReceiptHandle: message.ReceiptHandle})
+}
+
+// post sends one attempt and returns why it failed, or "" when the target answered 2xx.
+func (f *messageForwarder) post(ctx context.Context, message ReceivedMessage) string {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, f.targetURL, strings.NewReader(message.Body))
+	if err != nil {
+		return err.Error()
+	}
+	for name, value := range customMessageAttributes(message) {
+		if ingestSkippedHeaders[http.CanonicalHeaderKey(name)] {
+			continue
+		}
+		request.Header.Set(name, value)
+	}
+	if request.Header.Get("Content-Type") == "" {
+		contentType := "text/plain; charset=utf-8"
+		if json.Valid([]byte(message.Body)) {
+			contentType = "application/json"
+		}
+		request.Header.Set("Content-Type", contentType)
+	}
+	request.Header.Set("X-Sqs-Message-Id", message.ID)
+	request.Header.Set("X-Sqs-Queue-Name", f.queueName)
+	request.Header.Set("X-Sqs-Receive-Count", strconv.Itoa(int(message.ReceiveCount)))
+
+	response, err := f.client.Do(request)
+	if err != nil {
+		return err.Error()
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, response.Body)
+		return ""
+	}
+	body, _ := io.ReadAll(io.LimitReader(response.Body, maxForwardResponseBytes))
+	reason := response.Status
+	if text := strings.TrimSpace(string(bytes.ToValidUTF8(body, nil))); text != "" {
+		reason += ": " + text
+	}
+	return reason
+}
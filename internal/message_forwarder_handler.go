@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+type forwarderPageData struct {
+	Title           string
+	ViteTags        template.HTML
+	ErrorMessage    string
+	QueueName       string
+	EscapedURL      string
+	TargetURL       string
+	Duration        string
+	MaxAttempts     string
+	DeadLetterQueue string
+	JobID           string
+}
+
+// ForwarderHandler renders the form that starts forwarding a queue to an HTTP endpoint.
+func (h *HandlerImpl) ForwarderHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	h.renderForwarder(w, http.StatusOK, newForwarderPageData(queueURL))
+}
+
+// PostForwarderHandler starts a forwarder. The page then follows its job.
+func (h *HandlerImpl) PostForwarderHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	data := newForwarderPageData(queueURL)
+	data.TargetURL = strings.TrimSpace(r.FormValue("target_url"))
+	data.Duration = strings.TrimSpace(r.FormValue("duration"))
+	data.MaxAttempts = strings.TrimSpace(r.FormValue("max_attempts"))
+	data.DeadLetterQueue = strings.TrimSpace(r.FormValue("dead_letter_queue_url"))
+
+	input, err := parseForwarderForm(queueURL, data)
+	if err != nil {
+		data.ErrorMessage = err.Error()
+		h.renderForwarder(w, http.StatusBadRequest, data)
+		return
+	}
+
+	job, err := h.s.StartForwarder(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start forwarder", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderForwarder(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.JobID = job.ID
+	h.renderForwarder(w, http.StatusOK, data)
+}
+
+func parseForwarderForm(queueURL string, data forwarderPageData) (ForwardMessagesInput, error) {
+	input := ForwardMessagesInput{QueueURL: queueURL, TargetURL: data.TargetURL, DeadLetterQueueURL: data.DeadLetterQueue}
+	if data.Duration != "" {
+		duration, err := time.ParseDuration(data.Duration)
+		if err != nil {
+			return input, errors.New("duration must look like 30s, 5m or 1h")
+		}
+		input.Duration = duration
+	}
+	if data.MaxAttempts != "" {
+		attempts, err := strconv.Atoi(data.MaxAttempts)
+		if err != nil {
+			return input, errors.New("attempts must be a whole number")
+		}
+		input.MaxAttempts = attempts
+	}
+	return input, nil
+}
+
+func newForwarderPageData(queueURL string) forwarderPageData {
+	return forwarderPageData{
+		Title:       "Forward messages",
+		ViteTags:    fragments["assets/js/message_forwarder.ts"].Tags,
+		QueueName:   extractQueueName(queueURL),
+		EscapedURL:  url.QueryEscape(queueURL),
+		Duration:    defaultForwardDuration.String(),
+		MaxAttempts: strconv.Itoa(defaultForwardMaxAttempts),
+	}
+}
+
+func (h *HandlerImpl) renderForwarder(w http.ResponseWriter, status int, data forwarderPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["forward"].Execute(w, data); err != nil {
+		slog.Error("failed to render forward template", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_PostForwarderHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/forward", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("starts the forwarder and follows its job", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured forwarderPageData
+		captureTemplate(t, "forward", func(data forwarderPageData) { captured = data })
+		installFragment(t, "assets/js/message_forwarder.ts", "")
+
+		mockService.EXPECT().
+			StartForwarder(mock.Anything, ForwardMessagesInput{
+				QueueURL:           queueURL,
+				TargetURL:          "http://localhost:8080/events",
+				Duration:           time.Hour,
+				MaxAttempts:        5,
+				DeadLetterQueueURL: "https://sqs.local/orders-dlq",
+			}).
+			Return(Job{ID: "job-1"}, nil).
+			Once()
+
+		handler.PostForwarderHandler(rr, newRequest(url.Values{
+			"target_url":            {"http://localhost:8080/events"},
+			"duration":              {"1h"},
+			"max_attempts":          {"5"},
+			"dead_letter_queue_url": {"https://sqs.local/orders-dlq"},
+		}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "job-1", captured.JobID)
+		assert.Equal(t, "orders", captured.QueueName)
+	})
+
+	t.Run("keeps the form values on invalid input", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured forwarderPageData
+		captureTemplate(t, "forward", func(data forwarderPageData) { captured = data })
+		installFragment(t, "assets/js/message_forwarder.ts", "")
+
+		handler.PostForwarderHandler(rr, newRequest(url.Values{"target_url": {"http://localhost"}, "max_attempts": {"many"}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "attempts must be a whole number", captured.ErrorMessage)
+		assert.Equal(t, "http://localhost", captured.TargetURL)
+		assert.Empty(t, captured.JobID)
+	})
+}
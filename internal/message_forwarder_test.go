@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_StartForwarder(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+
+	// newService returns a service whose clock passes the end of the forwarder once the first
+	// batch has been received.
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository, func()) {
+		repo := NewMockSqsRepository(t)
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		service := &SqsServiceImpl{repo: repo, jobs: newJobRegistry(), clock: func() time.Time { return now }}
+		return service, repo, func() { now = now.Add(time.Hour) }
+	}
+
+	t.Run("posts each message with its attributes and deletes it", func(t *testing.T) {
+		service, repo, finish := newService(t)
+
+		var mu sync.Mutex
+		var bodies []string
+		var headers http.Header
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			defer mu.Unlock()
+			bodies = append(bodies, string(body))
+			headers = r.Header.Clone()
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer target.Close()
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       forwardBatch,
+			WaitTimeSeconds:   forwardReceiveWait,
+			VisibilityTimeout: 180,
+		}).
+			Run(func(context.Context, ReceiveMessagesRepositoryInput) { finish() }).
+			Return([]ReceivedMessage{{
+				ID:            "m-1",
+				Body:          `{"id":1}`,
+				ReceiptHandle: "r-1",
+				ReceiveCount:  2,
+				Attributes: []MessageAttribute{
+					{Name: "tenant", Value: "acme"},
+					{Name: "SentTimestamp", Value: "1714564800000"},
+				},
+			}}, nil).
+			Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-1"}).Return(nil).Once()
+
+		job, err := service.StartForwarder(ctx, ForwardMessagesInput{QueueURL: queueURL, TargetURL: target.URL})
+		require.NoError(t, err)
+		assert.Equal(t, "forward", job.Kind)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(1), job.Done)
+		assert.Equal(t, "Forwarded 1 messages to "+target.URL+".", job.Message)
+
+		assert.Equal(t, []string{`{"id":1}`}, bodies)
+		assert.Equal(t, "application/json", headers.Get("Content-Type"))
+		assert.Equal(t, "acme", headers.Get("Tenant"))
+		assert.Empty(t, headers.Get("SentTimestamp"))
+		assert.Equal(t, "m-1", headers.Get("X-Sqs-Message-Id"))
+		assert.Equal(t, "orders", headers.Get("X-Sqs-Queue-Name"))
+		assert.Equal(t, "2", headers.Get("X-Sqs-Receive-Count"))
+	})
+
+	t.Run("retries and moves a failing message to the dead-letter queue", func(t *testing.T) {
+		service, repo, finish := newService(t)
+		deadLetterURL := "https://sqs.local/000000000000/orders-dlq"
+
+		attempts := 0
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			attempts++
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}))
+		defer target.Close()
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).
+			Run(func(context.Context, ReceiveMessagesRepositoryInput) { finish() }).
+			Return([]ReceivedMessage{{
+				ID:            "m-1",
+				Body:          "hello",
+				ReceiptHandle: "r-1",
+				Attributes:    []MessageAttribute{{Name: "tenant", Value: "acme"}},
+			}}, nil).
+			Once()
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{
+			QueueURL:   deadLetterURL,
+			Body:       "hello",
+			Attributes: map[string]string{"tenant": "acme"},
+		}).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-1"}).Return(nil).Once()
+
+		job, err := service.StartForwarder(ctx, ForwardMessagesInput{
+			QueueURL:           queueURL,
+			TargetURL:          target.URL,
+			MaxAttempts:        2,
+			DeadLetterQueueURL: deadLetterURL,
+		})
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, 2, attempts)
+		assert.Equal(t, "Forwarded 0 messages to "+target.URL+". 1 failed and went to the dead-letter queue.", job.Message)
+		assert.Equal(t, []string{"m-1: 500 Internal Server Error: boom after 2 attempts"}, job.Details)
+	})
+
+	t.Run("leaves a failing message in the queue without a dead-letter queue", func(t *testing.T) {
+		service, repo, finish := newService(t)
+
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer target.Close()
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).
+			Run(func(context.Context, ReceiveMessagesRepositoryInput) { finish() }).
+			Return([]ReceivedMessage{{ID: "m-1", Body: "hello", ReceiptHandle: "r-1"}}, nil).
+			Once()
+
+		job, err := service.StartForwarder(ctx, ForwardMessagesInput{QueueURL: queueURL, TargetURL: target.URL, MaxAttempts: 1})
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Forwarded 0 messages to "+target.URL+". 1 failed and were left in the queue.", job.Message)
+		assert.Equal(t, []string{"m-1: 502 Bad Gateway after 1 attempts"}, job.Details)
+	})
+
+	t.Run("validates the input", func(t *testing.T) {
+		service, _, _ := newService(t)
+
+		_, err := service.StartForwarder(ctx, ForwardMessagesInput{QueueURL: queueURL})
+		require.EqualError(t, err, "target url is required")
+
+		_, err = service.StartForwarder(ctx, ForwardMessagesInput{QueueURL: queueURL, TargetURL: "localhost:8080"})
+		require.EqualError(t, err, "target url must be an absolute http or https URL")
+
+		_, err = service.StartForwarder(ctx, ForwardMessagesInput{QueueURL: queueURL, TargetURL: "http://localhost", MaxAttempts: 11})
+		require.EqualError(t, err, "attempts must be between 1 and 10")
+
+		_, err = service.StartForwarder(ctx, ForwardMessagesInput{QueueURL: queueURL, TargetURL: "http://localhost", Duration: 9 * time.Hour})
+		require.EqualError(t, err, "duration must be between 1s and 8h0m0s")
+
+		_, err = service.StartForwarder(ctx, ForwardMessagesInput{QueueURL: queueURL, TargetURL: "http://localhost", DeadLetterQueueURL: queueURL + "-dlq.fifo"})
+		require.EqualError(t, err, "the dead-letter queue must be FIFO exactly when the forwarded queue is")
+	})
+}
+
+func TestMessageForwarder_attemptsVisibility(t *testing.T) {
+	forwarder := &messageForwarder{service: &SqsServiceImpl{forwardRetryDelay: time.Second}, maxAttempts: maxForwardMaxAttempts}
+
+	// Each message may take ten 10s attempts and 1+2+...+256s of backoff, and a batch holds five.
+	assert.Equal(t, int32(5*(100+511)+30), forwarder.attemptsVisibility())
+	assert.LessOrEqual(t, forwarder.attemptsVisibility(), int32(43200), "SQS caps the visibility timeout at 12 hours")
+}
@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// MessageGroupStatus describes one FIFO message group seen in a sample. The head is the message
+// with the lowest sequence number, the one that blocks the rest of the group while it is in flight.
+type MessageGroupStatus struct {
+	GroupID             string
+	Sampled             int
+	HeadMessageID       string
+	HeadReceiveCount    int32
+	HeadSentAt          time.Time
+	HeadFirstReceivedAt time.Time
+	// Retried is set when the head was received before the sample and came back without being
+	// deleted, so a consumer is likely failing on it and holding up its successors.
+	Retried bool
+}
+
+// MessageGroupReport shows which FIFO message groups can be received right now. A group with a
+// message in flight returns nothing until that message is deleted or becomes visible again, so
+// such groups are missing from Groups; MessagesInFlight tells how many messages hold them.
+type MessageGroupReport struct {
+	QueueURL         string
+	Requested        int
+	Sampled          int
+	MessagesInFlight int64
+	// Groups lists retried heads first, then the groups with the most sampled messages.
+	Groups []MessageGroupStatus
+}
+
+// AnalyzeMessageGroups samples a FIFO queue and reports the message groups it returned with the state
+// of their head message. Sampled messages are made visible again once the sample is taken, but the
+// groups are blocked for consumers in the meantime.
+func (s *SqsServiceImpl) AnalyzeMessageGroups(ctx context.Context, queueURL string, samples int) (MessageGroupReport, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if !strings.HasSuffix(queueURL, ".fifo") {
+		return MessageGroupReport{}, errors.New("message groups are only available for fifo queues")
+	}
+
+	stats, err := s.repo.GetQueueStats(ctx, queueURL)
+	if err != nil {
+		return MessageGroupReport{}, err
+	}
+
+	// Sampled messages stay hidden until the sample is taken, so each receive moves on to other groups.
+	messages, requested, err := s.sampleMessages(ctx, queueURL, samples)
+	if err != nil {
+		return MessageGroupReport{}, err
+	}
+
+	report := MessageGroupReport{
+		QueueURL:         queueURL,
+		Requested:        requested,
+		Sampled:          len(messages),
+		MessagesInFlight: stats.MessagesInFlight,
+	}
+	for _, group := range groupMessagesBySequence(messages) {
+		head := group.Messages[0]
+		report.Groups = append(report.Groups, MessageGroupStatus{
+			GroupID:             group.GroupID,
+			Sampled:             len(group.Messages),
+			HeadMessageID:       head.ID,
+			HeadReceiveCount:    head.ReceiveCount,
+			HeadSentAt:          messageSentAt(head),
+			HeadFirstReceivedAt: messageTimestamp(head, "ApproximateFirstReceiveTimestamp"),
+			// The sample's own receive counts once.
+			Retried: head.ReceiveCount > 1,
+		})
+	}
+	slices.SortStableFunc(report.Groups, func(a, b MessageGroupStatus) int {
+		if a.Retried != b.Retried {
+			if a.Retried {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(b.Sampled, a.Sampled)
+	})
+	return report, nil
+}
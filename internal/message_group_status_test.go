@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_AnalyzeMessageGroups(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders.fifo"
+
+	t.Run("reports each group head and makes the sample visible again", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		message := func(id, group, sequence string, receives int32) ReceivedMessage {
+			return ReceivedMessage{ID: id, ReceiptHandle: "r-" + id, ReceiveCount: receives, Attributes: []MessageAttribute{
+				{Name: "MessageGroupId", Value: group},
+				{Name: "SequenceNumber", Value: sequence},
+			}}
+		}
+
+		repo.EXPECT().GetQueueStats(mock.Anything, queueURL).Return(QueueStats{MessagesAvailable: 3, MessagesInFlight: 2}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       10,
+			VisibilityTimeout: sampleVisibility,
+		}).Return([]ReceivedMessage{
+			message("a-2", "a", "2", 1),
+			message("a-1", "a", "1", 1),
+			message("b-1", "b", "3", 4),
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return(nil, nil).Times(maxIdleSampleBatches)
+		for _, id := range []string{"a-1", "a-2", "b-1"} {
+			repo.EXPECT().ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-" + id}).Return(nil).Once()
+		}
+
+		report, err := service.AnalyzeMessageGroups(ctx, queueURL, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.Sampled)
+		assert.Equal(t, int64(2), report.MessagesInFlight)
+		assert.Equal(t, []MessageGroupStatus{
+			{GroupID: "b", Sampled: 1, HeadMessageID: "b-1", HeadReceiveCount: 4, Retried: true},
+			{GroupID: "a", Sampled: 2, HeadMessageID: "a-1", HeadReceiveCount: 1},
+		}, report.Groups)
+	})
+
+	t.Run("only samples fifo queues", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.AnalyzeMessageGroups(ctx, "https://sqs.local/orders", 10)
+		assert.EqualError(t, err, "message groups are only available for fifo queues")
+	})
+}
@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Statuses of a message move task as SQS reports them.
+const (
+	MessageMoveTaskRunning    = "RUNNING"
+	MessageMoveTaskCompleted  = "COMPLETED"
+	MessageMoveTaskCancelling = "CANCELLING"
+	MessageMoveTaskCancelled  = "CANCELLED"
+	MessageMoveTaskFailed     = "FAILED"
+)
+
+// MessageMoveTask is a redrive of a dead-letter queue started with StartMessageMoveTask, from the
+// console or elsewhere. MessagesToMove is the approximate number of messages the task set out to
+// move and is zero when SQS does not know it yet. DestinationArn is empty when the messages go back
+// to their source queues.
+type MessageMoveTask struct {
+	TaskHandle           string
+	Status               string
+	SourceArn            string
+	DestinationArn       string
+	MaxMessagesPerSecond int32
+	MessagesMoved        int64
+	MessagesToMove       int64
+	FailureReason        string
+	StartedAt            time.Time
+}
+
+// MessageMoveTasks lists the recent message move tasks of a dead-letter queue, newest first, so a
+// running redrive can be followed.
+func (s *SqsServiceImpl) MessageMoveTasks(ctx context.Context, queueURL string) ([]MessageMoveTask, error) {
+	if strings.TrimSpace(queueURL) == "" {
+		return nil, errors.New("queue url is required")
+	}
+	attributes, err := s.repo.GetQueueAttributes(ctx, queueURL, []string{"QueueArn"})
+	if err != nil {
+		return nil, err
+	}
+	arn := attributes["QueueArn"]
+	if arn == "" {
+		return nil, errors.Newf("queue %s has no ARN", extractQueueName(queueURL))
+	}
+	return s.repo.ListMessageMoveTasks(ctx, arn)
+}
+
+// CancelMessageMoveTask stops a running message move task of the dead-letter queue and returns the
+// approximate number of messages it had moved. Only a task that is listed as running for that
+// queue can be cancelled, so a handle cannot reach the tasks of a queue the caller cannot see.
+func (s *SqsServiceImpl) CancelMessageMoveTask(ctx context.Context, queueURL, taskHandle string) (int64, error) {
+	taskHandle = strings.TrimSpace(taskHandle)
+	if taskHandle == "" {
+		return 0, errors.New("task handle is required")
+	}
+	tasks, err := s.MessageMoveTasks(ctx, queueURL)
+	if err != nil {
+		return 0, err
+	}
+	for _, task := range tasks {
+		if task.TaskHandle != taskHandle {
+			continue
+		}
+		if task.Status != MessageMoveTaskRunning {
+			return 0, errors.Newf("the task is %s and can no longer be cancelled", strings.ToLower(task.Status))
+		}
+		return s.repo.CancelMessageMoveTask(ctx, taskHandle)
+	}
+	return 0, errors.New("the task is not a recent move task of this queue")
+}
@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type messageMoveTaskItem struct {
+	TaskHandle           string `json:"taskHandle"`
+	Status               string `json:"status"`
+	SourceArn            string `json:"sourceArn"`
+	DestinationArn       string `json:"destinationArn,omitempty"`
+	DestinationName      string `json:"destinationName,omitempty"`
+	MaxMessagesPerSecond int32  `json:"maxMessagesPerSecond,omitempty"`
+	MessagesMoved        int64  `json:"messagesMoved"`
+	MessagesToMove       int64  `json:"messagesToMove"`
+	FailureReason        string `json:"failureReason,omitempty"`
+	StartedAt            string `json:"startedAt,omitempty"`
+}
+
+type messageMoveTasksResponse struct {
+	Tasks []messageMoveTaskItem `json:"tasks"`
+}
+
+type cancelMessageMoveTaskRequest struct {
+	TaskHandle string `json:"taskHandle"`
+}
+
+type cancelMessageMoveTaskResponse struct {
+	Message       string `json:"message"`
+	MessagesMoved int64  `json:"messagesMoved"`
+}
+
+// MessageMoveTasksAPI lists the recent redrives of the dead-letter queue in the path with their
+// progress.
+func (h *HandlerImpl) MessageMoveTasksAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	tasks, err := h.s.MessageMoveTasks(r.Context(), queueURL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list message move tasks", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	response := messageMoveTasksResponse{Tasks: make([]messageMoveTaskItem, 0, len(tasks))}
+	for _, task := range tasks {
+		item := messageMoveTaskItem{
+			TaskHandle:           task.TaskHandle,
+			Status:               task.Status,
+			SourceArn:            task.SourceArn,
+			DestinationArn:       task.DestinationArn,
+			MaxMessagesPerSecond: task.MaxMessagesPerSecond,
+			MessagesMoved:        task.MessagesMoved,
+			MessagesToMove:       task.MessagesToMove,
+			FailureReason:        task.FailureReason,
+		}
+		if task.DestinationArn != "" {
+			if name, err := queueNameFromArn(task.DestinationArn); err == nil {
+				item.DestinationName = name
+			}
+		}
+		if !task.StartedAt.IsZero() {
+			item.StartedAt = task.StartedAt.UTC().Format(time.RFC3339)
+		}
+		response.Tasks = append(response.Tasks, item)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// CancelMessageMoveTaskAPI cancels a running redrive of the dead-letter queue in the path. Messages
+// already moved stay where they were moved to.
+func (h *HandlerImpl) CancelMessageMoveTaskAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	var payload cancelMessageMoveTaskRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	moved, err := h.s.CancelMessageMoveTask(r.Context(), queueURL, payload.TaskHandle)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to cancel message move task", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, cancelMessageMoveTaskResponse{
+		Message:       fmt.Sprintf("Cancelling the redrive after about %d messages were moved.", moved),
+		MessagesMoved: moved,
+	})
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_MessageMoveTasksAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders-dlq"
+
+	t.Run("lists the tasks with their progress", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/queues/{url}/move-tasks", nil)
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			MessageMoveTasks(mock.Anything, queueURL).
+			Return([]MessageMoveTask{
+				{
+					TaskHandle:     "handle-1",
+					Status:         MessageMoveTaskRunning,
+					SourceArn:      "arn:aws:sqs:us-east-1:000000000000:orders-dlq",
+					DestinationArn: "arn:aws:sqs:us-east-1:000000000000:orders",
+					MessagesMoved:  40,
+					MessagesToMove: 100,
+					StartedAt:      time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+				},
+				{Status: MessageMoveTaskCancelled, SourceArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", MessagesMoved: 3},
+			}, nil).
+			Once()
+
+		handler.MessageMoveTasksAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"tasks":[
+			{
+				"taskHandle":"handle-1",
+				"status":"RUNNING",
+				"sourceArn":"arn:aws:sqs:us-east-1:000000000000:orders-dlq",
+				"destinationArn":"arn:aws:sqs:us-east-1:000000000000:orders",
+				"destinationName":"orders",
+				"messagesMoved":40,
+				"messagesToMove":100,
+				"startedAt":"2024-05-01T12:00:00Z"
+			},
+			{"taskHandle":"","status":"CANCELLED","sourceArn":"arn:aws:sqs:us-east-1:000000000000:orders-dlq","messagesMoved":3,"messagesToMove":0}
+		]}`, rr.Body.String())
+	})
+
+	t.Run("reports hidden queues as forbidden", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/queues/{url}/move-tasks", nil)
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			MessageMoveTasks(mock.Anything, queueURL).
+			Return(nil, errors.Wrap(ErrQueueAccessDenied, "hidden")).
+			Once()
+
+		handler.MessageMoveTasksAPI(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestHandlerImpl_CancelMessageMoveTaskAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders-dlq"
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/queues/{url}/move-tasks/cancel", strings.NewReader(`{"taskHandle":"handle-1"}`))
+	req.SetPathValue("url", url.QueryEscape(queueURL))
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		CancelMessageMoveTask(mock.Anything, queueURL, "handle-1").
+		Return(int64(40), nil).
+		Once()
+
+	handler.CancelMessageMoveTaskAPI(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"message":"Cancelling the redrive after about 40 messages were moved.","messagesMoved":40}`, rr.Body.String())
+}
@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_MessageMoveTasks(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders-dlq"
+	arn := "arn:aws:sqs:us-east-1:000000000000:orders-dlq"
+
+	t.Run("lists the tasks of the queue arn", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().GetQueueAttributes(mock.Anything, queueURL, []string{"QueueArn"}).Return(map[string]string{"QueueArn": arn}, nil).Once()
+		repo.EXPECT().ListMessageMoveTasks(mock.Anything, arn).Return([]MessageMoveTask{{TaskHandle: "handle-1", Status: MessageMoveTaskRunning}}, nil).Once()
+
+		tasks, err := service.MessageMoveTasks(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, []MessageMoveTask{{TaskHandle: "handle-1", Status: MessageMoveTaskRunning}}, tasks)
+	})
+
+	t.Run("requires a queue url", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.MessageMoveTasks(ctx, " ")
+		assert.EqualError(t, err, "queue url is required")
+	})
+}
+
+func TestSqsServiceImpl_CancelMessageMoveTask(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders-dlq"
+	arn := "arn:aws:sqs:us-east-1:000000000000:orders-dlq"
+	tasks := []MessageMoveTask{
+		{TaskHandle: "running", Status: MessageMoveTaskRunning},
+		{TaskHandle: "done", Status: MessageMoveTaskCompleted},
+	}
+	listTasks := func(repo *MockSqsRepository) {
+		repo.EXPECT().GetQueueAttributes(mock.Anything, queueURL, []string{"QueueArn"}).Return(map[string]string{"QueueArn": arn}, nil).Once()
+		repo.EXPECT().ListMessageMoveTasks(mock.Anything, arn).Return(tasks, nil).Once()
+	}
+
+	t.Run("cancels a running task", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		listTasks(repo)
+		repo.EXPECT().CancelMessageMoveTask(mock.Anything, "running").Return(int64(12), nil).Once()
+
+		moved, err := service.CancelMessageMoveTask(ctx, queueURL, "running")
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), moved)
+	})
+
+	testCases := []struct {
+		name       string
+		taskHandle string
+		wantErr    string
+	}{
+		{name: "finished task", taskHandle: "done", wantErr: "the task is completed and can no longer be cancelled"},
+		{name: "task of another queue", taskHandle: "elsewhere", wantErr: "the task is not a recent move task of this queue"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := NewMockSqsRepository(t)
+			service := &SqsServiceImpl{repo: repo}
+			listTasks(repo)
+
+			_, err := service.CancelMessageMoveTask(ctx, queueURL, tc.taskHandle)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+
+	t.Run("requires a task handle", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.CancelMessageMoveTask(ctx, queueURL, "")
+		assert.EqualError(t, err, "task handle is required")
+	})
+}
@@ -0,0 +1,180 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultMessageSearchMatches = 10
+	maxMessageSearchMatches     = 100
+	// maxMessageSearchScan bounds how many messages one search holds in flight and has to make
+	// visible again.
+	maxMessageSearchScan = 1000
+	// messageSearchHold is how long matches stay in flight after the search, so they can still be
+	// deleted or moved with the receipt handles it returned.
+	messageSearchHold = 5 * time.Minute
+)
+
+// SearchStoppedMatches is the reason a search stopped once it found the matches it was asked for.
+// A search otherwise stops for the reasons of a drain.
+const SearchStoppedMatches = "matches"
+
+// SearchMessagesInput looks for Query in the bodies of the messages of a queue or, when Attribute
+// is set, in the value of that attribute. Query is a case-insensitive substring, or a regular
+// expression when Regex is set. Zero budgets pick the defaults.
+type SearchMessagesInput struct {
+	QueueURL    string
+	Query       string
+	Regex       bool
+	Attribute   string
+	MaxMatches  int
+	MaxMessages int
+	MaxDuration time.Duration
+}
+
+// SearchMessagesResult tells how many messages a search looked at and matched, why it stopped,
+// and how many of the messages that did not match could not be made visible again.
+type SearchMessagesResult struct {
+	Scanned    int
+	Matched    int
+	Reason     string
+	Unrestored int
+}
+
+// SearchMessages keeps receiving from a queue without deleting anything until it found MaxMatches
+// matching messages, a receive comes back empty or the message or time budget is used up. Matches
+// are handed to emit with the number of messages looked at so far as each batch arrives. Every
+// message stays hidden while the search runs, so none is looked at twice; those that did not match
+// are made visible again when it ends, while the matches stay in flight for a few more minutes.
+// In a FIFO queue the hidden messages hold back the rest of their group, which the search then
+// does not reach.
+func (s *SqsServiceImpl) SearchMessages(ctx context.Context, input SearchMessagesInput, emit func(matches []ReceivedMessage, scanned int) error) (result SearchMessagesResult, err error) {
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return SearchMessagesResult{}, errors.New("queue url is required")
+	}
+	match, err := messageSearchMatcher(input.Query, input.Regex, strings.TrimSpace(input.Attribute))
+	if err != nil {
+		return SearchMessagesResult{}, err
+	}
+	maxMatches := input.MaxMatches
+	if maxMatches == 0 {
+		maxMatches = defaultMessageSearchMatches
+	}
+	if maxMatches < 1 || maxMatches > maxMessageSearchMatches {
+		return SearchMessagesResult{}, errors.Newf("max matches must be between 1 and %d", maxMessageSearchMatches)
+	}
+	maxMessages := input.MaxMessages
+	if maxMessages == 0 {
+		maxMessages = maxMessageSearchScan
+	}
+	if maxMessages < 1 || maxMessages > maxMessageSearchScan {
+		return SearchMessagesResult{}, errors.Newf("max messages must be between 1 and %d", maxMessageSearchScan)
+	}
+	maxDuration := input.MaxDuration
+	if maxDuration == 0 {
+		maxDuration = defaultDrainReceiveDuration
+	}
+	if maxDuration < time.Second || maxDuration > maxDrainReceiveDuration {
+		return SearchMessagesResult{}, errors.Newf("time budget must be between 1s and %s", maxDrainReceiveDuration)
+	}
+
+	pollCtx, done, err := s.polls.track(ctx)
+	if err != nil {
+		return SearchMessagesResult{}, err
+	}
+	defer done()
+
+	misses := make(map[string]string)
+	defer func() {
+		// Restore even when the client went away, or the messages would stay hidden.
+		result.Unrestored = s.restoreVisibility(context.WithoutCancel(ctx), queueURL, misses)
+		if result.Unrestored > 0 {
+			slog.WarnContext(ctx, "messages looked at by a search stay hidden", slog.String("queue_url", queueURL), slog.Int("messages", result.Unrestored))
+		}
+	}()
+
+	visibility := int32((maxDuration + messageSearchHold) / time.Second)
+	deadline := s.now().Add(maxDuration)
+	seen := make(map[string]bool)
+	for {
+		if result.Matched >= maxMatches {
+			result.Reason = SearchStoppedMatches
+			return result, nil
+		}
+		if result.Scanned >= maxMessages {
+			result.Reason = DrainStoppedMessageBudget
+			return result, nil
+		}
+		if !s.now().Before(deadline) {
+			result.Reason = DrainStoppedTimeBudget
+			return result, nil
+		}
+
+		messages, err := s.repo.ReceiveMessages(pollCtx, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       min(int32(maxMessages-result.Scanned), migrationReceiveBatch),
+			WaitTimeSeconds:   drainReceiveWait,
+			VisibilityTimeout: visibility,
+		})
+		if err != nil {
+			if pollCtx.Err() != nil && ctx.Err() == nil {
+				return result, errors.WithStack(ErrShuttingDown)
+			}
+			return result, errors.Wrapf(err, "searched %d messages", result.Scanned)
+		}
+		if len(messages) == 0 {
+			result.Reason = DrainStoppedEmpty
+			return result, nil
+		}
+
+		var matches []ReceivedMessage
+		for _, message := range messages {
+			if seen[message.ID] {
+				continue
+			}
+			seen[message.ID] = true
+			result.Scanned++
+			if result.Matched < maxMatches && match(message) {
+				result.Matched++
+				matches = append(matches, message)
+				continue
+			}
+			misses[message.ID] = message.ReceiptHandle
+		}
+		if err := emit(matches, result.Scanned); err != nil {
+			return result, err
+		}
+	}
+}
+
+// messageSearchMatcher returns whether a message's body, or the value of attribute when it is
+// set, holds query.
+func messageSearchMatcher(query string, regex bool, attribute string) (func(ReceivedMessage) bool, error) {
+	if query == "" {
+		return nil, errors.New("search text is required")
+	}
+	text := func(message ReceivedMessage) string {
+		if attribute != "" {
+			return messageAttributeValue(message, attribute)
+		}
+		return message.Body
+	}
+	if regex {
+		pattern, err := regexp.Compile(query)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid search pattern")
+		}
+		return func(message ReceivedMessage) bool { return pattern.MatchString(text(message)) }, nil
+	}
+	needle := strings.ToLower(query)
+	return func(message ReceivedMessage) bool {
+		return strings.Contains(strings.ToLower(text(message)), needle)
+	}, nil
+}
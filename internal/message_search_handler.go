@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"cmp"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type searchMessagesRequest struct {
+	Query       string `json:"query"`
+	Regex       bool   `json:"regex"`
+	Attribute   string `json:"attribute"`
+	MaxMatches  int    `json:"maxMatches"`
+	MaxMessages int    `json:"maxMessages"`
+	MaxSeconds  int    `json:"maxSeconds"`
+}
+
+// searchMessagesEvent is one line of a search stream: the matches of a batch with the progress so
+// far, the closing summary with the stop reason, or an error that ended the search early.
+type searchMessagesEvent struct {
+	Messages   []receiveMessageItem `json:"messages,omitempty"`
+	Scanned    int                  `json:"scanned"`
+	Matched    int                  `json:"matched"`
+	Done       bool                 `json:"done,omitempty"`
+	Reason     string               `json:"reason,omitempty"`
+	Unrestored int                  `json:"unrestored,omitempty"`
+	Error      string               `json:"error,omitempty"`
+}
+
+// SearchMessagesAPI polls a queue for messages whose body or attribute matches the search and
+// streams the matches as newline-delimited JSON, one line per batch received, as a drain does.
+func (h *HandlerImpl) SearchMessagesAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	var payload searchMessagesRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	input := SearchMessagesInput{
+		QueueURL:    queueURL,
+		Query:       payload.Query,
+		Regex:       payload.Regex,
+		Attribute:   payload.Attribute,
+		MaxMatches:  payload.MaxMatches,
+		MaxMessages: payload.MaxMessages,
+		MaxDuration: time.Duration(payload.MaxSeconds) * time.Second,
+	}
+
+	stream := newNDJSONStream(w, cmp.Or(input.MaxDuration, defaultDrainReceiveDuration))
+	matched := 0
+	scanned := 0
+	result, err := h.s.SearchMessages(r.Context(), input, func(matches []ReceivedMessage, scannedSoFar int) error {
+		matched += len(matches)
+		scanned = scannedSoFar
+		event := searchMessagesEvent{Scanned: scanned, Matched: matched, Messages: make([]receiveMessageItem, 0, len(matches))}
+		for _, message := range matches {
+			event.Messages = append(event.Messages, newReceiveMessageItem(message))
+		}
+		return stream.write(event)
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to search messages", slog.String("queue_url", queueURL), slog.Any("error", err))
+		if !stream.started {
+			writeJSONError(w, serviceErrorStatus(err), err.Error())
+			return
+		}
+		_ = stream.write(searchMessagesEvent{Scanned: scanned, Matched: matched, Done: true, Unrestored: result.Unrestored, Error: err.Error()})
+		return
+	}
+
+	done := searchMessagesEvent{Scanned: result.Scanned, Matched: result.Matched, Done: true, Reason: result.Reason, Unrestored: result.Unrestored}
+	if err := stream.write(done); err != nil {
+		slog.WarnContext(r.Context(), "failed to finish search stream", slog.String("queue_url", queueURL), slog.Any("error", err))
+	}
+}
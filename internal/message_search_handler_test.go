@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_SearchMessagesAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/messages/search", strings.NewReader(body))
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("streams the matches and the stop reason", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			SearchMessages(mock.Anything, SearchMessagesInput{QueueURL: queueURL, Query: "a", Attribute: "tenant", MaxMatches: 5}, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ SearchMessagesInput, emit func([]ReceivedMessage, int) error) (SearchMessagesResult, error) {
+				if err := emit(nil, 10); err != nil {
+					return SearchMessagesResult{}, err
+				}
+				if err := emit([]ReceivedMessage{{ID: "1", Body: "a", ReceiptHandle: "r-1"}}, 12); err != nil {
+					return SearchMessagesResult{}, err
+				}
+				return SearchMessagesResult{Scanned: 12, Matched: 1, Reason: DrainStoppedEmpty, Unrestored: 2}, nil
+			}).
+			Once()
+
+		handler.SearchMessagesAPI(rr, newRequest(`{"query":"a","attribute":"tenant","maxMatches":5}`))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+		assert.Equal(t, strings.Join([]string{
+			`{"scanned":10,"matched":0}`,
+			`{"messages":[{"id":"1","body":"a","receiptHandle":"r-1","receiveCount":0,"bodyHash":"ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb","attributes":[]}],"scanned":12,"matched":1}`,
+			`{"scanned":12,"matched":1,"done":true,"reason":"empty","unrestored":2}`,
+		}, "\n")+"\n", rr.Body.String())
+	})
+
+	t.Run("answers errors before the first batch with a status", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().SearchMessages(mock.Anything, mock.Anything, mock.Anything).
+			Return(SearchMessagesResult{}, errors.New("search text is required")).Once()
+
+		handler.SearchMessagesAPI(rr, newRequest(`{"query":""}`))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"search text is required"}`, rr.Body.String())
+	})
+}
@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_SearchMessages(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("returns the matches and releases the rest", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       10,
+			WaitTimeSeconds:   drainReceiveWait,
+			VisibilityTimeout: 330,
+		}).Return([]ReceivedMessage{
+			{ID: "1", Body: `{"status":"FAILED"}`, ReceiptHandle: "r-1"},
+			{ID: "2", Body: `{"status":"ok"}`, ReceiptHandle: "r-2"},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "2", Body: `{"status":"ok"}`, ReceiptHandle: "r-2b"},
+			{ID: "3", Body: `{"status":"failed"}`, ReceiptHandle: "r-3"},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		repo.EXPECT().ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-2"}).Return(nil).Once()
+
+		var batches [][]string
+		var progress []int
+		result, err := service.SearchMessages(ctx, SearchMessagesInput{QueueURL: queueURL, Query: "failed"}, func(matches []ReceivedMessage, scanned int) error {
+			ids := []string{}
+			for _, message := range matches {
+				ids = append(ids, message.ID)
+			}
+			batches = append(batches, ids)
+			progress = append(progress, scanned)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, SearchMessagesResult{Scanned: 3, Matched: 2, Reason: DrainStoppedEmpty}, result)
+		assert.Equal(t, [][]string{{"1"}, {"3"}}, batches)
+		assert.Equal(t, []int{2, 3}, progress)
+	})
+
+	t.Run("stops at the wanted matches and searches an attribute by pattern", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "1", ReceiptHandle: "r-1", Attributes: []MessageAttribute{{Name: "tenant", Value: "acme-7"}}},
+			{ID: "2", ReceiptHandle: "r-2", Attributes: []MessageAttribute{{Name: "tenant", Value: "acme-8"}}},
+			{ID: "3", ReceiptHandle: "r-3", Body: "acme-9"},
+		}, nil).Once()
+		repo.EXPECT().ChangeMessageVisibility(mock.Anything, mock.MatchedBy(func(input ChangeMessageVisibilityRepositoryInput) bool {
+			return input.ReceiptHandle == "r-2" || input.ReceiptHandle == "r-3"
+		})).Return(nil).Twice()
+
+		result, err := service.SearchMessages(ctx, SearchMessagesInput{
+			QueueURL:   queueURL,
+			Query:      `^acme-\d$`,
+			Regex:      true,
+			Attribute:  "tenant",
+			MaxMatches: 1,
+		}, func([]ReceivedMessage, int) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, SearchMessagesResult{Scanned: 3, Matched: 1, Reason: SearchStoppedMatches}, result)
+	})
+
+	t.Run("rejects invalid searches", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+		emit := func([]ReceivedMessage, int) error { return nil }
+
+		_, err := service.SearchMessages(ctx, SearchMessagesInput{QueueURL: queueURL}, emit)
+		assert.EqualError(t, err, "search text is required")
+
+		_, err = service.SearchMessages(ctx, SearchMessagesInput{QueueURL: queueURL, Query: "(", Regex: true}, emit)
+		assert.ErrorContains(t, err, "invalid search pattern")
+
+		_, err = service.SearchMessages(ctx, SearchMessagesInput{QueueURL: queueURL, Query: "a", MaxMessages: 5000}, emit)
+		assert.EqualError(t, err, "max messages must be between 1 and 1000")
+	})
+}
@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// maxTransferMessages bounds how many messages one copy or move may carry, which covers a long
+// receive-until-empty run.
+const maxTransferMessages = 1000
+
+// TransferMessagesInput copies Messages, as they were received from SourceQueueURL, to
+// TargetQueueURL. Move deletes each message from the source once it was sent. MessageGroupID is
+// used for messages without a group of their own when the target is a FIFO queue.
+type TransferMessagesInput struct {
+	SourceQueueURL string
+	TargetQueueURL string
+	Messages       []ReceivedMessage
+	Move           bool
+	MessageGroupID string
+}
+
+// TransferredMessage is the outcome for one message. Error is set when it could not be sent or,
+// for a move, deleted from the source after it was sent; Sent tells the two apart.
+type TransferredMessage struct {
+	MessageID string
+	Sent      bool
+	Deleted   bool
+	Error     string
+}
+
+// TransferMessages re-sends selected messages to another queue with their body and custom
+// attributes, so messages can be shuffled between queues by hand. Messages are handled one after
+// the other and one that fails does not stop the rest. A FIFO target gets the message's own
+// group, or else MessageGroupID, and the source message ID as deduplication ID, so sending the
+// same selection twice within five minutes does not create duplicates. A move needs the receipt
+// handles to still be valid, that is the messages must not have been received again since.
+func (s *SqsServiceImpl) TransferMessages(ctx context.Context, input TransferMessagesInput) ([]TransferredMessage, error) {
+	sourceURL := strings.TrimSpace(input.SourceQueueURL)
+	targetURL := strings.TrimSpace(input.TargetQueueURL)
+	if sourceURL == "" || targetURL == "" {
+		return nil, errors.New("source and target queue urls are required")
+	}
+	if sourceURL == targetURL {
+		return nil, errors.New("the target must be another queue")
+	}
+	if len(input.Messages) == 0 {
+		return nil, errors.New("at least one message is required")
+	}
+	if len(input.Messages) > maxTransferMessages {
+		return nil, errors.Newf("at most %d messages can be copied or moved at once", maxTransferMessages)
+	}
+
+	fifoTarget := queueTypeOfName(extractQueueName(targetURL)) == QueueTypeFIFO
+	groupID := strings.TrimSpace(input.MessageGroupID)
+	for _, message := range input.Messages {
+		if message.ID == "" {
+			return nil, errors.New("every message needs its message id")
+		}
+		if input.Move && message.ReceiptHandle == "" {
+			return nil, errors.Newf("message %s has no receipt handle to delete it with", message.ID)
+		}
+		if fifoTarget && groupID == "" && messageAttributeValue(message, "MessageGroupId") == "" {
+			return nil, errors.New("a message group id is required to send messages without one to a FIFO queue")
+		}
+	}
+
+	results := make([]TransferredMessage, 0, len(input.Messages))
+	for _, message := range input.Messages {
+		result := TransferredMessage{MessageID: message.ID}
+		send := SendMessageRepositoryInput{
+			QueueURL:       targetURL,
+			Body:           message.Body,
+			Attributes:     customMessageAttributes(message),
+			AttributeTypes: customMessageAttributeTypes(message),
+		}
+		if fifoTarget {
+			send.MessageGroupID = cmp.Or(messageAttributeValue(message, "MessageGroupId"), groupID)
+			send.MessageDeduplicationID = message.ID
+		}
+		if err := s.repo.SendMessage(ctx, send); err != nil {
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+		result.Sent = true
+
+		if input.Move {
+			err := s.repo.DeleteMessage(ctx, DeleteMessageRepositoryInput{QueueURL: sourceURL, ReceiptHandle: message.ReceiptHandle})
+			if err != nil {
+				result.Error = errors.Wrap(err, "copied but still in the source queue").Error()
+			} else {
+				result.Deleted = true
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
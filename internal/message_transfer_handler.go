@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+type transferMessagesRequest struct {
+	TargetQueueURL string                       `json:"targetQueueUrl"`
+	Move           bool                         `json:"move"`
+	MessageGroupID string                       `json:"messageGroupId"`
+	Messages       []transferMessageRequestItem `json:"messages"`
+}
+
+// transferMessageRequestItem is a message as the poll API returned it.
+type transferMessageRequestItem struct {
+	ID            string                     `json:"id"`
+	Body          string                     `json:"body"`
+	ReceiptHandle string                     `json:"receiptHandle"`
+	Attributes    []messageAttributeResponse `json:"attributes"`
+}
+
+type transferredMessageItem struct {
+	MessageID string `json:"messageId"`
+	Sent      bool   `json:"sent"`
+	Deleted   bool   `json:"deleted"`
+	Error     string `json:"error,omitempty"`
+}
+
+type transferMessagesResponse struct {
+	Message  string                   `json:"message"`
+	Messages []transferredMessageItem `json:"messages"`
+}
+
+// TransferMessagesAPI copies or moves messages received from the queue in the path to another
+// queue. Messages that fail are reported one by one; the request itself succeeds.
+func (h *HandlerImpl) TransferMessagesAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	var payload transferMessagesRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	input := TransferMessagesInput{
+		SourceQueueURL: queueURL,
+		TargetQueueURL: payload.TargetQueueURL,
+		Move:           payload.Move,
+		MessageGroupID: payload.MessageGroupID,
+		Messages:       receivedMessagesFromRequest(payload.Messages),
+	}
+
+	results, err := h.s.TransferMessages(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to transfer messages", slog.String("queue_url", queueURL), slog.String("target_queue_url", payload.TargetQueueURL), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	sent := 0
+	response := transferMessagesResponse{Messages: make([]transferredMessageItem, 0, len(results))}
+	for _, result := range results {
+		if result.Sent {
+			sent++
+		}
+		response.Messages = append(response.Messages, transferredMessageItem(result))
+	}
+	verb := "Copied"
+	if payload.Move {
+		verb = "Moved"
+	}
+	response.Message = fmt.Sprintf("%s %d of %d messages to %s.", verb, sent, len(results), extractQueueName(input.TargetQueueURL))
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// receivedMessagesFromRequest turns messages sent back by the page into the messages they were
+// received as.
+func receivedMessagesFromRequest(items []transferMessageRequestItem) []ReceivedMessage {
+	messages := make([]ReceivedMessage, 0, len(items))
+	for _, item := range items {
+		message := ReceivedMessage{ID: item.ID, Body: item.Body, ReceiptHandle: item.ReceiptHandle}
+		for _, attribute := range item.Attributes {
+			message.Attributes = append(message.Attributes, MessageAttribute(attribute))
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
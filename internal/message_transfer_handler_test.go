@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_TransferMessagesAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("moves the messages and lists the outcome of each", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		body := `{"targetQueueUrl":"https://sqs.local/retry","move":true,"messages":[
+			{"id":"m1","body":"one","receiptHandle":"rh-1","attributes":[{"name":"kind","value":"order"}]},
+			{"id":"m2","body":"two","receiptHandle":"rh-2","attributes":[]}
+		]}`
+		req := httptest.NewRequest(http.MethodPost, "/queues/{url}/messages/transfer", strings.NewReader(body))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			TransferMessages(mock.Anything, TransferMessagesInput{
+				SourceQueueURL: queueURL,
+				TargetQueueURL: "https://sqs.local/retry",
+				Move:           true,
+				Messages: []ReceivedMessage{
+					{ID: "m1", Body: "one", ReceiptHandle: "rh-1", Attributes: []MessageAttribute{{Name: "kind", Value: "order"}}},
+					{ID: "m2", Body: "two", ReceiptHandle: "rh-2"},
+				},
+			}).
+			Return([]TransferredMessage{
+				{MessageID: "m1", Sent: true, Deleted: true},
+				{MessageID: "m2", Error: "throttled"},
+			}, nil).
+			Once()
+
+		handler.TransferMessagesAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"message": "Moved 1 of 2 messages to retry.",
+			"messages": [
+				{"messageId":"m1","sent":true,"deleted":true},
+				{"messageId":"m2","sent":false,"deleted":false,"error":"throttled"}
+			]
+		}`, rr.Body.String())
+	})
+
+	t.Run("reports validation errors", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/queues/{url}/messages/transfer", strings.NewReader(`{"targetQueueUrl":"https://sqs.local/orders"}`))
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			TransferMessages(mock.Anything, mock.Anything).
+			Return(nil, assert.AnError).
+			Once()
+
+		handler.TransferMessagesAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_TransferMessages(t *testing.T) {
+	ctx := context.Background()
+	messages := []ReceivedMessage{
+		{ID: "m1", Body: "one", ReceiptHandle: "rh-1", Attributes: []MessageAttribute{
+			{Name: "ApproximateReceiveCount", Value: "2"},
+			{Name: "kind", Value: "order"},
+		}},
+		{ID: "m2", Body: "two", ReceiptHandle: "rh-2"},
+	}
+
+	t.Run("copies messages with their custom attributes", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{QueueURL: "https://sqs.local/retry", Body: "one", Attributes: map[string]string{"kind": "order"}}).Return(nil).Once()
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{QueueURL: "https://sqs.local/retry", Body: "two"}).Return(nil).Once()
+
+		results, err := service.TransferMessages(ctx, TransferMessagesInput{
+			SourceQueueURL: "https://sqs.local/orders",
+			TargetQueueURL: " https://sqs.local/retry ",
+			Messages:       messages,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []TransferredMessage{{MessageID: "m1", Sent: true}, {MessageID: "m2", Sent: true}}, results)
+	})
+
+	t.Run("keeps the data types of the attributes", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{
+			QueueURL:       "https://sqs.local/retry",
+			Body:           "one",
+			Attributes:     map[string]string{"kind": "order", "retries": "3", "payload": "AQI="},
+			AttributeTypes: map[string]string{"retries": "Number", "payload": "Binary"},
+		}).Return(nil).Once()
+
+		results, err := service.TransferMessages(ctx, TransferMessagesInput{
+			SourceQueueURL: "https://sqs.local/orders",
+			TargetQueueURL: "https://sqs.local/retry",
+			Messages: []ReceivedMessage{{ID: "m1", Body: "one", Attributes: []MessageAttribute{
+				{Name: "kind", Value: "order"},
+				{Name: "retries", Value: "3", DataType: "Number"},
+				{Name: "payload", Value: "AQI=", DataType: "Binary"},
+				{Name: "ApproximateReceiveCount", Value: "1"},
+			}}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []TransferredMessage{{MessageID: "m1", Sent: true}}, results)
+	})
+
+	t.Run("moves messages and reports each failure", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().SendMessage(mock.Anything, mock.MatchedBy(func(input SendMessageRepositoryInput) bool { return input.Body == "one" })).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: "https://sqs.local/orders", ReceiptHandle: "rh-1"}).Return(errors.New("receipt handle expired")).Once()
+		repo.EXPECT().SendMessage(mock.Anything, mock.MatchedBy(func(input SendMessageRepositoryInput) bool { return input.Body == "two" })).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: "https://sqs.local/orders", ReceiptHandle: "rh-2"}).Return(nil).Once()
+
+		results, err := service.TransferMessages(ctx, TransferMessagesInput{
+			SourceQueueURL: "https://sqs.local/orders",
+			TargetQueueURL: "https://sqs.local/retry",
+			Messages:       messages,
+			Move:           true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []TransferredMessage{
+			{MessageID: "m1", Sent: true, Error: "copied but still in the source queue: receipt handle expired"},
+			{MessageID: "m2", Sent: true, Deleted: true},
+		}, results)
+	})
+
+	t.Run("keeps the message group on a FIFO target", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{QueueURL: "https://sqs.local/retry.fifo", Body: "one", MessageGroupID: "customer-1", MessageDeduplicationID: "m1"}).Return(nil).Once()
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{QueueURL: "https://sqs.local/retry.fifo", Body: "two", MessageGroupID: "manual", MessageDeduplicationID: "m2"}).Return(errors.New("throttled")).Once()
+
+		results, err := service.TransferMessages(ctx, TransferMessagesInput{
+			SourceQueueURL: "https://sqs.local/orders.fifo",
+			TargetQueueURL: "https://sqs.local/retry.fifo",
+			Messages: []ReceivedMessage{
+				{ID: "m1", Body: "one", Attributes: []MessageAttribute{{Name: "MessageGroupId", Value: "customer-1"}}},
+				{ID: "m2", Body: "two"},
+			},
+			MessageGroupID: "manual",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []TransferredMessage{{MessageID: "m1", Sent: true}, {MessageID: "m2", Error: "throttled"}}, results)
+	})
+
+	testCases := []struct {
+		name    string
+		input   TransferMessagesInput
+		wantErr string
+	}{
+		{name: "missing target", input: TransferMessagesInput{SourceQueueURL: "https://sqs.local/orders", Messages: messages}, wantErr: "source and target queue urls are required"},
+		{name: "same queue", input: TransferMessagesInput{SourceQueueURL: "https://sqs.local/orders", TargetQueueURL: "https://sqs.local/orders", Messages: messages}, wantErr: "the target must be another queue"},
+		{name: "no messages", input: TransferMessagesInput{SourceQueueURL: "https://sqs.local/orders", TargetQueueURL: "https://sqs.local/retry"}, wantErr: "at least one message is required"},
+		{name: "move without receipt handle", input: TransferMessagesInput{SourceQueueURL: "https://sqs.local/orders", TargetQueueURL: "https://sqs.local/retry", Messages: []ReceivedMessage{{ID: "m1"}}, Move: true}, wantErr: "message m1 has no receipt handle to delete it with"},
+		{name: "FIFO target without group", input: TransferMessagesInput{SourceQueueURL: "https://sqs.local/orders", TargetQueueURL: "https://sqs.local/retry.fifo", Messages: messages}, wantErr: "a message group id is required to send messages without one to a FIFO queue"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+			_, err := service.TransferMessages(ctx, tc.input)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -6,19 +6,24 @@ package internal
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"os"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockHandler creates a new instance of MockHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockEventBridgeRepository creates a new instance of MockEventBridgeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockHandler(t interface {
+func NewMockEventBridgeRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockHandler {
-	mock := &MockHandler{}
+}) *MockEventBridgeRepository {
+	mock := &MockEventBridgeRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -26,46 +31,57 @@ func NewMockHandler(t interface {
 	return mock
 }
 
-// MockHandler is an autogenerated mock type for the Handler type
-type MockHandler struct {
+// MockEventBridgeRepository is an autogenerated mock type for the EventBridgeRepository type
+type MockEventBridgeRepository struct {
 	mock.Mock
 }
 
-type MockHandler_Expecter struct {
+type MockEventBridgeRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockHandler) EXPECT() *MockHandler_Expecter {
-	return &MockHandler_Expecter{mock: &_m.Mock}
+func (_m *MockEventBridgeRepository) EXPECT() *MockEventBridgeRepository_Expecter {
+	return &MockEventBridgeRepository_Expecter{mock: &_m.Mock}
 }
 
-// DeleteMessageAPI provides a mock function for the type MockHandler
-func (_mock *MockHandler) DeleteMessageAPI(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// PutQueueTarget provides a mock function for the type MockEventBridgeRepository
+func (_mock *MockEventBridgeRepository) PutQueueTarget(ctx context.Context, input PutQueueTargetRepositoryInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutQueueTarget")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PutQueueTargetRepositoryInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockHandler_DeleteMessageAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessageAPI'
-type MockHandler_DeleteMessageAPI_Call struct {
+// MockEventBridgeRepository_PutQueueTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutQueueTarget'
+type MockEventBridgeRepository_PutQueueTarget_Call struct {
 	*mock.Call
 }
 
-// DeleteMessageAPI is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) DeleteMessageAPI(w interface{}, r interface{}) *MockHandler_DeleteMessageAPI_Call {
-	return &MockHandler_DeleteMessageAPI_Call{Call: _e.mock.On("DeleteMessageAPI", w, r)}
+// PutQueueTarget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input PutQueueTargetRepositoryInput
+func (_e *MockEventBridgeRepository_Expecter) PutQueueTarget(ctx interface{}, input interface{}) *MockEventBridgeRepository_PutQueueTarget_Call {
+	return &MockEventBridgeRepository_PutQueueTarget_Call{Call: _e.mock.On("PutQueueTarget", ctx, input)}
 }
 
-func (_c *MockHandler_DeleteMessageAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteMessageAPI_Call {
+func (_c *MockEventBridgeRepository_PutQueueTarget_Call) Run(run func(ctx context.Context, input PutQueueTargetRepositoryInput)) *MockEventBridgeRepository_PutQueueTarget_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 PutQueueTargetRepositoryInput
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(PutQueueTargetRepositoryInput)
 		}
 		run(
 			arg0,
@@ -75,43 +91,63 @@ func (_c *MockHandler_DeleteMessageAPI_Call) Run(run func(w http.ResponseWriter,
 	return _c
 }
 
-func (_c *MockHandler_DeleteMessageAPI_Call) Return() *MockHandler_DeleteMessageAPI_Call {
-	_c.Call.Return()
+func (_c *MockEventBridgeRepository_PutQueueTarget_Call) Return(err error) *MockEventBridgeRepository_PutQueueTarget_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockHandler_DeleteMessageAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteMessageAPI_Call {
-	_c.Run(run)
+func (_c *MockEventBridgeRepository_PutQueueTarget_Call) RunAndReturn(run func(ctx context.Context, input PutQueueTargetRepositoryInput) error) *MockEventBridgeRepository_PutQueueTarget_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteQueueHandler provides a mock function for the type MockHandler
-func (_mock *MockHandler) DeleteQueueHandler(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// PutRule provides a mock function for the type MockEventBridgeRepository
+func (_mock *MockEventBridgeRepository) PutRule(ctx context.Context, input PutRuleRepositoryInput) (string, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutRule")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PutRuleRepositoryInput) (string, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PutRuleRepositoryInput) string); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PutRuleRepositoryInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockHandler_DeleteQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueueHandler'
-type MockHandler_DeleteQueueHandler_Call struct {
+// MockEventBridgeRepository_PutRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutRule'
+type MockEventBridgeRepository_PutRule_Call struct {
 	*mock.Call
 }
 
-// DeleteQueueHandler is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) DeleteQueueHandler(w interface{}, r interface{}) *MockHandler_DeleteQueueHandler_Call {
-	return &MockHandler_DeleteQueueHandler_Call{Call: _e.mock.On("DeleteQueueHandler", w, r)}
+// PutRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input PutRuleRepositoryInput
+func (_e *MockEventBridgeRepository_Expecter) PutRule(ctx interface{}, input interface{}) *MockEventBridgeRepository_PutRule_Call {
+	return &MockEventBridgeRepository_PutRule_Call{Call: _e.mock.On("PutRule", ctx, input)}
 }
 
-func (_c *MockHandler_DeleteQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteQueueHandler_Call {
+func (_c *MockEventBridgeRepository_PutRule_Call) Run(run func(ctx context.Context, input PutRuleRepositoryInput)) *MockEventBridgeRepository_PutRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 PutRuleRepositoryInput
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(PutRuleRepositoryInput)
 		}
 		run(
 			arg0,
@@ -121,135 +157,16616 @@ func (_c *MockHandler_DeleteQueueHandler_Call) Run(run func(w http.ResponseWrite
 	return _c
 }
 
-func (_c *MockHandler_DeleteQueueHandler_Call) Return() *MockHandler_DeleteQueueHandler_Call {
-	_c.Call.Return()
+func (_c *MockEventBridgeRepository_PutRule_Call) Return(s string, err error) *MockEventBridgeRepository_PutRule_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockHandler_DeleteQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteQueueHandler_Call {
-	_c.Run(run)
+func (_c *MockEventBridgeRepository_PutRule_Call) RunAndReturn(run func(ctx context.Context, input PutRuleRepositoryInput) (string, error)) *MockEventBridgeRepository_PutRule_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// GetCreateQueueHandler provides a mock function for the type MockHandler
-func (_mock *MockHandler) GetCreateQueueHandler(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// RulesTargeting provides a mock function for the type MockEventBridgeRepository
+func (_mock *MockEventBridgeRepository) RulesTargeting(ctx context.Context, eventBus string, targetArn string) ([]EventRule, error) {
+	ret := _mock.Called(ctx, eventBus, targetArn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RulesTargeting")
+	}
+
+	var r0 []EventRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]EventRule, error)); ok {
+		return returnFunc(ctx, eventBus, targetArn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []EventRule); ok {
+		r0 = returnFunc(ctx, eventBus, targetArn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]EventRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, eventBus, targetArn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockHandler_GetCreateQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreateQueueHandler'
-type MockHandler_GetCreateQueueHandler_Call struct {
+// MockEventBridgeRepository_RulesTargeting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RulesTargeting'
+type MockEventBridgeRepository_RulesTargeting_Call struct {
 	*mock.Call
 }
 
-// GetCreateQueueHandler is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) GetCreateQueueHandler(w interface{}, r interface{}) *MockHandler_GetCreateQueueHandler_Call {
-	return &MockHandler_GetCreateQueueHandler_Call{Call: _e.mock.On("GetCreateQueueHandler", w, r)}
+// RulesTargeting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventBus string
+//   - targetArn string
+func (_e *MockEventBridgeRepository_Expecter) RulesTargeting(ctx interface{}, eventBus interface{}, targetArn interface{}) *MockEventBridgeRepository_RulesTargeting_Call {
+	return &MockEventBridgeRepository_RulesTargeting_Call{Call: _e.mock.On("RulesTargeting", ctx, eventBus, targetArn)}
 }
 
-func (_c *MockHandler_GetCreateQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_GetCreateQueueHandler_Call {
+func (_c *MockEventBridgeRepository_RulesTargeting_Call) Run(run func(ctx context.Context, eventBus string, targetArn string)) *MockEventBridgeRepository_RulesTargeting_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_GetCreateQueueHandler_Call) Return() *MockHandler_GetCreateQueueHandler_Call {
-	_c.Call.Return()
+func (_c *MockEventBridgeRepository_RulesTargeting_Call) Return(eventRules []EventRule, err error) *MockEventBridgeRepository_RulesTargeting_Call {
+	_c.Call.Return(eventRules, err)
 	return _c
 }
 
-func (_c *MockHandler_GetCreateQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_GetCreateQueueHandler_Call {
-	_c.Run(run)
+func (_c *MockEventBridgeRepository_RulesTargeting_Call) RunAndReturn(run func(ctx context.Context, eventBus string, targetArn string) ([]EventRule, error)) *MockEventBridgeRepository_RulesTargeting_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// PostCreateQueueHandler provides a mock function for the type MockHandler
-func (_mock *MockHandler) PostCreateQueueHandler(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// TestEventPattern provides a mock function for the type MockEventBridgeRepository
+func (_mock *MockEventBridgeRepository) TestEventPattern(ctx context.Context, pattern string, event string) (bool, error) {
+	ret := _mock.Called(ctx, pattern, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TestEventPattern")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, pattern, event)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, pattern, event)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, pattern, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockHandler_PostCreateQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostCreateQueueHandler'
-type MockHandler_PostCreateQueueHandler_Call struct {
+// MockEventBridgeRepository_TestEventPattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TestEventPattern'
+type MockEventBridgeRepository_TestEventPattern_Call struct {
 	*mock.Call
 }
 
-// PostCreateQueueHandler is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) PostCreateQueueHandler(w interface{}, r interface{}) *MockHandler_PostCreateQueueHandler_Call {
-	return &MockHandler_PostCreateQueueHandler_Call{Call: _e.mock.On("PostCreateQueueHandler", w, r)}
+// TestEventPattern is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pattern string
+//   - event string
+func (_e *MockEventBridgeRepository_Expecter) TestEventPattern(ctx interface{}, pattern interface{}, event interface{}) *MockEventBridgeRepository_TestEventPattern_Call {
+	return &MockEventBridgeRepository_TestEventPattern_Call{Call: _e.mock.On("TestEventPattern", ctx, pattern, event)}
 }
 
-func (_c *MockHandler_PostCreateQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostCreateQueueHandler_Call {
+func (_c *MockEventBridgeRepository_TestEventPattern_Call) Run(run func(ctx context.Context, pattern string, event string)) *MockEventBridgeRepository_TestEventPattern_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_PostCreateQueueHandler_Call) Return() *MockHandler_PostCreateQueueHandler_Call {
-	_c.Call.Return()
+func (_c *MockEventBridgeRepository_TestEventPattern_Call) Return(b bool, err error) *MockEventBridgeRepository_TestEventPattern_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockHandler_PostCreateQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostCreateQueueHandler_Call {
-	_c.Run(run)
+func (_c *MockEventBridgeRepository_TestEventPattern_Call) RunAndReturn(run func(ctx context.Context, pattern string, event string) (bool, error)) *MockEventBridgeRepository_TestEventPattern_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// PurgeQueueHandler provides a mock function for the type MockHandler
-func (_mock *MockHandler) PurgeQueueHandler(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// newMockeventBridgeAPI creates a new instance of mockeventBridgeAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockeventBridgeAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockeventBridgeAPI {
+	mock := &mockeventBridgeAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockeventBridgeAPI is an autogenerated mock type for the eventBridgeAPI type
+type mockeventBridgeAPI struct {
+	mock.Mock
+}
+
+type mockeventBridgeAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockeventBridgeAPI) EXPECT() *mockeventBridgeAPI_Expecter {
+	return &mockeventBridgeAPI_Expecter{mock: &_m.Mock}
+}
+
+// DescribeRule provides a mock function for the type mockeventBridgeAPI
+func (_mock *mockeventBridgeAPI) DescribeRule(ctx context.Context, params *eventbridge.DescribeRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DescribeRuleOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeRule")
+	}
+
+	var r0 *eventbridge.DescribeRuleOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.DescribeRuleInput, ...func(*eventbridge.Options)) (*eventbridge.DescribeRuleOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.DescribeRuleInput, ...func(*eventbridge.Options)) *eventbridge.DescribeRuleOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eventbridge.DescribeRuleOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *eventbridge.DescribeRuleInput, ...func(*eventbridge.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockeventBridgeAPI_DescribeRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeRule'
+type mockeventBridgeAPI_DescribeRule_Call struct {
+	*mock.Call
+}
+
+// DescribeRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *eventbridge.DescribeRuleInput
+//   - optFns ...func(*eventbridge.Options)
+func (_e *mockeventBridgeAPI_Expecter) DescribeRule(ctx interface{}, params interface{}, optFns ...interface{}) *mockeventBridgeAPI_DescribeRule_Call {
+	return &mockeventBridgeAPI_DescribeRule_Call{Call: _e.mock.On("DescribeRule",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mockeventBridgeAPI_DescribeRule_Call) Run(run func(ctx context.Context, params *eventbridge.DescribeRuleInput, optFns ...func(*eventbridge.Options))) *mockeventBridgeAPI_DescribeRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *eventbridge.DescribeRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(*eventbridge.DescribeRuleInput)
+		}
+		var arg2 []func(*eventbridge.Options)
+		var variadicArgs []func(*eventbridge.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*eventbridge.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_DescribeRule_Call) Return(describeRuleOutput *eventbridge.DescribeRuleOutput, err error) *mockeventBridgeAPI_DescribeRule_Call {
+	_c.Call.Return(describeRuleOutput, err)
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_DescribeRule_Call) RunAndReturn(run func(ctx context.Context, params *eventbridge.DescribeRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DescribeRuleOutput, error)) *mockeventBridgeAPI_DescribeRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRuleNamesByTarget provides a mock function for the type mockeventBridgeAPI
+func (_mock *mockeventBridgeAPI) ListRuleNamesByTarget(ctx context.Context, params *eventbridge.ListRuleNamesByTargetInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListRuleNamesByTargetOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRuleNamesByTarget")
+	}
+
+	var r0 *eventbridge.ListRuleNamesByTargetOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.ListRuleNamesByTargetInput, ...func(*eventbridge.Options)) (*eventbridge.ListRuleNamesByTargetOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.ListRuleNamesByTargetInput, ...func(*eventbridge.Options)) *eventbridge.ListRuleNamesByTargetOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eventbridge.ListRuleNamesByTargetOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *eventbridge.ListRuleNamesByTargetInput, ...func(*eventbridge.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockeventBridgeAPI_ListRuleNamesByTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRuleNamesByTarget'
+type mockeventBridgeAPI_ListRuleNamesByTarget_Call struct {
+	*mock.Call
+}
+
+// ListRuleNamesByTarget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *eventbridge.ListRuleNamesByTargetInput
+//   - optFns ...func(*eventbridge.Options)
+func (_e *mockeventBridgeAPI_Expecter) ListRuleNamesByTarget(ctx interface{}, params interface{}, optFns ...interface{}) *mockeventBridgeAPI_ListRuleNamesByTarget_Call {
+	return &mockeventBridgeAPI_ListRuleNamesByTarget_Call{Call: _e.mock.On("ListRuleNamesByTarget",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mockeventBridgeAPI_ListRuleNamesByTarget_Call) Run(run func(ctx context.Context, params *eventbridge.ListRuleNamesByTargetInput, optFns ...func(*eventbridge.Options))) *mockeventBridgeAPI_ListRuleNamesByTarget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *eventbridge.ListRuleNamesByTargetInput
+		if args[1] != nil {
+			arg1 = args[1].(*eventbridge.ListRuleNamesByTargetInput)
+		}
+		var arg2 []func(*eventbridge.Options)
+		var variadicArgs []func(*eventbridge.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*eventbridge.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_ListRuleNamesByTarget_Call) Return(listRuleNamesByTargetOutput *eventbridge.ListRuleNamesByTargetOutput, err error) *mockeventBridgeAPI_ListRuleNamesByTarget_Call {
+	_c.Call.Return(listRuleNamesByTargetOutput, err)
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_ListRuleNamesByTarget_Call) RunAndReturn(run func(ctx context.Context, params *eventbridge.ListRuleNamesByTargetInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListRuleNamesByTargetOutput, error)) *mockeventBridgeAPI_ListRuleNamesByTarget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutRule provides a mock function for the type mockeventBridgeAPI
+func (_mock *mockeventBridgeAPI) PutRule(ctx context.Context, params *eventbridge.PutRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutRuleOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutRule")
+	}
+
+	var r0 *eventbridge.PutRuleOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.PutRuleInput, ...func(*eventbridge.Options)) (*eventbridge.PutRuleOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.PutRuleInput, ...func(*eventbridge.Options)) *eventbridge.PutRuleOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eventbridge.PutRuleOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *eventbridge.PutRuleInput, ...func(*eventbridge.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockeventBridgeAPI_PutRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutRule'
+type mockeventBridgeAPI_PutRule_Call struct {
+	*mock.Call
+}
+
+// PutRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *eventbridge.PutRuleInput
+//   - optFns ...func(*eventbridge.Options)
+func (_e *mockeventBridgeAPI_Expecter) PutRule(ctx interface{}, params interface{}, optFns ...interface{}) *mockeventBridgeAPI_PutRule_Call {
+	return &mockeventBridgeAPI_PutRule_Call{Call: _e.mock.On("PutRule",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mockeventBridgeAPI_PutRule_Call) Run(run func(ctx context.Context, params *eventbridge.PutRuleInput, optFns ...func(*eventbridge.Options))) *mockeventBridgeAPI_PutRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *eventbridge.PutRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(*eventbridge.PutRuleInput)
+		}
+		var arg2 []func(*eventbridge.Options)
+		var variadicArgs []func(*eventbridge.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*eventbridge.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_PutRule_Call) Return(putRuleOutput *eventbridge.PutRuleOutput, err error) *mockeventBridgeAPI_PutRule_Call {
+	_c.Call.Return(putRuleOutput, err)
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_PutRule_Call) RunAndReturn(run func(ctx context.Context, params *eventbridge.PutRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutRuleOutput, error)) *mockeventBridgeAPI_PutRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutTargets provides a mock function for the type mockeventBridgeAPI
+func (_mock *mockeventBridgeAPI) PutTargets(ctx context.Context, params *eventbridge.PutTargetsInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutTargetsOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutTargets")
+	}
+
+	var r0 *eventbridge.PutTargetsOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.PutTargetsInput, ...func(*eventbridge.Options)) (*eventbridge.PutTargetsOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.PutTargetsInput, ...func(*eventbridge.Options)) *eventbridge.PutTargetsOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eventbridge.PutTargetsOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *eventbridge.PutTargetsInput, ...func(*eventbridge.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockeventBridgeAPI_PutTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutTargets'
+type mockeventBridgeAPI_PutTargets_Call struct {
+	*mock.Call
+}
+
+// PutTargets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *eventbridge.PutTargetsInput
+//   - optFns ...func(*eventbridge.Options)
+func (_e *mockeventBridgeAPI_Expecter) PutTargets(ctx interface{}, params interface{}, optFns ...interface{}) *mockeventBridgeAPI_PutTargets_Call {
+	return &mockeventBridgeAPI_PutTargets_Call{Call: _e.mock.On("PutTargets",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mockeventBridgeAPI_PutTargets_Call) Run(run func(ctx context.Context, params *eventbridge.PutTargetsInput, optFns ...func(*eventbridge.Options))) *mockeventBridgeAPI_PutTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *eventbridge.PutTargetsInput
+		if args[1] != nil {
+			arg1 = args[1].(*eventbridge.PutTargetsInput)
+		}
+		var arg2 []func(*eventbridge.Options)
+		var variadicArgs []func(*eventbridge.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*eventbridge.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_PutTargets_Call) Return(putTargetsOutput *eventbridge.PutTargetsOutput, err error) *mockeventBridgeAPI_PutTargets_Call {
+	_c.Call.Return(putTargetsOutput, err)
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_PutTargets_Call) RunAndReturn(run func(ctx context.Context, params *eventbridge.PutTargetsInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutTargetsOutput, error)) *mockeventBridgeAPI_PutTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TestEventPattern provides a mock function for the type mockeventBridgeAPI
+func (_mock *mockeventBridgeAPI) TestEventPattern(ctx context.Context, params *eventbridge.TestEventPatternInput, optFns ...func(*eventbridge.Options)) (*eventbridge.TestEventPatternOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for TestEventPattern")
+	}
+
+	var r0 *eventbridge.TestEventPatternOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.TestEventPatternInput, ...func(*eventbridge.Options)) (*eventbridge.TestEventPatternOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *eventbridge.TestEventPatternInput, ...func(*eventbridge.Options)) *eventbridge.TestEventPatternOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eventbridge.TestEventPatternOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *eventbridge.TestEventPatternInput, ...func(*eventbridge.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockeventBridgeAPI_TestEventPattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TestEventPattern'
+type mockeventBridgeAPI_TestEventPattern_Call struct {
+	*mock.Call
+}
+
+// TestEventPattern is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *eventbridge.TestEventPatternInput
+//   - optFns ...func(*eventbridge.Options)
+func (_e *mockeventBridgeAPI_Expecter) TestEventPattern(ctx interface{}, params interface{}, optFns ...interface{}) *mockeventBridgeAPI_TestEventPattern_Call {
+	return &mockeventBridgeAPI_TestEventPattern_Call{Call: _e.mock.On("TestEventPattern",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mockeventBridgeAPI_TestEventPattern_Call) Run(run func(ctx context.Context, params *eventbridge.TestEventPatternInput, optFns ...func(*eventbridge.Options))) *mockeventBridgeAPI_TestEventPattern_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *eventbridge.TestEventPatternInput
+		if args[1] != nil {
+			arg1 = args[1].(*eventbridge.TestEventPatternInput)
+		}
+		var arg2 []func(*eventbridge.Options)
+		var variadicArgs []func(*eventbridge.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*eventbridge.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_TestEventPattern_Call) Return(testEventPatternOutput *eventbridge.TestEventPatternOutput, err error) *mockeventBridgeAPI_TestEventPattern_Call {
+	_c.Call.Return(testEventPatternOutput, err)
+	return _c
+}
+
+func (_c *mockeventBridgeAPI_TestEventPattern_Call) RunAndReturn(run func(ctx context.Context, params *eventbridge.TestEventPatternInput, optFns ...func(*eventbridge.Options)) (*eventbridge.TestEventPatternOutput, error)) *mockeventBridgeAPI_TestEventPattern_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHandler creates a new instance of MockHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHandler {
+	mock := &MockHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockHandler is an autogenerated mock type for the Handler type
+type MockHandler struct {
+	mock.Mock
+}
+
+type MockHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHandler) EXPECT() *MockHandler_Expecter {
+	return &MockHandler_Expecter{mock: &_m.Mock}
+}
+
+// AccessPolicyHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) AccessPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_AccessPolicyHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccessPolicyHandler'
+type MockHandler_AccessPolicyHandler_Call struct {
+	*mock.Call
+}
+
+// AccessPolicyHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) AccessPolicyHandler(w interface{}, r interface{}) *MockHandler_AccessPolicyHandler_Call {
+	return &MockHandler_AccessPolicyHandler_Call{Call: _e.mock.On("AccessPolicyHandler", w, r)}
+}
+
+func (_c *MockHandler_AccessPolicyHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_AccessPolicyHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_AccessPolicyHandler_Call) Return() *MockHandler_AccessPolicyHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_AccessPolicyHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_AccessPolicyHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// AlertsHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) AlertsHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_AlertsHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlertsHandler'
+type MockHandler_AlertsHandler_Call struct {
+	*mock.Call
+}
+
+// AlertsHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) AlertsHandler(w interface{}, r interface{}) *MockHandler_AlertsHandler_Call {
+	return &MockHandler_AlertsHandler_Call{Call: _e.mock.On("AlertsHandler", w, r)}
+}
+
+func (_c *MockHandler_AlertsHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_AlertsHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_AlertsHandler_Call) Return() *MockHandler_AlertsHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_AlertsHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_AlertsHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// AttributeHistoryHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) AttributeHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_AttributeHistoryHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttributeHistoryHandler'
+type MockHandler_AttributeHistoryHandler_Call struct {
+	*mock.Call
+}
+
+// AttributeHistoryHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) AttributeHistoryHandler(w interface{}, r interface{}) *MockHandler_AttributeHistoryHandler_Call {
+	return &MockHandler_AttributeHistoryHandler_Call{Call: _e.mock.On("AttributeHistoryHandler", w, r)}
+}
+
+func (_c *MockHandler_AttributeHistoryHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_AttributeHistoryHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_AttributeHistoryHandler_Call) Return() *MockHandler_AttributeHistoryHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_AttributeHistoryHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_AttributeHistoryHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// BulkQueueOperationAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) BulkQueueOperationAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_BulkQueueOperationAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkQueueOperationAPI'
+type MockHandler_BulkQueueOperationAPI_Call struct {
+	*mock.Call
+}
+
+// BulkQueueOperationAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) BulkQueueOperationAPI(w interface{}, r interface{}) *MockHandler_BulkQueueOperationAPI_Call {
+	return &MockHandler_BulkQueueOperationAPI_Call{Call: _e.mock.On("BulkQueueOperationAPI", w, r)}
+}
+
+func (_c *MockHandler_BulkQueueOperationAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_BulkQueueOperationAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_BulkQueueOperationAPI_Call) Return() *MockHandler_BulkQueueOperationAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_BulkQueueOperationAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_BulkQueueOperationAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CancelMessageMoveTaskAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) CancelMessageMoveTaskAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_CancelMessageMoveTaskAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelMessageMoveTaskAPI'
+type MockHandler_CancelMessageMoveTaskAPI_Call struct {
+	*mock.Call
+}
+
+// CancelMessageMoveTaskAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) CancelMessageMoveTaskAPI(w interface{}, r interface{}) *MockHandler_CancelMessageMoveTaskAPI_Call {
+	return &MockHandler_CancelMessageMoveTaskAPI_Call{Call: _e.mock.On("CancelMessageMoveTaskAPI", w, r)}
+}
+
+func (_c *MockHandler_CancelMessageMoveTaskAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CancelMessageMoveTaskAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_CancelMessageMoveTaskAPI_Call) Return() *MockHandler_CancelMessageMoveTaskAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_CancelMessageMoveTaskAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CancelMessageMoveTaskAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CapabilitiesAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) CapabilitiesAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_CapabilitiesAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CapabilitiesAPI'
+type MockHandler_CapabilitiesAPI_Call struct {
+	*mock.Call
+}
+
+// CapabilitiesAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) CapabilitiesAPI(w interface{}, r interface{}) *MockHandler_CapabilitiesAPI_Call {
+	return &MockHandler_CapabilitiesAPI_Call{Call: _e.mock.On("CapabilitiesAPI", w, r)}
+}
+
+func (_c *MockHandler_CapabilitiesAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CapabilitiesAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_CapabilitiesAPI_Call) Return() *MockHandler_CapabilitiesAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_CapabilitiesAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CapabilitiesAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CheckQueueNameAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) CheckQueueNameAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_CheckQueueNameAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckQueueNameAPI'
+type MockHandler_CheckQueueNameAPI_Call struct {
+	*mock.Call
+}
+
+// CheckQueueNameAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) CheckQueueNameAPI(w interface{}, r interface{}) *MockHandler_CheckQueueNameAPI_Call {
+	return &MockHandler_CheckQueueNameAPI_Call{Call: _e.mock.On("CheckQueueNameAPI", w, r)}
+}
+
+func (_c *MockHandler_CheckQueueNameAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CheckQueueNameAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_CheckQueueNameAPI_Call) Return() *MockHandler_CheckQueueNameAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_CheckQueueNameAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CheckQueueNameAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CleanupReportAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) CleanupReportAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_CleanupReportAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupReportAPI'
+type MockHandler_CleanupReportAPI_Call struct {
+	*mock.Call
+}
+
+// CleanupReportAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) CleanupReportAPI(w interface{}, r interface{}) *MockHandler_CleanupReportAPI_Call {
+	return &MockHandler_CleanupReportAPI_Call{Call: _e.mock.On("CleanupReportAPI", w, r)}
+}
+
+func (_c *MockHandler_CleanupReportAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CleanupReportAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_CleanupReportAPI_Call) Return() *MockHandler_CleanupReportAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_CleanupReportAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CleanupReportAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CompareMessageAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) CompareMessageAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_CompareMessageAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareMessageAPI'
+type MockHandler_CompareMessageAPI_Call struct {
+	*mock.Call
+}
+
+// CompareMessageAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) CompareMessageAPI(w interface{}, r interface{}) *MockHandler_CompareMessageAPI_Call {
+	return &MockHandler_CompareMessageAPI_Call{Call: _e.mock.On("CompareMessageAPI", w, r)}
+}
+
+func (_c *MockHandler_CompareMessageAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CompareMessageAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_CompareMessageAPI_Call) Return() *MockHandler_CompareMessageAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_CompareMessageAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CompareMessageAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ConsumerSimulatorHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) ConsumerSimulatorHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ConsumerSimulatorHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumerSimulatorHandler'
+type MockHandler_ConsumerSimulatorHandler_Call struct {
+	*mock.Call
+}
+
+// ConsumerSimulatorHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ConsumerSimulatorHandler(w interface{}, r interface{}) *MockHandler_ConsumerSimulatorHandler_Call {
+	return &MockHandler_ConsumerSimulatorHandler_Call{Call: _e.mock.On("ConsumerSimulatorHandler", w, r)}
+}
+
+func (_c *MockHandler_ConsumerSimulatorHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ConsumerSimulatorHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ConsumerSimulatorHandler_Call) Return() *MockHandler_ConsumerSimulatorHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ConsumerSimulatorHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ConsumerSimulatorHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CreateScheduleAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) CreateScheduleAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_CreateScheduleAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduleAPI'
+type MockHandler_CreateScheduleAPI_Call struct {
+	*mock.Call
+}
+
+// CreateScheduleAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) CreateScheduleAPI(w interface{}, r interface{}) *MockHandler_CreateScheduleAPI_Call {
+	return &MockHandler_CreateScheduleAPI_Call{Call: _e.mock.On("CreateScheduleAPI", w, r)}
+}
+
+func (_c *MockHandler_CreateScheduleAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CreateScheduleAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_CreateScheduleAPI_Call) Return() *MockHandler_CreateScheduleAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_CreateScheduleAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_CreateScheduleAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeadLetterQueuesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeadLetterQueuesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DeadLetterQueuesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeadLetterQueuesHandler'
+type MockHandler_DeadLetterQueuesHandler_Call struct {
+	*mock.Call
+}
+
+// DeadLetterQueuesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DeadLetterQueuesHandler(w interface{}, r interface{}) *MockHandler_DeadLetterQueuesHandler_Call {
+	return &MockHandler_DeadLetterQueuesHandler_Call{Call: _e.mock.On("DeadLetterQueuesHandler", w, r)}
+}
+
+func (_c *MockHandler_DeadLetterQueuesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeadLetterQueuesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeadLetterQueuesHandler_Call) Return() *MockHandler_DeadLetterQueuesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DeadLetterQueuesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeadLetterQueuesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteAlertRuleHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteAlertRuleHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DeleteAlertRuleHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlertRuleHandler'
+type MockHandler_DeleteAlertRuleHandler_Call struct {
+	*mock.Call
+}
+
+// DeleteAlertRuleHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DeleteAlertRuleHandler(w interface{}, r interface{}) *MockHandler_DeleteAlertRuleHandler_Call {
+	return &MockHandler_DeleteAlertRuleHandler_Call{Call: _e.mock.On("DeleteAlertRuleHandler", w, r)}
+}
+
+func (_c *MockHandler_DeleteAlertRuleHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteAlertRuleHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteAlertRuleHandler_Call) Return() *MockHandler_DeleteAlertRuleHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DeleteAlertRuleHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteAlertRuleHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteBaselineHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteBaselineHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DeleteBaselineHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBaselineHandler'
+type MockHandler_DeleteBaselineHandler_Call struct {
+	*mock.Call
+}
+
+// DeleteBaselineHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DeleteBaselineHandler(w interface{}, r interface{}) *MockHandler_DeleteBaselineHandler_Call {
+	return &MockHandler_DeleteBaselineHandler_Call{Call: _e.mock.On("DeleteBaselineHandler", w, r)}
+}
+
+func (_c *MockHandler_DeleteBaselineHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteBaselineHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteBaselineHandler_Call) Return() *MockHandler_DeleteBaselineHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DeleteBaselineHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteBaselineHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteMessageAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteMessageAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DeleteMessageAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessageAPI'
+type MockHandler_DeleteMessageAPI_Call struct {
+	*mock.Call
+}
+
+// DeleteMessageAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DeleteMessageAPI(w interface{}, r interface{}) *MockHandler_DeleteMessageAPI_Call {
+	return &MockHandler_DeleteMessageAPI_Call{Call: _e.mock.On("DeleteMessageAPI", w, r)}
+}
+
+func (_c *MockHandler_DeleteMessageAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteMessageAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteMessageAPI_Call) Return() *MockHandler_DeleteMessageAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DeleteMessageAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteMessageAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteMessageContractAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteMessageContractAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DeleteMessageContractAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessageContractAPI'
+type MockHandler_DeleteMessageContractAPI_Call struct {
+	*mock.Call
+}
+
+// DeleteMessageContractAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DeleteMessageContractAPI(w interface{}, r interface{}) *MockHandler_DeleteMessageContractAPI_Call {
+	return &MockHandler_DeleteMessageContractAPI_Call{Call: _e.mock.On("DeleteMessageContractAPI", w, r)}
+}
+
+func (_c *MockHandler_DeleteMessageContractAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteMessageContractAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteMessageContractAPI_Call) Return() *MockHandler_DeleteMessageContractAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DeleteMessageContractAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteMessageContractAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteQueueHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteQueueHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DeleteQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueueHandler'
+type MockHandler_DeleteQueueHandler_Call struct {
+	*mock.Call
+}
+
+// DeleteQueueHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DeleteQueueHandler(w interface{}, r interface{}) *MockHandler_DeleteQueueHandler_Call {
+	return &MockHandler_DeleteQueueHandler_Call{Call: _e.mock.On("DeleteQueueHandler", w, r)}
+}
+
+func (_c *MockHandler_DeleteQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteQueueHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteQueueHandler_Call) Return() *MockHandler_DeleteQueueHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DeleteQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteQueueHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteQueueTagHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteQueueTagHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DeleteQueueTagHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueueTagHandler'
+type MockHandler_DeleteQueueTagHandler_Call struct {
+	*mock.Call
+}
+
+// DeleteQueueTagHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DeleteQueueTagHandler(w interface{}, r interface{}) *MockHandler_DeleteQueueTagHandler_Call {
+	return &MockHandler_DeleteQueueTagHandler_Call{Call: _e.mock.On("DeleteQueueTagHandler", w, r)}
+}
+
+func (_c *MockHandler_DeleteQueueTagHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteQueueTagHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteQueueTagHandler_Call) Return() *MockHandler_DeleteQueueTagHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DeleteQueueTagHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteQueueTagHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteScheduleAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteScheduleAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DeleteScheduleAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteScheduleAPI'
+type MockHandler_DeleteScheduleAPI_Call struct {
+	*mock.Call
+}
+
+// DeleteScheduleAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DeleteScheduleAPI(w interface{}, r interface{}) *MockHandler_DeleteScheduleAPI_Call {
+	return &MockHandler_DeleteScheduleAPI_Call{Call: _e.mock.On("DeleteScheduleAPI", w, r)}
+}
+
+func (_c *MockHandler_DeleteScheduleAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteScheduleAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteScheduleAPI_Call) Return() *MockHandler_DeleteScheduleAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DeleteScheduleAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteScheduleAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteScheduleHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DeleteScheduleHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteScheduleHandler'
+type MockHandler_DeleteScheduleHandler_Call struct {
+	*mock.Call
+}
+
+// DeleteScheduleHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DeleteScheduleHandler(w interface{}, r interface{}) *MockHandler_DeleteScheduleHandler_Call {
+	return &MockHandler_DeleteScheduleHandler_Call{Call: _e.mock.On("DeleteScheduleHandler", w, r)}
+}
+
+func (_c *MockHandler_DeleteScheduleHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteScheduleHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteScheduleHandler_Call) Return() *MockHandler_DeleteScheduleHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DeleteScheduleHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DeleteScheduleHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DiscardTrashedQueueHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) DiscardTrashedQueueHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DiscardTrashedQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscardTrashedQueueHandler'
+type MockHandler_DiscardTrashedQueueHandler_Call struct {
+	*mock.Call
+}
+
+// DiscardTrashedQueueHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DiscardTrashedQueueHandler(w interface{}, r interface{}) *MockHandler_DiscardTrashedQueueHandler_Call {
+	return &MockHandler_DiscardTrashedQueueHandler_Call{Call: _e.mock.On("DiscardTrashedQueueHandler", w, r)}
+}
+
+func (_c *MockHandler_DiscardTrashedQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DiscardTrashedQueueHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DiscardTrashedQueueHandler_Call) Return() *MockHandler_DiscardTrashedQueueHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DiscardTrashedQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DiscardTrashedQueueHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DrainReceiveAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) DrainReceiveAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DrainReceiveAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainReceiveAPI'
+type MockHandler_DrainReceiveAPI_Call struct {
+	*mock.Call
+}
+
+// DrainReceiveAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DrainReceiveAPI(w interface{}, r interface{}) *MockHandler_DrainReceiveAPI_Call {
+	return &MockHandler_DrainReceiveAPI_Call{Call: _e.mock.On("DrainReceiveAPI", w, r)}
+}
+
+func (_c *MockHandler_DrainReceiveAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DrainReceiveAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DrainReceiveAPI_Call) Return() *MockHandler_DrainReceiveAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DrainReceiveAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DrainReceiveAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DrainToFileHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) DrainToFileHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DrainToFileHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainToFileHandler'
+type MockHandler_DrainToFileHandler_Call struct {
+	*mock.Call
+}
+
+// DrainToFileHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DrainToFileHandler(w interface{}, r interface{}) *MockHandler_DrainToFileHandler_Call {
+	return &MockHandler_DrainToFileHandler_Call{Call: _e.mock.On("DrainToFileHandler", w, r)}
+}
+
+func (_c *MockHandler_DrainToFileHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DrainToFileHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DrainToFileHandler_Call) Return() *MockHandler_DrainToFileHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DrainToFileHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DrainToFileHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DriftHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) DriftHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_DriftHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DriftHandler'
+type MockHandler_DriftHandler_Call struct {
+	*mock.Call
+}
+
+// DriftHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) DriftHandler(w interface{}, r interface{}) *MockHandler_DriftHandler_Call {
+	return &MockHandler_DriftHandler_Call{Call: _e.mock.On("DriftHandler", w, r)}
+}
+
+func (_c *MockHandler_DriftHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DriftHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DriftHandler_Call) Return() *MockHandler_DriftHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_DriftHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_DriftHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// EventRulesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) EventRulesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_EventRulesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EventRulesHandler'
+type MockHandler_EventRulesHandler_Call struct {
+	*mock.Call
+}
+
+// EventRulesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) EventRulesHandler(w interface{}, r interface{}) *MockHandler_EventRulesHandler_Call {
+	return &MockHandler_EventRulesHandler_Call{Call: _e.mock.On("EventRulesHandler", w, r)}
+}
+
+func (_c *MockHandler_EventRulesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_EventRulesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_EventRulesHandler_Call) Return() *MockHandler_EventRulesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_EventRulesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_EventRulesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ExportQueuesCSVHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) ExportQueuesCSVHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ExportQueuesCSVHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportQueuesCSVHandler'
+type MockHandler_ExportQueuesCSVHandler_Call struct {
+	*mock.Call
+}
+
+// ExportQueuesCSVHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ExportQueuesCSVHandler(w interface{}, r interface{}) *MockHandler_ExportQueuesCSVHandler_Call {
+	return &MockHandler_ExportQueuesCSVHandler_Call{Call: _e.mock.On("ExportQueuesCSVHandler", w, r)}
+}
+
+func (_c *MockHandler_ExportQueuesCSVHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ExportQueuesCSVHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ExportQueuesCSVHandler_Call) Return() *MockHandler_ExportQueuesCSVHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ExportQueuesCSVHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ExportQueuesCSVHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ExportSettingsAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) ExportSettingsAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ExportSettingsAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportSettingsAPI'
+type MockHandler_ExportSettingsAPI_Call struct {
+	*mock.Call
+}
+
+// ExportSettingsAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ExportSettingsAPI(w interface{}, r interface{}) *MockHandler_ExportSettingsAPI_Call {
+	return &MockHandler_ExportSettingsAPI_Call{Call: _e.mock.On("ExportSettingsAPI", w, r)}
+}
+
+func (_c *MockHandler_ExportSettingsAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ExportSettingsAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ExportSettingsAPI_Call) Return() *MockHandler_ExportSettingsAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ExportSettingsAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ExportSettingsAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// FanOutMessageAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) FanOutMessageAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_FanOutMessageAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FanOutMessageAPI'
+type MockHandler_FanOutMessageAPI_Call struct {
+	*mock.Call
+}
+
+// FanOutMessageAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) FanOutMessageAPI(w interface{}, r interface{}) *MockHandler_FanOutMessageAPI_Call {
+	return &MockHandler_FanOutMessageAPI_Call{Call: _e.mock.On("FanOutMessageAPI", w, r)}
+}
+
+func (_c *MockHandler_FanOutMessageAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_FanOutMessageAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_FanOutMessageAPI_Call) Return() *MockHandler_FanOutMessageAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_FanOutMessageAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_FanOutMessageAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// FilteredPurgeHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) FilteredPurgeHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_FilteredPurgeHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilteredPurgeHandler'
+type MockHandler_FilteredPurgeHandler_Call struct {
+	*mock.Call
+}
+
+// FilteredPurgeHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) FilteredPurgeHandler(w interface{}, r interface{}) *MockHandler_FilteredPurgeHandler_Call {
+	return &MockHandler_FilteredPurgeHandler_Call{Call: _e.mock.On("FilteredPurgeHandler", w, r)}
+}
+
+func (_c *MockHandler_FilteredPurgeHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_FilteredPurgeHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_FilteredPurgeHandler_Call) Return() *MockHandler_FilteredPurgeHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_FilteredPurgeHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_FilteredPurgeHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ForwarderHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) ForwarderHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ForwarderHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForwarderHandler'
+type MockHandler_ForwarderHandler_Call struct {
+	*mock.Call
+}
+
+// ForwarderHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ForwarderHandler(w interface{}, r interface{}) *MockHandler_ForwarderHandler_Call {
+	return &MockHandler_ForwarderHandler_Call{Call: _e.mock.On("ForwarderHandler", w, r)}
+}
+
+func (_c *MockHandler_ForwarderHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ForwarderHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ForwarderHandler_Call) Return() *MockHandler_ForwarderHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ForwarderHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ForwarderHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetCreateQueueHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetCreateQueueHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_GetCreateQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreateQueueHandler'
+type MockHandler_GetCreateQueueHandler_Call struct {
+	*mock.Call
+}
+
+// GetCreateQueueHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) GetCreateQueueHandler(w interface{}, r interface{}) *MockHandler_GetCreateQueueHandler_Call {
+	return &MockHandler_GetCreateQueueHandler_Call{Call: _e.mock.On("GetCreateQueueHandler", w, r)}
+}
+
+func (_c *MockHandler_GetCreateQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_GetCreateQueueHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetCreateQueueHandler_Call) Return() *MockHandler_GetCreateQueueHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_GetCreateQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_GetCreateQueueHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetMessageContractAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetMessageContractAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_GetMessageContractAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMessageContractAPI'
+type MockHandler_GetMessageContractAPI_Call struct {
+	*mock.Call
+}
+
+// GetMessageContractAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) GetMessageContractAPI(w interface{}, r interface{}) *MockHandler_GetMessageContractAPI_Call {
+	return &MockHandler_GetMessageContractAPI_Call{Call: _e.mock.On("GetMessageContractAPI", w, r)}
+}
+
+func (_c *MockHandler_GetMessageContractAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_GetMessageContractAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetMessageContractAPI_Call) Return() *MockHandler_GetMessageContractAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_GetMessageContractAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_GetMessageContractAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetScheduleAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetScheduleAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_GetScheduleAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScheduleAPI'
+type MockHandler_GetScheduleAPI_Call struct {
+	*mock.Call
+}
+
+// GetScheduleAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) GetScheduleAPI(w interface{}, r interface{}) *MockHandler_GetScheduleAPI_Call {
+	return &MockHandler_GetScheduleAPI_Call{Call: _e.mock.On("GetScheduleAPI", w, r)}
+}
+
+func (_c *MockHandler_GetScheduleAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_GetScheduleAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetScheduleAPI_Call) Return() *MockHandler_GetScheduleAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_GetScheduleAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_GetScheduleAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ImportQueuesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) ImportQueuesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ImportQueuesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportQueuesHandler'
+type MockHandler_ImportQueuesHandler_Call struct {
+	*mock.Call
+}
+
+// ImportQueuesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ImportQueuesHandler(w interface{}, r interface{}) *MockHandler_ImportQueuesHandler_Call {
+	return &MockHandler_ImportQueuesHandler_Call{Call: _e.mock.On("ImportQueuesHandler", w, r)}
+}
+
+func (_c *MockHandler_ImportQueuesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ImportQueuesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ImportQueuesHandler_Call) Return() *MockHandler_ImportQueuesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ImportQueuesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ImportQueuesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ImportSettingsAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) ImportSettingsAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ImportSettingsAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportSettingsAPI'
+type MockHandler_ImportSettingsAPI_Call struct {
+	*mock.Call
+}
+
+// ImportSettingsAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ImportSettingsAPI(w interface{}, r interface{}) *MockHandler_ImportSettingsAPI_Call {
+	return &MockHandler_ImportSettingsAPI_Call{Call: _e.mock.On("ImportSettingsAPI", w, r)}
+}
+
+func (_c *MockHandler_ImportSettingsAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ImportSettingsAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ImportSettingsAPI_Call) Return() *MockHandler_ImportSettingsAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ImportSettingsAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ImportSettingsAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IngestAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) IngestAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_IngestAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestAPI'
+type MockHandler_IngestAPI_Call struct {
+	*mock.Call
+}
+
+// IngestAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) IngestAPI(w interface{}, r interface{}) *MockHandler_IngestAPI_Call {
+	return &MockHandler_IngestAPI_Call{Call: _e.mock.On("IngestAPI", w, r)}
+}
+
+func (_c *MockHandler_IngestAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_IngestAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_IngestAPI_Call) Return() *MockHandler_IngestAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_IngestAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_IngestAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// JobAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) JobAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_JobAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobAPI'
+type MockHandler_JobAPI_Call struct {
+	*mock.Call
+}
+
+// JobAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) JobAPI(w interface{}, r interface{}) *MockHandler_JobAPI_Call {
+	return &MockHandler_JobAPI_Call{Call: _e.mock.On("JobAPI", w, r)}
+}
+
+func (_c *MockHandler_JobAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_JobAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_JobAPI_Call) Return() *MockHandler_JobAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_JobAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_JobAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// JobFileAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) JobFileAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_JobFileAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobFileAPI'
+type MockHandler_JobFileAPI_Call struct {
+	*mock.Call
+}
+
+// JobFileAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) JobFileAPI(w interface{}, r interface{}) *MockHandler_JobFileAPI_Call {
+	return &MockHandler_JobFileAPI_Call{Call: _e.mock.On("JobFileAPI", w, r)}
+}
+
+func (_c *MockHandler_JobFileAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_JobFileAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_JobFileAPI_Call) Return() *MockHandler_JobFileAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_JobFileAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_JobFileAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListQueuesAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) ListQueuesAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ListQueuesAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueuesAPI'
+type MockHandler_ListQueuesAPI_Call struct {
+	*mock.Call
+}
+
+// ListQueuesAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ListQueuesAPI(w interface{}, r interface{}) *MockHandler_ListQueuesAPI_Call {
+	return &MockHandler_ListQueuesAPI_Call{Call: _e.mock.On("ListQueuesAPI", w, r)}
+}
+
+func (_c *MockHandler_ListQueuesAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ListQueuesAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ListQueuesAPI_Call) Return() *MockHandler_ListQueuesAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ListQueuesAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ListQueuesAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListSchedulesAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) ListSchedulesAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ListSchedulesAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchedulesAPI'
+type MockHandler_ListSchedulesAPI_Call struct {
+	*mock.Call
+}
+
+// ListSchedulesAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ListSchedulesAPI(w interface{}, r interface{}) *MockHandler_ListSchedulesAPI_Call {
+	return &MockHandler_ListSchedulesAPI_Call{Call: _e.mock.On("ListSchedulesAPI", w, r)}
+}
+
+func (_c *MockHandler_ListSchedulesAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ListSchedulesAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ListSchedulesAPI_Call) Return() *MockHandler_ListSchedulesAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ListSchedulesAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ListSchedulesAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// MessageMoveTasksAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) MessageMoveTasksAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_MessageMoveTasksAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageMoveTasksAPI'
+type MockHandler_MessageMoveTasksAPI_Call struct {
+	*mock.Call
+}
+
+// MessageMoveTasksAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) MessageMoveTasksAPI(w interface{}, r interface{}) *MockHandler_MessageMoveTasksAPI_Call {
+	return &MockHandler_MessageMoveTasksAPI_Call{Call: _e.mock.On("MessageMoveTasksAPI", w, r)}
+}
+
+func (_c *MockHandler_MessageMoveTasksAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_MessageMoveTasksAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_MessageMoveTasksAPI_Call) Return() *MockHandler_MessageMoveTasksAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_MessageMoveTasksAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_MessageMoveTasksAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// MetricsHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) MetricsHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_MetricsHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MetricsHandler'
+type MockHandler_MetricsHandler_Call struct {
+	*mock.Call
+}
+
+// MetricsHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) MetricsHandler(w interface{}, r interface{}) *MockHandler_MetricsHandler_Call {
+	return &MockHandler_MetricsHandler_Call{Call: _e.mock.On("MetricsHandler", w, r)}
+}
+
+func (_c *MockHandler_MetricsHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_MetricsHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_MetricsHandler_Call) Return() *MockHandler_MetricsHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_MetricsHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_MetricsHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostAccessPolicyHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostAccessPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostAccessPolicyHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostAccessPolicyHandler'
+type MockHandler_PostAccessPolicyHandler_Call struct {
+	*mock.Call
+}
+
+// PostAccessPolicyHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostAccessPolicyHandler(w interface{}, r interface{}) *MockHandler_PostAccessPolicyHandler_Call {
+	return &MockHandler_PostAccessPolicyHandler_Call{Call: _e.mock.On("PostAccessPolicyHandler", w, r)}
+}
+
+func (_c *MockHandler_PostAccessPolicyHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostAccessPolicyHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostAccessPolicyHandler_Call) Return() *MockHandler_PostAccessPolicyHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostAccessPolicyHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostAccessPolicyHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostAlertRuleHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostAlertRuleHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostAlertRuleHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostAlertRuleHandler'
+type MockHandler_PostAlertRuleHandler_Call struct {
+	*mock.Call
+}
+
+// PostAlertRuleHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostAlertRuleHandler(w interface{}, r interface{}) *MockHandler_PostAlertRuleHandler_Call {
+	return &MockHandler_PostAlertRuleHandler_Call{Call: _e.mock.On("PostAlertRuleHandler", w, r)}
+}
+
+func (_c *MockHandler_PostAlertRuleHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostAlertRuleHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostAlertRuleHandler_Call) Return() *MockHandler_PostAlertRuleHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostAlertRuleHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostAlertRuleHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostAttributeCountHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostAttributeCountHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostAttributeCountHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostAttributeCountHandler'
+type MockHandler_PostAttributeCountHandler_Call struct {
+	*mock.Call
+}
+
+// PostAttributeCountHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostAttributeCountHandler(w interface{}, r interface{}) *MockHandler_PostAttributeCountHandler_Call {
+	return &MockHandler_PostAttributeCountHandler_Call{Call: _e.mock.On("PostAttributeCountHandler", w, r)}
+}
+
+func (_c *MockHandler_PostAttributeCountHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostAttributeCountHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostAttributeCountHandler_Call) Return() *MockHandler_PostAttributeCountHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostAttributeCountHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostAttributeCountHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostBulkQueuesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostBulkQueuesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostBulkQueuesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostBulkQueuesHandler'
+type MockHandler_PostBulkQueuesHandler_Call struct {
+	*mock.Call
+}
+
+// PostBulkQueuesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostBulkQueuesHandler(w interface{}, r interface{}) *MockHandler_PostBulkQueuesHandler_Call {
+	return &MockHandler_PostBulkQueuesHandler_Call{Call: _e.mock.On("PostBulkQueuesHandler", w, r)}
+}
+
+func (_c *MockHandler_PostBulkQueuesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostBulkQueuesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostBulkQueuesHandler_Call) Return() *MockHandler_PostBulkQueuesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostBulkQueuesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostBulkQueuesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostConsumerSimulatorHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostConsumerSimulatorHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostConsumerSimulatorHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostConsumerSimulatorHandler'
+type MockHandler_PostConsumerSimulatorHandler_Call struct {
+	*mock.Call
+}
+
+// PostConsumerSimulatorHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostConsumerSimulatorHandler(w interface{}, r interface{}) *MockHandler_PostConsumerSimulatorHandler_Call {
+	return &MockHandler_PostConsumerSimulatorHandler_Call{Call: _e.mock.On("PostConsumerSimulatorHandler", w, r)}
+}
+
+func (_c *MockHandler_PostConsumerSimulatorHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostConsumerSimulatorHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostConsumerSimulatorHandler_Call) Return() *MockHandler_PostConsumerSimulatorHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostConsumerSimulatorHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostConsumerSimulatorHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostCreateQueueHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostCreateQueueHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostCreateQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostCreateQueueHandler'
+type MockHandler_PostCreateQueueHandler_Call struct {
+	*mock.Call
+}
+
+// PostCreateQueueHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostCreateQueueHandler(w interface{}, r interface{}) *MockHandler_PostCreateQueueHandler_Call {
+	return &MockHandler_PostCreateQueueHandler_Call{Call: _e.mock.On("PostCreateQueueHandler", w, r)}
+}
+
+func (_c *MockHandler_PostCreateQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostCreateQueueHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostCreateQueueHandler_Call) Return() *MockHandler_PostCreateQueueHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostCreateQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostCreateQueueHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostDeleteMessageFormHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostDeleteMessageFormHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostDeleteMessageFormHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostDeleteMessageFormHandler'
+type MockHandler_PostDeleteMessageFormHandler_Call struct {
+	*mock.Call
+}
+
+// PostDeleteMessageFormHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostDeleteMessageFormHandler(w interface{}, r interface{}) *MockHandler_PostDeleteMessageFormHandler_Call {
+	return &MockHandler_PostDeleteMessageFormHandler_Call{Call: _e.mock.On("PostDeleteMessageFormHandler", w, r)}
+}
+
+func (_c *MockHandler_PostDeleteMessageFormHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostDeleteMessageFormHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostDeleteMessageFormHandler_Call) Return() *MockHandler_PostDeleteMessageFormHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostDeleteMessageFormHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostDeleteMessageFormHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostDrainToFileHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostDrainToFileHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostDrainToFileHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostDrainToFileHandler'
+type MockHandler_PostDrainToFileHandler_Call struct {
+	*mock.Call
+}
+
+// PostDrainToFileHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostDrainToFileHandler(w interface{}, r interface{}) *MockHandler_PostDrainToFileHandler_Call {
+	return &MockHandler_PostDrainToFileHandler_Call{Call: _e.mock.On("PostDrainToFileHandler", w, r)}
+}
+
+func (_c *MockHandler_PostDrainToFileHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostDrainToFileHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostDrainToFileHandler_Call) Return() *MockHandler_PostDrainToFileHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostDrainToFileHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostDrainToFileHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostDriftCheckHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostDriftCheckHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostDriftCheckHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostDriftCheckHandler'
+type MockHandler_PostDriftCheckHandler_Call struct {
+	*mock.Call
+}
+
+// PostDriftCheckHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostDriftCheckHandler(w interface{}, r interface{}) *MockHandler_PostDriftCheckHandler_Call {
+	return &MockHandler_PostDriftCheckHandler_Call{Call: _e.mock.On("PostDriftCheckHandler", w, r)}
+}
+
+func (_c *MockHandler_PostDriftCheckHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostDriftCheckHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostDriftCheckHandler_Call) Return() *MockHandler_PostDriftCheckHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostDriftCheckHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostDriftCheckHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostEventRulesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostEventRulesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostEventRulesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostEventRulesHandler'
+type MockHandler_PostEventRulesHandler_Call struct {
+	*mock.Call
+}
+
+// PostEventRulesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostEventRulesHandler(w interface{}, r interface{}) *MockHandler_PostEventRulesHandler_Call {
+	return &MockHandler_PostEventRulesHandler_Call{Call: _e.mock.On("PostEventRulesHandler", w, r)}
+}
+
+func (_c *MockHandler_PostEventRulesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostEventRulesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostEventRulesHandler_Call) Return() *MockHandler_PostEventRulesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostEventRulesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostEventRulesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostFavoriteQueueHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostFavoriteQueueHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostFavoriteQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostFavoriteQueueHandler'
+type MockHandler_PostFavoriteQueueHandler_Call struct {
+	*mock.Call
+}
+
+// PostFavoriteQueueHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostFavoriteQueueHandler(w interface{}, r interface{}) *MockHandler_PostFavoriteQueueHandler_Call {
+	return &MockHandler_PostFavoriteQueueHandler_Call{Call: _e.mock.On("PostFavoriteQueueHandler", w, r)}
+}
+
+func (_c *MockHandler_PostFavoriteQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostFavoriteQueueHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostFavoriteQueueHandler_Call) Return() *MockHandler_PostFavoriteQueueHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostFavoriteQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostFavoriteQueueHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostFifoThroughputHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostFifoThroughputHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostFifoThroughputHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostFifoThroughputHandler'
+type MockHandler_PostFifoThroughputHandler_Call struct {
+	*mock.Call
+}
+
+// PostFifoThroughputHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostFifoThroughputHandler(w interface{}, r interface{}) *MockHandler_PostFifoThroughputHandler_Call {
+	return &MockHandler_PostFifoThroughputHandler_Call{Call: _e.mock.On("PostFifoThroughputHandler", w, r)}
+}
+
+func (_c *MockHandler_PostFifoThroughputHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostFifoThroughputHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostFifoThroughputHandler_Call) Return() *MockHandler_PostFifoThroughputHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostFifoThroughputHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostFifoThroughputHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostFilteredPurgeHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostFilteredPurgeHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostFilteredPurgeHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostFilteredPurgeHandler'
+type MockHandler_PostFilteredPurgeHandler_Call struct {
+	*mock.Call
+}
+
+// PostFilteredPurgeHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostFilteredPurgeHandler(w interface{}, r interface{}) *MockHandler_PostFilteredPurgeHandler_Call {
+	return &MockHandler_PostFilteredPurgeHandler_Call{Call: _e.mock.On("PostFilteredPurgeHandler", w, r)}
+}
+
+func (_c *MockHandler_PostFilteredPurgeHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostFilteredPurgeHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostFilteredPurgeHandler_Call) Return() *MockHandler_PostFilteredPurgeHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostFilteredPurgeHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostFilteredPurgeHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostForwarderHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostForwarderHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostForwarderHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostForwarderHandler'
+type MockHandler_PostForwarderHandler_Call struct {
+	*mock.Call
+}
+
+// PostForwarderHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostForwarderHandler(w interface{}, r interface{}) *MockHandler_PostForwarderHandler_Call {
+	return &MockHandler_PostForwarderHandler_Call{Call: _e.mock.On("PostForwarderHandler", w, r)}
+}
+
+func (_c *MockHandler_PostForwarderHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostForwarderHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostForwarderHandler_Call) Return() *MockHandler_PostForwarderHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostForwarderHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostForwarderHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostImportQueuesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostImportQueuesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostImportQueuesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostImportQueuesHandler'
+type MockHandler_PostImportQueuesHandler_Call struct {
+	*mock.Call
+}
+
+// PostImportQueuesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostImportQueuesHandler(w interface{}, r interface{}) *MockHandler_PostImportQueuesHandler_Call {
+	return &MockHandler_PostImportQueuesHandler_Call{Call: _e.mock.On("PostImportQueuesHandler", w, r)}
+}
+
+func (_c *MockHandler_PostImportQueuesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostImportQueuesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostImportQueuesHandler_Call) Return() *MockHandler_PostImportQueuesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostImportQueuesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostImportQueuesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostProducerBenchmarkHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostProducerBenchmarkHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostProducerBenchmarkHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostProducerBenchmarkHandler'
+type MockHandler_PostProducerBenchmarkHandler_Call struct {
+	*mock.Call
+}
+
+// PostProducerBenchmarkHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostProducerBenchmarkHandler(w interface{}, r interface{}) *MockHandler_PostProducerBenchmarkHandler_Call {
+	return &MockHandler_PostProducerBenchmarkHandler_Call{Call: _e.mock.On("PostProducerBenchmarkHandler", w, r)}
+}
+
+func (_c *MockHandler_PostProducerBenchmarkHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostProducerBenchmarkHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostProducerBenchmarkHandler_Call) Return() *MockHandler_PostProducerBenchmarkHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostProducerBenchmarkHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostProducerBenchmarkHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostQueueColumnsHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostQueueColumnsHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostQueueColumnsHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostQueueColumnsHandler'
+type MockHandler_PostQueueColumnsHandler_Call struct {
+	*mock.Call
+}
+
+// PostQueueColumnsHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostQueueColumnsHandler(w interface{}, r interface{}) *MockHandler_PostQueueColumnsHandler_Call {
+	return &MockHandler_PostQueueColumnsHandler_Call{Call: _e.mock.On("PostQueueColumnsHandler", w, r)}
+}
+
+func (_c *MockHandler_PostQueueColumnsHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostQueueColumnsHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostQueueColumnsHandler_Call) Return() *MockHandler_PostQueueColumnsHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostQueueColumnsHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostQueueColumnsHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostQueueMigrationHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostQueueMigrationHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostQueueMigrationHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostQueueMigrationHandler'
+type MockHandler_PostQueueMigrationHandler_Call struct {
+	*mock.Call
+}
+
+// PostQueueMigrationHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostQueueMigrationHandler(w interface{}, r interface{}) *MockHandler_PostQueueMigrationHandler_Call {
+	return &MockHandler_PostQueueMigrationHandler_Call{Call: _e.mock.On("PostQueueMigrationHandler", w, r)}
+}
+
+func (_c *MockHandler_PostQueueMigrationHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostQueueMigrationHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostQueueMigrationHandler_Call) Return() *MockHandler_PostQueueMigrationHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostQueueMigrationHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostQueueMigrationHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostQueueTagHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostQueueTagHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostQueueTagHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostQueueTagHandler'
+type MockHandler_PostQueueTagHandler_Call struct {
+	*mock.Call
+}
+
+// PostQueueTagHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostQueueTagHandler(w interface{}, r interface{}) *MockHandler_PostQueueTagHandler_Call {
+	return &MockHandler_PostQueueTagHandler_Call{Call: _e.mock.On("PostQueueTagHandler", w, r)}
+}
+
+func (_c *MockHandler_PostQueueTagHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostQueueTagHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostQueueTagHandler_Call) Return() *MockHandler_PostQueueTagHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostQueueTagHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostQueueTagHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostReceiveMessagesFormHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostReceiveMessagesFormHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostReceiveMessagesFormHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostReceiveMessagesFormHandler'
+type MockHandler_PostReceiveMessagesFormHandler_Call struct {
+	*mock.Call
+}
+
+// PostReceiveMessagesFormHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostReceiveMessagesFormHandler(w interface{}, r interface{}) *MockHandler_PostReceiveMessagesFormHandler_Call {
+	return &MockHandler_PostReceiveMessagesFormHandler_Call{Call: _e.mock.On("PostReceiveMessagesFormHandler", w, r)}
+}
+
+func (_c *MockHandler_PostReceiveMessagesFormHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostReceiveMessagesFormHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostReceiveMessagesFormHandler_Call) Return() *MockHandler_PostReceiveMessagesFormHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostReceiveMessagesFormHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostReceiveMessagesFormHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostRedrivePolicyHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostRedrivePolicyHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostRedrivePolicyHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostRedrivePolicyHandler'
+type MockHandler_PostRedrivePolicyHandler_Call struct {
+	*mock.Call
+}
+
+// PostRedrivePolicyHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostRedrivePolicyHandler(w interface{}, r interface{}) *MockHandler_PostRedrivePolicyHandler_Call {
+	return &MockHandler_PostRedrivePolicyHandler_Call{Call: _e.mock.On("PostRedrivePolicyHandler", w, r)}
+}
+
+func (_c *MockHandler_PostRedrivePolicyHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostRedrivePolicyHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostRedrivePolicyHandler_Call) Return() *MockHandler_PostRedrivePolicyHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostRedrivePolicyHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostRedrivePolicyHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostRestoreFileHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostRestoreFileHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostRestoreFileHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostRestoreFileHandler'
+type MockHandler_PostRestoreFileHandler_Call struct {
+	*mock.Call
+}
+
+// PostRestoreFileHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostRestoreFileHandler(w interface{}, r interface{}) *MockHandler_PostRestoreFileHandler_Call {
+	return &MockHandler_PostRestoreFileHandler_Call{Call: _e.mock.On("PostRestoreFileHandler", w, r)}
+}
+
+func (_c *MockHandler_PostRestoreFileHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostRestoreFileHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostRestoreFileHandler_Call) Return() *MockHandler_PostRestoreFileHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostRestoreFileHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostRestoreFileHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostScheduleHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostScheduleHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostScheduleHandler'
+type MockHandler_PostScheduleHandler_Call struct {
+	*mock.Call
+}
+
+// PostScheduleHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostScheduleHandler(w interface{}, r interface{}) *MockHandler_PostScheduleHandler_Call {
+	return &MockHandler_PostScheduleHandler_Call{Call: _e.mock.On("PostScheduleHandler", w, r)}
+}
+
+func (_c *MockHandler_PostScheduleHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostScheduleHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostScheduleHandler_Call) Return() *MockHandler_PostScheduleHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostScheduleHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostScheduleHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostSendMessageFormHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostSendMessageFormHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostSendMessageFormHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostSendMessageFormHandler'
+type MockHandler_PostSendMessageFormHandler_Call struct {
+	*mock.Call
+}
+
+// PostSendMessageFormHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostSendMessageFormHandler(w interface{}, r interface{}) *MockHandler_PostSendMessageFormHandler_Call {
+	return &MockHandler_PostSendMessageFormHandler_Call{Call: _e.mock.On("PostSendMessageFormHandler", w, r)}
+}
+
+func (_c *MockHandler_PostSendMessageFormHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostSendMessageFormHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostSendMessageFormHandler_Call) Return() *MockHandler_PostSendMessageFormHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostSendMessageFormHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostSendMessageFormHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PostSnsPublishHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PostSnsPublishHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PostSnsPublishHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostSnsPublishHandler'
+type MockHandler_PostSnsPublishHandler_Call struct {
+	*mock.Call
+}
+
+// PostSnsPublishHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PostSnsPublishHandler(w interface{}, r interface{}) *MockHandler_PostSnsPublishHandler_Call {
+	return &MockHandler_PostSnsPublishHandler_Call{Call: _e.mock.On("PostSnsPublishHandler", w, r)}
+}
+
+func (_c *MockHandler_PostSnsPublishHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostSnsPublishHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PostSnsPublishHandler_Call) Return() *MockHandler_PostSnsPublishHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PostSnsPublishHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PostSnsPublishHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ProbeLatencyAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) ProbeLatencyAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ProbeLatencyAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProbeLatencyAPI'
+type MockHandler_ProbeLatencyAPI_Call struct {
+	*mock.Call
+}
+
+// ProbeLatencyAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ProbeLatencyAPI(w interface{}, r interface{}) *MockHandler_ProbeLatencyAPI_Call {
+	return &MockHandler_ProbeLatencyAPI_Call{Call: _e.mock.On("ProbeLatencyAPI", w, r)}
+}
+
+func (_c *MockHandler_ProbeLatencyAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ProbeLatencyAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ProbeLatencyAPI_Call) Return() *MockHandler_ProbeLatencyAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ProbeLatencyAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ProbeLatencyAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ProducerBenchmarkHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) ProducerBenchmarkHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ProducerBenchmarkHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProducerBenchmarkHandler'
+type MockHandler_ProducerBenchmarkHandler_Call struct {
+	*mock.Call
+}
+
+// ProducerBenchmarkHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ProducerBenchmarkHandler(w interface{}, r interface{}) *MockHandler_ProducerBenchmarkHandler_Call {
+	return &MockHandler_ProducerBenchmarkHandler_Call{Call: _e.mock.On("ProducerBenchmarkHandler", w, r)}
+}
+
+func (_c *MockHandler_ProducerBenchmarkHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ProducerBenchmarkHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ProducerBenchmarkHandler_Call) Return() *MockHandler_ProducerBenchmarkHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ProducerBenchmarkHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ProducerBenchmarkHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PurgeQueueHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) PurgeQueueHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PurgeQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeQueueHandler'
+type MockHandler_PurgeQueueHandler_Call struct {
+	*mock.Call
+}
+
+// PurgeQueueHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PurgeQueueHandler(w interface{}, r interface{}) *MockHandler_PurgeQueueHandler_Call {
+	return &MockHandler_PurgeQueueHandler_Call{Call: _e.mock.On("PurgeQueueHandler", w, r)}
+}
+
+func (_c *MockHandler_PurgeQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PurgeQueueHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PurgeQueueHandler_Call) Return() *MockHandler_PurgeQueueHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PurgeQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PurgeQueueHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PutMessageContractAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) PutMessageContractAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_PutMessageContractAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutMessageContractAPI'
+type MockHandler_PutMessageContractAPI_Call struct {
+	*mock.Call
+}
+
+// PutMessageContractAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) PutMessageContractAPI(w interface{}, r interface{}) *MockHandler_PutMessageContractAPI_Call {
+	return &MockHandler_PutMessageContractAPI_Call{Call: _e.mock.On("PutMessageContractAPI", w, r)}
+}
+
+func (_c *MockHandler_PutMessageContractAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PutMessageContractAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PutMessageContractAPI_Call) Return() *MockHandler_PutMessageContractAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_PutMessageContractAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PutMessageContractAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// QueueAnalysisHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) QueueAnalysisHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_QueueAnalysisHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueAnalysisHandler'
+type MockHandler_QueueAnalysisHandler_Call struct {
+	*mock.Call
+}
+
+// QueueAnalysisHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) QueueAnalysisHandler(w interface{}, r interface{}) *MockHandler_QueueAnalysisHandler_Call {
+	return &MockHandler_QueueAnalysisHandler_Call{Call: _e.mock.On("QueueAnalysisHandler", w, r)}
+}
+
+func (_c *MockHandler_QueueAnalysisHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueAnalysisHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_QueueAnalysisHandler_Call) Return() *MockHandler_QueueAnalysisHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_QueueAnalysisHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueAnalysisHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// QueueDefinitionHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) QueueDefinitionHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_QueueDefinitionHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueDefinitionHandler'
+type MockHandler_QueueDefinitionHandler_Call struct {
+	*mock.Call
+}
+
+// QueueDefinitionHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) QueueDefinitionHandler(w interface{}, r interface{}) *MockHandler_QueueDefinitionHandler_Call {
+	return &MockHandler_QueueDefinitionHandler_Call{Call: _e.mock.On("QueueDefinitionHandler", w, r)}
+}
+
+func (_c *MockHandler_QueueDefinitionHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueDefinitionHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_QueueDefinitionHandler_Call) Return() *MockHandler_QueueDefinitionHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_QueueDefinitionHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueDefinitionHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// QueueHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) QueueHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_QueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueHandler'
+type MockHandler_QueueHandler_Call struct {
+	*mock.Call
+}
+
+// QueueHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) QueueHandler(w interface{}, r interface{}) *MockHandler_QueueHandler_Call {
+	return &MockHandler_QueueHandler_Call{Call: _e.mock.On("QueueHandler", w, r)}
+}
+
+func (_c *MockHandler_QueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_QueueHandler_Call) Return() *MockHandler_QueueHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_QueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// QueueLiveStatsAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) QueueLiveStatsAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_QueueLiveStatsAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueLiveStatsAPI'
+type MockHandler_QueueLiveStatsAPI_Call struct {
+	*mock.Call
+}
+
+// QueueLiveStatsAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) QueueLiveStatsAPI(w interface{}, r interface{}) *MockHandler_QueueLiveStatsAPI_Call {
+	return &MockHandler_QueueLiveStatsAPI_Call{Call: _e.mock.On("QueueLiveStatsAPI", w, r)}
+}
+
+func (_c *MockHandler_QueueLiveStatsAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueLiveStatsAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_QueueLiveStatsAPI_Call) Return() *MockHandler_QueueLiveStatsAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_QueueLiveStatsAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueLiveStatsAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// QueueMigrationHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) QueueMigrationHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_QueueMigrationHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueMigrationHandler'
+type MockHandler_QueueMigrationHandler_Call struct {
+	*mock.Call
+}
+
+// QueueMigrationHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) QueueMigrationHandler(w interface{}, r interface{}) *MockHandler_QueueMigrationHandler_Call {
+	return &MockHandler_QueueMigrationHandler_Call{Call: _e.mock.On("QueueMigrationHandler", w, r)}
+}
+
+func (_c *MockHandler_QueueMigrationHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueMigrationHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_QueueMigrationHandler_Call) Return() *MockHandler_QueueMigrationHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_QueueMigrationHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueMigrationHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// QueueReportHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) QueueReportHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_QueueReportHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueReportHandler'
+type MockHandler_QueueReportHandler_Call struct {
+	*mock.Call
+}
+
+// QueueReportHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) QueueReportHandler(w interface{}, r interface{}) *MockHandler_QueueReportHandler_Call {
+	return &MockHandler_QueueReportHandler_Call{Call: _e.mock.On("QueueReportHandler", w, r)}
+}
+
+func (_c *MockHandler_QueueReportHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueReportHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_QueueReportHandler_Call) Return() *MockHandler_QueueReportHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_QueueReportHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueReportHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// QueueStatsAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) QueueStatsAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_QueueStatsAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueStatsAPI'
+type MockHandler_QueueStatsAPI_Call struct {
+	*mock.Call
+}
+
+// QueueStatsAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) QueueStatsAPI(w interface{}, r interface{}) *MockHandler_QueueStatsAPI_Call {
+	return &MockHandler_QueueStatsAPI_Call{Call: _e.mock.On("QueueStatsAPI", w, r)}
+}
+
+func (_c *MockHandler_QueueStatsAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueStatsAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_QueueStatsAPI_Call) Return() *MockHandler_QueueStatsAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_QueueStatsAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueStatsAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// QueuesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) QueuesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_QueuesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueuesHandler'
+type MockHandler_QueuesHandler_Call struct {
+	*mock.Call
+}
+
+// QueuesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) QueuesHandler(w interface{}, r interface{}) *MockHandler_QueuesHandler_Call {
+	return &MockHandler_QueuesHandler_Call{Call: _e.mock.On("QueuesHandler", w, r)}
+}
+
+func (_c *MockHandler_QueuesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueuesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_QueuesHandler_Call) Return() *MockHandler_QueuesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_QueuesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueuesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ReceiveMergedMessagesAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) ReceiveMergedMessagesAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ReceiveMergedMessagesAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMergedMessagesAPI'
+type MockHandler_ReceiveMergedMessagesAPI_Call struct {
+	*mock.Call
+}
+
+// ReceiveMergedMessagesAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ReceiveMergedMessagesAPI(w interface{}, r interface{}) *MockHandler_ReceiveMergedMessagesAPI_Call {
+	return &MockHandler_ReceiveMergedMessagesAPI_Call{Call: _e.mock.On("ReceiveMergedMessagesAPI", w, r)}
+}
+
+func (_c *MockHandler_ReceiveMergedMessagesAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ReceiveMergedMessagesAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ReceiveMergedMessagesAPI_Call) Return() *MockHandler_ReceiveMergedMessagesAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ReceiveMergedMessagesAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ReceiveMergedMessagesAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ReceiveMessagesAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) ReceiveMessagesAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ReceiveMessagesAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMessagesAPI'
+type MockHandler_ReceiveMessagesAPI_Call struct {
+	*mock.Call
+}
+
+// ReceiveMessagesAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ReceiveMessagesAPI(w interface{}, r interface{}) *MockHandler_ReceiveMessagesAPI_Call {
+	return &MockHandler_ReceiveMessagesAPI_Call{Call: _e.mock.On("ReceiveMessagesAPI", w, r)}
+}
+
+func (_c *MockHandler_ReceiveMessagesAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ReceiveMessagesAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ReceiveMessagesAPI_Call) Return() *MockHandler_ReceiveMessagesAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ReceiveMessagesAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ReceiveMessagesAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RedriveMessagesAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) RedriveMessagesAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_RedriveMessagesAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedriveMessagesAPI'
+type MockHandler_RedriveMessagesAPI_Call struct {
+	*mock.Call
+}
+
+// RedriveMessagesAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) RedriveMessagesAPI(w interface{}, r interface{}) *MockHandler_RedriveMessagesAPI_Call {
+	return &MockHandler_RedriveMessagesAPI_Call{Call: _e.mock.On("RedriveMessagesAPI", w, r)}
+}
+
+func (_c *MockHandler_RedriveMessagesAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_RedriveMessagesAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_RedriveMessagesAPI_Call) Return() *MockHandler_RedriveMessagesAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_RedriveMessagesAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_RedriveMessagesAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RestoreFileHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) RestoreFileHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_RestoreFileHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreFileHandler'
+type MockHandler_RestoreFileHandler_Call struct {
+	*mock.Call
+}
+
+// RestoreFileHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) RestoreFileHandler(w interface{}, r interface{}) *MockHandler_RestoreFileHandler_Call {
+	return &MockHandler_RestoreFileHandler_Call{Call: _e.mock.On("RestoreFileHandler", w, r)}
+}
+
+func (_c *MockHandler_RestoreFileHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_RestoreFileHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_RestoreFileHandler_Call) Return() *MockHandler_RestoreFileHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_RestoreFileHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_RestoreFileHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RestoreQueueHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) RestoreQueueHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_RestoreQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreQueueHandler'
+type MockHandler_RestoreQueueHandler_Call struct {
+	*mock.Call
+}
+
+// RestoreQueueHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) RestoreQueueHandler(w interface{}, r interface{}) *MockHandler_RestoreQueueHandler_Call {
+	return &MockHandler_RestoreQueueHandler_Call{Call: _e.mock.On("RestoreQueueHandler", w, r)}
+}
+
+func (_c *MockHandler_RestoreQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_RestoreQueueHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_RestoreQueueHandler_Call) Return() *MockHandler_RestoreQueueHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_RestoreQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_RestoreQueueHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SaveBaselineHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SaveBaselineHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SaveBaselineHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBaselineHandler'
+type MockHandler_SaveBaselineHandler_Call struct {
+	*mock.Call
+}
+
+// SaveBaselineHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SaveBaselineHandler(w interface{}, r interface{}) *MockHandler_SaveBaselineHandler_Call {
+	return &MockHandler_SaveBaselineHandler_Call{Call: _e.mock.On("SaveBaselineHandler", w, r)}
+}
+
+func (_c *MockHandler_SaveBaselineHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SaveBaselineHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SaveBaselineHandler_Call) Return() *MockHandler_SaveBaselineHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SaveBaselineHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SaveBaselineHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SaveDraftAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) SaveDraftAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SaveDraftAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDraftAPI'
+type MockHandler_SaveDraftAPI_Call struct {
+	*mock.Call
+}
+
+// SaveDraftAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SaveDraftAPI(w interface{}, r interface{}) *MockHandler_SaveDraftAPI_Call {
+	return &MockHandler_SaveDraftAPI_Call{Call: _e.mock.On("SaveDraftAPI", w, r)}
+}
+
+func (_c *MockHandler_SaveDraftAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SaveDraftAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SaveDraftAPI_Call) Return() *MockHandler_SaveDraftAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SaveDraftAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SaveDraftAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SchedulesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SchedulesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SchedulesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchedulesHandler'
+type MockHandler_SchedulesHandler_Call struct {
+	*mock.Call
+}
+
+// SchedulesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SchedulesHandler(w interface{}, r interface{}) *MockHandler_SchedulesHandler_Call {
+	return &MockHandler_SchedulesHandler_Call{Call: _e.mock.On("SchedulesHandler", w, r)}
+}
+
+func (_c *MockHandler_SchedulesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SchedulesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SchedulesHandler_Call) Return() *MockHandler_SchedulesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SchedulesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SchedulesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SearchAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) SearchAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SearchAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAPI'
+type MockHandler_SearchAPI_Call struct {
+	*mock.Call
+}
+
+// SearchAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SearchAPI(w interface{}, r interface{}) *MockHandler_SearchAPI_Call {
+	return &MockHandler_SearchAPI_Call{Call: _e.mock.On("SearchAPI", w, r)}
+}
+
+func (_c *MockHandler_SearchAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SearchAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SearchAPI_Call) Return() *MockHandler_SearchAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SearchAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SearchAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SearchHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SearchHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SearchHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchHandler'
+type MockHandler_SearchHandler_Call struct {
+	*mock.Call
+}
+
+// SearchHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SearchHandler(w interface{}, r interface{}) *MockHandler_SearchHandler_Call {
+	return &MockHandler_SearchHandler_Call{Call: _e.mock.On("SearchHandler", w, r)}
+}
+
+func (_c *MockHandler_SearchHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SearchHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SearchHandler_Call) Return() *MockHandler_SearchHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SearchHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SearchHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SearchMessagesAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) SearchMessagesAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SearchMessagesAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMessagesAPI'
+type MockHandler_SearchMessagesAPI_Call struct {
+	*mock.Call
+}
+
+// SearchMessagesAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SearchMessagesAPI(w interface{}, r interface{}) *MockHandler_SearchMessagesAPI_Call {
+	return &MockHandler_SearchMessagesAPI_Call{Call: _e.mock.On("SearchMessagesAPI", w, r)}
+}
+
+func (_c *MockHandler_SearchMessagesAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SearchMessagesAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SearchMessagesAPI_Call) Return() *MockHandler_SearchMessagesAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SearchMessagesAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SearchMessagesAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SendMessageAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) SendMessageAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SendMessageAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessageAPI'
+type MockHandler_SendMessageAPI_Call struct {
+	*mock.Call
+}
+
+// SendMessageAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SendMessageAPI(w interface{}, r interface{}) *MockHandler_SendMessageAPI_Call {
+	return &MockHandler_SendMessageAPI_Call{Call: _e.mock.On("SendMessageAPI", w, r)}
+}
+
+func (_c *MockHandler_SendMessageAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendMessageAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SendMessageAPI_Call) Return() *MockHandler_SendMessageAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SendMessageAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendMessageAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SendMessageBatchAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) SendMessageBatchAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SendMessageBatchAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessageBatchAPI'
+type MockHandler_SendMessageBatchAPI_Call struct {
+	*mock.Call
+}
+
+// SendMessageBatchAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SendMessageBatchAPI(w interface{}, r interface{}) *MockHandler_SendMessageBatchAPI_Call {
+	return &MockHandler_SendMessageBatchAPI_Call{Call: _e.mock.On("SendMessageBatchAPI", w, r)}
+}
+
+func (_c *MockHandler_SendMessageBatchAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendMessageBatchAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SendMessageBatchAPI_Call) Return() *MockHandler_SendMessageBatchAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SendMessageBatchAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendMessageBatchAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SendReceive provides a mock function for the type MockHandler
+func (_mock *MockHandler) SendReceive(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SendReceive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendReceive'
+type MockHandler_SendReceive_Call struct {
+	*mock.Call
+}
+
+// SendReceive is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SendReceive(w interface{}, r interface{}) *MockHandler_SendReceive_Call {
+	return &MockHandler_SendReceive_Call{Call: _e.mock.On("SendReceive", w, r)}
+}
+
+func (_c *MockHandler_SendReceive_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendReceive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SendReceive_Call) Return() *MockHandler_SendReceive_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SendReceive_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendReceive_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SilenceAlertRuleHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SilenceAlertRuleHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SilenceAlertRuleHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SilenceAlertRuleHandler'
+type MockHandler_SilenceAlertRuleHandler_Call struct {
+	*mock.Call
+}
+
+// SilenceAlertRuleHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SilenceAlertRuleHandler(w interface{}, r interface{}) *MockHandler_SilenceAlertRuleHandler_Call {
+	return &MockHandler_SilenceAlertRuleHandler_Call{Call: _e.mock.On("SilenceAlertRuleHandler", w, r)}
+}
+
+func (_c *MockHandler_SilenceAlertRuleHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SilenceAlertRuleHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SilenceAlertRuleHandler_Call) Return() *MockHandler_SilenceAlertRuleHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SilenceAlertRuleHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SilenceAlertRuleHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SlackCommandHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SlackCommandHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SlackCommandHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SlackCommandHandler'
+type MockHandler_SlackCommandHandler_Call struct {
+	*mock.Call
+}
+
+// SlackCommandHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SlackCommandHandler(w interface{}, r interface{}) *MockHandler_SlackCommandHandler_Call {
+	return &MockHandler_SlackCommandHandler_Call{Call: _e.mock.On("SlackCommandHandler", w, r)}
+}
+
+func (_c *MockHandler_SlackCommandHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SlackCommandHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SlackCommandHandler_Call) Return() *MockHandler_SlackCommandHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SlackCommandHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SlackCommandHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SnsPublishHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SnsPublishHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SnsPublishHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnsPublishHandler'
+type MockHandler_SnsPublishHandler_Call struct {
+	*mock.Call
+}
+
+// SnsPublishHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SnsPublishHandler(w interface{}, r interface{}) *MockHandler_SnsPublishHandler_Call {
+	return &MockHandler_SnsPublishHandler_Call{Call: _e.mock.On("SnsPublishHandler", w, r)}
+}
+
+func (_c *MockHandler_SnsPublishHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SnsPublishHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SnsPublishHandler_Call) Return() *MockHandler_SnsPublishHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SnsPublishHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SnsPublishHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// StartPurgeAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) StartPurgeAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_StartPurgeAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartPurgeAPI'
+type MockHandler_StartPurgeAPI_Call struct {
+	*mock.Call
+}
+
+// StartPurgeAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) StartPurgeAPI(w interface{}, r interface{}) *MockHandler_StartPurgeAPI_Call {
+	return &MockHandler_StartPurgeAPI_Call{Call: _e.mock.On("StartPurgeAPI", w, r)}
+}
+
+func (_c *MockHandler_StartPurgeAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_StartPurgeAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_StartPurgeAPI_Call) Return() *MockHandler_StartPurgeAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_StartPurgeAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_StartPurgeAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// StatusHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) StatusHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_StatusHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StatusHandler'
+type MockHandler_StatusHandler_Call struct {
+	*mock.Call
+}
+
+// StatusHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) StatusHandler(w interface{}, r interface{}) *MockHandler_StatusHandler_Call {
+	return &MockHandler_StatusHandler_Call{Call: _e.mock.On("StatusHandler", w, r)}
+}
+
+func (_c *MockHandler_StatusHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_StatusHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_StatusHandler_Call) Return() *MockHandler_StatusHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_StatusHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_StatusHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SubscribePushAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) SubscribePushAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_SubscribePushAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribePushAPI'
+type MockHandler_SubscribePushAPI_Call struct {
+	*mock.Call
+}
+
+// SubscribePushAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) SubscribePushAPI(w interface{}, r interface{}) *MockHandler_SubscribePushAPI_Call {
+	return &MockHandler_SubscribePushAPI_Call{Call: _e.mock.On("SubscribePushAPI", w, r)}
+}
+
+func (_c *MockHandler_SubscribePushAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SubscribePushAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SubscribePushAPI_Call) Return() *MockHandler_SubscribePushAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_SubscribePushAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SubscribePushAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ToggleScheduleHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) ToggleScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_ToggleScheduleHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToggleScheduleHandler'
+type MockHandler_ToggleScheduleHandler_Call struct {
+	*mock.Call
+}
+
+// ToggleScheduleHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) ToggleScheduleHandler(w interface{}, r interface{}) *MockHandler_ToggleScheduleHandler_Call {
+	return &MockHandler_ToggleScheduleHandler_Call{Call: _e.mock.On("ToggleScheduleHandler", w, r)}
+}
+
+func (_c *MockHandler_ToggleScheduleHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ToggleScheduleHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ToggleScheduleHandler_Call) Return() *MockHandler_ToggleScheduleHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_ToggleScheduleHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ToggleScheduleHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// TransferMessagesAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) TransferMessagesAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_TransferMessagesAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferMessagesAPI'
+type MockHandler_TransferMessagesAPI_Call struct {
+	*mock.Call
+}
+
+// TransferMessagesAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) TransferMessagesAPI(w interface{}, r interface{}) *MockHandler_TransferMessagesAPI_Call {
+	return &MockHandler_TransferMessagesAPI_Call{Call: _e.mock.On("TransferMessagesAPI", w, r)}
+}
+
+func (_c *MockHandler_TransferMessagesAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_TransferMessagesAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_TransferMessagesAPI_Call) Return() *MockHandler_TransferMessagesAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_TransferMessagesAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_TransferMessagesAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// TrashHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) TrashHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_TrashHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashHandler'
+type MockHandler_TrashHandler_Call struct {
+	*mock.Call
+}
+
+// TrashHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) TrashHandler(w interface{}, r interface{}) *MockHandler_TrashHandler_Call {
+	return &MockHandler_TrashHandler_Call{Call: _e.mock.On("TrashHandler", w, r)}
+}
+
+func (_c *MockHandler_TrashHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_TrashHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_TrashHandler_Call) Return() *MockHandler_TrashHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_TrashHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_TrashHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UnsilenceAlertRuleHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) UnsilenceAlertRuleHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_UnsilenceAlertRuleHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsilenceAlertRuleHandler'
+type MockHandler_UnsilenceAlertRuleHandler_Call struct {
+	*mock.Call
+}
+
+// UnsilenceAlertRuleHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) UnsilenceAlertRuleHandler(w interface{}, r interface{}) *MockHandler_UnsilenceAlertRuleHandler_Call {
+	return &MockHandler_UnsilenceAlertRuleHandler_Call{Call: _e.mock.On("UnsilenceAlertRuleHandler", w, r)}
+}
+
+func (_c *MockHandler_UnsilenceAlertRuleHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UnsilenceAlertRuleHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_UnsilenceAlertRuleHandler_Call) Return() *MockHandler_UnsilenceAlertRuleHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_UnsilenceAlertRuleHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UnsilenceAlertRuleHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UnsubscribePushAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) UnsubscribePushAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_UnsubscribePushAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribePushAPI'
+type MockHandler_UnsubscribePushAPI_Call struct {
+	*mock.Call
+}
+
+// UnsubscribePushAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) UnsubscribePushAPI(w interface{}, r interface{}) *MockHandler_UnsubscribePushAPI_Call {
+	return &MockHandler_UnsubscribePushAPI_Call{Call: _e.mock.On("UnsubscribePushAPI", w, r)}
+}
+
+func (_c *MockHandler_UnsubscribePushAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UnsubscribePushAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_UnsubscribePushAPI_Call) Return() *MockHandler_UnsubscribePushAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_UnsubscribePushAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UnsubscribePushAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UnwatchAttributesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) UnwatchAttributesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_UnwatchAttributesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnwatchAttributesHandler'
+type MockHandler_UnwatchAttributesHandler_Call struct {
+	*mock.Call
+}
+
+// UnwatchAttributesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) UnwatchAttributesHandler(w interface{}, r interface{}) *MockHandler_UnwatchAttributesHandler_Call {
+	return &MockHandler_UnwatchAttributesHandler_Call{Call: _e.mock.On("UnwatchAttributesHandler", w, r)}
+}
+
+func (_c *MockHandler_UnwatchAttributesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UnwatchAttributesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_UnwatchAttributesHandler_Call) Return() *MockHandler_UnwatchAttributesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_UnwatchAttributesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UnwatchAttributesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateQueueAttributeAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateQueueAttributeAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_UpdateQueueAttributeAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQueueAttributeAPI'
+type MockHandler_UpdateQueueAttributeAPI_Call struct {
+	*mock.Call
+}
+
+// UpdateQueueAttributeAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) UpdateQueueAttributeAPI(w interface{}, r interface{}) *MockHandler_UpdateQueueAttributeAPI_Call {
+	return &MockHandler_UpdateQueueAttributeAPI_Call{Call: _e.mock.On("UpdateQueueAttributeAPI", w, r)}
+}
+
+func (_c *MockHandler_UpdateQueueAttributeAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UpdateQueueAttributeAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_UpdateQueueAttributeAPI_Call) Return() *MockHandler_UpdateQueueAttributeAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_UpdateQueueAttributeAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UpdateQueueAttributeAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateScheduleAPI provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateScheduleAPI(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_UpdateScheduleAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateScheduleAPI'
+type MockHandler_UpdateScheduleAPI_Call struct {
+	*mock.Call
+}
+
+// UpdateScheduleAPI is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) UpdateScheduleAPI(w interface{}, r interface{}) *MockHandler_UpdateScheduleAPI_Call {
+	return &MockHandler_UpdateScheduleAPI_Call{Call: _e.mock.On("UpdateScheduleAPI", w, r)}
+}
+
+func (_c *MockHandler_UpdateScheduleAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UpdateScheduleAPI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_UpdateScheduleAPI_Call) Return() *MockHandler_UpdateScheduleAPI_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_UpdateScheduleAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_UpdateScheduleAPI_Call {
+	_c.Run(run)
+	return _c
+}
+
+// WatchAttributesHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) WatchAttributesHandler(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockHandler_WatchAttributesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchAttributesHandler'
+type MockHandler_WatchAttributesHandler_Call struct {
+	*mock.Call
+}
+
+// WatchAttributesHandler is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockHandler_Expecter) WatchAttributesHandler(w interface{}, r interface{}) *MockHandler_WatchAttributesHandler_Call {
+	return &MockHandler_WatchAttributesHandler_Call{Call: _e.mock.On("WatchAttributesHandler", w, r)}
+}
+
+func (_c *MockHandler_WatchAttributesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_WatchAttributesHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_WatchAttributesHandler_Call) Return() *MockHandler_WatchAttributesHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockHandler_WatchAttributesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_WatchAttributesHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockLocalStore creates a new instance of MockLocalStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLocalStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLocalStore {
+	mock := &MockLocalStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLocalStore is an autogenerated mock type for the LocalStore type
+type MockLocalStore struct {
+	mock.Mock
+}
+
+type MockLocalStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLocalStore) EXPECT() *MockLocalStore_Expecter {
+	return &MockLocalStore_Expecter{mock: &_m.Mock}
+}
+
+// AlertRule provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) AlertRule(id string) (AlertRule, bool, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertRule")
+	}
+
+	var r0 AlertRule
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (AlertRule, bool, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) AlertRule); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(AlertRule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocalStore_AlertRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlertRule'
+type MockLocalStore_AlertRule_Call struct {
+	*mock.Call
+}
+
+// AlertRule is a helper method to define mock.On call
+//   - id string
+func (_e *MockLocalStore_Expecter) AlertRule(id interface{}) *MockLocalStore_AlertRule_Call {
+	return &MockLocalStore_AlertRule_Call{Call: _e.mock.On("AlertRule", id)}
+}
+
+func (_c *MockLocalStore_AlertRule_Call) Run(run func(id string)) *MockLocalStore_AlertRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_AlertRule_Call) Return(alertRule AlertRule, b bool, err error) *MockLocalStore_AlertRule_Call {
+	_c.Call.Return(alertRule, b, err)
+	return _c
+}
+
+func (_c *MockLocalStore_AlertRule_Call) RunAndReturn(run func(id string) (AlertRule, bool, error)) *MockLocalStore_AlertRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlertRules provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) AlertRules() ([]AlertRule, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertRules")
+	}
+
+	var r0 []AlertRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]AlertRule, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []AlertRule); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AlertRule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalStore_AlertRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlertRules'
+type MockLocalStore_AlertRules_Call struct {
+	*mock.Call
+}
+
+// AlertRules is a helper method to define mock.On call
+func (_e *MockLocalStore_Expecter) AlertRules() *MockLocalStore_AlertRules_Call {
+	return &MockLocalStore_AlertRules_Call{Call: _e.mock.On("AlertRules")}
+}
+
+func (_c *MockLocalStore_AlertRules_Call) Run(run func()) *MockLocalStore_AlertRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_AlertRules_Call) Return(alertRules []AlertRule, err error) *MockLocalStore_AlertRules_Call {
+	_c.Call.Return(alertRules, err)
+	return _c
+}
+
+func (_c *MockLocalStore_AlertRules_Call) RunAndReturn(run func() ([]AlertRule, error)) *MockLocalStore_AlertRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AttributeHistories provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) AttributeHistories() ([]AttributeHistory, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttributeHistories")
+	}
+
+	var r0 []AttributeHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]AttributeHistory, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []AttributeHistory); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AttributeHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalStore_AttributeHistories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttributeHistories'
+type MockLocalStore_AttributeHistories_Call struct {
+	*mock.Call
+}
+
+// AttributeHistories is a helper method to define mock.On call
+func (_e *MockLocalStore_Expecter) AttributeHistories() *MockLocalStore_AttributeHistories_Call {
+	return &MockLocalStore_AttributeHistories_Call{Call: _e.mock.On("AttributeHistories")}
+}
+
+func (_c *MockLocalStore_AttributeHistories_Call) Run(run func()) *MockLocalStore_AttributeHistories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_AttributeHistories_Call) Return(attributeHistorys []AttributeHistory, err error) *MockLocalStore_AttributeHistories_Call {
+	_c.Call.Return(attributeHistorys, err)
+	return _c
+}
+
+func (_c *MockLocalStore_AttributeHistories_Call) RunAndReturn(run func() ([]AttributeHistory, error)) *MockLocalStore_AttributeHistories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AttributeHistory provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) AttributeHistory(queueURL string) (AttributeHistory, bool, error) {
+	ret := _mock.Called(queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttributeHistory")
+	}
+
+	var r0 AttributeHistory
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (AttributeHistory, bool, error)); ok {
+		return returnFunc(queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) AttributeHistory); ok {
+		r0 = returnFunc(queueURL)
+	} else {
+		r0 = ret.Get(0).(AttributeHistory)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(queueURL)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(queueURL)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocalStore_AttributeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttributeHistory'
+type MockLocalStore_AttributeHistory_Call struct {
+	*mock.Call
+}
+
+// AttributeHistory is a helper method to define mock.On call
+//   - queueURL string
+func (_e *MockLocalStore_Expecter) AttributeHistory(queueURL interface{}) *MockLocalStore_AttributeHistory_Call {
+	return &MockLocalStore_AttributeHistory_Call{Call: _e.mock.On("AttributeHistory", queueURL)}
+}
+
+func (_c *MockLocalStore_AttributeHistory_Call) Run(run func(queueURL string)) *MockLocalStore_AttributeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_AttributeHistory_Call) Return(attributeHistory AttributeHistory, b bool, err error) *MockLocalStore_AttributeHistory_Call {
+	_c.Call.Return(attributeHistory, b, err)
+	return _c
+}
+
+func (_c *MockLocalStore_AttributeHistory_Call) RunAndReturn(run func(queueURL string) (AttributeHistory, bool, error)) *MockLocalStore_AttributeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Baselines provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) Baselines() ([]QueueBaseline, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Baselines")
+	}
+
+	var r0 []QueueBaseline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]QueueBaseline, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []QueueBaseline); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]QueueBaseline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalStore_Baselines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Baselines'
+type MockLocalStore_Baselines_Call struct {
+	*mock.Call
+}
+
+// Baselines is a helper method to define mock.On call
+func (_e *MockLocalStore_Expecter) Baselines() *MockLocalStore_Baselines_Call {
+	return &MockLocalStore_Baselines_Call{Call: _e.mock.On("Baselines")}
+}
+
+func (_c *MockLocalStore_Baselines_Call) Run(run func()) *MockLocalStore_Baselines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_Baselines_Call) Return(queueBaselines []QueueBaseline, err error) *MockLocalStore_Baselines_Call {
+	_c.Call.Return(queueBaselines, err)
+	return _c
+}
+
+func (_c *MockLocalStore_Baselines_Call) RunAndReturn(run func() ([]QueueBaseline, error)) *MockLocalStore_Baselines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAlertRule provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) DeleteAlertRule(id string) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlertRule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_DeleteAlertRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlertRule'
+type MockLocalStore_DeleteAlertRule_Call struct {
+	*mock.Call
+}
+
+// DeleteAlertRule is a helper method to define mock.On call
+//   - id string
+func (_e *MockLocalStore_Expecter) DeleteAlertRule(id interface{}) *MockLocalStore_DeleteAlertRule_Call {
+	return &MockLocalStore_DeleteAlertRule_Call{Call: _e.mock.On("DeleteAlertRule", id)}
+}
+
+func (_c *MockLocalStore_DeleteAlertRule_Call) Run(run func(id string)) *MockLocalStore_DeleteAlertRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteAlertRule_Call) Return(err error) *MockLocalStore_DeleteAlertRule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteAlertRule_Call) RunAndReturn(run func(id string) error) *MockLocalStore_DeleteAlertRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAttributeHistory provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) DeleteAttributeHistory(queueURL string) error {
+	ret := _mock.Called(queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttributeHistory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(queueURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_DeleteAttributeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttributeHistory'
+type MockLocalStore_DeleteAttributeHistory_Call struct {
+	*mock.Call
+}
+
+// DeleteAttributeHistory is a helper method to define mock.On call
+//   - queueURL string
+func (_e *MockLocalStore_Expecter) DeleteAttributeHistory(queueURL interface{}) *MockLocalStore_DeleteAttributeHistory_Call {
+	return &MockLocalStore_DeleteAttributeHistory_Call{Call: _e.mock.On("DeleteAttributeHistory", queueURL)}
+}
+
+func (_c *MockLocalStore_DeleteAttributeHistory_Call) Run(run func(queueURL string)) *MockLocalStore_DeleteAttributeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteAttributeHistory_Call) Return(err error) *MockLocalStore_DeleteAttributeHistory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteAttributeHistory_Call) RunAndReturn(run func(queueURL string) error) *MockLocalStore_DeleteAttributeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBaseline provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) DeleteBaseline(queueURL string) error {
+	ret := _mock.Called(queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBaseline")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(queueURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_DeleteBaseline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBaseline'
+type MockLocalStore_DeleteBaseline_Call struct {
+	*mock.Call
+}
+
+// DeleteBaseline is a helper method to define mock.On call
+//   - queueURL string
+func (_e *MockLocalStore_Expecter) DeleteBaseline(queueURL interface{}) *MockLocalStore_DeleteBaseline_Call {
+	return &MockLocalStore_DeleteBaseline_Call{Call: _e.mock.On("DeleteBaseline", queueURL)}
+}
+
+func (_c *MockLocalStore_DeleteBaseline_Call) Run(run func(queueURL string)) *MockLocalStore_DeleteBaseline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteBaseline_Call) Return(err error) *MockLocalStore_DeleteBaseline_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteBaseline_Call) RunAndReturn(run func(queueURL string) error) *MockLocalStore_DeleteBaseline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDraft provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) DeleteDraft(queueURL string) error {
+	ret := _mock.Called(queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDraft")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(queueURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_DeleteDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDraft'
+type MockLocalStore_DeleteDraft_Call struct {
+	*mock.Call
+}
+
+// DeleteDraft is a helper method to define mock.On call
+//   - queueURL string
+func (_e *MockLocalStore_Expecter) DeleteDraft(queueURL interface{}) *MockLocalStore_DeleteDraft_Call {
+	return &MockLocalStore_DeleteDraft_Call{Call: _e.mock.On("DeleteDraft", queueURL)}
+}
+
+func (_c *MockLocalStore_DeleteDraft_Call) Run(run func(queueURL string)) *MockLocalStore_DeleteDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteDraft_Call) Return(err error) *MockLocalStore_DeleteDraft_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteDraft_Call) RunAndReturn(run func(queueURL string) error) *MockLocalStore_DeleteDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMessageContract provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) DeleteMessageContract(queueURL string) error {
+	ret := _mock.Called(queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessageContract")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(queueURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_DeleteMessageContract_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessageContract'
+type MockLocalStore_DeleteMessageContract_Call struct {
+	*mock.Call
+}
+
+// DeleteMessageContract is a helper method to define mock.On call
+//   - queueURL string
+func (_e *MockLocalStore_Expecter) DeleteMessageContract(queueURL interface{}) *MockLocalStore_DeleteMessageContract_Call {
+	return &MockLocalStore_DeleteMessageContract_Call{Call: _e.mock.On("DeleteMessageContract", queueURL)}
+}
+
+func (_c *MockLocalStore_DeleteMessageContract_Call) Run(run func(queueURL string)) *MockLocalStore_DeleteMessageContract_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteMessageContract_Call) Return(err error) *MockLocalStore_DeleteMessageContract_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteMessageContract_Call) RunAndReturn(run func(queueURL string) error) *MockLocalStore_DeleteMessageContract_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePushSubscription provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) DeletePushSubscription(endpoint string) error {
+	ret := _mock.Called(endpoint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePushSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(endpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_DeletePushSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePushSubscription'
+type MockLocalStore_DeletePushSubscription_Call struct {
+	*mock.Call
+}
+
+// DeletePushSubscription is a helper method to define mock.On call
+//   - endpoint string
+func (_e *MockLocalStore_Expecter) DeletePushSubscription(endpoint interface{}) *MockLocalStore_DeletePushSubscription_Call {
+	return &MockLocalStore_DeletePushSubscription_Call{Call: _e.mock.On("DeletePushSubscription", endpoint)}
+}
+
+func (_c *MockLocalStore_DeletePushSubscription_Call) Run(run func(endpoint string)) *MockLocalStore_DeletePushSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_DeletePushSubscription_Call) Return(err error) *MockLocalStore_DeletePushSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_DeletePushSubscription_Call) RunAndReturn(run func(endpoint string) error) *MockLocalStore_DeletePushSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSchedule provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) DeleteSchedule(id string) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSchedule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_DeleteSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSchedule'
+type MockLocalStore_DeleteSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteSchedule is a helper method to define mock.On call
+//   - id string
+func (_e *MockLocalStore_Expecter) DeleteSchedule(id interface{}) *MockLocalStore_DeleteSchedule_Call {
+	return &MockLocalStore_DeleteSchedule_Call{Call: _e.mock.On("DeleteSchedule", id)}
+}
+
+func (_c *MockLocalStore_DeleteSchedule_Call) Run(run func(id string)) *MockLocalStore_DeleteSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteSchedule_Call) Return(err error) *MockLocalStore_DeleteSchedule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteSchedule_Call) RunAndReturn(run func(id string) error) *MockLocalStore_DeleteSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTrashedQueue provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) DeleteTrashedQueue(id string) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrashedQueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_DeleteTrashedQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrashedQueue'
+type MockLocalStore_DeleteTrashedQueue_Call struct {
+	*mock.Call
+}
+
+// DeleteTrashedQueue is a helper method to define mock.On call
+//   - id string
+func (_e *MockLocalStore_Expecter) DeleteTrashedQueue(id interface{}) *MockLocalStore_DeleteTrashedQueue_Call {
+	return &MockLocalStore_DeleteTrashedQueue_Call{Call: _e.mock.On("DeleteTrashedQueue", id)}
+}
+
+func (_c *MockLocalStore_DeleteTrashedQueue_Call) Run(run func(id string)) *MockLocalStore_DeleteTrashedQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteTrashedQueue_Call) Return(err error) *MockLocalStore_DeleteTrashedQueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_DeleteTrashedQueue_Call) RunAndReturn(run func(id string) error) *MockLocalStore_DeleteTrashedQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Draft provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) Draft(queueURL string) (MessageDraft, bool, error) {
+	ret := _mock.Called(queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Draft")
+	}
+
+	var r0 MessageDraft
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (MessageDraft, bool, error)); ok {
+		return returnFunc(queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) MessageDraft); ok {
+		r0 = returnFunc(queueURL)
+	} else {
+		r0 = ret.Get(0).(MessageDraft)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(queueURL)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(queueURL)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocalStore_Draft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Draft'
+type MockLocalStore_Draft_Call struct {
+	*mock.Call
+}
+
+// Draft is a helper method to define mock.On call
+//   - queueURL string
+func (_e *MockLocalStore_Expecter) Draft(queueURL interface{}) *MockLocalStore_Draft_Call {
+	return &MockLocalStore_Draft_Call{Call: _e.mock.On("Draft", queueURL)}
+}
+
+func (_c *MockLocalStore_Draft_Call) Run(run func(queueURL string)) *MockLocalStore_Draft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_Draft_Call) Return(messageDraft MessageDraft, b bool, err error) *MockLocalStore_Draft_Call {
+	_c.Call.Return(messageDraft, b, err)
+	return _c
+}
+
+func (_c *MockLocalStore_Draft_Call) RunAndReturn(run func(queueURL string) (MessageDraft, bool, error)) *MockLocalStore_Draft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MessageContract provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) MessageContract(queueURL string) (MessageContract, bool, error) {
+	ret := _mock.Called(queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MessageContract")
+	}
+
+	var r0 MessageContract
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (MessageContract, bool, error)); ok {
+		return returnFunc(queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) MessageContract); ok {
+		r0 = returnFunc(queueURL)
+	} else {
+		r0 = ret.Get(0).(MessageContract)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(queueURL)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(queueURL)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocalStore_MessageContract_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageContract'
+type MockLocalStore_MessageContract_Call struct {
+	*mock.Call
+}
+
+// MessageContract is a helper method to define mock.On call
+//   - queueURL string
+func (_e *MockLocalStore_Expecter) MessageContract(queueURL interface{}) *MockLocalStore_MessageContract_Call {
+	return &MockLocalStore_MessageContract_Call{Call: _e.mock.On("MessageContract", queueURL)}
+}
+
+func (_c *MockLocalStore_MessageContract_Call) Run(run func(queueURL string)) *MockLocalStore_MessageContract_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_MessageContract_Call) Return(messageContract MessageContract, b bool, err error) *MockLocalStore_MessageContract_Call {
+	_c.Call.Return(messageContract, b, err)
+	return _c
+}
+
+func (_c *MockLocalStore_MessageContract_Call) RunAndReturn(run func(queueURL string) (MessageContract, bool, error)) *MockLocalStore_MessageContract_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Preferences provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) Preferences() (Preferences, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Preferences")
+	}
+
+	var r0 Preferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (Preferences, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() Preferences); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(Preferences)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalStore_Preferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preferences'
+type MockLocalStore_Preferences_Call struct {
+	*mock.Call
+}
+
+// Preferences is a helper method to define mock.On call
+func (_e *MockLocalStore_Expecter) Preferences() *MockLocalStore_Preferences_Call {
+	return &MockLocalStore_Preferences_Call{Call: _e.mock.On("Preferences")}
+}
+
+func (_c *MockLocalStore_Preferences_Call) Run(run func()) *MockLocalStore_Preferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_Preferences_Call) Return(preferences Preferences, err error) *MockLocalStore_Preferences_Call {
+	_c.Call.Return(preferences, err)
+	return _c
+}
+
+func (_c *MockLocalStore_Preferences_Call) RunAndReturn(run func() (Preferences, error)) *MockLocalStore_Preferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PushSubscriptions provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) PushSubscriptions() ([]PushSubscription, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PushSubscriptions")
+	}
+
+	var r0 []PushSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]PushSubscription, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []PushSubscription); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]PushSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalStore_PushSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushSubscriptions'
+type MockLocalStore_PushSubscriptions_Call struct {
+	*mock.Call
+}
+
+// PushSubscriptions is a helper method to define mock.On call
+func (_e *MockLocalStore_Expecter) PushSubscriptions() *MockLocalStore_PushSubscriptions_Call {
+	return &MockLocalStore_PushSubscriptions_Call{Call: _e.mock.On("PushSubscriptions")}
+}
+
+func (_c *MockLocalStore_PushSubscriptions_Call) Run(run func()) *MockLocalStore_PushSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_PushSubscriptions_Call) Return(pushSubscriptions []PushSubscription, err error) *MockLocalStore_PushSubscriptions_Call {
+	_c.Call.Return(pushSubscriptions, err)
+	return _c
+}
+
+func (_c *MockLocalStore_PushSubscriptions_Call) RunAndReturn(run func() ([]PushSubscription, error)) *MockLocalStore_PushSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) Restore(snapshot StateSnapshot) error {
+	ret := _mock.Called(snapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(StateSnapshot) error); ok {
+		r0 = returnFunc(snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockLocalStore_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - snapshot StateSnapshot
+func (_e *MockLocalStore_Expecter) Restore(snapshot interface{}) *MockLocalStore_Restore_Call {
+	return &MockLocalStore_Restore_Call{Call: _e.mock.On("Restore", snapshot)}
+}
+
+func (_c *MockLocalStore_Restore_Call) Run(run func(snapshot StateSnapshot)) *MockLocalStore_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 StateSnapshot
+		if args[0] != nil {
+			arg0 = args[0].(StateSnapshot)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_Restore_Call) Return(err error) *MockLocalStore_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_Restore_Call) RunAndReturn(run func(snapshot StateSnapshot) error) *MockLocalStore_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAlertRule provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SaveAlertRule(rule AlertRule) error {
+	ret := _mock.Called(rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAlertRule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(AlertRule) error); ok {
+		r0 = returnFunc(rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_SaveAlertRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAlertRule'
+type MockLocalStore_SaveAlertRule_Call struct {
+	*mock.Call
+}
+
+// SaveAlertRule is a helper method to define mock.On call
+//   - rule AlertRule
+func (_e *MockLocalStore_Expecter) SaveAlertRule(rule interface{}) *MockLocalStore_SaveAlertRule_Call {
+	return &MockLocalStore_SaveAlertRule_Call{Call: _e.mock.On("SaveAlertRule", rule)}
+}
+
+func (_c *MockLocalStore_SaveAlertRule_Call) Run(run func(rule AlertRule)) *MockLocalStore_SaveAlertRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 AlertRule
+		if args[0] != nil {
+			arg0 = args[0].(AlertRule)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SaveAlertRule_Call) Return(err error) *MockLocalStore_SaveAlertRule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_SaveAlertRule_Call) RunAndReturn(run func(rule AlertRule) error) *MockLocalStore_SaveAlertRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAttributeHistory provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SaveAttributeHistory(history AttributeHistory) error {
+	ret := _mock.Called(history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAttributeHistory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(AttributeHistory) error); ok {
+		r0 = returnFunc(history)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_SaveAttributeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAttributeHistory'
+type MockLocalStore_SaveAttributeHistory_Call struct {
+	*mock.Call
+}
+
+// SaveAttributeHistory is a helper method to define mock.On call
+//   - history AttributeHistory
+func (_e *MockLocalStore_Expecter) SaveAttributeHistory(history interface{}) *MockLocalStore_SaveAttributeHistory_Call {
+	return &MockLocalStore_SaveAttributeHistory_Call{Call: _e.mock.On("SaveAttributeHistory", history)}
+}
+
+func (_c *MockLocalStore_SaveAttributeHistory_Call) Run(run func(history AttributeHistory)) *MockLocalStore_SaveAttributeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 AttributeHistory
+		if args[0] != nil {
+			arg0 = args[0].(AttributeHistory)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SaveAttributeHistory_Call) Return(err error) *MockLocalStore_SaveAttributeHistory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_SaveAttributeHistory_Call) RunAndReturn(run func(history AttributeHistory) error) *MockLocalStore_SaveAttributeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBaseline provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SaveBaseline(baseline QueueBaseline) error {
+	ret := _mock.Called(baseline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveBaseline")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(QueueBaseline) error); ok {
+		r0 = returnFunc(baseline)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_SaveBaseline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBaseline'
+type MockLocalStore_SaveBaseline_Call struct {
+	*mock.Call
+}
+
+// SaveBaseline is a helper method to define mock.On call
+//   - baseline QueueBaseline
+func (_e *MockLocalStore_Expecter) SaveBaseline(baseline interface{}) *MockLocalStore_SaveBaseline_Call {
+	return &MockLocalStore_SaveBaseline_Call{Call: _e.mock.On("SaveBaseline", baseline)}
+}
+
+func (_c *MockLocalStore_SaveBaseline_Call) Run(run func(baseline QueueBaseline)) *MockLocalStore_SaveBaseline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 QueueBaseline
+		if args[0] != nil {
+			arg0 = args[0].(QueueBaseline)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SaveBaseline_Call) Return(err error) *MockLocalStore_SaveBaseline_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_SaveBaseline_Call) RunAndReturn(run func(baseline QueueBaseline) error) *MockLocalStore_SaveBaseline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDraft provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SaveDraft(queueURL string, draft MessageDraft) error {
+	ret := _mock.Called(queueURL, draft)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDraft")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, MessageDraft) error); ok {
+		r0 = returnFunc(queueURL, draft)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_SaveDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDraft'
+type MockLocalStore_SaveDraft_Call struct {
+	*mock.Call
+}
+
+// SaveDraft is a helper method to define mock.On call
+//   - queueURL string
+//   - draft MessageDraft
+func (_e *MockLocalStore_Expecter) SaveDraft(queueURL interface{}, draft interface{}) *MockLocalStore_SaveDraft_Call {
+	return &MockLocalStore_SaveDraft_Call{Call: _e.mock.On("SaveDraft", queueURL, draft)}
+}
+
+func (_c *MockLocalStore_SaveDraft_Call) Run(run func(queueURL string, draft MessageDraft)) *MockLocalStore_SaveDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 MessageDraft
+		if args[1] != nil {
+			arg1 = args[1].(MessageDraft)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SaveDraft_Call) Return(err error) *MockLocalStore_SaveDraft_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_SaveDraft_Call) RunAndReturn(run func(queueURL string, draft MessageDraft) error) *MockLocalStore_SaveDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMessageContract provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SaveMessageContract(contract MessageContract) error {
+	ret := _mock.Called(contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMessageContract")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(MessageContract) error); ok {
+		r0 = returnFunc(contract)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_SaveMessageContract_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMessageContract'
+type MockLocalStore_SaveMessageContract_Call struct {
+	*mock.Call
+}
+
+// SaveMessageContract is a helper method to define mock.On call
+//   - contract MessageContract
+func (_e *MockLocalStore_Expecter) SaveMessageContract(contract interface{}) *MockLocalStore_SaveMessageContract_Call {
+	return &MockLocalStore_SaveMessageContract_Call{Call: _e.mock.On("SaveMessageContract", contract)}
+}
+
+func (_c *MockLocalStore_SaveMessageContract_Call) Run(run func(contract MessageContract)) *MockLocalStore_SaveMessageContract_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 MessageContract
+		if args[0] != nil {
+			arg0 = args[0].(MessageContract)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SaveMessageContract_Call) Return(err error) *MockLocalStore_SaveMessageContract_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_SaveMessageContract_Call) RunAndReturn(run func(contract MessageContract) error) *MockLocalStore_SaveMessageContract_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePushSubscription provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SavePushSubscription(subscription PushSubscription) error {
+	ret := _mock.Called(subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePushSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(PushSubscription) error); ok {
+		r0 = returnFunc(subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_SavePushSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePushSubscription'
+type MockLocalStore_SavePushSubscription_Call struct {
+	*mock.Call
+}
+
+// SavePushSubscription is a helper method to define mock.On call
+//   - subscription PushSubscription
+func (_e *MockLocalStore_Expecter) SavePushSubscription(subscription interface{}) *MockLocalStore_SavePushSubscription_Call {
+	return &MockLocalStore_SavePushSubscription_Call{Call: _e.mock.On("SavePushSubscription", subscription)}
+}
+
+func (_c *MockLocalStore_SavePushSubscription_Call) Run(run func(subscription PushSubscription)) *MockLocalStore_SavePushSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 PushSubscription
+		if args[0] != nil {
+			arg0 = args[0].(PushSubscription)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SavePushSubscription_Call) Return(err error) *MockLocalStore_SavePushSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_SavePushSubscription_Call) RunAndReturn(run func(subscription PushSubscription) error) *MockLocalStore_SavePushSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSchedule provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SaveSchedule(schedule Schedule) error {
+	ret := _mock.Called(schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSchedule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(Schedule) error); ok {
+		r0 = returnFunc(schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_SaveSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSchedule'
+type MockLocalStore_SaveSchedule_Call struct {
+	*mock.Call
+}
+
+// SaveSchedule is a helper method to define mock.On call
+//   - schedule Schedule
+func (_e *MockLocalStore_Expecter) SaveSchedule(schedule interface{}) *MockLocalStore_SaveSchedule_Call {
+	return &MockLocalStore_SaveSchedule_Call{Call: _e.mock.On("SaveSchedule", schedule)}
+}
+
+func (_c *MockLocalStore_SaveSchedule_Call) Run(run func(schedule Schedule)) *MockLocalStore_SaveSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 Schedule
+		if args[0] != nil {
+			arg0 = args[0].(Schedule)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SaveSchedule_Call) Return(err error) *MockLocalStore_SaveSchedule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_SaveSchedule_Call) RunAndReturn(run func(schedule Schedule) error) *MockLocalStore_SaveSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSendDefaults provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SaveSendDefaults(queueURL string, defaults SendDefaults) error {
+	ret := _mock.Called(queueURL, defaults)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSendDefaults")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, SendDefaults) error); ok {
+		r0 = returnFunc(queueURL, defaults)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_SaveSendDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSendDefaults'
+type MockLocalStore_SaveSendDefaults_Call struct {
+	*mock.Call
+}
+
+// SaveSendDefaults is a helper method to define mock.On call
+//   - queueURL string
+//   - defaults SendDefaults
+func (_e *MockLocalStore_Expecter) SaveSendDefaults(queueURL interface{}, defaults interface{}) *MockLocalStore_SaveSendDefaults_Call {
+	return &MockLocalStore_SaveSendDefaults_Call{Call: _e.mock.On("SaveSendDefaults", queueURL, defaults)}
+}
+
+func (_c *MockLocalStore_SaveSendDefaults_Call) Run(run func(queueURL string, defaults SendDefaults)) *MockLocalStore_SaveSendDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 SendDefaults
+		if args[1] != nil {
+			arg1 = args[1].(SendDefaults)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SaveSendDefaults_Call) Return(err error) *MockLocalStore_SaveSendDefaults_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_SaveSendDefaults_Call) RunAndReturn(run func(queueURL string, defaults SendDefaults) error) *MockLocalStore_SaveSendDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTrashedQueue provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SaveTrashedQueue(queue TrashedQueue) error {
+	ret := _mock.Called(queue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTrashedQueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(TrashedQueue) error); ok {
+		r0 = returnFunc(queue)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_SaveTrashedQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTrashedQueue'
+type MockLocalStore_SaveTrashedQueue_Call struct {
+	*mock.Call
+}
+
+// SaveTrashedQueue is a helper method to define mock.On call
+//   - queue TrashedQueue
+func (_e *MockLocalStore_Expecter) SaveTrashedQueue(queue interface{}) *MockLocalStore_SaveTrashedQueue_Call {
+	return &MockLocalStore_SaveTrashedQueue_Call{Call: _e.mock.On("SaveTrashedQueue", queue)}
+}
+
+func (_c *MockLocalStore_SaveTrashedQueue_Call) Run(run func(queue TrashedQueue)) *MockLocalStore_SaveTrashedQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 TrashedQueue
+		if args[0] != nil {
+			arg0 = args[0].(TrashedQueue)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SaveTrashedQueue_Call) Return(err error) *MockLocalStore_SaveTrashedQueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_SaveTrashedQueue_Call) RunAndReturn(run func(queue TrashedQueue) error) *MockLocalStore_SaveTrashedQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schedule provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) Schedule(id string) (Schedule, bool, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Schedule")
+	}
+
+	var r0 Schedule
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (Schedule, bool, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) Schedule); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(Schedule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocalStore_Schedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schedule'
+type MockLocalStore_Schedule_Call struct {
+	*mock.Call
+}
+
+// Schedule is a helper method to define mock.On call
+//   - id string
+func (_e *MockLocalStore_Expecter) Schedule(id interface{}) *MockLocalStore_Schedule_Call {
+	return &MockLocalStore_Schedule_Call{Call: _e.mock.On("Schedule", id)}
+}
+
+func (_c *MockLocalStore_Schedule_Call) Run(run func(id string)) *MockLocalStore_Schedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_Schedule_Call) Return(schedule Schedule, b bool, err error) *MockLocalStore_Schedule_Call {
+	_c.Call.Return(schedule, b, err)
+	return _c
+}
+
+func (_c *MockLocalStore_Schedule_Call) RunAndReturn(run func(id string) (Schedule, bool, error)) *MockLocalStore_Schedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schedules provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) Schedules() ([]Schedule, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Schedules")
+	}
+
+	var r0 []Schedule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]Schedule, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []Schedule); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Schedule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalStore_Schedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schedules'
+type MockLocalStore_Schedules_Call struct {
+	*mock.Call
+}
+
+// Schedules is a helper method to define mock.On call
+func (_e *MockLocalStore_Expecter) Schedules() *MockLocalStore_Schedules_Call {
+	return &MockLocalStore_Schedules_Call{Call: _e.mock.On("Schedules")}
+}
+
+func (_c *MockLocalStore_Schedules_Call) Run(run func()) *MockLocalStore_Schedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_Schedules_Call) Return(schedules []Schedule, err error) *MockLocalStore_Schedules_Call {
+	_c.Call.Return(schedules, err)
+	return _c
+}
+
+func (_c *MockLocalStore_Schedules_Call) RunAndReturn(run func() ([]Schedule, error)) *MockLocalStore_Schedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendDefaults provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) SendDefaults(queueURL string) (SendDefaults, bool, error) {
+	ret := _mock.Called(queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDefaults")
+	}
+
+	var r0 SendDefaults
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (SendDefaults, bool, error)); ok {
+		return returnFunc(queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) SendDefaults); ok {
+		r0 = returnFunc(queueURL)
+	} else {
+		r0 = ret.Get(0).(SendDefaults)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(queueURL)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(queueURL)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocalStore_SendDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDefaults'
+type MockLocalStore_SendDefaults_Call struct {
+	*mock.Call
+}
+
+// SendDefaults is a helper method to define mock.On call
+//   - queueURL string
+func (_e *MockLocalStore_Expecter) SendDefaults(queueURL interface{}) *MockLocalStore_SendDefaults_Call {
+	return &MockLocalStore_SendDefaults_Call{Call: _e.mock.On("SendDefaults", queueURL)}
+}
+
+func (_c *MockLocalStore_SendDefaults_Call) Run(run func(queueURL string)) *MockLocalStore_SendDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_SendDefaults_Call) Return(sendDefaults SendDefaults, b bool, err error) *MockLocalStore_SendDefaults_Call {
+	_c.Call.Return(sendDefaults, b, err)
+	return _c
+}
+
+func (_c *MockLocalStore_SendDefaults_Call) RunAndReturn(run func(queueURL string) (SendDefaults, bool, error)) *MockLocalStore_SendDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snapshot provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) Snapshot() (StateSnapshot, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 StateSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (StateSnapshot, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() StateSnapshot); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(StateSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalStore_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type MockLocalStore_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+func (_e *MockLocalStore_Expecter) Snapshot() *MockLocalStore_Snapshot_Call {
+	return &MockLocalStore_Snapshot_Call{Call: _e.mock.On("Snapshot")}
+}
+
+func (_c *MockLocalStore_Snapshot_Call) Run(run func()) *MockLocalStore_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_Snapshot_Call) Return(stateSnapshot StateSnapshot, err error) *MockLocalStore_Snapshot_Call {
+	_c.Call.Return(stateSnapshot, err)
+	return _c
+}
+
+func (_c *MockLocalStore_Snapshot_Call) RunAndReturn(run func() (StateSnapshot, error)) *MockLocalStore_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrashedQueue provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) TrashedQueue(id string) (TrashedQueue, bool, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashedQueue")
+	}
+
+	var r0 TrashedQueue
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (TrashedQueue, bool, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) TrashedQueue); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(TrashedQueue)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocalStore_TrashedQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashedQueue'
+type MockLocalStore_TrashedQueue_Call struct {
+	*mock.Call
+}
+
+// TrashedQueue is a helper method to define mock.On call
+//   - id string
+func (_e *MockLocalStore_Expecter) TrashedQueue(id interface{}) *MockLocalStore_TrashedQueue_Call {
+	return &MockLocalStore_TrashedQueue_Call{Call: _e.mock.On("TrashedQueue", id)}
+}
+
+func (_c *MockLocalStore_TrashedQueue_Call) Run(run func(id string)) *MockLocalStore_TrashedQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_TrashedQueue_Call) Return(trashedQueue TrashedQueue, b bool, err error) *MockLocalStore_TrashedQueue_Call {
+	_c.Call.Return(trashedQueue, b, err)
+	return _c
+}
+
+func (_c *MockLocalStore_TrashedQueue_Call) RunAndReturn(run func(id string) (TrashedQueue, bool, error)) *MockLocalStore_TrashedQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrashedQueues provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) TrashedQueues() ([]TrashedQueue, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashedQueues")
+	}
+
+	var r0 []TrashedQueue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]TrashedQueue, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []TrashedQueue); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TrashedQueue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalStore_TrashedQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashedQueues'
+type MockLocalStore_TrashedQueues_Call struct {
+	*mock.Call
+}
+
+// TrashedQueues is a helper method to define mock.On call
+func (_e *MockLocalStore_Expecter) TrashedQueues() *MockLocalStore_TrashedQueues_Call {
+	return &MockLocalStore_TrashedQueues_Call{Call: _e.mock.On("TrashedQueues")}
+}
+
+func (_c *MockLocalStore_TrashedQueues_Call) Run(run func()) *MockLocalStore_TrashedQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_TrashedQueues_Call) Return(trashedQueues []TrashedQueue, err error) *MockLocalStore_TrashedQueues_Call {
+	_c.Call.Return(trashedQueues, err)
+	return _c
+}
+
+func (_c *MockLocalStore_TrashedQueues_Call) RunAndReturn(run func() ([]TrashedQueue, error)) *MockLocalStore_TrashedQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreferences provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) UpdatePreferences(update func(preferences *Preferences) error) error {
+	ret := _mock.Called(update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(func(preferences *Preferences) error) error); ok {
+		r0 = returnFunc(update)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type MockLocalStore_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - update func(preferences *Preferences) error
+func (_e *MockLocalStore_Expecter) UpdatePreferences(update interface{}) *MockLocalStore_UpdatePreferences_Call {
+	return &MockLocalStore_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", update)}
+}
+
+func (_c *MockLocalStore_UpdatePreferences_Call) Run(run func(update func(preferences *Preferences) error)) *MockLocalStore_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 func(preferences *Preferences) error
+		if args[0] != nil {
+			arg0 = args[0].(func(preferences *Preferences) error)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_UpdatePreferences_Call) Return(err error) *MockLocalStore_UpdatePreferences_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_UpdatePreferences_Call) RunAndReturn(run func(update func(preferences *Preferences) error) error) *MockLocalStore_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSchedule provides a mock function for the type MockLocalStore
+func (_mock *MockLocalStore) UpdateSchedule(id string, update func(schedule *Schedule) error) error {
+	ret := _mock.Called(id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSchedule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, func(schedule *Schedule) error) error); ok {
+		r0 = returnFunc(id, update)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalStore_UpdateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchedule'
+type MockLocalStore_UpdateSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateSchedule is a helper method to define mock.On call
+//   - id string
+//   - update func(schedule *Schedule) error
+func (_e *MockLocalStore_Expecter) UpdateSchedule(id interface{}, update interface{}) *MockLocalStore_UpdateSchedule_Call {
+	return &MockLocalStore_UpdateSchedule_Call{Call: _e.mock.On("UpdateSchedule", id, update)}
+}
+
+func (_c *MockLocalStore_UpdateSchedule_Call) Run(run func(id string, update func(schedule *Schedule) error)) *MockLocalStore_UpdateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 func(schedule *Schedule) error
+		if args[1] != nil {
+			arg1 = args[1].(func(schedule *Schedule) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalStore_UpdateSchedule_Call) Return(err error) *MockLocalStore_UpdateSchedule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalStore_UpdateSchedule_Call) RunAndReturn(run func(id string, update func(schedule *Schedule) error) error) *MockLocalStore_UpdateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMailer creates a new instance of MockMailer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMailer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMailer {
+	mock := &MockMailer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMailer is an autogenerated mock type for the Mailer type
+type MockMailer struct {
+	mock.Mock
+}
+
+type MockMailer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMailer) EXPECT() *MockMailer_Expecter {
+	return &MockMailer_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function for the type MockMailer
+func (_mock *MockMailer) Send(ctx context.Context, message EmailMessage) error {
+	ret := _mock.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, EmailMessage) error); ok {
+		r0 = returnFunc(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMailer_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockMailer_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message EmailMessage
+func (_e *MockMailer_Expecter) Send(ctx interface{}, message interface{}) *MockMailer_Send_Call {
+	return &MockMailer_Send_Call{Call: _e.mock.On("Send", ctx, message)}
+}
+
+func (_c *MockMailer_Send_Call) Run(run func(ctx context.Context, message EmailMessage)) *MockMailer_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 EmailMessage
+		if args[1] != nil {
+			arg1 = args[1].(EmailMessage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMailer_Send_Call) Return(err error) *MockMailer_Send_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMailer_Send_Call) RunAndReturn(run func(ctx context.Context, message EmailMessage) error) *MockMailer_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotifier creates a new instance of MockNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotifier {
+	mock := &MockNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotifier is an autogenerated mock type for the Notifier type
+type MockNotifier struct {
+	mock.Mock
+}
+
+type MockNotifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotifier) EXPECT() *MockNotifier_Expecter {
+	return &MockNotifier_Expecter{mock: &_m.Mock}
+}
+
+// Notify provides a mock function for the type MockNotifier
+func (_mock *MockNotifier) Notify(ctx context.Context, notification Notification) error {
+	ret := _mock.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Notification) error); ok {
+		r0 = returnFunc(ctx, notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotifier_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type MockNotifier_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification Notification
+func (_e *MockNotifier_Expecter) Notify(ctx interface{}, notification interface{}) *MockNotifier_Notify_Call {
+	return &MockNotifier_Notify_Call{Call: _e.mock.On("Notify", ctx, notification)}
+}
+
+func (_c *MockNotifier_Notify_Call) Run(run func(ctx context.Context, notification Notification)) *MockNotifier_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Notification
+		if args[1] != nil {
+			arg1 = args[1].(Notification)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotifier_Notify_Call) Return(err error) *MockNotifier_Notify_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotifier_Notify_Call) RunAndReturn(run func(ctx context.Context, notification Notification) error) *MockNotifier_Notify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockpageTemplate creates a new instance of mockpageTemplate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockpageTemplate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockpageTemplate {
+	mock := &mockpageTemplate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockpageTemplate is an autogenerated mock type for the pageTemplate type
+type mockpageTemplate struct {
+	mock.Mock
+}
+
+type mockpageTemplate_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockpageTemplate) EXPECT() *mockpageTemplate_Expecter {
+	return &mockpageTemplate_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type mockpageTemplate
+func (_mock *mockpageTemplate) Execute(w io.Writer, data any) error {
+	ret := _mock.Called(w, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(io.Writer, any) error); ok {
+		r0 = returnFunc(w, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockpageTemplate_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type mockpageTemplate_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - w io.Writer
+//   - data any
+func (_e *mockpageTemplate_Expecter) Execute(w interface{}, data interface{}) *mockpageTemplate_Execute_Call {
+	return &mockpageTemplate_Execute_Call{Call: _e.mock.On("Execute", w, data)}
+}
+
+func (_c *mockpageTemplate_Execute_Call) Run(run func(w io.Writer, data any)) *mockpageTemplate_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 io.Writer
+		if args[0] != nil {
+			arg0 = args[0].(io.Writer)
+		}
+		var arg1 any
+		if args[1] != nil {
+			arg1 = args[1].(any)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockpageTemplate_Execute_Call) Return(err error) *mockpageTemplate_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockpageTemplate_Execute_Call) RunAndReturn(run func(w io.Writer, data any) error) *mockpageTemplate_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoute creates a new instance of MockRoute. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoute(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoute {
+	mock := &MockRoute{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoute is an autogenerated mock type for the Route type
+type MockRoute struct {
+	mock.Mock
+}
+
+type MockRoute_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoute) EXPECT() *MockRoute_Expecter {
+	return &MockRoute_Expecter{mock: &_m.Mock}
+}
+
+// InitRoute provides a mock function for the type MockRoute
+func (_mock *MockRoute) InitRoute() (http.Handler, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitRoute")
+	}
+
+	var r0 http.Handler
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (http.Handler, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoute_InitRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitRoute'
+type MockRoute_InitRoute_Call struct {
+	*mock.Call
+}
+
+// InitRoute is a helper method to define mock.On call
+func (_e *MockRoute_Expecter) InitRoute() *MockRoute_InitRoute_Call {
+	return &MockRoute_InitRoute_Call{Call: _e.mock.On("InitRoute")}
+}
+
+func (_c *MockRoute_InitRoute_Call) Run(run func()) *MockRoute_InitRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRoute_InitRoute_Call) Return(handler http.Handler, err error) *MockRoute_InitRoute_Call {
+	_c.Call.Return(handler, err)
+	return _c
+}
+
+func (_c *MockRoute_InitRoute_Call) RunAndReturn(run func() (http.Handler, error)) *MockRoute_InitRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMocksnsAPI creates a new instance of mocksnsAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMocksnsAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mocksnsAPI {
+	mock := &mocksnsAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mocksnsAPI is an autogenerated mock type for the snsAPI type
+type mocksnsAPI struct {
+	mock.Mock
+}
+
+type mocksnsAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mocksnsAPI) EXPECT() *mocksnsAPI_Expecter {
+	return &mocksnsAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetSubscriptionAttributes provides a mock function for the type mocksnsAPI
+func (_mock *mocksnsAPI) GetSubscriptionAttributes(ctx context.Context, params *sns.GetSubscriptionAttributesInput, optFns ...func(*sns.Options)) (*sns.GetSubscriptionAttributesOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscriptionAttributes")
+	}
+
+	var r0 *sns.GetSubscriptionAttributesOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sns.GetSubscriptionAttributesInput, ...func(*sns.Options)) (*sns.GetSubscriptionAttributesOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sns.GetSubscriptionAttributesInput, ...func(*sns.Options)) *sns.GetSubscriptionAttributesOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sns.GetSubscriptionAttributesOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sns.GetSubscriptionAttributesInput, ...func(*sns.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksnsAPI_GetSubscriptionAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscriptionAttributes'
+type mocksnsAPI_GetSubscriptionAttributes_Call struct {
+	*mock.Call
+}
+
+// GetSubscriptionAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sns.GetSubscriptionAttributesInput
+//   - optFns ...func(*sns.Options)
+func (_e *mocksnsAPI_Expecter) GetSubscriptionAttributes(ctx interface{}, params interface{}, optFns ...interface{}) *mocksnsAPI_GetSubscriptionAttributes_Call {
+	return &mocksnsAPI_GetSubscriptionAttributes_Call{Call: _e.mock.On("GetSubscriptionAttributes",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksnsAPI_GetSubscriptionAttributes_Call) Run(run func(ctx context.Context, params *sns.GetSubscriptionAttributesInput, optFns ...func(*sns.Options))) *mocksnsAPI_GetSubscriptionAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sns.GetSubscriptionAttributesInput
+		if args[1] != nil {
+			arg1 = args[1].(*sns.GetSubscriptionAttributesInput)
+		}
+		var arg2 []func(*sns.Options)
+		var variadicArgs []func(*sns.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sns.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksnsAPI_GetSubscriptionAttributes_Call) Return(getSubscriptionAttributesOutput *sns.GetSubscriptionAttributesOutput, err error) *mocksnsAPI_GetSubscriptionAttributes_Call {
+	_c.Call.Return(getSubscriptionAttributesOutput, err)
+	return _c
+}
+
+func (_c *mocksnsAPI_GetSubscriptionAttributes_Call) RunAndReturn(run func(ctx context.Context, params *sns.GetSubscriptionAttributesInput, optFns ...func(*sns.Options)) (*sns.GetSubscriptionAttributesOutput, error)) *mocksnsAPI_GetSubscriptionAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscriptions provides a mock function for the type mocksnsAPI
+func (_mock *mocksnsAPI) ListSubscriptions(ctx context.Context, params *sns.ListSubscriptionsInput, optFns ...func(*sns.Options)) (*sns.ListSubscriptionsOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubscriptions")
+	}
+
+	var r0 *sns.ListSubscriptionsOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sns.ListSubscriptionsInput, ...func(*sns.Options)) (*sns.ListSubscriptionsOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sns.ListSubscriptionsInput, ...func(*sns.Options)) *sns.ListSubscriptionsOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sns.ListSubscriptionsOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sns.ListSubscriptionsInput, ...func(*sns.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksnsAPI_ListSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptions'
+type mocksnsAPI_ListSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sns.ListSubscriptionsInput
+//   - optFns ...func(*sns.Options)
+func (_e *mocksnsAPI_Expecter) ListSubscriptions(ctx interface{}, params interface{}, optFns ...interface{}) *mocksnsAPI_ListSubscriptions_Call {
+	return &mocksnsAPI_ListSubscriptions_Call{Call: _e.mock.On("ListSubscriptions",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksnsAPI_ListSubscriptions_Call) Run(run func(ctx context.Context, params *sns.ListSubscriptionsInput, optFns ...func(*sns.Options))) *mocksnsAPI_ListSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sns.ListSubscriptionsInput
+		if args[1] != nil {
+			arg1 = args[1].(*sns.ListSubscriptionsInput)
+		}
+		var arg2 []func(*sns.Options)
+		var variadicArgs []func(*sns.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sns.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksnsAPI_ListSubscriptions_Call) Return(listSubscriptionsOutput *sns.ListSubscriptionsOutput, err error) *mocksnsAPI_ListSubscriptions_Call {
+	_c.Call.Return(listSubscriptionsOutput, err)
+	return _c
+}
+
+func (_c *mocksnsAPI_ListSubscriptions_Call) RunAndReturn(run func(ctx context.Context, params *sns.ListSubscriptionsInput, optFns ...func(*sns.Options)) (*sns.ListSubscriptionsOutput, error)) *mocksnsAPI_ListSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type mocksnsAPI
+func (_mock *mocksnsAPI) Publish(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options)) (*sns.PublishOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 *sns.PublishOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sns.PublishInput, ...func(*sns.Options)) (*sns.PublishOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sns.PublishInput, ...func(*sns.Options)) *sns.PublishOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sns.PublishOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sns.PublishInput, ...func(*sns.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksnsAPI_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type mocksnsAPI_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sns.PublishInput
+//   - optFns ...func(*sns.Options)
+func (_e *mocksnsAPI_Expecter) Publish(ctx interface{}, params interface{}, optFns ...interface{}) *mocksnsAPI_Publish_Call {
+	return &mocksnsAPI_Publish_Call{Call: _e.mock.On("Publish",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksnsAPI_Publish_Call) Run(run func(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options))) *mocksnsAPI_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sns.PublishInput
+		if args[1] != nil {
+			arg1 = args[1].(*sns.PublishInput)
+		}
+		var arg2 []func(*sns.Options)
+		var variadicArgs []func(*sns.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sns.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksnsAPI_Publish_Call) Return(publishOutput *sns.PublishOutput, err error) *mocksnsAPI_Publish_Call {
+	_c.Call.Return(publishOutput, err)
+	return _c
+}
+
+func (_c *mocksnsAPI_Publish_Call) RunAndReturn(run func(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options)) (*sns.PublishOutput, error)) *mocksnsAPI_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMocksqsAPI creates a new instance of mocksqsAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMocksqsAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mocksqsAPI {
+	mock := &mocksqsAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mocksqsAPI is an autogenerated mock type for the sqsAPI type
+type mocksqsAPI struct {
+	mock.Mock
+}
+
+type mocksqsAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mocksqsAPI) EXPECT() *mocksqsAPI_Expecter {
+	return &mocksqsAPI_Expecter{mock: &_m.Mock}
+}
+
+// CancelMessageMoveTask provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) CancelMessageMoveTask(ctx context.Context, params *sqs.CancelMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelMessageMoveTask")
+	}
+
+	var r0 *sqs.CancelMessageMoveTaskOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.CancelMessageMoveTaskInput, ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.CancelMessageMoveTaskInput, ...func(*sqs.Options)) *sqs.CancelMessageMoveTaskOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.CancelMessageMoveTaskOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.CancelMessageMoveTaskInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_CancelMessageMoveTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelMessageMoveTask'
+type mocksqsAPI_CancelMessageMoveTask_Call struct {
+	*mock.Call
+}
+
+// CancelMessageMoveTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.CancelMessageMoveTaskInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) CancelMessageMoveTask(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_CancelMessageMoveTask_Call {
+	return &mocksqsAPI_CancelMessageMoveTask_Call{Call: _e.mock.On("CancelMessageMoveTask",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_CancelMessageMoveTask_Call) Run(run func(ctx context.Context, params *sqs.CancelMessageMoveTaskInput, optFns ...func(*sqs.Options))) *mocksqsAPI_CancelMessageMoveTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.CancelMessageMoveTaskInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.CancelMessageMoveTaskInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_CancelMessageMoveTask_Call) Return(cancelMessageMoveTaskOutput *sqs.CancelMessageMoveTaskOutput, err error) *mocksqsAPI_CancelMessageMoveTask_Call {
+	_c.Call.Return(cancelMessageMoveTaskOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_CancelMessageMoveTask_Call) RunAndReturn(run func(ctx context.Context, params *sqs.CancelMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error)) *mocksqsAPI_CancelMessageMoveTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeMessageVisibility provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) ChangeMessageVisibility(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeMessageVisibility")
+	}
+
+	var r0 *sqs.ChangeMessageVisibilityOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ChangeMessageVisibilityInput, ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ChangeMessageVisibilityInput, ...func(*sqs.Options)) *sqs.ChangeMessageVisibilityOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.ChangeMessageVisibilityOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.ChangeMessageVisibilityInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_ChangeMessageVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeMessageVisibility'
+type mocksqsAPI_ChangeMessageVisibility_Call struct {
+	*mock.Call
+}
+
+// ChangeMessageVisibility is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.ChangeMessageVisibilityInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) ChangeMessageVisibility(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_ChangeMessageVisibility_Call {
+	return &mocksqsAPI_ChangeMessageVisibility_Call{Call: _e.mock.On("ChangeMessageVisibility",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_ChangeMessageVisibility_Call) Run(run func(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options))) *mocksqsAPI_ChangeMessageVisibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.ChangeMessageVisibilityInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.ChangeMessageVisibilityInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_ChangeMessageVisibility_Call) Return(changeMessageVisibilityOutput *sqs.ChangeMessageVisibilityOutput, err error) *mocksqsAPI_ChangeMessageVisibility_Call {
+	_c.Call.Return(changeMessageVisibilityOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_ChangeMessageVisibility_Call) RunAndReturn(run func(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error)) *mocksqsAPI_ChangeMessageVisibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQueue provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) CreateQueue(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQueue")
+	}
+
+	var r0 *sqs.CreateQueueOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.CreateQueueInput, ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.CreateQueueInput, ...func(*sqs.Options)) *sqs.CreateQueueOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.CreateQueueOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.CreateQueueInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_CreateQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQueue'
+type mocksqsAPI_CreateQueue_Call struct {
+	*mock.Call
+}
+
+// CreateQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.CreateQueueInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) CreateQueue(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_CreateQueue_Call {
+	return &mocksqsAPI_CreateQueue_Call{Call: _e.mock.On("CreateQueue",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_CreateQueue_Call) Run(run func(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options))) *mocksqsAPI_CreateQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.CreateQueueInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.CreateQueueInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_CreateQueue_Call) Return(createQueueOutput *sqs.CreateQueueOutput, err error) *mocksqsAPI_CreateQueue_Call {
+	_c.Call.Return(createQueueOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_CreateQueue_Call) RunAndReturn(run func(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error)) *mocksqsAPI_CreateQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMessage provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) DeleteMessage(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessage")
+	}
+
+	var r0 *sqs.DeleteMessageOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.DeleteMessageInput, ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.DeleteMessageInput, ...func(*sqs.Options)) *sqs.DeleteMessageOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.DeleteMessageOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.DeleteMessageInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
+type mocksqsAPI_DeleteMessage_Call struct {
+	*mock.Call
+}
+
+// DeleteMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.DeleteMessageInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) DeleteMessage(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_DeleteMessage_Call {
+	return &mocksqsAPI_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_DeleteMessage_Call) Run(run func(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options))) *mocksqsAPI_DeleteMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.DeleteMessageInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.DeleteMessageInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_DeleteMessage_Call) Return(deleteMessageOutput *sqs.DeleteMessageOutput, err error) *mocksqsAPI_DeleteMessage_Call {
+	_c.Call.Return(deleteMessageOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_DeleteMessage_Call) RunAndReturn(run func(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)) *mocksqsAPI_DeleteMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQueue provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) DeleteQueue(ctx context.Context, params *sqs.DeleteQueueInput, optFns ...func(*sqs.Options)) (*sqs.DeleteQueueOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQueue")
+	}
+
+	var r0 *sqs.DeleteQueueOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.DeleteQueueInput, ...func(*sqs.Options)) (*sqs.DeleteQueueOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.DeleteQueueInput, ...func(*sqs.Options)) *sqs.DeleteQueueOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.DeleteQueueOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.DeleteQueueInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_DeleteQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueue'
+type mocksqsAPI_DeleteQueue_Call struct {
+	*mock.Call
+}
+
+// DeleteQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.DeleteQueueInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) DeleteQueue(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_DeleteQueue_Call {
+	return &mocksqsAPI_DeleteQueue_Call{Call: _e.mock.On("DeleteQueue",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_DeleteQueue_Call) Run(run func(ctx context.Context, params *sqs.DeleteQueueInput, optFns ...func(*sqs.Options))) *mocksqsAPI_DeleteQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.DeleteQueueInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.DeleteQueueInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_DeleteQueue_Call) Return(deleteQueueOutput *sqs.DeleteQueueOutput, err error) *mocksqsAPI_DeleteQueue_Call {
+	_c.Call.Return(deleteQueueOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_DeleteQueue_Call) RunAndReturn(run func(ctx context.Context, params *sqs.DeleteQueueInput, optFns ...func(*sqs.Options)) (*sqs.DeleteQueueOutput, error)) *mocksqsAPI_DeleteQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueueAttributes provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueueAttributes")
+	}
+
+	var r0 *sqs.GetQueueAttributesOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) *sqs.GetQueueAttributesOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.GetQueueAttributesOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_GetQueueAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueAttributes'
+type mocksqsAPI_GetQueueAttributes_Call struct {
+	*mock.Call
+}
+
+// GetQueueAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.GetQueueAttributesInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) GetQueueAttributes(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_GetQueueAttributes_Call {
+	return &mocksqsAPI_GetQueueAttributes_Call{Call: _e.mock.On("GetQueueAttributes",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_GetQueueAttributes_Call) Run(run func(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options))) *mocksqsAPI_GetQueueAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.GetQueueAttributesInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.GetQueueAttributesInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_GetQueueAttributes_Call) Return(getQueueAttributesOutput *sqs.GetQueueAttributesOutput, err error) *mocksqsAPI_GetQueueAttributes_Call {
+	_c.Call.Return(getQueueAttributesOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_GetQueueAttributes_Call) RunAndReturn(run func(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)) *mocksqsAPI_GetQueueAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueueUrl provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) GetQueueUrl(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueueUrl")
+	}
+
+	var r0 *sqs.GetQueueUrlOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.GetQueueUrlInput, ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.GetQueueUrlInput, ...func(*sqs.Options)) *sqs.GetQueueUrlOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.GetQueueUrlOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.GetQueueUrlInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_GetQueueUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueUrl'
+type mocksqsAPI_GetQueueUrl_Call struct {
+	*mock.Call
+}
+
+// GetQueueUrl is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.GetQueueUrlInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) GetQueueUrl(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_GetQueueUrl_Call {
+	return &mocksqsAPI_GetQueueUrl_Call{Call: _e.mock.On("GetQueueUrl",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_GetQueueUrl_Call) Run(run func(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options))) *mocksqsAPI_GetQueueUrl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.GetQueueUrlInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.GetQueueUrlInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_GetQueueUrl_Call) Return(getQueueUrlOutput *sqs.GetQueueUrlOutput, err error) *mocksqsAPI_GetQueueUrl_Call {
+	_c.Call.Return(getQueueUrlOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_GetQueueUrl_Call) RunAndReturn(run func(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error)) *mocksqsAPI_GetQueueUrl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeadLetterSourceQueues provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) ListDeadLetterSourceQueues(ctx context.Context, params *sqs.ListDeadLetterSourceQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListDeadLetterSourceQueuesOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeadLetterSourceQueues")
+	}
+
+	var r0 *sqs.ListDeadLetterSourceQueuesOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListDeadLetterSourceQueuesInput, ...func(*sqs.Options)) (*sqs.ListDeadLetterSourceQueuesOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListDeadLetterSourceQueuesInput, ...func(*sqs.Options)) *sqs.ListDeadLetterSourceQueuesOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.ListDeadLetterSourceQueuesOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.ListDeadLetterSourceQueuesInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_ListDeadLetterSourceQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeadLetterSourceQueues'
+type mocksqsAPI_ListDeadLetterSourceQueues_Call struct {
+	*mock.Call
+}
+
+// ListDeadLetterSourceQueues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.ListDeadLetterSourceQueuesInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) ListDeadLetterSourceQueues(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_ListDeadLetterSourceQueues_Call {
+	return &mocksqsAPI_ListDeadLetterSourceQueues_Call{Call: _e.mock.On("ListDeadLetterSourceQueues",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_ListDeadLetterSourceQueues_Call) Run(run func(ctx context.Context, params *sqs.ListDeadLetterSourceQueuesInput, optFns ...func(*sqs.Options))) *mocksqsAPI_ListDeadLetterSourceQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.ListDeadLetterSourceQueuesInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.ListDeadLetterSourceQueuesInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_ListDeadLetterSourceQueues_Call) Return(listDeadLetterSourceQueuesOutput *sqs.ListDeadLetterSourceQueuesOutput, err error) *mocksqsAPI_ListDeadLetterSourceQueues_Call {
+	_c.Call.Return(listDeadLetterSourceQueuesOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_ListDeadLetterSourceQueues_Call) RunAndReturn(run func(ctx context.Context, params *sqs.ListDeadLetterSourceQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListDeadLetterSourceQueuesOutput, error)) *mocksqsAPI_ListDeadLetterSourceQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMessageMoveTasks provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) ListMessageMoveTasks(ctx context.Context, params *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMessageMoveTasks")
+	}
+
+	var r0 *sqs.ListMessageMoveTasksOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListMessageMoveTasksInput, ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListMessageMoveTasksInput, ...func(*sqs.Options)) *sqs.ListMessageMoveTasksOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.ListMessageMoveTasksOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.ListMessageMoveTasksInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_ListMessageMoveTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMessageMoveTasks'
+type mocksqsAPI_ListMessageMoveTasks_Call struct {
+	*mock.Call
+}
+
+// ListMessageMoveTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.ListMessageMoveTasksInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) ListMessageMoveTasks(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_ListMessageMoveTasks_Call {
+	return &mocksqsAPI_ListMessageMoveTasks_Call{Call: _e.mock.On("ListMessageMoveTasks",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_ListMessageMoveTasks_Call) Run(run func(ctx context.Context, params *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options))) *mocksqsAPI_ListMessageMoveTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.ListMessageMoveTasksInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.ListMessageMoveTasksInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_ListMessageMoveTasks_Call) Return(listMessageMoveTasksOutput *sqs.ListMessageMoveTasksOutput, err error) *mocksqsAPI_ListMessageMoveTasks_Call {
+	_c.Call.Return(listMessageMoveTasksOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_ListMessageMoveTasks_Call) RunAndReturn(run func(ctx context.Context, params *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error)) *mocksqsAPI_ListMessageMoveTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListQueueTags provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) ListQueueTags(ctx context.Context, params *sqs.ListQueueTagsInput, optFns ...func(*sqs.Options)) (*sqs.ListQueueTagsOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQueueTags")
+	}
+
+	var r0 *sqs.ListQueueTagsOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListQueueTagsInput, ...func(*sqs.Options)) (*sqs.ListQueueTagsOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListQueueTagsInput, ...func(*sqs.Options)) *sqs.ListQueueTagsOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.ListQueueTagsOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.ListQueueTagsInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_ListQueueTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueueTags'
+type mocksqsAPI_ListQueueTags_Call struct {
+	*mock.Call
+}
+
+// ListQueueTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.ListQueueTagsInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) ListQueueTags(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_ListQueueTags_Call {
+	return &mocksqsAPI_ListQueueTags_Call{Call: _e.mock.On("ListQueueTags",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_ListQueueTags_Call) Run(run func(ctx context.Context, params *sqs.ListQueueTagsInput, optFns ...func(*sqs.Options))) *mocksqsAPI_ListQueueTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.ListQueueTagsInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.ListQueueTagsInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_ListQueueTags_Call) Return(listQueueTagsOutput *sqs.ListQueueTagsOutput, err error) *mocksqsAPI_ListQueueTags_Call {
+	_c.Call.Return(listQueueTagsOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_ListQueueTags_Call) RunAndReturn(run func(ctx context.Context, params *sqs.ListQueueTagsInput, optFns ...func(*sqs.Options)) (*sqs.ListQueueTagsOutput, error)) *mocksqsAPI_ListQueueTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListQueues provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) ListQueues(ctx context.Context, params *sqs.ListQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQueues")
+	}
+
+	var r0 *sqs.ListQueuesOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListQueuesInput, ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListQueuesInput, ...func(*sqs.Options)) *sqs.ListQueuesOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.ListQueuesOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.ListQueuesInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_ListQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueues'
+type mocksqsAPI_ListQueues_Call struct {
+	*mock.Call
+}
+
+// ListQueues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.ListQueuesInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) ListQueues(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_ListQueues_Call {
+	return &mocksqsAPI_ListQueues_Call{Call: _e.mock.On("ListQueues",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_ListQueues_Call) Run(run func(ctx context.Context, params *sqs.ListQueuesInput, optFns ...func(*sqs.Options))) *mocksqsAPI_ListQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.ListQueuesInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.ListQueuesInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_ListQueues_Call) Return(listQueuesOutput *sqs.ListQueuesOutput, err error) *mocksqsAPI_ListQueues_Call {
+	_c.Call.Return(listQueuesOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_ListQueues_Call) RunAndReturn(run func(ctx context.Context, params *sqs.ListQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error)) *mocksqsAPI_ListQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeQueue provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) PurgeQueue(ctx context.Context, params *sqs.PurgeQueueInput, optFns ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeQueue")
+	}
+
+	var r0 *sqs.PurgeQueueOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.PurgeQueueInput, ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.PurgeQueueInput, ...func(*sqs.Options)) *sqs.PurgeQueueOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.PurgeQueueOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.PurgeQueueInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_PurgeQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeQueue'
+type mocksqsAPI_PurgeQueue_Call struct {
+	*mock.Call
+}
+
+// PurgeQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.PurgeQueueInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) PurgeQueue(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_PurgeQueue_Call {
+	return &mocksqsAPI_PurgeQueue_Call{Call: _e.mock.On("PurgeQueue",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_PurgeQueue_Call) Run(run func(ctx context.Context, params *sqs.PurgeQueueInput, optFns ...func(*sqs.Options))) *mocksqsAPI_PurgeQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.PurgeQueueInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.PurgeQueueInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_PurgeQueue_Call) Return(purgeQueueOutput *sqs.PurgeQueueOutput, err error) *mocksqsAPI_PurgeQueue_Call {
+	_c.Call.Return(purgeQueueOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_PurgeQueue_Call) RunAndReturn(run func(ctx context.Context, params *sqs.PurgeQueueInput, optFns ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error)) *mocksqsAPI_PurgeQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveMessage provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveMessage")
+	}
+
+	var r0 *sqs.ReceiveMessageOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ReceiveMessageInput, ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ReceiveMessageInput, ...func(*sqs.Options)) *sqs.ReceiveMessageOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.ReceiveMessageOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.ReceiveMessageInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_ReceiveMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMessage'
+type mocksqsAPI_ReceiveMessage_Call struct {
+	*mock.Call
+}
+
+// ReceiveMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.ReceiveMessageInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) ReceiveMessage(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_ReceiveMessage_Call {
+	return &mocksqsAPI_ReceiveMessage_Call{Call: _e.mock.On("ReceiveMessage",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_ReceiveMessage_Call) Run(run func(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options))) *mocksqsAPI_ReceiveMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.ReceiveMessageInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.ReceiveMessageInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_ReceiveMessage_Call) Return(receiveMessageOutput *sqs.ReceiveMessageOutput, err error) *mocksqsAPI_ReceiveMessage_Call {
+	_c.Call.Return(receiveMessageOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_ReceiveMessage_Call) RunAndReturn(run func(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)) *mocksqsAPI_ReceiveMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessage provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 *sqs.SendMessageOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.SendMessageInput, ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.SendMessageInput, ...func(*sqs.Options)) *sqs.SendMessageOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.SendMessageOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.SendMessageInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type mocksqsAPI_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.SendMessageInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) SendMessage(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_SendMessage_Call {
+	return &mocksqsAPI_SendMessage_Call{Call: _e.mock.On("SendMessage",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_SendMessage_Call) Run(run func(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options))) *mocksqsAPI_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.SendMessageInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.SendMessageInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_SendMessage_Call) Return(sendMessageOutput *sqs.SendMessageOutput, err error) *mocksqsAPI_SendMessage_Call {
+	_c.Call.Return(sendMessageOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_SendMessage_Call) RunAndReturn(run func(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)) *mocksqsAPI_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessageBatch provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) SendMessageBatch(ctx context.Context, params *sqs.SendMessageBatchInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessageBatch")
+	}
+
+	var r0 *sqs.SendMessageBatchOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.SendMessageBatchInput, ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.SendMessageBatchInput, ...func(*sqs.Options)) *sqs.SendMessageBatchOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.SendMessageBatchOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.SendMessageBatchInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_SendMessageBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessageBatch'
+type mocksqsAPI_SendMessageBatch_Call struct {
+	*mock.Call
+}
+
+// SendMessageBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.SendMessageBatchInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) SendMessageBatch(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_SendMessageBatch_Call {
+	return &mocksqsAPI_SendMessageBatch_Call{Call: _e.mock.On("SendMessageBatch",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_SendMessageBatch_Call) Run(run func(ctx context.Context, params *sqs.SendMessageBatchInput, optFns ...func(*sqs.Options))) *mocksqsAPI_SendMessageBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.SendMessageBatchInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.SendMessageBatchInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_SendMessageBatch_Call) Return(sendMessageBatchOutput *sqs.SendMessageBatchOutput, err error) *mocksqsAPI_SendMessageBatch_Call {
+	_c.Call.Return(sendMessageBatchOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_SendMessageBatch_Call) RunAndReturn(run func(ctx context.Context, params *sqs.SendMessageBatchInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error)) *mocksqsAPI_SendMessageBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetQueueAttributes provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) SetQueueAttributes(ctx context.Context, params *sqs.SetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetQueueAttributes")
+	}
+
+	var r0 *sqs.SetQueueAttributesOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.SetQueueAttributesInput, ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.SetQueueAttributesInput, ...func(*sqs.Options)) *sqs.SetQueueAttributesOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.SetQueueAttributesOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.SetQueueAttributesInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_SetQueueAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetQueueAttributes'
+type mocksqsAPI_SetQueueAttributes_Call struct {
+	*mock.Call
+}
+
+// SetQueueAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.SetQueueAttributesInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) SetQueueAttributes(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_SetQueueAttributes_Call {
+	return &mocksqsAPI_SetQueueAttributes_Call{Call: _e.mock.On("SetQueueAttributes",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_SetQueueAttributes_Call) Run(run func(ctx context.Context, params *sqs.SetQueueAttributesInput, optFns ...func(*sqs.Options))) *mocksqsAPI_SetQueueAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.SetQueueAttributesInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.SetQueueAttributesInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_SetQueueAttributes_Call) Return(setQueueAttributesOutput *sqs.SetQueueAttributesOutput, err error) *mocksqsAPI_SetQueueAttributes_Call {
+	_c.Call.Return(setQueueAttributesOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_SetQueueAttributes_Call) RunAndReturn(run func(ctx context.Context, params *sqs.SetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error)) *mocksqsAPI_SetQueueAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagQueue provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) TagQueue(ctx context.Context, params *sqs.TagQueueInput, optFns ...func(*sqs.Options)) (*sqs.TagQueueOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagQueue")
+	}
+
+	var r0 *sqs.TagQueueOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.TagQueueInput, ...func(*sqs.Options)) (*sqs.TagQueueOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.TagQueueInput, ...func(*sqs.Options)) *sqs.TagQueueOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.TagQueueOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.TagQueueInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_TagQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagQueue'
+type mocksqsAPI_TagQueue_Call struct {
+	*mock.Call
+}
+
+// TagQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.TagQueueInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) TagQueue(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_TagQueue_Call {
+	return &mocksqsAPI_TagQueue_Call{Call: _e.mock.On("TagQueue",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_TagQueue_Call) Run(run func(ctx context.Context, params *sqs.TagQueueInput, optFns ...func(*sqs.Options))) *mocksqsAPI_TagQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.TagQueueInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.TagQueueInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_TagQueue_Call) Return(tagQueueOutput *sqs.TagQueueOutput, err error) *mocksqsAPI_TagQueue_Call {
+	_c.Call.Return(tagQueueOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_TagQueue_Call) RunAndReturn(run func(ctx context.Context, params *sqs.TagQueueInput, optFns ...func(*sqs.Options)) (*sqs.TagQueueOutput, error)) *mocksqsAPI_TagQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UntagQueue provides a mock function for the type mocksqsAPI
+func (_mock *mocksqsAPI) UntagQueue(ctx context.Context, params *sqs.UntagQueueInput, optFns ...func(*sqs.Options)) (*sqs.UntagQueueOutput, error) {
+	var tmpRet mock.Arguments
+	if len(optFns) > 0 {
+		tmpRet = _mock.Called(ctx, params, optFns)
+	} else {
+		tmpRet = _mock.Called(ctx, params)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for UntagQueue")
+	}
+
+	var r0 *sqs.UntagQueueOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.UntagQueueInput, ...func(*sqs.Options)) (*sqs.UntagQueueOutput, error)); ok {
+		return returnFunc(ctx, params, optFns...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.UntagQueueInput, ...func(*sqs.Options)) *sqs.UntagQueueOutput); ok {
+		r0 = returnFunc(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.UntagQueueOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.UntagQueueInput, ...func(*sqs.Options)) error); ok {
+		r1 = returnFunc(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocksqsAPI_UntagQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntagQueue'
+type mocksqsAPI_UntagQueue_Call struct {
+	*mock.Call
+}
+
+// UntagQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.UntagQueueInput
+//   - optFns ...func(*sqs.Options)
+func (_e *mocksqsAPI_Expecter) UntagQueue(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_UntagQueue_Call {
+	return &mocksqsAPI_UntagQueue_Call{Call: _e.mock.On("UntagQueue",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *mocksqsAPI_UntagQueue_Call) Run(run func(ctx context.Context, params *sqs.UntagQueueInput, optFns ...func(*sqs.Options))) *mocksqsAPI_UntagQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sqs.UntagQueueInput
+		if args[1] != nil {
+			arg1 = args[1].(*sqs.UntagQueueInput)
+		}
+		var arg2 []func(*sqs.Options)
+		var variadicArgs []func(*sqs.Options)
+		if len(args) > 2 {
+			variadicArgs = args[2].([]func(*sqs.Options))
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *mocksqsAPI_UntagQueue_Call) Return(untagQueueOutput *sqs.UntagQueueOutput, err error) *mocksqsAPI_UntagQueue_Call {
+	_c.Call.Return(untagQueueOutput, err)
+	return _c
+}
+
+func (_c *mocksqsAPI_UntagQueue_Call) RunAndReturn(run func(ctx context.Context, params *sqs.UntagQueueInput, optFns ...func(*sqs.Options)) (*sqs.UntagQueueOutput, error)) *mocksqsAPI_UntagQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSnsRepository creates a new instance of MockSnsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSnsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSnsRepository {
+	mock := &MockSnsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSnsRepository is an autogenerated mock type for the SnsRepository type
+type MockSnsRepository struct {
+	mock.Mock
+}
+
+type MockSnsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSnsRepository) EXPECT() *MockSnsRepository_Expecter {
+	return &MockSnsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function for the type MockSnsRepository
+func (_mock *MockSnsRepository) Publish(ctx context.Context, input PublishRepositoryInput) (string, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PublishRepositoryInput) (string, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PublishRepositoryInput) string); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PublishRepositoryInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSnsRepository_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockSnsRepository_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input PublishRepositoryInput
+func (_e *MockSnsRepository_Expecter) Publish(ctx interface{}, input interface{}) *MockSnsRepository_Publish_Call {
+	return &MockSnsRepository_Publish_Call{Call: _e.mock.On("Publish", ctx, input)}
+}
+
+func (_c *MockSnsRepository_Publish_Call) Run(run func(ctx context.Context, input PublishRepositoryInput)) *MockSnsRepository_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 PublishRepositoryInput
+		if args[1] != nil {
+			arg1 = args[1].(PublishRepositoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnsRepository_Publish_Call) Return(s string, err error) *MockSnsRepository_Publish_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockSnsRepository_Publish_Call) RunAndReturn(run func(ctx context.Context, input PublishRepositoryInput) (string, error)) *MockSnsRepository_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueSubscriptions provides a mock function for the type MockSnsRepository
+func (_mock *MockSnsRepository) QueueSubscriptions(ctx context.Context, queueArn string) ([]TopicSubscription, error) {
+	ret := _mock.Called(ctx, queueArn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueSubscriptions")
+	}
+
+	var r0 []TopicSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]TopicSubscription, error)); ok {
+		return returnFunc(ctx, queueArn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []TopicSubscription); ok {
+		r0 = returnFunc(ctx, queueArn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TopicSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueArn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSnsRepository_QueueSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueSubscriptions'
+type MockSnsRepository_QueueSubscriptions_Call struct {
+	*mock.Call
+}
+
+// QueueSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueArn string
+func (_e *MockSnsRepository_Expecter) QueueSubscriptions(ctx interface{}, queueArn interface{}) *MockSnsRepository_QueueSubscriptions_Call {
+	return &MockSnsRepository_QueueSubscriptions_Call{Call: _e.mock.On("QueueSubscriptions", ctx, queueArn)}
+}
+
+func (_c *MockSnsRepository_QueueSubscriptions_Call) Run(run func(ctx context.Context, queueArn string)) *MockSnsRepository_QueueSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnsRepository_QueueSubscriptions_Call) Return(topicSubscriptions []TopicSubscription, err error) *MockSnsRepository_QueueSubscriptions_Call {
+	_c.Call.Return(topicSubscriptions, err)
+	return _c
+}
+
+func (_c *MockSnsRepository_QueueSubscriptions_Call) RunAndReturn(run func(ctx context.Context, queueArn string) ([]TopicSubscription, error)) *MockSnsRepository_QueueSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSqsRepository creates a new instance of MockSqsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSqsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSqsRepository {
+	mock := &MockSqsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSqsRepository is an autogenerated mock type for the SqsRepository type
+type MockSqsRepository struct {
+	mock.Mock
+}
+
+type MockSqsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSqsRepository) EXPECT() *MockSqsRepository_Expecter {
+	return &MockSqsRepository_Expecter{mock: &_m.Mock}
+}
+
+// APIMetrics provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) APIMetrics() APIMetrics {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for APIMetrics")
+	}
+
+	var r0 APIMetrics
+	if returnFunc, ok := ret.Get(0).(func() APIMetrics); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(APIMetrics)
+	}
+	return r0
+}
+
+// MockSqsRepository_APIMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APIMetrics'
+type MockSqsRepository_APIMetrics_Call struct {
+	*mock.Call
+}
+
+// APIMetrics is a helper method to define mock.On call
+func (_e *MockSqsRepository_Expecter) APIMetrics() *MockSqsRepository_APIMetrics_Call {
+	return &MockSqsRepository_APIMetrics_Call{Call: _e.mock.On("APIMetrics")}
+}
+
+func (_c *MockSqsRepository_APIMetrics_Call) Run(run func()) *MockSqsRepository_APIMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_APIMetrics_Call) Return(aPIMetrics APIMetrics) *MockSqsRepository_APIMetrics_Call {
+	_c.Call.Return(aPIMetrics)
+	return _c
+}
+
+func (_c *MockSqsRepository_APIMetrics_Call) RunAndReturn(run func() APIMetrics) *MockSqsRepository_APIMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelMessageMoveTask provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) CancelMessageMoveTask(ctx context.Context, taskHandle string) (int64, error) {
+	ret := _mock.Called(ctx, taskHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelMessageMoveTask")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, taskHandle)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, taskHandle)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_CancelMessageMoveTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelMessageMoveTask'
+type MockSqsRepository_CancelMessageMoveTask_Call struct {
+	*mock.Call
+}
+
+// CancelMessageMoveTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskHandle string
+func (_e *MockSqsRepository_Expecter) CancelMessageMoveTask(ctx interface{}, taskHandle interface{}) *MockSqsRepository_CancelMessageMoveTask_Call {
+	return &MockSqsRepository_CancelMessageMoveTask_Call{Call: _e.mock.On("CancelMessageMoveTask", ctx, taskHandle)}
+}
+
+func (_c *MockSqsRepository_CancelMessageMoveTask_Call) Run(run func(ctx context.Context, taskHandle string)) *MockSqsRepository_CancelMessageMoveTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_CancelMessageMoveTask_Call) Return(int64 int64, err error) *MockSqsRepository_CancelMessageMoveTask_Call {
+	_c.Call.Return(int64, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_CancelMessageMoveTask_Call) RunAndReturn(run func(ctx context.Context, taskHandle string) (int64, error)) *MockSqsRepository_CancelMessageMoveTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeMessageVisibility provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) ChangeMessageVisibility(ctx context.Context, input ChangeMessageVisibilityRepositoryInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeMessageVisibility")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ChangeMessageVisibilityRepositoryInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsRepository_ChangeMessageVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeMessageVisibility'
+type MockSqsRepository_ChangeMessageVisibility_Call struct {
+	*mock.Call
+}
+
+// ChangeMessageVisibility is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input ChangeMessageVisibilityRepositoryInput
+func (_e *MockSqsRepository_Expecter) ChangeMessageVisibility(ctx interface{}, input interface{}) *MockSqsRepository_ChangeMessageVisibility_Call {
+	return &MockSqsRepository_ChangeMessageVisibility_Call{Call: _e.mock.On("ChangeMessageVisibility", ctx, input)}
+}
+
+func (_c *MockSqsRepository_ChangeMessageVisibility_Call) Run(run func(ctx context.Context, input ChangeMessageVisibilityRepositoryInput)) *MockSqsRepository_ChangeMessageVisibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ChangeMessageVisibilityRepositoryInput
+		if args[1] != nil {
+			arg1 = args[1].(ChangeMessageVisibilityRepositoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_ChangeMessageVisibility_Call) Return(err error) *MockSqsRepository_ChangeMessageVisibility_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsRepository_ChangeMessageVisibility_Call) RunAndReturn(run func(ctx context.Context, input ChangeMessageVisibilityRepositoryInput) error) *MockSqsRepository_ChangeMessageVisibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQueue provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) CreateQueue(ctx context.Context, input CreateQueueRepositoryInput) (string, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQueue")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateQueueRepositoryInput) (string, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateQueueRepositoryInput) string); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateQueueRepositoryInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_CreateQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQueue'
+type MockSqsRepository_CreateQueue_Call struct {
+	*mock.Call
+}
+
+// CreateQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input CreateQueueRepositoryInput
+func (_e *MockSqsRepository_Expecter) CreateQueue(ctx interface{}, input interface{}) *MockSqsRepository_CreateQueue_Call {
+	return &MockSqsRepository_CreateQueue_Call{Call: _e.mock.On("CreateQueue", ctx, input)}
+}
+
+func (_c *MockSqsRepository_CreateQueue_Call) Run(run func(ctx context.Context, input CreateQueueRepositoryInput)) *MockSqsRepository_CreateQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateQueueRepositoryInput
+		if args[1] != nil {
+			arg1 = args[1].(CreateQueueRepositoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_CreateQueue_Call) Return(s string, err error) *MockSqsRepository_CreateQueue_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_CreateQueue_Call) RunAndReturn(run func(ctx context.Context, input CreateQueueRepositoryInput) (string, error)) *MockSqsRepository_CreateQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMessage provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) DeleteMessage(ctx context.Context, input DeleteMessageRepositoryInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DeleteMessageRepositoryInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsRepository_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
+type MockSqsRepository_DeleteMessage_Call struct {
+	*mock.Call
+}
+
+// DeleteMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input DeleteMessageRepositoryInput
+func (_e *MockSqsRepository_Expecter) DeleteMessage(ctx interface{}, input interface{}) *MockSqsRepository_DeleteMessage_Call {
+	return &MockSqsRepository_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage", ctx, input)}
+}
+
+func (_c *MockSqsRepository_DeleteMessage_Call) Run(run func(ctx context.Context, input DeleteMessageRepositoryInput)) *MockSqsRepository_DeleteMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DeleteMessageRepositoryInput
+		if args[1] != nil {
+			arg1 = args[1].(DeleteMessageRepositoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_DeleteMessage_Call) Return(err error) *MockSqsRepository_DeleteMessage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsRepository_DeleteMessage_Call) RunAndReturn(run func(ctx context.Context, input DeleteMessageRepositoryInput) error) *MockSqsRepository_DeleteMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQueue provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) DeleteQueue(ctx context.Context, queueURL string) error {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsRepository_DeleteQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueue'
+type MockSqsRepository_DeleteQueue_Call struct {
+	*mock.Call
+}
+
+// DeleteQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsRepository_Expecter) DeleteQueue(ctx interface{}, queueURL interface{}) *MockSqsRepository_DeleteQueue_Call {
+	return &MockSqsRepository_DeleteQueue_Call{Call: _e.mock.On("DeleteQueue", ctx, queueURL)}
+}
+
+func (_c *MockSqsRepository_DeleteQueue_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsRepository_DeleteQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_DeleteQueue_Call) Return(err error) *MockSqsRepository_DeleteQueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsRepository_DeleteQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsRepository_DeleteQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueueAttributes provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) GetQueueAttributes(ctx context.Context, queueURL string, names []string) (map[string]string, error) {
+	ret := _mock.Called(ctx, queueURL, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueueAttributes")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) (map[string]string, error)); ok {
+		return returnFunc(ctx, queueURL, names)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) map[string]string); ok {
+		r0 = returnFunc(ctx, queueURL, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, queueURL, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_GetQueueAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueAttributes'
+type MockSqsRepository_GetQueueAttributes_Call struct {
+	*mock.Call
+}
+
+// GetQueueAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - names []string
+func (_e *MockSqsRepository_Expecter) GetQueueAttributes(ctx interface{}, queueURL interface{}, names interface{}) *MockSqsRepository_GetQueueAttributes_Call {
+	return &MockSqsRepository_GetQueueAttributes_Call{Call: _e.mock.On("GetQueueAttributes", ctx, queueURL, names)}
+}
+
+func (_c *MockSqsRepository_GetQueueAttributes_Call) Run(run func(ctx context.Context, queueURL string, names []string)) *MockSqsRepository_GetQueueAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_GetQueueAttributes_Call) Return(stringToString map[string]string, err error) *MockSqsRepository_GetQueueAttributes_Call {
+	_c.Call.Return(stringToString, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_GetQueueAttributes_Call) RunAndReturn(run func(ctx context.Context, queueURL string, names []string) (map[string]string, error)) *MockSqsRepository_GetQueueAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueueDetail provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) GetQueueDetail(ctx context.Context, queueURL string) (QueueDetail, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueueDetail")
+	}
+
+	var r0 QueueDetail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QueueDetail, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QueueDetail); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(QueueDetail)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_GetQueueDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueDetail'
+type MockSqsRepository_GetQueueDetail_Call struct {
+	*mock.Call
+}
+
+// GetQueueDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsRepository_Expecter) GetQueueDetail(ctx interface{}, queueURL interface{}) *MockSqsRepository_GetQueueDetail_Call {
+	return &MockSqsRepository_GetQueueDetail_Call{Call: _e.mock.On("GetQueueDetail", ctx, queueURL)}
+}
+
+func (_c *MockSqsRepository_GetQueueDetail_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsRepository_GetQueueDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_GetQueueDetail_Call) Return(queueDetail QueueDetail, err error) *MockSqsRepository_GetQueueDetail_Call {
+	_c.Call.Return(queueDetail, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_GetQueueDetail_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (QueueDetail, error)) *MockSqsRepository_GetQueueDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueueStats provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) GetQueueStats(ctx context.Context, queueURL string) (QueueStats, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueueStats")
+	}
+
+	var r0 QueueStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QueueStats, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QueueStats); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(QueueStats)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_GetQueueStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueStats'
+type MockSqsRepository_GetQueueStats_Call struct {
+	*mock.Call
+}
+
+// GetQueueStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsRepository_Expecter) GetQueueStats(ctx interface{}, queueURL interface{}) *MockSqsRepository_GetQueueStats_Call {
+	return &MockSqsRepository_GetQueueStats_Call{Call: _e.mock.On("GetQueueStats", ctx, queueURL)}
+}
+
+func (_c *MockSqsRepository_GetQueueStats_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsRepository_GetQueueStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_GetQueueStats_Call) Return(queueStats QueueStats, err error) *MockSqsRepository_GetQueueStats_Call {
+	_c.Call.Return(queueStats, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_GetQueueStats_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (QueueStats, error)) *MockSqsRepository_GetQueueStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeadLetterSourceQueues provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) ListDeadLetterSourceQueues(ctx context.Context, queueURL string) ([]string, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeadLetterSourceQueues")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_ListDeadLetterSourceQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeadLetterSourceQueues'
+type MockSqsRepository_ListDeadLetterSourceQueues_Call struct {
+	*mock.Call
+}
+
+// ListDeadLetterSourceQueues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsRepository_Expecter) ListDeadLetterSourceQueues(ctx interface{}, queueURL interface{}) *MockSqsRepository_ListDeadLetterSourceQueues_Call {
+	return &MockSqsRepository_ListDeadLetterSourceQueues_Call{Call: _e.mock.On("ListDeadLetterSourceQueues", ctx, queueURL)}
+}
+
+func (_c *MockSqsRepository_ListDeadLetterSourceQueues_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsRepository_ListDeadLetterSourceQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_ListDeadLetterSourceQueues_Call) Return(strings []string, err error) *MockSqsRepository_ListDeadLetterSourceQueues_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_ListDeadLetterSourceQueues_Call) RunAndReturn(run func(ctx context.Context, queueURL string) ([]string, error)) *MockSqsRepository_ListDeadLetterSourceQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMessageMoveTasks provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) ListMessageMoveTasks(ctx context.Context, sourceArn string) ([]MessageMoveTask, error) {
+	ret := _mock.Called(ctx, sourceArn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMessageMoveTasks")
+	}
+
+	var r0 []MessageMoveTask
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]MessageMoveTask, error)); ok {
+		return returnFunc(ctx, sourceArn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []MessageMoveTask); ok {
+		r0 = returnFunc(ctx, sourceArn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]MessageMoveTask)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sourceArn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_ListMessageMoveTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMessageMoveTasks'
+type MockSqsRepository_ListMessageMoveTasks_Call struct {
+	*mock.Call
+}
+
+// ListMessageMoveTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceArn string
+func (_e *MockSqsRepository_Expecter) ListMessageMoveTasks(ctx interface{}, sourceArn interface{}) *MockSqsRepository_ListMessageMoveTasks_Call {
+	return &MockSqsRepository_ListMessageMoveTasks_Call{Call: _e.mock.On("ListMessageMoveTasks", ctx, sourceArn)}
+}
+
+func (_c *MockSqsRepository_ListMessageMoveTasks_Call) Run(run func(ctx context.Context, sourceArn string)) *MockSqsRepository_ListMessageMoveTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_ListMessageMoveTasks_Call) Return(messageMoveTasks []MessageMoveTask, err error) *MockSqsRepository_ListMessageMoveTasks_Call {
+	_c.Call.Return(messageMoveTasks, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_ListMessageMoveTasks_Call) RunAndReturn(run func(ctx context.Context, sourceArn string) ([]MessageMoveTask, error)) *MockSqsRepository_ListMessageMoveTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListQueueTags provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) ListQueueTags(ctx context.Context, queueURL string) (map[string]string, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQueueTags")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (map[string]string, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) map[string]string); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_ListQueueTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueueTags'
+type MockSqsRepository_ListQueueTags_Call struct {
+	*mock.Call
+}
+
+// ListQueueTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsRepository_Expecter) ListQueueTags(ctx interface{}, queueURL interface{}) *MockSqsRepository_ListQueueTags_Call {
+	return &MockSqsRepository_ListQueueTags_Call{Call: _e.mock.On("ListQueueTags", ctx, queueURL)}
+}
+
+func (_c *MockSqsRepository_ListQueueTags_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsRepository_ListQueueTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_ListQueueTags_Call) Return(stringToString map[string]string, err error) *MockSqsRepository_ListQueueTags_Call {
+	_c.Call.Return(stringToString, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_ListQueueTags_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (map[string]string, error)) *MockSqsRepository_ListQueueTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListQueues provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) ListQueues(ctx context.Context) ([]QueueSummary, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQueues")
+	}
+
+	var r0 []QueueSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]QueueSummary, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []QueueSummary); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]QueueSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_ListQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueues'
+type MockSqsRepository_ListQueues_Call struct {
+	*mock.Call
+}
+
+// ListQueues is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsRepository_Expecter) ListQueues(ctx interface{}) *MockSqsRepository_ListQueues_Call {
+	return &MockSqsRepository_ListQueues_Call{Call: _e.mock.On("ListQueues", ctx)}
+}
+
+func (_c *MockSqsRepository_ListQueues_Call) Run(run func(ctx context.Context)) *MockSqsRepository_ListQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_ListQueues_Call) Return(queueSummarys []QueueSummary, err error) *MockSqsRepository_ListQueues_Call {
+	_c.Call.Return(queueSummarys, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_ListQueues_Call) RunAndReturn(run func(ctx context.Context) ([]QueueSummary, error)) *MockSqsRepository_ListQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListQueuesPage provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) ListQueuesPage(ctx context.Context, input ListQueuesPageRepositoryInput) (QueueSummaryPage, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQueuesPage")
+	}
+
+	var r0 QueueSummaryPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListQueuesPageRepositoryInput) (QueueSummaryPage, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ListQueuesPageRepositoryInput) QueueSummaryPage); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(QueueSummaryPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ListQueuesPageRepositoryInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_ListQueuesPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueuesPage'
+type MockSqsRepository_ListQueuesPage_Call struct {
+	*mock.Call
+}
+
+// ListQueuesPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input ListQueuesPageRepositoryInput
+func (_e *MockSqsRepository_Expecter) ListQueuesPage(ctx interface{}, input interface{}) *MockSqsRepository_ListQueuesPage_Call {
+	return &MockSqsRepository_ListQueuesPage_Call{Call: _e.mock.On("ListQueuesPage", ctx, input)}
+}
+
+func (_c *MockSqsRepository_ListQueuesPage_Call) Run(run func(ctx context.Context, input ListQueuesPageRepositoryInput)) *MockSqsRepository_ListQueuesPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ListQueuesPageRepositoryInput
+		if args[1] != nil {
+			arg1 = args[1].(ListQueuesPageRepositoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_ListQueuesPage_Call) Return(queueSummaryPage QueueSummaryPage, err error) *MockSqsRepository_ListQueuesPage_Call {
+	_c.Call.Return(queueSummaryPage, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_ListQueuesPage_Call) RunAndReturn(run func(ctx context.Context, input ListQueuesPageRepositoryInput) (QueueSummaryPage, error)) *MockSqsRepository_ListQueuesPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeQueue provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) PurgeQueue(ctx context.Context, queueURL string) error {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeQueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsRepository_PurgeQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeQueue'
+type MockSqsRepository_PurgeQueue_Call struct {
+	*mock.Call
+}
+
+// PurgeQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsRepository_Expecter) PurgeQueue(ctx interface{}, queueURL interface{}) *MockSqsRepository_PurgeQueue_Call {
+	return &MockSqsRepository_PurgeQueue_Call{Call: _e.mock.On("PurgeQueue", ctx, queueURL)}
+}
+
+func (_c *MockSqsRepository_PurgeQueue_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsRepository_PurgeQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_PurgeQueue_Call) Return(err error) *MockSqsRepository_PurgeQueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsRepository_PurgeQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsRepository_PurgeQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueURL provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) QueueURL(ctx context.Context, name string) (string, bool, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueURL")
+	}
+
+	var r0 string
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, bool, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, name)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockSqsRepository_QueueURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueURL'
+type MockSqsRepository_QueueURL_Call struct {
+	*mock.Call
+}
+
+// QueueURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockSqsRepository_Expecter) QueueURL(ctx interface{}, name interface{}) *MockSqsRepository_QueueURL_Call {
+	return &MockSqsRepository_QueueURL_Call{Call: _e.mock.On("QueueURL", ctx, name)}
+}
+
+func (_c *MockSqsRepository_QueueURL_Call) Run(run func(ctx context.Context, name string)) *MockSqsRepository_QueueURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_QueueURL_Call) Return(s string, b bool, err error) *MockSqsRepository_QueueURL_Call {
+	_c.Call.Return(s, b, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_QueueURL_Call) RunAndReturn(run func(ctx context.Context, name string) (string, bool, error)) *MockSqsRepository_QueueURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveMessages provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) ReceiveMessages(ctx context.Context, input ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveMessages")
+	}
+
+	var r0 []ReceivedMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ReceiveMessagesRepositoryInput) []ReceivedMessage); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ReceivedMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ReceiveMessagesRepositoryInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_ReceiveMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMessages'
+type MockSqsRepository_ReceiveMessages_Call struct {
+	*mock.Call
+}
+
+// ReceiveMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input ReceiveMessagesRepositoryInput
+func (_e *MockSqsRepository_Expecter) ReceiveMessages(ctx interface{}, input interface{}) *MockSqsRepository_ReceiveMessages_Call {
+	return &MockSqsRepository_ReceiveMessages_Call{Call: _e.mock.On("ReceiveMessages", ctx, input)}
+}
+
+func (_c *MockSqsRepository_ReceiveMessages_Call) Run(run func(ctx context.Context, input ReceiveMessagesRepositoryInput)) *MockSqsRepository_ReceiveMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ReceiveMessagesRepositoryInput
+		if args[1] != nil {
+			arg1 = args[1].(ReceiveMessagesRepositoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_ReceiveMessages_Call) Return(receivedMessages []ReceivedMessage, err error) *MockSqsRepository_ReceiveMessages_Call {
+	_c.Call.Return(receivedMessages, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_ReceiveMessages_Call) RunAndReturn(run func(ctx context.Context, input ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error)) *MockSqsRepository_ReceiveMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessage provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) SendMessage(ctx context.Context, input SendMessageRepositoryInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SendMessageRepositoryInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsRepository_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type MockSqsRepository_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input SendMessageRepositoryInput
+func (_e *MockSqsRepository_Expecter) SendMessage(ctx interface{}, input interface{}) *MockSqsRepository_SendMessage_Call {
+	return &MockSqsRepository_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, input)}
+}
+
+func (_c *MockSqsRepository_SendMessage_Call) Run(run func(ctx context.Context, input SendMessageRepositoryInput)) *MockSqsRepository_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 SendMessageRepositoryInput
+		if args[1] != nil {
+			arg1 = args[1].(SendMessageRepositoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_SendMessage_Call) Return(err error) *MockSqsRepository_SendMessage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsRepository_SendMessage_Call) RunAndReturn(run func(ctx context.Context, input SendMessageRepositoryInput) error) *MockSqsRepository_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessageBatch provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) SendMessageBatch(ctx context.Context, input SendMessageBatchRepositoryInput) ([]SendMessageBatchFailure, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessageBatch")
+	}
+
+	var r0 []SendMessageBatchFailure
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SendMessageBatchRepositoryInput) ([]SendMessageBatchFailure, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SendMessageBatchRepositoryInput) []SendMessageBatchFailure); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]SendMessageBatchFailure)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SendMessageBatchRepositoryInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsRepository_SendMessageBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessageBatch'
+type MockSqsRepository_SendMessageBatch_Call struct {
+	*mock.Call
+}
+
+// SendMessageBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input SendMessageBatchRepositoryInput
+func (_e *MockSqsRepository_Expecter) SendMessageBatch(ctx interface{}, input interface{}) *MockSqsRepository_SendMessageBatch_Call {
+	return &MockSqsRepository_SendMessageBatch_Call{Call: _e.mock.On("SendMessageBatch", ctx, input)}
+}
+
+func (_c *MockSqsRepository_SendMessageBatch_Call) Run(run func(ctx context.Context, input SendMessageBatchRepositoryInput)) *MockSqsRepository_SendMessageBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 SendMessageBatchRepositoryInput
+		if args[1] != nil {
+			arg1 = args[1].(SendMessageBatchRepositoryInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_SendMessageBatch_Call) Return(sendMessageBatchFailures []SendMessageBatchFailure, err error) *MockSqsRepository_SendMessageBatch_Call {
+	_c.Call.Return(sendMessageBatchFailures, err)
+	return _c
+}
+
+func (_c *MockSqsRepository_SendMessageBatch_Call) RunAndReturn(run func(ctx context.Context, input SendMessageBatchRepositoryInput) ([]SendMessageBatchFailure, error)) *MockSqsRepository_SendMessageBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetQueueAttributes provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) SetQueueAttributes(ctx context.Context, queueURL string, attributes map[string]string) error {
+	ret := _mock.Called(ctx, queueURL, attributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetQueueAttributes")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]string) error); ok {
+		r0 = returnFunc(ctx, queueURL, attributes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsRepository_SetQueueAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetQueueAttributes'
+type MockSqsRepository_SetQueueAttributes_Call struct {
+	*mock.Call
+}
+
+// SetQueueAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - attributes map[string]string
+func (_e *MockSqsRepository_Expecter) SetQueueAttributes(ctx interface{}, queueURL interface{}, attributes interface{}) *MockSqsRepository_SetQueueAttributes_Call {
+	return &MockSqsRepository_SetQueueAttributes_Call{Call: _e.mock.On("SetQueueAttributes", ctx, queueURL, attributes)}
+}
+
+func (_c *MockSqsRepository_SetQueueAttributes_Call) Run(run func(ctx context.Context, queueURL string, attributes map[string]string)) *MockSqsRepository_SetQueueAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_SetQueueAttributes_Call) Return(err error) *MockSqsRepository_SetQueueAttributes_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsRepository_SetQueueAttributes_Call) RunAndReturn(run func(ctx context.Context, queueURL string, attributes map[string]string) error) *MockSqsRepository_SetQueueAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagQueue provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) TagQueue(ctx context.Context, queueURL string, tags map[string]string) error {
+	ret := _mock.Called(ctx, queueURL, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagQueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]string) error); ok {
+		r0 = returnFunc(ctx, queueURL, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsRepository_TagQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagQueue'
+type MockSqsRepository_TagQueue_Call struct {
+	*mock.Call
+}
+
+// TagQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - tags map[string]string
+func (_e *MockSqsRepository_Expecter) TagQueue(ctx interface{}, queueURL interface{}, tags interface{}) *MockSqsRepository_TagQueue_Call {
+	return &MockSqsRepository_TagQueue_Call{Call: _e.mock.On("TagQueue", ctx, queueURL, tags)}
+}
+
+func (_c *MockSqsRepository_TagQueue_Call) Run(run func(ctx context.Context, queueURL string, tags map[string]string)) *MockSqsRepository_TagQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_TagQueue_Call) Return(err error) *MockSqsRepository_TagQueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsRepository_TagQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string, tags map[string]string) error) *MockSqsRepository_TagQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UntagQueue provides a mock function for the type MockSqsRepository
+func (_mock *MockSqsRepository) UntagQueue(ctx context.Context, queueURL string, keys []string) error {
+	ret := _mock.Called(ctx, queueURL, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UntagQueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, queueURL, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsRepository_UntagQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntagQueue'
+type MockSqsRepository_UntagQueue_Call struct {
+	*mock.Call
+}
+
+// UntagQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - keys []string
+func (_e *MockSqsRepository_Expecter) UntagQueue(ctx interface{}, queueURL interface{}, keys interface{}) *MockSqsRepository_UntagQueue_Call {
+	return &MockSqsRepository_UntagQueue_Call{Call: _e.mock.On("UntagQueue", ctx, queueURL, keys)}
+}
+
+func (_c *MockSqsRepository_UntagQueue_Call) Run(run func(ctx context.Context, queueURL string, keys []string)) *MockSqsRepository_UntagQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsRepository_UntagQueue_Call) Return(err error) *MockSqsRepository_UntagQueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsRepository_UntagQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string, keys []string) error) *MockSqsRepository_UntagQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSqsService creates a new instance of MockSqsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSqsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSqsService {
+	mock := &MockSqsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSqsService is an autogenerated mock type for the SqsService type
+type MockSqsService struct {
+	mock.Mock
+}
+
+type MockSqsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSqsService) EXPECT() *MockSqsService_Expecter {
+	return &MockSqsService_Expecter{mock: &_m.Mock}
+}
+
+// APIMetrics provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) APIMetrics(ctx context.Context) APIMetrics {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for APIMetrics")
+	}
+
+	var r0 APIMetrics
+	if returnFunc, ok := ret.Get(0).(func(context.Context) APIMetrics); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(APIMetrics)
+	}
+	return r0
+}
+
+// MockSqsService_APIMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APIMetrics'
+type MockSqsService_APIMetrics_Call struct {
+	*mock.Call
+}
+
+// APIMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) APIMetrics(ctx interface{}) *MockSqsService_APIMetrics_Call {
+	return &MockSqsService_APIMetrics_Call{Call: _e.mock.On("APIMetrics", ctx)}
+}
+
+func (_c *MockSqsService_APIMetrics_Call) Run(run func(ctx context.Context)) *MockSqsService_APIMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_APIMetrics_Call) Return(aPIMetrics APIMetrics) *MockSqsService_APIMetrics_Call {
+	_c.Call.Return(aPIMetrics)
+	return _c
+}
+
+func (_c *MockSqsService_APIMetrics_Call) RunAndReturn(run func(ctx context.Context) APIMetrics) *MockSqsService_APIMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlertRules provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) AlertRules(ctx context.Context) ([]AlertRuleState, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertRules")
+	}
+
+	var r0 []AlertRuleState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]AlertRuleState, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []AlertRuleState); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AlertRuleState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_AlertRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlertRules'
+type MockSqsService_AlertRules_Call struct {
+	*mock.Call
+}
+
+// AlertRules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) AlertRules(ctx interface{}) *MockSqsService_AlertRules_Call {
+	return &MockSqsService_AlertRules_Call{Call: _e.mock.On("AlertRules", ctx)}
+}
+
+func (_c *MockSqsService_AlertRules_Call) Run(run func(ctx context.Context)) *MockSqsService_AlertRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_AlertRules_Call) Return(alertRuleStates []AlertRuleState, err error) *MockSqsService_AlertRules_Call {
+	_c.Call.Return(alertRuleStates, err)
+	return _c
+}
+
+func (_c *MockSqsService_AlertRules_Call) RunAndReturn(run func(ctx context.Context) ([]AlertRuleState, error)) *MockSqsService_AlertRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AnalyzeMessageFields provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) AnalyzeMessageFields(ctx context.Context, queueURL string, samples int) (MessageFieldReport, error) {
+	ret := _mock.Called(ctx, queueURL, samples)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnalyzeMessageFields")
+	}
+
+	var r0 MessageFieldReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (MessageFieldReport, error)); ok {
+		return returnFunc(ctx, queueURL, samples)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) MessageFieldReport); ok {
+		r0 = returnFunc(ctx, queueURL, samples)
+	} else {
+		r0 = ret.Get(0).(MessageFieldReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, queueURL, samples)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_AnalyzeMessageFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnalyzeMessageFields'
+type MockSqsService_AnalyzeMessageFields_Call struct {
+	*mock.Call
+}
+
+// AnalyzeMessageFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - samples int
+func (_e *MockSqsService_Expecter) AnalyzeMessageFields(ctx interface{}, queueURL interface{}, samples interface{}) *MockSqsService_AnalyzeMessageFields_Call {
+	return &MockSqsService_AnalyzeMessageFields_Call{Call: _e.mock.On("AnalyzeMessageFields", ctx, queueURL, samples)}
+}
+
+func (_c *MockSqsService_AnalyzeMessageFields_Call) Run(run func(ctx context.Context, queueURL string, samples int)) *MockSqsService_AnalyzeMessageFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_AnalyzeMessageFields_Call) Return(messageFieldReport MessageFieldReport, err error) *MockSqsService_AnalyzeMessageFields_Call {
+	_c.Call.Return(messageFieldReport, err)
+	return _c
+}
+
+func (_c *MockSqsService_AnalyzeMessageFields_Call) RunAndReturn(run func(ctx context.Context, queueURL string, samples int) (MessageFieldReport, error)) *MockSqsService_AnalyzeMessageFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AnalyzeMessageGroups provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) AnalyzeMessageGroups(ctx context.Context, queueURL string, samples int) (MessageGroupReport, error) {
+	ret := _mock.Called(ctx, queueURL, samples)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnalyzeMessageGroups")
+	}
+
+	var r0 MessageGroupReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (MessageGroupReport, error)); ok {
+		return returnFunc(ctx, queueURL, samples)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) MessageGroupReport); ok {
+		r0 = returnFunc(ctx, queueURL, samples)
+	} else {
+		r0 = ret.Get(0).(MessageGroupReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, queueURL, samples)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_AnalyzeMessageGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnalyzeMessageGroups'
+type MockSqsService_AnalyzeMessageGroups_Call struct {
+	*mock.Call
+}
+
+// AnalyzeMessageGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - samples int
+func (_e *MockSqsService_Expecter) AnalyzeMessageGroups(ctx interface{}, queueURL interface{}, samples interface{}) *MockSqsService_AnalyzeMessageGroups_Call {
+	return &MockSqsService_AnalyzeMessageGroups_Call{Call: _e.mock.On("AnalyzeMessageGroups", ctx, queueURL, samples)}
+}
+
+func (_c *MockSqsService_AnalyzeMessageGroups_Call) Run(run func(ctx context.Context, queueURL string, samples int)) *MockSqsService_AnalyzeMessageGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_AnalyzeMessageGroups_Call) Return(messageGroupReport MessageGroupReport, err error) *MockSqsService_AnalyzeMessageGroups_Call {
+	_c.Call.Return(messageGroupReport, err)
+	return _c
+}
+
+func (_c *MockSqsService_AnalyzeMessageGroups_Call) RunAndReturn(run func(ctx context.Context, queueURL string, samples int) (MessageGroupReport, error)) *MockSqsService_AnalyzeMessageGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AnalyzeMessageSizes provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) AnalyzeMessageSizes(ctx context.Context, queueURL string, samples int) (MessageSizeReport, error) {
+	ret := _mock.Called(ctx, queueURL, samples)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnalyzeMessageSizes")
+	}
+
+	var r0 MessageSizeReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (MessageSizeReport, error)); ok {
+		return returnFunc(ctx, queueURL, samples)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) MessageSizeReport); ok {
+		r0 = returnFunc(ctx, queueURL, samples)
+	} else {
+		r0 = ret.Get(0).(MessageSizeReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, queueURL, samples)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_AnalyzeMessageSizes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnalyzeMessageSizes'
+type MockSqsService_AnalyzeMessageSizes_Call struct {
+	*mock.Call
+}
+
+// AnalyzeMessageSizes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - samples int
+func (_e *MockSqsService_Expecter) AnalyzeMessageSizes(ctx interface{}, queueURL interface{}, samples interface{}) *MockSqsService_AnalyzeMessageSizes_Call {
+	return &MockSqsService_AnalyzeMessageSizes_Call{Call: _e.mock.On("AnalyzeMessageSizes", ctx, queueURL, samples)}
+}
+
+func (_c *MockSqsService_AnalyzeMessageSizes_Call) Run(run func(ctx context.Context, queueURL string, samples int)) *MockSqsService_AnalyzeMessageSizes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_AnalyzeMessageSizes_Call) Return(messageSizeReport MessageSizeReport, err error) *MockSqsService_AnalyzeMessageSizes_Call {
+	_c.Call.Return(messageSizeReport, err)
+	return _c
+}
+
+func (_c *MockSqsService_AnalyzeMessageSizes_Call) RunAndReturn(run func(ctx context.Context, queueURL string, samples int) (MessageSizeReport, error)) *MockSqsService_AnalyzeMessageSizes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BenchmarkProducer provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) BenchmarkProducer(ctx context.Context, input ProducerBenchmarkInput) (Job, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BenchmarkProducer")
+	}
+
+	var r0 Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ProducerBenchmarkInput) (Job, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ProducerBenchmarkInput) Job); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(Job)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ProducerBenchmarkInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_BenchmarkProducer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BenchmarkProducer'
+type MockSqsService_BenchmarkProducer_Call struct {
+	*mock.Call
+}
+
+// BenchmarkProducer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input ProducerBenchmarkInput
+func (_e *MockSqsService_Expecter) BenchmarkProducer(ctx interface{}, input interface{}) *MockSqsService_BenchmarkProducer_Call {
+	return &MockSqsService_BenchmarkProducer_Call{Call: _e.mock.On("BenchmarkProducer", ctx, input)}
+}
+
+func (_c *MockSqsService_BenchmarkProducer_Call) Run(run func(ctx context.Context, input ProducerBenchmarkInput)) *MockSqsService_BenchmarkProducer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ProducerBenchmarkInput
+		if args[1] != nil {
+			arg1 = args[1].(ProducerBenchmarkInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_BenchmarkProducer_Call) Return(job Job, err error) *MockSqsService_BenchmarkProducer_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockSqsService_BenchmarkProducer_Call) RunAndReturn(run func(ctx context.Context, input ProducerBenchmarkInput) (Job, error)) *MockSqsService_BenchmarkProducer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelMessageMoveTask provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CancelMessageMoveTask(ctx context.Context, queueURL string, taskHandle string) (int64, error) {
+	ret := _mock.Called(ctx, queueURL, taskHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelMessageMoveTask")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return returnFunc(ctx, queueURL, taskHandle)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = returnFunc(ctx, queueURL, taskHandle)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, queueURL, taskHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CancelMessageMoveTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelMessageMoveTask'
+type MockSqsService_CancelMessageMoveTask_Call struct {
+	*mock.Call
+}
+
+// CancelMessageMoveTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - taskHandle string
+func (_e *MockSqsService_Expecter) CancelMessageMoveTask(ctx interface{}, queueURL interface{}, taskHandle interface{}) *MockSqsService_CancelMessageMoveTask_Call {
+	return &MockSqsService_CancelMessageMoveTask_Call{Call: _e.mock.On("CancelMessageMoveTask", ctx, queueURL, taskHandle)}
+}
+
+func (_c *MockSqsService_CancelMessageMoveTask_Call) Run(run func(ctx context.Context, queueURL string, taskHandle string)) *MockSqsService_CancelMessageMoveTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CancelMessageMoveTask_Call) Return(int64 int64, err error) *MockSqsService_CancelMessageMoveTask_Call {
+	_c.Call.Return(int64, err)
+	return _c
+}
+
+func (_c *MockSqsService_CancelMessageMoveTask_Call) RunAndReturn(run func(ctx context.Context, queueURL string, taskHandle string) (int64, error)) *MockSqsService_CancelMessageMoveTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckDeadLetterArrivals provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CheckDeadLetterArrivals(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDeadLetterArrivals")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_CheckDeadLetterArrivals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckDeadLetterArrivals'
+type MockSqsService_CheckDeadLetterArrivals_Call struct {
+	*mock.Call
+}
+
+// CheckDeadLetterArrivals is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) CheckDeadLetterArrivals(ctx interface{}) *MockSqsService_CheckDeadLetterArrivals_Call {
+	return &MockSqsService_CheckDeadLetterArrivals_Call{Call: _e.mock.On("CheckDeadLetterArrivals", ctx)}
+}
+
+func (_c *MockSqsService_CheckDeadLetterArrivals_Call) Run(run func(ctx context.Context)) *MockSqsService_CheckDeadLetterArrivals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CheckDeadLetterArrivals_Call) Return(err error) *MockSqsService_CheckDeadLetterArrivals_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_CheckDeadLetterArrivals_Call) RunAndReturn(run func(ctx context.Context) error) *MockSqsService_CheckDeadLetterArrivals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckDrift provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CheckDrift(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDrift")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_CheckDrift_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckDrift'
+type MockSqsService_CheckDrift_Call struct {
+	*mock.Call
+}
+
+// CheckDrift is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) CheckDrift(ctx interface{}) *MockSqsService_CheckDrift_Call {
+	return &MockSqsService_CheckDrift_Call{Call: _e.mock.On("CheckDrift", ctx)}
+}
+
+func (_c *MockSqsService_CheckDrift_Call) Run(run func(ctx context.Context)) *MockSqsService_CheckDrift_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CheckDrift_Call) Return(err error) *MockSqsService_CheckDrift_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_CheckDrift_Call) RunAndReturn(run func(ctx context.Context) error) *MockSqsService_CheckDrift_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckQueueName provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CheckQueueName(ctx context.Context, name string, queueType QueueType) (QueueNameCheck, error) {
+	ret := _mock.Called(ctx, name, queueType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckQueueName")
+	}
+
+	var r0 QueueNameCheck
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, QueueType) (QueueNameCheck, error)); ok {
+		return returnFunc(ctx, name, queueType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, QueueType) QueueNameCheck); ok {
+		r0 = returnFunc(ctx, name, queueType)
+	} else {
+		r0 = ret.Get(0).(QueueNameCheck)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, QueueType) error); ok {
+		r1 = returnFunc(ctx, name, queueType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CheckQueueName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckQueueName'
+type MockSqsService_CheckQueueName_Call struct {
+	*mock.Call
+}
+
+// CheckQueueName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - queueType QueueType
+func (_e *MockSqsService_Expecter) CheckQueueName(ctx interface{}, name interface{}, queueType interface{}) *MockSqsService_CheckQueueName_Call {
+	return &MockSqsService_CheckQueueName_Call{Call: _e.mock.On("CheckQueueName", ctx, name, queueType)}
+}
+
+func (_c *MockSqsService_CheckQueueName_Call) Run(run func(ctx context.Context, name string, queueType QueueType)) *MockSqsService_CheckQueueName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 QueueType
+		if args[2] != nil {
+			arg2 = args[2].(QueueType)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CheckQueueName_Call) Return(queueNameCheck QueueNameCheck, err error) *MockSqsService_CheckQueueName_Call {
+	_c.Call.Return(queueNameCheck, err)
+	return _c
+}
+
+func (_c *MockSqsService_CheckQueueName_Call) RunAndReturn(run func(ctx context.Context, name string, queueType QueueType) (QueueNameCheck, error)) *MockSqsService_CheckQueueName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CleanupReport provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CleanupReport(ctx context.Context) (CleanupReport, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupReport")
+	}
+
+	var r0 CleanupReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (CleanupReport, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) CleanupReport); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(CleanupReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CleanupReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupReport'
+type MockSqsService_CleanupReport_Call struct {
+	*mock.Call
+}
+
+// CleanupReport is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) CleanupReport(ctx interface{}) *MockSqsService_CleanupReport_Call {
+	return &MockSqsService_CleanupReport_Call{Call: _e.mock.On("CleanupReport", ctx)}
+}
+
+func (_c *MockSqsService_CleanupReport_Call) Run(run func(ctx context.Context)) *MockSqsService_CleanupReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CleanupReport_Call) Return(cleanupReport CleanupReport, err error) *MockSqsService_CleanupReport_Call {
+	_c.Call.Return(cleanupReport, err)
+	return _c
+}
+
+func (_c *MockSqsService_CleanupReport_Call) RunAndReturn(run func(ctx context.Context) (CleanupReport, error)) *MockSqsService_CleanupReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompareMessage provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CompareMessage(ctx context.Context, queueURL string, body string) (MessageComparison, error) {
+	ret := _mock.Called(ctx, queueURL, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareMessage")
+	}
+
+	var r0 MessageComparison
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (MessageComparison, error)); ok {
+		return returnFunc(ctx, queueURL, body)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) MessageComparison); ok {
+		r0 = returnFunc(ctx, queueURL, body)
+	} else {
+		r0 = ret.Get(0).(MessageComparison)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, queueURL, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CompareMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareMessage'
+type MockSqsService_CompareMessage_Call struct {
+	*mock.Call
+}
+
+// CompareMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - body string
+func (_e *MockSqsService_Expecter) CompareMessage(ctx interface{}, queueURL interface{}, body interface{}) *MockSqsService_CompareMessage_Call {
+	return &MockSqsService_CompareMessage_Call{Call: _e.mock.On("CompareMessage", ctx, queueURL, body)}
+}
+
+func (_c *MockSqsService_CompareMessage_Call) Run(run func(ctx context.Context, queueURL string, body string)) *MockSqsService_CompareMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CompareMessage_Call) Return(messageComparison MessageComparison, err error) *MockSqsService_CompareMessage_Call {
+	_c.Call.Return(messageComparison, err)
+	return _c
+}
+
+func (_c *MockSqsService_CompareMessage_Call) RunAndReturn(run func(ctx context.Context, queueURL string, body string) (MessageComparison, error)) *MockSqsService_CompareMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountMessagesByAttribute provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CountMessagesByAttribute(ctx context.Context, queueURL string, attribute string, samples int) (AttributeCountReport, error) {
+	ret := _mock.Called(ctx, queueURL, attribute, samples)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountMessagesByAttribute")
+	}
+
+	var r0 AttributeCountReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) (AttributeCountReport, error)); ok {
+		return returnFunc(ctx, queueURL, attribute, samples)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) AttributeCountReport); ok {
+		r0 = returnFunc(ctx, queueURL, attribute, samples)
+	} else {
+		r0 = ret.Get(0).(AttributeCountReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = returnFunc(ctx, queueURL, attribute, samples)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CountMessagesByAttribute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountMessagesByAttribute'
+type MockSqsService_CountMessagesByAttribute_Call struct {
+	*mock.Call
+}
+
+// CountMessagesByAttribute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - attribute string
+//   - samples int
+func (_e *MockSqsService_Expecter) CountMessagesByAttribute(ctx interface{}, queueURL interface{}, attribute interface{}, samples interface{}) *MockSqsService_CountMessagesByAttribute_Call {
+	return &MockSqsService_CountMessagesByAttribute_Call{Call: _e.mock.On("CountMessagesByAttribute", ctx, queueURL, attribute, samples)}
+}
+
+func (_c *MockSqsService_CountMessagesByAttribute_Call) Run(run func(ctx context.Context, queueURL string, attribute string, samples int)) *MockSqsService_CountMessagesByAttribute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CountMessagesByAttribute_Call) Return(attributeCountReport AttributeCountReport, err error) *MockSqsService_CountMessagesByAttribute_Call {
+	_c.Call.Return(attributeCountReport, err)
+	return _c
+}
+
+func (_c *MockSqsService_CountMessagesByAttribute_Call) RunAndReturn(run func(ctx context.Context, queueURL string, attribute string, samples int) (AttributeCountReport, error)) *MockSqsService_CountMessagesByAttribute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAlertRule provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CreateAlertRule(ctx context.Context, input CreateAlertRuleInput) (AlertRule, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAlertRule")
+	}
+
+	var r0 AlertRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateAlertRuleInput) (AlertRule, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateAlertRuleInput) AlertRule); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(AlertRule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateAlertRuleInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CreateAlertRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlertRule'
+type MockSqsService_CreateAlertRule_Call struct {
+	*mock.Call
+}
+
+// CreateAlertRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input CreateAlertRuleInput
+func (_e *MockSqsService_Expecter) CreateAlertRule(ctx interface{}, input interface{}) *MockSqsService_CreateAlertRule_Call {
+	return &MockSqsService_CreateAlertRule_Call{Call: _e.mock.On("CreateAlertRule", ctx, input)}
+}
+
+func (_c *MockSqsService_CreateAlertRule_Call) Run(run func(ctx context.Context, input CreateAlertRuleInput)) *MockSqsService_CreateAlertRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateAlertRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(CreateAlertRuleInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CreateAlertRule_Call) Return(alertRule AlertRule, err error) *MockSqsService_CreateAlertRule_Call {
+	_c.Call.Return(alertRule, err)
+	return _c
+}
+
+func (_c *MockSqsService_CreateAlertRule_Call) RunAndReturn(run func(ctx context.Context, input CreateAlertRuleInput) (AlertRule, error)) *MockSqsService_CreateAlertRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEventRule provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CreateEventRule(ctx context.Context, input CreateEventRuleInput) (EventRule, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEventRule")
+	}
+
+	var r0 EventRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateEventRuleInput) (EventRule, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateEventRuleInput) EventRule); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(EventRule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateEventRuleInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CreateEventRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEventRule'
+type MockSqsService_CreateEventRule_Call struct {
+	*mock.Call
+}
+
+// CreateEventRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input CreateEventRuleInput
+func (_e *MockSqsService_Expecter) CreateEventRule(ctx interface{}, input interface{}) *MockSqsService_CreateEventRule_Call {
+	return &MockSqsService_CreateEventRule_Call{Call: _e.mock.On("CreateEventRule", ctx, input)}
+}
+
+func (_c *MockSqsService_CreateEventRule_Call) Run(run func(ctx context.Context, input CreateEventRuleInput)) *MockSqsService_CreateEventRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateEventRuleInput
+		if args[1] != nil {
+			arg1 = args[1].(CreateEventRuleInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CreateEventRule_Call) Return(eventRule EventRule, err error) *MockSqsService_CreateEventRule_Call {
+	_c.Call.Return(eventRule, err)
+	return _c
+}
+
+func (_c *MockSqsService_CreateEventRule_Call) RunAndReturn(run func(ctx context.Context, input CreateEventRuleInput) (EventRule, error)) *MockSqsService_CreateEventRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQueue provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CreateQueue(ctx context.Context, input CreateQueueInput) (CreateQueueResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQueue")
+	}
+
+	var r0 CreateQueueResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateQueueInput) (CreateQueueResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateQueueInput) CreateQueueResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(CreateQueueResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateQueueInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CreateQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQueue'
+type MockSqsService_CreateQueue_Call struct {
+	*mock.Call
+}
+
+// CreateQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input CreateQueueInput
+func (_e *MockSqsService_Expecter) CreateQueue(ctx interface{}, input interface{}) *MockSqsService_CreateQueue_Call {
+	return &MockSqsService_CreateQueue_Call{Call: _e.mock.On("CreateQueue", ctx, input)}
+}
+
+func (_c *MockSqsService_CreateQueue_Call) Run(run func(ctx context.Context, input CreateQueueInput)) *MockSqsService_CreateQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateQueueInput
+		if args[1] != nil {
+			arg1 = args[1].(CreateQueueInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CreateQueue_Call) Return(createQueueResult CreateQueueResult, err error) *MockSqsService_CreateQueue_Call {
+	_c.Call.Return(createQueueResult, err)
+	return _c
+}
+
+func (_c *MockSqsService_CreateQueue_Call) RunAndReturn(run func(ctx context.Context, input CreateQueueInput) (CreateQueueResult, error)) *MockSqsService_CreateQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQueueFromAttributes provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CreateQueueFromAttributes(ctx context.Context, input RawCreateQueueInput) (CreateQueueResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQueueFromAttributes")
+	}
+
+	var r0 CreateQueueResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RawCreateQueueInput) (CreateQueueResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RawCreateQueueInput) CreateQueueResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(CreateQueueResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, RawCreateQueueInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CreateQueueFromAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQueueFromAttributes'
+type MockSqsService_CreateQueueFromAttributes_Call struct {
+	*mock.Call
+}
+
+// CreateQueueFromAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input RawCreateQueueInput
+func (_e *MockSqsService_Expecter) CreateQueueFromAttributes(ctx interface{}, input interface{}) *MockSqsService_CreateQueueFromAttributes_Call {
+	return &MockSqsService_CreateQueueFromAttributes_Call{Call: _e.mock.On("CreateQueueFromAttributes", ctx, input)}
+}
+
+func (_c *MockSqsService_CreateQueueFromAttributes_Call) Run(run func(ctx context.Context, input RawCreateQueueInput)) *MockSqsService_CreateQueueFromAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 RawCreateQueueInput
+		if args[1] != nil {
+			arg1 = args[1].(RawCreateQueueInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CreateQueueFromAttributes_Call) Return(createQueueResult CreateQueueResult, err error) *MockSqsService_CreateQueueFromAttributes_Call {
+	_c.Call.Return(createQueueResult, err)
+	return _c
+}
+
+func (_c *MockSqsService_CreateQueueFromAttributes_Call) RunAndReturn(run func(ctx context.Context, input RawCreateQueueInput) (CreateQueueResult, error)) *MockSqsService_CreateQueueFromAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSchedule provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) CreateSchedule(ctx context.Context, input CreateScheduleInput) (Schedule, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSchedule")
+	}
+
+	var r0 Schedule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateScheduleInput) (Schedule, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateScheduleInput) Schedule); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(Schedule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateScheduleInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_CreateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchedule'
+type MockSqsService_CreateSchedule_Call struct {
+	*mock.Call
+}
+
+// CreateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input CreateScheduleInput
+func (_e *MockSqsService_Expecter) CreateSchedule(ctx interface{}, input interface{}) *MockSqsService_CreateSchedule_Call {
+	return &MockSqsService_CreateSchedule_Call{Call: _e.mock.On("CreateSchedule", ctx, input)}
+}
+
+func (_c *MockSqsService_CreateSchedule_Call) Run(run func(ctx context.Context, input CreateScheduleInput)) *MockSqsService_CreateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CreateScheduleInput
+		if args[1] != nil {
+			arg1 = args[1].(CreateScheduleInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_CreateSchedule_Call) Return(schedule Schedule, err error) *MockSqsService_CreateSchedule_Call {
+	_c.Call.Return(schedule, err)
+	return _c
+}
+
+func (_c *MockSqsService_CreateSchedule_Call) RunAndReturn(run func(ctx context.Context, input CreateScheduleInput) (Schedule, error)) *MockSqsService_CreateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeadLetterCandidates provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DeadLetterCandidates(ctx context.Context, sourceURL string) ([]QueueSummary, error) {
+	ret := _mock.Called(ctx, sourceURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterCandidates")
+	}
+
+	var r0 []QueueSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]QueueSummary, error)); ok {
+		return returnFunc(ctx, sourceURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []QueueSummary); ok {
+		r0 = returnFunc(ctx, sourceURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]QueueSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sourceURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_DeadLetterCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeadLetterCandidates'
+type MockSqsService_DeadLetterCandidates_Call struct {
+	*mock.Call
+}
+
+// DeadLetterCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceURL string
+func (_e *MockSqsService_Expecter) DeadLetterCandidates(ctx interface{}, sourceURL interface{}) *MockSqsService_DeadLetterCandidates_Call {
+	return &MockSqsService_DeadLetterCandidates_Call{Call: _e.mock.On("DeadLetterCandidates", ctx, sourceURL)}
+}
+
+func (_c *MockSqsService_DeadLetterCandidates_Call) Run(run func(ctx context.Context, sourceURL string)) *MockSqsService_DeadLetterCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DeadLetterCandidates_Call) Return(queueSummarys []QueueSummary, err error) *MockSqsService_DeadLetterCandidates_Call {
+	_c.Call.Return(queueSummarys, err)
+	return _c
+}
+
+func (_c *MockSqsService_DeadLetterCandidates_Call) RunAndReturn(run func(ctx context.Context, sourceURL string) ([]QueueSummary, error)) *MockSqsService_DeadLetterCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeadLetterQueues provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DeadLetterQueues(ctx context.Context, sampleAge bool) ([]DeadLetterQueueSummary, error) {
+	ret := _mock.Called(ctx, sampleAge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterQueues")
+	}
+
+	var r0 []DeadLetterQueueSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) ([]DeadLetterQueueSummary, error)); ok {
+		return returnFunc(ctx, sampleAge)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) []DeadLetterQueueSummary); ok {
+		r0 = returnFunc(ctx, sampleAge)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DeadLetterQueueSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, sampleAge)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_DeadLetterQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeadLetterQueues'
+type MockSqsService_DeadLetterQueues_Call struct {
+	*mock.Call
+}
+
+// DeadLetterQueues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sampleAge bool
+func (_e *MockSqsService_Expecter) DeadLetterQueues(ctx interface{}, sampleAge interface{}) *MockSqsService_DeadLetterQueues_Call {
+	return &MockSqsService_DeadLetterQueues_Call{Call: _e.mock.On("DeadLetterQueues", ctx, sampleAge)}
+}
+
+func (_c *MockSqsService_DeadLetterQueues_Call) Run(run func(ctx context.Context, sampleAge bool)) *MockSqsService_DeadLetterQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DeadLetterQueues_Call) Return(deadLetterQueueSummarys []DeadLetterQueueSummary, err error) *MockSqsService_DeadLetterQueues_Call {
+	_c.Call.Return(deadLetterQueueSummarys, err)
+	return _c
+}
+
+func (_c *MockSqsService_DeadLetterQueues_Call) RunAndReturn(run func(ctx context.Context, sampleAge bool) ([]DeadLetterQueueSummary, error)) *MockSqsService_DeadLetterQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeadLetterSourceQueues provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DeadLetterSourceQueues(ctx context.Context, queueURL string) ([]DeadLetterSource, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadLetterSourceQueues")
+	}
+
+	var r0 []DeadLetterSource
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]DeadLetterSource, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []DeadLetterSource); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]DeadLetterSource)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_DeadLetterSourceQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeadLetterSourceQueues'
+type MockSqsService_DeadLetterSourceQueues_Call struct {
+	*mock.Call
+}
+
+// DeadLetterSourceQueues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) DeadLetterSourceQueues(ctx interface{}, queueURL interface{}) *MockSqsService_DeadLetterSourceQueues_Call {
+	return &MockSqsService_DeadLetterSourceQueues_Call{Call: _e.mock.On("DeadLetterSourceQueues", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_DeadLetterSourceQueues_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_DeadLetterSourceQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DeadLetterSourceQueues_Call) Return(deadLetterSources []DeadLetterSource, err error) *MockSqsService_DeadLetterSourceQueues_Call {
+	_c.Call.Return(deadLetterSources, err)
+	return _c
+}
+
+func (_c *MockSqsService_DeadLetterSourceQueues_Call) RunAndReturn(run func(ctx context.Context, queueURL string) ([]DeadLetterSource, error)) *MockSqsService_DeadLetterSourceQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAlertRule provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DeleteAlertRule(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlertRule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_DeleteAlertRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlertRule'
+type MockSqsService_DeleteAlertRule_Call struct {
+	*mock.Call
+}
+
+// DeleteAlertRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSqsService_Expecter) DeleteAlertRule(ctx interface{}, id interface{}) *MockSqsService_DeleteAlertRule_Call {
+	return &MockSqsService_DeleteAlertRule_Call{Call: _e.mock.On("DeleteAlertRule", ctx, id)}
+}
+
+func (_c *MockSqsService_DeleteAlertRule_Call) Run(run func(ctx context.Context, id string)) *MockSqsService_DeleteAlertRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DeleteAlertRule_Call) Return(err error) *MockSqsService_DeleteAlertRule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_DeleteAlertRule_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockSqsService_DeleteAlertRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMessage provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DeleteMessage(ctx context.Context, input DeleteMessageInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DeleteMessageInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
+type MockSqsService_DeleteMessage_Call struct {
+	*mock.Call
+}
+
+// DeleteMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input DeleteMessageInput
+func (_e *MockSqsService_Expecter) DeleteMessage(ctx interface{}, input interface{}) *MockSqsService_DeleteMessage_Call {
+	return &MockSqsService_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage", ctx, input)}
+}
+
+func (_c *MockSqsService_DeleteMessage_Call) Run(run func(ctx context.Context, input DeleteMessageInput)) *MockSqsService_DeleteMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DeleteMessageInput
+		if args[1] != nil {
+			arg1 = args[1].(DeleteMessageInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DeleteMessage_Call) Return(err error) *MockSqsService_DeleteMessage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_DeleteMessage_Call) RunAndReturn(run func(ctx context.Context, input DeleteMessageInput) error) *MockSqsService_DeleteMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMessageContract provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DeleteMessageContract(ctx context.Context, queueURL string) error {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMessageContract")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_DeleteMessageContract_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessageContract'
+type MockSqsService_DeleteMessageContract_Call struct {
+	*mock.Call
+}
+
+// DeleteMessageContract is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) DeleteMessageContract(ctx interface{}, queueURL interface{}) *MockSqsService_DeleteMessageContract_Call {
+	return &MockSqsService_DeleteMessageContract_Call{Call: _e.mock.On("DeleteMessageContract", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_DeleteMessageContract_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_DeleteMessageContract_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DeleteMessageContract_Call) Return(err error) *MockSqsService_DeleteMessageContract_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_DeleteMessageContract_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsService_DeleteMessageContract_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQueue provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DeleteQueue(ctx context.Context, queueURL string) (TrashedQueue, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQueue")
+	}
+
+	var r0 TrashedQueue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (TrashedQueue, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) TrashedQueue); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(TrashedQueue)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_DeleteQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueue'
+type MockSqsService_DeleteQueue_Call struct {
+	*mock.Call
+}
+
+// DeleteQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) DeleteQueue(ctx interface{}, queueURL interface{}) *MockSqsService_DeleteQueue_Call {
+	return &MockSqsService_DeleteQueue_Call{Call: _e.mock.On("DeleteQueue", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_DeleteQueue_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_DeleteQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DeleteQueue_Call) Return(trashedQueue TrashedQueue, err error) *MockSqsService_DeleteQueue_Call {
+	_c.Call.Return(trashedQueue, err)
+	return _c
+}
+
+func (_c *MockSqsService_DeleteQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (TrashedQueue, error)) *MockSqsService_DeleteQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQueueBaseline provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DeleteQueueBaseline(ctx context.Context, queueURL string) error {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQueueBaseline")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_DeleteQueueBaseline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueueBaseline'
+type MockSqsService_DeleteQueueBaseline_Call struct {
+	*mock.Call
+}
+
+// DeleteQueueBaseline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) DeleteQueueBaseline(ctx interface{}, queueURL interface{}) *MockSqsService_DeleteQueueBaseline_Call {
+	return &MockSqsService_DeleteQueueBaseline_Call{Call: _e.mock.On("DeleteQueueBaseline", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_DeleteQueueBaseline_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_DeleteQueueBaseline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DeleteQueueBaseline_Call) Return(err error) *MockSqsService_DeleteQueueBaseline_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_DeleteQueueBaseline_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsService_DeleteQueueBaseline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSchedule provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DeleteSchedule(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSchedule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_DeleteSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSchedule'
+type MockSqsService_DeleteSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSqsService_Expecter) DeleteSchedule(ctx interface{}, id interface{}) *MockSqsService_DeleteSchedule_Call {
+	return &MockSqsService_DeleteSchedule_Call{Call: _e.mock.On("DeleteSchedule", ctx, id)}
+}
+
+func (_c *MockSqsService_DeleteSchedule_Call) Run(run func(ctx context.Context, id string)) *MockSqsService_DeleteSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DeleteSchedule_Call) Return(err error) *MockSqsService_DeleteSchedule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_DeleteSchedule_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockSqsService_DeleteSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiscardTrashedQueue provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DiscardTrashedQueue(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscardTrashedQueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_DiscardTrashedQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscardTrashedQueue'
+type MockSqsService_DiscardTrashedQueue_Call struct {
+	*mock.Call
+}
+
+// DiscardTrashedQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSqsService_Expecter) DiscardTrashedQueue(ctx interface{}, id interface{}) *MockSqsService_DiscardTrashedQueue_Call {
+	return &MockSqsService_DiscardTrashedQueue_Call{Call: _e.mock.On("DiscardTrashedQueue", ctx, id)}
+}
+
+func (_c *MockSqsService_DiscardTrashedQueue_Call) Run(run func(ctx context.Context, id string)) *MockSqsService_DiscardTrashedQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DiscardTrashedQueue_Call) Return(err error) *MockSqsService_DiscardTrashedQueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_DiscardTrashedQueue_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockSqsService_DiscardTrashedQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Draft provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) Draft(ctx context.Context, queueURL string) (MessageDraft, bool, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Draft")
+	}
+
+	var r0 MessageDraft
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (MessageDraft, bool, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) MessageDraft); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(MessageDraft)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, queueURL)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockSqsService_Draft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Draft'
+type MockSqsService_Draft_Call struct {
+	*mock.Call
+}
+
+// Draft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) Draft(ctx interface{}, queueURL interface{}) *MockSqsService_Draft_Call {
+	return &MockSqsService_Draft_Call{Call: _e.mock.On("Draft", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_Draft_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_Draft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_Draft_Call) Return(messageDraft MessageDraft, b bool, err error) *MockSqsService_Draft_Call {
+	_c.Call.Return(messageDraft, b, err)
+	return _c
+}
+
+func (_c *MockSqsService_Draft_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (MessageDraft, bool, error)) *MockSqsService_Draft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DrainPolls provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DrainPolls() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainPolls")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockSqsService_DrainPolls_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainPolls'
+type MockSqsService_DrainPolls_Call struct {
+	*mock.Call
+}
+
+// DrainPolls is a helper method to define mock.On call
+func (_e *MockSqsService_Expecter) DrainPolls() *MockSqsService_DrainPolls_Call {
+	return &MockSqsService_DrainPolls_Call{Call: _e.mock.On("DrainPolls")}
+}
+
+func (_c *MockSqsService_DrainPolls_Call) Run(run func()) *MockSqsService_DrainPolls_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DrainPolls_Call) Return(n int) *MockSqsService_DrainPolls_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockSqsService_DrainPolls_Call) RunAndReturn(run func() int) *MockSqsService_DrainPolls_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DrainReceive provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) DrainReceive(ctx context.Context, input DrainReceiveInput, emit func([]ReceivedMessage) error) (DrainReceiveResult, error) {
+	ret := _mock.Called(ctx, input, emit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainReceive")
+	}
+
+	var r0 DrainReceiveResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DrainReceiveInput, func([]ReceivedMessage) error) (DrainReceiveResult, error)); ok {
+		return returnFunc(ctx, input, emit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DrainReceiveInput, func([]ReceivedMessage) error) DrainReceiveResult); ok {
+		r0 = returnFunc(ctx, input, emit)
+	} else {
+		r0 = ret.Get(0).(DrainReceiveResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, DrainReceiveInput, func([]ReceivedMessage) error) error); ok {
+		r1 = returnFunc(ctx, input, emit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_DrainReceive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainReceive'
+type MockSqsService_DrainReceive_Call struct {
+	*mock.Call
+}
+
+// DrainReceive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input DrainReceiveInput
+//   - emit func([]ReceivedMessage) error
+func (_e *MockSqsService_Expecter) DrainReceive(ctx interface{}, input interface{}, emit interface{}) *MockSqsService_DrainReceive_Call {
+	return &MockSqsService_DrainReceive_Call{Call: _e.mock.On("DrainReceive", ctx, input, emit)}
+}
+
+func (_c *MockSqsService_DrainReceive_Call) Run(run func(ctx context.Context, input DrainReceiveInput, emit func([]ReceivedMessage) error)) *MockSqsService_DrainReceive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DrainReceiveInput
+		if args[1] != nil {
+			arg1 = args[1].(DrainReceiveInput)
+		}
+		var arg2 func([]ReceivedMessage) error
+		if args[2] != nil {
+			arg2 = args[2].(func([]ReceivedMessage) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_DrainReceive_Call) Return(drainReceiveResult DrainReceiveResult, err error) *MockSqsService_DrainReceive_Call {
+	_c.Call.Return(drainReceiveResult, err)
+	return _c
+}
+
+func (_c *MockSqsService_DrainReceive_Call) RunAndReturn(run func(ctx context.Context, input DrainReceiveInput, emit func([]ReceivedMessage) error) (DrainReceiveResult, error)) *MockSqsService_DrainReceive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EndpointCapabilities provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) EndpointCapabilities(ctx context.Context) EndpointCapabilities {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndpointCapabilities")
+	}
+
+	var r0 EndpointCapabilities
+	if returnFunc, ok := ret.Get(0).(func(context.Context) EndpointCapabilities); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(EndpointCapabilities)
+	}
+	return r0
+}
+
+// MockSqsService_EndpointCapabilities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndpointCapabilities'
+type MockSqsService_EndpointCapabilities_Call struct {
+	*mock.Call
+}
+
+// EndpointCapabilities is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) EndpointCapabilities(ctx interface{}) *MockSqsService_EndpointCapabilities_Call {
+	return &MockSqsService_EndpointCapabilities_Call{Call: _e.mock.On("EndpointCapabilities", ctx)}
+}
+
+func (_c *MockSqsService_EndpointCapabilities_Call) Run(run func(ctx context.Context)) *MockSqsService_EndpointCapabilities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_EndpointCapabilities_Call) Return(endpointCapabilities EndpointCapabilities) *MockSqsService_EndpointCapabilities_Call {
+	_c.Call.Return(endpointCapabilities)
+	return _c
+}
+
+func (_c *MockSqsService_EndpointCapabilities_Call) RunAndReturn(run func(ctx context.Context) EndpointCapabilities) *MockSqsService_EndpointCapabilities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EvaluateAlerts provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) EvaluateAlerts(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvaluateAlerts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_EvaluateAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluateAlerts'
+type MockSqsService_EvaluateAlerts_Call struct {
+	*mock.Call
+}
+
+// EvaluateAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) EvaluateAlerts(ctx interface{}) *MockSqsService_EvaluateAlerts_Call {
+	return &MockSqsService_EvaluateAlerts_Call{Call: _e.mock.On("EvaluateAlerts", ctx)}
+}
+
+func (_c *MockSqsService_EvaluateAlerts_Call) Run(run func(ctx context.Context)) *MockSqsService_EvaluateAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_EvaluateAlerts_Call) Return(err error) *MockSqsService_EvaluateAlerts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_EvaluateAlerts_Call) RunAndReturn(run func(ctx context.Context) error) *MockSqsService_EvaluateAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportQueues provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) ExportQueues(ctx context.Context, opts QueueListOptions) ([]QueueExport, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportQueues")
+	}
+
+	var r0 []QueueExport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, QueueListOptions) ([]QueueExport, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, QueueListOptions) []QueueExport); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]QueueExport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, QueueListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_ExportQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportQueues'
+type MockSqsService_ExportQueues_Call struct {
+	*mock.Call
+}
+
+// ExportQueues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts QueueListOptions
+func (_e *MockSqsService_Expecter) ExportQueues(ctx interface{}, opts interface{}) *MockSqsService_ExportQueues_Call {
+	return &MockSqsService_ExportQueues_Call{Call: _e.mock.On("ExportQueues", ctx, opts)}
+}
+
+func (_c *MockSqsService_ExportQueues_Call) Run(run func(ctx context.Context, opts QueueListOptions)) *MockSqsService_ExportQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 QueueListOptions
+		if args[1] != nil {
+			arg1 = args[1].(QueueListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_ExportQueues_Call) Return(queueExports []QueueExport, err error) *MockSqsService_ExportQueues_Call {
+	_c.Call.Return(queueExports, err)
+	return _c
+}
+
+func (_c *MockSqsService_ExportQueues_Call) RunAndReturn(run func(ctx context.Context, opts QueueListOptions) ([]QueueExport, error)) *MockSqsService_ExportQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportSettings provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) ExportSettings(ctx context.Context) (SettingsBundle, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportSettings")
+	}
+
+	var r0 SettingsBundle
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (SettingsBundle, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) SettingsBundle); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(SettingsBundle)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_ExportSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportSettings'
+type MockSqsService_ExportSettings_Call struct {
+	*mock.Call
+}
+
+// ExportSettings is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) ExportSettings(ctx interface{}) *MockSqsService_ExportSettings_Call {
+	return &MockSqsService_ExportSettings_Call{Call: _e.mock.On("ExportSettings", ctx)}
+}
+
+func (_c *MockSqsService_ExportSettings_Call) Run(run func(ctx context.Context)) *MockSqsService_ExportSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_ExportSettings_Call) Return(settingsBundle SettingsBundle, err error) *MockSqsService_ExportSettings_Call {
+	_c.Call.Return(settingsBundle, err)
+	return _c
+}
+
+func (_c *MockSqsService_ExportSettings_Call) RunAndReturn(run func(ctx context.Context) (SettingsBundle, error)) *MockSqsService_ExportSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FanOutMessage provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) FanOutMessage(ctx context.Context, input FanOutInput) ([]FanOutQueueResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FanOutMessage")
+	}
+
+	var r0 []FanOutQueueResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, FanOutInput) ([]FanOutQueueResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, FanOutInput) []FanOutQueueResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]FanOutQueueResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, FanOutInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_FanOutMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FanOutMessage'
+type MockSqsService_FanOutMessage_Call struct {
+	*mock.Call
+}
+
+// FanOutMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input FanOutInput
+func (_e *MockSqsService_Expecter) FanOutMessage(ctx interface{}, input interface{}) *MockSqsService_FanOutMessage_Call {
+	return &MockSqsService_FanOutMessage_Call{Call: _e.mock.On("FanOutMessage", ctx, input)}
+}
+
+func (_c *MockSqsService_FanOutMessage_Call) Run(run func(ctx context.Context, input FanOutInput)) *MockSqsService_FanOutMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 FanOutInput
+		if args[1] != nil {
+			arg1 = args[1].(FanOutInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_FanOutMessage_Call) Return(fanOutQueueResults []FanOutQueueResult, err error) *MockSqsService_FanOutMessage_Call {
+	_c.Call.Return(fanOutQueueResults, err)
+	return _c
+}
+
+func (_c *MockSqsService_FanOutMessage_Call) RunAndReturn(run func(ctx context.Context, input FanOutInput) ([]FanOutQueueResult, error)) *MockSqsService_FanOutMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FavoriteQueues provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) FavoriteQueues(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FavoriteQueues")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_FavoriteQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FavoriteQueues'
+type MockSqsService_FavoriteQueues_Call struct {
+	*mock.Call
+}
+
+// FavoriteQueues is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) FavoriteQueues(ctx interface{}) *MockSqsService_FavoriteQueues_Call {
+	return &MockSqsService_FavoriteQueues_Call{Call: _e.mock.On("FavoriteQueues", ctx)}
+}
+
+func (_c *MockSqsService_FavoriteQueues_Call) Run(run func(ctx context.Context)) *MockSqsService_FavoriteQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_FavoriteQueues_Call) Return(strings []string, err error) *MockSqsService_FavoriteQueues_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSqsService_FavoriteQueues_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *MockSqsService_FavoriteQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDuplicateMessages provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) FindDuplicateMessages(ctx context.Context, queueURL string, samples int) (DuplicateMessageReport, error) {
+	ret := _mock.Called(ctx, queueURL, samples)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDuplicateMessages")
+	}
+
+	var r0 DuplicateMessageReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (DuplicateMessageReport, error)); ok {
+		return returnFunc(ctx, queueURL, samples)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) DuplicateMessageReport); ok {
+		r0 = returnFunc(ctx, queueURL, samples)
+	} else {
+		r0 = ret.Get(0).(DuplicateMessageReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, queueURL, samples)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_FindDuplicateMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDuplicateMessages'
+type MockSqsService_FindDuplicateMessages_Call struct {
+	*mock.Call
+}
+
+// FindDuplicateMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - samples int
+func (_e *MockSqsService_Expecter) FindDuplicateMessages(ctx interface{}, queueURL interface{}, samples interface{}) *MockSqsService_FindDuplicateMessages_Call {
+	return &MockSqsService_FindDuplicateMessages_Call{Call: _e.mock.On("FindDuplicateMessages", ctx, queueURL, samples)}
+}
+
+func (_c *MockSqsService_FindDuplicateMessages_Call) Run(run func(ctx context.Context, queueURL string, samples int)) *MockSqsService_FindDuplicateMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_FindDuplicateMessages_Call) Return(duplicateMessageReport DuplicateMessageReport, err error) *MockSqsService_FindDuplicateMessages_Call {
+	_c.Call.Return(duplicateMessageReport, err)
+	return _c
+}
+
+func (_c *MockSqsService_FindDuplicateMessages_Call) RunAndReturn(run func(ctx context.Context, queueURL string, samples int) (DuplicateMessageReport, error)) *MockSqsService_FindDuplicateMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindQueues provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) FindQueues(ctx context.Context, opts QueueListOptions) (QueueListPage, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQueues")
+	}
+
+	var r0 QueueListPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, QueueListOptions) (QueueListPage, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, QueueListOptions) QueueListPage); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(QueueListPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, QueueListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_FindQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQueues'
+type MockSqsService_FindQueues_Call struct {
+	*mock.Call
+}
+
+// FindQueues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts QueueListOptions
+func (_e *MockSqsService_Expecter) FindQueues(ctx interface{}, opts interface{}) *MockSqsService_FindQueues_Call {
+	return &MockSqsService_FindQueues_Call{Call: _e.mock.On("FindQueues", ctx, opts)}
+}
+
+func (_c *MockSqsService_FindQueues_Call) Run(run func(ctx context.Context, opts QueueListOptions)) *MockSqsService_FindQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 QueueListOptions
+		if args[1] != nil {
+			arg1 = args[1].(QueueListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_FindQueues_Call) Return(queueListPage QueueListPage, err error) *MockSqsService_FindQueues_Call {
+	_c.Call.Return(queueListPage, err)
+	return _c
+}
+
+func (_c *MockSqsService_FindQueues_Call) RunAndReturn(run func(ctx context.Context, opts QueueListOptions) (QueueListPage, error)) *MockSqsService_FindQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportSettings provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) ImportSettings(ctx context.Context, bundle SettingsBundle) error {
+	ret := _mock.Called(ctx, bundle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportSettings")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SettingsBundle) error); ok {
+		r0 = returnFunc(ctx, bundle)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_ImportSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportSettings'
+type MockSqsService_ImportSettings_Call struct {
+	*mock.Call
+}
+
+// ImportSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundle SettingsBundle
+func (_e *MockSqsService_Expecter) ImportSettings(ctx interface{}, bundle interface{}) *MockSqsService_ImportSettings_Call {
+	return &MockSqsService_ImportSettings_Call{Call: _e.mock.On("ImportSettings", ctx, bundle)}
+}
+
+func (_c *MockSqsService_ImportSettings_Call) Run(run func(ctx context.Context, bundle SettingsBundle)) *MockSqsService_ImportSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 SettingsBundle
+		if args[1] != nil {
+			arg1 = args[1].(SettingsBundle)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_ImportSettings_Call) Return(err error) *MockSqsService_ImportSettings_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_ImportSettings_Call) RunAndReturn(run func(ctx context.Context, bundle SettingsBundle) error) *MockSqsService_ImportSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IngestMessage provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) IngestMessage(ctx context.Context, input IngestInput) (IngestResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IngestMessage")
+	}
+
+	var r0 IngestResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, IngestInput) (IngestResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, IngestInput) IngestResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(IngestResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, IngestInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_IngestMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IngestMessage'
+type MockSqsService_IngestMessage_Call struct {
+	*mock.Call
+}
+
+// IngestMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input IngestInput
+func (_e *MockSqsService_Expecter) IngestMessage(ctx interface{}, input interface{}) *MockSqsService_IngestMessage_Call {
+	return &MockSqsService_IngestMessage_Call{Call: _e.mock.On("IngestMessage", ctx, input)}
+}
+
+func (_c *MockSqsService_IngestMessage_Call) Run(run func(ctx context.Context, input IngestInput)) *MockSqsService_IngestMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 IngestInput
+		if args[1] != nil {
+			arg1 = args[1].(IngestInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_IngestMessage_Call) Return(ingestResult IngestResult, err error) *MockSqsService_IngestMessage_Call {
+	_c.Call.Return(ingestResult, err)
+	return _c
+}
+
+func (_c *MockSqsService_IngestMessage_Call) RunAndReturn(run func(ctx context.Context, input IngestInput) (IngestResult, error)) *MockSqsService_IngestMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Job provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) Job(ctx context.Context, id string) (Job, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Job")
+	}
+
+	var r0 Job
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (Job, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) Job); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Job)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_Job_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Job'
+type MockSqsService_Job_Call struct {
+	*mock.Call
+}
+
+// Job is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSqsService_Expecter) Job(ctx interface{}, id interface{}) *MockSqsService_Job_Call {
+	return &MockSqsService_Job_Call{Call: _e.mock.On("Job", ctx, id)}
+}
+
+func (_c *MockSqsService_Job_Call) Run(run func(ctx context.Context, id string)) *MockSqsService_Job_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_Job_Call) Return(job Job, err error) *MockSqsService_Job_Call {
+	_c.Call.Return(job, err)
+	return _c
+}
+
+func (_c *MockSqsService_Job_Call) RunAndReturn(run func(ctx context.Context, id string) (Job, error)) *MockSqsService_Job_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LiveQueueStats provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) LiveQueueStats(ctx context.Context, queueURL string) (LiveQueueStats, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LiveQueueStats")
+	}
+
+	var r0 LiveQueueStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (LiveQueueStats, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) LiveQueueStats); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(LiveQueueStats)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_LiveQueueStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LiveQueueStats'
+type MockSqsService_LiveQueueStats_Call struct {
+	*mock.Call
+}
+
+// LiveQueueStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) LiveQueueStats(ctx interface{}, queueURL interface{}) *MockSqsService_LiveQueueStats_Call {
+	return &MockSqsService_LiveQueueStats_Call{Call: _e.mock.On("LiveQueueStats", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_LiveQueueStats_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_LiveQueueStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_LiveQueueStats_Call) Return(liveQueueStats LiveQueueStats, err error) *MockSqsService_LiveQueueStats_Call {
+	_c.Call.Return(liveQueueStats, err)
+	return _c
+}
+
+func (_c *MockSqsService_LiveQueueStats_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (LiveQueueStats, error)) *MockSqsService_LiveQueueStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MessageContract provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) MessageContract(ctx context.Context, queueURL string) (MessageContract, bool, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MessageContract")
+	}
+
+	var r0 MessageContract
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (MessageContract, bool, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) MessageContract); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(MessageContract)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, queueURL)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockSqsService_MessageContract_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageContract'
+type MockSqsService_MessageContract_Call struct {
+	*mock.Call
+}
+
+// MessageContract is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) MessageContract(ctx interface{}, queueURL interface{}) *MockSqsService_MessageContract_Call {
+	return &MockSqsService_MessageContract_Call{Call: _e.mock.On("MessageContract", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_MessageContract_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_MessageContract_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_MessageContract_Call) Return(messageContract MessageContract, b bool, err error) *MockSqsService_MessageContract_Call {
+	_c.Call.Return(messageContract, b, err)
+	return _c
+}
+
+func (_c *MockSqsService_MessageContract_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (MessageContract, bool, error)) *MockSqsService_MessageContract_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MessageMoveTasks provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) MessageMoveTasks(ctx context.Context, queueURL string) ([]MessageMoveTask, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MessageMoveTasks")
+	}
+
+	var r0 []MessageMoveTask
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]MessageMoveTask, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []MessageMoveTask); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]MessageMoveTask)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_MessageMoveTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageMoveTasks'
+type MockSqsService_MessageMoveTasks_Call struct {
+	*mock.Call
+}
+
+// MessageMoveTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) MessageMoveTasks(ctx interface{}, queueURL interface{}) *MockSqsService_MessageMoveTasks_Call {
+	return &MockSqsService_MessageMoveTasks_Call{Call: _e.mock.On("MessageMoveTasks", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_MessageMoveTasks_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_MessageMoveTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_MessageMoveTasks_Call) Return(messageMoveTasks []MessageMoveTask, err error) *MockSqsService_MessageMoveTasks_Call {
+	_c.Call.Return(messageMoveTasks, err)
+	return _c
+}
+
+func (_c *MockSqsService_MessageMoveTasks_Call) RunAndReturn(run func(ctx context.Context, queueURL string) ([]MessageMoveTask, error)) *MockSqsService_MessageMoveTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigrateQueue provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) MigrateQueue(ctx context.Context, input MigrateQueueInput) (QueueMigration, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateQueue")
+	}
+
+	var r0 QueueMigration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, MigrateQueueInput) (QueueMigration, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, MigrateQueueInput) QueueMigration); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(QueueMigration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, MigrateQueueInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_MigrateQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateQueue'
+type MockSqsService_MigrateQueue_Call struct {
+	*mock.Call
+}
+
+// MigrateQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input MigrateQueueInput
+func (_e *MockSqsService_Expecter) MigrateQueue(ctx interface{}, input interface{}) *MockSqsService_MigrateQueue_Call {
+	return &MockSqsService_MigrateQueue_Call{Call: _e.mock.On("MigrateQueue", ctx, input)}
+}
+
+func (_c *MockSqsService_MigrateQueue_Call) Run(run func(ctx context.Context, input MigrateQueueInput)) *MockSqsService_MigrateQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 MigrateQueueInput
+		if args[1] != nil {
+			arg1 = args[1].(MigrateQueueInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_MigrateQueue_Call) Return(queueMigration QueueMigration, err error) *MockSqsService_MigrateQueue_Call {
+	_c.Call.Return(queueMigration, err)
+	return _c
+}
+
+func (_c *MockSqsService_MigrateQueue_Call) RunAndReturn(run func(ctx context.Context, input MigrateQueueInput) (QueueMigration, error)) *MockSqsService_MigrateQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigrationRegions provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) MigrationRegions() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrationRegions")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockSqsService_MigrationRegions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrationRegions'
+type MockSqsService_MigrationRegions_Call struct {
+	*mock.Call
+}
+
+// MigrationRegions is a helper method to define mock.On call
+func (_e *MockSqsService_Expecter) MigrationRegions() *MockSqsService_MigrationRegions_Call {
+	return &MockSqsService_MigrationRegions_Call{Call: _e.mock.On("MigrationRegions")}
+}
+
+func (_c *MockSqsService_MigrationRegions_Call) Run(run func()) *MockSqsService_MigrationRegions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSqsService_MigrationRegions_Call) Return(strings []string) *MockSqsService_MigrationRegions_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockSqsService_MigrationRegions_Call) RunAndReturn(run func() []string) *MockSqsService_MigrationRegions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotificationChannels provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) NotificationChannels(ctx context.Context) []NotificationChannel {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationChannels")
+	}
+
+	var r0 []NotificationChannel
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []NotificationChannel); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NotificationChannel)
+		}
+	}
+	return r0
+}
+
+// MockSqsService_NotificationChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotificationChannels'
+type MockSqsService_NotificationChannels_Call struct {
+	*mock.Call
+}
+
+// NotificationChannels is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) NotificationChannels(ctx interface{}) *MockSqsService_NotificationChannels_Call {
+	return &MockSqsService_NotificationChannels_Call{Call: _e.mock.On("NotificationChannels", ctx)}
+}
+
+func (_c *MockSqsService_NotificationChannels_Call) Run(run func(ctx context.Context)) *MockSqsService_NotificationChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_NotificationChannels_Call) Return(notificationChannels []NotificationChannel) *MockSqsService_NotificationChannels_Call {
+	_c.Call.Return(notificationChannels)
+	return _c
+}
+
+func (_c *MockSqsService_NotificationChannels_Call) RunAndReturn(run func(ctx context.Context) []NotificationChannel) *MockSqsService_NotificationChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenJobFile provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) OpenJobFile(ctx context.Context, id string) (Job, *os.File, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenJobFile")
+	}
+
+	var r0 Job
+	var r1 *os.File
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (Job, *os.File, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) Job); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Job)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *os.File); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*os.File)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockSqsService_OpenJobFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenJobFile'
+type MockSqsService_OpenJobFile_Call struct {
+	*mock.Call
+}
+
+// OpenJobFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSqsService_Expecter) OpenJobFile(ctx interface{}, id interface{}) *MockSqsService_OpenJobFile_Call {
+	return &MockSqsService_OpenJobFile_Call{Call: _e.mock.On("OpenJobFile", ctx, id)}
+}
+
+func (_c *MockSqsService_OpenJobFile_Call) Run(run func(ctx context.Context, id string)) *MockSqsService_OpenJobFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_OpenJobFile_Call) Return(job Job, file *os.File, err error) *MockSqsService_OpenJobFile_Call {
+	_c.Call.Return(job, file, err)
+	return _c
+}
+
+func (_c *MockSqsService_OpenJobFile_Call) RunAndReturn(run func(ctx context.Context, id string) (Job, *os.File, error)) *MockSqsService_OpenJobFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProbeLatency provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) ProbeLatency(ctx context.Context, input LatencyProbeInput) ([]QueueLatency, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProbeLatency")
+	}
+
+	var r0 []QueueLatency
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, LatencyProbeInput) ([]QueueLatency, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, LatencyProbeInput) []QueueLatency); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]QueueLatency)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, LatencyProbeInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_ProbeLatency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProbeLatency'
+type MockSqsService_ProbeLatency_Call struct {
+	*mock.Call
+}
+
+// ProbeLatency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input LatencyProbeInput
+func (_e *MockSqsService_Expecter) ProbeLatency(ctx interface{}, input interface{}) *MockSqsService_ProbeLatency_Call {
+	return &MockSqsService_ProbeLatency_Call{Call: _e.mock.On("ProbeLatency", ctx, input)}
+}
+
+func (_c *MockSqsService_ProbeLatency_Call) Run(run func(ctx context.Context, input LatencyProbeInput)) *MockSqsService_ProbeLatency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 LatencyProbeInput
+		if args[1] != nil {
+			arg1 = args[1].(LatencyProbeInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_ProbeLatency_Call) Return(queueLatencys []QueueLatency, err error) *MockSqsService_ProbeLatency_Call {
+	_c.Call.Return(queueLatencys, err)
+	return _c
+}
+
+func (_c *MockSqsService_ProbeLatency_Call) RunAndReturn(run func(ctx context.Context, input LatencyProbeInput) ([]QueueLatency, error)) *MockSqsService_ProbeLatency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishToTopic provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) PublishToTopic(ctx context.Context, input PublishToTopicInput) (PublishToTopicResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishToTopic")
+	}
+
+	var r0 PublishToTopicResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PublishToTopicInput) (PublishToTopicResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PublishToTopicInput) PublishToTopicResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(PublishToTopicResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, PublishToTopicInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_PublishToTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishToTopic'
+type MockSqsService_PublishToTopic_Call struct {
+	*mock.Call
+}
+
+// PublishToTopic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input PublishToTopicInput
+func (_e *MockSqsService_Expecter) PublishToTopic(ctx interface{}, input interface{}) *MockSqsService_PublishToTopic_Call {
+	return &MockSqsService_PublishToTopic_Call{Call: _e.mock.On("PublishToTopic", ctx, input)}
+}
+
+func (_c *MockSqsService_PublishToTopic_Call) Run(run func(ctx context.Context, input PublishToTopicInput)) *MockSqsService_PublishToTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 PublishToTopicInput
+		if args[1] != nil {
+			arg1 = args[1].(PublishToTopicInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_PublishToTopic_Call) Return(publishToTopicResult PublishToTopicResult, err error) *MockSqsService_PublishToTopic_Call {
+	_c.Call.Return(publishToTopicResult, err)
+	return _c
+}
+
+func (_c *MockSqsService_PublishToTopic_Call) RunAndReturn(run func(ctx context.Context, input PublishToTopicInput) (PublishToTopicResult, error)) *MockSqsService_PublishToTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeQueue provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) PurgeQueue(ctx context.Context, queueURL string) error {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeQueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_PurgeQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeQueue'
+type MockSqsService_PurgeQueue_Call struct {
+	*mock.Call
+}
+
+// PurgeQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) PurgeQueue(ctx interface{}, queueURL interface{}) *MockSqsService_PurgeQueue_Call {
+	return &MockSqsService_PurgeQueue_Call{Call: _e.mock.On("PurgeQueue", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_PurgeQueue_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_PurgeQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_PurgeQueue_Call) Return(err error) *MockSqsService_PurgeQueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_PurgeQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsService_PurgeQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueAccessPolicy provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueAccessPolicy(ctx context.Context, queueURL string) (QueueAccessPolicy, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueAccessPolicy")
+	}
+
+	var r0 QueueAccessPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QueueAccessPolicy, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QueueAccessPolicy); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(QueueAccessPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueAccessPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueAccessPolicy'
+type MockSqsService_QueueAccessPolicy_Call struct {
+	*mock.Call
+}
+
+// QueueAccessPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) QueueAccessPolicy(ctx interface{}, queueURL interface{}) *MockSqsService_QueueAccessPolicy_Call {
+	return &MockSqsService_QueueAccessPolicy_Call{Call: _e.mock.On("QueueAccessPolicy", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_QueueAccessPolicy_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_QueueAccessPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueAccessPolicy_Call) Return(queueAccessPolicy QueueAccessPolicy, err error) *MockSqsService_QueueAccessPolicy_Call {
+	_c.Call.Return(queueAccessPolicy, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueAccessPolicy_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (QueueAccessPolicy, error)) *MockSqsService_QueueAccessPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueAttributeHistory provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueAttributeHistory(ctx context.Context, queueURL string) (AttributeHistory, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueAttributeHistory")
+	}
+
+	var r0 AttributeHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (AttributeHistory, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) AttributeHistory); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(AttributeHistory)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueAttributeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueAttributeHistory'
+type MockSqsService_QueueAttributeHistory_Call struct {
+	*mock.Call
+}
+
+// QueueAttributeHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) QueueAttributeHistory(ctx interface{}, queueURL interface{}) *MockSqsService_QueueAttributeHistory_Call {
+	return &MockSqsService_QueueAttributeHistory_Call{Call: _e.mock.On("QueueAttributeHistory", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_QueueAttributeHistory_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_QueueAttributeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueAttributeHistory_Call) Return(attributeHistory AttributeHistory, err error) *MockSqsService_QueueAttributeHistory_Call {
+	_c.Call.Return(attributeHistory, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueAttributeHistory_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (AttributeHistory, error)) *MockSqsService_QueueAttributeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueDefinition provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueDefinition(ctx context.Context, queueURL string, format QueueDefinitionFormat) (QueueDefinition, error) {
+	ret := _mock.Called(ctx, queueURL, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueDefinition")
+	}
+
+	var r0 QueueDefinition
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, QueueDefinitionFormat) (QueueDefinition, error)); ok {
+		return returnFunc(ctx, queueURL, format)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, QueueDefinitionFormat) QueueDefinition); ok {
+		r0 = returnFunc(ctx, queueURL, format)
+	} else {
+		r0 = ret.Get(0).(QueueDefinition)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, QueueDefinitionFormat) error); ok {
+		r1 = returnFunc(ctx, queueURL, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueDefinition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueDefinition'
+type MockSqsService_QueueDefinition_Call struct {
+	*mock.Call
+}
+
+// QueueDefinition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - format QueueDefinitionFormat
+func (_e *MockSqsService_Expecter) QueueDefinition(ctx interface{}, queueURL interface{}, format interface{}) *MockSqsService_QueueDefinition_Call {
+	return &MockSqsService_QueueDefinition_Call{Call: _e.mock.On("QueueDefinition", ctx, queueURL, format)}
+}
+
+func (_c *MockSqsService_QueueDefinition_Call) Run(run func(ctx context.Context, queueURL string, format QueueDefinitionFormat)) *MockSqsService_QueueDefinition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 QueueDefinitionFormat
+		if args[2] != nil {
+			arg2 = args[2].(QueueDefinitionFormat)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueDefinition_Call) Return(queueDefinition QueueDefinition, err error) *MockSqsService_QueueDefinition_Call {
+	_c.Call.Return(queueDefinition, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueDefinition_Call) RunAndReturn(run func(ctx context.Context, queueURL string, format QueueDefinitionFormat) (QueueDefinition, error)) *MockSqsService_QueueDefinition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueDetail provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueDetail(ctx context.Context, queueURL string) (QueueDetail, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueDetail")
+	}
+
+	var r0 QueueDetail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QueueDetail, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QueueDetail); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(QueueDetail)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueDetail'
+type MockSqsService_QueueDetail_Call struct {
+	*mock.Call
+}
+
+// QueueDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) QueueDetail(ctx interface{}, queueURL interface{}) *MockSqsService_QueueDetail_Call {
+	return &MockSqsService_QueueDetail_Call{Call: _e.mock.On("QueueDetail", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_QueueDetail_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_QueueDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueDetail_Call) Return(queueDetail QueueDetail, err error) *MockSqsService_QueueDetail_Call {
+	_c.Call.Return(queueDetail, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueDetail_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (QueueDetail, error)) *MockSqsService_QueueDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueDrift provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueDrift(ctx context.Context) ([]QueueDriftState, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueDrift")
+	}
+
+	var r0 []QueueDriftState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]QueueDriftState, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []QueueDriftState); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]QueueDriftState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueDrift_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueDrift'
+type MockSqsService_QueueDrift_Call struct {
+	*mock.Call
+}
+
+// QueueDrift is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) QueueDrift(ctx interface{}) *MockSqsService_QueueDrift_Call {
+	return &MockSqsService_QueueDrift_Call{Call: _e.mock.On("QueueDrift", ctx)}
+}
+
+func (_c *MockSqsService_QueueDrift_Call) Run(run func(ctx context.Context)) *MockSqsService_QueueDrift_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueDrift_Call) Return(queueDriftStates []QueueDriftState, err error) *MockSqsService_QueueDrift_Call {
+	_c.Call.Return(queueDriftStates, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueDrift_Call) RunAndReturn(run func(ctx context.Context) ([]QueueDriftState, error)) *MockSqsService_QueueDrift_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueEventRules provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueEventRules(ctx context.Context, queueURL string, eventBus string) (QueueEventRules, error) {
+	ret := _mock.Called(ctx, queueURL, eventBus)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueEventRules")
+	}
+
+	var r0 QueueEventRules
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (QueueEventRules, error)); ok {
+		return returnFunc(ctx, queueURL, eventBus)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) QueueEventRules); ok {
+		r0 = returnFunc(ctx, queueURL, eventBus)
+	} else {
+		r0 = ret.Get(0).(QueueEventRules)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, queueURL, eventBus)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueEventRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueEventRules'
+type MockSqsService_QueueEventRules_Call struct {
+	*mock.Call
+}
+
+// QueueEventRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - eventBus string
+func (_e *MockSqsService_Expecter) QueueEventRules(ctx interface{}, queueURL interface{}, eventBus interface{}) *MockSqsService_QueueEventRules_Call {
+	return &MockSqsService_QueueEventRules_Call{Call: _e.mock.On("QueueEventRules", ctx, queueURL, eventBus)}
+}
+
+func (_c *MockSqsService_QueueEventRules_Call) Run(run func(ctx context.Context, queueURL string, eventBus string)) *MockSqsService_QueueEventRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueEventRules_Call) Return(queueEventRules QueueEventRules, err error) *MockSqsService_QueueEventRules_Call {
+	_c.Call.Return(queueEventRules, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueEventRules_Call) RunAndReturn(run func(ctx context.Context, queueURL string, eventBus string) (QueueEventRules, error)) *MockSqsService_QueueEventRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueListColumns provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueListColumns(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueListColumns")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueListColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueListColumns'
+type MockSqsService_QueueListColumns_Call struct {
+	*mock.Call
+}
+
+// QueueListColumns is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) QueueListColumns(ctx interface{}) *MockSqsService_QueueListColumns_Call {
+	return &MockSqsService_QueueListColumns_Call{Call: _e.mock.On("QueueListColumns", ctx)}
+}
+
+func (_c *MockSqsService_QueueListColumns_Call) Run(run func(ctx context.Context)) *MockSqsService_QueueListColumns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueListColumns_Call) Return(strings []string, err error) *MockSqsService_QueueListColumns_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueListColumns_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *MockSqsService_QueueListColumns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueReport provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueReport(ctx context.Context, queueURLs []string) (QueueReport, error) {
+	ret := _mock.Called(ctx, queueURLs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueReport")
+	}
+
+	var r0 QueueReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (QueueReport, error)); ok {
+		return returnFunc(ctx, queueURLs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) QueueReport); ok {
+		r0 = returnFunc(ctx, queueURLs)
+	} else {
+		r0 = ret.Get(0).(QueueReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, queueURLs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueReport'
+type MockSqsService_QueueReport_Call struct {
+	*mock.Call
+}
+
+// QueueReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURLs []string
+func (_e *MockSqsService_Expecter) QueueReport(ctx interface{}, queueURLs interface{}) *MockSqsService_QueueReport_Call {
+	return &MockSqsService_QueueReport_Call{Call: _e.mock.On("QueueReport", ctx, queueURLs)}
+}
+
+func (_c *MockSqsService_QueueReport_Call) Run(run func(ctx context.Context, queueURLs []string)) *MockSqsService_QueueReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueReport_Call) Return(queueReport QueueReport, err error) *MockSqsService_QueueReport_Call {
+	_c.Call.Return(queueReport, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueReport_Call) RunAndReturn(run func(ctx context.Context, queueURLs []string) (QueueReport, error)) *MockSqsService_QueueReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueStats provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueStats(ctx context.Context, queueURLs []string) ([]QueueStatsResult, error) {
+	ret := _mock.Called(ctx, queueURLs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueStats")
+	}
+
+	var r0 []QueueStatsResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]QueueStatsResult, error)); ok {
+		return returnFunc(ctx, queueURLs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []QueueStatsResult); ok {
+		r0 = returnFunc(ctx, queueURLs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]QueueStatsResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, queueURLs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueStats'
+type MockSqsService_QueueStats_Call struct {
+	*mock.Call
+}
+
+// QueueStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURLs []string
+func (_e *MockSqsService_Expecter) QueueStats(ctx interface{}, queueURLs interface{}) *MockSqsService_QueueStats_Call {
+	return &MockSqsService_QueueStats_Call{Call: _e.mock.On("QueueStats", ctx, queueURLs)}
+}
+
+func (_c *MockSqsService_QueueStats_Call) Run(run func(ctx context.Context, queueURLs []string)) *MockSqsService_QueueStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueStats_Call) Return(queueStatsResults []QueueStatsResult, err error) *MockSqsService_QueueStats_Call {
+	_c.Call.Return(queueStatsResults, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueStats_Call) RunAndReturn(run func(ctx context.Context, queueURLs []string) ([]QueueStatsResult, error)) *MockSqsService_QueueStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueueTopics provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueueTopics(ctx context.Context, queueURL string) (QueueTopics, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueTopics")
+	}
+
+	var r0 QueueTopics
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QueueTopics, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QueueTopics); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(QueueTopics)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueueTopics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueTopics'
+type MockSqsService_QueueTopics_Call struct {
+	*mock.Call
+}
+
+// QueueTopics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) QueueTopics(ctx interface{}, queueURL interface{}) *MockSqsService_QueueTopics_Call {
+	return &MockSqsService_QueueTopics_Call{Call: _e.mock.On("QueueTopics", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_QueueTopics_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_QueueTopics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueueTopics_Call) Return(queueTopics QueueTopics, err error) *MockSqsService_QueueTopics_Call {
+	_c.Call.Return(queueTopics, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueueTopics_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (QueueTopics, error)) *MockSqsService_QueueTopics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Queues provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) Queues(ctx context.Context) ([]QueueSummary, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Queues")
+	}
+
+	var r0 []QueueSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]QueueSummary, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []QueueSummary); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]QueueSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_Queues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Queues'
+type MockSqsService_Queues_Call struct {
+	*mock.Call
+}
+
+// Queues is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) Queues(ctx interface{}) *MockSqsService_Queues_Call {
+	return &MockSqsService_Queues_Call{Call: _e.mock.On("Queues", ctx)}
+}
+
+func (_c *MockSqsService_Queues_Call) Run(run func(ctx context.Context)) *MockSqsService_Queues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_Queues_Call) Return(queueSummarys []QueueSummary, err error) *MockSqsService_Queues_Call {
+	_c.Call.Return(queueSummarys, err)
+	return _c
+}
+
+func (_c *MockSqsService_Queues_Call) RunAndReturn(run func(ctx context.Context) ([]QueueSummary, error)) *MockSqsService_Queues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueuesPage provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) QueuesPage(ctx context.Context, maxResults int, nextToken string) (QueueSummaryPage, error) {
+	ret := _mock.Called(ctx, maxResults, nextToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueuesPage")
+	}
+
+	var r0 QueueSummaryPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) (QueueSummaryPage, error)); ok {
+		return returnFunc(ctx, maxResults, nextToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) QueueSummaryPage); ok {
+		r0 = returnFunc(ctx, maxResults, nextToken)
+	} else {
+		r0 = ret.Get(0).(QueueSummaryPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = returnFunc(ctx, maxResults, nextToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_QueuesPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueuesPage'
+type MockSqsService_QueuesPage_Call struct {
+	*mock.Call
+}
+
+// QueuesPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxResults int
+//   - nextToken string
+func (_e *MockSqsService_Expecter) QueuesPage(ctx interface{}, maxResults interface{}, nextToken interface{}) *MockSqsService_QueuesPage_Call {
+	return &MockSqsService_QueuesPage_Call{Call: _e.mock.On("QueuesPage", ctx, maxResults, nextToken)}
+}
+
+func (_c *MockSqsService_QueuesPage_Call) Run(run func(ctx context.Context, maxResults int, nextToken string)) *MockSqsService_QueuesPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_QueuesPage_Call) Return(queueSummaryPage QueueSummaryPage, err error) *MockSqsService_QueuesPage_Call {
+	_c.Call.Return(queueSummaryPage, err)
+	return _c
+}
+
+func (_c *MockSqsService_QueuesPage_Call) RunAndReturn(run func(ctx context.Context, maxResults int, nextToken string) (QueueSummaryPage, error)) *MockSqsService_QueuesPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveMergedMessages provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) ReceiveMergedMessages(ctx context.Context, input MergedReceiveInput) (MergedReceiveResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveMergedMessages")
+	}
+
+	var r0 MergedReceiveResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, MergedReceiveInput) (MergedReceiveResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, MergedReceiveInput) MergedReceiveResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(MergedReceiveResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, MergedReceiveInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_ReceiveMergedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMergedMessages'
+type MockSqsService_ReceiveMergedMessages_Call struct {
+	*mock.Call
+}
+
+// ReceiveMergedMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input MergedReceiveInput
+func (_e *MockSqsService_Expecter) ReceiveMergedMessages(ctx interface{}, input interface{}) *MockSqsService_ReceiveMergedMessages_Call {
+	return &MockSqsService_ReceiveMergedMessages_Call{Call: _e.mock.On("ReceiveMergedMessages", ctx, input)}
+}
+
+func (_c *MockSqsService_ReceiveMergedMessages_Call) Run(run func(ctx context.Context, input MergedReceiveInput)) *MockSqsService_ReceiveMergedMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 MergedReceiveInput
+		if args[1] != nil {
+			arg1 = args[1].(MergedReceiveInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_ReceiveMergedMessages_Call) Return(mergedReceiveResult MergedReceiveResult, err error) *MockSqsService_ReceiveMergedMessages_Call {
+	_c.Call.Return(mergedReceiveResult, err)
+	return _c
+}
+
+func (_c *MockSqsService_ReceiveMergedMessages_Call) RunAndReturn(run func(ctx context.Context, input MergedReceiveInput) (MergedReceiveResult, error)) *MockSqsService_ReceiveMergedMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveMessages provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) ReceiveMessages(ctx context.Context, input ReceiveMessagesInput) (ReceiveMessagesResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveMessages")
+	}
+
+	var r0 ReceiveMessagesResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ReceiveMessagesInput) (ReceiveMessagesResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ReceiveMessagesInput) ReceiveMessagesResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(ReceiveMessagesResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ReceiveMessagesInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_ReceiveMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMessages'
+type MockSqsService_ReceiveMessages_Call struct {
+	*mock.Call
+}
+
+// ReceiveMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input ReceiveMessagesInput
+func (_e *MockSqsService_Expecter) ReceiveMessages(ctx interface{}, input interface{}) *MockSqsService_ReceiveMessages_Call {
+	return &MockSqsService_ReceiveMessages_Call{Call: _e.mock.On("ReceiveMessages", ctx, input)}
+}
+
+func (_c *MockSqsService_ReceiveMessages_Call) Run(run func(ctx context.Context, input ReceiveMessagesInput)) *MockSqsService_ReceiveMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ReceiveMessagesInput
+		if args[1] != nil {
+			arg1 = args[1].(ReceiveMessagesInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_ReceiveMessages_Call) Return(receiveMessagesResult ReceiveMessagesResult, err error) *MockSqsService_ReceiveMessages_Call {
+	_c.Call.Return(receiveMessagesResult, err)
+	return _c
+}
+
+func (_c *MockSqsService_ReceiveMessages_Call) RunAndReturn(run func(ctx context.Context, input ReceiveMessagesInput) (ReceiveMessagesResult, error)) *MockSqsService_ReceiveMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAttributeHistory provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) RecordAttributeHistory(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAttributeHistory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_RecordAttributeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAttributeHistory'
+type MockSqsService_RecordAttributeHistory_Call struct {
+	*mock.Call
+}
+
+// RecordAttributeHistory is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) RecordAttributeHistory(ctx interface{}) *MockSqsService_RecordAttributeHistory_Call {
+	return &MockSqsService_RecordAttributeHistory_Call{Call: _e.mock.On("RecordAttributeHistory", ctx)}
+}
+
+func (_c *MockSqsService_RecordAttributeHistory_Call) Run(run func(ctx context.Context)) *MockSqsService_RecordAttributeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_RecordAttributeHistory_Call) Return(err error) *MockSqsService_RecordAttributeHistory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_RecordAttributeHistory_Call) RunAndReturn(run func(ctx context.Context) error) *MockSqsService_RecordAttributeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedriveMessages provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) RedriveMessages(ctx context.Context, queueURL string, messages []ReceivedMessage) ([]RedrivenMessage, error) {
+	ret := _mock.Called(ctx, queueURL, messages)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedriveMessages")
+	}
+
+	var r0 []RedrivenMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []ReceivedMessage) ([]RedrivenMessage, error)); ok {
+		return returnFunc(ctx, queueURL, messages)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []ReceivedMessage) []RedrivenMessage); ok {
+		r0 = returnFunc(ctx, queueURL, messages)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]RedrivenMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []ReceivedMessage) error); ok {
+		r1 = returnFunc(ctx, queueURL, messages)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_RedriveMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedriveMessages'
+type MockSqsService_RedriveMessages_Call struct {
+	*mock.Call
+}
+
+// RedriveMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - messages []ReceivedMessage
+func (_e *MockSqsService_Expecter) RedriveMessages(ctx interface{}, queueURL interface{}, messages interface{}) *MockSqsService_RedriveMessages_Call {
+	return &MockSqsService_RedriveMessages_Call{Call: _e.mock.On("RedriveMessages", ctx, queueURL, messages)}
+}
+
+func (_c *MockSqsService_RedriveMessages_Call) Run(run func(ctx context.Context, queueURL string, messages []ReceivedMessage)) *MockSqsService_RedriveMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []ReceivedMessage
+		if args[2] != nil {
+			arg2 = args[2].([]ReceivedMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_RedriveMessages_Call) Return(redrivenMessages []RedrivenMessage, err error) *MockSqsService_RedriveMessages_Call {
+	_c.Call.Return(redrivenMessages, err)
+	return _c
+}
+
+func (_c *MockSqsService_RedriveMessages_Call) RunAndReturn(run func(ctx context.Context, queueURL string, messages []ReceivedMessage) ([]RedrivenMessage, error)) *MockSqsService_RedriveMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreQueue provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) RestoreQueue(ctx context.Context, id string) (string, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreQueue")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_RestoreQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreQueue'
+type MockSqsService_RestoreQueue_Call struct {
+	*mock.Call
+}
+
+// RestoreQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSqsService_Expecter) RestoreQueue(ctx interface{}, id interface{}) *MockSqsService_RestoreQueue_Call {
+	return &MockSqsService_RestoreQueue_Call{Call: _e.mock.On("RestoreQueue", ctx, id)}
+}
+
+func (_c *MockSqsService_RestoreQueue_Call) Run(run func(ctx context.Context, id string)) *MockSqsService_RestoreQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_RestoreQueue_Call) Return(s string, err error) *MockSqsService_RestoreQueue_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockSqsService_RestoreQueue_Call) RunAndReturn(run func(ctx context.Context, id string) (string, error)) *MockSqsService_RestoreQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunDueSchedules provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) RunDueSchedules(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunDueSchedules")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_RunDueSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunDueSchedules'
+type MockSqsService_RunDueSchedules_Call struct {
+	*mock.Call
+}
+
+// RunDueSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) RunDueSchedules(ctx interface{}) *MockSqsService_RunDueSchedules_Call {
+	return &MockSqsService_RunDueSchedules_Call{Call: _e.mock.On("RunDueSchedules", ctx)}
+}
+
+func (_c *MockSqsService_RunDueSchedules_Call) Run(run func(ctx context.Context)) *MockSqsService_RunDueSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_RunDueSchedules_Call) Return(err error) *MockSqsService_RunDueSchedules_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_RunDueSchedules_Call) RunAndReturn(run func(ctx context.Context) error) *MockSqsService_RunDueSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunSlackCommand provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) RunSlackCommand(ctx context.Context, cmd SlackCommand) (SlackReply, error) {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunSlackCommand")
+	}
+
+	var r0 SlackReply
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SlackCommand) (SlackReply, error)); ok {
+		return returnFunc(ctx, cmd)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SlackCommand) SlackReply); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		r0 = ret.Get(0).(SlackReply)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SlackCommand) error); ok {
+		r1 = returnFunc(ctx, cmd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_RunSlackCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunSlackCommand'
+type MockSqsService_RunSlackCommand_Call struct {
+	*mock.Call
+}
+
+// RunSlackCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd SlackCommand
+func (_e *MockSqsService_Expecter) RunSlackCommand(ctx interface{}, cmd interface{}) *MockSqsService_RunSlackCommand_Call {
+	return &MockSqsService_RunSlackCommand_Call{Call: _e.mock.On("RunSlackCommand", ctx, cmd)}
+}
+
+func (_c *MockSqsService_RunSlackCommand_Call) Run(run func(ctx context.Context, cmd SlackCommand)) *MockSqsService_RunSlackCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 SlackCommand
+		if args[1] != nil {
+			arg1 = args[1].(SlackCommand)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_RunSlackCommand_Call) Return(slackReply SlackReply, err error) *MockSqsService_RunSlackCommand_Call {
+	_c.Call.Return(slackReply, err)
+	return _c
+}
+
+func (_c *MockSqsService_RunSlackCommand_Call) RunAndReturn(run func(ctx context.Context, cmd SlackCommand) (SlackReply, error)) *MockSqsService_RunSlackCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDraft provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SaveDraft(ctx context.Context, queueURL string, draft MessageDraft) (MessageDraft, error) {
+	ret := _mock.Called(ctx, queueURL, draft)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDraft")
+	}
+
+	var r0 MessageDraft
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, MessageDraft) (MessageDraft, error)); ok {
+		return returnFunc(ctx, queueURL, draft)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, MessageDraft) MessageDraft); ok {
+		r0 = returnFunc(ctx, queueURL, draft)
+	} else {
+		r0 = ret.Get(0).(MessageDraft)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, MessageDraft) error); ok {
+		r1 = returnFunc(ctx, queueURL, draft)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_SaveDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDraft'
+type MockSqsService_SaveDraft_Call struct {
+	*mock.Call
+}
+
+// SaveDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - draft MessageDraft
+func (_e *MockSqsService_Expecter) SaveDraft(ctx interface{}, queueURL interface{}, draft interface{}) *MockSqsService_SaveDraft_Call {
+	return &MockSqsService_SaveDraft_Call{Call: _e.mock.On("SaveDraft", ctx, queueURL, draft)}
+}
+
+func (_c *MockSqsService_SaveDraft_Call) Run(run func(ctx context.Context, queueURL string, draft MessageDraft)) *MockSqsService_SaveDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 MessageDraft
+		if args[2] != nil {
+			arg2 = args[2].(MessageDraft)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_SaveDraft_Call) Return(messageDraft MessageDraft, err error) *MockSqsService_SaveDraft_Call {
+	_c.Call.Return(messageDraft, err)
+	return _c
+}
+
+func (_c *MockSqsService_SaveDraft_Call) RunAndReturn(run func(ctx context.Context, queueURL string, draft MessageDraft) (MessageDraft, error)) *MockSqsService_SaveDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMessageContract provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SaveMessageContract(ctx context.Context, contract MessageContract) (MessageContract, error) {
+	ret := _mock.Called(ctx, contract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMessageContract")
+	}
+
+	var r0 MessageContract
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, MessageContract) (MessageContract, error)); ok {
+		return returnFunc(ctx, contract)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, MessageContract) MessageContract); ok {
+		r0 = returnFunc(ctx, contract)
+	} else {
+		r0 = ret.Get(0).(MessageContract)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, MessageContract) error); ok {
+		r1 = returnFunc(ctx, contract)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_SaveMessageContract_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMessageContract'
+type MockSqsService_SaveMessageContract_Call struct {
+	*mock.Call
+}
+
+// SaveMessageContract is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contract MessageContract
+func (_e *MockSqsService_Expecter) SaveMessageContract(ctx interface{}, contract interface{}) *MockSqsService_SaveMessageContract_Call {
+	return &MockSqsService_SaveMessageContract_Call{Call: _e.mock.On("SaveMessageContract", ctx, contract)}
+}
+
+func (_c *MockSqsService_SaveMessageContract_Call) Run(run func(ctx context.Context, contract MessageContract)) *MockSqsService_SaveMessageContract_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 MessageContract
+		if args[1] != nil {
+			arg1 = args[1].(MessageContract)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_SaveMessageContract_Call) Return(messageContract MessageContract, err error) *MockSqsService_SaveMessageContract_Call {
+	_c.Call.Return(messageContract, err)
+	return _c
+}
+
+func (_c *MockSqsService_SaveMessageContract_Call) RunAndReturn(run func(ctx context.Context, contract MessageContract) (MessageContract, error)) *MockSqsService_SaveMessageContract_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveQueueBaseline provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SaveQueueBaseline(ctx context.Context, queueURL string) (QueueBaseline, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveQueueBaseline")
+	}
+
+	var r0 QueueBaseline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QueueBaseline, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QueueBaseline); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(QueueBaseline)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_SaveQueueBaseline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveQueueBaseline'
+type MockSqsService_SaveQueueBaseline_Call struct {
+	*mock.Call
+}
+
+// SaveQueueBaseline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) SaveQueueBaseline(ctx interface{}, queueURL interface{}) *MockSqsService_SaveQueueBaseline_Call {
+	return &MockSqsService_SaveQueueBaseline_Call{Call: _e.mock.On("SaveQueueBaseline", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_SaveQueueBaseline_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_SaveQueueBaseline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_SaveQueueBaseline_Call) Return(queueBaseline QueueBaseline, err error) *MockSqsService_SaveQueueBaseline_Call {
+	_c.Call.Return(queueBaseline, err)
+	return _c
+}
+
+func (_c *MockSqsService_SaveQueueBaseline_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (QueueBaseline, error)) *MockSqsService_SaveQueueBaseline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveQueueListColumns provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SaveQueueListColumns(ctx context.Context, columns []string) ([]string, error) {
+	ret := _mock.Called(ctx, columns)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveQueueListColumns")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return returnFunc(ctx, columns)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = returnFunc(ctx, columns)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, columns)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_SaveQueueListColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveQueueListColumns'
+type MockSqsService_SaveQueueListColumns_Call struct {
+	*mock.Call
+}
+
+// SaveQueueListColumns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - columns []string
+func (_e *MockSqsService_Expecter) SaveQueueListColumns(ctx interface{}, columns interface{}) *MockSqsService_SaveQueueListColumns_Call {
+	return &MockSqsService_SaveQueueListColumns_Call{Call: _e.mock.On("SaveQueueListColumns", ctx, columns)}
+}
+
+func (_c *MockSqsService_SaveQueueListColumns_Call) Run(run func(ctx context.Context, columns []string)) *MockSqsService_SaveQueueListColumns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_SaveQueueListColumns_Call) Return(strings []string, err error) *MockSqsService_SaveQueueListColumns_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSqsService_SaveQueueListColumns_Call) RunAndReturn(run func(ctx context.Context, columns []string) ([]string, error)) *MockSqsService_SaveQueueListColumns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schedule provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) Schedule(ctx context.Context, id string) (Schedule, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Schedule")
+	}
+
+	var r0 Schedule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (Schedule, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) Schedule); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Schedule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_Schedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schedule'
+type MockSqsService_Schedule_Call struct {
+	*mock.Call
+}
+
+// Schedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSqsService_Expecter) Schedule(ctx interface{}, id interface{}) *MockSqsService_Schedule_Call {
+	return &MockSqsService_Schedule_Call{Call: _e.mock.On("Schedule", ctx, id)}
+}
+
+func (_c *MockSqsService_Schedule_Call) Run(run func(ctx context.Context, id string)) *MockSqsService_Schedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_Schedule_Call) Return(schedule Schedule, err error) *MockSqsService_Schedule_Call {
+	_c.Call.Return(schedule, err)
+	return _c
+}
+
+func (_c *MockSqsService_Schedule_Call) RunAndReturn(run func(ctx context.Context, id string) (Schedule, error)) *MockSqsService_Schedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schedules provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) Schedules(ctx context.Context) ([]Schedule, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Schedules")
+	}
+
+	var r0 []Schedule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Schedule, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Schedule); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Schedule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_Schedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schedules'
+type MockSqsService_Schedules_Call struct {
+	*mock.Call
+}
+
+// Schedules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) Schedules(ctx interface{}) *MockSqsService_Schedules_Call {
+	return &MockSqsService_Schedules_Call{Call: _e.mock.On("Schedules", ctx)}
+}
+
+func (_c *MockSqsService_Schedules_Call) Run(run func(ctx context.Context)) *MockSqsService_Schedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_Schedules_Call) Return(schedules []Schedule, err error) *MockSqsService_Schedules_Call {
+	_c.Call.Return(schedules, err)
+	return _c
+}
+
+func (_c *MockSqsService_Schedules_Call) RunAndReturn(run func(ctx context.Context) ([]Schedule, error)) *MockSqsService_Schedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) Search(ctx context.Context, query string) (SearchResults, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 SearchResults
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (SearchResults, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) SearchResults); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		r0 = ret.Get(0).(SearchResults)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockSqsService_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+func (_e *MockSqsService_Expecter) Search(ctx interface{}, query interface{}) *MockSqsService_Search_Call {
+	return &MockSqsService_Search_Call{Call: _e.mock.On("Search", ctx, query)}
+}
+
+func (_c *MockSqsService_Search_Call) Run(run func(ctx context.Context, query string)) *MockSqsService_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_Search_Call) Return(searchResults SearchResults, err error) *MockSqsService_Search_Call {
+	_c.Call.Return(searchResults, err)
+	return _c
+}
+
+func (_c *MockSqsService_Search_Call) RunAndReturn(run func(ctx context.Context, query string) (SearchResults, error)) *MockSqsService_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchMessages provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SearchMessages(ctx context.Context, input SearchMessagesInput, emit func([]ReceivedMessage, int) error) (SearchMessagesResult, error) {
+	ret := _mock.Called(ctx, input, emit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMessages")
+	}
+
+	var r0 SearchMessagesResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SearchMessagesInput, func([]ReceivedMessage, int) error) (SearchMessagesResult, error)); ok {
+		return returnFunc(ctx, input, emit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SearchMessagesInput, func([]ReceivedMessage, int) error) SearchMessagesResult); ok {
+		r0 = returnFunc(ctx, input, emit)
+	} else {
+		r0 = ret.Get(0).(SearchMessagesResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SearchMessagesInput, func([]ReceivedMessage, int) error) error); ok {
+		r1 = returnFunc(ctx, input, emit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_SearchMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMessages'
+type MockSqsService_SearchMessages_Call struct {
+	*mock.Call
+}
+
+// SearchMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input SearchMessagesInput
+//   - emit func([]ReceivedMessage, int) error
+func (_e *MockSqsService_Expecter) SearchMessages(ctx interface{}, input interface{}, emit interface{}) *MockSqsService_SearchMessages_Call {
+	return &MockSqsService_SearchMessages_Call{Call: _e.mock.On("SearchMessages", ctx, input, emit)}
+}
+
+func (_c *MockSqsService_SearchMessages_Call) Run(run func(ctx context.Context, input SearchMessagesInput, emit func([]ReceivedMessage, int) error)) *MockSqsService_SearchMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 SearchMessagesInput
+		if args[1] != nil {
+			arg1 = args[1].(SearchMessagesInput)
+		}
+		var arg2 func([]ReceivedMessage, int) error
+		if args[2] != nil {
+			arg2 = args[2].(func([]ReceivedMessage, int) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_SearchMessages_Call) Return(searchMessagesResult SearchMessagesResult, err error) *MockSqsService_SearchMessages_Call {
+	_c.Call.Return(searchMessagesResult, err)
+	return _c
+}
+
+func (_c *MockSqsService_SearchMessages_Call) RunAndReturn(run func(ctx context.Context, input SearchMessagesInput, emit func([]ReceivedMessage, int) error) (SearchMessagesResult, error)) *MockSqsService_SearchMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendDefaults provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SendDefaults(ctx context.Context, queueURL string) (SendDefaults, error) {
+	ret := _mock.Called(ctx, queueURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDefaults")
+	}
+
+	var r0 SendDefaults
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (SendDefaults, error)); ok {
+		return returnFunc(ctx, queueURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) SendDefaults); ok {
+		r0 = returnFunc(ctx, queueURL)
+	} else {
+		r0 = ret.Get(0).(SendDefaults)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_SendDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDefaults'
+type MockSqsService_SendDefaults_Call struct {
+	*mock.Call
+}
+
+// SendDefaults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+func (_e *MockSqsService_Expecter) SendDefaults(ctx interface{}, queueURL interface{}) *MockSqsService_SendDefaults_Call {
+	return &MockSqsService_SendDefaults_Call{Call: _e.mock.On("SendDefaults", ctx, queueURL)}
+}
+
+func (_c *MockSqsService_SendDefaults_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_SendDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_SendDefaults_Call) Return(sendDefaults SendDefaults, err error) *MockSqsService_SendDefaults_Call {
+	_c.Call.Return(sendDefaults, err)
+	return _c
+}
+
+func (_c *MockSqsService_SendDefaults_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (SendDefaults, error)) *MockSqsService_SendDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendDueEmailReport provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SendDueEmailReport(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDueEmailReport")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSqsService_SendDueEmailReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDueEmailReport'
+type MockSqsService_SendDueEmailReport_Call struct {
+	*mock.Call
+}
+
+// SendDueEmailReport is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqsService_Expecter) SendDueEmailReport(ctx interface{}) *MockSqsService_SendDueEmailReport_Call {
+	return &MockSqsService_SendDueEmailReport_Call{Call: _e.mock.On("SendDueEmailReport", ctx)}
+}
+
+func (_c *MockSqsService_SendDueEmailReport_Call) Run(run func(ctx context.Context)) *MockSqsService_SendDueEmailReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_SendDueEmailReport_Call) Return(err error) *MockSqsService_SendDueEmailReport_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSqsService_SendDueEmailReport_Call) RunAndReturn(run func(ctx context.Context) error) *MockSqsService_SendDueEmailReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessage provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SendMessage(ctx context.Context, input SendMessageInput) (SendMessageResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 SendMessageResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SendMessageInput) (SendMessageResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SendMessageInput) SendMessageResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(SendMessageResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SendMessageInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSqsService_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type MockSqsService_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input SendMessageInput
+func (_e *MockSqsService_Expecter) SendMessage(ctx interface{}, input interface{}) *MockSqsService_SendMessage_Call {
+	return &MockSqsService_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, input)}
+}
+
+func (_c *MockSqsService_SendMessage_Call) Run(run func(ctx context.Context, input SendMessageInput)) *MockSqsService_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 SendMessageInput
+		if args[1] != nil {
+			arg1 = args[1].(SendMessageInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSqsService_SendMessage_Call) Return(sendMessageResult SendMessageResult, err error) *MockSqsService_SendMessage_Call {
+	_c.Call.Return(sendMessageResult, err)
+	return _c
+}
+
+func (_c *MockSqsService_SendMessage_Call) RunAndReturn(run func(ctx context.Context, input SendMessageInput) (SendMessageResult, error)) *MockSqsService_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessageBatch provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SendMessageBatch(ctx context.Context, input SendMessageBatchInput) (SendMessageBatchResult, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessageBatch")
+	}
+
+	var r0 SendMessageBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SendMessageBatchInput) (SendMessageBatchResult, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SendMessageBatchInput) SendMessageBatchResult); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(SendMessageBatchResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SendMessageBatchInput) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockHandler_PurgeQueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeQueueHandler'
-type MockHandler_PurgeQueueHandler_Call struct {
+// MockSqsService_SendMessageBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessageBatch'
+type MockSqsService_SendMessageBatch_Call struct {
 	*mock.Call
 }
 
-// PurgeQueueHandler is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) PurgeQueueHandler(w interface{}, r interface{}) *MockHandler_PurgeQueueHandler_Call {
-	return &MockHandler_PurgeQueueHandler_Call{Call: _e.mock.On("PurgeQueueHandler", w, r)}
+// SendMessageBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input SendMessageBatchInput
+func (_e *MockSqsService_Expecter) SendMessageBatch(ctx interface{}, input interface{}) *MockSqsService_SendMessageBatch_Call {
+	return &MockSqsService_SendMessageBatch_Call{Call: _e.mock.On("SendMessageBatch", ctx, input)}
 }
 
-func (_c *MockHandler_PurgeQueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PurgeQueueHandler_Call {
+func (_c *MockSqsService_SendMessageBatch_Call) Run(run func(ctx context.Context, input SendMessageBatchInput)) *MockSqsService_SendMessageBatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 SendMessageBatchInput
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(SendMessageBatchInput)
 		}
 		run(
 			arg0,
@@ -259,1176 +16776,1061 @@ func (_c *MockHandler_PurgeQueueHandler_Call) Run(run func(w http.ResponseWriter
 	return _c
 }
 
-func (_c *MockHandler_PurgeQueueHandler_Call) Return() *MockHandler_PurgeQueueHandler_Call {
-	_c.Call.Return()
+func (_c *MockSqsService_SendMessageBatch_Call) Return(sendMessageBatchResult SendMessageBatchResult, err error) *MockSqsService_SendMessageBatch_Call {
+	_c.Call.Return(sendMessageBatchResult, err)
 	return _c
 }
 
-func (_c *MockHandler_PurgeQueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_PurgeQueueHandler_Call {
-	_c.Run(run)
+func (_c *MockSqsService_SendMessageBatch_Call) RunAndReturn(run func(ctx context.Context, input SendMessageBatchInput) (SendMessageBatchResult, error)) *MockSqsService_SendMessageBatch_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// QueueHandler provides a mock function for the type MockHandler
-func (_mock *MockHandler) QueueHandler(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// SetFavoriteQueue provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SetFavoriteQueue(ctx context.Context, queueURL string, favorite bool) error {
+	ret := _mock.Called(ctx, queueURL, favorite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFavoriteQueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, queueURL, favorite)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockHandler_QueueHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueHandler'
-type MockHandler_QueueHandler_Call struct {
+// MockSqsService_SetFavoriteQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFavoriteQueue'
+type MockSqsService_SetFavoriteQueue_Call struct {
 	*mock.Call
 }
 
-// QueueHandler is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) QueueHandler(w interface{}, r interface{}) *MockHandler_QueueHandler_Call {
-	return &MockHandler_QueueHandler_Call{Call: _e.mock.On("QueueHandler", w, r)}
+// SetFavoriteQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - favorite bool
+func (_e *MockSqsService_Expecter) SetFavoriteQueue(ctx interface{}, queueURL interface{}, favorite interface{}) *MockSqsService_SetFavoriteQueue_Call {
+	return &MockSqsService_SetFavoriteQueue_Call{Call: _e.mock.On("SetFavoriteQueue", ctx, queueURL, favorite)}
 }
 
-func (_c *MockHandler_QueueHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueHandler_Call {
+func (_c *MockSqsService_SetFavoriteQueue_Call) Run(run func(ctx context.Context, queueURL string, favorite bool)) *MockSqsService_SetFavoriteQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_QueueHandler_Call) Return() *MockHandler_QueueHandler_Call {
-	_c.Call.Return()
+func (_c *MockSqsService_SetFavoriteQueue_Call) Return(err error) *MockSqsService_SetFavoriteQueue_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockHandler_QueueHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueueHandler_Call {
-	_c.Run(run)
+func (_c *MockSqsService_SetFavoriteQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string, favorite bool) error) *MockSqsService_SetFavoriteQueue_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// QueuesHandler provides a mock function for the type MockHandler
-func (_mock *MockHandler) QueuesHandler(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// SetFifoThroughput provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SetFifoThroughput(ctx context.Context, queueURL string, throughput FifoThroughput) error {
+	ret := _mock.Called(ctx, queueURL, throughput)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFifoThroughput")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, FifoThroughput) error); ok {
+		r0 = returnFunc(ctx, queueURL, throughput)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockHandler_QueuesHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueuesHandler'
-type MockHandler_QueuesHandler_Call struct {
+// MockSqsService_SetFifoThroughput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFifoThroughput'
+type MockSqsService_SetFifoThroughput_Call struct {
 	*mock.Call
 }
 
-// QueuesHandler is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) QueuesHandler(w interface{}, r interface{}) *MockHandler_QueuesHandler_Call {
-	return &MockHandler_QueuesHandler_Call{Call: _e.mock.On("QueuesHandler", w, r)}
+// SetFifoThroughput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - throughput FifoThroughput
+func (_e *MockSqsService_Expecter) SetFifoThroughput(ctx interface{}, queueURL interface{}, throughput interface{}) *MockSqsService_SetFifoThroughput_Call {
+	return &MockSqsService_SetFifoThroughput_Call{Call: _e.mock.On("SetFifoThroughput", ctx, queueURL, throughput)}
 }
 
-func (_c *MockHandler_QueuesHandler_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueuesHandler_Call {
+func (_c *MockSqsService_SetFifoThroughput_Call) Run(run func(ctx context.Context, queueURL string, throughput FifoThroughput)) *MockSqsService_SetFifoThroughput_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(string)
+		}
+		var arg2 FifoThroughput
+		if args[2] != nil {
+			arg2 = args[2].(FifoThroughput)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_QueuesHandler_Call) Return() *MockHandler_QueuesHandler_Call {
-	_c.Call.Return()
+func (_c *MockSqsService_SetFifoThroughput_Call) Return(err error) *MockSqsService_SetFifoThroughput_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockHandler_QueuesHandler_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_QueuesHandler_Call {
-	_c.Run(run)
+func (_c *MockSqsService_SetFifoThroughput_Call) RunAndReturn(run func(ctx context.Context, queueURL string, throughput FifoThroughput) error) *MockSqsService_SetFifoThroughput_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// ReceiveMessagesAPI provides a mock function for the type MockHandler
-func (_mock *MockHandler) ReceiveMessagesAPI(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// SetQueueAccessPolicy provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SetQueueAccessPolicy(ctx context.Context, queueURL string, policy string) ([]PolicyFinding, error) {
+	ret := _mock.Called(ctx, queueURL, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetQueueAccessPolicy")
+	}
+
+	var r0 []PolicyFinding
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]PolicyFinding, error)); ok {
+		return returnFunc(ctx, queueURL, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []PolicyFinding); ok {
+		r0 = returnFunc(ctx, queueURL, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]PolicyFinding)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, queueURL, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockHandler_ReceiveMessagesAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMessagesAPI'
-type MockHandler_ReceiveMessagesAPI_Call struct {
+// MockSqsService_SetQueueAccessPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetQueueAccessPolicy'
+type MockSqsService_SetQueueAccessPolicy_Call struct {
 	*mock.Call
 }
 
-// ReceiveMessagesAPI is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) ReceiveMessagesAPI(w interface{}, r interface{}) *MockHandler_ReceiveMessagesAPI_Call {
-	return &MockHandler_ReceiveMessagesAPI_Call{Call: _e.mock.On("ReceiveMessagesAPI", w, r)}
+// SetQueueAccessPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - policy string
+func (_e *MockSqsService_Expecter) SetQueueAccessPolicy(ctx interface{}, queueURL interface{}, policy interface{}) *MockSqsService_SetQueueAccessPolicy_Call {
+	return &MockSqsService_SetQueueAccessPolicy_Call{Call: _e.mock.On("SetQueueAccessPolicy", ctx, queueURL, policy)}
 }
 
-func (_c *MockHandler_ReceiveMessagesAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ReceiveMessagesAPI_Call {
+func (_c *MockSqsService_SetQueueAccessPolicy_Call) Run(run func(ctx context.Context, queueURL string, policy string)) *MockSqsService_SetQueueAccessPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_ReceiveMessagesAPI_Call) Return() *MockHandler_ReceiveMessagesAPI_Call {
-	_c.Call.Return()
+func (_c *MockSqsService_SetQueueAccessPolicy_Call) Return(policyFindings []PolicyFinding, err error) *MockSqsService_SetQueueAccessPolicy_Call {
+	_c.Call.Return(policyFindings, err)
 	return _c
 }
 
-func (_c *MockHandler_ReceiveMessagesAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_ReceiveMessagesAPI_Call {
-	_c.Run(run)
+func (_c *MockSqsService_SetQueueAccessPolicy_Call) RunAndReturn(run func(ctx context.Context, queueURL string, policy string) ([]PolicyFinding, error)) *MockSqsService_SetQueueAccessPolicy_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// SendMessageAPI provides a mock function for the type MockHandler
-func (_mock *MockHandler) SendMessageAPI(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// SetRedrivePolicy provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SetRedrivePolicy(ctx context.Context, queueURL string, policy *RedrivePolicy) error {
+	ret := _mock.Called(ctx, queueURL, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRedrivePolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *RedrivePolicy) error); ok {
+		r0 = returnFunc(ctx, queueURL, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockHandler_SendMessageAPI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessageAPI'
-type MockHandler_SendMessageAPI_Call struct {
+// MockSqsService_SetRedrivePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRedrivePolicy'
+type MockSqsService_SetRedrivePolicy_Call struct {
 	*mock.Call
 }
 
-// SendMessageAPI is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) SendMessageAPI(w interface{}, r interface{}) *MockHandler_SendMessageAPI_Call {
-	return &MockHandler_SendMessageAPI_Call{Call: _e.mock.On("SendMessageAPI", w, r)}
+// SetRedrivePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - policy *RedrivePolicy
+func (_e *MockSqsService_Expecter) SetRedrivePolicy(ctx interface{}, queueURL interface{}, policy interface{}) *MockSqsService_SetRedrivePolicy_Call {
+	return &MockSqsService_SetRedrivePolicy_Call{Call: _e.mock.On("SetRedrivePolicy", ctx, queueURL, policy)}
 }
 
-func (_c *MockHandler_SendMessageAPI_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendMessageAPI_Call {
+func (_c *MockSqsService_SetRedrivePolicy_Call) Run(run func(ctx context.Context, queueURL string, policy *RedrivePolicy)) *MockSqsService_SetRedrivePolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(string)
+		}
+		var arg2 *RedrivePolicy
+		if args[2] != nil {
+			arg2 = args[2].(*RedrivePolicy)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_SendMessageAPI_Call) Return() *MockHandler_SendMessageAPI_Call {
-	_c.Call.Return()
+func (_c *MockSqsService_SetRedrivePolicy_Call) Return(err error) *MockSqsService_SetRedrivePolicy_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockHandler_SendMessageAPI_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendMessageAPI_Call {
-	_c.Run(run)
+func (_c *MockSqsService_SetRedrivePolicy_Call) RunAndReturn(run func(ctx context.Context, queueURL string, policy *RedrivePolicy) error) *MockSqsService_SetRedrivePolicy_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// SendReceive provides a mock function for the type MockHandler
-func (_mock *MockHandler) SendReceive(w http.ResponseWriter, r *http.Request) {
-	_mock.Called(w, r)
-	return
+// SilenceAlertRule provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SilenceAlertRule(ctx context.Context, id string, duration time.Duration, reason string) (AlertRule, error) {
+	ret := _mock.Called(ctx, id, duration, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SilenceAlertRule")
+	}
+
+	var r0 AlertRule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration, string) (AlertRule, error)); ok {
+		return returnFunc(ctx, id, duration, reason)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration, string) AlertRule); ok {
+		r0 = returnFunc(ctx, id, duration, reason)
+	} else {
+		r0 = ret.Get(0).(AlertRule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Duration, string) error); ok {
+		r1 = returnFunc(ctx, id, duration, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockHandler_SendReceive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendReceive'
-type MockHandler_SendReceive_Call struct {
+// MockSqsService_SilenceAlertRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SilenceAlertRule'
+type MockSqsService_SilenceAlertRule_Call struct {
 	*mock.Call
 }
 
-// SendReceive is a helper method to define mock.On call
-//   - w http.ResponseWriter
-//   - r *http.Request
-func (_e *MockHandler_Expecter) SendReceive(w interface{}, r interface{}) *MockHandler_SendReceive_Call {
-	return &MockHandler_SendReceive_Call{Call: _e.mock.On("SendReceive", w, r)}
+// SilenceAlertRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - duration time.Duration
+//   - reason string
+func (_e *MockSqsService_Expecter) SilenceAlertRule(ctx interface{}, id interface{}, duration interface{}, reason interface{}) *MockSqsService_SilenceAlertRule_Call {
+	return &MockSqsService_SilenceAlertRule_Call{Call: _e.mock.On("SilenceAlertRule", ctx, id, duration, reason)}
 }
 
-func (_c *MockHandler_SendReceive_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendReceive_Call {
+func (_c *MockSqsService_SilenceAlertRule_Call) Run(run func(ctx context.Context, id string, duration time.Duration, reason string)) *MockSqsService_SilenceAlertRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 http.ResponseWriter
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(http.ResponseWriter)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *http.Request
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*http.Request)
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_SendReceive_Call) Return() *MockHandler_SendReceive_Call {
-	_c.Call.Return()
+func (_c *MockSqsService_SilenceAlertRule_Call) Return(alertRule AlertRule, err error) *MockSqsService_SilenceAlertRule_Call {
+	_c.Call.Return(alertRule, err)
 	return _c
 }
 
-func (_c *MockHandler_SendReceive_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockHandler_SendReceive_Call {
-	_c.Run(run)
+func (_c *MockSqsService_SilenceAlertRule_Call) RunAndReturn(run func(ctx context.Context, id string, duration time.Duration, reason string) (AlertRule, error)) *MockSqsService_SilenceAlertRule_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRoute creates a new instance of MockRoute. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRoute(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRoute {
-	mock := &MockRoute{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockRoute is an autogenerated mock type for the Route type
-type MockRoute struct {
-	mock.Mock
-}
-
-type MockRoute_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockRoute) EXPECT() *MockRoute_Expecter {
-	return &MockRoute_Expecter{mock: &_m.Mock}
-}
-
-// InitRoute provides a mock function for the type MockRoute
-func (_mock *MockRoute) InitRoute() (http.Handler, error) {
-	ret := _mock.Called()
+// SimulateConsumer provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SimulateConsumer(ctx context.Context, input SimulateConsumerInput) (Job, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InitRoute")
+		panic("no return value specified for SimulateConsumer")
 	}
 
-	var r0 http.Handler
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (http.Handler, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SimulateConsumerInput) (Job, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func() http.Handler); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SimulateConsumerInput) Job); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(http.Handler)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SimulateConsumerInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRoute_InitRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitRoute'
-type MockRoute_InitRoute_Call struct {
+// MockSqsService_SimulateConsumer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateConsumer'
+type MockSqsService_SimulateConsumer_Call struct {
 	*mock.Call
 }
 
-// InitRoute is a helper method to define mock.On call
-func (_e *MockRoute_Expecter) InitRoute() *MockRoute_InitRoute_Call {
-	return &MockRoute_InitRoute_Call{Call: _e.mock.On("InitRoute")}
+// SimulateConsumer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input SimulateConsumerInput
+func (_e *MockSqsService_Expecter) SimulateConsumer(ctx interface{}, input interface{}) *MockSqsService_SimulateConsumer_Call {
+	return &MockSqsService_SimulateConsumer_Call{Call: _e.mock.On("SimulateConsumer", ctx, input)}
 }
 
-func (_c *MockRoute_InitRoute_Call) Run(run func()) *MockRoute_InitRoute_Call {
+func (_c *MockSqsService_SimulateConsumer_Call) Run(run func(ctx context.Context, input SimulateConsumerInput)) *MockSqsService_SimulateConsumer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 SimulateConsumerInput
+		if args[1] != nil {
+			arg1 = args[1].(SimulateConsumerInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockRoute_InitRoute_Call) Return(handler http.Handler, err error) *MockRoute_InitRoute_Call {
-	_c.Call.Return(handler, err)
+func (_c *MockSqsService_SimulateConsumer_Call) Return(job Job, err error) *MockSqsService_SimulateConsumer_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *MockRoute_InitRoute_Call) RunAndReturn(run func() (http.Handler, error)) *MockRoute_InitRoute_Call {
+func (_c *MockSqsService_SimulateConsumer_Call) RunAndReturn(run func(ctx context.Context, input SimulateConsumerInput) (Job, error)) *MockSqsService_SimulateConsumer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// newMocksqsAPI creates a new instance of mocksqsAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func newMocksqsAPI(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *mocksqsAPI {
-	mock := &mocksqsAPI{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// mocksqsAPI is an autogenerated mock type for the sqsAPI type
-type mocksqsAPI struct {
-	mock.Mock
-}
-
-type mocksqsAPI_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *mocksqsAPI) EXPECT() *mocksqsAPI_Expecter {
-	return &mocksqsAPI_Expecter{mock: &_m.Mock}
-}
-
-// CreateQueue provides a mock function for the type mocksqsAPI
-func (_mock *mocksqsAPI) CreateQueue(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error) {
-	var tmpRet mock.Arguments
-	if len(optFns) > 0 {
-		tmpRet = _mock.Called(ctx, params, optFns)
-	} else {
-		tmpRet = _mock.Called(ctx, params)
-	}
-	ret := tmpRet
+// StartAttributeCount provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) StartAttributeCount(ctx context.Context, queueURL string, attribute string) (Job, error) {
+	ret := _mock.Called(ctx, queueURL, attribute)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateQueue")
+		panic("no return value specified for StartAttributeCount")
 	}
 
-	var r0 *sqs.CreateQueueOutput
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.CreateQueueInput, ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error)); ok {
-		return returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (Job, error)); ok {
+		return returnFunc(ctx, queueURL, attribute)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.CreateQueueInput, ...func(*sqs.Options)) *sqs.CreateQueueOutput); ok {
-		r0 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) Job); ok {
+		r0 = returnFunc(ctx, queueURL, attribute)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sqs.CreateQueueOutput)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.CreateQueueInput, ...func(*sqs.Options)) error); ok {
-		r1 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, queueURL, attribute)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocksqsAPI_CreateQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQueue'
-type mocksqsAPI_CreateQueue_Call struct {
+// MockSqsService_StartAttributeCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartAttributeCount'
+type MockSqsService_StartAttributeCount_Call struct {
 	*mock.Call
 }
 
-// CreateQueue is a helper method to define mock.On call
+// StartAttributeCount is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *sqs.CreateQueueInput
-//   - optFns ...func(*sqs.Options)
-func (_e *mocksqsAPI_Expecter) CreateQueue(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_CreateQueue_Call {
-	return &mocksqsAPI_CreateQueue_Call{Call: _e.mock.On("CreateQueue",
-		append([]interface{}{ctx, params}, optFns...)...)}
+//   - queueURL string
+//   - attribute string
+func (_e *MockSqsService_Expecter) StartAttributeCount(ctx interface{}, queueURL interface{}, attribute interface{}) *MockSqsService_StartAttributeCount_Call {
+	return &MockSqsService_StartAttributeCount_Call{Call: _e.mock.On("StartAttributeCount", ctx, queueURL, attribute)}
 }
 
-func (_c *mocksqsAPI_CreateQueue_Call) Run(run func(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options))) *mocksqsAPI_CreateQueue_Call {
+func (_c *MockSqsService_StartAttributeCount_Call) Run(run func(ctx context.Context, queueURL string, attribute string)) *MockSqsService_StartAttributeCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *sqs.CreateQueueInput
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*sqs.CreateQueueInput)
+			arg1 = args[1].(string)
 		}
-		var arg2 []func(*sqs.Options)
-		var variadicArgs []func(*sqs.Options)
-		if len(args) > 2 {
-			variadicArgs = args[2].([]func(*sqs.Options))
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *mocksqsAPI_CreateQueue_Call) Return(createQueueOutput *sqs.CreateQueueOutput, err error) *mocksqsAPI_CreateQueue_Call {
-	_c.Call.Return(createQueueOutput, err)
+func (_c *MockSqsService_StartAttributeCount_Call) Return(job Job, err error) *MockSqsService_StartAttributeCount_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *mocksqsAPI_CreateQueue_Call) RunAndReturn(run func(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error)) *mocksqsAPI_CreateQueue_Call {
+func (_c *MockSqsService_StartAttributeCount_Call) RunAndReturn(run func(ctx context.Context, queueURL string, attribute string) (Job, error)) *MockSqsService_StartAttributeCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteMessage provides a mock function for the type mocksqsAPI
-func (_mock *mocksqsAPI) DeleteMessage(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error) {
-	var tmpRet mock.Arguments
-	if len(optFns) > 0 {
-		tmpRet = _mock.Called(ctx, params, optFns)
-	} else {
-		tmpRet = _mock.Called(ctx, params)
-	}
-	ret := tmpRet
+// StartBulkQueueOperation provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) StartBulkQueueOperation(ctx context.Context, input BulkQueueInput) (Job, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteMessage")
+		panic("no return value specified for StartBulkQueueOperation")
 	}
 
-	var r0 *sqs.DeleteMessageOutput
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.DeleteMessageInput, ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)); ok {
-		return returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, BulkQueueInput) (Job, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.DeleteMessageInput, ...func(*sqs.Options)) *sqs.DeleteMessageOutput); ok {
-		r0 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, BulkQueueInput) Job); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sqs.DeleteMessageOutput)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.DeleteMessageInput, ...func(*sqs.Options)) error); ok {
-		r1 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, BulkQueueInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocksqsAPI_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
-type mocksqsAPI_DeleteMessage_Call struct {
+// MockSqsService_StartBulkQueueOperation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartBulkQueueOperation'
+type MockSqsService_StartBulkQueueOperation_Call struct {
 	*mock.Call
 }
 
-// DeleteMessage is a helper method to define mock.On call
+// StartBulkQueueOperation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *sqs.DeleteMessageInput
-//   - optFns ...func(*sqs.Options)
-func (_e *mocksqsAPI_Expecter) DeleteMessage(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_DeleteMessage_Call {
-	return &mocksqsAPI_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage",
-		append([]interface{}{ctx, params}, optFns...)...)}
+//   - input BulkQueueInput
+func (_e *MockSqsService_Expecter) StartBulkQueueOperation(ctx interface{}, input interface{}) *MockSqsService_StartBulkQueueOperation_Call {
+	return &MockSqsService_StartBulkQueueOperation_Call{Call: _e.mock.On("StartBulkQueueOperation", ctx, input)}
 }
 
-func (_c *mocksqsAPI_DeleteMessage_Call) Run(run func(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options))) *mocksqsAPI_DeleteMessage_Call {
+func (_c *MockSqsService_StartBulkQueueOperation_Call) Run(run func(ctx context.Context, input BulkQueueInput)) *MockSqsService_StartBulkQueueOperation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *sqs.DeleteMessageInput
+		var arg1 BulkQueueInput
 		if args[1] != nil {
-			arg1 = args[1].(*sqs.DeleteMessageInput)
-		}
-		var arg2 []func(*sqs.Options)
-		var variadicArgs []func(*sqs.Options)
-		if len(args) > 2 {
-			variadicArgs = args[2].([]func(*sqs.Options))
+			arg1 = args[1].(BulkQueueInput)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *mocksqsAPI_DeleteMessage_Call) Return(deleteMessageOutput *sqs.DeleteMessageOutput, err error) *mocksqsAPI_DeleteMessage_Call {
-	_c.Call.Return(deleteMessageOutput, err)
+func (_c *MockSqsService_StartBulkQueueOperation_Call) Return(job Job, err error) *MockSqsService_StartBulkQueueOperation_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *mocksqsAPI_DeleteMessage_Call) RunAndReturn(run func(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)) *mocksqsAPI_DeleteMessage_Call {
+func (_c *MockSqsService_StartBulkQueueOperation_Call) RunAndReturn(run func(ctx context.Context, input BulkQueueInput) (Job, error)) *MockSqsService_StartBulkQueueOperation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteQueue provides a mock function for the type mocksqsAPI
-func (_mock *mocksqsAPI) DeleteQueue(ctx context.Context, params *sqs.DeleteQueueInput, optFns ...func(*sqs.Options)) (*sqs.DeleteQueueOutput, error) {
-	var tmpRet mock.Arguments
-	if len(optFns) > 0 {
-		tmpRet = _mock.Called(ctx, params, optFns)
-	} else {
-		tmpRet = _mock.Called(ctx, params)
-	}
-	ret := tmpRet
+// StartDrainToFile provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) StartDrainToFile(ctx context.Context, queueURL string) (Job, error) {
+	ret := _mock.Called(ctx, queueURL)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteQueue")
+		panic("no return value specified for StartDrainToFile")
 	}
 
-	var r0 *sqs.DeleteQueueOutput
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.DeleteQueueInput, ...func(*sqs.Options)) (*sqs.DeleteQueueOutput, error)); ok {
-		return returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (Job, error)); ok {
+		return returnFunc(ctx, queueURL)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.DeleteQueueInput, ...func(*sqs.Options)) *sqs.DeleteQueueOutput); ok {
-		r0 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) Job); ok {
+		r0 = returnFunc(ctx, queueURL)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sqs.DeleteQueueOutput)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.DeleteQueueInput, ...func(*sqs.Options)) error); ok {
-		r1 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocksqsAPI_DeleteQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueue'
-type mocksqsAPI_DeleteQueue_Call struct {
+// MockSqsService_StartDrainToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartDrainToFile'
+type MockSqsService_StartDrainToFile_Call struct {
 	*mock.Call
 }
 
-// DeleteQueue is a helper method to define mock.On call
+// StartDrainToFile is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *sqs.DeleteQueueInput
-//   - optFns ...func(*sqs.Options)
-func (_e *mocksqsAPI_Expecter) DeleteQueue(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_DeleteQueue_Call {
-	return &mocksqsAPI_DeleteQueue_Call{Call: _e.mock.On("DeleteQueue",
-		append([]interface{}{ctx, params}, optFns...)...)}
+//   - queueURL string
+func (_e *MockSqsService_Expecter) StartDrainToFile(ctx interface{}, queueURL interface{}) *MockSqsService_StartDrainToFile_Call {
+	return &MockSqsService_StartDrainToFile_Call{Call: _e.mock.On("StartDrainToFile", ctx, queueURL)}
 }
 
-func (_c *mocksqsAPI_DeleteQueue_Call) Run(run func(ctx context.Context, params *sqs.DeleteQueueInput, optFns ...func(*sqs.Options))) *mocksqsAPI_DeleteQueue_Call {
+func (_c *MockSqsService_StartDrainToFile_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_StartDrainToFile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *sqs.DeleteQueueInput
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*sqs.DeleteQueueInput)
-		}
-		var arg2 []func(*sqs.Options)
-		var variadicArgs []func(*sqs.Options)
-		if len(args) > 2 {
-			variadicArgs = args[2].([]func(*sqs.Options))
+			arg1 = args[1].(string)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *mocksqsAPI_DeleteQueue_Call) Return(deleteQueueOutput *sqs.DeleteQueueOutput, err error) *mocksqsAPI_DeleteQueue_Call {
-	_c.Call.Return(deleteQueueOutput, err)
+func (_c *MockSqsService_StartDrainToFile_Call) Return(job Job, err error) *MockSqsService_StartDrainToFile_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *mocksqsAPI_DeleteQueue_Call) RunAndReturn(run func(ctx context.Context, params *sqs.DeleteQueueInput, optFns ...func(*sqs.Options)) (*sqs.DeleteQueueOutput, error)) *mocksqsAPI_DeleteQueue_Call {
+func (_c *MockSqsService_StartDrainToFile_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (Job, error)) *MockSqsService_StartDrainToFile_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetQueueAttributes provides a mock function for the type mocksqsAPI
-func (_mock *mocksqsAPI) GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
-	var tmpRet mock.Arguments
-	if len(optFns) > 0 {
-		tmpRet = _mock.Called(ctx, params, optFns)
-	} else {
-		tmpRet = _mock.Called(ctx, params)
-	}
-	ret := tmpRet
+// StartFilteredPurge provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) StartFilteredPurge(ctx context.Context, input FilteredPurgeInput) (Job, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetQueueAttributes")
+		panic("no return value specified for StartFilteredPurge")
 	}
 
-	var r0 *sqs.GetQueueAttributesOutput
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)); ok {
-		return returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, FilteredPurgeInput) (Job, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) *sqs.GetQueueAttributesOutput); ok {
-		r0 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, FilteredPurgeInput) Job); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sqs.GetQueueAttributesOutput)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) error); ok {
-		r1 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, FilteredPurgeInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocksqsAPI_GetQueueAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueAttributes'
-type mocksqsAPI_GetQueueAttributes_Call struct {
+// MockSqsService_StartFilteredPurge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartFilteredPurge'
+type MockSqsService_StartFilteredPurge_Call struct {
 	*mock.Call
 }
 
-// GetQueueAttributes is a helper method to define mock.On call
+// StartFilteredPurge is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *sqs.GetQueueAttributesInput
-//   - optFns ...func(*sqs.Options)
-func (_e *mocksqsAPI_Expecter) GetQueueAttributes(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_GetQueueAttributes_Call {
-	return &mocksqsAPI_GetQueueAttributes_Call{Call: _e.mock.On("GetQueueAttributes",
-		append([]interface{}{ctx, params}, optFns...)...)}
+//   - input FilteredPurgeInput
+func (_e *MockSqsService_Expecter) StartFilteredPurge(ctx interface{}, input interface{}) *MockSqsService_StartFilteredPurge_Call {
+	return &MockSqsService_StartFilteredPurge_Call{Call: _e.mock.On("StartFilteredPurge", ctx, input)}
 }
 
-func (_c *mocksqsAPI_GetQueueAttributes_Call) Run(run func(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options))) *mocksqsAPI_GetQueueAttributes_Call {
+func (_c *MockSqsService_StartFilteredPurge_Call) Run(run func(ctx context.Context, input FilteredPurgeInput)) *MockSqsService_StartFilteredPurge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *sqs.GetQueueAttributesInput
+		var arg1 FilteredPurgeInput
 		if args[1] != nil {
-			arg1 = args[1].(*sqs.GetQueueAttributesInput)
+			arg1 = args[1].(FilteredPurgeInput)
 		}
-		var arg2 []func(*sqs.Options)
-		var variadicArgs []func(*sqs.Options)
-		if len(args) > 2 {
-			variadicArgs = args[2].([]func(*sqs.Options))
-		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *mocksqsAPI_GetQueueAttributes_Call) Return(getQueueAttributesOutput *sqs.GetQueueAttributesOutput, err error) *mocksqsAPI_GetQueueAttributes_Call {
-	_c.Call.Return(getQueueAttributesOutput, err)
-	return _c
-}
-
-func (_c *mocksqsAPI_GetQueueAttributes_Call) RunAndReturn(run func(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)) *mocksqsAPI_GetQueueAttributes_Call {
-	_c.Call.Return(run)
+func (_c *MockSqsService_StartFilteredPurge_Call) Return(job Job, err error) *MockSqsService_StartFilteredPurge_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-// ListQueueTags provides a mock function for the type mocksqsAPI
-func (_mock *mocksqsAPI) ListQueueTags(ctx context.Context, params *sqs.ListQueueTagsInput, optFns ...func(*sqs.Options)) (*sqs.ListQueueTagsOutput, error) {
-	var tmpRet mock.Arguments
-	if len(optFns) > 0 {
-		tmpRet = _mock.Called(ctx, params, optFns)
-	} else {
-		tmpRet = _mock.Called(ctx, params)
-	}
-	ret := tmpRet
-
+func (_c *MockSqsService_StartFilteredPurge_Call) RunAndReturn(run func(ctx context.Context, input FilteredPurgeInput) (Job, error)) *MockSqsService_StartFilteredPurge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartForwarder provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) StartForwarder(ctx context.Context, input ForwardMessagesInput) (Job, error) {
+	ret := _mock.Called(ctx, input)
+
 	if len(ret) == 0 {
-		panic("no return value specified for ListQueueTags")
+		panic("no return value specified for StartForwarder")
 	}
 
-	var r0 *sqs.ListQueueTagsOutput
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListQueueTagsInput, ...func(*sqs.Options)) (*sqs.ListQueueTagsOutput, error)); ok {
-		return returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ForwardMessagesInput) (Job, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListQueueTagsInput, ...func(*sqs.Options)) *sqs.ListQueueTagsOutput); ok {
-		r0 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ForwardMessagesInput) Job); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sqs.ListQueueTagsOutput)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.ListQueueTagsInput, ...func(*sqs.Options)) error); ok {
-		r1 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ForwardMessagesInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocksqsAPI_ListQueueTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueueTags'
-type mocksqsAPI_ListQueueTags_Call struct {
+// MockSqsService_StartForwarder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartForwarder'
+type MockSqsService_StartForwarder_Call struct {
 	*mock.Call
 }
 
-// ListQueueTags is a helper method to define mock.On call
+// StartForwarder is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *sqs.ListQueueTagsInput
-//   - optFns ...func(*sqs.Options)
-func (_e *mocksqsAPI_Expecter) ListQueueTags(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_ListQueueTags_Call {
-	return &mocksqsAPI_ListQueueTags_Call{Call: _e.mock.On("ListQueueTags",
-		append([]interface{}{ctx, params}, optFns...)...)}
+//   - input ForwardMessagesInput
+func (_e *MockSqsService_Expecter) StartForwarder(ctx interface{}, input interface{}) *MockSqsService_StartForwarder_Call {
+	return &MockSqsService_StartForwarder_Call{Call: _e.mock.On("StartForwarder", ctx, input)}
 }
 
-func (_c *mocksqsAPI_ListQueueTags_Call) Run(run func(ctx context.Context, params *sqs.ListQueueTagsInput, optFns ...func(*sqs.Options))) *mocksqsAPI_ListQueueTags_Call {
+func (_c *MockSqsService_StartForwarder_Call) Run(run func(ctx context.Context, input ForwardMessagesInput)) *MockSqsService_StartForwarder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *sqs.ListQueueTagsInput
+		var arg1 ForwardMessagesInput
 		if args[1] != nil {
-			arg1 = args[1].(*sqs.ListQueueTagsInput)
-		}
-		var arg2 []func(*sqs.Options)
-		var variadicArgs []func(*sqs.Options)
-		if len(args) > 2 {
-			variadicArgs = args[2].([]func(*sqs.Options))
+			arg1 = args[1].(ForwardMessagesInput)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *mocksqsAPI_ListQueueTags_Call) Return(listQueueTagsOutput *sqs.ListQueueTagsOutput, err error) *mocksqsAPI_ListQueueTags_Call {
-	_c.Call.Return(listQueueTagsOutput, err)
+func (_c *MockSqsService_StartForwarder_Call) Return(job Job, err error) *MockSqsService_StartForwarder_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *mocksqsAPI_ListQueueTags_Call) RunAndReturn(run func(ctx context.Context, params *sqs.ListQueueTagsInput, optFns ...func(*sqs.Options)) (*sqs.ListQueueTagsOutput, error)) *mocksqsAPI_ListQueueTags_Call {
+func (_c *MockSqsService_StartForwarder_Call) RunAndReturn(run func(ctx context.Context, input ForwardMessagesInput) (Job, error)) *MockSqsService_StartForwarder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListQueues provides a mock function for the type mocksqsAPI
-func (_mock *mocksqsAPI) ListQueues(ctx context.Context, params *sqs.ListQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error) {
-	var tmpRet mock.Arguments
-	if len(optFns) > 0 {
-		tmpRet = _mock.Called(ctx, params, optFns)
-	} else {
-		tmpRet = _mock.Called(ctx, params)
-	}
-	ret := tmpRet
+// StartPurge provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) StartPurge(ctx context.Context, queueURL string) (Job, error) {
+	ret := _mock.Called(ctx, queueURL)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListQueues")
+		panic("no return value specified for StartPurge")
 	}
 
-	var r0 *sqs.ListQueuesOutput
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListQueuesInput, ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error)); ok {
-		return returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (Job, error)); ok {
+		return returnFunc(ctx, queueURL)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ListQueuesInput, ...func(*sqs.Options)) *sqs.ListQueuesOutput); ok {
-		r0 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) Job); ok {
+		r0 = returnFunc(ctx, queueURL)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sqs.ListQueuesOutput)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.ListQueuesInput, ...func(*sqs.Options)) error); ok {
-		r1 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocksqsAPI_ListQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueues'
-type mocksqsAPI_ListQueues_Call struct {
+// MockSqsService_StartPurge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartPurge'
+type MockSqsService_StartPurge_Call struct {
 	*mock.Call
 }
 
-// ListQueues is a helper method to define mock.On call
+// StartPurge is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *sqs.ListQueuesInput
-//   - optFns ...func(*sqs.Options)
-func (_e *mocksqsAPI_Expecter) ListQueues(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_ListQueues_Call {
-	return &mocksqsAPI_ListQueues_Call{Call: _e.mock.On("ListQueues",
-		append([]interface{}{ctx, params}, optFns...)...)}
+//   - queueURL string
+func (_e *MockSqsService_Expecter) StartPurge(ctx interface{}, queueURL interface{}) *MockSqsService_StartPurge_Call {
+	return &MockSqsService_StartPurge_Call{Call: _e.mock.On("StartPurge", ctx, queueURL)}
 }
 
-func (_c *mocksqsAPI_ListQueues_Call) Run(run func(ctx context.Context, params *sqs.ListQueuesInput, optFns ...func(*sqs.Options))) *mocksqsAPI_ListQueues_Call {
+func (_c *MockSqsService_StartPurge_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_StartPurge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *sqs.ListQueuesInput
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*sqs.ListQueuesInput)
-		}
-		var arg2 []func(*sqs.Options)
-		var variadicArgs []func(*sqs.Options)
-		if len(args) > 2 {
-			variadicArgs = args[2].([]func(*sqs.Options))
+			arg1 = args[1].(string)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *mocksqsAPI_ListQueues_Call) Return(listQueuesOutput *sqs.ListQueuesOutput, err error) *mocksqsAPI_ListQueues_Call {
-	_c.Call.Return(listQueuesOutput, err)
+func (_c *MockSqsService_StartPurge_Call) Return(job Job, err error) *MockSqsService_StartPurge_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *mocksqsAPI_ListQueues_Call) RunAndReturn(run func(ctx context.Context, params *sqs.ListQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error)) *mocksqsAPI_ListQueues_Call {
+func (_c *MockSqsService_StartPurge_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (Job, error)) *MockSqsService_StartPurge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PurgeQueue provides a mock function for the type mocksqsAPI
-func (_mock *mocksqsAPI) PurgeQueue(ctx context.Context, params *sqs.PurgeQueueInput, optFns ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error) {
-	var tmpRet mock.Arguments
-	if len(optFns) > 0 {
-		tmpRet = _mock.Called(ctx, params, optFns)
-	} else {
-		tmpRet = _mock.Called(ctx, params)
-	}
-	ret := tmpRet
+// StartPurgeWithBackup provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) StartPurgeWithBackup(ctx context.Context, queueURL string) (Job, error) {
+	ret := _mock.Called(ctx, queueURL)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PurgeQueue")
+		panic("no return value specified for StartPurgeWithBackup")
 	}
 
-	var r0 *sqs.PurgeQueueOutput
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.PurgeQueueInput, ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error)); ok {
-		return returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (Job, error)); ok {
+		return returnFunc(ctx, queueURL)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.PurgeQueueInput, ...func(*sqs.Options)) *sqs.PurgeQueueOutput); ok {
-		r0 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) Job); ok {
+		r0 = returnFunc(ctx, queueURL)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sqs.PurgeQueueOutput)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.PurgeQueueInput, ...func(*sqs.Options)) error); ok {
-		r1 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, queueURL)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocksqsAPI_PurgeQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeQueue'
-type mocksqsAPI_PurgeQueue_Call struct {
+// MockSqsService_StartPurgeWithBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartPurgeWithBackup'
+type MockSqsService_StartPurgeWithBackup_Call struct {
 	*mock.Call
 }
 
-// PurgeQueue is a helper method to define mock.On call
+// StartPurgeWithBackup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *sqs.PurgeQueueInput
-//   - optFns ...func(*sqs.Options)
-func (_e *mocksqsAPI_Expecter) PurgeQueue(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_PurgeQueue_Call {
-	return &mocksqsAPI_PurgeQueue_Call{Call: _e.mock.On("PurgeQueue",
-		append([]interface{}{ctx, params}, optFns...)...)}
+//   - queueURL string
+func (_e *MockSqsService_Expecter) StartPurgeWithBackup(ctx interface{}, queueURL interface{}) *MockSqsService_StartPurgeWithBackup_Call {
+	return &MockSqsService_StartPurgeWithBackup_Call{Call: _e.mock.On("StartPurgeWithBackup", ctx, queueURL)}
 }
 
-func (_c *mocksqsAPI_PurgeQueue_Call) Run(run func(ctx context.Context, params *sqs.PurgeQueueInput, optFns ...func(*sqs.Options))) *mocksqsAPI_PurgeQueue_Call {
+func (_c *MockSqsService_StartPurgeWithBackup_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_StartPurgeWithBackup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *sqs.PurgeQueueInput
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*sqs.PurgeQueueInput)
-		}
-		var arg2 []func(*sqs.Options)
-		var variadicArgs []func(*sqs.Options)
-		if len(args) > 2 {
-			variadicArgs = args[2].([]func(*sqs.Options))
+			arg1 = args[1].(string)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *mocksqsAPI_PurgeQueue_Call) Return(purgeQueueOutput *sqs.PurgeQueueOutput, err error) *mocksqsAPI_PurgeQueue_Call {
-	_c.Call.Return(purgeQueueOutput, err)
+func (_c *MockSqsService_StartPurgeWithBackup_Call) Return(job Job, err error) *MockSqsService_StartPurgeWithBackup_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *mocksqsAPI_PurgeQueue_Call) RunAndReturn(run func(ctx context.Context, params *sqs.PurgeQueueInput, optFns ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error)) *mocksqsAPI_PurgeQueue_Call {
+func (_c *MockSqsService_StartPurgeWithBackup_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (Job, error)) *MockSqsService_StartPurgeWithBackup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReceiveMessage provides a mock function for the type mocksqsAPI
-func (_mock *mocksqsAPI) ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
-	var tmpRet mock.Arguments
-	if len(optFns) > 0 {
-		tmpRet = _mock.Called(ctx, params, optFns)
-	} else {
-		tmpRet = _mock.Called(ctx, params)
-	}
-	ret := tmpRet
+// StartQueueImport provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) StartQueueImport(ctx context.Context, queues []RawCreateQueueInput) (Job, error) {
+	ret := _mock.Called(ctx, queues)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReceiveMessage")
+		panic("no return value specified for StartQueueImport")
 	}
 
-	var r0 *sqs.ReceiveMessageOutput
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ReceiveMessageInput, ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)); ok {
-		return returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []RawCreateQueueInput) (Job, error)); ok {
+		return returnFunc(ctx, queues)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.ReceiveMessageInput, ...func(*sqs.Options)) *sqs.ReceiveMessageOutput); ok {
-		r0 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []RawCreateQueueInput) Job); ok {
+		r0 = returnFunc(ctx, queues)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sqs.ReceiveMessageOutput)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.ReceiveMessageInput, ...func(*sqs.Options)) error); ok {
-		r1 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []RawCreateQueueInput) error); ok {
+		r1 = returnFunc(ctx, queues)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocksqsAPI_ReceiveMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMessage'
-type mocksqsAPI_ReceiveMessage_Call struct {
+// MockSqsService_StartQueueImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartQueueImport'
+type MockSqsService_StartQueueImport_Call struct {
 	*mock.Call
 }
 
-// ReceiveMessage is a helper method to define mock.On call
+// StartQueueImport is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *sqs.ReceiveMessageInput
-//   - optFns ...func(*sqs.Options)
-func (_e *mocksqsAPI_Expecter) ReceiveMessage(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_ReceiveMessage_Call {
-	return &mocksqsAPI_ReceiveMessage_Call{Call: _e.mock.On("ReceiveMessage",
-		append([]interface{}{ctx, params}, optFns...)...)}
+//   - queues []RawCreateQueueInput
+func (_e *MockSqsService_Expecter) StartQueueImport(ctx interface{}, queues interface{}) *MockSqsService_StartQueueImport_Call {
+	return &MockSqsService_StartQueueImport_Call{Call: _e.mock.On("StartQueueImport", ctx, queues)}
 }
 
-func (_c *mocksqsAPI_ReceiveMessage_Call) Run(run func(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options))) *mocksqsAPI_ReceiveMessage_Call {
+func (_c *MockSqsService_StartQueueImport_Call) Run(run func(ctx context.Context, queues []RawCreateQueueInput)) *MockSqsService_StartQueueImport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *sqs.ReceiveMessageInput
+		var arg1 []RawCreateQueueInput
 		if args[1] != nil {
-			arg1 = args[1].(*sqs.ReceiveMessageInput)
-		}
-		var arg2 []func(*sqs.Options)
-		var variadicArgs []func(*sqs.Options)
-		if len(args) > 2 {
-			variadicArgs = args[2].([]func(*sqs.Options))
+			arg1 = args[1].([]RawCreateQueueInput)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *mocksqsAPI_ReceiveMessage_Call) Return(receiveMessageOutput *sqs.ReceiveMessageOutput, err error) *mocksqsAPI_ReceiveMessage_Call {
-	_c.Call.Return(receiveMessageOutput, err)
+func (_c *MockSqsService_StartQueueImport_Call) Return(job Job, err error) *MockSqsService_StartQueueImport_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *mocksqsAPI_ReceiveMessage_Call) RunAndReturn(run func(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)) *mocksqsAPI_ReceiveMessage_Call {
+func (_c *MockSqsService_StartQueueImport_Call) RunAndReturn(run func(ctx context.Context, queues []RawCreateQueueInput) (Job, error)) *MockSqsService_StartQueueImport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendMessage provides a mock function for the type mocksqsAPI
-func (_mock *mocksqsAPI) SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
-	var tmpRet mock.Arguments
-	if len(optFns) > 0 {
-		tmpRet = _mock.Called(ctx, params, optFns)
-	} else {
-		tmpRet = _mock.Called(ctx, params)
-	}
-	ret := tmpRet
+// StartRestoreFromFile provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) StartRestoreFromFile(ctx context.Context, input RestoreFileInput) (Job, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendMessage")
+		panic("no return value specified for StartRestoreFromFile")
 	}
 
-	var r0 *sqs.SendMessageOutput
+	var r0 Job
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.SendMessageInput, ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)); ok {
-		return returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RestoreFileInput) (Job, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *sqs.SendMessageInput, ...func(*sqs.Options)) *sqs.SendMessageOutput); ok {
-		r0 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RestoreFileInput) Job); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*sqs.SendMessageOutput)
-		}
+		r0 = ret.Get(0).(Job)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *sqs.SendMessageInput, ...func(*sqs.Options)) error); ok {
-		r1 = returnFunc(ctx, params, optFns...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, RestoreFileInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocksqsAPI_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
-type mocksqsAPI_SendMessage_Call struct {
+// MockSqsService_StartRestoreFromFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartRestoreFromFile'
+type MockSqsService_StartRestoreFromFile_Call struct {
 	*mock.Call
 }
 
-// SendMessage is a helper method to define mock.On call
+// StartRestoreFromFile is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *sqs.SendMessageInput
-//   - optFns ...func(*sqs.Options)
-func (_e *mocksqsAPI_Expecter) SendMessage(ctx interface{}, params interface{}, optFns ...interface{}) *mocksqsAPI_SendMessage_Call {
-	return &mocksqsAPI_SendMessage_Call{Call: _e.mock.On("SendMessage",
-		append([]interface{}{ctx, params}, optFns...)...)}
+//   - input RestoreFileInput
+func (_e *MockSqsService_Expecter) StartRestoreFromFile(ctx interface{}, input interface{}) *MockSqsService_StartRestoreFromFile_Call {
+	return &MockSqsService_StartRestoreFromFile_Call{Call: _e.mock.On("StartRestoreFromFile", ctx, input)}
 }
 
-func (_c *mocksqsAPI_SendMessage_Call) Run(run func(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options))) *mocksqsAPI_SendMessage_Call {
+func (_c *MockSqsService_StartRestoreFromFile_Call) Run(run func(ctx context.Context, input RestoreFileInput)) *MockSqsService_StartRestoreFromFile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *sqs.SendMessageInput
+		var arg1 RestoreFileInput
 		if args[1] != nil {
-			arg1 = args[1].(*sqs.SendMessageInput)
-		}
-		var arg2 []func(*sqs.Options)
-		var variadicArgs []func(*sqs.Options)
-		if len(args) > 2 {
-			variadicArgs = args[2].([]func(*sqs.Options))
+			arg1 = args[1].(RestoreFileInput)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *mocksqsAPI_SendMessage_Call) Return(sendMessageOutput *sqs.SendMessageOutput, err error) *mocksqsAPI_SendMessage_Call {
-	_c.Call.Return(sendMessageOutput, err)
+func (_c *MockSqsService_StartRestoreFromFile_Call) Return(job Job, err error) *MockSqsService_StartRestoreFromFile_Call {
+	_c.Call.Return(job, err)
 	return _c
 }
 
-func (_c *mocksqsAPI_SendMessage_Call) RunAndReturn(run func(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)) *mocksqsAPI_SendMessage_Call {
+func (_c *MockSqsService_StartRestoreFromFile_Call) RunAndReturn(run func(ctx context.Context, input RestoreFileInput) (Job, error)) *MockSqsService_StartRestoreFromFile_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSqsRepository creates a new instance of MockSqsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockSqsRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockSqsRepository {
-	mock := &MockSqsRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockSqsRepository is an autogenerated mock type for the SqsRepository type
-type MockSqsRepository struct {
-	mock.Mock
-}
-
-type MockSqsRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockSqsRepository) EXPECT() *MockSqsRepository_Expecter {
-	return &MockSqsRepository_Expecter{mock: &_m.Mock}
-}
-
-// CreateQueue provides a mock function for the type MockSqsRepository
-func (_mock *MockSqsRepository) CreateQueue(ctx context.Context, input CreateQueueRepositoryInput) (string, error) {
-	ret := _mock.Called(ctx, input)
-
+// SubscribePush provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SubscribePush(ctx context.Context, subscription PushSubscription) error {
+	ret := _mock.Called(ctx, subscription)
+
 	if len(ret) == 0 {
-		panic("no return value specified for CreateQueue")
+		panic("no return value specified for SubscribePush")
 	}
 
-	var r0 string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateQueueRepositoryInput) (string, error)); ok {
-		return returnFunc(ctx, input)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateQueueRepositoryInput) string); ok {
-		r0 = returnFunc(ctx, input)
-	} else {
-		r0 = ret.Get(0).(string)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateQueueRepositoryInput) error); ok {
-		r1 = returnFunc(ctx, input)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PushSubscription) error); ok {
+		r0 = returnFunc(ctx, subscription)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSqsRepository_CreateQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQueue'
-type MockSqsRepository_CreateQueue_Call struct {
+// MockSqsService_SubscribePush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribePush'
+type MockSqsService_SubscribePush_Call struct {
 	*mock.Call
 }
 
-// CreateQueue is a helper method to define mock.On call
+// SubscribePush is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input CreateQueueRepositoryInput
-func (_e *MockSqsRepository_Expecter) CreateQueue(ctx interface{}, input interface{}) *MockSqsRepository_CreateQueue_Call {
-	return &MockSqsRepository_CreateQueue_Call{Call: _e.mock.On("CreateQueue", ctx, input)}
+//   - subscription PushSubscription
+func (_e *MockSqsService_Expecter) SubscribePush(ctx interface{}, subscription interface{}) *MockSqsService_SubscribePush_Call {
+	return &MockSqsService_SubscribePush_Call{Call: _e.mock.On("SubscribePush", ctx, subscription)}
 }
 
-func (_c *MockSqsRepository_CreateQueue_Call) Run(run func(ctx context.Context, input CreateQueueRepositoryInput)) *MockSqsRepository_CreateQueue_Call {
+func (_c *MockSqsService_SubscribePush_Call) Run(run func(ctx context.Context, subscription PushSubscription)) *MockSqsService_SubscribePush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 CreateQueueRepositoryInput
+		var arg1 PushSubscription
 		if args[1] != nil {
-			arg1 = args[1].(CreateQueueRepositoryInput)
+			arg1 = args[1].(PushSubscription)
 		}
 		run(
 			arg0,
@@ -1438,103 +17840,107 @@ func (_c *MockSqsRepository_CreateQueue_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockSqsRepository_CreateQueue_Call) Return(s string, err error) *MockSqsRepository_CreateQueue_Call {
-	_c.Call.Return(s, err)
+func (_c *MockSqsService_SubscribePush_Call) Return(err error) *MockSqsService_SubscribePush_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSqsRepository_CreateQueue_Call) RunAndReturn(run func(ctx context.Context, input CreateQueueRepositoryInput) (string, error)) *MockSqsRepository_CreateQueue_Call {
+func (_c *MockSqsService_SubscribePush_Call) RunAndReturn(run func(ctx context.Context, subscription PushSubscription) error) *MockSqsService_SubscribePush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteMessage provides a mock function for the type MockSqsRepository
-func (_mock *MockSqsRepository) DeleteMessage(ctx context.Context, input DeleteMessageRepositoryInput) error {
-	ret := _mock.Called(ctx, input)
+// SweepTemporaryQueues provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) SweepTemporaryQueues(ctx context.Context) (CleanupReport, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteMessage")
+		panic("no return value specified for SweepTemporaryQueues")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, DeleteMessageRepositoryInput) error); ok {
-		r0 = returnFunc(ctx, input)
+	var r0 CleanupReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (CleanupReport, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) CleanupReport); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(CleanupReport)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSqsRepository_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
-type MockSqsRepository_DeleteMessage_Call struct {
+// MockSqsService_SweepTemporaryQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SweepTemporaryQueues'
+type MockSqsService_SweepTemporaryQueues_Call struct {
 	*mock.Call
 }
 
-// DeleteMessage is a helper method to define mock.On call
+// SweepTemporaryQueues is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input DeleteMessageRepositoryInput
-func (_e *MockSqsRepository_Expecter) DeleteMessage(ctx interface{}, input interface{}) *MockSqsRepository_DeleteMessage_Call {
-	return &MockSqsRepository_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage", ctx, input)}
+func (_e *MockSqsService_Expecter) SweepTemporaryQueues(ctx interface{}) *MockSqsService_SweepTemporaryQueues_Call {
+	return &MockSqsService_SweepTemporaryQueues_Call{Call: _e.mock.On("SweepTemporaryQueues", ctx)}
 }
 
-func (_c *MockSqsRepository_DeleteMessage_Call) Run(run func(ctx context.Context, input DeleteMessageRepositoryInput)) *MockSqsRepository_DeleteMessage_Call {
+func (_c *MockSqsService_SweepTemporaryQueues_Call) Run(run func(ctx context.Context)) *MockSqsService_SweepTemporaryQueues_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 DeleteMessageRepositoryInput
-		if args[1] != nil {
-			arg1 = args[1].(DeleteMessageRepositoryInput)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSqsRepository_DeleteMessage_Call) Return(err error) *MockSqsRepository_DeleteMessage_Call {
-	_c.Call.Return(err)
+func (_c *MockSqsService_SweepTemporaryQueues_Call) Return(cleanupReport CleanupReport, err error) *MockSqsService_SweepTemporaryQueues_Call {
+	_c.Call.Return(cleanupReport, err)
 	return _c
 }
 
-func (_c *MockSqsRepository_DeleteMessage_Call) RunAndReturn(run func(ctx context.Context, input DeleteMessageRepositoryInput) error) *MockSqsRepository_DeleteMessage_Call {
+func (_c *MockSqsService_SweepTemporaryQueues_Call) RunAndReturn(run func(ctx context.Context) (CleanupReport, error)) *MockSqsService_SweepTemporaryQueues_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteQueue provides a mock function for the type MockSqsRepository
-func (_mock *MockSqsRepository) DeleteQueue(ctx context.Context, queueURL string) error {
-	ret := _mock.Called(ctx, queueURL)
+// TagQueue provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) TagQueue(ctx context.Context, queueURL string, tags map[string]string) error {
+	ret := _mock.Called(ctx, queueURL, tags)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteQueue")
+		panic("no return value specified for TagQueue")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, queueURL)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]string) error); ok {
+		r0 = returnFunc(ctx, queueURL, tags)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSqsRepository_DeleteQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueue'
-type MockSqsRepository_DeleteQueue_Call struct {
+// MockSqsService_TagQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagQueue'
+type MockSqsService_TagQueue_Call struct {
 	*mock.Call
 }
 
-// DeleteQueue is a helper method to define mock.On call
+// TagQueue is a helper method to define mock.On call
 //   - ctx context.Context
 //   - queueURL string
-func (_e *MockSqsRepository_Expecter) DeleteQueue(ctx interface{}, queueURL interface{}) *MockSqsRepository_DeleteQueue_Call {
-	return &MockSqsRepository_DeleteQueue_Call{Call: _e.mock.On("DeleteQueue", ctx, queueURL)}
+//   - tags map[string]string
+func (_e *MockSqsService_Expecter) TagQueue(ctx interface{}, queueURL interface{}, tags interface{}) *MockSqsService_TagQueue_Call {
+	return &MockSqsService_TagQueue_Call{Call: _e.mock.On("TagQueue", ctx, queueURL, tags)}
 }
 
-func (_c *MockSqsRepository_DeleteQueue_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsRepository_DeleteQueue_Call {
+func (_c *MockSqsService_TagQueue_Call) Run(run func(ctx context.Context, queueURL string, tags map[string]string)) *MockSqsService_TagQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1544,63 +17950,69 @@ func (_c *MockSqsRepository_DeleteQueue_Call) Run(run func(ctx context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSqsRepository_DeleteQueue_Call) Return(err error) *MockSqsRepository_DeleteQueue_Call {
+func (_c *MockSqsService_TagQueue_Call) Return(err error) *MockSqsService_TagQueue_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSqsRepository_DeleteQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsRepository_DeleteQueue_Call {
+func (_c *MockSqsService_TagQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string, tags map[string]string) error) *MockSqsService_TagQueue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetQueueDetail provides a mock function for the type MockSqsRepository
-func (_mock *MockSqsRepository) GetQueueDetail(ctx context.Context, queueURL string) (QueueDetail, error) {
-	ret := _mock.Called(ctx, queueURL)
+// TestEventPattern provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) TestEventPattern(ctx context.Context, pattern string, event string) (bool, error) {
+	ret := _mock.Called(ctx, pattern, event)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetQueueDetail")
+		panic("no return value specified for TestEventPattern")
 	}
 
-	var r0 QueueDetail
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QueueDetail, error)); ok {
-		return returnFunc(ctx, queueURL)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, pattern, event)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QueueDetail); ok {
-		r0 = returnFunc(ctx, queueURL)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, pattern, event)
 	} else {
-		r0 = ret.Get(0).(QueueDetail)
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, queueURL)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, pattern, event)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSqsRepository_GetQueueDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueDetail'
-type MockSqsRepository_GetQueueDetail_Call struct {
+// MockSqsService_TestEventPattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TestEventPattern'
+type MockSqsService_TestEventPattern_Call struct {
 	*mock.Call
 }
 
-// GetQueueDetail is a helper method to define mock.On call
+// TestEventPattern is a helper method to define mock.On call
 //   - ctx context.Context
-//   - queueURL string
-func (_e *MockSqsRepository_Expecter) GetQueueDetail(ctx interface{}, queueURL interface{}) *MockSqsRepository_GetQueueDetail_Call {
-	return &MockSqsRepository_GetQueueDetail_Call{Call: _e.mock.On("GetQueueDetail", ctx, queueURL)}
+//   - pattern string
+//   - event string
+func (_e *MockSqsService_Expecter) TestEventPattern(ctx interface{}, pattern interface{}, event interface{}) *MockSqsService_TestEventPattern_Call {
+	return &MockSqsService_TestEventPattern_Call{Call: _e.mock.On("TestEventPattern", ctx, pattern, event)}
 }
 
-func (_c *MockSqsRepository_GetQueueDetail_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsRepository_GetQueueDetail_Call {
+func (_c *MockSqsService_TestEventPattern_Call) Run(run func(ctx context.Context, pattern string, event string)) *MockSqsService_TestEventPattern_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1610,192 +18022,206 @@ func (_c *MockSqsRepository_GetQueueDetail_Call) Run(run func(ctx context.Contex
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSqsRepository_GetQueueDetail_Call) Return(queueDetail QueueDetail, err error) *MockSqsRepository_GetQueueDetail_Call {
-	_c.Call.Return(queueDetail, err)
+func (_c *MockSqsService_TestEventPattern_Call) Return(b bool, err error) *MockSqsService_TestEventPattern_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockSqsRepository_GetQueueDetail_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (QueueDetail, error)) *MockSqsRepository_GetQueueDetail_Call {
+func (_c *MockSqsService_TestEventPattern_Call) RunAndReturn(run func(ctx context.Context, pattern string, event string) (bool, error)) *MockSqsService_TestEventPattern_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListQueues provides a mock function for the type MockSqsRepository
-func (_mock *MockSqsRepository) ListQueues(ctx context.Context) ([]QueueSummary, error) {
-	ret := _mock.Called(ctx)
+// TransferMessages provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) TransferMessages(ctx context.Context, input TransferMessagesInput) ([]TransferredMessage, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListQueues")
+		panic("no return value specified for TransferMessages")
 	}
 
-	var r0 []QueueSummary
+	var r0 []TransferredMessage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]QueueSummary, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TransferMessagesInput) ([]TransferredMessage, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []QueueSummary); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TransferMessagesInput) []TransferredMessage); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]QueueSummary)
+			r0 = ret.Get(0).([]TransferredMessage)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TransferMessagesInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSqsRepository_ListQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueues'
-type MockSqsRepository_ListQueues_Call struct {
+// MockSqsService_TransferMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferMessages'
+type MockSqsService_TransferMessages_Call struct {
 	*mock.Call
 }
 
-// ListQueues is a helper method to define mock.On call
+// TransferMessages is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockSqsRepository_Expecter) ListQueues(ctx interface{}) *MockSqsRepository_ListQueues_Call {
-	return &MockSqsRepository_ListQueues_Call{Call: _e.mock.On("ListQueues", ctx)}
+//   - input TransferMessagesInput
+func (_e *MockSqsService_Expecter) TransferMessages(ctx interface{}, input interface{}) *MockSqsService_TransferMessages_Call {
+	return &MockSqsService_TransferMessages_Call{Call: _e.mock.On("TransferMessages", ctx, input)}
 }
 
-func (_c *MockSqsRepository_ListQueues_Call) Run(run func(ctx context.Context)) *MockSqsRepository_ListQueues_Call {
+func (_c *MockSqsService_TransferMessages_Call) Run(run func(ctx context.Context, input TransferMessagesInput)) *MockSqsService_TransferMessages_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 TransferMessagesInput
+		if args[1] != nil {
+			arg1 = args[1].(TransferMessagesInput)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSqsRepository_ListQueues_Call) Return(queueSummarys []QueueSummary, err error) *MockSqsRepository_ListQueues_Call {
-	_c.Call.Return(queueSummarys, err)
+func (_c *MockSqsService_TransferMessages_Call) Return(transferredMessages []TransferredMessage, err error) *MockSqsService_TransferMessages_Call {
+	_c.Call.Return(transferredMessages, err)
 	return _c
 }
 
-func (_c *MockSqsRepository_ListQueues_Call) RunAndReturn(run func(ctx context.Context) ([]QueueSummary, error)) *MockSqsRepository_ListQueues_Call {
+func (_c *MockSqsService_TransferMessages_Call) RunAndReturn(run func(ctx context.Context, input TransferMessagesInput) ([]TransferredMessage, error)) *MockSqsService_TransferMessages_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PurgeQueue provides a mock function for the type MockSqsRepository
-func (_mock *MockSqsRepository) PurgeQueue(ctx context.Context, queueURL string) error {
-	ret := _mock.Called(ctx, queueURL)
+// TrashedQueues provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) TrashedQueues(ctx context.Context) ([]TrashedQueue, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PurgeQueue")
+		panic("no return value specified for TrashedQueues")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, queueURL)
+	var r0 []TrashedQueue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]TrashedQueue, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []TrashedQueue); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TrashedQueue)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSqsRepository_PurgeQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeQueue'
-type MockSqsRepository_PurgeQueue_Call struct {
+// MockSqsService_TrashedQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashedQueues'
+type MockSqsService_TrashedQueues_Call struct {
 	*mock.Call
 }
 
-// PurgeQueue is a helper method to define mock.On call
+// TrashedQueues is a helper method to define mock.On call
 //   - ctx context.Context
-//   - queueURL string
-func (_e *MockSqsRepository_Expecter) PurgeQueue(ctx interface{}, queueURL interface{}) *MockSqsRepository_PurgeQueue_Call {
-	return &MockSqsRepository_PurgeQueue_Call{Call: _e.mock.On("PurgeQueue", ctx, queueURL)}
+func (_e *MockSqsService_Expecter) TrashedQueues(ctx interface{}) *MockSqsService_TrashedQueues_Call {
+	return &MockSqsService_TrashedQueues_Call{Call: _e.mock.On("TrashedQueues", ctx)}
 }
 
-func (_c *MockSqsRepository_PurgeQueue_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsRepository_PurgeQueue_Call {
+func (_c *MockSqsService_TrashedQueues_Call) Run(run func(ctx context.Context)) *MockSqsService_TrashedQueues_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSqsRepository_PurgeQueue_Call) Return(err error) *MockSqsRepository_PurgeQueue_Call {
-	_c.Call.Return(err)
+func (_c *MockSqsService_TrashedQueues_Call) Return(trashedQueues []TrashedQueue, err error) *MockSqsService_TrashedQueues_Call {
+	_c.Call.Return(trashedQueues, err)
 	return _c
 }
 
-func (_c *MockSqsRepository_PurgeQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsRepository_PurgeQueue_Call {
+func (_c *MockSqsService_TrashedQueues_Call) RunAndReturn(run func(ctx context.Context) ([]TrashedQueue, error)) *MockSqsService_TrashedQueues_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReceiveMessages provides a mock function for the type MockSqsRepository
-func (_mock *MockSqsRepository) ReceiveMessages(ctx context.Context, input ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error) {
-	ret := _mock.Called(ctx, input)
+// UnsilenceAlertRule provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) UnsilenceAlertRule(ctx context.Context, id string) (AlertRule, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReceiveMessages")
+		panic("no return value specified for UnsilenceAlertRule")
 	}
 
-	var r0 []ReceivedMessage
+	var r0 AlertRule
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error)); ok {
-		return returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (AlertRule, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ReceiveMessagesRepositoryInput) []ReceivedMessage); ok {
-		r0 = returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) AlertRule); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]ReceivedMessage)
-		}
+		r0 = ret.Get(0).(AlertRule)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, ReceiveMessagesRepositoryInput) error); ok {
-		r1 = returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSqsRepository_ReceiveMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMessages'
-type MockSqsRepository_ReceiveMessages_Call struct {
+// MockSqsService_UnsilenceAlertRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsilenceAlertRule'
+type MockSqsService_UnsilenceAlertRule_Call struct {
 	*mock.Call
 }
 
-// ReceiveMessages is a helper method to define mock.On call
+// UnsilenceAlertRule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input ReceiveMessagesRepositoryInput
-func (_e *MockSqsRepository_Expecter) ReceiveMessages(ctx interface{}, input interface{}) *MockSqsRepository_ReceiveMessages_Call {
-	return &MockSqsRepository_ReceiveMessages_Call{Call: _e.mock.On("ReceiveMessages", ctx, input)}
+//   - id string
+func (_e *MockSqsService_Expecter) UnsilenceAlertRule(ctx interface{}, id interface{}) *MockSqsService_UnsilenceAlertRule_Call {
+	return &MockSqsService_UnsilenceAlertRule_Call{Call: _e.mock.On("UnsilenceAlertRule", ctx, id)}
 }
 
-func (_c *MockSqsRepository_ReceiveMessages_Call) Run(run func(ctx context.Context, input ReceiveMessagesRepositoryInput)) *MockSqsRepository_ReceiveMessages_Call {
+func (_c *MockSqsService_UnsilenceAlertRule_Call) Run(run func(ctx context.Context, id string)) *MockSqsService_UnsilenceAlertRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 ReceiveMessagesRepositoryInput
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(ReceiveMessagesRepositoryInput)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -1805,54 +18231,54 @@ func (_c *MockSqsRepository_ReceiveMessages_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockSqsRepository_ReceiveMessages_Call) Return(receivedMessages []ReceivedMessage, err error) *MockSqsRepository_ReceiveMessages_Call {
-	_c.Call.Return(receivedMessages, err)
+func (_c *MockSqsService_UnsilenceAlertRule_Call) Return(alertRule AlertRule, err error) *MockSqsService_UnsilenceAlertRule_Call {
+	_c.Call.Return(alertRule, err)
 	return _c
 }
 
-func (_c *MockSqsRepository_ReceiveMessages_Call) RunAndReturn(run func(ctx context.Context, input ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error)) *MockSqsRepository_ReceiveMessages_Call {
+func (_c *MockSqsService_UnsilenceAlertRule_Call) RunAndReturn(run func(ctx context.Context, id string) (AlertRule, error)) *MockSqsService_UnsilenceAlertRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendMessage provides a mock function for the type MockSqsRepository
-func (_mock *MockSqsRepository) SendMessage(ctx context.Context, input SendMessageRepositoryInput) error {
-	ret := _mock.Called(ctx, input)
+// UnsubscribePush provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) UnsubscribePush(ctx context.Context, endpoint string) error {
+	ret := _mock.Called(ctx, endpoint)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendMessage")
+		panic("no return value specified for UnsubscribePush")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, SendMessageRepositoryInput) error); ok {
-		r0 = returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, endpoint)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSqsRepository_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
-type MockSqsRepository_SendMessage_Call struct {
+// MockSqsService_UnsubscribePush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribePush'
+type MockSqsService_UnsubscribePush_Call struct {
 	*mock.Call
 }
 
-// SendMessage is a helper method to define mock.On call
+// UnsubscribePush is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input SendMessageRepositoryInput
-func (_e *MockSqsRepository_Expecter) SendMessage(ctx interface{}, input interface{}) *MockSqsRepository_SendMessage_Call {
-	return &MockSqsRepository_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, input)}
+//   - endpoint string
+func (_e *MockSqsService_Expecter) UnsubscribePush(ctx interface{}, endpoint interface{}) *MockSqsService_UnsubscribePush_Call {
+	return &MockSqsService_UnsubscribePush_Call{Call: _e.mock.On("UnsubscribePush", ctx, endpoint)}
 }
 
-func (_c *MockSqsRepository_SendMessage_Call) Run(run func(ctx context.Context, input SendMessageRepositoryInput)) *MockSqsRepository_SendMessage_Call {
+func (_c *MockSqsService_UnsubscribePush_Call) Run(run func(ctx context.Context, endpoint string)) *MockSqsService_UnsubscribePush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 SendMessageRepositoryInput
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(SendMessageRepositoryInput)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -1862,90 +18288,117 @@ func (_c *MockSqsRepository_SendMessage_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockSqsRepository_SendMessage_Call) Return(err error) *MockSqsRepository_SendMessage_Call {
+func (_c *MockSqsService_UnsubscribePush_Call) Return(err error) *MockSqsService_UnsubscribePush_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSqsRepository_SendMessage_Call) RunAndReturn(run func(ctx context.Context, input SendMessageRepositoryInput) error) *MockSqsRepository_SendMessage_Call {
+func (_c *MockSqsService_UnsubscribePush_Call) RunAndReturn(run func(ctx context.Context, endpoint string) error) *MockSqsService_UnsubscribePush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSqsService creates a new instance of MockSqsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockSqsService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockSqsService {
-	mock := &MockSqsService{}
-	mock.Mock.Test(t)
+// UntagQueue provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) UntagQueue(ctx context.Context, queueURL string, keys []string) error {
+	ret := _mock.Called(ctx, queueURL, keys)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for UntagQueue")
+	}
 
-	return mock
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, queueURL, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockSqsService is an autogenerated mock type for the SqsService type
-type MockSqsService struct {
-	mock.Mock
+// MockSqsService_UntagQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntagQueue'
+type MockSqsService_UntagQueue_Call struct {
+	*mock.Call
+}
+
+// UntagQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queueURL string
+//   - keys []string
+func (_e *MockSqsService_Expecter) UntagQueue(ctx interface{}, queueURL interface{}, keys interface{}) *MockSqsService_UntagQueue_Call {
+	return &MockSqsService_UntagQueue_Call{Call: _e.mock.On("UntagQueue", ctx, queueURL, keys)}
+}
+
+func (_c *MockSqsService_UntagQueue_Call) Run(run func(ctx context.Context, queueURL string, keys []string)) *MockSqsService_UntagQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
 }
 
-type MockSqsService_Expecter struct {
-	mock *mock.Mock
+func (_c *MockSqsService_UntagQueue_Call) Return(err error) *MockSqsService_UntagQueue_Call {
+	_c.Call.Return(err)
+	return _c
 }
 
-func (_m *MockSqsService) EXPECT() *MockSqsService_Expecter {
-	return &MockSqsService_Expecter{mock: &_m.Mock}
+func (_c *MockSqsService_UntagQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string, keys []string) error) *MockSqsService_UntagQueue_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// CreateQueue provides a mock function for the type MockSqsService
-func (_mock *MockSqsService) CreateQueue(ctx context.Context, input CreateQueueInput) (CreateQueueResult, error) {
-	ret := _mock.Called(ctx, input)
+// UnwatchQueueAttributes provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) UnwatchQueueAttributes(ctx context.Context, queueURL string) error {
+	ret := _mock.Called(ctx, queueURL)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateQueue")
+		panic("no return value specified for UnwatchQueueAttributes")
 	}
 
-	var r0 CreateQueueResult
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateQueueInput) (CreateQueueResult, error)); ok {
-		return returnFunc(ctx, input)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, CreateQueueInput) CreateQueueResult); ok {
-		r0 = returnFunc(ctx, input)
-	} else {
-		r0 = ret.Get(0).(CreateQueueResult)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, CreateQueueInput) error); ok {
-		r1 = returnFunc(ctx, input)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, queueURL)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSqsService_CreateQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQueue'
-type MockSqsService_CreateQueue_Call struct {
+// MockSqsService_UnwatchQueueAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnwatchQueueAttributes'
+type MockSqsService_UnwatchQueueAttributes_Call struct {
 	*mock.Call
 }
 
-// CreateQueue is a helper method to define mock.On call
+// UnwatchQueueAttributes is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input CreateQueueInput
-func (_e *MockSqsService_Expecter) CreateQueue(ctx interface{}, input interface{}) *MockSqsService_CreateQueue_Call {
-	return &MockSqsService_CreateQueue_Call{Call: _e.mock.On("CreateQueue", ctx, input)}
+//   - queueURL string
+func (_e *MockSqsService_Expecter) UnwatchQueueAttributes(ctx interface{}, queueURL interface{}) *MockSqsService_UnwatchQueueAttributes_Call {
+	return &MockSqsService_UnwatchQueueAttributes_Call{Call: _e.mock.On("UnwatchQueueAttributes", ctx, queueURL)}
 }
 
-func (_c *MockSqsService_CreateQueue_Call) Run(run func(ctx context.Context, input CreateQueueInput)) *MockSqsService_CreateQueue_Call {
+func (_c *MockSqsService_UnwatchQueueAttributes_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_UnwatchQueueAttributes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 CreateQueueInput
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(CreateQueueInput)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -1955,103 +18408,134 @@ func (_c *MockSqsService_CreateQueue_Call) Run(run func(ctx context.Context, inp
 	return _c
 }
 
-func (_c *MockSqsService_CreateQueue_Call) Return(createQueueResult CreateQueueResult, err error) *MockSqsService_CreateQueue_Call {
-	_c.Call.Return(createQueueResult, err)
+func (_c *MockSqsService_UnwatchQueueAttributes_Call) Return(err error) *MockSqsService_UnwatchQueueAttributes_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSqsService_CreateQueue_Call) RunAndReturn(run func(ctx context.Context, input CreateQueueInput) (CreateQueueResult, error)) *MockSqsService_CreateQueue_Call {
+func (_c *MockSqsService_UnwatchQueueAttributes_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsService_UnwatchQueueAttributes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteMessage provides a mock function for the type MockSqsService
-func (_mock *MockSqsService) DeleteMessage(ctx context.Context, input DeleteMessageInput) error {
-	ret := _mock.Called(ctx, input)
+// UpdateQueueAttribute provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) UpdateQueueAttribute(ctx context.Context, queueURL string, name string, value string) (string, error) {
+	ret := _mock.Called(ctx, queueURL, name, value)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteMessage")
+		panic("no return value specified for UpdateQueueAttribute")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, DeleteMessageInput) error); ok {
-		r0 = returnFunc(ctx, input)
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return returnFunc(ctx, queueURL, name, value)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = returnFunc(ctx, queueURL, name, value)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, queueURL, name, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSqsService_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
-type MockSqsService_DeleteMessage_Call struct {
+// MockSqsService_UpdateQueueAttribute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQueueAttribute'
+type MockSqsService_UpdateQueueAttribute_Call struct {
 	*mock.Call
 }
 
-// DeleteMessage is a helper method to define mock.On call
+// UpdateQueueAttribute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input DeleteMessageInput
-func (_e *MockSqsService_Expecter) DeleteMessage(ctx interface{}, input interface{}) *MockSqsService_DeleteMessage_Call {
-	return &MockSqsService_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage", ctx, input)}
+//   - queueURL string
+//   - name string
+//   - value string
+func (_e *MockSqsService_Expecter) UpdateQueueAttribute(ctx interface{}, queueURL interface{}, name interface{}, value interface{}) *MockSqsService_UpdateQueueAttribute_Call {
+	return &MockSqsService_UpdateQueueAttribute_Call{Call: _e.mock.On("UpdateQueueAttribute", ctx, queueURL, name, value)}
 }
 
-func (_c *MockSqsService_DeleteMessage_Call) Run(run func(ctx context.Context, input DeleteMessageInput)) *MockSqsService_DeleteMessage_Call {
+func (_c *MockSqsService_UpdateQueueAttribute_Call) Run(run func(ctx context.Context, queueURL string, name string, value string)) *MockSqsService_UpdateQueueAttribute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 DeleteMessageInput
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(DeleteMessageInput)
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSqsService_DeleteMessage_Call) Return(err error) *MockSqsService_DeleteMessage_Call {
-	_c.Call.Return(err)
+func (_c *MockSqsService_UpdateQueueAttribute_Call) Return(s string, err error) *MockSqsService_UpdateQueueAttribute_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockSqsService_DeleteMessage_Call) RunAndReturn(run func(ctx context.Context, input DeleteMessageInput) error) *MockSqsService_DeleteMessage_Call {
+func (_c *MockSqsService_UpdateQueueAttribute_Call) RunAndReturn(run func(ctx context.Context, queueURL string, name string, value string) (string, error)) *MockSqsService_UpdateQueueAttribute_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteQueue provides a mock function for the type MockSqsService
-func (_mock *MockSqsService) DeleteQueue(ctx context.Context, queueURL string) error {
-	ret := _mock.Called(ctx, queueURL)
+// UpdateSchedule provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) UpdateSchedule(ctx context.Context, id string, input UpdateScheduleInput) (Schedule, error) {
+	ret := _mock.Called(ctx, id, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteQueue")
+		panic("no return value specified for UpdateSchedule")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, queueURL)
+	var r0 Schedule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, UpdateScheduleInput) (Schedule, error)); ok {
+		return returnFunc(ctx, id, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, UpdateScheduleInput) Schedule); ok {
+		r0 = returnFunc(ctx, id, input)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(Schedule)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, UpdateScheduleInput) error); ok {
+		r1 = returnFunc(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSqsService_DeleteQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueue'
-type MockSqsService_DeleteQueue_Call struct {
+// MockSqsService_UpdateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchedule'
+type MockSqsService_UpdateSchedule_Call struct {
 	*mock.Call
 }
 
-// DeleteQueue is a helper method to define mock.On call
+// UpdateSchedule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - queueURL string
-func (_e *MockSqsService_Expecter) DeleteQueue(ctx interface{}, queueURL interface{}) *MockSqsService_DeleteQueue_Call {
-	return &MockSqsService_DeleteQueue_Call{Call: _e.mock.On("DeleteQueue", ctx, queueURL)}
+//   - id string
+//   - input UpdateScheduleInput
+func (_e *MockSqsService_Expecter) UpdateSchedule(ctx interface{}, id interface{}, input interface{}) *MockSqsService_UpdateSchedule_Call {
+	return &MockSqsService_UpdateSchedule_Call{Call: _e.mock.On("UpdateSchedule", ctx, id, input)}
 }
 
-func (_c *MockSqsService_DeleteQueue_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_DeleteQueue_Call {
+func (_c *MockSqsService_UpdateSchedule_Call) Run(run func(ctx context.Context, id string, input UpdateScheduleInput)) *MockSqsService_UpdateSchedule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2061,98 +18545,109 @@ func (_c *MockSqsService_DeleteQueue_Call) Run(run func(ctx context.Context, que
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 UpdateScheduleInput
+		if args[2] != nil {
+			arg2 = args[2].(UpdateScheduleInput)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSqsService_DeleteQueue_Call) Return(err error) *MockSqsService_DeleteQueue_Call {
-	_c.Call.Return(err)
+func (_c *MockSqsService_UpdateSchedule_Call) Return(schedule Schedule, err error) *MockSqsService_UpdateSchedule_Call {
+	_c.Call.Return(schedule, err)
 	return _c
 }
 
-func (_c *MockSqsService_DeleteQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsService_DeleteQueue_Call {
+func (_c *MockSqsService_UpdateSchedule_Call) RunAndReturn(run func(ctx context.Context, id string, input UpdateScheduleInput) (Schedule, error)) *MockSqsService_UpdateSchedule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PurgeQueue provides a mock function for the type MockSqsService
-func (_mock *MockSqsService) PurgeQueue(ctx context.Context, queueURL string) error {
-	ret := _mock.Called(ctx, queueURL)
+// VerifySlackRequest provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) VerifySlackRequest(timestamp string, signature string, body []byte) error {
+	ret := _mock.Called(timestamp, signature, body)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PurgeQueue")
+		panic("no return value specified for VerifySlackRequest")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, queueURL)
+	if returnFunc, ok := ret.Get(0).(func(string, string, []byte) error); ok {
+		r0 = returnFunc(timestamp, signature, body)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSqsService_PurgeQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeQueue'
-type MockSqsService_PurgeQueue_Call struct {
+// MockSqsService_VerifySlackRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifySlackRequest'
+type MockSqsService_VerifySlackRequest_Call struct {
 	*mock.Call
 }
 
-// PurgeQueue is a helper method to define mock.On call
-//   - ctx context.Context
-//   - queueURL string
-func (_e *MockSqsService_Expecter) PurgeQueue(ctx interface{}, queueURL interface{}) *MockSqsService_PurgeQueue_Call {
-	return &MockSqsService_PurgeQueue_Call{Call: _e.mock.On("PurgeQueue", ctx, queueURL)}
+// VerifySlackRequest is a helper method to define mock.On call
+//   - timestamp string
+//   - signature string
+//   - body []byte
+func (_e *MockSqsService_Expecter) VerifySlackRequest(timestamp interface{}, signature interface{}, body interface{}) *MockSqsService_VerifySlackRequest_Call {
+	return &MockSqsService_VerifySlackRequest_Call{Call: _e.mock.On("VerifySlackRequest", timestamp, signature, body)}
 }
 
-func (_c *MockSqsService_PurgeQueue_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_PurgeQueue_Call {
+func (_c *MockSqsService_VerifySlackRequest_Call) Run(run func(timestamp string, signature string, body []byte)) *MockSqsService_VerifySlackRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(context.Context)
+			arg0 = args[0].(string)
 		}
 		var arg1 string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSqsService_PurgeQueue_Call) Return(err error) *MockSqsService_PurgeQueue_Call {
+func (_c *MockSqsService_VerifySlackRequest_Call) Return(err error) *MockSqsService_VerifySlackRequest_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSqsService_PurgeQueue_Call) RunAndReturn(run func(ctx context.Context, queueURL string) error) *MockSqsService_PurgeQueue_Call {
+func (_c *MockSqsService_VerifySlackRequest_Call) RunAndReturn(run func(timestamp string, signature string, body []byte) error) *MockSqsService_VerifySlackRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// QueueDetail provides a mock function for the type MockSqsService
-func (_mock *MockSqsService) QueueDetail(ctx context.Context, queueURL string) (QueueDetail, error) {
+// WatchQueueAttributes provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) WatchQueueAttributes(ctx context.Context, queueURL string) (AttributeHistory, error) {
 	ret := _mock.Called(ctx, queueURL)
 
 	if len(ret) == 0 {
-		panic("no return value specified for QueueDetail")
+		panic("no return value specified for WatchQueueAttributes")
 	}
 
-	var r0 QueueDetail
+	var r0 AttributeHistory
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QueueDetail, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (AttributeHistory, error)); ok {
 		return returnFunc(ctx, queueURL)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QueueDetail); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) AttributeHistory); ok {
 		r0 = returnFunc(ctx, queueURL)
 	} else {
-		r0 = ret.Get(0).(QueueDetail)
+		r0 = ret.Get(0).(AttributeHistory)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = returnFunc(ctx, queueURL)
@@ -2162,19 +18657,19 @@ func (_mock *MockSqsService) QueueDetail(ctx context.Context, queueURL string) (
 	return r0, r1
 }
 
-// MockSqsService_QueueDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueueDetail'
-type MockSqsService_QueueDetail_Call struct {
+// MockSqsService_WatchQueueAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchQueueAttributes'
+type MockSqsService_WatchQueueAttributes_Call struct {
 	*mock.Call
 }
 
-// QueueDetail is a helper method to define mock.On call
+// WatchQueueAttributes is a helper method to define mock.On call
 //   - ctx context.Context
 //   - queueURL string
-func (_e *MockSqsService_Expecter) QueueDetail(ctx interface{}, queueURL interface{}) *MockSqsService_QueueDetail_Call {
-	return &MockSqsService_QueueDetail_Call{Call: _e.mock.On("QueueDetail", ctx, queueURL)}
+func (_e *MockSqsService_Expecter) WatchQueueAttributes(ctx interface{}, queueURL interface{}) *MockSqsService_WatchQueueAttributes_Call {
+	return &MockSqsService_WatchQueueAttributes_Call{Call: _e.mock.On("WatchQueueAttributes", ctx, queueURL)}
 }
 
-func (_c *MockSqsService_QueueDetail_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_QueueDetail_Call {
+func (_c *MockSqsService_WatchQueueAttributes_Call) Run(run func(ctx context.Context, queueURL string)) *MockSqsService_WatchQueueAttributes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2192,56 +18687,45 @@ func (_c *MockSqsService_QueueDetail_Call) Run(run func(ctx context.Context, que
 	return _c
 }
 
-func (_c *MockSqsService_QueueDetail_Call) Return(queueDetail QueueDetail, err error) *MockSqsService_QueueDetail_Call {
-	_c.Call.Return(queueDetail, err)
+func (_c *MockSqsService_WatchQueueAttributes_Call) Return(attributeHistory AttributeHistory, err error) *MockSqsService_WatchQueueAttributes_Call {
+	_c.Call.Return(attributeHistory, err)
 	return _c
 }
 
-func (_c *MockSqsService_QueueDetail_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (QueueDetail, error)) *MockSqsService_QueueDetail_Call {
+func (_c *MockSqsService_WatchQueueAttributes_Call) RunAndReturn(run func(ctx context.Context, queueURL string) (AttributeHistory, error)) *MockSqsService_WatchQueueAttributes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Queues provides a mock function for the type MockSqsService
-func (_mock *MockSqsService) Queues(ctx context.Context) ([]QueueSummary, error) {
+// WebPushKey provides a mock function for the type MockSqsService
+func (_mock *MockSqsService) WebPushKey(ctx context.Context) string {
 	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Queues")
+		panic("no return value specified for WebPushKey")
 	}
 
-	var r0 []QueueSummary
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]QueueSummary, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []QueueSummary); ok {
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
 		r0 = returnFunc(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]QueueSummary)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(string)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSqsService_Queues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Queues'
-type MockSqsService_Queues_Call struct {
+// MockSqsService_WebPushKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebPushKey'
+type MockSqsService_WebPushKey_Call struct {
 	*mock.Call
 }
 
-// Queues is a helper method to define mock.On call
+// WebPushKey is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockSqsService_Expecter) Queues(ctx interface{}) *MockSqsService_Queues_Call {
-	return &MockSqsService_Queues_Call{Call: _e.mock.On("Queues", ctx)}
+func (_e *MockSqsService_Expecter) WebPushKey(ctx interface{}) *MockSqsService_WebPushKey_Call {
+	return &MockSqsService_WebPushKey_Call{Call: _e.mock.On("WebPushKey", ctx)}
 }
 
-func (_c *MockSqsService_Queues_Call) Run(run func(ctx context.Context)) *MockSqsService_Queues_Call {
+func (_c *MockSqsService_WebPushKey_Call) Run(run func(ctx context.Context)) *MockSqsService_WebPushKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2254,135 +18738,146 @@ func (_c *MockSqsService_Queues_Call) Run(run func(ctx context.Context)) *MockSq
 	return _c
 }
 
-func (_c *MockSqsService_Queues_Call) Return(queueSummarys []QueueSummary, err error) *MockSqsService_Queues_Call {
-	_c.Call.Return(queueSummarys, err)
+func (_c *MockSqsService_WebPushKey_Call) Return(s string) *MockSqsService_WebPushKey_Call {
+	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockSqsService_Queues_Call) RunAndReturn(run func(ctx context.Context) ([]QueueSummary, error)) *MockSqsService_Queues_Call {
+func (_c *MockSqsService_WebPushKey_Call) RunAndReturn(run func(ctx context.Context) string) *MockSqsService_WebPushKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReceiveMessages provides a mock function for the type MockSqsService
-func (_mock *MockSqsService) ReceiveMessages(ctx context.Context, input ReceiveMessagesInput) (ReceiveMessagesResult, error) {
-	ret := _mock.Called(ctx, input)
+// NewMockWebPusher creates a new instance of MockWebPusher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebPusher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebPusher {
+	mock := &MockWebPusher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebPusher is an autogenerated mock type for the WebPusher type
+type MockWebPusher struct {
+	mock.Mock
+}
+
+type MockWebPusher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebPusher) EXPECT() *MockWebPusher_Expecter {
+	return &MockWebPusher_Expecter{mock: &_m.Mock}
+}
+
+// PublicKey provides a mock function for the type MockWebPusher
+func (_mock *MockWebPusher) PublicKey() string {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReceiveMessages")
+		panic("no return value specified for PublicKey")
 	}
 
-	var r0 ReceiveMessagesResult
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ReceiveMessagesInput) (ReceiveMessagesResult, error)); ok {
-		return returnFunc(ctx, input)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ReceiveMessagesInput) ReceiveMessagesResult); ok {
-		r0 = returnFunc(ctx, input)
-	} else {
-		r0 = ret.Get(0).(ReceiveMessagesResult)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, ReceiveMessagesInput) error); ok {
-		r1 = returnFunc(ctx, input)
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(string)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSqsService_ReceiveMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveMessages'
-type MockSqsService_ReceiveMessages_Call struct {
+// MockWebPusher_PublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublicKey'
+type MockWebPusher_PublicKey_Call struct {
 	*mock.Call
 }
 
-// ReceiveMessages is a helper method to define mock.On call
-//   - ctx context.Context
-//   - input ReceiveMessagesInput
-func (_e *MockSqsService_Expecter) ReceiveMessages(ctx interface{}, input interface{}) *MockSqsService_ReceiveMessages_Call {
-	return &MockSqsService_ReceiveMessages_Call{Call: _e.mock.On("ReceiveMessages", ctx, input)}
+// PublicKey is a helper method to define mock.On call
+func (_e *MockWebPusher_Expecter) PublicKey() *MockWebPusher_PublicKey_Call {
+	return &MockWebPusher_PublicKey_Call{Call: _e.mock.On("PublicKey")}
 }
 
-func (_c *MockSqsService_ReceiveMessages_Call) Run(run func(ctx context.Context, input ReceiveMessagesInput)) *MockSqsService_ReceiveMessages_Call {
+func (_c *MockWebPusher_PublicKey_Call) Run(run func()) *MockWebPusher_PublicKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 ReceiveMessagesInput
-		if args[1] != nil {
-			arg1 = args[1].(ReceiveMessagesInput)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockSqsService_ReceiveMessages_Call) Return(receiveMessagesResult ReceiveMessagesResult, err error) *MockSqsService_ReceiveMessages_Call {
-	_c.Call.Return(receiveMessagesResult, err)
+func (_c *MockWebPusher_PublicKey_Call) Return(s string) *MockWebPusher_PublicKey_Call {
+	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockSqsService_ReceiveMessages_Call) RunAndReturn(run func(ctx context.Context, input ReceiveMessagesInput) (ReceiveMessagesResult, error)) *MockSqsService_ReceiveMessages_Call {
+func (_c *MockWebPusher_PublicKey_Call) RunAndReturn(run func() string) *MockWebPusher_PublicKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendMessage provides a mock function for the type MockSqsService
-func (_mock *MockSqsService) SendMessage(ctx context.Context, input SendMessageInput) error {
-	ret := _mock.Called(ctx, input)
+// Push provides a mock function for the type MockWebPusher
+func (_mock *MockWebPusher) Push(ctx context.Context, subscription PushSubscription, message PushMessage) error {
+	ret := _mock.Called(ctx, subscription, message)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendMessage")
+		panic("no return value specified for Push")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, SendMessageInput) error); ok {
-		r0 = returnFunc(ctx, input)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, PushSubscription, PushMessage) error); ok {
+		r0 = returnFunc(ctx, subscription, message)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSqsService_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
-type MockSqsService_SendMessage_Call struct {
+// MockWebPusher_Push_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Push'
+type MockWebPusher_Push_Call struct {
 	*mock.Call
 }
 
-// SendMessage is a helper method to define mock.On call
+// Push is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input SendMessageInput
-func (_e *MockSqsService_Expecter) SendMessage(ctx interface{}, input interface{}) *MockSqsService_SendMessage_Call {
-	return &MockSqsService_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, input)}
+//   - subscription PushSubscription
+//   - message PushMessage
+func (_e *MockWebPusher_Expecter) Push(ctx interface{}, subscription interface{}, message interface{}) *MockWebPusher_Push_Call {
+	return &MockWebPusher_Push_Call{Call: _e.mock.On("Push", ctx, subscription, message)}
 }
 
-func (_c *MockSqsService_SendMessage_Call) Run(run func(ctx context.Context, input SendMessageInput)) *MockSqsService_SendMessage_Call {
+func (_c *MockWebPusher_Push_Call) Run(run func(ctx context.Context, subscription PushSubscription, message PushMessage)) *MockWebPusher_Push_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 SendMessageInput
+		var arg1 PushSubscription
 		if args[1] != nil {
-			arg1 = args[1].(SendMessageInput)
+			arg1 = args[1].(PushSubscription)
+		}
+		var arg2 PushMessage
+		if args[2] != nil {
+			arg2 = args[2].(PushMessage)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSqsService_SendMessage_Call) Return(err error) *MockSqsService_SendMessage_Call {
+func (_c *MockWebPusher_Push_Call) Return(err error) *MockWebPusher_Push_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSqsService_SendMessage_Call) RunAndReturn(run func(ctx context.Context, input SendMessageInput) error) *MockSqsService_SendMessage_Call {
+func (_c *MockWebPusher_Push_Call) RunAndReturn(run func(ctx context.Context, subscription PushSubscription, message PushMessage) error) *MockWebPusher_Push_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,220 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Notification is a message delivered to operators when background work needs attention.
+type Notification struct {
+	Title string
+	Text  string
+}
+
+// Notifier delivers notifications to an external channel.
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// NotificationChannel names where notifications can be delivered.
+type NotificationChannel string
+
+const (
+	// NotificationChannelWebhook posts JSON to SQS_GUI_NOTIFY_WEBHOOK_URL.
+	NotificationChannelWebhook NotificationChannel = "webhook"
+	// NotificationChannelSlack posts to the Slack incoming webhook in SQS_GUI_NOTIFY_SLACK_WEBHOOK_URL.
+	NotificationChannelSlack NotificationChannel = "slack"
+	// NotificationChannelTeams posts an adaptive card to the Teams webhook in
+	// SQS_GUI_NOTIFY_TEAMS_WEBHOOK_URL.
+	NotificationChannelTeams NotificationChannel = "teams"
+)
+
+// notificationChannels lists every channel in the order notifications are delivered.
+var notificationChannels = []NotificationChannel{NotificationChannelWebhook, NotificationChannelSlack, NotificationChannelTeams}
+
+// newNotifiers creates a notifier for every channel with a configured URL.
+func newNotifiers(config ServiceConfig) map[NotificationChannel]Notifier {
+	notifiers := make(map[NotificationChannel]Notifier)
+	if config.NotifyWebhookURL != "" {
+		notifiers[NotificationChannelWebhook] = NewWebhookNotifier(config.NotifyWebhookURL)
+	}
+	if config.NotifySlackWebhookURL != "" {
+		notifiers[NotificationChannelSlack] = NewSlackNotifier(config.NotifySlackWebhookURL)
+	}
+	if config.NotifyTeamsWebhookURL != "" {
+		notifiers[NotificationChannelTeams] = NewTeamsNotifier(config.NotifyTeamsWebhookURL)
+	}
+	return notifiers
+}
+
+// WebhookNotifier posts notifications as JSON to a generic webhook URL.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a notifier that posts to url.
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type webhookPayload struct {
+	Title  string `json:"title"`
+	Text   string `json:"text"`
+	SentAt string `json:"sentAt"`
+}
+
+// Notify sends the notification and fails on any non-2xx response.
+func (n *WebhookNotifier) Notify(ctx context.Context, notification Notification) error {
+	return postNotification(ctx, n.client, n.url, "notification webhook", webhookPayload{
+		Title:  notification.Title,
+		Text:   notification.Text,
+		SentAt: time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// SlackNotifier posts notifications to a Slack incoming webhook.
+type SlackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewSlackNotifier creates a notifier that posts to the Slack incoming webhook url.
+func NewSlackNotifier(url string) *SlackNotifier {
+	return &SlackNotifier{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// Notify sends the notification as a message with the title in bold.
+func (n *SlackNotifier) Notify(ctx context.Context, notification Notification) error {
+	return postNotification(ctx, n.client, n.url, "Slack webhook", slackPayload{
+		Text: "*" + notification.Title + "*\n" + notification.Text,
+	})
+}
+
+// TeamsNotifier posts notifications as adaptive cards to a Microsoft Teams webhook, either an
+// incoming webhook or a Workflows "when a webhook request is received" trigger.
+type TeamsNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewTeamsNotifier creates a notifier that posts to the Teams webhook url.
+func NewTeamsNotifier(url string) *TeamsNotifier {
+	return &TeamsNotifier{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type teamsPayload struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string    `json:"contentType"`
+	Content     teamsCard `json:"content"`
+}
+
+type teamsCard struct {
+	Schema  string           `json:"$schema"`
+	Type    string           `json:"type"`
+	Version string           `json:"version"`
+	Body    []teamsTextBlock `json:"body"`
+}
+
+type teamsTextBlock struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Size   string `json:"size,omitempty"`
+	Weight string `json:"weight,omitempty"`
+	Wrap   bool   `json:"wrap"`
+}
+
+// Notify sends the notification as an adaptive card with the title as its heading.
+func (n *TeamsNotifier) Notify(ctx context.Context, notification Notification) error {
+	return postNotification(ctx, n.client, n.url, "Teams webhook", teamsPayload{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: teamsCard{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body: []teamsTextBlock{
+					{Type: "TextBlock", Text: notification.Title, Size: "Medium", Weight: "Bolder", Wrap: true},
+					{Type: "TextBlock", Text: notification.Text, Wrap: true},
+				},
+			},
+		}},
+	})
+}
+
+// postNotification posts payload as JSON to url and fails on any non-2xx response. name describes
+// the receiver in errors.
+func postNotification(ctx context.Context, client *http.Client, url, name string, payload any) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode notification")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(raw))
+	if err != nil {
+		return errors.Wrap(err, "failed to build notification request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send notification")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Newf("%s responded with status %d", name, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// NotificationChannels lists the channels with a configured URL, in delivery order.
+func (s *SqsServiceImpl) NotificationChannels(_ context.Context) []NotificationChannel {
+	var channels []NotificationChannel
+	for _, channel := range notificationChannels {
+		if _, ok := s.notifiers[channel]; ok {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// notify delivers a notification to every configured channel.
+func (s *SqsServiceImpl) notify(ctx context.Context, notification Notification) {
+	s.notifyChannels(ctx, nil, notification)
+}
+
+// notifyChannels delivers a notification to the given channels, or to every configured channel
+// when none are given, logging rather than failing on errors. Channels that are no longer
+// configured are skipped.
+func (s *SqsServiceImpl) notifyChannels(ctx context.Context, channels []NotificationChannel, notification Notification) {
+	if len(channels) == 0 {
+		channels = notificationChannels
+	}
+
+	for _, channel := range channels {
+		notifier, ok := s.notifiers[channel]
+		if !ok {
+			continue
+		}
+		if err := notifier.Notify(ctx, notification); err != nil {
+			slog.WarnContext(ctx, "failed to deliver notification", slog.String("channel", string(channel)), slog.String("title", notification.Title), slog.Any("error", err))
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	t.Run("posts the notification as json", func(t *testing.T) {
+		var received webhookPayload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		err := NewWebhookNotifier(server.URL).Notify(context.Background(), Notification{Title: "title", Text: "text"})
+		require.NoError(t, err)
+		assert.Equal(t, "title", received.Title)
+		assert.Equal(t, "text", received.Text)
+		assert.NotEmpty(t, received.SentAt)
+	})
+
+	t.Run("fails on error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		err := NewWebhookNotifier(server.URL).Notify(context.Background(), Notification{Title: "title"})
+		assert.EqualError(t, err, "notification webhook responded with status 500")
+	})
+}
+
+func TestSlackNotifier_Notify(t *testing.T) {
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	err := NewSlackNotifier(server.URL).Notify(context.Background(), Notification{Title: "title", Text: "text"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"text": "*title*\ntext"}, received)
+}
+
+func TestTeamsNotifier_Notify(t *testing.T) {
+	t.Run("posts an adaptive card", func(t *testing.T) {
+		var received teamsPayload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		err := NewTeamsNotifier(server.URL).Notify(context.Background(), Notification{Title: "title", Text: "text"})
+		require.NoError(t, err)
+		assert.Equal(t, "message", received.Type)
+		require.Len(t, received.Attachments, 1)
+		assert.Equal(t, "application/vnd.microsoft.card.adaptive", received.Attachments[0].ContentType)
+		card := received.Attachments[0].Content
+		assert.Equal(t, "AdaptiveCard", card.Type)
+		require.Len(t, card.Body, 2)
+		assert.Equal(t, "title", card.Body[0].Text)
+		assert.Equal(t, "Bolder", card.Body[0].Weight)
+		assert.Equal(t, "text", card.Body[1].Text)
+	})
+
+	t.Run("fails on error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		err := NewTeamsNotifier(server.URL).Notify(context.Background(), Notification{Title: "title"})
+		assert.EqualError(t, err, "Teams webhook responded with status 400")
+	})
+}
+
+func TestSqsServiceImpl_notifyChannels(t *testing.T) {
+	notification := Notification{Title: "title", Text: "text"}
+	webhook := NewMockNotifier(t)
+	teams := NewMockNotifier(t)
+	s := &SqsServiceImpl{notifiers: map[NotificationChannel]Notifier{
+		NotificationChannelWebhook: webhook,
+		NotificationChannelTeams:   teams,
+	}}
+
+	assert.Equal(t, []NotificationChannel{NotificationChannelWebhook, NotificationChannelTeams}, s.NotificationChannels(context.Background()))
+
+	teams.EXPECT().Notify(context.Background(), notification).Return(nil).Once()
+	s.notifyChannels(context.Background(), []NotificationChannel{NotificationChannelTeams, NotificationChannelSlack}, notification)
+
+	webhook.EXPECT().Notify(context.Background(), notification).Return(nil).Once()
+	teams.EXPECT().Notify(context.Background(), notification).Return(errors.New("unreachable")).Once()
+	s.notifyChannels(context.Background(), nil, notification)
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// pageTokenState is what an opaque continuation token carries: where the next page starts and a
+// fingerprint of the list it belongs to, so a token cannot be replayed against another filter.
+// Lists paged by AWS itself carry the NextToken of the AWS call instead of an offset.
+type pageTokenState struct {
+	Scope    string `json:"s"`
+	Offset   int    `json:"o,omitempty"`
+	Upstream string `json:"u,omitempty"`
+}
+
+// pagingParams are the AWS-style paging parameters of the JSON list endpoints: maxResults caps the
+// page and nextToken continues from an earlier response, as with ListQueues.
+type pagingParams struct {
+	MaxResults int
+	NextToken  string
+}
+
+// pagingParamsFromQuery reads maxResults and nextToken. maxResults must be between 1 and limit.
+func pagingParamsFromQuery(query url.Values, limit int) (pagingParams, error) {
+	params := pagingParams{NextToken: strings.TrimSpace(query.Get("nextToken"))}
+	if raw := strings.TrimSpace(query.Get("maxResults")); raw != "" {
+		value, err := strconv.Atoi(raw)
+		if err != nil || value < 1 || value > limit {
+			return pagingParams{}, errors.Newf("maxResults must be between 1 and %d", limit)
+		}
+		params.MaxResults = value
+	}
+	return params, nil
+}
+
+// encodePageToken returns the continuation token for the item at offset of the list described by scope.
+func encodePageToken(scope string, offset int) string {
+	raw, _ := json.Marshal(pageTokenState{Scope: pageTokenScope(scope), Offset: offset})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// encodeUpstreamPageToken wraps the NextToken of an AWS list call in a continuation token of the list
+// described by scope.
+func encodeUpstreamPageToken(scope, upstream string) string {
+	raw, _ := json.Marshal(pageTokenState{Scope: pageTokenScope(scope), Upstream: upstream})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodePageToken returns the offset a token continues from. Tokens from another list or with other
+// filters are rejected.
+func decodePageToken(token, scope string) (int, error) {
+	state, err := parsePageToken(token, scope)
+	if err != nil {
+		return 0, err
+	}
+	return state.Offset, nil
+}
+
+// decodeUpstreamPageToken returns the AWS NextToken a token continues from. Tokens from another list
+// are rejected.
+func decodeUpstreamPageToken(token, scope string) (string, error) {
+	state, err := parsePageToken(token, scope)
+	if err != nil {
+		return "", err
+	}
+	return state.Upstream, nil
+}
+
+// isUpstreamPageToken reports whether token wraps an AWS NextToken rather than an offset.
+func isUpstreamPageToken(token string) bool {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return false
+	}
+	var state pageTokenState
+	return json.Unmarshal(raw, &state) == nil && state.Upstream != ""
+}
+
+func parsePageToken(token, scope string) (pageTokenState, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageTokenState{}, errors.New("invalid nextToken")
+	}
+	var state pageTokenState
+	if err := json.Unmarshal(raw, &state); err != nil || state.Offset < 0 {
+		return pageTokenState{}, errors.New("invalid nextToken")
+	}
+	if state.Scope != pageTokenScope(scope) {
+		return pageTokenState{}, errors.New("nextToken belongs to a different listing; repeat the same filter and sort")
+	}
+	return state, nil
+}
+
+// nextPageToken returns the token of the page after one that ends at end, or "" on the last page.
+func nextPageToken(scope string, end, total int) string {
+	if end >= total {
+		return ""
+	}
+	return encodePageToken(scope, end)
+}
+
+func pageTokenScope(scope string) string {
+	sum := sha256.Sum256([]byte(scope))
+	return hex.EncodeToString(sum[:8])
+}
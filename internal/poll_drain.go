@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrShuttingDown is returned for long polls that were cut short or refused because the server is
+// stopping.
+var ErrShuttingDown = errors.New("the server is shutting down; poll again in a moment")
+
+// pollTracker keeps the cancel functions of in-flight long polls so shutdown does not have to wait
+// up to 20 seconds for SQS to answer them.
+type pollTracker struct {
+	mu       sync.Mutex
+	draining bool
+	next     int
+	cancels  map[int]context.CancelFunc
+}
+
+func newPollTracker() *pollTracker {
+	return &pollTracker{cancels: make(map[int]context.CancelFunc)}
+}
+
+// track derives a context for one poll that is cancelled when the tracker drains. done must be
+// called when the poll returns.
+func (t *pollTracker) track(ctx context.Context) (context.Context, func(), error) {
+	if t == nil {
+		return ctx, func() {}, nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.draining {
+		return nil, nil, errors.WithStack(ErrShuttingDown)
+	}
+
+	pollCtx, cancel := context.WithCancel(ctx)
+	id := t.next
+	t.next++
+	t.cancels[id] = cancel
+
+	done := func() {
+		t.mu.Lock()
+		delete(t.cancels, id)
+		t.mu.Unlock()
+		cancel()
+	}
+	return pollCtx, done, nil
+}
+
+// drain cancels every tracked poll, refuses new ones and returns how many polls were cancelled.
+func (t *pollTracker) drain() int {
+	if t == nil {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.draining = true
+	cancelled := len(t.cancels)
+	for id, cancel := range t.cancels {
+		cancel()
+		delete(t.cancels, id)
+	}
+	return cancelled
+}
+
+// DrainPolls cancels in-flight long polls and makes new ones fail with ErrShuttingDown. Call it
+// right before shutting the HTTP server down so open polls answer immediately. Messages SQS had
+// already handed to a cancelled poll stay invisible until their visibility timeout expires and are
+// then delivered again. It returns the number of polls that were cancelled.
+func (s *SqsServiceImpl) DrainPolls() int {
+	return s.polls.drain()
+}
@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_DrainPolls(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("cancels in-flight polls and refuses new ones", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, polls: newPollTracker()}
+
+		started := make(chan struct{})
+		repo.EXPECT().
+			ReceiveMessages(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, _ ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error) {
+				close(started)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}).
+			Once()
+
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := service.ReceiveMessages(context.Background(), ReceiveMessagesInput{QueueURL: queueURL})
+			errCh <- err
+		}()
+
+		<-started
+		assert.Equal(t, 1, service.DrainPolls())
+
+		select {
+		case err := <-errCh:
+			assert.ErrorIs(t, err, ErrShuttingDown)
+		case <-time.After(time.Second):
+			t.Fatal("poll was not cancelled")
+		}
+
+		_, err := service.ReceiveMessages(context.Background(), ReceiveMessagesInput{QueueURL: queueURL})
+		assert.ErrorIs(t, err, ErrShuttingDown)
+	})
+
+	t.Run("reports a poll the client abandoned as its own error", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, polls: newPollTracker()}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		repo.EXPECT().
+			ReceiveMessages(mock.Anything, mock.Anything).
+			Return(nil, context.Canceled).
+			Once()
+
+		_, err := service.ReceiveMessages(ctx, ReceiveMessagesInput{QueueURL: queueURL})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrShuttingDown)
+		assert.Equal(t, 0, service.DrainPolls())
+	})
+}
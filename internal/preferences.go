@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Columns the queue list can show besides the queue name, which is always shown. They are kept
+// in the preferences, so they must not be renamed.
+const (
+	QueueColumnType       = "type"
+	QueueColumnCreated    = "created"
+	QueueColumnAvailable  = "available"
+	QueueColumnInFlight   = "in-flight"
+	QueueColumnDelayed    = "delayed"
+	QueueColumnOldestAge  = "oldest-age"
+	QueueColumnVisibility = "visibility-timeout"
+	QueueColumnEncryption = "encryption"
+	QueueColumnDedup      = "content-based-dedup"
+	QueueColumnArn        = "arn"
+	QueueColumnTags       = "tags"
+)
+
+// queueColumns lists every queue list column in the order the table shows them.
+var queueColumns = []string{
+	QueueColumnType,
+	QueueColumnCreated,
+	QueueColumnAvailable,
+	QueueColumnInFlight,
+	QueueColumnDelayed,
+	QueueColumnOldestAge,
+	QueueColumnVisibility,
+	QueueColumnEncryption,
+	QueueColumnDedup,
+	QueueColumnArn,
+	QueueColumnTags,
+}
+
+// defaultQueueColumns are shown until columns are chosen; they are the columns the table always had.
+var defaultQueueColumns = []string{
+	QueueColumnType,
+	QueueColumnCreated,
+	QueueColumnAvailable,
+	QueueColumnInFlight,
+	QueueColumnVisibility,
+	QueueColumnEncryption,
+	QueueColumnDedup,
+}
+
+// Preferences are the display choices kept in the local store.
+type Preferences struct {
+	// QueueListColumns are the queue list columns to show in table order; empty means the defaults.
+	QueueListColumns []string `json:"queueListColumns,omitempty"`
+	// FavoriteQueues are the URLs of the starred queues, which the queue list shows first.
+	FavoriteQueues []string `json:"favoriteQueues,omitempty"`
+}
+
+// maxFavoriteQueues bounds how many queues can be starred, so the favorites stay a short list.
+const maxFavoriteQueues = 50
+
+// QueueListColumns returns the columns the queue list shows, in table order.
+func (s *SqsServiceImpl) QueueListColumns(_ context.Context) ([]string, error) {
+	if s.store == nil {
+		return slices.Clone(defaultQueueColumns), nil
+	}
+
+	preferences, err := s.store.Preferences()
+	if err != nil {
+		return nil, err
+	}
+	if len(preferences.QueueListColumns) == 0 {
+		return slices.Clone(defaultQueueColumns), nil
+	}
+	return preferences.QueueListColumns, nil
+}
+
+// SaveQueueListColumns stores the columns the queue list shows and returns them in table order.
+func (s *SqsServiceImpl) SaveQueueListColumns(_ context.Context, columns []string) ([]string, error) {
+	if s.store == nil {
+		return nil, errors.New("preferences are not available without a state store")
+	}
+
+	columns, err := normalizeQueueColumns(columns)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.UpdatePreferences(func(preferences *Preferences) error {
+		preferences.QueueListColumns = columns
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+// FavoriteQueues returns the URLs of the starred queues in the order they were starred.
+func (s *SqsServiceImpl) FavoriteQueues(_ context.Context) ([]string, error) {
+	if s.store == nil {
+		return []string{}, nil
+	}
+
+	preferences, err := s.store.Preferences()
+	if err != nil {
+		return nil, err
+	}
+	return slices.Clone(preferences.FavoriteQueues), nil
+}
+
+// SetFavoriteQueue stars or unstars a queue. Starring a starred queue or unstarring one that is
+// not starred changes nothing.
+func (s *SqsServiceImpl) SetFavoriteQueue(_ context.Context, queueURL string, favorite bool) error {
+	if s.store == nil {
+		return errors.New("preferences are not available without a state store")
+	}
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return errors.New("queue url is required")
+	}
+
+	return s.store.UpdatePreferences(func(preferences *Preferences) error {
+		starred := slices.Contains(preferences.FavoriteQueues, queueURL)
+		switch {
+		case favorite && !starred:
+			if len(preferences.FavoriteQueues) >= maxFavoriteQueues {
+				return errors.Newf("at most %d queues can be favorites", maxFavoriteQueues)
+			}
+			preferences.FavoriteQueues = append(preferences.FavoriteQueues, queueURL)
+		case !favorite && starred:
+			preferences.FavoriteQueues = slices.DeleteFunc(preferences.FavoriteQueues, func(url string) bool { return url == queueURL })
+		}
+		return nil
+	})
+}
+
+// normalizeQueueColumns checks that columns are known and puts them in table order without duplicates.
+func normalizeQueueColumns(columns []string) ([]string, error) {
+	chosen := make(map[string]struct{}, len(columns))
+	for _, column := range columns {
+		column = strings.TrimSpace(column)
+		if !slices.Contains(queueColumns, column) {
+			return nil, errors.Newf("unknown queue list column %q; columns are %s", column, strings.Join(queueColumns, ", "))
+		}
+		chosen[column] = struct{}{}
+	}
+	if len(chosen) == 0 {
+		return nil, errors.New("choose at least one column")
+	}
+
+	normalized := make([]string, 0, len(chosen))
+	for _, column := range queueColumns {
+		if _, ok := chosen[column]; ok {
+			normalized = append(normalized, column)
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_QueueListColumns(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("shows the default columns until columns are chosen", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		service := &SqsServiceImpl{store: store}
+
+		columns, err := service.QueueListColumns(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, defaultQueueColumns, columns)
+	})
+
+	t.Run("saves the chosen columns in table order", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		service := &SqsServiceImpl{store: store}
+
+		saved, err := service.SaveQueueListColumns(ctx, []string{QueueColumnTags, " available ", QueueColumnArn, QueueColumnTags})
+		require.NoError(t, err)
+		assert.Equal(t, []string{QueueColumnAvailable, QueueColumnArn, QueueColumnTags}, saved)
+
+		columns, err := service.QueueListColumns(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, saved, columns)
+	})
+
+	t.Run("rejects unknown and missing columns", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		service := &SqsServiceImpl{store: store}
+
+		_, err = service.SaveQueueListColumns(ctx, []string{QueueColumnArn, "size"})
+		assert.ErrorContains(t, err, `unknown queue list column "size"`)
+
+		_, err = service.SaveQueueListColumns(ctx, nil)
+		assert.EqualError(t, err, "choose at least one column")
+
+		columns, err := service.QueueListColumns(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, defaultQueueColumns, columns)
+	})
+
+	t.Run("uses the defaults without a state store", func(t *testing.T) {
+		service := &SqsServiceImpl{}
+
+		columns, err := service.QueueListColumns(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, defaultQueueColumns, columns)
+
+		_, err = service.SaveQueueListColumns(ctx, []string{QueueColumnArn})
+		assert.EqualError(t, err, "preferences are not available without a state store")
+	})
+}
+
+func TestSqsServiceImpl_SetFavoriteQueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stars and unstars queues", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		service := &SqsServiceImpl{store: store}
+
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/orders", true))
+		require.NoError(t, service.SetFavoriteQueue(ctx, " https://sqs.local/billing ", true))
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/orders", true))
+		favorites, err := service.FavoriteQueues(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://sqs.local/orders", "https://sqs.local/billing"}, favorites)
+
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/orders", false))
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/audit", false))
+		favorites, err = service.FavoriteQueues(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://sqs.local/billing"}, favorites)
+	})
+
+	t.Run("unstarring does not change preferences read before", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		service := &SqsServiceImpl{store: store}
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/orders", true))
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/billing", true))
+
+		before, err := store.Preferences()
+		require.NoError(t, err)
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/orders", false))
+
+		assert.Equal(t, []string{"https://sqs.local/orders", "https://sqs.local/billing"}, before.FavoriteQueues)
+		after, err := store.Preferences()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://sqs.local/billing"}, after.FavoriteQueues)
+	})
+
+	t.Run("keeps concurrent changes", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		service := &SqsServiceImpl{store: store}
+
+		var wg sync.WaitGroup
+		for i := range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, service.SetFavoriteQueue(ctx, fmt.Sprintf("https://sqs.local/queue-%d", i), true))
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.SaveQueueListColumns(ctx, []string{QueueColumnArn})
+			assert.NoError(t, err)
+		}()
+		wg.Wait()
+
+		favorites, err := service.FavoriteQueues(ctx)
+		require.NoError(t, err)
+		assert.Len(t, favorites, 20)
+		columns, err := service.QueueListColumns(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{QueueColumnArn}, columns)
+	})
+
+	t.Run("limits the number of favorites", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		service := &SqsServiceImpl{store: store}
+		for i := range maxFavoriteQueues {
+			require.NoError(t, service.SetFavoriteQueue(ctx, fmt.Sprintf("https://sqs.local/queue-%d", i), true))
+		}
+
+		err = service.SetFavoriteQueue(ctx, "https://sqs.local/one-more", true)
+		assert.EqualError(t, err, "at most 50 queues can be favorites")
+	})
+
+	t.Run("needs a state store", func(t *testing.T) {
+		service := &SqsServiceImpl{}
+
+		favorites, err := service.FavoriteQueues(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, favorites)
+
+		err = service.SetFavoriteQueue(ctx, "https://sqs.local/orders", true)
+		assert.EqualError(t, err, "preferences are not available without a state store")
+	})
+}
@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultBenchmarkConcurrency  = 4
+	maxBenchmarkConcurrency      = 50
+	defaultBenchmarkPayloadBytes = 1024
+	defaultBenchmarkDuration     = 30 * time.Second
+	maxBenchmarkDuration         = 5 * time.Minute
+	// benchmarkGroupPrefix names the message groups used on FIFO queues, one per worker, so the
+	// workers do not wait on each other's ordering.
+	benchmarkGroupPrefix = "benchmark-"
+)
+
+// ProducerBenchmarkInput configures a producer benchmark. Concurrency is the number of senders
+// working at the same time and PayloadBytes the size of each message body. Zero values pick the
+// defaults.
+type ProducerBenchmarkInput struct {
+	QueueURL     string
+	Concurrency  int
+	PayloadBytes int
+	Duration     time.Duration
+}
+
+// BenchmarkProducer sends messages to a queue in the background for a fixed duration and reports
+// the achieved throughput and error rate, to see what the configured endpoint can take. Every
+// message stays in the queue; purge it afterwards.
+func (s *SqsServiceImpl) BenchmarkProducer(ctx context.Context, input ProducerBenchmarkInput) (Job, error) {
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return Job{}, errors.New("queue url is required")
+	}
+
+	concurrency := input.Concurrency
+	if concurrency == 0 {
+		concurrency = defaultBenchmarkConcurrency
+	}
+	if concurrency < 1 || concurrency > maxBenchmarkConcurrency {
+		return Job{}, errors.Newf("concurrency must be between 1 and %d", maxBenchmarkConcurrency)
+	}
+	payloadBytes := input.PayloadBytes
+	if payloadBytes == 0 {
+		payloadBytes = defaultBenchmarkPayloadBytes
+	}
+	if payloadBytes < 1 || payloadBytes > maxMessageBodyBytes {
+		return Job{}, errors.Newf("payload size must be between 1 and %d bytes", maxMessageBodyBytes)
+	}
+	duration := input.Duration
+	if duration == 0 {
+		duration = defaultBenchmarkDuration
+	}
+	if duration < time.Second || duration > maxBenchmarkDuration {
+		return Job{}, errors.Newf("duration must be between 1s and %s", maxBenchmarkDuration)
+	}
+
+	return s.jobs.start(ctx, "benchmark", queueURL, func(ctx context.Context, progress *JobProgress) error {
+		progress.SetMessage(s.benchmarkProducer(ctx, queueURL, concurrency, payloadBytes, duration, progress).String())
+		return nil
+	})
+}
+
+// benchmarkTally collects the outcome of the sends of all workers.
+type benchmarkTally struct {
+	mu           sync.Mutex
+	sent         int64
+	failed       int64
+	errors       map[string]int
+	payloadBytes int
+	elapsed      time.Duration
+}
+
+func (t *benchmarkTally) record(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err == nil {
+		t.sent++
+		return
+	}
+	t.failed++
+	if t.errors == nil {
+		t.errors = make(map[string]int)
+	}
+	t.errors[err.Error()]++
+}
+
+// String summarises the run. The most frequent error is included, since a benchmark that fails
+// usually fails for one reason such as throttling.
+func (t *benchmarkTally) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	seconds := t.elapsed.Seconds()
+	perSecond := 0.0
+	if seconds > 0 {
+		perSecond = float64(t.sent) / seconds
+	}
+	summary := fmt.Sprintf("Sent %d messages of %d bytes in %s: %.1f messages/s, %.2f MB/s.",
+		t.sent, t.payloadBytes, t.elapsed.Round(100*time.Millisecond), perSecond, perSecond*float64(t.payloadBytes)/1e6)
+
+	attempts := t.sent + t.failed
+	if t.failed == 0 || attempts == 0 {
+		return summary + " No sends failed."
+	}
+	common, count := "", 0
+	for message, n := range t.errors {
+		if n > count || (n == count && message < common) {
+			common, count = message, n
+		}
+	}
+	return summary + fmt.Sprintf(" %d of %d sends failed (%.1f%%), most often: %s",
+		t.failed, attempts, float64(t.failed)*100/float64(attempts), common)
+}
+
+func (s *SqsServiceImpl) benchmarkProducer(ctx context.Context, queueURL string, concurrency, payloadBytes int, duration time.Duration, progress *JobProgress) *benchmarkTally {
+	body := strings.Repeat("x", payloadBytes)
+	fifo := strings.HasSuffix(queueURL, ".fifo")
+	tally := &benchmarkTally{payloadBytes: payloadBytes}
+
+	start := s.now()
+	deadline := start.Add(duration)
+	var wg sync.WaitGroup
+	for worker := range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; s.now().Before(deadline) && ctx.Err() == nil; n++ {
+				send := SendMessageRepositoryInput{QueueURL: queueURL, Body: body}
+				if fifo {
+					send.MessageGroupID = benchmarkGroupPrefix + strconv.Itoa(worker)
+					send.MessageDeduplicationID = fmt.Sprintf("%d-%d-%d", start.UnixNano(), worker, n)
+				}
+				err := s.repo.SendMessage(ctx, send)
+				tally.record(err)
+				if err == nil {
+					progress.Advance(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	tally.elapsed = s.now().Sub(start)
+	return tally
+}
@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+type producerBenchmarkPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	QueueName    string
+	EscapedURL   string
+	Concurrency  string
+	PayloadBytes string
+	Duration     string
+	JobID        string
+}
+
+// ProducerBenchmarkHandler renders the form that starts a producer benchmark on a queue.
+func (h *HandlerImpl) ProducerBenchmarkHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	h.renderProducerBenchmark(w, http.StatusOK, newProducerBenchmarkPageData(queueURL))
+}
+
+// PostProducerBenchmarkHandler starts a producer benchmark. The page then follows its job.
+func (h *HandlerImpl) PostProducerBenchmarkHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	data := newProducerBenchmarkPageData(queueURL)
+	data.Concurrency = strings.TrimSpace(r.FormValue("concurrency"))
+	data.PayloadBytes = strings.TrimSpace(r.FormValue("payload_bytes"))
+	data.Duration = strings.TrimSpace(r.FormValue("duration"))
+
+	input, err := parseProducerBenchmarkForm(queueURL, data)
+	if err != nil {
+		data.ErrorMessage = err.Error()
+		h.renderProducerBenchmark(w, http.StatusBadRequest, data)
+		return
+	}
+
+	job, err := h.s.BenchmarkProducer(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start producer benchmark", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderProducerBenchmark(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.JobID = job.ID
+	h.renderProducerBenchmark(w, http.StatusOK, data)
+}
+
+func parseProducerBenchmarkForm(queueURL string, data producerBenchmarkPageData) (ProducerBenchmarkInput, error) {
+	input := ProducerBenchmarkInput{QueueURL: queueURL}
+	if data.Concurrency != "" {
+		concurrency, err := strconv.Atoi(data.Concurrency)
+		if err != nil {
+			return input, errors.New("concurrency must be a whole number")
+		}
+		input.Concurrency = concurrency
+	}
+	if data.PayloadBytes != "" {
+		payloadBytes, err := strconv.Atoi(data.PayloadBytes)
+		if err != nil {
+			return input, errors.New("payload size must be a whole number")
+		}
+		input.PayloadBytes = payloadBytes
+	}
+	if data.Duration != "" {
+		duration, err := time.ParseDuration(data.Duration)
+		if err != nil {
+			return input, errors.New("duration must look like 30s or 2m")
+		}
+		input.Duration = duration
+	}
+	return input, nil
+}
+
+func newProducerBenchmarkPageData(queueURL string) producerBenchmarkPageData {
+	return producerBenchmarkPageData{
+		Title:        "Benchmark producers",
+		ViteTags:     fragments["assets/js/producer_benchmark.ts"].Tags,
+		QueueName:    extractQueueName(queueURL),
+		EscapedURL:   url.QueryEscape(queueURL),
+		Concurrency:  strconv.Itoa(defaultBenchmarkConcurrency),
+		PayloadBytes: strconv.Itoa(defaultBenchmarkPayloadBytes),
+		Duration:     defaultBenchmarkDuration.String(),
+	}
+}
+
+func (h *HandlerImpl) renderProducerBenchmark(w http.ResponseWriter, status int, data producerBenchmarkPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["producer-benchmark"].Execute(w, data); err != nil {
+		slog.Error("failed to render producer-benchmark template", slog.Any("error", err))
+	}
+}
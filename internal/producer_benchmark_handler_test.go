@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_PostProducerBenchmarkHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/benchmark", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("starts the benchmark and follows its job", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured producerBenchmarkPageData
+		captureTemplate(t, "producer-benchmark", func(data producerBenchmarkPageData) { captured = data })
+		installFragment(t, "assets/js/producer_benchmark.ts", "")
+
+		mockService.EXPECT().
+			BenchmarkProducer(mock.Anything, ProducerBenchmarkInput{
+				QueueURL:     queueURL,
+				Concurrency:  8,
+				PayloadBytes: 2048,
+				Duration:     time.Minute,
+			}).
+			Return(Job{ID: "job-1"}, nil).
+			Once()
+
+		handler.PostProducerBenchmarkHandler(rr, newRequest(url.Values{
+			"concurrency":   {"8"},
+			"payload_bytes": {"2048"},
+			"duration":      {"1m"},
+		}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "job-1", captured.JobID)
+	})
+
+	t.Run("shows service errors", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured producerBenchmarkPageData
+		captureTemplate(t, "producer-benchmark", func(data producerBenchmarkPageData) { captured = data })
+		installFragment(t, "assets/js/producer_benchmark.ts", "")
+
+		mockService.EXPECT().
+			BenchmarkProducer(mock.Anything, mock.Anything).
+			Return(Job{}, assert.AnError).
+			Once()
+
+		handler.PostProducerBenchmarkHandler(rr, newRequest(url.Values{"concurrency": {"80"}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "80", captured.Concurrency)
+		assert.Equal(t, assert.AnError.Error(), captured.ErrorMessage)
+	})
+}
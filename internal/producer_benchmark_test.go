@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_BenchmarkProducer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports throughput and the most common error", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		service := &SqsServiceImpl{repo: repo, jobs: newJobRegistry(), clock: func() time.Time { return now }}
+		queueURL := "https://sqs.local/000000000000/orders.fifo"
+
+		// Each send takes half a second on the fake clock, so four fit into two seconds.
+		sends := 0
+		repo.EXPECT().
+			SendMessage(mock.Anything, mock.MatchedBy(func(input SendMessageRepositoryInput) bool {
+				return input.QueueURL == queueURL && len(input.Body) == 100 &&
+					input.MessageGroupID == "benchmark-0" && strings.HasSuffix(input.MessageDeduplicationID, fmt.Sprintf("-0-%d", sends))
+			})).
+			RunAndReturn(func(context.Context, SendMessageRepositoryInput) error {
+				sends++
+				now = now.Add(500 * time.Millisecond)
+				if sends == 2 {
+					return errors.New("throttled")
+				}
+				return nil
+			}).
+			Times(4)
+
+		job, err := service.BenchmarkProducer(ctx, ProducerBenchmarkInput{
+			QueueURL:     queueURL,
+			Concurrency:  1,
+			PayloadBytes: 100,
+			Duration:     2 * time.Second,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "benchmark", job.Kind)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(3), job.Done)
+		assert.Equal(t, "Sent 3 messages of 100 bytes in 2s: 1.5 messages/s, 0.00 MB/s. 1 of 4 sends failed (25.0%), most often: throttled", job.Message)
+	})
+
+	t.Run("validates the input", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t), jobs: newJobRegistry()}
+		queueURL := "https://sqs.local/000000000000/orders"
+
+		_, err := service.BenchmarkProducer(ctx, ProducerBenchmarkInput{QueueURL: queueURL, Concurrency: 51})
+		require.EqualError(t, err, "concurrency must be between 1 and 50")
+
+		_, err = service.BenchmarkProducer(ctx, ProducerBenchmarkInput{QueueURL: queueURL, PayloadBytes: maxMessageBodyBytes + 1})
+		require.EqualError(t, err, "payload size must be between 1 and 262144 bytes")
+
+		_, err = service.BenchmarkProducer(ctx, ProducerBenchmarkInput{QueueURL: queueURL, Duration: time.Hour})
+		require.EqualError(t, err, "duration must be between 1s and 5m0s")
+	})
+}
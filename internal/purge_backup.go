@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// StartPurgeWithBackup drains a queue to a file as a drain to file does and only then purges it,
+// so a purge by mistake can be undone by restoring the file, which is offered as the job download.
+// The queue is left alone when the drain fails or the file fills up. The purge still removes the
+// messages that were in flight or delayed while the drain ran, which are not in the file; the job
+// reports how many SQS counted. Queues the queue policy protects are refused before the drain, which
+// would otherwise empty them even though the purge is refused.
+func (s *SqsServiceImpl) StartPurgeWithBackup(ctx context.Context, queueURL string) (Job, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return Job{}, errors.New("queue url is required")
+	}
+	if err := s.checkBulkDelete(queueURL); err != nil {
+		return Job{}, err
+	}
+
+	return s.jobs.start(ctx, "purge-with-backup", queueURL, func(ctx context.Context, progress *JobProgress) error {
+		if stats, err := s.repo.GetQueueStats(ctx, queueURL); err == nil {
+			progress.SetTotal(stats.MessagesAvailable)
+		}
+
+		file, err := os.CreateTemp("", "sqs-gui-drain-*.ndjson")
+		if err != nil {
+			return errors.Wrap(err, "failed to create the archive")
+		}
+		progress.SetFile(file.Name())
+
+		drained, drainErr := s.drainToFile(ctx, queueURL, file, progress)
+		if err := file.Close(); err != nil && drainErr == nil {
+			drainErr = errors.Wrap(err, "failed to close the archive")
+		}
+		if drainErr != nil {
+			return errors.Wrap(drainErr, "the queue was not purged")
+		}
+		if drained == maxDrainToFileMessages {
+			return errors.Newf("the archive is full at %d messages and the queue was not purged", maxDrainToFileMessages)
+		}
+
+		summary := fmt.Sprintf("Archived %d messages.", drained)
+		var unarchived int64
+		if stats, err := s.repo.GetQueueStats(ctx, queueURL); err == nil {
+			unarchived = stats.MessagesInFlight + stats.MessagesDelayed
+		}
+		if err := s.purgeWithRetry(ctx, queueURL, progress); err != nil {
+			return errors.Wrapf(err, "%s The purge failed", summary)
+		}
+		summary += " Purge requested."
+		if unarchived > 0 {
+			summary += fmt.Sprintf(" About %d messages in flight or delayed were purged without being archived.", unarchived)
+		}
+		progress.SetMessage(summary)
+		return nil
+	})
+}
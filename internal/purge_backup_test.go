@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_StartPurgeWithBackup(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository) {
+		t.Setenv("TMPDIR", t.TempDir())
+		repo := NewMockSqsRepository(t)
+		repo.EXPECT().GetQueueStats(mock.Anything, queueURL).Return(QueueStats{MessagesAvailable: 1}, nil).Once()
+		return &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}, repo
+	}
+
+	t.Run("archives every message before purging", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "m-1", Body: "one", ReceiptHandle: "r-1", ReceiveCount: 1},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-1", Bulk: true}).Return(nil).Once()
+		repo.EXPECT().GetQueueStats(mock.Anything, queueURL).Return(QueueStats{MessagesInFlight: 2, MessagesDelayed: 1}, nil).Once()
+		repo.EXPECT().PurgeQueue(mock.Anything, queueURL).Return(nil).Once()
+
+		job, err := service.StartPurgeWithBackup(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, "purge-with-backup", job.Kind)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, "Archived 1 messages. Purge requested. About 3 messages in flight or delayed were purged without being archived.", job.Message)
+
+		content, err := os.ReadFile(job.File)
+		require.NoError(t, err)
+		assert.Equal(t, `{"messageId":"m-1","body":"one","receiveCount":1}`+"\n", string(content))
+	})
+
+	t.Run("leaves the queue alone when the drain fails", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return(nil, errors.New("throttled")).Once()
+
+		job, err := service.StartPurgeWithBackup(ctx, queueURL)
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusFailed, job.Status)
+		assert.Equal(t, "the queue was not purged: Drained 0 messages to the file.: throttled", job.Error)
+		repo.AssertNotCalled(t, "PurgeQueue", mock.Anything, mock.Anything)
+	})
+
+	t.Run("refuses a protected queue before draining it", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{
+			repo:   repo,
+			jobs:   newJobRegistry(),
+			config: ServiceConfig{QueuePolicy: QueuePolicy{Protect: []string{"orders"}}},
+		}
+
+		_, err := service.StartPurgeWithBackup(ctx, queueURL)
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		service.jobs.wg.Wait()
+		repo.AssertNotCalled(t, "DeleteMessage", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,289 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrInvalidAccessPolicy is returned when a queue access policy has findings of severity error,
+// so it is not sent to SQS.
+var ErrInvalidAccessPolicy = errors.New("the access policy has errors")
+
+// Severities of a PolicyFinding.
+const (
+	PolicyFindingError   = "error"
+	PolicyFindingWarning = "warning"
+)
+
+// PolicyFinding is a problem found in a queue access policy. Statement is the 1-based position
+// of the statement it concerns, or 0 for the policy as a whole.
+type PolicyFinding struct {
+	Severity  string `json:"severity"`
+	Statement int    `json:"statement,omitempty"`
+	Message   string `json:"message"`
+}
+
+// QueueAccessPolicy is the Policy attribute of a queue, indented for editing, with the findings
+// of linting it.
+type QueueAccessPolicy struct {
+	QueueName string
+	QueueArn  string
+	Policy    string
+	Findings  []PolicyFinding
+}
+
+var (
+	policyElements    = []string{"Id", "Statement", "Version"}
+	statementElements = []string{"Action", "Condition", "Effect", "NotAction", "NotPrincipal", "NotResource", "Principal", "Resource", "Sid"}
+)
+
+// QueueAccessPolicy reads the access policy of a queue. A queue without one has an empty Policy.
+func (s *SqsServiceImpl) QueueAccessPolicy(ctx context.Context, queueURL string) (QueueAccessPolicy, error) {
+	if strings.TrimSpace(queueURL) == "" {
+		return QueueAccessPolicy{}, errors.New("queue url is required")
+	}
+	detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+	if err != nil {
+		return QueueAccessPolicy{}, err
+	}
+
+	policy := QueueAccessPolicy{QueueName: detail.Name, QueueArn: detail.Arn}
+	if raw := detail.Attributes["Policy"]; raw != "" {
+		policy.Policy = indentAccessPolicy(raw)
+		policy.Findings = lintAccessPolicy(raw, detail.Arn)
+	}
+	return policy, nil
+}
+
+// SetQueueAccessPolicy lints policy and stores it as the access policy of a queue, or removes
+// the access policy when policy is blank. A policy with errors is not stored and
+// ErrInvalidAccessPolicy is returned with the findings; the warnings of a stored policy are
+// returned with a nil error.
+func (s *SqsServiceImpl) SetQueueAccessPolicy(ctx context.Context, queueURL, policy string) ([]PolicyFinding, error) {
+	if strings.TrimSpace(queueURL) == "" {
+		return nil, errors.New("queue url is required")
+	}
+	policy = strings.TrimSpace(policy)
+	if policy == "" {
+		return nil, s.repo.SetQueueAttributes(ctx, queueURL, map[string]string{"Policy": ""})
+	}
+
+	detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+	if err != nil {
+		return nil, err
+	}
+	findings := lintAccessPolicy(policy, detail.Arn)
+	for _, finding := range findings {
+		if finding.Severity == PolicyFindingError {
+			return findings, ErrInvalidAccessPolicy
+		}
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, []byte(policy)); err != nil {
+		return findings, errors.Wrap(err, "failed to compact access policy")
+	}
+	if err := s.repo.SetQueueAttributes(ctx, queueURL, map[string]string{"Policy": compact.String()}); err != nil {
+		return findings, err
+	}
+	return findings, nil
+}
+
+// lintAccessPolicy checks a queue access policy against the IAM policy grammar and the SQS
+// rules for queue policies, and flags statements that open the queue wider than intended.
+// queueArn is the ARN of the queue the policy is for; Resource entries not matching it are
+// flagged.
+func lintAccessPolicy(policy, queueArn string) []PolicyFinding {
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return []PolicyFinding{policyError(0, fmt.Sprintf("The policy is not valid JSON: %v.", err))}
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return []PolicyFinding{policyError(0, "The policy has content after its closing brace.")}
+	}
+	root, ok := document.(map[string]any)
+	if !ok {
+		return []PolicyFinding{policyError(0, "The policy must be a JSON object.")}
+	}
+
+	var findings []PolicyFinding
+	findings = append(findings, unknownElements(0, root, policyElements, "policy")...)
+
+	switch version := root["Version"]; version {
+	case nil:
+		findings = append(findings, policyWarning(0, `Version is missing; add "Version": "2012-10-17" so policy variables work.`))
+	case "2012-10-17":
+	case "2008-10-17":
+		findings = append(findings, policyWarning(0, `Version 2008-10-17 does not support policy variables; use 2012-10-17.`))
+	default:
+		findings = append(findings, policyError(0, fmt.Sprintf("Version must be 2012-10-17 or 2008-10-17, not %v.", version)))
+	}
+
+	var statements []any
+	switch value := root["Statement"].(type) {
+	case nil:
+		return append(findings, policyError(0, "Statement is required."))
+	case []any:
+		statements = value
+	case map[string]any:
+		statements = []any{value}
+	default:
+		return append(findings, policyError(0, "Statement must be an object or a list of objects."))
+	}
+	if len(statements) == 0 {
+		findings = append(findings, policyError(0, "Statement must contain at least one statement."))
+	}
+
+	sids := make(map[string]int)
+	for i, raw := range statements {
+		n := i + 1
+		statement, ok := raw.(map[string]any)
+		if !ok {
+			findings = append(findings, policyError(n, "The statement must be a JSON object."))
+			continue
+		}
+		findings = append(findings, unknownElements(n, statement, statementElements, "statement")...)
+
+		if sid, ok := statement["Sid"].(string); ok && sid != "" {
+			if first, seen := sids[sid]; seen {
+				findings = append(findings, policyError(n, fmt.Sprintf("Sid %q is already used by statement %d.", sid, first)))
+			} else {
+				sids[sid] = n
+			}
+		}
+
+		effect := statement["Effect"]
+		if effect != "Allow" && effect != "Deny" {
+			findings = append(findings, policyError(n, `Effect must be "Allow" or "Deny".`))
+		}
+
+		principal, hasPrincipal := statement["Principal"]
+		_, hasNotPrincipal := statement["NotPrincipal"]
+		if !hasPrincipal && !hasNotPrincipal {
+			findings = append(findings, policyError(n, "A queue policy statement needs a Principal."))
+		}
+
+		actionKey := "Action"
+		if _, ok := statement["Action"]; !ok {
+			actionKey = "NotAction"
+		}
+		actions, err := policyStrings(statement[actionKey])
+		switch {
+		case statement[actionKey] == nil:
+			findings = append(findings, policyError(n, "Action or NotAction is required."))
+		case err != nil:
+			findings = append(findings, policyError(n, fmt.Sprintf("%s %v.", actionKey, err)))
+		}
+		broadAction := false
+		for _, action := range actions {
+			lower := strings.ToLower(action)
+			if action != "*" && !strings.HasPrefix(lower, "sqs:") {
+				findings = append(findings, policyError(n, fmt.Sprintf("%q is not an SQS action; queue policies only grant sqs: actions.", action)))
+			}
+			if action == "*" || lower == "sqs:*" {
+				broadAction = true
+			}
+		}
+
+		_, hasCondition := statement["Condition"]
+		if effect == "Allow" && hasPrincipal && isPublicPrincipal(principal) && !hasCondition {
+			findings = append(findings, policyWarning(n, "The statement allows anyone without a Condition, which makes the queue public."))
+		}
+		if effect == "Allow" && actionKey == "Action" && broadAction {
+			findings = append(findings, policyWarning(n, "The statement allows every SQS action; list only the actions needed."))
+		}
+
+		resources, err := policyStrings(statement["Resource"])
+		if err != nil {
+			findings = append(findings, policyError(n, fmt.Sprintf("Resource %v.", err)))
+		}
+		for _, resource := range resources {
+			if queueArn == "" || resource == "*" {
+				continue
+			}
+			if matched, _ := path.Match(resource, queueArn); !matched {
+				findings = append(findings, policyWarning(n, fmt.Sprintf("Resource %q does not match this queue (%s), so the statement has no effect on it.", resource, queueArn)))
+			}
+		}
+	}
+	return findings
+}
+
+// unknownElements reports the keys of element that are not in known, in name order.
+func unknownElements(statement int, element map[string]any, known []string, kind string) []PolicyFinding {
+	var unknown []string
+	for key := range element {
+		if !slices.Contains(known, key) {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+
+	findings := make([]PolicyFinding, 0, len(unknown))
+	for _, key := range unknown {
+		findings = append(findings, policyError(statement, fmt.Sprintf("%q is not a %s element.", key, kind)))
+	}
+	return findings
+}
+
+// policyStrings reads an element that holds a string or a list of strings.
+func policyStrings(value any) ([]string, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{value}, nil
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			text, ok := item.(string)
+			if !ok {
+				return nil, errors.New("must only list strings")
+			}
+			values = append(values, text)
+		}
+		return values, nil
+	}
+	return nil, errors.New("must be a string or a list of strings")
+}
+
+// isPublicPrincipal reports whether principal is "*" or names "*" as its AWS principal.
+func isPublicPrincipal(principal any) bool {
+	if principal == "*" {
+		return true
+	}
+	object, ok := principal.(map[string]any)
+	if !ok {
+		return false
+	}
+	principals, _ := policyStrings(object["AWS"])
+	return slices.Contains(principals, "*")
+}
+
+func policyError(statement int, message string) PolicyFinding {
+	return PolicyFinding{Severity: PolicyFindingError, Statement: statement, Message: message}
+}
+
+func policyWarning(statement int, message string) PolicyFinding {
+	return PolicyFinding{Severity: PolicyFindingWarning, Statement: statement, Message: message}
+}
+
+// indentAccessPolicy formats a stored policy for editing, leaving it as it is when it is not JSON.
+func indentAccessPolicy(policy string) string {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(policy), "", "  "); err != nil {
+		return policy
+	}
+	return indented.String()
+}
@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+type accessPolicyPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	QueueName    string
+	QueueArn     string
+	EscapedURL   string
+	Policy       string
+	Findings     []PolicyFinding
+	FlashMessage string
+	ErrorMessage string
+}
+
+// AccessPolicyHandler renders the editor for the access policy of a queue, with the findings of
+// linting the stored policy.
+func (h *HandlerImpl) AccessPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	policy, err := h.s.QueueAccessPolicy(r.Context(), queueURL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load access policy", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeServiceError(w, err, "failed to load access policy", http.StatusInternalServerError)
+		return
+	}
+
+	data := newAccessPolicyPageData(queueURL, policy)
+	switch {
+	case r.URL.Query().Get("saved") == "1":
+		data.FlashMessage = "The access policy was saved."
+	case r.URL.Query().Get("removed") == "1":
+		data.FlashMessage = "The access policy was removed."
+	}
+	h.renderAccessPolicy(w, http.StatusOK, data)
+}
+
+// PostAccessPolicyHandler saves the edited access policy, or removes it when the form is
+// submitted with action=remove. A policy with errors is shown again with its findings.
+func (h *HandlerImpl) PostAccessPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	policy := r.PostForm.Get("policy")
+	outcome := "saved"
+	if r.PostForm.Get("action") == "remove" {
+		policy, outcome = "", "removed"
+	}
+
+	findings, err := h.s.SetQueueAccessPolicy(r.Context(), queueURL, policy)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to set access policy", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data := newAccessPolicyPageData(queueURL, QueueAccessPolicy{QueueName: extractQueueName(queueURL), Policy: policy, Findings: findings})
+		data.ErrorMessage = err.Error()
+		h.renderAccessPolicy(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	redirectURL := fmt.Sprintf("/queues/%s/access-policy?%s=1", url.QueryEscape(queueURL), outcome)
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+func newAccessPolicyPageData(queueURL string, policy QueueAccessPolicy) accessPolicyPageData {
+	return accessPolicyPageData{
+		Title:      "Access policy",
+		ViteTags:   fragments["assets/js/access_policy.ts"].Tags,
+		QueueName:  policy.QueueName,
+		QueueArn:   policy.QueueArn,
+		EscapedURL: url.QueryEscape(queueURL),
+		Policy:     policy.Policy,
+		Findings:   policy.Findings,
+	}
+}
+
+func (h *HandlerImpl) renderAccessPolicy(w http.ResponseWriter, status int, data accessPolicyPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["access-policy"].Execute(w, data); err != nil {
+		slog.Error("failed to render access-policy template", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func captureAccessPolicyTemplate(t *testing.T, captured *accessPolicyPageData) {
+	t.Helper()
+	captureTemplate(t, "access-policy", func(data accessPolicyPageData) { *captured = data })
+}
+
+func TestHandlerImpl_AccessPolicyHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+	queueURL := "https://sqs.local/000000000000/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	var captured accessPolicyPageData
+	captureAccessPolicyTemplate(t, &captured)
+	installFragment(t, "assets/js/access_policy.ts", "")
+
+	findings := []PolicyFinding{policyWarning(0, "Version is missing.")}
+	mockService.EXPECT().QueueAccessPolicy(mock.Anything, queueURL).
+		Return(QueueAccessPolicy{QueueName: "orders", QueueArn: testQueueArn, Policy: "{}", Findings: findings}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/access-policy?saved=1", nil)
+	req.SetPathValue("url", escaped)
+	rr := httptest.NewRecorder()
+	handler.AccessPolicyHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "orders", captured.QueueName)
+	assert.Equal(t, escaped, captured.EscapedURL)
+	assert.Equal(t, "{}", captured.Policy)
+	assert.Equal(t, findings, captured.Findings)
+	assert.Equal(t, "The access policy was saved.", captured.FlashMessage)
+}
+
+func TestHandlerImpl_PostAccessPolicyHandler(t *testing.T) {
+	queueURL := "https://sqs.local/000000000000/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/access-policy", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("saves and redirects to the editor", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().SetQueueAccessPolicy(mock.Anything, queueURL, `{"Statement":[]}`).Return(nil, nil).Once()
+
+		handler.PostAccessPolicyHandler(rr, newRequest(url.Values{"policy": {`{"Statement":[]}`}}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues/"+escaped+"/access-policy?saved=1", rr.Header().Get("Location"))
+	})
+
+	t.Run("removes the policy", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().SetQueueAccessPolicy(mock.Anything, queueURL, "").Return(nil, nil).Once()
+
+		handler.PostAccessPolicyHandler(rr, newRequest(url.Values{"policy": {`{"Statement":[]}`}, "action": {"remove"}}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues/"+escaped+"/access-policy?removed=1", rr.Header().Get("Location"))
+	})
+
+	t.Run("shows the findings of a rejected policy", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured accessPolicyPageData
+		captureAccessPolicyTemplate(t, &captured)
+		installFragment(t, "assets/js/access_policy.ts", "")
+		findings := []PolicyFinding{policyError(0, "Statement is required.")}
+		mockService.EXPECT().SetQueueAccessPolicy(mock.Anything, queueURL, `{"Version":"2012-10-17"}`).Return(findings, ErrInvalidAccessPolicy).Once()
+
+		handler.PostAccessPolicyHandler(rr, newRequest(url.Values{"policy": {`{"Version":"2012-10-17"}`}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "the access policy has errors", captured.ErrorMessage)
+		assert.Equal(t, `{"Version":"2012-10-17"}`, captured.Policy, "the submitted policy is kept for fixing")
+		assert.Equal(t, findings, captured.Findings)
+		assert.Equal(t, "orders", captured.QueueName)
+	})
+}
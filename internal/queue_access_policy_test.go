@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testQueueArn = "arn:aws:sqs:us-east-1:000000000000:orders"
+
+func TestLintAccessPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		want   []PolicyFinding
+	}{
+		{
+			name: "accepts a scoped policy",
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"sns","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},
+				"Action":"sqs:SendMessage","Resource":"arn:aws:sqs:us-east-1:000000000000:orders",
+				"Condition":{"ArnEquals":{"aws:SourceArn":"arn:aws:sns:us-east-1:000000000000:events"}}}]}`,
+		},
+		{
+			name:   "rejects invalid JSON",
+			policy: `{"Version":"2012-10-17",}`,
+			want:   []PolicyFinding{policyError(0, "The policy is not valid JSON: invalid character '}' looking for beginning of object key string.")},
+		},
+		{
+			name:   "rejects content after the policy",
+			policy: `{"Statement":[]} {}`,
+			want:   []PolicyFinding{policyError(0, "The policy has content after its closing brace.")},
+		},
+		{
+			name:   "requires statements",
+			policy: `{"Version":"2012-10-17","Statment":[]}`,
+			want: []PolicyFinding{
+				policyError(0, `"Statment" is not a policy element.`),
+				policyError(0, "Statement is required."),
+			},
+		},
+		{
+			name: "checks each statement",
+			policy: `{"Version":"2012-10-17","Statement":[
+				{"Sid":"a","Effect":"allow","Principal":{"AWS":"111122223333"},"Action":["sqs:SendMessage","s3:GetObject"]},
+				{"Sid":"a","Effect":"Deny","Action":"sqs:DeleteQueue","Resources":"*"}]}`,
+			want: []PolicyFinding{
+				policyError(1, `Effect must be "Allow" or "Deny".`),
+				policyError(1, `"s3:GetObject" is not an SQS action; queue policies only grant sqs: actions.`),
+				policyError(2, `"Resources" is not a statement element.`),
+				policyError(2, `Sid "a" is already used by statement 1.`),
+				policyError(2, "A queue policy statement needs a Principal."),
+			},
+		},
+		{
+			name: "warns about open and mismatched statements",
+			policy: `{"Statement":{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":"sqs:*",
+				"Resource":"arn:aws:sqs:us-east-1:000000000000:billing"}}`,
+			want: []PolicyFinding{
+				policyWarning(0, `Version is missing; add "Version": "2012-10-17" so policy variables work.`),
+				policyWarning(1, "The statement allows anyone without a Condition, which makes the queue public."),
+				policyWarning(1, "The statement allows every SQS action; list only the actions needed."),
+				policyWarning(1, `Resource "arn:aws:sqs:us-east-1:000000000000:billing" does not match this queue (arn:aws:sqs:us-east-1:000000000000:orders), so the statement has no effect on it.`),
+			},
+		},
+		{
+			name:   "matches resource wildcards against the queue",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"sqs:*","Resource":"arn:aws:sqs:*:000000000000:ord*"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, lintAccessPolicy(tt.policy, testQueueArn))
+		})
+	}
+}
+
+func TestSqsServiceImpl_SetQueueAccessPolicy(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+	detail := QueueDetail{QueueSummary: QueueSummary{URL: queueURL, Name: "orders", Arn: testQueueArn}}
+
+	t.Run("stores the compacted policy and returns its warnings", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(detail, nil).Once()
+		repo.EXPECT().SetQueueAttributes(mock.Anything, queueURL, map[string]string{
+			"Policy": `{"Statement":[{"Effect":"Deny","Principal":"*","Action":"sqs:DeleteQueue"}]}`,
+		}).Return(nil).Once()
+
+		findings, err := service.SetQueueAccessPolicy(ctx, queueURL, "{\n  \"Statement\": [{\"Effect\": \"Deny\", \"Principal\": \"*\", \"Action\": \"sqs:DeleteQueue\"}]\n}\n")
+		require.NoError(t, err)
+		assert.Equal(t, []PolicyFinding{policyWarning(0, `Version is missing; add "Version": "2012-10-17" so policy variables work.`)}, findings)
+	})
+
+	t.Run("does not store a policy with errors", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(detail, nil).Once()
+
+		findings, err := service.SetQueueAccessPolicy(ctx, queueURL, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage"}]}`)
+		assert.ErrorIs(t, err, ErrInvalidAccessPolicy)
+		assert.Equal(t, []PolicyFinding{policyError(1, "A queue policy statement needs a Principal.")}, findings)
+	})
+
+	t.Run("removes the policy when blank", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().SetQueueAttributes(mock.Anything, queueURL, map[string]string{"Policy": ""}).Return(nil).Once()
+
+		findings, err := service.SetQueueAccessPolicy(ctx, queueURL, "  ")
+		require.NoError(t, err)
+		assert.Empty(t, findings)
+	})
+}
+
+func TestSqsServiceImpl_QueueAccessPolicy(t *testing.T) {
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo}
+	queueURL := "https://sqs.local/000000000000/orders"
+	repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{
+		QueueSummary: QueueSummary{URL: queueURL, Name: "orders", Arn: testQueueArn},
+		Attributes:   map[string]string{"Policy": `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"sqs:DeleteQueue"}]}`},
+	}, nil).Once()
+
+	policy, err := service.QueueAccessPolicy(context.Background(), queueURL)
+	require.NoError(t, err)
+	assert.Equal(t, "orders", policy.QueueName)
+	assert.Equal(t, testQueueArn, policy.QueueArn)
+	assert.Equal(t, `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "sqs:DeleteQueue"
+    }
+  ]
+}`, policy.Policy)
+	assert.Empty(t, policy.Findings)
+}
@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// defaultAnalysisSamples is how many messages an analysis receives when no sample size is given.
+	defaultAnalysisSamples = 50
+	// maxAnalysisSamples bounds how many messages a single analysis may receive.
+	maxAnalysisSamples = 500
+	// maxIdleSampleBatches stops sampling after this many receives in a row return nothing new.
+	maxIdleSampleBatches = 3
+	// sampleVisibility hides sampled messages while the sample is taken, so each receive moves on to
+	// other messages. They are made visible again right after; the timeout only matters if
+	// restoring them fails.
+	sampleVisibility int32 = 60
+	// nearLimitBytes flags messages at 80% or more of the SQS size limit.
+	nearLimitBytes = maxMessageBodyBytes * 8 / 10
+)
+
+// systemMessageAttributes are the attributes SQS adds to received messages.
+// They do not count towards the message size, so analysis ignores them.
+var systemMessageAttributes = map[string]bool{
+	"ApproximateReceiveCount":          true,
+	"ApproximateFirstReceiveTimestamp": true,
+	"SentTimestamp":                    true,
+	"MessageGroupId":                   true,
+	"MessageDeduplicationId":           true,
+	"SequenceNumber":                   true,
+	"DeadLetterQueueSourceArn":         true,
+}
+
+// MessageSizeReport summarises the sizes of messages sampled from a queue.
+type MessageSizeReport struct {
+	QueueURL  string
+	Requested int
+	Sampled   int
+	// BodyBytes covers message bodies only; TotalBytes adds attribute names and values,
+	// which is what SQS checks against the 256 KB limit.
+	BodyBytes  SizePercentiles
+	TotalBytes SizePercentiles
+	// NearLimit counts messages whose total size is at least 80% of the limit.
+	NearLimit       int
+	LargestID       string
+	AttributeCounts []AttributeCountBucket
+}
+
+// SizePercentiles are nearest-rank percentiles over sampled sizes, in bytes.
+type SizePercentiles struct {
+	Min int
+	P50 int
+	P90 int
+	P99 int
+	Max int
+}
+
+// AttributeCountBucket is the number of sampled messages carrying a given number of custom attributes.
+type AttributeCountBucket struct {
+	Attributes int
+	Messages   int
+}
+
+// AnalyzeMessageSizes samples up to samples messages from queueURL and reports their size distribution.
+func (s *SqsServiceImpl) AnalyzeMessageSizes(ctx context.Context, queueURL string, samples int) (MessageSizeReport, error) {
+	messages, requested, err := s.sampleMessages(ctx, queueURL, samples)
+	if err != nil {
+		return MessageSizeReport{}, err
+	}
+
+	report := MessageSizeReport{QueueURL: queueURL, Requested: requested, Sampled: len(messages)}
+	if len(messages) == 0 {
+		return report, nil
+	}
+
+	bodySizes := make([]int, 0, len(messages))
+	totalSizes := make([]int, 0, len(messages))
+	attributeCounts := make(map[int]int)
+	largest := -1
+	for _, message := range messages {
+		total := len(message.Body)
+		count := 0
+		for _, attr := range message.Attributes {
+			if systemMessageAttributes[attr.Name] {
+				continue
+			}
+			total += len(attr.Name) + len(attr.Value)
+			count++
+		}
+
+		bodySizes = append(bodySizes, len(message.Body))
+		totalSizes = append(totalSizes, total)
+		attributeCounts[count]++
+		if total >= nearLimitBytes {
+			report.NearLimit++
+		}
+		if total > largest {
+			largest = total
+			report.LargestID = message.ID
+		}
+	}
+
+	report.BodyBytes = sizePercentiles(bodySizes)
+	report.TotalBytes = sizePercentiles(totalSizes)
+	for attributes, count := range attributeCounts {
+		report.AttributeCounts = append(report.AttributeCounts, AttributeCountBucket{Attributes: attributes, Messages: count})
+	}
+	slices.SortFunc(report.AttributeCounts, func(a, b AttributeCountBucket) int {
+		return a.Attributes - b.Attributes
+	})
+
+	return report, nil
+}
+
+// sampleMessages receives distinct messages from queueURL until samples are collected or the queue stops
+// returning new ones, then makes them visible again, so a sample does not keep messages from
+// consumers for the queue's visibility timeout. Their receive counts still go up. It returns the
+// clamped sample size that was requested.
+func (s *SqsServiceImpl) sampleMessages(ctx context.Context, queueURL string, samples int) (messages []ReceivedMessage, requested int, err error) {
+	if strings.TrimSpace(queueURL) == "" {
+		return nil, 0, errors.New("queue url is required")
+	}
+	if samples <= 0 {
+		samples = defaultAnalysisSamples
+	}
+	samples = min(samples, maxAnalysisSamples)
+
+	handles := make(map[string]string, samples)
+	defer func() {
+		// Restore even when sampling failed or the request went away, or the messages would stay hidden.
+		if failed := s.restoreVisibility(context.WithoutCancel(ctx), queueURL, handles); failed > 0 && err == nil {
+			messages = nil
+			err = errors.Newf("%d of %d sampled messages could not be made visible again and reappear after %d seconds", failed, len(handles), sampleVisibility)
+		}
+	}()
+
+	messages = make([]ReceivedMessage, 0, samples)
+	idle := 0
+	for len(messages) < samples && idle < maxIdleSampleBatches {
+		batch, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+			QueueURL:          queueURL,
+			MaxMessages:       int32(min(samples-len(messages), 10)),
+			VisibilityTimeout: sampleVisibility,
+		})
+		if err != nil {
+			return nil, samples, err
+		}
+
+		added := 0
+		for _, message := range batch {
+			if _, ok := handles[message.ID]; ok {
+				continue
+			}
+			// Extra messages are released too.
+			handles[message.ID] = message.ReceiptHandle
+			if len(messages) == samples {
+				continue
+			}
+			messages = append(messages, message)
+			added++
+		}
+		if added == 0 {
+			idle++
+		} else {
+			idle = 0
+		}
+	}
+
+	return messages, samples, nil
+}
+
+// sizePercentiles computes nearest-rank percentiles; sizes is sorted in place.
+func sizePercentiles(sizes []int) SizePercentiles {
+	if len(sizes) == 0 {
+		return SizePercentiles{}
+	}
+	slices.Sort(sizes)
+	rank := func(p int) int {
+		index := (p*len(sizes)+99)/100 - 1
+		return sizes[max(index, 0)]
+	}
+	return SizePercentiles{
+		Min: sizes[0],
+		P50: rank(50),
+		P90: rank(90),
+		P99: rank(99),
+		Max: sizes[len(sizes)-1],
+	}
+}
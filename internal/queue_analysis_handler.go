@@ -0,0 +1,356 @@
+package internal
+
+import (
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxFieldValueDisplay is how many characters of a common field value the page shows.
+const maxFieldValueDisplay = 80
+
+type queueAnalysisPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	QueueName    string
+	EscapedURL   string
+	Samples      string
+	Report       string
+	Reports      []selectOption
+	Attribute    string
+	Sizes        *messageSizeView
+	Fields       *messageFieldView
+	Attributes   *AttributeCountReport
+	Duplicates   *duplicateMessageView
+	Groups       *messageGroupView
+	JobID        string
+}
+
+type messageSizeView struct {
+	Requested       int
+	Sampled         int
+	Rows            []sizePercentileRow
+	NearLimit       int
+	LargestID       string
+	LargestOfLimit  string
+	AttributeCounts []AttributeCountBucket
+}
+
+type messageFieldView struct {
+	Requested    int
+	Sampled      int
+	JSONMessages int
+	MoreFields   int
+	Fields       []messageFieldRow
+}
+
+type duplicateMessageView struct {
+	Requested  int
+	Sampled    int
+	Duplicates int
+	Groups     []duplicateBodyRow
+}
+
+type duplicateBodyRow struct {
+	BodyHash   string
+	Body       string
+	MessageIDs []string
+}
+
+type messageGroupView struct {
+	Requested        int
+	Sampled          int
+	MessagesInFlight int64
+	Retried          int
+	Groups           []messageGroupRow
+}
+
+type messageGroupRow struct {
+	GroupID         string
+	Sampled         int
+	HeadMessageID   string
+	HeadState       string
+	SentAt          string
+	FirstReceivedAt string
+	Retried         bool
+}
+
+type messageFieldRow struct {
+	Key       string
+	Presence  string
+	Types     string
+	Distinct  string
+	TopValues []FieldValueCount
+}
+
+type sizePercentileRow struct {
+	Label string
+	Min   string
+	P50   string
+	P90   string
+	P99   string
+	Max   string
+}
+
+// QueueAnalysisHandler renders the analysis page for a queue. Sampling only runs when
+// run=1 is given, because receiving messages increments their receive counts. The report
+// parameter picks the size report (default), the JSON field report, the duplicate body report, the
+// FIFO message group report or the count of messages by the value of the attribute parameter.
+func (h *HandlerImpl) QueueAnalysisHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	query := r.URL.Query()
+	data := newQueueAnalysisPageData(queueURL)
+	if report := query.Get("report"); report == "fields" || report == "duplicates" || report == "groups" || report == "attributes" {
+		data.Report = report
+	}
+	data.Attribute = strings.TrimSpace(query.Get("attribute"))
+
+	if raw := strings.TrimSpace(query.Get("samples")); raw != "" {
+		data.Samples = raw
+	}
+
+	status = http.StatusOK
+	if query.Get("run") == "1" {
+		samples, err := strconv.Atoi(data.Samples)
+		if err != nil || samples < 1 || samples > maxAnalysisSamples {
+			data.ErrorMessage = fmt.Sprintf("Sample size must be between 1 and %d.", maxAnalysisSamples)
+			status = http.StatusBadRequest
+		} else if data.Report == "attributes" && data.Attribute == "" {
+			data.ErrorMessage = "Enter the name of the attribute to count messages by."
+			status = http.StatusBadRequest
+		} else if data.Report == "attributes" {
+			report, err := h.s.CountMessagesByAttribute(r.Context(), queueURL, data.Attribute, samples)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "failed to count messages by attribute", slog.String("queue_url", queueURL), slog.Any("error", err))
+				data.ErrorMessage = "Failed to sample messages from the queue."
+			} else {
+				data.Attributes = &report
+			}
+		} else if data.Report == "groups" {
+			report, err := h.s.AnalyzeMessageGroups(r.Context(), queueURL, samples)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "failed to analyze message groups", slog.String("queue_url", queueURL), slog.Any("error", err))
+				data.ErrorMessage = "Failed to sample message groups: " + err.Error()
+			} else {
+				data.Groups = newMessageGroupView(report)
+			}
+		} else if data.Report == "duplicates" {
+			report, err := h.s.FindDuplicateMessages(r.Context(), queueURL, samples)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "failed to find duplicate messages", slog.String("queue_url", queueURL), slog.Any("error", err))
+				data.ErrorMessage = "Failed to sample messages from the queue."
+			} else {
+				data.Duplicates = newDuplicateMessageView(report)
+			}
+		} else if data.Report == "fields" {
+			report, err := h.s.AnalyzeMessageFields(r.Context(), queueURL, samples)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "failed to analyze message fields", slog.String("queue_url", queueURL), slog.Any("error", err))
+				data.ErrorMessage = "Failed to sample messages from the queue."
+			} else {
+				data.Fields = newMessageFieldView(report)
+			}
+		} else {
+			report, err := h.s.AnalyzeMessageSizes(r.Context(), queueURL, samples)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "failed to analyze message sizes", slog.String("queue_url", queueURL), slog.Any("error", err))
+				data.ErrorMessage = "Failed to sample messages from the queue."
+			} else {
+				data.Sizes = newMessageSizeView(report)
+			}
+		}
+	}
+
+	h.renderQueueAnalysis(w, status, data)
+}
+
+// PostAttributeCountHandler starts counting every message of the queue by an attribute value,
+// rather than a sample. The analysis page then follows the job.
+func (h *HandlerImpl) PostAttributeCountHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	data := newQueueAnalysisPageData(queueURL)
+	data.Report = "attributes"
+	data.Attribute = strings.TrimSpace(r.FormValue("attribute"))
+	if data.Attribute == "" {
+		data.ErrorMessage = "Enter the name of the attribute to count messages by."
+		h.renderQueueAnalysis(w, http.StatusBadRequest, data)
+		return
+	}
+
+	job, err := h.s.StartAttributeCount(r.Context(), queueURL, data.Attribute)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start attribute count", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderQueueAnalysis(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.JobID = job.ID
+	h.renderQueueAnalysis(w, http.StatusOK, data)
+}
+
+func newQueueAnalysisPageData(queueURL string) queueAnalysisPageData {
+	return queueAnalysisPageData{
+		Title:      "Queue analysis",
+		ViteTags:   fragments["assets/js/queue_analysis.ts"].Tags,
+		QueueName:  extractQueueName(queueURL),
+		EscapedURL: url.QueryEscape(queueURL),
+		Samples:    strconv.Itoa(defaultAnalysisSamples),
+		Report:     "sizes",
+		Reports: []selectOption{
+			{Value: "sizes", Label: "Message sizes"},
+			{Value: "fields", Label: "JSON fields"},
+			{Value: "duplicates", Label: "Duplicate bodies"},
+			{Value: "groups", Label: "FIFO message groups"},
+			{Value: "attributes", Label: "Messages by attribute"},
+		},
+	}
+}
+
+func (h *HandlerImpl) renderQueueAnalysis(w http.ResponseWriter, status int, data queueAnalysisPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["queue-analysis"].Execute(w, data); err != nil {
+		slog.Error("failed to render queue-analysis template", slog.Any("error", err))
+	}
+}
+
+func newMessageSizeView(report MessageSizeReport) *messageSizeView {
+	view := &messageSizeView{
+		Requested:       report.Requested,
+		Sampled:         report.Sampled,
+		NearLimit:       report.NearLimit,
+		LargestID:       report.LargestID,
+		AttributeCounts: report.AttributeCounts,
+		Rows: []sizePercentileRow{
+			newSizePercentileRow("Body", report.BodyBytes),
+			newSizePercentileRow("Body + attributes", report.TotalBytes),
+		},
+	}
+	if report.Sampled > 0 {
+		view.LargestOfLimit = fmt.Sprintf("%.1f%%", float64(report.TotalBytes.Max)*100/maxMessageBodyBytes)
+	}
+	return view
+}
+
+func newMessageFieldView(report MessageFieldReport) *messageFieldView {
+	view := &messageFieldView{
+		Requested:    report.Requested,
+		Sampled:      report.Sampled,
+		JSONMessages: report.JSONMessages,
+		MoreFields:   report.MoreFields,
+	}
+	for _, field := range report.Fields {
+		topValues := make([]FieldValueCount, 0, len(field.TopValues))
+		for _, value := range field.TopValues {
+			topValues = append(topValues, FieldValueCount{Value: truncateRunes(value.Value, maxFieldValueDisplay), Count: value.Count})
+		}
+		distinct := strconv.Itoa(field.Distinct)
+		if field.DistinctCapped {
+			distinct += "+"
+		}
+		view.Fields = append(view.Fields, messageFieldRow{
+			Key:       field.Key,
+			Presence:  fmt.Sprintf("%d (%.0f%%)", field.Messages, float64(field.Messages)*100/float64(report.JSONMessages)),
+			Types:     strings.Join(field.Types, ", "),
+			Distinct:  distinct,
+			TopValues: topValues,
+		})
+	}
+	return view
+}
+
+func newDuplicateMessageView(report DuplicateMessageReport) *duplicateMessageView {
+	view := &duplicateMessageView{
+		Requested:  report.Requested,
+		Sampled:    report.Sampled,
+		Duplicates: report.Duplicates,
+	}
+	for _, group := range report.Groups {
+		view.Groups = append(view.Groups, duplicateBodyRow{
+			BodyHash:   group.BodyHash[:12],
+			Body:       truncateRunes(group.Body, maxFieldValueDisplay),
+			MessageIDs: group.MessageIDs,
+		})
+	}
+	return view
+}
+
+func newMessageGroupView(report MessageGroupReport) *messageGroupView {
+	view := &messageGroupView{
+		Requested:        report.Requested,
+		Sampled:          report.Sampled,
+		MessagesInFlight: report.MessagesInFlight,
+	}
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return "—"
+		}
+		return t.Local().Format(displayTimeLayout)
+	}
+	for _, group := range report.Groups {
+		row := messageGroupRow{
+			GroupID:         group.GroupID,
+			Sampled:         group.Sampled,
+			HeadMessageID:   group.HeadMessageID,
+			HeadState:       "Not received before",
+			SentAt:          formatTime(group.HeadSentAt),
+			FirstReceivedAt: formatTime(group.HeadFirstReceivedAt),
+			Retried:         group.Retried,
+		}
+		if group.Retried {
+			view.Retried++
+			row.HeadState = fmt.Sprintf("Received %d times before, not deleted", group.HeadReceiveCount-1)
+		}
+		view.Groups = append(view.Groups, row)
+	}
+	return view
+}
+
+func newSizePercentileRow(label string, p SizePercentiles) sizePercentileRow {
+	return sizePercentileRow{
+		Label: label,
+		Min:   formatBytes(p.Min),
+		P50:   formatBytes(p.P50),
+		P90:   formatBytes(p.P90),
+		P99:   formatBytes(p.P99),
+		Max:   formatBytes(p.Max),
+	}
+}
+
+// formatBytes renders a byte count the way the SQS console does, in B or KB.
+func formatBytes(n int) string {
+	if n < 1024 {
+		return fmt.Sprintf("%d B", n)
+	}
+	return fmt.Sprintf("%.1f KB", float64(n)/1024)
+}
+
+// truncateRunes shortens s to at most n runes, marking the cut with an ellipsis.
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,316 @@
+package internal
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_QueueAnalysisHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/analysis?"+query, nil)
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("does not sample without run", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured queueAnalysisPageData
+		captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+		installFragment(t, "assets/js/queue_analysis.ts", template.HTML(`<script data-test="analysis"></script>`))
+
+		handler.QueueAnalysisHandler(rr, newRequest(""))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "orders", captured.QueueName)
+		assert.Equal(t, "50", captured.Samples)
+		assert.Nil(t, captured.Sizes)
+	})
+
+	t.Run("renders the size report", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queueAnalysisPageData
+		captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+		installFragment(t, "assets/js/queue_analysis.ts", "")
+
+		mockService.EXPECT().
+			AnalyzeMessageSizes(mock.Anything, queueURL, 20).
+			Return(MessageSizeReport{
+				Requested:  20,
+				Sampled:    2,
+				BodyBytes:  SizePercentiles{Min: 10, P50: 10, P90: 2048, P99: 2048, Max: 2048},
+				TotalBytes: SizePercentiles{Min: 20, P50: 20, P90: 131072, P99: 131072, Max: 131072},
+				LargestID:  "b",
+			}, nil).
+			Once()
+
+		handler.QueueAnalysisHandler(rr, newRequest("run=1&samples=20"))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		if assert.NotNil(t, captured.Sizes) {
+			assert.Equal(t, sizePercentileRow{Label: "Body", Min: "10 B", P50: "10 B", P90: "2.0 KB", P99: "2.0 KB", Max: "2.0 KB"}, captured.Sizes.Rows[0])
+			assert.Equal(t, "50.0%", captured.Sizes.LargestOfLimit)
+		}
+	})
+
+	t.Run("rejects invalid sample sizes", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured queueAnalysisPageData
+		captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+		installFragment(t, "assets/js/queue_analysis.ts", "")
+
+		handler.QueueAnalysisHandler(rr, newRequest("run=1&samples=0"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "Sample size must be between 1 and 500.", captured.ErrorMessage)
+	})
+
+	t.Run("reports sampling failures", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queueAnalysisPageData
+		captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+		installFragment(t, "assets/js/queue_analysis.ts", "")
+
+		mockService.EXPECT().AnalyzeMessageSizes(mock.Anything, queueURL, 50).Return(MessageSizeReport{}, errors.New("boom")).Once()
+
+		handler.QueueAnalysisHandler(rr, newRequest("run=1"))
+
+		assert.Equal(t, "Failed to sample messages from the queue.", captured.ErrorMessage)
+	})
+}
+
+func TestHandlerImpl_QueueAnalysisHandler_Fields(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+	rr := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/analysis?run=1&report=fields&samples=10", nil)
+	req.SetPathValue("url", escaped)
+
+	var captured queueAnalysisPageData
+	captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+	installFragment(t, "assets/js/queue_analysis.ts", "")
+
+	long := `"` + strings.Repeat("a", 100) + `"`
+	mockService.EXPECT().
+		AnalyzeMessageFields(mock.Anything, queueURL, 10).
+		Return(MessageFieldReport{
+			Requested:    10,
+			Sampled:      5,
+			JSONMessages: 4,
+			Fields: []FieldStats{{
+				Key:            "type",
+				Messages:       3,
+				Types:          []string{"null", "string"},
+				Distinct:       100,
+				DistinctCapped: true,
+				TopValues:      []FieldValueCount{{Value: long, Count: 2}},
+			}},
+		}, nil).
+		Once()
+
+	handler.QueueAnalysisHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "fields", captured.Report)
+	assert.Nil(t, captured.Sizes)
+	if assert.NotNil(t, captured.Fields) && assert.Len(t, captured.Fields.Fields, 1) {
+		row := captured.Fields.Fields[0]
+		assert.Equal(t, "3 (75%)", row.Presence)
+		assert.Equal(t, "null, string", row.Types)
+		assert.Equal(t, "100+", row.Distinct)
+		assert.Equal(t, long[:maxFieldValueDisplay-1]+"…", row.TopValues[0].Value)
+	}
+}
+
+func TestHandlerImpl_QueueAnalysisHandler_Duplicates(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+	rr := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/analysis?run=1&report=duplicates&samples=10", nil)
+	req.SetPathValue("url", escaped)
+
+	var captured queueAnalysisPageData
+	captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+	installFragment(t, "assets/js/queue_analysis.ts", "")
+
+	mockService.EXPECT().
+		FindDuplicateMessages(mock.Anything, queueURL, 10).
+		Return(DuplicateMessageReport{
+			Requested:  10,
+			Sampled:    4,
+			Duplicates: 1,
+			Groups: []DuplicateBodyGroup{
+				{BodyHash: bodyHash("order-1"), Body: "order-1", MessageIDs: []string{"1", "3"}},
+			},
+		}, nil).
+		Once()
+
+	handler.QueueAnalysisHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "duplicates", captured.Report)
+	if assert.NotNil(t, captured.Duplicates) && assert.Len(t, captured.Duplicates.Groups, 1) {
+		assert.Equal(t, 1, captured.Duplicates.Duplicates)
+		assert.Equal(t, bodyHash("order-1")[:12], captured.Duplicates.Groups[0].BodyHash)
+		assert.Equal(t, []string{"1", "3"}, captured.Duplicates.Groups[0].MessageIDs)
+	}
+}
+
+func TestHandlerImpl_QueueAnalysisHandler_Groups(t *testing.T) {
+	queueURL := "https://sqs.local/orders.fifo"
+	escaped := url.QueryEscape(queueURL)
+
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+	rr := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/analysis?run=1&report=groups&samples=10", nil)
+	req.SetPathValue("url", escaped)
+
+	var captured queueAnalysisPageData
+	captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+	installFragment(t, "assets/js/queue_analysis.ts", "")
+
+	mockService.EXPECT().
+		AnalyzeMessageGroups(mock.Anything, queueURL, 10).
+		Return(MessageGroupReport{
+			Requested:        10,
+			Sampled:          3,
+			MessagesInFlight: 1,
+			Groups: []MessageGroupStatus{
+				{GroupID: "b", Sampled: 1, HeadMessageID: "b-1", HeadReceiveCount: 4, Retried: true},
+				{GroupID: "a", Sampled: 2, HeadMessageID: "a-1", HeadReceiveCount: 1},
+			},
+		}, nil).
+		Once()
+
+	handler.QueueAnalysisHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	if assert.NotNil(t, captured.Groups) && assert.Len(t, captured.Groups.Groups, 2) {
+		assert.Equal(t, 1, captured.Groups.Retried)
+		assert.Equal(t, "Received 3 times before, not deleted", captured.Groups.Groups[0].HeadState)
+		assert.Equal(t, "Not received before", captured.Groups.Groups[1].HeadState)
+		assert.Equal(t, "—", captured.Groups.Groups[1].SentAt)
+	}
+}
+
+func TestHandlerImpl_QueueAnalysisHandler_Attributes(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/analysis?"+query, nil)
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("renders the counts", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queueAnalysisPageData
+		captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+		installFragment(t, "assets/js/queue_analysis.ts", "")
+
+		report := AttributeCountReport{Attribute: "eventType", Requested: 10, Sampled: 2, Values: []AttributeValueCount{{Value: "created", Messages: 2}}}
+		mockService.EXPECT().CountMessagesByAttribute(mock.Anything, queueURL, "eventType", 10).Return(report, nil).Once()
+
+		handler.QueueAnalysisHandler(rr, newRequest("run=1&report=attributes&attribute=eventType&samples=10"))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "attributes", captured.Report)
+		assert.Equal(t, &report, captured.Attributes)
+	})
+
+	t.Run("requires an attribute", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured queueAnalysisPageData
+		captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+		installFragment(t, "assets/js/queue_analysis.ts", "")
+
+		handler.QueueAnalysisHandler(rr, newRequest("run=1&report=attributes"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "Enter the name of the attribute to count messages by.", captured.ErrorMessage)
+	})
+}
+
+func TestHandlerImpl_PostAttributeCountHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/analysis/attribute-count", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("starts the count", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queueAnalysisPageData
+		captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+		installFragment(t, "assets/js/queue_analysis.ts", "")
+
+		mockService.EXPECT().StartAttributeCount(mock.Anything, queueURL, "eventType").Return(Job{ID: "job-1"}, nil).Once()
+
+		handler.PostAttributeCountHandler(rr, newRequest(url.Values{"attribute": {" eventType "}}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "job-1", captured.JobID)
+		assert.Equal(t, "eventType", captured.Attribute)
+	})
+
+	t.Run("reports failures to start", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queueAnalysisPageData
+		captureTemplate(t, "queue-analysis", func(data queueAnalysisPageData) { captured = data })
+		installFragment(t, "assets/js/queue_analysis.ts", "")
+
+		mockService.EXPECT().StartAttributeCount(mock.Anything, queueURL, "eventType").Return(Job{}, errors.New("boom")).Once()
+
+		handler.PostAttributeCountHandler(rr, newRequest(url.Values{"attribute": {"eventType"}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "boom", captured.ErrorMessage)
+		assert.Empty(t, captured.JobID)
+	})
+}
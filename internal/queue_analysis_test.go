@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_AnalyzeMessageSizes(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("reports percentiles and attribute counts", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		large := strings.Repeat("x", 220*1024)
+		repo.EXPECT().
+			ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{QueueURL: queueURL, MaxMessages: 4, VisibilityTimeout: sampleVisibility}).
+			Return([]ReceivedMessage{
+				{ID: "a", Body: "1234", Attributes: []MessageAttribute{{Name: "SentTimestamp", Value: "2024-05-01T12:00:00Z"}}},
+				{ID: "b", Body: "12345678", Attributes: []MessageAttribute{{Name: "tenant", Value: "acme"}}},
+				{ID: "c", Body: large},
+			}, nil).
+			Once()
+		repo.EXPECT().
+			ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{QueueURL: queueURL, MaxMessages: 1, VisibilityTimeout: sampleVisibility}).
+			Return([]ReceivedMessage{{ID: "a", Body: "1234"}}, nil).
+			Times(maxIdleSampleBatches)
+		repo.EXPECT().
+			ChangeMessageVisibility(mock.Anything, mock.MatchedBy(func(input ChangeMessageVisibilityRepositoryInput) bool { return input.QueueURL == queueURL })).
+			Return(nil).
+			Times(3)
+
+		report, err := service.AnalyzeMessageSizes(ctx, queueURL, 4)
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, report.Requested)
+		assert.Equal(t, 3, report.Sampled)
+		assert.Equal(t, SizePercentiles{Min: 4, P50: 8, P90: len(large), P99: len(large), Max: len(large)}, report.BodyBytes)
+		assert.Equal(t, SizePercentiles{Min: 4, P50: 18, P90: len(large), P99: len(large), Max: len(large)}, report.TotalBytes)
+		assert.Equal(t, 1, report.NearLimit)
+		assert.Equal(t, "c", report.LargestID)
+		assert.Equal(t, []AttributeCountBucket{{Attributes: 0, Messages: 2}, {Attributes: 1, Messages: 1}}, report.AttributeCounts)
+	})
+
+	t.Run("clamps the sample size", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().
+			ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{QueueURL: queueURL, MaxMessages: 10, VisibilityTimeout: sampleVisibility}).
+			Return(nil, nil).
+			Times(maxIdleSampleBatches)
+
+		report, err := service.AnalyzeMessageSizes(ctx, queueURL, 10_000)
+		require.NoError(t, err)
+		assert.Equal(t, maxAnalysisSamples, report.Requested)
+		assert.Zero(t, report.Sampled)
+	})
+
+	t.Run("fails when sampled messages cannot be made visible again", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().
+			ReceiveMessages(ctx, mock.Anything).
+			Return([]ReceivedMessage{{ID: "a", ReceiptHandle: "r-a"}, {ID: "b", ReceiptHandle: "r-b"}}, nil).
+			Once()
+		repo.EXPECT().
+			ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-a"}).
+			Return(nil).
+			Once()
+		repo.EXPECT().
+			ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: queueURL, ReceiptHandle: "r-b"}).
+			Return(errors.New("expired")).
+			Once()
+
+		_, err := service.AnalyzeMessageSizes(ctx, queueURL, 2)
+		assert.EqualError(t, err, "1 of 2 sampled messages could not be made visible again and reappear after 60 seconds")
+	})
+}
+
+func TestSizePercentiles(t *testing.T) {
+	sizes := make([]int, 0, 100)
+	for i := 100; i >= 1; i-- {
+		sizes = append(sizes, i)
+	}
+
+	assert.Equal(t, SizePercentiles{Min: 1, P50: 50, P90: 90, P99: 99, Max: 100}, sizePercentiles(sizes))
+	assert.Equal(t, SizePercentiles{}, sizePercentiles(nil))
+}
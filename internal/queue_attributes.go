@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// attributeRange is the inclusive range SQS accepts for a numeric queue attribute.
+type attributeRange struct {
+	min int64
+	max int64
+}
+
+// editableQueueAttributes lists the attributes that can be changed one at a time from the queue list.
+var editableQueueAttributes = map[string]attributeRange{
+	"DelaySeconds":                  {min: 0, max: 900},
+	"MaximumMessageSize":            {min: 1024, max: 262144},
+	"MessageRetentionPeriod":        {min: 60, max: 1209600},
+	"ReceiveMessageWaitTimeSeconds": {min: 0, max: 20},
+	"VisibilityTimeout":             {min: 0, max: 43200},
+}
+
+// UpdateQueueAttribute changes a single editable attribute of a queue and returns the value
+// that was stored.
+func (s *SqsServiceImpl) UpdateQueueAttribute(ctx context.Context, queueURL, name, value string) (string, error) {
+	if strings.TrimSpace(queueURL) == "" {
+		return "", errors.New("queue url is required")
+	}
+
+	limits, ok := editableQueueAttributes[name]
+	if !ok {
+		return "", errors.Newf("attribute %q cannot be edited", name)
+	}
+
+	number, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return "", errors.Newf("%s must be a whole number", name)
+	}
+	if number < limits.min || number > limits.max {
+		return "", errors.Newf("%s must be between %d and %d", name, limits.min, limits.max)
+	}
+
+	stored := strconv.FormatInt(number, 10)
+	if err := s.repo.SetQueueAttributes(ctx, queueURL, map[string]string{name: stored}); err != nil {
+		return "", err
+	}
+	return stored, nil
+}
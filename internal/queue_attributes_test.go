@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSqsServiceImpl_UpdateQueueAttribute(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+
+	tests := []struct {
+		name     string
+		queueURL string
+		attr     string
+		value    string
+		arrange  func(repo *MockSqsRepository)
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "stores normalized value",
+			queueURL: queueURL,
+			attr:     "VisibilityTimeout",
+			value:    " 045 ",
+			arrange: func(repo *MockSqsRepository) {
+				repo.EXPECT().
+					SetQueueAttributes(mock.Anything, queueURL, map[string]string{"VisibilityTimeout": "45"}).
+					Return(nil).
+					Once()
+			},
+			want: "45",
+		},
+		{
+			name:     "rejects attributes that are not editable",
+			queueURL: queueURL,
+			attr:     "RedrivePolicy",
+			value:    "{}",
+			wantErr:  `attribute "RedrivePolicy" cannot be edited`,
+		},
+		{
+			name:     "rejects non numeric value",
+			queueURL: queueURL,
+			attr:     "DelaySeconds",
+			value:    "soon",
+			wantErr:  "DelaySeconds must be a whole number",
+		},
+		{
+			name:     "rejects value out of range",
+			queueURL: queueURL,
+			attr:     "ReceiveMessageWaitTimeSeconds",
+			value:    "21",
+			wantErr:  "ReceiveMessageWaitTimeSeconds must be between 0 and 20",
+		},
+		{
+			name:    "requires queue url",
+			attr:    "VisibilityTimeout",
+			value:   "30",
+			wantErr: "queue url is required",
+		},
+		{
+			name:     "returns repository error",
+			queueURL: queueURL,
+			attr:     "MessageRetentionPeriod",
+			value:    "60",
+			arrange: func(repo *MockSqsRepository) {
+				repo.EXPECT().
+					SetQueueAttributes(mock.Anything, queueURL, map[string]string{"MessageRetentionPeriod": "60"}).
+					Return(errors.New("boom")).
+					Once()
+			},
+			wantErr: "boom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockSqsRepository(t)
+			if tt.arrange != nil {
+				tt.arrange(repo)
+			}
+			service := &SqsServiceImpl{repo: repo}
+
+			got, err := service.UpdateQueueAttribute(context.Background(), tt.queueURL, tt.attr, tt.value)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Cleanup actions reported for each queue matching the cleanup pattern.
+const (
+	CleanupActionInUse       = "in use"
+	CleanupActionWaiting     = "waiting"
+	CleanupActionWouldDelete = "would delete"
+	CleanupActionDeleted     = "deleted"
+	CleanupActionFailed      = "failed"
+)
+
+// CleanupCandidate describes one queue considered by a cleanup sweep.
+type CleanupCandidate struct {
+	QueueURL   string
+	Name       string
+	EmptySince time.Time
+	EligibleAt time.Time
+	Action     string
+	Error      string
+}
+
+// CleanupReport summarises the most recent cleanup sweep.
+type CleanupReport struct {
+	Enabled    bool
+	RanAt      time.Time
+	Pattern    string
+	IdleFor    time.Duration
+	DryRun     bool
+	Candidates []CleanupCandidate
+}
+
+// cleanupTracker remembers since when each matching queue has been observed empty.
+// SQS exposes no "last activity" timestamp, so idleness is measured across sweeps.
+type cleanupTracker struct {
+	mu         sync.Mutex
+	now        func() time.Time
+	emptySince map[string]time.Time
+	last       CleanupReport
+}
+
+func newCleanupTracker() *cleanupTracker {
+	return &cleanupTracker{now: time.Now, emptySince: make(map[string]time.Time)}
+}
+
+// SweepTemporaryQueues applies the configured cleanup policy once and returns the resulting report.
+func (s *SqsServiceImpl) SweepTemporaryQueues(ctx context.Context) (CleanupReport, error) {
+	policy := s.config.Cleanup
+	if !policy.Enabled() {
+		return CleanupReport{}, errors.New("queue cleanup policy is not configured")
+	}
+
+	queues, err := s.Queues(ctx)
+	if err != nil {
+		return CleanupReport{}, err
+	}
+
+	tracker := s.cleanup
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	now := tracker.now()
+	report := CleanupReport{
+		Enabled:    true,
+		RanAt:      now,
+		Pattern:    policy.Pattern,
+		IdleFor:    policy.IdleFor,
+		DryRun:     policy.DryRun,
+		Candidates: make([]CleanupCandidate, 0),
+	}
+
+	seen := make(map[string]struct{}, len(queues))
+	for _, queue := range queues {
+		if matched, _ := path.Match(policy.Pattern, queue.Name); !matched {
+			continue
+		}
+		seen[queue.URL] = struct{}{}
+
+		candidate := CleanupCandidate{QueueURL: queue.URL, Name: queue.Name}
+		if queue.MessagesAvailable > 0 || queue.MessagesInFlight > 0 || queue.MessagesDelayed > 0 {
+			delete(tracker.emptySince, queue.URL)
+			candidate.Action = CleanupActionInUse
+			report.Candidates = append(report.Candidates, candidate)
+			continue
+		}
+
+		since, ok := tracker.emptySince[queue.URL]
+		if !ok {
+			since = now
+			tracker.emptySince[queue.URL] = since
+		}
+		candidate.EmptySince = since
+		candidate.EligibleAt = since.Add(policy.IdleFor)
+
+		switch {
+		case now.Before(candidate.EligibleAt):
+			candidate.Action = CleanupActionWaiting
+		case policy.DryRun:
+			candidate.Action = CleanupActionWouldDelete
+		default:
+			// Idle temporary queues skip the trash; keeping them would only crowd out real deletions.
+			if err := s.repo.DeleteQueue(ctx, queue.URL); err != nil {
+				slog.WarnContext(ctx, "failed to delete idle temporary queue", slog.String("queue_url", queue.URL), slog.Any("error", err))
+				candidate.Action = CleanupActionFailed
+				candidate.Error = err.Error()
+			} else {
+				slog.InfoContext(ctx, "deleted idle temporary queue", slog.String("queue_url", queue.URL))
+				candidate.Action = CleanupActionDeleted
+				delete(tracker.emptySince, queue.URL)
+			}
+		}
+		report.Candidates = append(report.Candidates, candidate)
+	}
+
+	for queueURL := range tracker.emptySince {
+		if _, ok := seen[queueURL]; !ok {
+			delete(tracker.emptySince, queueURL)
+		}
+	}
+
+	tracker.last = report
+	return report, nil
+}
+
+// CleanupReport returns the report of the most recent cleanup sweep.
+func (s *SqsServiceImpl) CleanupReport(_ context.Context) (CleanupReport, error) {
+	policy := s.config.Cleanup
+	if !policy.Enabled() {
+		return CleanupReport{}, nil
+	}
+
+	s.cleanup.mu.Lock()
+	defer s.cleanup.mu.Unlock()
+
+	report := s.cleanup.last
+	report.Enabled = true
+	report.Pattern = policy.Pattern
+	report.IdleFor = policy.IdleFor
+	report.DryRun = policy.DryRun
+	return report, nil
+}
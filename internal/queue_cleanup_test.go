@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_SweepTemporaryQueues(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	newService := func(repo SqsRepository, dryRun bool) (*SqsServiceImpl, *time.Time) {
+		now := start
+		tracker := newCleanupTracker()
+		tracker.now = func() time.Time { return now }
+		return &SqsServiceImpl{
+			repo:    repo,
+			config:  ServiceConfig{Cleanup: CleanupPolicy{Pattern: "tmp-*", IdleFor: time.Hour, DryRun: dryRun}},
+			cleanup: tracker,
+		}, &now
+	}
+
+	queues := []QueueSummary{
+		{URL: "https://sqs.local/tmp-empty", Name: "tmp-empty"},
+		{URL: "https://sqs.local/tmp-busy", Name: "tmp-busy", MessagesAvailable: 2},
+		{URL: "https://sqs.local/orders", Name: "orders"},
+	}
+
+	t.Run("deletes queues once idle for the configured period", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service, now := newService(repo, false)
+
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues, nil).Twice()
+		repo.EXPECT().DeleteQueue(mock.Anything, "https://sqs.local/tmp-empty").Return(nil).Once()
+
+		report, err := service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+		require.Len(t, report.Candidates, 2)
+		assert.Equal(t, CleanupActionWaiting, report.Candidates[0].Action)
+		assert.Equal(t, start.Add(time.Hour), report.Candidates[0].EligibleAt)
+		assert.Equal(t, CleanupActionInUse, report.Candidates[1].Action)
+
+		*now = start.Add(time.Hour)
+		report, err = service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, CleanupActionDeleted, report.Candidates[0].Action)
+		assert.Equal(t, start, report.Candidates[0].EmptySince)
+
+		last, err := service.CleanupReport(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, report, last)
+	})
+
+	t.Run("dry run only reports eligible queues", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service, now := newService(repo, true)
+
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues[:1], nil).Twice()
+
+		_, err := service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+
+		*now = start.Add(2 * time.Hour)
+		report, err := service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+		require.Len(t, report.Candidates, 1)
+		assert.Equal(t, CleanupActionWouldDelete, report.Candidates[0].Action)
+		assert.True(t, report.DryRun)
+	})
+
+	t.Run("resets the idle clock when messages arrive", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service, now := newService(repo, false)
+
+		busy := []QueueSummary{{URL: "https://sqs.local/tmp-empty", Name: "tmp-empty", MessagesInFlight: 1}}
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues[:1], nil).Once()
+		repo.EXPECT().ListQueues(mock.Anything).Return(busy, nil).Once()
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues[:1], nil).Once()
+
+		_, err := service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+		*now = start.Add(30 * time.Minute)
+		_, err = service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+		*now = start.Add(time.Hour)
+		report, err := service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, CleanupActionWaiting, report.Candidates[0].Action)
+		assert.Equal(t, start.Add(time.Hour), report.Candidates[0].EmptySince)
+	})
+
+	t.Run("keeps queues holding only delayed messages", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service, now := newService(repo, false)
+
+		delayed := []QueueSummary{{URL: "https://sqs.local/tmp-delayed", Name: "tmp-delayed", MessagesDelayed: 3}}
+		repo.EXPECT().ListQueues(mock.Anything).Return(delayed, nil).Twice()
+
+		_, err := service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+		*now = start.Add(2 * time.Hour)
+		report, err := service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, report.Candidates, 1)
+		assert.Equal(t, CleanupActionInUse, report.Candidates[0].Action)
+		assert.True(t, report.Candidates[0].EmptySince.IsZero())
+	})
+
+	t.Run("records delete failures", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service, now := newService(repo, false)
+
+		repo.EXPECT().ListQueues(mock.Anything).Return(queues[:1], nil).Twice()
+		repo.EXPECT().DeleteQueue(mock.Anything, "https://sqs.local/tmp-empty").Return(errors.New("denied")).Once()
+
+		_, err := service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+		*now = start.Add(time.Hour)
+		report, err := service.SweepTemporaryQueues(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, CleanupActionFailed, report.Candidates[0].Action)
+		assert.Equal(t, "denied", report.Candidates[0].Error)
+	})
+
+	t.Run("errors when no policy is configured", func(t *testing.T) {
+		service := &SqsServiceImpl{cleanup: newCleanupTracker()}
+
+		_, err := service.SweepTemporaryQueues(ctx)
+		assert.EqualError(t, err, "queue cleanup policy is not configured")
+
+		report, err := service.CleanupReport(ctx)
+		require.NoError(t, err)
+		assert.False(t, report.Enabled)
+	})
+}
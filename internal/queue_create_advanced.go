@@ -0,0 +1,235 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// RawCreateQueueInput creates a queue from an attribute map as SQS takes it, such as one exported
+// by another tool, instead of from the individual fields of CreateQueueInput.
+type RawCreateQueueInput struct {
+	Name       string
+	Attributes map[string]string
+	Tags       map[string]string
+}
+
+// readOnlyQueueAttributes are reported by GetQueueAttributes but refused by CreateQueue. They are
+// dropped from a raw attribute map so the output of get-queue-attributes can be pasted as is.
+var readOnlyQueueAttributes = []string{
+	"ApproximateNumberOfMessages",
+	"ApproximateNumberOfMessagesDelayed",
+	"ApproximateNumberOfMessagesNotVisible",
+	"CreatedTimestamp",
+	"LastModifiedTimestamp",
+	"QueueArn",
+}
+
+// rawQueueAttributeRanges are the numeric attributes of a raw attribute map and the values SQS
+// accepts for them.
+var rawQueueAttributeRanges = map[string]attributeRange{
+	"DelaySeconds":                  editableQueueAttributes["DelaySeconds"],
+	"KmsDataKeyReusePeriodSeconds":  {min: 60, max: 86400},
+	"MaximumMessageSize":            editableQueueAttributes["MaximumMessageSize"],
+	"MessageRetentionPeriod":        editableQueueAttributes["MessageRetentionPeriod"],
+	"ReceiveMessageWaitTimeSeconds": editableQueueAttributes["ReceiveMessageWaitTimeSeconds"],
+	"VisibilityTimeout":             editableQueueAttributes["VisibilityTimeout"],
+}
+
+// rawQueueAttributeChoices are the attributes of a raw attribute map that take one of a few words.
+var rawQueueAttributeChoices = map[string][]string{
+	"ContentBasedDeduplication": {"true", "false"},
+	"DeduplicationScope":        {DeduplicationScopeMessageGroup, DeduplicationScopeQueue},
+	"FifoQueue":                 {"true", "false"},
+	"FifoThroughputLimit":       {FifoThroughputLimitPerQueue, FifoThroughputLimitPerMessageGroupID},
+	"SqsManagedSseEnabled":      {"true", "false"},
+}
+
+// CreateQueueFromAttributes creates a queue from a raw attribute map and tags. The attributes are
+// checked against the names and values CreateQueue accepts; read-only attributes are dropped. A
+// name ending in .fifo makes the queue FIFO, as does FifoQueue=true, which requires that name.
+// The configured default tags are added under the given ones.
+func (s *SqsServiceImpl) CreateQueueFromAttributes(ctx context.Context, input RawCreateQueueInput) (CreateQueueResult, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return CreateQueueResult{}, errors.New("queue name is required")
+	}
+	if err := validateQueueName(name); err != nil {
+		return CreateQueueResult{}, err
+	}
+
+	attributes, err := checkRawQueueAttributes(name, input.Attributes)
+	if err != nil {
+		return CreateQueueResult{}, err
+	}
+
+	for key, value := range input.Tags {
+		if err := validateQueueTag(key, value); err != nil {
+			return CreateQueueResult{}, err
+		}
+	}
+	tags := maps.Clone(s.config.DefaultTags)
+	if tags == nil {
+		tags = make(map[string]string, len(input.Tags))
+	}
+	maps.Copy(tags, input.Tags)
+	if len(tags) > maxQueueTags {
+		return CreateQueueResult{}, errors.Newf("a queue can have at most %d tags", maxQueueTags)
+	}
+	if len(input.Tags) > 0 && !s.EndpointCapabilities(ctx).Tags {
+		return CreateQueueResult{}, ErrTagsUnsupported
+	}
+
+	queueURL, err := s.repo.CreateQueue(ctx, CreateQueueRepositoryInput{
+		Name:       name,
+		Attributes: attributes,
+		Tags:       s.creatableTags(ctx, tags),
+	})
+	if err != nil {
+		return CreateQueueResult{}, err
+	}
+	return CreateQueueResult{QueueURL: queueURL}, nil
+}
+
+// checkRawQueueAttributes validates the attributes of a queue called name and returns the ones to
+// send to CreateQueue.
+func checkRawQueueAttributes(name string, raw map[string]string) (map[string]string, error) {
+	queueType := queueTypeOfName(name)
+	attributes := make(map[string]string, len(raw)+1)
+	for key, value := range raw {
+		if slices.Contains(readOnlyQueueAttributes, key) {
+			continue
+		}
+		if !slices.Contains(restorableQueueAttributes, key) {
+			return nil, errors.Newf("%s is not an attribute a queue can be created with", key)
+		}
+		value = strings.TrimSpace(value)
+
+		if limits, ok := rawQueueAttributeRanges[key]; ok {
+			number, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.Newf("%s must be a whole number", key)
+			}
+			if number < limits.min || number > limits.max {
+				return nil, errors.Newf("%s must be between %d and %d", key, limits.min, limits.max)
+			}
+			value = strconv.FormatInt(number, 10)
+		}
+		if choices, ok := rawQueueAttributeChoices[key]; ok && !slices.Contains(choices, value) {
+			return nil, errors.Newf("%s must be %s", key, strings.Join(choices, " or "))
+		}
+		attributes[key] = value
+	}
+
+	switch attributes["FifoQueue"] {
+	case "true":
+		if queueType != QueueTypeFIFO {
+			return nil, errors.New("FIFO queue names must end with .fifo")
+		}
+	case "false":
+		if queueType == QueueTypeFIFO {
+			return nil, errors.New("a queue name ending with .fifo needs FifoQueue true")
+		}
+		delete(attributes, "FifoQueue")
+	}
+	if queueType == QueueTypeFIFO {
+		attributes["FifoQueue"] = "true"
+	} else {
+		for _, key := range fifoOnlyQueueAttributes {
+			if _, ok := attributes[key]; ok {
+				return nil, errors.Newf("%s is only available for FIFO queues", key)
+			}
+		}
+	}
+
+	if attributes["KmsDataKeyReusePeriodSeconds"] != "" && attributes["KmsMasterKeyId"] == "" {
+		return nil, errors.New("KmsDataKeyReusePeriodSeconds requires KmsMasterKeyId")
+	}
+	if attributes["KmsMasterKeyId"] != "" && attributes["SqsManagedSseEnabled"] == "true" {
+		return nil, errors.New("use either KmsMasterKeyId or SqsManagedSseEnabled")
+	}
+
+	if raw, ok := attributes["RedrivePolicy"]; ok {
+		policy := parseRedrivePolicy(raw)
+		if policy == nil {
+			return nil, errors.New("RedrivePolicy must be a JSON object with deadLetterTargetArn and maxReceiveCount")
+		}
+		encoded, err := encodeRedrivePolicy(name, queueType, *policy)
+		if err != nil {
+			return nil, err
+		}
+		attributes["RedrivePolicy"] = encoded
+	}
+	if policy, ok := attributes["Policy"]; ok {
+		for _, finding := range lintAccessPolicy(policy, "") {
+			if finding.Severity == PolicyFindingError {
+				return nil, errors.Newf("Policy: %s", finding.Message)
+			}
+		}
+	}
+	return attributes, nil
+}
+
+// decodeQueueAttributeJSON reads a JSON object of queue attributes or tags into the string map
+// SQS takes. Numbers and booleans become their text and nested objects such as RedrivePolicy are
+// encoded as JSON strings. An object holding only Attributes or Tags, as the AWS CLI prints them,
+// is unwrapped. Blank input is an empty map.
+func decodeQueueAttributeJSON(raw string) (map[string]string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return map[string]string{}, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+	var document map[string]any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, errors.Wrap(err, "must be a JSON object")
+	}
+	if len(document) == 1 {
+		for _, wrapper := range []string{"Attributes", "Tags"} {
+			if inner, ok := document[wrapper].(map[string]any); ok {
+				document = inner
+				break
+			}
+		}
+	}
+
+	return queueAttributeStrings(document)
+}
+
+// queueAttributeStrings converts decoded JSON or YAML values of queue attributes or tags to the
+// text SQS takes, encoding objects and lists as JSON.
+func queueAttributeStrings(document map[string]any) (map[string]string, error) {
+	values := make(map[string]string, len(document))
+	for key, value := range document {
+		switch value := value.(type) {
+		case string:
+			values[key] = value
+		case json.Number:
+			values[key] = value.String()
+		case int:
+			values[key] = strconv.Itoa(value)
+		case float64:
+			values[key] = strconv.FormatFloat(value, 'f', -1, 64)
+		case bool:
+			values[key] = strconv.FormatBool(value)
+		case map[string]any, []any:
+			var encoded bytes.Buffer
+			encoder := json.NewEncoder(&encoded)
+			encoder.SetEscapeHTML(false)
+			if err := encoder.Encode(value); err != nil {
+				return nil, errors.Wrapf(err, "failed to encode %s", key)
+			}
+			values[key] = strings.TrimSpace(encoded.String())
+		default:
+			return nil, errors.Newf("%s must be a string, number, boolean or object", key)
+		}
+	}
+	return values, nil
+}
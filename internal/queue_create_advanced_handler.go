@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// handleAdvancedCreateQueuePost creates a queue from the attribute and tag JSON of the advanced
+// mode of the create page. The JSON is kept on the page when it is rejected.
+func (h *HandlerImpl) handleAdvancedCreateQueuePost(w http.ResponseWriter, r *http.Request) {
+	form := h.defaultCreateQueueForm()
+	form.Advanced = true
+	form.Name = strings.TrimSpace(r.FormValue("queue_name"))
+	form.AttributesJSON = r.FormValue("attributes_json")
+	form.TagsJSON = r.FormValue("tags_json")
+
+	attributes, err := decodeQueueAttributeJSON(form.AttributesJSON)
+	if err != nil {
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, errors.Wrap(err, "Attributes")))
+		return
+	}
+	tags, err := decodeQueueAttributeJSON(form.TagsJSON)
+	if err != nil {
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, errors.Wrap(err, "Tags")))
+		return
+	}
+
+	result, err := h.s.CreateQueueFromAttributes(r.Context(), RawCreateQueueInput{Name: form.Name, Attributes: attributes, Tags: tags})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create queue from attributes", slog.Any("error", err))
+		h.renderCreateQueue(w, h.createQueueErrorData(r, form, err))
+		return
+	}
+
+	redirectURL := fmt.Sprintf("/queues?created=%s", url.QueryEscape(extractQueueName(result.QueueURL)))
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_PostCreateQueueHandler_Advanced(t *testing.T) {
+	newRequest := func(form url.Values) *http.Request {
+		form.Set("mode", "advanced")
+		req := httptest.NewRequest(http.MethodPost, "/create-queue", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	t.Run("creates the queue from the JSON", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			CreateQueueFromAttributes(mock.Anything, RawCreateQueueInput{
+				Name:       "orders",
+				Attributes: map[string]string{"VisibilityTimeout": "60"},
+				Tags:       map[string]string{"team": "payments"},
+			}).
+			Return(CreateQueueResult{QueueURL: "https://sqs.local/orders"}, nil).
+			Once()
+
+		handler.PostCreateQueueHandler(rr, newRequest(url.Values{
+			"queue_name":      {" orders "},
+			"attributes_json": {`{"VisibilityTimeout": 60}`},
+			"tags_json":       {`{"team": "payments"}`},
+		}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues?created=orders", rr.Header().Get("Location"))
+	})
+
+	t.Run("keeps the JSON when it cannot be read", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured createQueuePageData
+		captureCreateQueueTemplate(t, &captured)
+		installCreateQueueFragment(t, "")
+		mockService.EXPECT().DeadLetterCandidates(mock.Anything, "").Return(nil, nil).Once()
+
+		handler.PostCreateQueueHandler(rr, newRequest(url.Values{
+			"queue_name":      {"orders"},
+			"attributes_json": {`{"VisibilityTimeout": }`},
+		}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.True(t, captured.Form.Advanced)
+		assert.Equal(t, `{"VisibilityTimeout": }`, captured.Form.AttributesJSON)
+		assert.Contains(t, captured.ErrorMessage, "Attributes: must be a JSON object")
+	})
+}
@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_CreateQueueFromAttributes(t *testing.T) {
+	ctx := context.Background()
+	dlqArn := "arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo"
+
+	t.Run("creates the queue with the checked attributes and tags", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{
+			repo:         repo,
+			config:       ServiceConfig{DefaultTags: map[string]string{"created-by": "sqs-gui", "team": "core"}},
+			capabilities: &capabilityCache{conclusive: true, caps: EndpointCapabilities{Tags: true}},
+		}
+		repo.EXPECT().
+			CreateQueue(mock.Anything, CreateQueueRepositoryInput{
+				Name: "orders.fifo",
+				Attributes: map[string]string{
+					"FifoQueue":           "true",
+					"VisibilityTimeout":   "60",
+					"FifoThroughputLimit": "perMessageGroupId",
+					"RedrivePolicy":       `{"deadLetterTargetArn":"` + dlqArn + `","maxReceiveCount":5}`,
+				},
+				Tags: map[string]string{"created-by": "sqs-gui", "team": "payments"},
+			}).
+			Return("https://sqs.local/orders.fifo", nil).
+			Once()
+
+		result, err := service.CreateQueueFromAttributes(ctx, RawCreateQueueInput{
+			Name: " orders.fifo ",
+			Attributes: map[string]string{
+				"VisibilityTimeout":           " 60 ",
+				"FifoThroughputLimit":         "perMessageGroupId",
+				"RedrivePolicy":               `{"deadLetterTargetArn":"` + dlqArn + `","maxReceiveCount":"5"}`,
+				"QueueArn":                    "arn:aws:sqs:us-east-1:000000000000:old.fifo",
+				"ApproximateNumberOfMessages": "12",
+			},
+			Tags: map[string]string{"team": "payments"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "https://sqs.local/orders.fifo", result.QueueURL)
+	})
+
+	testCases := []struct {
+		name       string
+		queueName  string
+		attributes map[string]string
+		tags       map[string]string
+		wantErr    string
+	}{
+		{name: "unknown attribute", queueName: "orders", attributes: map[string]string{"Visibility": "1"}, wantErr: "Visibility is not an attribute a queue can be created with"},
+		{name: "number out of range", queueName: "orders", attributes: map[string]string{"DelaySeconds": "901"}, wantErr: "DelaySeconds must be between 0 and 900"},
+		{name: "number that is not one", queueName: "orders", attributes: map[string]string{"MaximumMessageSize": "big"}, wantErr: "MaximumMessageSize must be a whole number"},
+		{name: "unknown choice", queueName: "orders.fifo", attributes: map[string]string{"DeduplicationScope": "group"}, wantErr: "DeduplicationScope must be messageGroup or queue"},
+		{name: "FIFO attribute on a standard queue", queueName: "orders", attributes: map[string]string{"ContentBasedDeduplication": "true"}, wantErr: "ContentBasedDeduplication is only available for FIFO queues"},
+		{name: "FIFO queue without the suffix", queueName: "orders", attributes: map[string]string{"FifoQueue": "true"}, wantErr: "FIFO queue names must end with .fifo"},
+		{name: "key reuse without a key", queueName: "orders", attributes: map[string]string{"KmsDataKeyReusePeriodSeconds": "300"}, wantErr: "KmsDataKeyReusePeriodSeconds requires KmsMasterKeyId"},
+		{name: "broken redrive policy", queueName: "orders", attributes: map[string]string{"RedrivePolicy": "dlq"}, wantErr: "RedrivePolicy must be a JSON object with deadLetterTargetArn and maxReceiveCount"},
+		{name: "policy with errors", queueName: "orders", attributes: map[string]string{"Policy": `{"Version":"2012-10-17"}`}, wantErr: "Policy: Statement is required."},
+		{name: "reserved tag", queueName: "orders", tags: map[string]string{"aws:team": "x"}, wantErr: "tag keys starting with aws: are reserved"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+			_, err := service.CreateQueueFromAttributes(ctx, RawCreateQueueInput{Name: tc.queueName, Attributes: tc.attributes, Tags: tc.tags})
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestDecodeQueueAttributeJSON(t *testing.T) {
+	t.Run("turns values into strings", func(t *testing.T) {
+		values, err := decodeQueueAttributeJSON(`{"Attributes": {
+			"DelaySeconds": 5,
+			"FifoQueue": true,
+			"RedrivePolicy": {"deadLetterTargetArn": "arn:aws:sqs:us-east-1:0:dlq", "maxReceiveCount": 3},
+			"KmsMasterKeyId": "alias/orders"
+		}}`)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"DelaySeconds":   "5",
+			"FifoQueue":      "true",
+			"RedrivePolicy":  `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:0:dlq","maxReceiveCount":3}`,
+			"KmsMasterKeyId": "alias/orders",
+		}, values)
+	})
+
+	t.Run("reads blank input as no values", func(t *testing.T) {
+		values, err := decodeQueueAttributeJSON("  ")
+		require.NoError(t, err)
+		assert.Empty(t, values)
+	})
+
+	t.Run("rejects what is not an object", func(t *testing.T) {
+		_, err := decodeQueueAttributeJSON(`["DelaySeconds"]`)
+		require.ErrorContains(t, err, "must be a JSON object")
+
+		_, err = decodeQueueAttributeJSON(`{"DelaySeconds": null}`)
+		require.EqualError(t, err, "DelaySeconds must be a string, number, boolean or object")
+	})
+}
@@ -0,0 +1,349 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+)
+
+// QueueDefinitionFormat is the infrastructure-as-code language a queue definition is written in.
+type QueueDefinitionFormat string
+
+const (
+	// QueueDefinitionCloudFormation is a JSON CloudFormation template with an AWS::SQS::Queue
+	// resource, plus an AWS::SQS::QueuePolicy when the queue has an access policy.
+	QueueDefinitionCloudFormation QueueDefinitionFormat = "cloudformation"
+	// QueueDefinitionTerraform is an aws_sqs_queue resource block.
+	QueueDefinitionTerraform QueueDefinitionFormat = "terraform"
+)
+
+// QueueDefinition is the configuration of a live queue written as infrastructure as code.
+type QueueDefinition struct {
+	QueueName string
+	Format    QueueDefinitionFormat
+	Filename  string
+	Body      string
+}
+
+type definitionValueKind int
+
+const (
+	definitionString definitionValueKind = iota
+	definitionNumber
+	definitionBool
+	definitionJSON
+)
+
+// queueDefinitionProperty maps a queue attribute to its CloudFormation property and Terraform
+// argument.
+type queueDefinitionProperty struct {
+	attribute      string
+	cloudFormation string
+	terraform      string
+	kind           definitionValueKind
+}
+
+// queueDefinitionProperties are the attributes written to a queue definition, in the order the
+// Terraform block lists them. Policy has no AWS::SQS::Queue property; CloudFormation gets it as
+// a separate AWS::SQS::QueuePolicy resource.
+var queueDefinitionProperties = []queueDefinitionProperty{
+	{"FifoQueue", "FifoQueue", "fifo_queue", definitionBool},
+	{"ContentBasedDeduplication", "ContentBasedDeduplication", "content_based_deduplication", definitionBool},
+	{"DeduplicationScope", "DeduplicationScope", "deduplication_scope", definitionString},
+	{"FifoThroughputLimit", "FifoThroughputLimit", "fifo_throughput_limit", definitionString},
+	{"DelaySeconds", "DelaySeconds", "delay_seconds", definitionNumber},
+	{"MaximumMessageSize", "MaximumMessageSize", "max_message_size", definitionNumber},
+	{"MessageRetentionPeriod", "MessageRetentionPeriod", "message_retention_seconds", definitionNumber},
+	{"ReceiveMessageWaitTimeSeconds", "ReceiveMessageWaitTimeSeconds", "receive_wait_time_seconds", definitionNumber},
+	{"VisibilityTimeout", "VisibilityTimeout", "visibility_timeout_seconds", definitionNumber},
+	{"KmsMasterKeyId", "KmsMasterKeyId", "kms_master_key_id", definitionString},
+	{"KmsDataKeyReusePeriodSeconds", "KmsDataKeyReusePeriodSeconds", "kms_data_key_reuse_period_seconds", definitionNumber},
+	{"SqsManagedSseEnabled", "SqsManagedSseEnabled", "sqs_managed_sse_enabled", definitionBool},
+	{"RedrivePolicy", "RedrivePolicy", "redrive_policy", definitionJSON},
+	{"RedriveAllowPolicy", "RedriveAllowPolicy", "redrive_allow_policy", definitionJSON},
+	{"Policy", "", "policy", definitionJSON},
+}
+
+// ParseQueueDefinitionFormat reads the format parameter of a queue definition export.
+func ParseQueueDefinitionFormat(raw string) (QueueDefinitionFormat, error) {
+	switch format := QueueDefinitionFormat(strings.ToLower(strings.TrimSpace(raw))); format {
+	case QueueDefinitionCloudFormation, QueueDefinitionTerraform:
+		return format, nil
+	}
+	return "", errors.Newf("format must be %s or %s", QueueDefinitionCloudFormation, QueueDefinitionTerraform)
+}
+
+// QueueDefinition writes the live attributes, tags and redrive policy of a queue as a
+// CloudFormation template or a Terraform block, so a queue set up by hand can be brought under
+// infrastructure as code. Counters, timestamps and the ARN are left out.
+func (s *SqsServiceImpl) QueueDefinition(ctx context.Context, queueURL string, format QueueDefinitionFormat) (QueueDefinition, error) {
+	if strings.TrimSpace(queueURL) == "" {
+		return QueueDefinition{}, errors.New("queue url is required")
+	}
+	if _, err := ParseQueueDefinitionFormat(string(format)); err != nil {
+		return QueueDefinition{}, err
+	}
+
+	detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+	if err != nil {
+		return QueueDefinition{}, err
+	}
+	name := detail.Name
+	if name == "" {
+		name = extractQueueName(queueURL)
+	}
+	attributes := configurationAttributes(detail.Attributes)
+
+	definition := QueueDefinition{QueueName: name, Format: format}
+	switch format {
+	case QueueDefinitionCloudFormation:
+		definition.Filename = name + ".template.json"
+		definition.Body, err = cloudFormationQueueDefinition(name, attributes, detail.Tags)
+	case QueueDefinitionTerraform:
+		definition.Filename = name + ".tf"
+		definition.Body = terraformQueueDefinition(name, attributes, detail.Tags)
+	}
+	if err != nil {
+		return QueueDefinition{}, err
+	}
+	return definition, nil
+}
+
+// definitionValue converts an attribute to the type its property takes. A value that does not
+// parse as that type is kept as text rather than dropped.
+func definitionValue(kind definitionValueKind, raw string) any {
+	switch kind {
+	case definitionNumber:
+		if number, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return number
+		}
+	case definitionBool:
+		if value, err := strconv.ParseBool(raw); err == nil {
+			return value
+		}
+	case definitionJSON:
+		decoder := json.NewDecoder(strings.NewReader(raw))
+		decoder.UseNumber()
+		var value any
+		if err := decoder.Decode(&value); err == nil {
+			return value
+		}
+	}
+	return raw
+}
+
+// cloudFormationQueueDefinition writes a template with the queue as its only resource, and its
+// access policy when it has one.
+func cloudFormationQueueDefinition(name string, attributes, tags map[string]string) (string, error) {
+	logicalID := cloudFormationLogicalID(name)
+	properties := map[string]any{"QueueName": name}
+	for _, property := range queueDefinitionProperties {
+		raw, ok := attributes[property.attribute]
+		if !ok || property.cloudFormation == "" {
+			continue
+		}
+		properties[property.cloudFormation] = definitionValue(property.kind, raw)
+	}
+	if len(tags) > 0 {
+		keys := slices.Sorted(maps.Keys(tags))
+		tagList := make([]map[string]string, 0, len(keys))
+		for _, key := range keys {
+			tagList = append(tagList, map[string]string{"Key": key, "Value": tags[key]})
+		}
+		properties["Tags"] = tagList
+	}
+
+	resources := map[string]any{
+		logicalID: map[string]any{"Type": "AWS::SQS::Queue", "Properties": properties},
+	}
+	if policy, ok := attributes["Policy"]; ok {
+		resources[logicalID+"Policy"] = map[string]any{
+			"Type": "AWS::SQS::QueuePolicy",
+			"Properties": map[string]any{
+				"Queues":         []any{map[string]string{"Ref": logicalID}},
+				"PolicyDocument": definitionValue(definitionJSON, policy),
+			},
+		}
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(map[string]any{
+		"AWSTemplateFormatVersion": "2010-09-09",
+		"Resources":                resources,
+	}); err != nil {
+		return "", errors.Wrap(err, "failed to encode CloudFormation template")
+	}
+	return body.String(), nil
+}
+
+// cloudFormationLogicalID turns a queue name into a logical ID, which may only hold letters and
+// digits: orders-dlq.fifo becomes OrdersDlqFifo.
+func cloudFormationLogicalID(name string) string {
+	var id strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return !isASCIIAlphanumeric(r) }) {
+		id.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	if id.Len() == 0 || unicode.IsDigit(rune(id.String()[0])) {
+		return "Queue" + id.String()
+	}
+	return id.String()
+}
+
+func isASCIIAlphanumeric(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// terraformQueueDefinition writes an aws_sqs_queue block. Scalar arguments come first, aligned as
+// terraform fmt would, then the policies as jsonencode calls and the tags.
+func terraformQueueDefinition(name string, attributes, tags map[string]string) string {
+	type argument struct{ name, value string }
+	scalars := []argument{{"name", hclString(name)}}
+	var blocks []argument
+	for _, property := range queueDefinitionProperties {
+		raw, ok := attributes[property.attribute]
+		if !ok {
+			continue
+		}
+		if property.kind == definitionJSON {
+			value := definitionValue(definitionJSON, raw)
+			if _, isText := value.(string); isText {
+				blocks = append(blocks, argument{property.terraform, hclString(raw)})
+			} else {
+				blocks = append(blocks, argument{property.terraform, "jsonencode(" + hclValue(value, "  ") + ")"})
+			}
+			continue
+		}
+		scalars = append(scalars, argument{property.terraform, hclValue(definitionValue(property.kind, raw), "  ")})
+	}
+	if len(tags) > 0 {
+		values := make(map[string]any, len(tags))
+		for key, value := range tags {
+			values[key] = value
+		}
+		blocks = append(blocks, argument{"tags", hclValue(values, "  ")})
+	}
+
+	width := 0
+	for _, scalar := range scalars {
+		width = max(width, len(scalar.name))
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "resource \"aws_sqs_queue\" %s {\n", hclString(terraformResourceName(name)))
+	for _, scalar := range scalars {
+		fmt.Fprintf(&body, "  %-*s = %s\n", width, scalar.name, scalar.value)
+	}
+	for _, block := range blocks {
+		fmt.Fprintf(&body, "\n  %s = %s\n", block.name, block.value)
+	}
+	body.WriteString("}\n")
+	return body.String()
+}
+
+// terraformResourceName turns a queue name into a resource name in Terraform's snake case:
+// orders-dlq.fifo becomes orders_dlq_fifo.
+func terraformResourceName(name string) string {
+	var resource strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if isASCIIAlphanumeric(r) {
+			resource.WriteRune(r)
+		} else {
+			resource.WriteByte('_')
+		}
+	}
+	if resource.Len() == 0 || unicode.IsDigit(rune(resource.String()[0])) {
+		return "queue_" + resource.String()
+	}
+	return resource.String()
+}
+
+var hclIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// hclValue writes a decoded JSON value as an HCL expression. Nested lines are indented one level
+// deeper than indent, the indentation of the line the value starts on.
+func hclValue(value any, indent string) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return hclString(value)
+	case bool:
+		return strconv.FormatBool(value)
+	case int64:
+		return strconv.FormatInt(value, 10)
+	case json.Number:
+		return value.String()
+	case []any:
+		if len(value) == 0 {
+			return "[]"
+		}
+		var list strings.Builder
+		list.WriteString("[\n")
+		for _, item := range value {
+			list.WriteString(indent + "  " + hclValue(item, indent+"  ") + ",\n")
+		}
+		list.WriteString(indent + "]")
+		return list.String()
+	case map[string]any:
+		if len(value) == 0 {
+			return "{}"
+		}
+		keys := slices.Sorted(maps.Keys(value))
+		labels := make([]string, len(keys))
+		width := 0
+		for i, key := range keys {
+			labels[i] = key
+			if !hclIdentifier.MatchString(key) {
+				labels[i] = hclString(key)
+			}
+			width = max(width, len(labels[i]))
+		}
+		var object strings.Builder
+		object.WriteString("{\n")
+		for i, key := range keys {
+			fmt.Fprintf(&object, "%s  %-*s = %s\n", indent, width, labels[i], hclValue(value[key], indent+"  "))
+		}
+		object.WriteString(indent + "}")
+		return object.String()
+	}
+	return hclString(fmt.Sprint(value))
+}
+
+// hclString quotes text as an HCL string literal. Template sequences are escaped so ${ and %{ in
+// a policy are kept as written rather than interpolated.
+func hclString(text string) string {
+	var quoted strings.Builder
+	quoted.WriteByte('"')
+	for i, r := range text {
+		switch {
+		case r == '"' || r == '\\':
+			quoted.WriteString(`\` + string(r))
+		case r == '\n':
+			quoted.WriteString(`\n`)
+		case r == '\r':
+			quoted.WriteString(`\r`)
+		case r == '\t':
+			quoted.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(text[i+1:], "{"):
+			quoted.WriteString(string(r) + string(r))
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&quoted, `\u%04x`, r)
+		default:
+			quoted.WriteRune(r)
+		}
+	}
+	quoted.WriteByte('"')
+	return quoted.String()
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// QueueDefinitionHandler shows the configuration of a queue as a CloudFormation template or a
+// Terraform block, chosen by the format parameter, as plain text to copy or save.
+func (h *HandlerImpl) QueueDefinitionHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	format, err := ParseQueueDefinitionFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	definition, err := h.s.QueueDefinition(r.Context(), queueURL, format)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to export queue definition", slog.String("queue_url", queueURL), slog.String("format", string(format)), slog.Any("error", err))
+		writeServiceError(w, err, "failed to export queue definition", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", definition.Filename))
+	_, _ = w.Write([]byte(definition.Body))
+}
@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_QueueDefinitionHandler(t *testing.T) {
+	queueURL := "https://sqs.local/000000000000/orders"
+
+	newRequest := func(format string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/queues/"+url.QueryEscape(queueURL)+"/definition?format="+format, nil)
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		return req
+	}
+
+	t.Run("shows the definition as text", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			QueueDefinition(mock.Anything, queueURL, QueueDefinitionTerraform).
+			Return(QueueDefinition{QueueName: "orders", Format: QueueDefinitionTerraform, Filename: "orders.tf", Body: "resource \"aws_sqs_queue\" \"orders\" {}\n"}, nil).
+			Once()
+
+		handler.QueueDefinitionHandler(rr, newRequest("Terraform"))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", rr.Header().Get("Content-Type"))
+		assert.Equal(t, `inline; filename="orders.tf"`, rr.Header().Get("Content-Disposition"))
+		assert.Equal(t, "resource \"aws_sqs_queue\" \"orders\" {}\n", rr.Body.String())
+	})
+
+	t.Run("rejects an unknown format", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		handler.QueueDefinitionHandler(rr, newRequest("yaml"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "format must be cloudformation or terraform\n", rr.Body.String())
+	})
+
+	t.Run("reports a denied queue as forbidden", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			QueueDefinition(mock.Anything, queueURL, QueueDefinitionCloudFormation).
+			Return(QueueDefinition{}, ErrQueueAccessDenied).
+			Once()
+
+		handler.QueueDefinitionHandler(rr, newRequest("cloudformation"))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_QueueDefinition(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders-events.fifo"
+	detail := QueueDetail{
+		QueueSummary: QueueSummary{URL: queueURL, Name: "orders-events.fifo", Type: QueueTypeFIFO},
+		Attributes: map[string]string{
+			"ApproximateNumberOfMessages": "4",
+			"ContentBasedDeduplication":   "true",
+			"FifoQueue":                   "true",
+			"Policy":                      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*","Condition":{"ArnEquals":{"aws:SourceArn":"arn:aws:sns:us-east-1:000000000000:${topic}"}}}]}`,
+			"QueueArn":                    "arn:aws:sqs:us-east-1:000000000000:orders-events.fifo",
+			"RedrivePolicy":               `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo","maxReceiveCount":5}`,
+			"VisibilityTimeout":           "30",
+		},
+		Tags: map[string]string{"team": "payments", "cost:center": "42"},
+	}
+
+	t.Run("writes a CloudFormation template", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(detail, nil).Once()
+
+		definition, err := service.QueueDefinition(ctx, queueURL, QueueDefinitionCloudFormation)
+		require.NoError(t, err)
+		assert.Equal(t, "orders-events.fifo.template.json", definition.Filename)
+		assert.JSONEq(t, `{
+			"AWSTemplateFormatVersion": "2010-09-09",
+			"Resources": {
+				"OrdersEventsFifo": {
+					"Type": "AWS::SQS::Queue",
+					"Properties": {
+						"QueueName": "orders-events.fifo",
+						"ContentBasedDeduplication": true,
+						"FifoQueue": true,
+						"RedrivePolicy": {"deadLetterTargetArn": "arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo", "maxReceiveCount": 5},
+						"VisibilityTimeout": 30,
+						"Tags": [{"Key": "cost:center", "Value": "42"}, {"Key": "team", "Value": "payments"}]
+					}
+				},
+				"OrdersEventsFifoPolicy": {
+					"Type": "AWS::SQS::QueuePolicy",
+					"Properties": {
+						"Queues": [{"Ref": "OrdersEventsFifo"}],
+						"PolicyDocument": {"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"*","Condition":{"ArnEquals":{"aws:SourceArn":"arn:aws:sns:us-east-1:000000000000:${topic}"}}}]}
+					}
+				}
+			}
+		}`, definition.Body)
+	})
+
+	t.Run("writes a Terraform block", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(detail, nil).Once()
+
+		definition, err := service.QueueDefinition(ctx, queueURL, QueueDefinitionTerraform)
+		require.NoError(t, err)
+		assert.Equal(t, "orders-events.fifo.tf", definition.Filename)
+		assert.Equal(t, `resource "aws_sqs_queue" "orders_events_fifo" {
+  name                        = "orders-events.fifo"
+  fifo_queue                  = true
+  content_based_deduplication = true
+  visibility_timeout_seconds  = 30
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = "arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo"
+    maxReceiveCount     = 5
+  })
+
+  policy = jsonencode({
+    Statement = [
+      {
+        Action    = "sqs:SendMessage"
+        Condition = {
+          ArnEquals = {
+            "aws:SourceArn" = "arn:aws:sns:us-east-1:000000000000:$${topic}"
+          }
+        }
+        Effect    = "Allow"
+        Principal = {
+          Service = "sns.amazonaws.com"
+        }
+        Resource  = "*"
+      },
+    ]
+    Version   = "2012-10-17"
+  })
+
+  tags = {
+    "cost:center" = "42"
+    team          = "payments"
+  }
+}
+`, definition.Body)
+	})
+
+	t.Run("rejects an unknown format", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.QueueDefinition(ctx, queueURL, "pulumi")
+		assert.EqualError(t, err, "format must be cloudformation or terraform")
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{}, errors.New("boom")).Once()
+
+		_, err := service.QueueDefinition(ctx, queueURL, QueueDefinitionTerraform)
+		assert.EqualError(t, err, "boom")
+	})
+}
+
+func TestCloudFormationLogicalID(t *testing.T) {
+	assert.Equal(t, "OrdersDlq", cloudFormationLogicalID("orders_dlq"))
+	assert.Equal(t, "Queue2024Events", cloudFormationLogicalID("2024-events"))
+	assert.Equal(t, "Queue", cloudFormationLogicalID("---"))
+}
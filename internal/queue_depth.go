@@ -0,0 +1,208 @@
+package internal
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// QueueAnomaly names a pattern in a queue's recent depth history that needs attention.
+type QueueAnomaly string
+
+const (
+	// QueueAnomalyBacklogGrowing means the available message count rose on every recent sample,
+	// so consumers are not keeping up.
+	QueueAnomalyBacklogGrowing QueueAnomaly = "backlog-growing"
+	// QueueAnomalyInFlightStuck means the same non-zero number of messages stayed in flight for
+	// a while, which usually points at a hung consumer.
+	QueueAnomalyInFlightStuck QueueAnomaly = "in-flight-stuck"
+)
+
+const (
+	// depthSampleInterval is the minimum spacing of depth samples, so an auto-refreshing page
+	// does not fill the history within seconds.
+	depthSampleInterval = 30 * time.Second
+	// depthHistorySize is how many samples are kept per queue.
+	depthHistorySize = 20
+	// backlogGrowthSamples is how many consecutive rising samples flag a growing backlog.
+	backlogGrowthSamples = 5
+	// stuckInFlightAfter is how long the in-flight count must stay unchanged to be flagged.
+	stuckInFlightAfter = 5 * time.Minute
+)
+
+// queueAnomalyLabels are the descriptions shown for each anomaly on the queue list.
+var queueAnomalyLabels = map[QueueAnomaly]string{
+	QueueAnomalyBacklogGrowing: "Backlog has grown on every recent sample",
+	QueueAnomalyInFlightStuck:  "In-flight count has not changed for several minutes",
+}
+
+func anomalyLabels(anomalies []QueueAnomaly) string {
+	labels := make([]string, 0, len(anomalies))
+	for _, anomaly := range anomalies {
+		labels = append(labels, queueAnomalyLabels[anomaly])
+	}
+	return strings.Join(labels, "; ")
+}
+
+type depthSample struct {
+	At        time.Time
+	Available int64
+	InFlight  int64
+}
+
+// depthHistory keeps recent depth samples per queue in memory. Samples are taken whenever the
+// queue list is loaded, by the queue list page, alert evaluation or temporary queue cleanup, so
+// no extra SQS requests are spent on it.
+type depthHistory struct {
+	mu      sync.Mutex
+	samples map[string][]depthSample
+}
+
+func newDepthHistory() *depthHistory {
+	return &depthHistory{samples: make(map[string][]depthSample)}
+}
+
+// record adds a sample for each of queues and forgets queues that are no longer listed. queues
+// must be the complete list.
+func (h *depthHistory) record(now time.Time, queues []QueueSummary) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	listed := make(map[string]struct{}, len(queues))
+	for _, queue := range queues {
+		listed[queue.URL] = struct{}{}
+		samples := h.samples[queue.URL]
+		if n := len(samples); n > 0 && now.Sub(samples[n-1].At) < depthSampleInterval {
+			continue
+		}
+		samples = append(samples, depthSample{At: now, Available: queue.MessagesAvailable, InFlight: queue.MessagesInFlight})
+		if len(samples) > depthHistorySize {
+			samples = samples[len(samples)-depthHistorySize:]
+		}
+		h.samples[queue.URL] = samples
+	}
+	for queueURL := range h.samples {
+		if _, ok := listed[queueURL]; !ok {
+			delete(h.samples, queueURL)
+		}
+	}
+}
+
+// anomalies reports the patterns found in the history of queueURL, or nil when it looks healthy.
+func (h *depthHistory) anomalies(queueURL string) []QueueAnomaly {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	samples := h.samples[queueURL]
+	var found []QueueAnomaly
+	if backlogGrowing(samples) {
+		found = append(found, QueueAnomalyBacklogGrowing)
+	}
+	if inFlightStuck(samples) {
+		found = append(found, QueueAnomalyInFlightStuck)
+	}
+	return found
+}
+
+// history returns a copy of the samples kept for queueURL, oldest first.
+func (h *depthHistory) history(queueURL string) []depthSample {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return slices.Clone(h.samples[queueURL])
+}
+
+// backlogAge returns how long queueURL has had available messages according to its history: the
+// time from the last sample that found it empty to now. The messages waiting now arrived after
+// that sample, so the age bounds the age of the oldest one. exceeded is set when no sample found
+// the queue empty, in which case the backlog may be older than the history. ok is false when the
+// queue is empty or has a single sample.
+func (h *depthHistory) backlogAge(queueURL string, now time.Time) (age time.Duration, exceeded, ok bool) {
+	if h == nil {
+		return 0, false, false
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	samples := h.samples[queueURL]
+	if len(samples) == 0 || samples[len(samples)-1].Available == 0 {
+		return 0, false, false
+	}
+	for i := len(samples) - 2; i >= 0; i-- {
+		if samples[i].Available == 0 {
+			return now.Sub(samples[i].At), false, true
+		}
+	}
+	if len(samples) == 1 {
+		return 0, false, false
+	}
+	return now.Sub(samples[0].At), true, true
+}
+
+func backlogGrowing(samples []depthSample) bool {
+	if len(samples) < backlogGrowthSamples {
+		return false
+	}
+	recent := samples[len(samples)-backlogGrowthSamples:]
+	for i := 1; i < len(recent); i++ {
+		if recent[i].Available <= recent[i-1].Available {
+			return false
+		}
+	}
+	return true
+}
+
+func inFlightStuck(samples []depthSample) bool {
+	if len(samples) < 2 {
+		return false
+	}
+	last := samples[len(samples)-1]
+	if last.InFlight == 0 {
+		return false
+	}
+	for i := len(samples) - 2; i >= 0; i-- {
+		if samples[i].InFlight != last.InFlight {
+			return false
+		}
+		if last.At.Sub(samples[i].At) >= stuckInFlightAfter {
+			return true
+		}
+	}
+	return false
+}
+
+// trackDepths records queues in the depth history and attaches the anomalies found for each.
+func (s *SqsServiceImpl) trackDepths(queues []QueueSummary) {
+	if s.depths == nil {
+		return
+	}
+	s.depths.record(s.now(), queues)
+	s.describeDepths(queues)
+}
+
+// describeDepths sets the anomalies and backlog age of queues from the recorded history without
+// recording them, for listings that hold only part of the queues.
+func (s *SqsServiceImpl) describeDepths(queues []QueueSummary) {
+	if s.depths == nil {
+		return
+	}
+	now := s.now()
+	for i := range queues {
+		queues[i].Anomalies = s.depths.anomalies(queues[i].URL)
+		queues[i].BacklogAge, queues[i].BacklogAgeExceeded, _ = s.depths.backlogAge(queues[i].URL, now)
+	}
+}
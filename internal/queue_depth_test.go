@@ -0,0 +1,205 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepthHistory_Anomalies(t *testing.T) {
+	base := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	const queueURL = "https://sqs.local/orders"
+
+	testCases := []struct {
+		name      string
+		available []int64
+		inFlight  []int64
+		want      []QueueAnomaly
+	}{
+		{
+			name:      "steady queue",
+			available: []int64{4, 2, 5, 3, 4, 1},
+			inFlight:  []int64{1, 0, 2, 1, 0, 3},
+		},
+		{
+			name:      "backlog rising on every sample",
+			available: []int64{1, 2, 3, 5, 8},
+			inFlight:  []int64{0, 0, 0, 0, 0},
+			want:      []QueueAnomaly{QueueAnomalyBacklogGrowing},
+		},
+		{
+			name:      "backlog rising with a dip",
+			available: []int64{1, 2, 3, 3, 8},
+			inFlight:  []int64{0, 0, 0, 0, 0},
+		},
+		{
+			name:      "too few samples to tell",
+			available: []int64{1, 2, 3, 4},
+			inFlight:  []int64{0, 0, 0, 0},
+		},
+		{
+			name:      "in-flight count unchanged for minutes",
+			available: []int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			inFlight:  []int64{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+			want:      []QueueAnomaly{QueueAnomalyInFlightStuck},
+		},
+		{
+			name:      "in-flight count unchanged but not for long",
+			available: []int64{0, 0, 0, 0},
+			inFlight:  []int64{3, 3, 3, 3},
+		},
+		{
+			name:      "nothing in flight",
+			available: []int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			inFlight:  []int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			history := newDepthHistory()
+			for i := range tc.available {
+				history.record(base.Add(time.Duration(i)*depthSampleInterval), []QueueSummary{{
+					URL:               queueURL,
+					MessagesAvailable: tc.available[i],
+					MessagesInFlight:  tc.inFlight[i],
+				}})
+			}
+
+			assert.Equal(t, tc.want, history.anomalies(queueURL))
+		})
+	}
+}
+
+func TestDepthHistory_Record(t *testing.T) {
+	base := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	history := newDepthHistory()
+
+	orders := QueueSummary{URL: "https://sqs.local/orders", MessagesAvailable: 1}
+	billing := QueueSummary{URL: "https://sqs.local/billing"}
+	history.record(base, []QueueSummary{orders, billing})
+
+	// A refresh within the sample interval is not recorded.
+	orders.MessagesAvailable = 2
+	history.record(base.Add(10*time.Second), []QueueSummary{orders, billing})
+	require.Len(t, history.samples[orders.URL], 1)
+
+	// Queues that disappear from the list are forgotten.
+	history.record(base.Add(depthSampleInterval), []QueueSummary{orders})
+	assert.Len(t, history.samples[orders.URL], 2)
+	assert.NotContains(t, history.samples, billing.URL)
+
+	for i := 2; i < depthHistorySize+5; i++ {
+		history.record(base.Add(time.Duration(i)*depthSampleInterval), []QueueSummary{orders})
+	}
+	assert.Len(t, history.samples[orders.URL], depthHistorySize)
+}
+
+func TestDepthHistory_BacklogAge(t *testing.T) {
+	base := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	const queueURL = "https://sqs.local/orders"
+
+	testCases := []struct {
+		name         string
+		available    []int64
+		wantAge      time.Duration
+		wantExceeded bool
+		wantOK       bool
+	}{
+		{
+			name:      "empty queue",
+			available: []int64{3, 0},
+		},
+		{
+			name:      "single sample",
+			available: []int64{3},
+		},
+		{
+			name:      "messages since the last empty sample",
+			available: []int64{2, 0, 1, 4},
+			wantAge:   3 * depthSampleInterval,
+			wantOK:    true,
+		},
+		{
+			name:         "never seen empty",
+			available:    []int64{2, 1, 4},
+			wantAge:      3 * depthSampleInterval,
+			wantExceeded: true,
+			wantOK:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			history := newDepthHistory()
+			for i, available := range tc.available {
+				history.record(base.Add(time.Duration(i)*depthSampleInterval), []QueueSummary{{URL: queueURL, MessagesAvailable: available}})
+			}
+
+			now := base.Add(time.Duration(len(tc.available)) * depthSampleInterval)
+			age, exceeded, ok := history.backlogAge(queueURL, now)
+			assert.Equal(t, tc.wantAge, age)
+			assert.Equal(t, tc.wantExceeded, exceeded)
+			assert.Equal(t, tc.wantOK, ok)
+		})
+	}
+}
+
+func TestSqsServiceImpl_Queues_FlagsAnomalies(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo, depths: newDepthHistory(), clock: func() time.Time { return now }}
+
+	for available := int64(1); available <= backlogGrowthSamples; available++ {
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{
+			{URL: "https://sqs.local/orders", Name: "orders", MessagesAvailable: available},
+			{URL: "https://sqs.local/billing", Name: "billing"},
+		}, nil).Once()
+		now = now.Add(depthSampleInterval)
+
+		page, err := service.FindQueues(ctx, QueueListOptions{})
+		require.NoError(t, err)
+		require.Len(t, page.Queues, 2)
+		assert.Empty(t, page.Queues[0].Anomalies, "billing")
+		if available < backlogGrowthSamples {
+			assert.Empty(t, page.Queues[1].Anomalies)
+		} else {
+			assert.Equal(t, []QueueAnomaly{QueueAnomalyBacklogGrowing}, page.Queues[1].Anomalies)
+		}
+	}
+}
+
+func TestSqsServiceImpl_QueuesPage_KeepsDepthHistory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo, depths: newDepthHistory(), clock: func() time.Time { return now }}
+
+	for available := int64(1); available <= backlogGrowthSamples; available++ {
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{
+			{URL: "https://sqs.local/orders", Name: "orders", MessagesAvailable: available},
+			{URL: "https://sqs.local/billing", Name: "billing"},
+		}, nil).Once()
+		now = now.Add(depthSampleInterval)
+		_, err := service.Queues(ctx)
+		require.NoError(t, err)
+	}
+
+	repo.EXPECT().ListQueuesPage(mock.Anything, ListQueuesPageRepositoryInput{MaxResults: 1}).Return(QueueSummaryPage{
+		Queues:    []QueueSummary{{URL: "https://sqs.local/orders", Name: "orders", MessagesAvailable: 1}},
+		NextToken: "page-2",
+	}, nil).Once()
+	page, err := service.QueuesPage(ctx, 1, "")
+	require.NoError(t, err)
+	assert.Equal(t, []QueueAnomaly{QueueAnomalyBacklogGrowing}, page.Queues[0].Anomalies)
+
+	service.depths.mu.Lock()
+	defer service.depths.mu.Unlock()
+	assert.Len(t, service.depths.samples["https://sqs.local/orders"], backlogGrowthSamples, "a page records no sample")
+	assert.Len(t, service.depths.samples["https://sqs.local/billing"], backlogGrowthSamples, "queues on other pages are kept")
+}
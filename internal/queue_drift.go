@@ -0,0 +1,255 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrBaselineNotFound is returned when a queue has no saved configuration baseline.
+var ErrBaselineNotFound = errors.New("the queue has no configuration baseline")
+
+// Kinds of drift between a baseline and the live queue.
+const (
+	DriftChanged = "changed"
+	DriftAdded   = "added"
+	DriftRemoved = "removed"
+)
+
+// QueueBaseline is the configuration a queue is expected to keep: the attributes CreateQueue
+// accepts and the tags, as they were when the baseline was saved.
+type QueueBaseline struct {
+	QueueURL   string            `json:"queueUrl"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	SavedAt    time.Time         `json:"savedAt"`
+}
+
+// DriftChange is one attribute or tag that differs from an earlier configuration, a baseline or
+// the previous history snapshot, which Baseline holds the value of. Tag is set for tags.
+type DriftChange struct {
+	Name     string `json:"name"`
+	Tag      bool   `json:"tag,omitempty"`
+	Change   string `json:"change"`
+	Baseline string `json:"baseline,omitempty"`
+	Current  string `json:"current,omitempty"`
+}
+
+// QueueDriftState pairs a baseline with the outcome of its latest check. Changes is empty while
+// the queue matches its baseline; CheckedAt is zero until the first check.
+type QueueDriftState struct {
+	Baseline  QueueBaseline
+	Changes   []DriftChange
+	CheckedAt time.Time
+	Error     string
+}
+
+// driftTracker keeps check results in memory; baselines are unchecked again after a restart.
+type driftTracker struct {
+	mu     sync.Mutex
+	states map[string]QueueDriftState
+}
+
+func newDriftTracker() *driftTracker {
+	return &driftTracker{states: make(map[string]QueueDriftState)}
+}
+
+// SaveQueueBaseline records the current configuration of a queue as its baseline, replacing an
+// earlier one.
+func (s *SqsServiceImpl) SaveQueueBaseline(ctx context.Context, queueURL string) (QueueBaseline, error) {
+	if s.store == nil {
+		return QueueBaseline{}, errors.New("baselines are not available without a state store")
+	}
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return QueueBaseline{}, errors.New("queue url is required")
+	}
+
+	detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+	if err != nil {
+		return QueueBaseline{}, err
+	}
+	baseline := QueueBaseline{
+		QueueURL:   queueURL,
+		Attributes: configurationAttributes(detail.Attributes),
+		Tags:       maps.Clone(detail.Tags),
+		SavedAt:    s.now().UTC(),
+	}
+	if err := s.store.SaveBaseline(baseline); err != nil {
+		return QueueBaseline{}, err
+	}
+
+	s.drift.mu.Lock()
+	s.drift.states[queueURL] = QueueDriftState{Baseline: baseline, CheckedAt: baseline.SavedAt}
+	s.drift.mu.Unlock()
+
+	return baseline, nil
+}
+
+// DeleteQueueBaseline stops watching a queue for drift.
+func (s *SqsServiceImpl) DeleteQueueBaseline(_ context.Context, queueURL string) error {
+	if s.store == nil {
+		return ErrBaselineNotFound
+	}
+	if err := s.store.DeleteBaseline(queueURL); err != nil {
+		return err
+	}
+
+	s.drift.mu.Lock()
+	delete(s.drift.states, queueURL)
+	s.drift.mu.Unlock()
+
+	return nil
+}
+
+// QueueDrift returns every baseline with the result of its latest check, ordered by queue name.
+func (s *SqsServiceImpl) QueueDrift(_ context.Context) ([]QueueDriftState, error) {
+	if s.store == nil {
+		return []QueueDriftState{}, nil
+	}
+
+	baselines, err := s.store.Baselines()
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(baselines, func(a, b QueueBaseline) int {
+		return cmp.Or(strings.Compare(extractQueueName(a.QueueURL), extractQueueName(b.QueueURL)), strings.Compare(a.QueueURL, b.QueueURL))
+	})
+
+	s.drift.mu.Lock()
+	defer s.drift.mu.Unlock()
+
+	states := make([]QueueDriftState, 0, len(baselines))
+	for _, baseline := range baselines {
+		state := s.drift.states[baseline.QueueURL]
+		state.Baseline = baseline
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// CheckDrift compares every baseline with the live queue and notifies when a queue starts to
+// differ from its baseline, when the differences change and when it matches again.
+func (s *SqsServiceImpl) CheckDrift(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+
+	baselines, err := s.store.Baselines()
+	if err != nil {
+		return err
+	}
+
+	for _, baseline := range baselines {
+		state := QueueDriftState{Baseline: baseline, CheckedAt: s.now().UTC()}
+		detail, err := s.repo.GetQueueDetail(ctx, baseline.QueueURL)
+		if err != nil {
+			state.Error = err.Error()
+		} else {
+			state.Changes = queueDriftChanges(baseline, detail)
+		}
+
+		s.drift.mu.Lock()
+		previous, checked := s.drift.states[baseline.QueueURL]
+		if state.Error != "" {
+			// A failed check says nothing about the configuration; keep the last known changes.
+			state.Changes = previous.Changes
+		}
+		s.drift.states[baseline.QueueURL] = state
+		s.drift.mu.Unlock()
+
+		if state.Error != "" || (checked && slices.Equal(previous.Changes, state.Changes)) {
+			continue
+		}
+		name := extractQueueName(baseline.QueueURL)
+		switch {
+		case len(state.Changes) > 0:
+			s.notify(ctx, Notification{
+				Title: fmt.Sprintf("Configuration drift: %s", name),
+				Text:  fmt.Sprintf("%s differs from its baseline: %s.", baseline.QueueURL, describeDriftChanges(state.Changes)),
+			})
+		case checked && len(previous.Changes) > 0:
+			s.notify(ctx, Notification{
+				Title: fmt.Sprintf("Configuration drift resolved: %s", name),
+				Text:  fmt.Sprintf("%s matches its baseline again.", baseline.QueueURL),
+			})
+		}
+	}
+
+	s.drift.mu.Lock()
+	for queueURL := range s.drift.states {
+		if !slices.ContainsFunc(baselines, func(baseline QueueBaseline) bool { return baseline.QueueURL == queueURL }) {
+			delete(s.drift.states, queueURL)
+		}
+	}
+	s.drift.mu.Unlock()
+
+	return nil
+}
+
+// configurationAttributes keeps the attributes that describe how a queue is set up, leaving out
+// counters and timestamps that change on their own.
+func configurationAttributes(attributes map[string]string) map[string]string {
+	kept := make(map[string]string, len(restorableQueueAttributes))
+	for _, name := range restorableQueueAttributes {
+		if value, ok := attributes[name]; ok {
+			kept[name] = value
+		}
+	}
+	return kept
+}
+
+// queueDriftChanges lists the differences between a baseline and the live queue, attributes first,
+// each in name order.
+func queueDriftChanges(baseline QueueBaseline, detail QueueDetail) []DriftChange {
+	changes := diffDriftValues(baseline.Attributes, configurationAttributes(detail.Attributes), false)
+	return append(changes, diffDriftValues(baseline.Tags, detail.Tags, true)...)
+}
+
+func diffDriftValues(baseline, current map[string]string, tag bool) []DriftChange {
+	names := slices.Collect(maps.Keys(baseline))
+	for name := range current {
+		if _, ok := baseline[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var changes []DriftChange
+	for _, name := range names {
+		before, inBaseline := baseline[name]
+		after, inCurrent := current[name]
+		change := DriftChange{Name: name, Tag: tag, Baseline: before, Current: after}
+		switch {
+		case !inCurrent:
+			change.Change = DriftRemoved
+		case !inBaseline:
+			change.Change = DriftAdded
+		case before != after:
+			change.Change = DriftChanged
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func describeDriftChanges(changes []DriftChange) string {
+	parts := make([]string, 0, len(changes))
+	for _, change := range changes {
+		name := change.Name
+		if change.Tag {
+			name = "tag " + name
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", name, change.Change))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+)
+
+type driftPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	Flash        *pageFlash
+	ErrorMessage string
+	Queues       []queueDriftView
+}
+
+type queueDriftView struct {
+	Name       string
+	URL        string
+	EscapedURL string
+	SavedAt    string
+	CheckedAt  string
+	// Status is "in sync", "drifted", "unchecked" or "error".
+	Status  string
+	Error   string
+	Changes []driftChangeView
+}
+
+type driftChangeView struct {
+	Name     string
+	Change   string
+	Baseline string
+	Current  string
+}
+
+// DriftHandler lists the queues with a configuration baseline and how they differ from it.
+func (h *HandlerImpl) DriftHandler(w http.ResponseWriter, r *http.Request) {
+	var flash *pageFlash
+	query := r.URL.Query()
+	switch query.Get("done") {
+	case "saved":
+		flash = &pageFlash{Message: fmt.Sprintf("Baseline of %s was saved.", query.Get("queue")), Kind: "success"}
+	case "deleted":
+		flash = &pageFlash{Message: fmt.Sprintf("%s is no longer checked for drift.", query.Get("queue")), Kind: "success"}
+	case "checked":
+		flash = &pageFlash{Message: "Every baseline was checked.", Kind: "success"}
+	}
+
+	h.renderDrift(w, r, http.StatusOK, driftPageData{Flash: flash})
+}
+
+// PostDriftCheckHandler checks every baseline right away instead of waiting for the next interval.
+func (h *HandlerImpl) PostDriftCheckHandler(w http.ResponseWriter, r *http.Request) {
+	if err := h.s.CheckDrift(r.Context()); err != nil {
+		slog.ErrorContext(r.Context(), "failed to check configuration drift", slog.Any("error", err))
+		h.renderDrift(w, r, http.StatusInternalServerError, driftPageData{ErrorMessage: "Failed to check baselines: " + err.Error()})
+		return
+	}
+
+	http.Redirect(w, r, "/drift?done=checked", http.StatusSeeOther)
+}
+
+// SaveBaselineHandler saves the current configuration of a queue as its baseline.
+func (h *HandlerImpl) SaveBaselineHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	if _, err := h.s.SaveQueueBaseline(r.Context(), queueURL); err != nil {
+		slog.ErrorContext(r.Context(), "failed to save queue baseline", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeServiceError(w, err, "failed to save baseline", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/drift?done=saved&queue="+url.QueryEscape(extractQueueName(queueURL)), http.StatusSeeOther)
+}
+
+// DeleteBaselineHandler stops checking a queue for drift.
+func (h *HandlerImpl) DeleteBaselineHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	if err := h.s.DeleteQueueBaseline(r.Context(), queueURL); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete queue baseline", slog.String("queue_url", queueURL), slog.Any("error", err))
+		if errors.Is(err, ErrBaselineNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, "failed to delete baseline", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/drift?done=deleted&queue="+url.QueryEscape(extractQueueName(queueURL)), http.StatusSeeOther)
+}
+
+func (h *HandlerImpl) renderDrift(w http.ResponseWriter, r *http.Request, status int, data driftPageData) {
+	data.Title = "Configuration drift"
+	data.ViteTags = fragments["assets/js/drift.ts"].Tags
+
+	states, err := h.s.QueueDrift(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load baselines", slog.Any("error", err))
+		data.ErrorMessage = "Failed to load baselines."
+	}
+	for _, state := range states {
+		data.Queues = append(data.Queues, newQueueDriftView(state))
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["drift"].Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "failed to render drift template", slog.Any("error", err))
+	}
+}
+
+func newQueueDriftView(state QueueDriftState) queueDriftView {
+	view := queueDriftView{
+		Name:       extractQueueName(state.Baseline.QueueURL),
+		URL:        state.Baseline.QueueURL,
+		EscapedURL: url.QueryEscape(state.Baseline.QueueURL),
+		SavedAt:    state.Baseline.SavedAt.Format(displayTimeLayout),
+		Error:      state.Error,
+	}
+	switch {
+	case state.Error != "":
+		view.Status = "error"
+	case state.CheckedAt.IsZero():
+		view.Status = "unchecked"
+	case len(state.Changes) > 0:
+		view.Status = "drifted"
+	default:
+		view.Status = "in sync"
+	}
+	if !state.CheckedAt.IsZero() {
+		view.CheckedAt = state.CheckedAt.Format(displayTimeLayout)
+	}
+	for _, change := range state.Changes {
+		name := change.Name
+		if change.Tag {
+			name = "tag: " + name
+		}
+		view.Changes = append(view.Changes, driftChangeView{
+			Name:     name,
+			Change:   change.Change,
+			Baseline: change.Baseline,
+			Current:  change.Current,
+		})
+	}
+	return view
+}
@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_DriftHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/drift?done=saved&queue=orders", nil)
+	rr := httptest.NewRecorder()
+
+	var captured driftPageData
+	captureTemplate(t, "drift", func(data driftPageData) { captured = data })
+	installFragment(t, "assets/js/drift.ts", "")
+
+	saved := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockService.EXPECT().QueueDrift(mock.Anything).Return([]QueueDriftState{
+		{
+			Baseline:  QueueBaseline{QueueURL: "https://sqs.local/orders", SavedAt: saved},
+			CheckedAt: saved.Add(time.Hour),
+			Changes: []DriftChange{
+				{Name: "VisibilityTimeout", Change: DriftChanged, Baseline: "30", Current: "60"},
+				{Name: "owner", Tag: true, Change: DriftAdded, Current: "someone"},
+			},
+		},
+		{Baseline: QueueBaseline{QueueURL: "https://sqs.local/payments", SavedAt: saved}},
+	}, nil).Once()
+
+	handler.DriftHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, &pageFlash{Message: "Baseline of orders was saved.", Kind: "success"}, captured.Flash)
+	assert.Equal(t, []queueDriftView{
+		{
+			Name:       "orders",
+			URL:        "https://sqs.local/orders",
+			EscapedURL: url.QueryEscape("https://sqs.local/orders"),
+			SavedAt:    "2024-05-01 12:00:00 UTC",
+			CheckedAt:  "2024-05-01 13:00:00 UTC",
+			Status:     "drifted",
+			Changes: []driftChangeView{
+				{Name: "VisibilityTimeout", Change: "changed", Baseline: "30", Current: "60"},
+				{Name: "tag: owner", Change: "added", Current: "someone"},
+			},
+		},
+		{
+			Name:       "payments",
+			URL:        "https://sqs.local/payments",
+			EscapedURL: url.QueryEscape("https://sqs.local/payments"),
+			SavedAt:    "2024-05-01 12:00:00 UTC",
+			Status:     "unchecked",
+		},
+	}, captured.Queues)
+}
+
+func TestHandlerImpl_SaveBaselineHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/baseline", nil)
+	req.SetPathValue("url", escaped)
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().SaveQueueBaseline(mock.Anything, queueURL).Return(QueueBaseline{QueueURL: queueURL}, nil).Once()
+
+	handler.SaveBaselineHandler(rr, req)
+
+	assert.Equal(t, http.StatusSeeOther, rr.Code)
+	assert.Equal(t, "/drift?done=saved&queue=orders", rr.Header().Get("Location"))
+}
+
+func TestHandlerImpl_DeleteBaselineHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/baseline/delete", nil)
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("redirects to the drift page", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().DeleteQueueBaseline(mock.Anything, queueURL).Return(nil).Once()
+
+		handler.DeleteBaselineHandler(rr, newRequest())
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/drift?done=deleted&queue=orders", rr.Header().Get("Location"))
+	})
+
+	t.Run("returns 404 without a baseline", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().DeleteQueueBaseline(mock.Anything, queueURL).Return(ErrBaselineNotFound).Once()
+
+		handler.DeleteBaselineHandler(rr, newRequest())
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_QueueDrift(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository, *MockNotifier) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		repo := NewMockSqsRepository(t)
+		notifier := NewMockNotifier(t)
+		return &SqsServiceImpl{
+			repo:      repo,
+			store:     store,
+			notifiers: map[NotificationChannel]Notifier{NotificationChannelWebhook: notifier},
+			drift:     newDriftTracker(),
+			clock:     func() time.Time { return now },
+		}, repo, notifier
+	}
+	baselineDetail := QueueDetail{
+		Attributes: map[string]string{
+			"VisibilityTimeout":           "30",
+			"RedrivePolicy":               `{"maxReceiveCount":"3"}`,
+			"ApproximateNumberOfMessages": "12",
+		},
+		Tags: map[string]string{"team": "payments"},
+	}
+
+	t.Run("saves the configuration attributes and tags", func(t *testing.T) {
+		service, repo, _ := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(baselineDetail, nil).Once()
+
+		baseline, err := service.SaveQueueBaseline(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, QueueBaseline{
+			QueueURL:   queueURL,
+			Attributes: map[string]string{"VisibilityTimeout": "30", "RedrivePolicy": `{"maxReceiveCount":"3"}`},
+			Tags:       map[string]string{"team": "payments"},
+			SavedAt:    now,
+		}, baseline)
+
+		states, err := service.QueueDrift(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []QueueDriftState{{Baseline: baseline, CheckedAt: now}}, states)
+	})
+
+	t.Run("reports drift once and notifies when it is resolved", func(t *testing.T) {
+		service, repo, notifier := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(baselineDetail, nil).Once()
+		_, err := service.SaveQueueBaseline(ctx, queueURL)
+		require.NoError(t, err)
+
+		drifted := QueueDetail{
+			Attributes: map[string]string{"VisibilityTimeout": "60", "DelaySeconds": "5", "ApproximateNumberOfMessages": "0"},
+			Tags:       map[string]string{"team": "payments", "owner": "someone"},
+		}
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(drifted, nil).Twice()
+		notifier.EXPECT().Notify(mock.Anything, Notification{
+			Title: "Configuration drift: orders",
+			Text:  queueURL + " differs from its baseline: DelaySeconds added, RedrivePolicy removed, VisibilityTimeout changed, tag owner added.",
+		}).Return(nil).Once()
+
+		require.NoError(t, service.CheckDrift(ctx))
+		// The same drift is not announced again.
+		require.NoError(t, service.CheckDrift(ctx))
+
+		states, err := service.QueueDrift(ctx)
+		require.NoError(t, err)
+		require.Len(t, states, 1)
+		assert.Equal(t, []DriftChange{
+			{Name: "DelaySeconds", Change: DriftAdded, Current: "5"},
+			{Name: "RedrivePolicy", Change: DriftRemoved, Baseline: `{"maxReceiveCount":"3"}`},
+			{Name: "VisibilityTimeout", Change: DriftChanged, Baseline: "30", Current: "60"},
+			{Name: "owner", Tag: true, Change: DriftAdded, Current: "someone"},
+		}, states[0].Changes)
+
+		// A failed check keeps the known drift without notifying.
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{}, errors.New("throttled")).Once()
+		require.NoError(t, service.CheckDrift(ctx))
+		states, err = service.QueueDrift(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "throttled", states[0].Error)
+		assert.Len(t, states[0].Changes, 4)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(baselineDetail, nil).Once()
+		notifier.EXPECT().Notify(mock.Anything, Notification{
+			Title: "Configuration drift resolved: orders",
+			Text:  queueURL + " matches its baseline again.",
+		}).Return(nil).Once()
+		require.NoError(t, service.CheckDrift(ctx))
+	})
+
+	t.Run("forgets a deleted baseline", func(t *testing.T) {
+		service, repo, _ := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(baselineDetail, nil).Once()
+		_, err := service.SaveQueueBaseline(ctx, queueURL)
+		require.NoError(t, err)
+
+		require.NoError(t, service.DeleteQueueBaseline(ctx, queueURL))
+		require.ErrorIs(t, service.DeleteQueueBaseline(ctx, queueURL), ErrBaselineNotFound)
+
+		states, err := service.QueueDrift(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, states)
+		require.NoError(t, service.CheckDrift(ctx))
+	})
+}
@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// QueueExport is one queue of a queue list export with all of its attributes and tags. Error is
+// set when they could not be read, in which case only the fields of the queue list are known.
+type QueueExport struct {
+	QueueDetail
+	Error string
+}
+
+// ExportQueues returns the queues matched by opts, in its order, with every attribute and tag,
+// for a spreadsheet of the queue list. The whole filtered list is exported; Limit and Offset are
+// ignored. Queues whose attributes cannot be read are kept with the error rather than failing
+// the export.
+func (s *SqsServiceImpl) ExportQueues(ctx context.Context, opts QueueListOptions) ([]QueueExport, error) {
+	opts.Limit, opts.Offset, opts.Tags = 0, 0, false
+	opts.GroupBy, opts.GroupTag = "", ""
+	page, err := s.FindQueues(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	exports := make([]QueueExport, len(page.Queues))
+	slots := make(chan struct{}, statsConcurrency)
+	var wg sync.WaitGroup
+	for i, queue := range page.Queues {
+		wg.Add(1)
+		go func(i int, queue QueueSummary) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			detail, err := s.repo.GetQueueDetail(ctx, queue.URL)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to read queue for export", slog.String("queue_url", queue.URL), slog.Any("error", err))
+				exports[i] = QueueExport{QueueDetail: QueueDetail{QueueSummary: queue}, Error: err.Error()}
+				return
+			}
+			detail.Anomalies = queue.Anomalies
+			exports[i] = QueueExport{QueueDetail: detail}
+		}(i, queue)
+	}
+	wg.Wait()
+
+	return exports, nil
+}
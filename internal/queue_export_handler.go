@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"encoding/csv"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ExportQueuesCSVHandler downloads the queue list as CSV, filtered and sorted by the same q, type,
+// sort and order parameters as the queue list page. Each attribute any queue has gets a column,
+// in name order, followed by the tags and the error of queues that could not be read.
+func (h *HandlerImpl) ExportQueuesCSVHandler(w http.ResponseWriter, r *http.Request) {
+	opts, err := queueListOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	exports, err := h.s.ExportQueues(r.Context(), opts)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to export queue list", slog.Any("error", err))
+		writeServiceError(w, err, "failed to export queues", http.StatusInternalServerError)
+		return
+	}
+
+	var attributeNames []string
+	for _, export := range exports {
+		for name := range export.Attributes {
+			if !slices.Contains(attributeNames, name) {
+				attributeNames = append(attributeNames, name)
+			}
+		}
+	}
+	slices.Sort(attributeNames)
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="queues.csv"`)
+
+	writer := csv.NewWriter(w)
+	header := append([]string{"Name", "URL", "Type"}, attributeNames...)
+	header = append(header, "Tags", "Error")
+	_ = writer.Write(header)
+
+	for _, export := range exports {
+		record := []string{csvCell(export.Name), csvCell(export.URL), string(export.Type)}
+		for _, name := range attributeNames {
+			record = append(record, csvCell(export.Attributes[name]))
+		}
+		record = append(record, csvCell(queueTagsCSV(export.Tags)), csvCell(export.Error))
+		_ = writer.Write(record)
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		slog.ErrorContext(r.Context(), "failed to write queue export", slog.Any("error", err))
+	}
+}
+
+// queueTagsCSV joins tags as key=value pairs sorted by key, separated by semicolons.
+func queueTagsCSV(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for key, value := range tags {
+		pairs = append(pairs, key+"="+value)
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, "; ")
+}
+
+// csvCell keeps spreadsheets from evaluating a value as a formula by prefixing an apostrophe to
+// text starting with a formula character. Numbers such as -1 are left alone.
+func csvCell(value string) string {
+	if value == "" || !strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	return "'" + value
+}
@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_ExportQueuesCSVHandler(t *testing.T) {
+	t.Run("writes a column per attribute", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		mockService.EXPECT().
+			ExportQueues(mock.Anything, QueueListOptions{Query: "orders", Sort: QueueSortAvailable, Descending: true}).
+			Return([]QueueExport{
+				{QueueDetail: QueueDetail{
+					QueueSummary: QueueSummary{URL: "https://sqs.local/orders", Name: "orders", Type: QueueTypeStandard},
+					Attributes:   map[string]string{"VisibilityTimeout": "30", "DelaySeconds": "0"},
+					Tags:         map[string]string{"team": "core", "=cmd": "x"},
+				}},
+				{
+					QueueDetail: QueueDetail{QueueSummary: QueueSummary{URL: "https://sqs.local/orders.fifo", Name: "orders.fifo", Type: QueueTypeFIFO}},
+					Error:       "denied",
+				},
+			}, nil).
+			Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/queues/export.csv?q=orders&sort=available&order=desc", nil)
+		rr := httptest.NewRecorder()
+		handler.ExportQueuesCSVHandler(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="queues.csv"`, rr.Header().Get("Content-Disposition"))
+		assert.Equal(t, "Name,URL,Type,DelaySeconds,VisibilityTimeout,Tags,Error\n"+
+			"orders,https://sqs.local/orders,standard,0,30,'=cmd=x; team=core,\n"+
+			"orders.fifo,https://sqs.local/orders.fifo,fifo,,,,denied\n", rr.Body.String())
+	})
+
+	t.Run("rejects invalid list parameters", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+
+		req := httptest.NewRequest(http.MethodGet, "/queues/export.csv?order=sideways", nil)
+		rr := httptest.NewRecorder()
+		handler.ExportQueuesCSVHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("reports a listing failure", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().ExportQueues(mock.Anything, QueueListOptions{}).Return(nil, errors.New("boom")).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/queues/export.csv", nil)
+		rr := httptest.NewRecorder()
+		handler.ExportQueuesCSVHandler(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, "failed to export queues\n", rr.Body.String())
+	})
+}
+
+func TestCsvCell(t *testing.T) {
+	assert.Equal(t, "orders", csvCell("orders"))
+	assert.Equal(t, "-1", csvCell("-1"))
+	assert.Equal(t, "'-orders", csvCell("-orders"))
+	assert.Equal(t, "'@SUM(A1)", csvCell("@SUM(A1)"))
+	assert.Equal(t, "", csvCell(""))
+}
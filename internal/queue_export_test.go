@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_ExportQueues(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo}
+
+	repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{
+		{URL: "https://sqs.local/orders", Name: "orders", MessagesAvailable: 1},
+		{URL: "https://sqs.local/orders-dlq", Name: "orders-dlq", MessagesAvailable: 9},
+		{URL: "https://sqs.local/billing", Name: "billing", MessagesAvailable: 5},
+	}, nil).Once()
+	repo.EXPECT().GetQueueDetail(mock.Anything, "https://sqs.local/orders-dlq").Return(QueueDetail{
+		QueueSummary: QueueSummary{URL: "https://sqs.local/orders-dlq", Name: "orders-dlq"},
+		Attributes:   map[string]string{"VisibilityTimeout": "30"},
+		Tags:         map[string]string{"team": "core"},
+	}, nil).Once()
+	repo.EXPECT().GetQueueDetail(mock.Anything, "https://sqs.local/orders").Return(QueueDetail{}, errors.New("denied")).Once()
+
+	exports, err := service.ExportQueues(ctx, QueueListOptions{Query: "orders", Sort: QueueSortAvailable, Descending: true, Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, exports, 2)
+	assert.Equal(t, "orders-dlq", exports[0].Name)
+	assert.Equal(t, map[string]string{"VisibilityTimeout": "30"}, exports[0].Attributes)
+	assert.Equal(t, map[string]string{"team": "core"}, exports[0].Tags)
+	assert.Empty(t, exports[0].Error)
+	assert.Equal(t, "orders", exports[1].Name)
+	assert.Equal(t, int64(1), exports[1].MessagesAvailable)
+	assert.Equal(t, "denied", exports[1].Error)
+}
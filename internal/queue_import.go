@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// QueueImportDefinition is one queue of an import file. Attribute and tag values may be written
+// as numbers, booleans or, for policies, nested objects; they are sent to SQS as text.
+type QueueImportDefinition struct {
+	Name       string         `yaml:"name"`
+	Attributes map[string]any `yaml:"attributes"`
+	Tags       map[string]any `yaml:"tags"`
+}
+
+// ParseQueueImport reads the queues of an import file, which is JSON or YAML holding either a
+// list of queues or an object with a queues list. Each queue has a name and optional attributes
+// and tags, as CreateQueue takes them:
+//
+//	queues:
+//	  - name: orders.fifo
+//	    attributes:
+//	      ContentBasedDeduplication: true
+//	    tags:
+//	      team: payments
+func ParseQueueImport(data []byte) ([]RawCreateQueueInput, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, errors.Wrap(err, "the file is neither valid JSON nor YAML")
+	}
+	if len(document.Content) == 0 {
+		return nil, errors.New("the file has no queues")
+	}
+
+	var definitions []QueueImportDefinition
+	root := document.Content[0]
+	switch root.Kind {
+	case yaml.SequenceNode:
+		if err := root.Decode(&definitions); err != nil {
+			return nil, errors.Wrap(err, "queues must be a list of objects with a name, attributes and tags")
+		}
+	case yaml.MappingNode:
+		var file struct {
+			Queues []QueueImportDefinition `yaml:"queues"`
+		}
+		if err := root.Decode(&file); err != nil {
+			return nil, errors.Wrap(err, "queues must be a list of objects with a name, attributes and tags")
+		}
+		definitions = file.Queues
+	default:
+		return nil, errors.New("the file must hold a list of queues or an object with a queues list")
+	}
+	if len(definitions) == 0 {
+		return nil, errors.New("the file has no queues")
+	}
+
+	inputs := make([]RawCreateQueueInput, 0, len(definitions))
+	for i, definition := range definitions {
+		name := strings.TrimSpace(definition.Name)
+		if name == "" {
+			return nil, errors.Newf("queue %d has no name", i+1)
+		}
+		attributes, err := queueAttributeStrings(definition.Attributes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s attributes", name)
+		}
+		tags, err := queueAttributeStrings(definition.Tags)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s tags", name)
+		}
+		inputs = append(inputs, RawCreateQueueInput{Name: name, Attributes: attributes, Tags: tags})
+	}
+	return inputs, nil
+}
+
+// StartQueueImport creates the queues of an import file in the background, one after the other,
+// such as to seed a local emulator. Each queue is an item of the job with its own outcome; a
+// queue that fails, for example because its attributes are invalid or a queue of that name
+// already exists with others, does not stop the rest. Queues are created in file order, so a
+// dead-letter queue listed before the queues that name it exists by the time they are created.
+func (s *SqsServiceImpl) StartQueueImport(ctx context.Context, queues []RawCreateQueueInput) (Job, error) {
+	if len(queues) == 0 {
+		return Job{}, errors.New("at least one queue is required")
+	}
+	if len(queues) > maxBulkQueues {
+		return Job{}, errors.Newf("at most %d queues can be imported at once", maxBulkQueues)
+	}
+	names := make([]string, 0, len(queues))
+	for _, queue := range queues {
+		name := strings.TrimSpace(queue.Name)
+		if name == "" {
+			return Job{}, errors.New("queue name is required")
+		}
+		if slices.Contains(names, name) {
+			return Job{}, errors.Newf("%s is listed more than once", name)
+		}
+		names = append(names, name)
+	}
+
+	return s.jobs.start(ctx, "import-queues", "", func(ctx context.Context, progress *JobProgress) error {
+		progress.SetItems(names)
+		failed := 0
+		for i, queue := range queues {
+			progress.SetMessage(fmt.Sprintf("Creating %s (%d of %d).", names[i], i+1, len(queues)))
+			progress.StartItem(names[i])
+			_, err := s.CreateQueueFromAttributes(ctx, queue)
+			progress.FinishItem(names[i], err)
+			if err != nil {
+				failed++
+			}
+		}
+
+		summary := fmt.Sprintf("%d of %d queues created.", len(queues)-failed, len(queues))
+		progress.SetMessage(summary)
+		if failed > 0 {
+			return errors.Newf("%s %d failed; see the queues for their errors", summary, failed)
+		}
+		return nil
+	})
+}
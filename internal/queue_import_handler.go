@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"html/template"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+const (
+	// maxQueueImportBytes bounds the size of an uploaded or pasted queue import file.
+	maxQueueImportBytes = 1 << 20
+	// queueImportMemoryBytes is how much of an upload is kept in memory before it goes to a temporary file.
+	queueImportMemoryBytes = 1 << 20
+)
+
+type importQueuesPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	Definitions  string
+	QueueNames   []string
+	JobID        string
+}
+
+// ImportQueuesHandler renders the form that creates queues from a JSON or YAML file.
+func (h *HandlerImpl) ImportQueuesHandler(w http.ResponseWriter, _ *http.Request) {
+	h.renderImportQueues(w, http.StatusOK, newImportQueuesPageData())
+}
+
+// PostImportQueuesHandler reads the queues of an uploaded file, or of the pasted text when no
+// file is chosen, and starts creating them.
+func (h *HandlerImpl) PostImportQueuesHandler(w http.ResponseWriter, r *http.Request) {
+	data := newImportQueuesPageData()
+	r.Body = http.MaxBytesReader(w, r.Body, maxQueueImportBytes+queueImportMemoryBytes)
+	if err := r.ParseMultipartForm(queueImportMemoryBytes); err != nil {
+		data.ErrorMessage = "Upload a file of at most 1 MB."
+		h.renderImportQueues(w, http.StatusBadRequest, data)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	data.Definitions = r.PostFormValue("definitions")
+	content := []byte(data.Definitions)
+	if file, _, err := r.FormFile("file"); err == nil {
+		defer func() { _ = file.Close() }()
+		content, err = io.ReadAll(io.LimitReader(file, maxQueueImportBytes+1))
+		if err != nil || len(content) > maxQueueImportBytes {
+			data.ErrorMessage = "Upload a file of at most 1 MB."
+			h.renderImportQueues(w, http.StatusBadRequest, data)
+			return
+		}
+	}
+	if strings.TrimSpace(string(content)) == "" {
+		data.ErrorMessage = "Choose a file or paste the queues to import."
+		h.renderImportQueues(w, http.StatusBadRequest, data)
+		return
+	}
+
+	queues, err := ParseQueueImport(content)
+	if err != nil {
+		data.ErrorMessage = err.Error()
+		h.renderImportQueues(w, http.StatusBadRequest, data)
+		return
+	}
+	for _, queue := range queues {
+		data.QueueNames = append(data.QueueNames, queue.Name)
+	}
+
+	job, err := h.s.StartQueueImport(r.Context(), queues)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start queue import", slog.Int("queues", len(queues)), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderImportQueues(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.JobID = job.ID
+	h.renderImportQueues(w, http.StatusOK, data)
+}
+
+func newImportQueuesPageData() importQueuesPageData {
+	return importQueuesPageData{
+		Title:    "Import queues",
+		ViteTags: fragments["assets/js/import_queues.ts"].Tags,
+	}
+}
+
+func (h *HandlerImpl) renderImportQueues(w http.ResponseWriter, status int, data importQueuesPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["import-queues"].Execute(w, data); err != nil {
+		slog.Error("failed to render import-queues template", slog.Any("error", err))
+	}
+}
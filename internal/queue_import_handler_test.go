@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerImpl_PostImportQueuesHandler(t *testing.T) {
+	newRequest := func(t *testing.T, fields map[string]string, file string) *http.Request {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		for name, value := range fields {
+			require.NoError(t, writer.WriteField(name, value))
+		}
+		if file != "" {
+			part, err := writer.CreateFormFile("file", "queues.yaml")
+			require.NoError(t, err)
+			_, err = part.Write([]byte(file))
+			require.NoError(t, err)
+		}
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/import-queues", &body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req
+	}
+
+	t.Run("starts creating the queues of the uploaded file", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured importQueuesPageData
+		captureTemplate(t, "import-queues", func(data importQueuesPageData) { captured = data })
+		installFragment(t, "assets/js/import_queues.ts", "")
+
+		mockService.EXPECT().
+			StartQueueImport(mock.Anything, []RawCreateQueueInput{
+				{Name: "orders-dlq", Attributes: map[string]string{}, Tags: map[string]string{}},
+				{Name: "orders", Attributes: map[string]string{"VisibilityTimeout": "60"}, Tags: map[string]string{}},
+			}).
+			Return(Job{ID: "import1"}, nil).
+			Once()
+
+		handler.PostImportQueuesHandler(rr, newRequest(t, map[string]string{"definitions": "ignored"}, "- name: orders-dlq\n- name: orders\n  attributes:\n    VisibilityTimeout: 60\n"))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "import1", captured.JobID)
+		assert.Equal(t, []string{"orders-dlq", "orders"}, captured.QueueNames)
+	})
+
+	t.Run("keeps pasted definitions that do not parse", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured importQueuesPageData
+		captureTemplate(t, "import-queues", func(data importQueuesPageData) { captured = data })
+		installFragment(t, "assets/js/import_queues.ts", "")
+
+		handler.PostImportQueuesHandler(rr, newRequest(t, map[string]string{"definitions": `[{"attributes": {}}]`}, ""))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "queue 1 has no name", captured.ErrorMessage)
+		assert.Equal(t, `[{"attributes": {}}]`, captured.Definitions)
+		assert.Empty(t, captured.JobID)
+	})
+
+	t.Run("asks for a file or text", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured importQueuesPageData
+		captureTemplate(t, "import-queues", func(data importQueuesPageData) { captured = data })
+		installFragment(t, "assets/js/import_queues.ts", "")
+
+		handler.PostImportQueuesHandler(rr, newRequest(t, map[string]string{"definitions": " "}, ""))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "Choose a file or paste the queues to import.", captured.ErrorMessage)
+	})
+}
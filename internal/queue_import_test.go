@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueueImport(t *testing.T) {
+	t.Run("reads a YAML queues list", func(t *testing.T) {
+		queues, err := ParseQueueImport([]byte(`
+queues:
+  - name: orders-dlq.fifo
+  - name: orders.fifo
+    attributes:
+      ContentBasedDeduplication: true
+      VisibilityTimeout: 60
+      RedrivePolicy:
+        deadLetterTargetArn: arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo
+        maxReceiveCount: 5
+    tags:
+      team: payments
+      tier: 1
+`))
+		require.NoError(t, err)
+		assert.Equal(t, []RawCreateQueueInput{
+			{Name: "orders-dlq.fifo", Attributes: map[string]string{}, Tags: map[string]string{}},
+			{
+				Name: "orders.fifo",
+				Attributes: map[string]string{
+					"ContentBasedDeduplication": "true",
+					"VisibilityTimeout":         "60",
+					"RedrivePolicy":             `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo","maxReceiveCount":5}`,
+				},
+				Tags: map[string]string{"team": "payments", "tier": "1"},
+			},
+		}, queues)
+	})
+
+	t.Run("reads a JSON list", func(t *testing.T) {
+		queues, err := ParseQueueImport([]byte(`[{"name": "orders", "attributes": {"DelaySeconds": "5"}}]`))
+		require.NoError(t, err)
+		assert.Equal(t, []RawCreateQueueInput{
+			{Name: "orders", Attributes: map[string]string{"DelaySeconds": "5"}, Tags: map[string]string{}},
+		}, queues)
+	})
+
+	testCases := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "empty", data: "", wantErr: "the file has no queues"},
+		{name: "no queues", data: `{"queues": []}`, wantErr: "the file has no queues"},
+		{name: "scalar", data: `"orders"`, wantErr: "the file must hold a list of queues or an object with a queues list"},
+		{name: "missing name", data: `[{"attributes": {}}]`, wantErr: "queue 1 has no name"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseQueueImport([]byte(tc.data))
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestSqsServiceImpl_StartQueueImport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates every queue and keeps going after a failure", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}
+		repo.EXPECT().
+			CreateQueue(mock.Anything, CreateQueueRepositoryInput{Name: "orders", Attributes: map[string]string{"VisibilityTimeout": "60"}, Tags: map[string]string{}}).
+			Return("", errors.New("QueueAlreadyExists")).
+			Once()
+		repo.EXPECT().
+			CreateQueue(mock.Anything, CreateQueueRepositoryInput{Name: "billing", Attributes: map[string]string{}, Tags: map[string]string{}}).
+			Return("https://sqs.local/000000000000/billing", nil).
+			Once()
+
+		started, err := service.StartQueueImport(ctx, []RawCreateQueueInput{
+			{Name: "orders", Attributes: map[string]string{"VisibilityTimeout": "60"}},
+			{Name: "billing"},
+			{Name: "audit", Attributes: map[string]string{"DelaySeconds": "901"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "import-queues", started.Kind)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusFailed, job.Status)
+		assert.Equal(t, "1 of 3 queues created. 2 failed; see the queues for their errors", job.Error)
+		assert.Equal(t, []JobItem{
+			{Key: "orders", Status: JobItemFailed, Error: "QueueAlreadyExists"},
+			{Key: "billing", Status: JobItemSucceeded},
+			{Key: "audit", Status: JobItemFailed, Error: "DelaySeconds must be between 0 and 900"},
+		}, job.Items)
+	})
+
+	t.Run("rejects a queue listed twice", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t), jobs: newJobRegistry()}
+
+		_, err := service.StartQueueImport(ctx, []RawCreateQueueInput{{Name: "orders"}, {Name: " orders "}})
+		assert.EqualError(t, err, "orders is listed more than once")
+	})
+}
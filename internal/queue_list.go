@@ -0,0 +1,271 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Sort keys accepted by FindQueues. They are part of the public query string of the queue list
+// and /api/v1/queues, so they must not be renamed.
+const (
+	QueueSortName       = "name"
+	QueueSortCreated    = "created"
+	QueueSortAvailable  = "available"
+	QueueSortInFlight   = "in-flight"
+	QueueSortVisibility = "visibility-timeout"
+)
+
+// Encryption filters accepted by FindQueues. QueueEncryptionKMS keeps the queues encrypted with a
+// KMS key and QueueEncryptionNone the rest.
+const (
+	QueueEncryptionKMS  = "kms"
+	QueueEncryptionNone = "none"
+)
+
+// Groupings accepted by FindQueues. QueueGroupTag buckets queues by the value of a tag and
+// QueueGroupPrefix by the start of their name.
+const (
+	QueueGroupTag    = "tag"
+	QueueGroupPrefix = "prefix"
+)
+
+// queueSortKeys lists the sort keys in the order they are offered on the queue list.
+var queueSortKeys = []string{QueueSortName, QueueSortCreated, QueueSortAvailable, QueueSortInFlight, QueueSortVisibility}
+
+// QueueListOptions narrows, orders and pages the queue list. The zero value returns every queue
+// sorted by name.
+type QueueListOptions struct {
+	// Query keeps queues whose name contains it, ignoring case.
+	Query string
+	// Type keeps only queues of that type when set.
+	Type QueueType
+	// Encryption keeps only queues with (QueueEncryptionKMS) or without (QueueEncryptionNone) a
+	// KMS key when set.
+	Encryption string
+	// Sort is one of the QueueSort constants; empty means QueueSortName.
+	Sort string
+	// Descending reverses the order.
+	Descending bool
+	// Limit caps the number of queues returned; zero means no cap.
+	Limit int
+	// Offset skips that many queues after filtering and sorting.
+	Offset int
+	// Tags lists the tags of the queues on the page, when the endpoint supports tags.
+	Tags bool
+	// Favorites lists the starred queues, whatever the filter and page.
+	Favorites bool
+	// GroupBy buckets the queues on the page into Groups when set: by the value of the GroupTag
+	// tag with QueueGroupTag, or by name prefix with QueueGroupPrefix.
+	GroupBy  string
+	GroupTag string
+}
+
+// QueueListPage is one page of the filtered and sorted queue list.
+type QueueListPage struct {
+	Queues []QueueSummary
+	// Total is the number of queues that matched before paging.
+	Total int
+	// Tags holds the tags of each queue on the page by URL when they were asked for. Queues whose
+	// tags could not be read are missing.
+	Tags map[string]map[string]string
+	// Favorites holds the starred queues that still exist, by name, when they were asked for.
+	Favorites []QueueSummary
+	// Groups holds the queues on the page bucketed as GroupBy asked, in group name order, with the
+	// queues that fit no group last under an empty name.
+	Groups []QueueGroup
+}
+
+// QueueGroup is a bucket of the grouped queue list. Queues keep the order of the list.
+type QueueGroup struct {
+	Name   string
+	Queues []QueueSummary
+}
+
+// FindQueues lists queues filtered, sorted and paged as described by opts. Ties are broken by
+// name so paging through the result is stable.
+func (s *SqsServiceImpl) FindQueues(ctx context.Context, opts QueueListOptions) (QueueListPage, error) {
+	if opts.Sort == "" {
+		opts.Sort = QueueSortName
+	}
+	if !slices.Contains(queueSortKeys, opts.Sort) {
+		return QueueListPage{}, errors.Newf("sort must be one of %s", strings.Join(queueSortKeys, ", "))
+	}
+	if opts.Type != "" && opts.Type != QueueTypeStandard && opts.Type != QueueTypeFIFO {
+		return QueueListPage{}, errors.New("type must be standard or fifo")
+	}
+	if opts.Encryption != "" && opts.Encryption != QueueEncryptionKMS && opts.Encryption != QueueEncryptionNone {
+		return QueueListPage{}, errors.New("encryption must be kms or none")
+	}
+	if opts.Limit < 0 {
+		return QueueListPage{}, errors.New("limit must not be negative")
+	}
+	if opts.Offset < 0 {
+		return QueueListPage{}, errors.New("offset must not be negative")
+	}
+	opts.GroupTag = strings.TrimSpace(opts.GroupTag)
+	switch opts.GroupBy {
+	case "", QueueGroupPrefix:
+	case QueueGroupTag:
+		if opts.GroupTag == "" {
+			return QueueListPage{}, errors.New("a tag key is required to group by tag")
+		}
+		if !s.EndpointCapabilities(ctx).Tags {
+			return QueueListPage{}, ErrTagsUnsupported
+		}
+	default:
+		return QueueListPage{}, errors.New("group must be tag or prefix")
+	}
+
+	queues, err := s.Queues(ctx)
+	if err != nil {
+		return QueueListPage{}, err
+	}
+
+	keyword := strings.ToLower(strings.TrimSpace(opts.Query))
+	matched := make([]QueueSummary, 0, len(queues))
+	for _, queue := range queues {
+		if keyword != "" && !strings.Contains(strings.ToLower(queue.Name), keyword) {
+			continue
+		}
+		if opts.Type != "" && queue.Type != opts.Type {
+			continue
+		}
+		if opts.Encryption != "" && (queue.Encryption == "KMS") != (opts.Encryption == QueueEncryptionKMS) {
+			continue
+		}
+		matched = append(matched, queue)
+	}
+
+	slices.SortStableFunc(matched, func(a, b QueueSummary) int {
+		order := compareQueues(a, b, opts.Sort)
+		if order == 0 {
+			order = strings.Compare(a.Name, b.Name)
+		}
+		if opts.Descending {
+			return -order
+		}
+		return order
+	})
+
+	page := QueueListPage{Total: len(matched)}
+	start := min(opts.Offset, len(matched))
+	end := len(matched)
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, end)
+	}
+	page.Queues = matched[start:end]
+
+	if (opts.Tags || opts.GroupBy == QueueGroupTag) && len(page.Queues) > 0 && s.EndpointCapabilities(ctx).Tags {
+		queueURLs := make([]string, len(page.Queues))
+		for i, queue := range page.Queues {
+			queueURLs[i] = queue.URL
+		}
+		tags, errs := s.listQueueTags(ctx, queueURLs)
+		page.Tags = make(map[string]map[string]string, len(queueURLs))
+		for i, queueURL := range queueURLs {
+			if errs[i] != nil {
+				slog.WarnContext(ctx, "failed to list queue tags", slog.String("queue_url", queueURL), slog.Any("error", errs[i]))
+				continue
+			}
+			page.Tags[queueURL] = tags[i]
+		}
+	}
+	if opts.Favorites {
+		page.Favorites = s.favoriteQueueSummaries(ctx, queues)
+	}
+	switch opts.GroupBy {
+	case QueueGroupTag:
+		page.Groups = groupQueues(page.Queues, func(queue QueueSummary) string {
+			return page.Tags[queue.URL][opts.GroupTag]
+		})
+	case QueueGroupPrefix:
+		page.Groups = groupQueues(page.Queues, func(queue QueueSummary) string {
+			return queueNamePrefix(queue.Name)
+		})
+		// A prefix only one queue has groups nothing; those queues are listed together instead.
+		var ungrouped []QueueSummary
+		page.Groups = slices.DeleteFunc(page.Groups, func(group QueueGroup) bool {
+			if len(group.Queues) == 1 {
+				ungrouped = append(ungrouped, group.Queues...)
+				return true
+			}
+			return false
+		})
+		if len(ungrouped) > 0 {
+			page.Groups = append(page.Groups, QueueGroup{Queues: ungrouped})
+		}
+	}
+	return page, nil
+}
+
+// groupQueues buckets queues by the key each maps to, in key order with the empty key last.
+// Queues keep their order within a bucket.
+func groupQueues(queues []QueueSummary, key func(QueueSummary) string) []QueueGroup {
+	groups := []QueueGroup{}
+	for _, queue := range queues {
+		name := key(queue)
+		i := slices.IndexFunc(groups, func(group QueueGroup) bool { return group.Name == name })
+		if i < 0 {
+			groups = append(groups, QueueGroup{Name: name})
+			i = len(groups) - 1
+		}
+		groups[i].Queues = append(groups[i].Queues, queue)
+	}
+	slices.SortStableFunc(groups, func(a, b QueueGroup) int {
+		if (a.Name == "") != (b.Name == "") {
+			if a.Name == "" {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return groups
+}
+
+// queueNamePrefix is the part of a queue name before its first -, _ or ., which related queues
+// such as orders, orders-dlq and orders_retry.fifo share.
+func queueNamePrefix(name string) string {
+	if i := strings.IndexAny(name, "-_."); i > 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// favoriteQueueSummaries picks the starred queues out of queues, sorted by name. When the
+// favorites cannot be loaded there are none, rather than the queue list failing.
+func (s *SqsServiceImpl) favoriteQueueSummaries(ctx context.Context, queues []QueueSummary) []QueueSummary {
+	favorites, err := s.FavoriteQueues(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to load favorite queues", slog.Any("error", err))
+		return nil
+	}
+	var starred []QueueSummary
+	for _, queue := range queues {
+		if slices.Contains(favorites, queue.URL) {
+			starred = append(starred, queue)
+		}
+	}
+	slices.SortFunc(starred, func(a, b QueueSummary) int { return strings.Compare(a.Name, b.Name) })
+	return starred
+}
+
+func compareQueues(a, b QueueSummary, sortKey string) int {
+	switch sortKey {
+	case QueueSortCreated:
+		return a.CreatedAt.Compare(b.CreatedAt)
+	case QueueSortAvailable:
+		return cmp.Compare(a.MessagesAvailable, b.MessagesAvailable)
+	case QueueSortInFlight:
+		return cmp.Compare(a.MessagesInFlight, b.MessagesInFlight)
+	case QueueSortVisibility:
+		return cmp.Compare(a.VisibilityTimeout, b.VisibilityTimeout)
+	default:
+		return strings.Compare(a.Name, b.Name)
+	}
+}
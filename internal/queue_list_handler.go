@@ -0,0 +1,465 @@
+package internal
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// defaultQueueListLimit is the page size of /api/v1/queues when no limit is given.
+	defaultQueueListLimit = 100
+	// maxQueueListLimit bounds the page size of /api/v1/queues.
+	maxQueueListLimit = 1000
+)
+
+type queueListItem struct {
+	QueueURL                  string         `json:"queueUrl"`
+	QueueName                 string         `json:"queueName"`
+	Type                      QueueType      `json:"type"`
+	CreatedAt                 *time.Time     `json:"createdAt,omitempty"`
+	MessagesAvailable         int64          `json:"messagesAvailable"`
+	MessagesInFlight          int64          `json:"messagesInFlight"`
+	MessagesDelayed           int64          `json:"messagesDelayed"`
+	VisibilityTimeout         int64          `json:"visibilityTimeout"`
+	Encryption                string         `json:"encryption"`
+	ContentBasedDeduplication bool           `json:"contentBasedDeduplication"`
+	Anomalies                 []QueueAnomaly `json:"anomalies"`
+}
+
+type queueListResponse struct {
+	Queues    []queueListItem  `json:"queues"`
+	Groups    []queueListGroup `json:"groups,omitempty"`
+	Total     int              `json:"total"`
+	Limit     int              `json:"limit"`
+	Offset    int              `json:"offset"`
+	NextToken string           `json:"nextToken,omitempty"`
+}
+
+// queueListGroup is a group of the queues on a grouped page; the queues that fit no group have an
+// empty name.
+type queueListGroup struct {
+	Name      string   `json:"name"`
+	QueueURLs []string `json:"queueUrls"`
+}
+
+// queueListOptionsFromQuery reads the list parameters shared by the queue list page and
+// /api/v1/queues: q, type, encryption, sort, order, group, group_tag, limit and offset.
+func queueListOptionsFromQuery(query url.Values) (QueueListOptions, error) {
+	opts := QueueListOptions{
+		Query:      strings.TrimSpace(query.Get("q")),
+		Type:       QueueType(strings.ToLower(strings.TrimSpace(query.Get("type")))),
+		Encryption: strings.ToLower(strings.TrimSpace(query.Get("encryption"))),
+		Sort:       strings.TrimSpace(query.Get("sort")),
+		GroupBy:    strings.ToLower(strings.TrimSpace(query.Get("group"))),
+		GroupTag:   strings.TrimSpace(query.Get("group_tag")),
+	}
+
+	switch order := strings.TrimSpace(query.Get("order")); order {
+	case "", "asc":
+	case "desc":
+		opts.Descending = true
+	default:
+		return QueueListOptions{}, errors.New("order must be asc or desc")
+	}
+
+	switch opts.GroupBy {
+	case "", QueueGroupPrefix:
+		opts.GroupTag = ""
+	case QueueGroupTag:
+		if opts.GroupTag == "" {
+			return QueueListOptions{}, errors.New("a tag key is required to group by tag")
+		}
+	default:
+		return QueueListOptions{}, errors.New("group must be tag or prefix")
+	}
+
+	for _, param := range []struct {
+		name   string
+		target *int
+	}{
+		{name: "limit", target: &opts.Limit},
+		{name: "offset", target: &opts.Offset},
+	} {
+		raw := strings.TrimSpace(query.Get(param.name))
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil || value < 0 {
+			return QueueListOptions{}, errors.Newf("%s must be a non-negative whole number", param.name)
+		}
+		*param.target = value
+	}
+
+	return opts, nil
+}
+
+// queueListUpstreamScope is the token scope of the queue list paged by ListQueues itself.
+const queueListUpstreamScope = "queues\x00upstream"
+
+// queueListUpstreamResponse is a page of the queue list paged by ListQueues. SQS does not report
+// how many queues there are, so it has no total.
+type queueListUpstreamResponse struct {
+	Queues    []queueListItem `json:"queues"`
+	Limit     int             `json:"limit"`
+	NextToken string          `json:"nextToken,omitempty"`
+}
+
+// ListQueuesAPI returns one page of the queue list as JSON. Besides limit and offset it pages like
+// the ListQueues API: maxResults caps the page and the nextToken of a response continues the list.
+// Without a filter, sort or grouping those pages are ListQueues pages, so each costs one call and
+// the token wraps the SQS NextToken; otherwise the whole list is filtered and the token holds an
+// offset into it.
+func (h *HandlerImpl) ListQueuesAPI(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts, err := queueListOptionsFromQuery(query)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	paging, err := pagingParamsFromQuery(query, maxQueueListLimit)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if pagesQueuesUpstream(query, paging) {
+		h.listQueuesUpstream(w, r, paging)
+		return
+	}
+	if paging.MaxResults > 0 {
+		if query.Has("limit") {
+			writeJSONError(w, http.StatusBadRequest, "use either limit or maxResults")
+			return
+		}
+		opts.Limit = paging.MaxResults
+	}
+	scope := queueListTokenScope(opts)
+	if paging.NextToken != "" {
+		if query.Has("offset") {
+			writeJSONError(w, http.StatusBadRequest, "use either offset or nextToken")
+			return
+		}
+		if opts.Offset, err = decodePageToken(paging.NextToken, scope); err != nil {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if opts.Limit == 0 {
+		opts.Limit = defaultQueueListLimit
+	}
+	if opts.Limit > maxQueueListLimit {
+		writeJSONError(w, http.StatusBadRequest, "limit must be at most "+strconv.Itoa(maxQueueListLimit))
+		return
+	}
+
+	page, err := h.s.FindQueues(r.Context(), opts)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list queues", slog.Any("error", err))
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response := queueListResponse{
+		Queues:    make([]queueListItem, 0, len(page.Queues)),
+		Total:     page.Total,
+		Limit:     opts.Limit,
+		Offset:    opts.Offset,
+		NextToken: nextPageToken(scope, opts.Offset+len(page.Queues), page.Total),
+	}
+	for _, queue := range page.Queues {
+		response.Queues = append(response.Queues, newQueueListItem(queue))
+	}
+	for _, group := range page.Groups {
+		urls := make([]string, 0, len(group.Queues))
+		for _, queue := range group.Queues {
+			urls = append(urls, queue.URL)
+		}
+		response.Groups = append(response.Groups, queueListGroup{Name: group.Name, QueueURLs: urls})
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// pagesQueuesUpstream reports whether a queue list request is served by ListQueues pages: it asks
+// for maxResults or continues such a page, and neither filters, sorts, groups nor uses limit and
+// offset, which all need the whole list.
+func pagesQueuesUpstream(query url.Values, paging pagingParams) bool {
+	for _, name := range []string{"q", "type", "encryption", "sort", "order", "group", "group_tag"} {
+		if strings.TrimSpace(query.Get(name)) != "" {
+			return false
+		}
+	}
+	if query.Has("limit") || query.Has("offset") {
+		return false
+	}
+	if paging.NextToken != "" {
+		return isUpstreamPageToken(paging.NextToken)
+	}
+	return paging.MaxResults > 0
+}
+
+func (h *HandlerImpl) listQueuesUpstream(w http.ResponseWriter, r *http.Request, paging pagingParams) {
+	upstream := ""
+	if paging.NextToken != "" {
+		var err error
+		if upstream, err = decodeUpstreamPageToken(paging.NextToken, queueListUpstreamScope); err != nil {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	limit := cmp.Or(paging.MaxResults, defaultQueueListLimit)
+
+	page, err := h.s.QueuesPage(r.Context(), limit, upstream)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list queues", slog.Any("error", err))
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response := queueListUpstreamResponse{Queues: make([]queueListItem, 0, len(page.Queues)), Limit: limit}
+	if page.NextToken != "" {
+		response.NextToken = encodeUpstreamPageToken(queueListUpstreamScope, page.NextToken)
+	}
+	for _, queue := range page.Queues {
+		response.Queues = append(response.Queues, newQueueListItem(queue))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func newQueueListItem(queue QueueSummary) queueListItem {
+	item := queueListItem{
+		QueueURL:                  queue.URL,
+		QueueName:                 queue.Name,
+		Type:                      queue.Type,
+		MessagesAvailable:         queue.MessagesAvailable,
+		MessagesInFlight:          queue.MessagesInFlight,
+		MessagesDelayed:           queue.MessagesDelayed,
+		VisibilityTimeout:         queue.VisibilityTimeout,
+		Encryption:                queue.Encryption,
+		ContentBasedDeduplication: queue.ContentBasedDeduplication,
+		Anomalies:                 queue.Anomalies,
+	}
+	if item.Anomalies == nil {
+		item.Anomalies = []QueueAnomaly{}
+	}
+	if !queue.CreatedAt.IsZero() {
+		createdAt := queue.CreatedAt.UTC()
+		item.CreatedAt = &createdAt
+	}
+	return item
+}
+
+// queueListTokenScope identifies the filter and order a continuation token was issued for.
+func queueListTokenScope(opts QueueListOptions) string {
+	sortKey := cmp.Or(opts.Sort, QueueSortName)
+	return strings.Join([]string{"queues", opts.Query, string(opts.Type), opts.Encryption, sortKey, strconv.FormatBool(opts.Descending)}, "\x00")
+}
+
+// queueGroupLabel heads a group of the queue list grouped as opts asks. The queues that fit no
+// group are listed last under name "".
+func queueGroupLabel(opts QueueListOptions, name string) string {
+	switch {
+	case opts.GroupBy == QueueGroupTag && name == "":
+		return fmt.Sprintf("No %s tag", opts.GroupTag)
+	case opts.GroupBy == QueueGroupTag:
+		return fmt.Sprintf("%s: %s", opts.GroupTag, name)
+	case name == "":
+		return "Other queues"
+	}
+	return name + "*"
+}
+
+// queueSortOptions are the choices of the sort select on the queue list.
+var queueSortOptions = []selectOption{
+	{Value: QueueSortName, Label: "Name"},
+	{Value: QueueSortCreated, Label: "Created"},
+	{Value: QueueSortAvailable, Label: "Messages available"},
+	{Value: QueueSortInFlight, Label: "Messages in flight"},
+	{Value: QueueSortVisibility, Label: "Visibility timeout"},
+}
+
+// queueListFlashParams carry the flash message of the queue list and are dropped from links to it.
+var queueListFlashParams = []string{"created", "deleted", "trash", "restored"}
+
+// queueColumnOption is a checkbox of the column form on the queue list.
+type queueColumnOption struct {
+	Value string
+	Label string
+	Shown bool
+}
+
+// queueColumnLabels are the headings of the optional queue list columns.
+var queueColumnLabels = map[string]string{
+	QueueColumnType:       "Type",
+	QueueColumnCreated:    "Created",
+	QueueColumnAvailable:  "Messages Available",
+	QueueColumnInFlight:   "Messages In Flight",
+	QueueColumnDelayed:    "Messages Delayed",
+	QueueColumnOldestAge:  "Oldest Message Age",
+	QueueColumnVisibility: "Visibility Timeout (s)",
+	QueueColumnEncryption: "Encryption",
+	QueueColumnDedup:      "Content-based Dedup",
+	QueueColumnArn:        "ARN",
+	QueueColumnTags:       "Tags",
+}
+
+func queueColumnOptions(shown map[string]bool) []queueColumnOption {
+	options := make([]queueColumnOption, 0, len(queueColumns))
+	for _, column := range queueColumns {
+		options = append(options, queueColumnOption{Value: column, Label: queueColumnLabels[column], Shown: shown[column]})
+	}
+	return options
+}
+
+// ColumnCount is the number of columns the queue table shows, including the selection box and
+// the name.
+func (d queuesPageData) ColumnCount() int {
+	return len(d.Shown) + 2
+}
+
+// backlogAgeLabel shows the estimated age of the oldest message of queue: at most the backlog age,
+// or more than it when the queue was never seen empty.
+func backlogAgeLabel(queue QueueSummary) string {
+	if queue.BacklogAge <= 0 {
+		return "-"
+	}
+	age := queue.BacklogAge.Truncate(time.Second).String()
+	if queue.BacklogAgeExceeded {
+		return "> " + age
+	}
+	return "≤ " + age
+}
+
+// queueTagsLabel lists the tags of queueURL as key=value pairs sorted by key. tags is nil when the
+// endpoint does not support tags.
+func queueTagsLabel(tags map[string]map[string]string, queueURL string) string {
+	if tags == nil {
+		return "-"
+	}
+	queueTags, ok := tags[queueURL]
+	if !ok {
+		return "Unavailable"
+	}
+	if len(queueTags) == 0 {
+		return "-"
+	}
+	pairs := make([]string, 0, len(queueTags))
+	for key, value := range queueTags {
+		pairs = append(pairs, key+"="+value)
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ", ")
+}
+
+// queueListReturnURL is the queue list at requestURL without its flash message parameters.
+func queueListReturnURL(requestURL *url.URL) string {
+	query := requestURL.Query()
+	for _, key := range queueListFlashParams {
+		query.Del(key)
+	}
+	if len(query) == 0 {
+		return "/queues"
+	}
+	return "/queues?" + query.Encode()
+}
+
+// queueListExportURL is the CSV export of the queue list at requestURL, which covers every page.
+func queueListExportURL(requestURL *url.URL) string {
+	query := requestURL.Query()
+	for _, key := range slices.Concat(queueListFlashParams, []string{"limit", "offset"}) {
+		query.Del(key)
+	}
+	if len(query) == 0 {
+		return "/queues/export.csv"
+	}
+	return "/queues/export.csv?" + query.Encode()
+}
+
+// PostQueueColumnsHandler saves the columns chosen on the queue list and returns to the list.
+func (h *HandlerImpl) PostQueueColumnsHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.s.SaveQueueListColumns(r.Context(), r.PostForm["column"]); err != nil {
+		slog.ErrorContext(r.Context(), "failed to save queue list columns", slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	http.Redirect(w, r, queueListReturnTarget(r.PostFormValue("return")), http.StatusSeeOther)
+}
+
+// PostFavoriteQueueHandler stars a queue when favorite is true and unstars it otherwise, then
+// returns to the queue list.
+func (h *HandlerImpl) PostFavoriteQueueHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	queueURL := r.PostFormValue("queue_url")
+	favorite := r.PostFormValue("favorite") == "true"
+	if err := h.s.SetFavoriteQueue(r.Context(), queueURL, favorite); err != nil {
+		slog.ErrorContext(r.Context(), "failed to save favorite queue", slog.String("queue_url", queueURL), slog.Bool("favorite", favorite), slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	http.Redirect(w, r, queueListReturnTarget(r.PostFormValue("return")), http.StatusSeeOther)
+}
+
+// queueListReturnTarget keeps a return field posted from the queue list when it points back at
+// the list, so forms cannot be used to redirect elsewhere.
+func queueListReturnTarget(returnURL string) string {
+	if returnURL != "/queues" && !strings.HasPrefix(returnURL, "/queues?") {
+		return "/queues"
+	}
+	return returnURL
+}
+
+func newQueueListingView(requestURL *url.URL, opts QueueListOptions, total int) queueListingView {
+	view := queueListingView{
+		Query:      opts.Query,
+		Type:       string(opts.Type),
+		Encryption: opts.Encryption,
+		Sort:       opts.Sort,
+		Order:      "asc",
+		GroupBy:    opts.GroupBy,
+		GroupTag:   opts.GroupTag,
+		Limit:      opts.Limit,
+		Total:      total,
+	}
+	if opts.Descending {
+		view.Order = "desc"
+	}
+	if opts.Limit == 0 {
+		return view
+	}
+
+	pageURL := func(offset int) string {
+		query := requestURL.Query()
+		for _, key := range queueListFlashParams {
+			query.Del(key)
+		}
+		query.Set("offset", strconv.Itoa(offset))
+		return "/queues?" + query.Encode()
+	}
+	if opts.Offset > 0 {
+		view.PrevURL = pageURL(max(opts.Offset-opts.Limit, 0))
+	}
+	if opts.Offset+opts.Limit < total {
+		view.NextURL = pageURL(opts.Offset + opts.Limit)
+	}
+	return view
+}
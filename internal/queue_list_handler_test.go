@@ -0,0 +1,458 @@
+package internal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerImpl_ListQueuesAPI(t *testing.T) {
+	t.Run("passes list parameters and returns a page", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/queues?q=orders&type=FIFO&encryption=KMS&sort=created&order=desc&limit=1&offset=2", nil)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			FindQueues(mock.Anything, QueueListOptions{Query: "orders", Type: QueueTypeFIFO, Encryption: QueueEncryptionKMS, Sort: QueueSortCreated, Descending: true, Limit: 1, Offset: 2}).
+			Return(QueueListPage{
+				Queues: []QueueSummary{{
+					URL:                       "https://sqs.local/1/orders.fifo",
+					Name:                      "orders.fifo",
+					Type:                      QueueTypeFIFO,
+					CreatedAt:                 time.Date(2024, time.May, 1, 15, 4, 5, 0, time.UTC),
+					MessagesAvailable:         3,
+					MessagesInFlight:          1,
+					VisibilityTimeout:         30,
+					Encryption:                "SSE-SQS",
+					ContentBasedDeduplication: true,
+					Anomalies:                 []QueueAnomaly{QueueAnomalyBacklogGrowing},
+				}},
+				Total: 5,
+			}, nil).
+			Once()
+
+		handler.ListQueuesAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"queues":[{
+			"queueUrl":"https://sqs.local/1/orders.fifo","queueName":"orders.fifo","type":"fifo",
+			"createdAt":"2024-05-01T15:04:05Z","messagesAvailable":3,"messagesInFlight":1,
+			"messagesDelayed":0,"visibilityTimeout":30,"encryption":"SSE-SQS","contentBasedDeduplication":true,
+			"anomalies":["backlog-growing"]
+		}],"total":5,"limit":1,"offset":2,"nextToken":"`+encodePageToken(queueListTokenScope(QueueListOptions{Query: "orders", Type: QueueTypeFIFO, Encryption: QueueEncryptionKMS, Sort: QueueSortCreated, Descending: true}), 3)+`"}`, rr.Body.String())
+	})
+
+	t.Run("lists the groups of a grouped page", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/queues?group=prefix&group_tag=team", nil)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			FindQueues(mock.Anything, QueueListOptions{GroupBy: QueueGroupPrefix, Limit: defaultQueueListLimit}).
+			Return(QueueListPage{
+				Queues: []QueueSummary{
+					{URL: "https://sqs.local/orders", Name: "orders"},
+					{URL: "https://sqs.local/orders-dlq", Name: "orders-dlq"},
+				},
+				Total: 2,
+				Groups: []QueueGroup{{Name: "orders", Queues: []QueueSummary{
+					{URL: "https://sqs.local/orders", Name: "orders"},
+					{URL: "https://sqs.local/orders-dlq", Name: "orders-dlq"},
+				}}},
+			}, nil).
+			Once()
+
+		handler.ListQueuesAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var response queueListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, []queueListGroup{{Name: "orders", QueueURLs: []string{"https://sqs.local/orders", "https://sqs.local/orders-dlq"}}}, response.Groups)
+	})
+
+	t.Run("pages with maxResults and nextToken", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		mockService.EXPECT().
+			FindQueues(mock.Anything, QueueListOptions{Query: "orders", Limit: 2}).
+			Return(QueueListPage{Queues: []QueueSummary{{Name: "a"}, {Name: "b"}}, Total: 3}, nil).
+			Once()
+		mockService.EXPECT().
+			FindQueues(mock.Anything, QueueListOptions{Query: "orders", Limit: 2, Offset: 2}).
+			Return(QueueListPage{Queues: []QueueSummary{{Name: "c"}}, Total: 3}, nil).
+			Once()
+
+		rr := httptest.NewRecorder()
+		handler.ListQueuesAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/queues?q=orders&maxResults=2", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var first queueListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &first))
+		require.NotEmpty(t, first.NextToken)
+
+		rr = httptest.NewRecorder()
+		handler.ListQueuesAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/queues?q=orders&maxResults=2&nextToken="+url.QueryEscape(first.NextToken), nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var second queueListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &second))
+		assert.Len(t, second.Queues, 1)
+		assert.Empty(t, second.NextToken)
+	})
+
+	t.Run("pages unfiltered listings with the ListQueues NextToken", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		mockService.EXPECT().
+			QueuesPage(mock.Anything, 2, "").
+			Return(QueueSummaryPage{Queues: []QueueSummary{{Name: "a"}, {Name: "b"}}, NextToken: "sqs-page-2"}, nil).
+			Once()
+		mockService.EXPECT().
+			QueuesPage(mock.Anything, 2, "sqs-page-2").
+			Return(QueueSummaryPage{Queues: []QueueSummary{{Name: "c"}}}, nil).
+			Once()
+
+		rr := httptest.NewRecorder()
+		handler.ListQueuesAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/queues?maxResults=2", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var first queueListUpstreamResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &first))
+		assert.Len(t, first.Queues, 2)
+		assert.Equal(t, encodeUpstreamPageToken(queueListUpstreamScope, "sqs-page-2"), first.NextToken)
+		assert.NotContains(t, rr.Body.String(), `"total"`)
+
+		rr = httptest.NewRecorder()
+		handler.ListQueuesAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/queues?maxResults=2&nextToken="+url.QueryEscape(first.NextToken), nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"queues":[{"queueUrl":"","queueName":"c","type":"","messagesAvailable":0,"messagesInFlight":0,"messagesDelayed":0,"visibilityTimeout":0,"encryption":"","contentBasedDeduplication":false,"anomalies":[]}],"limit":2}`, rr.Body.String())
+	})
+
+	t.Run("rejects a ListQueues token with a filter", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+
+		rr := httptest.NewRecorder()
+		token := encodeUpstreamPageToken(queueListUpstreamScope, "sqs-page-2")
+		handler.ListQueuesAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/queues?q=orders&nextToken="+url.QueryEscape(token), nil))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Contains(t, rr.Body.String(), "nextToken belongs to a different listing")
+	})
+
+	t.Run("applies the default limit", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/queues", nil)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			FindQueues(mock.Anything, QueueListOptions{Limit: defaultQueueListLimit}).
+			Return(QueueListPage{Queues: []QueueSummary{}}, nil).
+			Once()
+
+		handler.ListQueuesAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"queues":[],"total":0,"limit":100,"offset":0}`, rr.Body.String())
+	})
+
+	testCases := []struct {
+		name      string
+		query     string
+		wantError string
+	}{
+		{name: "invalid order", query: "order=up", wantError: "order must be asc or desc"},
+		{name: "invalid limit", query: "limit=ten", wantError: "limit must be a non-negative whole number"},
+		{name: "negative offset", query: "offset=-1", wantError: "offset must be a non-negative whole number"},
+		{name: "limit too large", query: "limit=1001", wantError: "limit must be at most 1000"},
+		{name: "maxResults too large", query: "maxResults=1001", wantError: "maxResults must be between 1 and 1000"},
+		{name: "limit and maxResults", query: "limit=5&maxResults=5", wantError: "use either limit or maxResults"},
+		{name: "offset and nextToken", query: "offset=5&nextToken=" + encodePageToken(queueListTokenScope(QueueListOptions{}), 5), wantError: "use either offset or nextToken"},
+		{name: "malformed nextToken", query: "nextToken=%25%25", wantError: "invalid nextToken"},
+		{name: "invalid group", query: "group=owner", wantError: "group must be tag or prefix"},
+		{name: "group by tag without a key", query: "group=tag&group_tag=+", wantError: "a tag key is required to group by tag"},
+		{name: "nextToken of another filter", query: "q=orders&nextToken=" + encodePageToken(queueListTokenScope(QueueListOptions{}), 5), wantError: "nextToken belongs to a different listing; repeat the same filter and sort"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewHandler(NewMockSqsService(t))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/queues?"+tc.query, nil)
+			rr := httptest.NewRecorder()
+
+			handler.ListQueuesAPI(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, `{"error":"`+tc.wantError+`"}`, rr.Body.String())
+		})
+	}
+}
+
+func TestHandlerImpl_QueuesHandler_Listing(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/queues?q=orders&sort=available&order=desc&limit=2&offset=2&created=orders", nil)
+	mockService.EXPECT().QueueListColumns(mock.Anything).Return(defaultQueueColumns, nil).Once()
+	mockService.EXPECT().
+		FindQueues(mock.Anything, QueueListOptions{Query: "orders", Sort: QueueSortAvailable, Descending: true, Limit: 2, Offset: 2, Favorites: true}).
+		Return(QueueListPage{Queues: []QueueSummary{{Name: "orders"}, {Name: "orders-dlq"}}, Total: 5}, nil).
+		Once()
+
+	var captured queuesPageData
+	captureQueuesTemplate(t, &captured)
+	installQueuesFragment(t, "")
+
+	rr := httptest.NewRecorder()
+	handler.QueuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Len(t, captured.Queues, 2)
+	assert.Equal(t, queueListingView{
+		Query:   "orders",
+		Sort:    QueueSortAvailable,
+		Order:   "desc",
+		Limit:   2,
+		Total:   5,
+		PrevURL: "/queues?limit=2&offset=0&order=desc&q=orders&sort=available",
+		NextURL: "/queues?limit=2&offset=4&order=desc&q=orders&sort=available",
+	}, captured.Listing)
+	assert.Equal(t, queueSortOptions, captured.SortOptions)
+	assert.Equal(t, "/queues/export.csv?order=desc&q=orders&sort=available", captured.ExportURL)
+}
+
+func TestHandlerImpl_QueuesHandler_Columns(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/queues?q=orders&deleted=old&trash=1", nil)
+	mockService.EXPECT().QueueListColumns(mock.Anything).Return([]string{QueueColumnDelayed, QueueColumnOldestAge, QueueColumnArn, QueueColumnTags}, nil).Once()
+	mockService.EXPECT().
+		FindQueues(mock.Anything, QueueListOptions{Query: "orders", Tags: true, Favorites: true}).
+		Return(QueueListPage{
+			Queues: []QueueSummary{
+				{URL: "https://sqs.local/orders", Name: "orders", Arn: "arn:aws:sqs:us-east-1:000000000000:orders", MessagesDelayed: 3, MessagesAvailable: 2, BacklogAge: 90*time.Second + 400*time.Millisecond},
+				{URL: "https://sqs.local/orders-dlq", Name: "orders-dlq", MessagesAvailable: 7, BacklogAge: 10 * time.Minute, BacklogAgeExceeded: true},
+				{URL: "https://sqs.local/orders-retry", Name: "orders-retry"},
+			},
+			Total: 3,
+			Tags: map[string]map[string]string{
+				"https://sqs.local/orders":     {"team": "core", "env": "prod"},
+				"https://sqs.local/orders-dlq": {},
+			},
+		}, nil).
+		Once()
+
+	var captured queuesPageData
+	captureQueuesTemplate(t, &captured)
+	installQueuesFragment(t, "")
+
+	rr := httptest.NewRecorder()
+	handler.QueuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, map[string]bool{QueueColumnDelayed: true, QueueColumnOldestAge: true, QueueColumnArn: true, QueueColumnTags: true}, captured.Shown)
+	assert.Equal(t, 6, captured.ColumnCount())
+	assert.Equal(t, "/queues?q=orders", captured.ReturnURL)
+	assert.Equal(t, "/queues/export.csv?q=orders", captured.ExportURL)
+	assert.Equal(t, "/reports/queues?queue=https%3A%2F%2Fsqs.local%2Forders&queue=https%3A%2F%2Fsqs.local%2Forders-dlq&queue=https%3A%2F%2Fsqs.local%2Forders-retry", captured.ReportURL)
+	require.Len(t, captured.Columns, len(queueColumns))
+	assert.Equal(t, queueColumnOption{Value: QueueColumnType, Label: "Type"}, captured.Columns[0])
+	assert.Equal(t, queueColumnOption{Value: QueueColumnTags, Label: "Tags", Shown: true}, captured.Columns[len(queueColumns)-1])
+
+	require.Len(t, captured.Queues, 3)
+	assert.Equal(t, "3", captured.Queues[0].MessagesDelayed)
+	assert.Equal(t, "≤ 1m30s", captured.Queues[0].OldestAge)
+	assert.Equal(t, "arn:aws:sqs:us-east-1:000000000000:orders", captured.Queues[0].Arn)
+	assert.Equal(t, "env=prod, team=core", captured.Queues[0].Tags)
+	assert.Equal(t, "> 10m0s", captured.Queues[1].OldestAge)
+	assert.Equal(t, "-", captured.Queues[1].Arn)
+	assert.Equal(t, "-", captured.Queues[1].Tags)
+	assert.Equal(t, "-", captured.Queues[2].OldestAge)
+	assert.Equal(t, "Unavailable", captured.Queues[2].Tags)
+}
+
+func TestHandlerImpl_PostQueueColumnsHandler(t *testing.T) {
+	testCases := []struct {
+		name         string
+		returnURL    string
+		wantLocation string
+	}{
+		{name: "returns to the filtered list", returnURL: "/queues?q=orders", wantLocation: "/queues?q=orders"},
+		{name: "ignores a return url off the queue list", returnURL: "https://example.com/queues", wantLocation: "/queues"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSqsService(t)
+			handler := NewHandler(mockService)
+			mockService.EXPECT().
+				SaveQueueListColumns(mock.Anything, []string{QueueColumnAvailable, QueueColumnTags}).
+				Return([]string{QueueColumnAvailable, QueueColumnTags}, nil).
+				Once()
+
+			form := url.Values{"column": {QueueColumnAvailable, QueueColumnTags}, "return": {tc.returnURL}}
+			req := httptest.NewRequest(http.MethodPost, "/preferences/queue-columns", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rr := httptest.NewRecorder()
+			handler.PostQueueColumnsHandler(rr, req)
+
+			assert.Equal(t, http.StatusSeeOther, rr.Code)
+			assert.Equal(t, tc.wantLocation, rr.Header().Get("Location"))
+		})
+	}
+
+	t.Run("rejects an invalid selection", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().
+			SaveQueueListColumns(mock.Anything, []string(nil)).
+			Return(nil, errors.New("choose at least one column")).
+			Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/preferences/queue-columns", strings.NewReader("return=%2Fqueues"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		handler.PostQueueColumnsHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "choose at least one column\n", rr.Body.String())
+	})
+}
+
+func TestHandlerImpl_PostFavoriteQueueHandler(t *testing.T) {
+	t.Run("stars a queue and returns to the list", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().SetFavoriteQueue(mock.Anything, "https://sqs.local/orders", true).Return(nil).Once()
+
+		form := url.Values{"queue_url": {"https://sqs.local/orders"}, "favorite": {"true"}, "return": {"/queues?q=ord"}}
+		req := httptest.NewRequest(http.MethodPost, "/preferences/favorite-queues", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		handler.PostFavoriteQueueHandler(rr, req)
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues?q=ord", rr.Header().Get("Location"))
+	})
+
+	t.Run("reports a full favorites list", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().
+			SetFavoriteQueue(mock.Anything, "https://sqs.local/orders", true).
+			Return(errors.New("at most 50 queues can be favorites")).
+			Once()
+
+		form := url.Values{"queue_url": {"https://sqs.local/orders"}, "favorite": {"true"}}
+		req := httptest.NewRequest(http.MethodPost, "/preferences/favorite-queues", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		handler.PostFavoriteQueueHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "at most 50 queues can be favorites\n", rr.Body.String())
+	})
+}
+
+func TestHandlerImpl_QueuesHandler_Favorites(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/queues?q=orders", nil)
+	mockService.EXPECT().QueueListColumns(mock.Anything).Return(defaultQueueColumns, nil).Once()
+	mockService.EXPECT().
+		FindQueues(mock.Anything, QueueListOptions{Query: "orders", Favorites: true}).
+		Return(QueueListPage{
+			Queues: []QueueSummary{
+				{URL: "https://sqs.local/orders", Name: "orders"},
+				{URL: "https://sqs.local/orders-dlq", Name: "orders-dlq"},
+			},
+			Total: 2,
+			Favorites: []QueueSummary{
+				{URL: "https://sqs.local/billing", Name: "billing", MessagesAvailable: 4},
+				{URL: "https://sqs.local/orders", Name: "orders"},
+			},
+		}, nil).
+		Once()
+
+	var captured queuesPageData
+	captureQueuesTemplate(t, &captured)
+	installQueuesFragment(t, "")
+
+	rr := httptest.NewRecorder()
+	handler.QueuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.Len(t, captured.Favorites, 2)
+	assert.Equal(t, "billing", captured.Favorites[0].Name)
+	assert.Equal(t, "4", captured.Favorites[0].MessagesAvailable)
+	assert.True(t, captured.Favorites[0].Favorite)
+	require.Len(t, captured.Queues, 2)
+	assert.True(t, captured.Queues[0].Favorite)
+	assert.False(t, captured.Queues[1].Favorite)
+}
+
+func TestHandlerImpl_QueuesHandler_Groups(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/queues?group=tag&group_tag=team", nil)
+	mockService.EXPECT().QueueListColumns(mock.Anything).Return(defaultQueueColumns, nil).Once()
+	mockService.EXPECT().
+		FindQueues(mock.Anything, QueueListOptions{GroupBy: QueueGroupTag, GroupTag: "team", Favorites: true}).
+		Return(QueueListPage{
+			Queues: []QueueSummary{
+				{URL: "https://sqs.local/audit", Name: "audit"},
+				{URL: "https://sqs.local/billing", Name: "billing"},
+			},
+			Total: 2,
+			Groups: []QueueGroup{
+				{Name: "finance", Queues: []QueueSummary{{URL: "https://sqs.local/billing", Name: "billing"}}},
+				{Queues: []QueueSummary{{URL: "https://sqs.local/audit", Name: "audit"}}},
+			},
+		}, nil).
+		Once()
+
+	var captured queuesPageData
+	captureQueuesTemplate(t, &captured)
+	installQueuesFragment(t, "")
+
+	rr := httptest.NewRecorder()
+	handler.QueuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, QueueGroupTag, captured.Listing.GroupBy)
+	assert.Equal(t, "team", captured.Listing.GroupTag)
+	require.Len(t, captured.Groups, 2)
+	assert.Equal(t, "team: finance", captured.Groups[0].Label)
+	require.Len(t, captured.Groups[0].Queues, 1)
+	assert.Equal(t, "billing", captured.Groups[0].Queues[0].Name)
+	assert.Equal(t, "No team tag", captured.Groups[1].Label)
+	require.Len(t, captured.Groups[1].Queues, 1)
+	assert.Equal(t, "audit", captured.Groups[1].Queues[0].Name)
+	assert.Len(t, captured.Queues, 2)
+}
+
+func TestHandlerImpl_QueuesHandler_InvalidListing(t *testing.T) {
+	handler := NewHandler(NewMockSqsService(t))
+
+	req := httptest.NewRequest(http.MethodGet, "/queues?order=sideways", nil)
+	rr := httptest.NewRecorder()
+	handler.QueuesHandler(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, "order must be asc or desc\n", rr.Body.String())
+}
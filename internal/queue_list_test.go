@@ -0,0 +1,246 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_FindQueues(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	queues := []QueueSummary{
+		{Name: "orders", Type: QueueTypeStandard, CreatedAt: base.Add(2 * time.Hour), MessagesAvailable: 5},
+		{Name: "Billing.fifo", Type: QueueTypeFIFO, CreatedAt: base, MessagesAvailable: 5, Encryption: "KMS"},
+		{Name: "orders-dlq", Type: QueueTypeStandard, CreatedAt: base.Add(time.Hour), MessagesAvailable: 1},
+		{Name: "audit", Type: QueueTypeStandard, CreatedAt: base.Add(3 * time.Hour), MessagesAvailable: 9},
+	}
+	names := func(page QueueListPage) []string {
+		result := make([]string, 0, len(page.Queues))
+		for _, queue := range page.Queues {
+			result = append(result, queue.Name)
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name      string
+		opts      QueueListOptions
+		wantNames []string
+		wantTotal int
+	}{
+		{
+			name:      "sorts by name by default",
+			opts:      QueueListOptions{},
+			wantNames: []string{"Billing.fifo", "audit", "orders", "orders-dlq"},
+			wantTotal: 4,
+		},
+		{
+			name:      "filters by name ignoring case",
+			opts:      QueueListOptions{Query: "ORDERS"},
+			wantNames: []string{"orders", "orders-dlq"},
+			wantTotal: 2,
+		},
+		{
+			name:      "filters by type",
+			opts:      QueueListOptions{Type: QueueTypeFIFO},
+			wantNames: []string{"Billing.fifo"},
+			wantTotal: 1,
+		},
+		{
+			name:      "filters queues with a KMS key",
+			opts:      QueueListOptions{Encryption: QueueEncryptionKMS},
+			wantNames: []string{"Billing.fifo"},
+			wantTotal: 1,
+		},
+		{
+			name:      "filters queues without a KMS key",
+			opts:      QueueListOptions{Encryption: QueueEncryptionNone, Sort: QueueSortCreated},
+			wantNames: []string{"orders-dlq", "orders", "audit"},
+			wantTotal: 3,
+		},
+		{
+			name:      "sorts by creation time descending",
+			opts:      QueueListOptions{Sort: QueueSortCreated, Descending: true},
+			wantNames: []string{"audit", "orders", "orders-dlq", "Billing.fifo"},
+			wantTotal: 4,
+		},
+		{
+			name:      "breaks ties by name",
+			opts:      QueueListOptions{Sort: QueueSortAvailable},
+			wantNames: []string{"orders-dlq", "Billing.fifo", "orders", "audit"},
+			wantTotal: 4,
+		},
+		{
+			name:      "pages after sorting",
+			opts:      QueueListOptions{Sort: QueueSortAvailable, Limit: 2, Offset: 1},
+			wantNames: []string{"Billing.fifo", "orders"},
+			wantTotal: 4,
+		},
+		{
+			name:      "returns an empty page past the end",
+			opts:      QueueListOptions{Offset: 10},
+			wantNames: []string{},
+			wantTotal: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := NewMockSqsRepository(t)
+			service := &SqsServiceImpl{repo: repo}
+			repo.EXPECT().ListQueues(mock.Anything).Return(append([]QueueSummary(nil), queues...), nil).Once()
+
+			page, err := service.FindQueues(ctx, tc.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantNames, names(page))
+			assert.Equal(t, tc.wantTotal, page.Total)
+		})
+	}
+
+	t.Run("rejects unknown sort key", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.FindQueues(ctx, QueueListOptions{Sort: "size"})
+		assert.EqualError(t, err, "sort must be one of name, created, available, in-flight, visibility-timeout")
+	})
+
+	t.Run("rejects unknown type", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.FindQueues(ctx, QueueListOptions{Type: "priority"})
+		assert.EqualError(t, err, "type must be standard or fifo")
+	})
+
+	t.Run("rejects unknown encryption", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.FindQueues(ctx, QueueListOptions{Encryption: "sse"})
+		assert.EqualError(t, err, "encryption must be kms or none")
+	})
+
+	t.Run("lists the tags of the queues on the page", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, capabilities: &capabilityCache{conclusive: true, caps: EndpointCapabilities{Tags: true}}}
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{
+			{URL: "https://sqs.local/orders", Name: "orders"},
+			{URL: "https://sqs.local/billing", Name: "billing"},
+			{URL: "https://sqs.local/audit", Name: "audit"},
+		}, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, "https://sqs.local/audit").Return(map[string]string{"team": "core"}, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, "https://sqs.local/billing").Return(nil, errors.New("denied")).Once()
+
+		page, err := service.FindQueues(ctx, QueueListOptions{Limit: 2, Tags: true})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]map[string]string{"https://sqs.local/audit": {"team": "core"}}, page.Tags)
+	})
+
+	t.Run("skips tags the endpoint does not support", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, capabilities: &capabilityCache{conclusive: true}}
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{{URL: "https://sqs.local/orders", Name: "orders"}}, nil).Once()
+
+		page, err := service.FindQueues(ctx, QueueListOptions{Tags: true})
+		require.NoError(t, err)
+		assert.Nil(t, page.Tags)
+	})
+
+	t.Run("lists the favorites whatever the filter", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, store: store}
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/orders", true))
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/audit", true))
+		require.NoError(t, service.SetFavoriteQueue(ctx, "https://sqs.local/deleted", true))
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{
+			{URL: "https://sqs.local/orders", Name: "orders"},
+			{URL: "https://sqs.local/billing", Name: "billing"},
+			{URL: "https://sqs.local/audit", Name: "audit"},
+		}, nil).Once()
+
+		page, err := service.FindQueues(ctx, QueueListOptions{Query: "billing", Favorites: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"billing"}, names(page))
+		assert.Equal(t, []QueueSummary{
+			{URL: "https://sqs.local/audit", Name: "audit"},
+			{URL: "https://sqs.local/orders", Name: "orders"},
+		}, page.Favorites)
+	})
+
+	t.Run("groups the page by name prefix", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{
+			{URL: "https://sqs.local/orders", Name: "orders"},
+			{URL: "https://sqs.local/billing-dlq", Name: "billing-dlq"},
+			{URL: "https://sqs.local/orders_retry.fifo", Name: "orders_retry.fifo"},
+			{URL: "https://sqs.local/billing", Name: "billing"},
+			{URL: "https://sqs.local/audit", Name: "audit"},
+			{URL: "https://sqs.local/orders-dlq", Name: "orders-dlq"},
+		}, nil).Once()
+
+		page, err := service.FindQueues(ctx, QueueListOptions{GroupBy: QueueGroupPrefix})
+		require.NoError(t, err)
+		assert.Equal(t, []QueueGroup{
+			{Name: "billing", Queues: []QueueSummary{
+				{URL: "https://sqs.local/billing", Name: "billing"},
+				{URL: "https://sqs.local/billing-dlq", Name: "billing-dlq"},
+			}},
+			{Name: "orders", Queues: []QueueSummary{
+				{URL: "https://sqs.local/orders", Name: "orders"},
+				{URL: "https://sqs.local/orders-dlq", Name: "orders-dlq"},
+				{URL: "https://sqs.local/orders_retry.fifo", Name: "orders_retry.fifo"},
+			}},
+			{Queues: []QueueSummary{{URL: "https://sqs.local/audit", Name: "audit"}}},
+		}, page.Groups)
+	})
+
+	t.Run("groups the page by tag", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, capabilities: &capabilityCache{conclusive: true, caps: EndpointCapabilities{Tags: true}}}
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{
+			{URL: "https://sqs.local/orders", Name: "orders"},
+			{URL: "https://sqs.local/billing", Name: "billing"},
+			{URL: "https://sqs.local/audit", Name: "audit"},
+			{URL: "https://sqs.local/payments", Name: "payments"},
+		}, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, "https://sqs.local/orders").Return(map[string]string{"team": "sales"}, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, "https://sqs.local/billing").Return(map[string]string{"team": "finance"}, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, "https://sqs.local/audit").Return(map[string]string{"env": "prod"}, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, "https://sqs.local/payments").Return(map[string]string{"team": "finance"}, nil).Once()
+
+		page, err := service.FindQueues(ctx, QueueListOptions{Sort: QueueSortName, GroupBy: QueueGroupTag, GroupTag: " team "})
+		require.NoError(t, err)
+		assert.Equal(t, []QueueGroup{
+			{Name: "finance", Queues: []QueueSummary{
+				{URL: "https://sqs.local/billing", Name: "billing"},
+				{URL: "https://sqs.local/payments", Name: "payments"},
+			}},
+			{Name: "sales", Queues: []QueueSummary{{URL: "https://sqs.local/orders", Name: "orders"}}},
+			{Queues: []QueueSummary{{URL: "https://sqs.local/audit", Name: "audit"}}},
+		}, page.Groups)
+	})
+
+	t.Run("rejects a grouping it does not know", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.FindQueues(ctx, QueueListOptions{GroupBy: "owner"})
+		assert.EqualError(t, err, "group must be tag or prefix")
+
+		_, err = service.FindQueues(ctx, QueueListOptions{GroupBy: QueueGroupTag})
+		assert.EqualError(t, err, "a tag key is required to group by tag")
+	})
+
+	t.Run("rejects grouping by tag without tag support", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t), capabilities: &capabilityCache{conclusive: true}}
+
+		_, err := service.FindQueues(ctx, QueueListOptions{GroupBy: QueueGroupTag, GroupTag: "team"})
+		assert.ErrorIs(t, err, ErrTagsUnsupported)
+	})
+}
@@ -0,0 +1,282 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// defaultMigrationGroupID is the message group used for messages moved into a FIFO queue
+	// when none is given. A single group keeps them in the order they were received.
+	defaultMigrationGroupID = "migrated"
+	// migrationReceiveWait is the long poll used while moving messages; long polling asks every
+	// SQS server, so an empty response means the queue has no visible messages left.
+	migrationReceiveWait int32 = 2
+	// migrationReceiveBatch is the most messages one ReceiveMessage call returns.
+	migrationReceiveBatch int32 = 10
+	// defaultSQSKMSKey is the AWS managed key of SQS, which every region has.
+	defaultSQSKMSKey = "alias/aws/sqs"
+)
+
+// fifoOnlyQueueAttributes only exist on FIFO queues and are dropped when migrating to standard.
+var fifoOnlyQueueAttributes = []string{"ContentBasedDeduplication", "DeduplicationScope", "FifoQueue", "FifoThroughputLimit"}
+
+// MigrateQueueInput describes the counterpart of a queue to create. TargetName defaults to the
+// source name with .fifo added or removed. MessageGroupID is used for messages moved into a FIFO
+// queue that have no group of their own.
+type MigrateQueueInput struct {
+	SourceURL      string
+	TargetName     string
+	MoveMessages   bool
+	MessageGroupID string
+	// TargetRegion copies the queue to one of the regions of SQS_GUI_MIGRATION_REGIONS instead.
+	// The copy keeps the type of the source and, by default, its name.
+	TargetRegion string
+}
+
+// SkippedQueueAttribute is a source attribute that was not copied to the new queue.
+type SkippedQueueAttribute struct {
+	Name   string
+	Reason string
+}
+
+// QueueMigration is the outcome of MigrateQueue. Job is set when messages are being moved.
+type QueueMigration struct {
+	TargetURL  string
+	TargetName string
+	TargetType QueueType
+	// TargetRegion is set when the queue was copied to another region.
+	TargetRegion string
+	Skipped      []SkippedQueueAttribute
+	Job          *Job
+}
+
+// migrationTarget returns the default name and the type of the counterpart of queueName, which
+// is always of the other type.
+func migrationTarget(queueName string) (string, QueueType) {
+	if strings.HasSuffix(queueName, ".fifo") {
+		return strings.TrimSuffix(queueName, ".fifo"), QueueTypeStandard
+	}
+	return queueName + ".fifo", QueueTypeFIFO
+}
+
+// MigrationRegions returns the regions queues can be copied to, in alphabetical order.
+func (s *SqsServiceImpl) MigrationRegions() []string {
+	return slices.Sorted(maps.Keys(s.regions))
+}
+
+// MigrateQueue creates a queue of the other type with the attributes and tags of the source,
+// since SQS cannot convert a queue in place. With TargetRegion it creates a queue of the same
+// type in that region instead. Attributes that would not work on the new queue, such as a policy
+// naming the source ARN or a redrive policy pointing at a dead-letter queue of the old type or
+// region, are reported instead of copied. When MoveMessages is set the visible messages are moved
+// in a background job; the source queue itself is left in place.
+func (s *SqsServiceImpl) MigrateQueue(ctx context.Context, input MigrateQueueInput) (QueueMigration, error) {
+	sourceURL := strings.TrimSpace(input.SourceURL)
+	if sourceURL == "" {
+		return QueueMigration{}, errors.New("queue url is required")
+	}
+
+	target := s.repo
+	sourceName := extractQueueName(sourceURL)
+	defaultName, targetType := migrationTarget(sourceName)
+	region := strings.TrimSpace(input.TargetRegion)
+	if region != "" {
+		repo, ok := s.regions[region]
+		if !ok {
+			return QueueMigration{}, errors.Newf("region %q is not one of the migration regions", region)
+		}
+		target = repo
+		defaultName, targetType = sourceName, queueTypeOfName(sourceName)
+	}
+	targetName := strings.TrimSpace(input.TargetName)
+	if targetName == "" {
+		targetName = defaultName
+	}
+	targetName, normalizedType := normalizeQueueName(targetName, targetType)
+	if normalizedType != targetType {
+		return QueueMigration{}, errors.New("a standard queue name must not end in .fifo")
+	}
+	if err := validateQueueName(targetName); err != nil {
+		return QueueMigration{}, err
+	}
+
+	groupID := strings.TrimSpace(input.MessageGroupID)
+	if groupID == "" {
+		groupID = defaultMigrationGroupID
+	}
+
+	detail, err := s.repo.GetQueueDetail(ctx, sourceURL)
+	if err != nil {
+		return QueueMigration{}, err
+	}
+
+	attributes, skipped := migrationAttributes(detail.Attributes, targetType, region)
+	tags := maps.Clone(detail.Tags)
+	for key, value := range s.config.DefaultTags {
+		if _, ok := tags[key]; !ok {
+			if tags == nil {
+				tags = make(map[string]string)
+			}
+			tags[key] = value
+		}
+	}
+
+	targetURL, err := target.CreateQueue(ctx, CreateQueueRepositoryInput{
+		Name:       targetName,
+		Attributes: attributes,
+		Tags:       s.creatableTags(ctx, tags),
+	})
+	if err != nil {
+		return QueueMigration{}, err
+	}
+
+	migration := QueueMigration{
+		TargetURL:    targetURL,
+		TargetName:   targetName,
+		TargetType:   targetType,
+		TargetRegion: region,
+		Skipped:      skipped,
+	}
+	if !input.MoveMessages {
+		return migration, nil
+	}
+
+	job, err := s.jobs.start(ctx, "migrate", sourceURL, func(ctx context.Context, progress *JobProgress) error {
+		return s.moveMessages(ctx, sourceURL, target, targetURL, targetType, groupID, progress)
+	})
+	if err != nil {
+		return QueueMigration{}, errors.Wrapf(err, "created %s but could not start moving messages", targetName)
+	}
+	migration.Job = &job
+	return migration, nil
+}
+
+// migrationAttributes picks the source attributes CreateQueue accepts for a queue of targetType.
+// A region is given for copies to another region, where the dead-letter queues, the customer
+// managed KMS keys and the queues a redrive allow policy names do not exist.
+func migrationAttributes(source map[string]string, targetType QueueType, region string) (map[string]string, []SkippedQueueAttribute) {
+	attributes := make(map[string]string)
+	var skipped []SkippedQueueAttribute
+	for _, name := range restorableQueueAttributes {
+		value, ok := source[name]
+		if !ok || strings.TrimSpace(value) == "" {
+			continue
+		}
+		switch {
+		case targetType == QueueTypeStandard && slices.Contains(fifoOnlyQueueAttributes, name):
+			continue
+		case name == "Policy":
+			skipped = append(skipped, SkippedQueueAttribute{Name: name, Reason: "the access policy names the source queue ARN; review it and set it on the new queue"})
+			continue
+		case name == "RedrivePolicy" && region != "":
+			skipped = append(skipped, SkippedQueueAttribute{Name: name, Reason: "the dead-letter queue is in the source region; create one in " + region})
+			continue
+		case name == "RedrivePolicy":
+			skipped = append(skipped, SkippedQueueAttribute{Name: name, Reason: fmt.Sprintf("a %s queue needs a %s dead-letter queue", targetType, targetType)})
+			continue
+		case name == "RedriveAllowPolicy" && region != "" && strings.Contains(value, "sourceQueueArns"):
+			skipped = append(skipped, SkippedQueueAttribute{Name: name, Reason: "the allowed source queues are in the source region"})
+			continue
+		case name == "KmsMasterKeyId" && region != "" && value != defaultSQSKMSKey:
+			skipped = append(skipped, SkippedQueueAttribute{Name: name, Reason: "KMS keys belong to one region; set a key of " + region + " on the new queue"})
+			continue
+		}
+		attributes[name] = value
+	}
+	if targetType == QueueTypeFIFO {
+		attributes["FifoQueue"] = "true"
+	}
+	return attributes, skipped
+}
+
+// moveMessages receives the visible messages of sourceURL and sends them to targetURL through
+// target, deleting each one only after it was sent. Messages in flight when the job runs stay in
+// the source, and the job stops at the first message it cannot send or delete.
+func (s *SqsServiceImpl) moveMessages(ctx context.Context, sourceURL string, target SqsRepository, targetURL string, targetType QueueType, groupID string, progress *JobProgress) error {
+	if stats, err := s.repo.GetQueueStats(ctx, sourceURL); err == nil {
+		progress.SetTotal(stats.MessagesAvailable)
+	}
+
+	var moved int64
+	for {
+		progress.SetMessage(fmt.Sprintf("Moved %d messages.", moved))
+		messages, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
+			QueueURL:        sourceURL,
+			MaxMessages:     migrationReceiveBatch,
+			WaitTimeSeconds: migrationReceiveWait,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "moved %d messages", moved)
+		}
+		if len(messages) == 0 {
+			break
+		}
+
+		for _, message := range messages {
+			send := SendMessageRepositoryInput{
+				QueueURL:       targetURL,
+				Body:           message.Body,
+				Attributes:     customMessageAttributes(message),
+				AttributeTypes: customMessageAttributeTypes(message),
+			}
+			if targetType == QueueTypeFIFO {
+				// The source message ID keeps a retried send from creating a duplicate. Messages
+				// from a FIFO source keep their group.
+				send.MessageGroupID = cmp.Or(messageAttributeValue(message, "MessageGroupId"), groupID)
+				send.MessageDeduplicationID = message.ID
+			}
+			if err := target.SendMessage(ctx, send); err != nil {
+				return errors.Wrapf(err, "moved %d messages", moved)
+			}
+			if err := s.repo.DeleteMessage(ctx, DeleteMessageRepositoryInput{QueueURL: sourceURL, ReceiptHandle: message.ReceiptHandle}); err != nil {
+				// Carrying on would move the message again once it becomes visible.
+				return errors.Wrapf(err, "moved %d messages; message %s was copied but is still in the source queue", moved, message.ID)
+			}
+			moved++
+			progress.Advance(1)
+		}
+	}
+
+	progress.SetMessage(fmt.Sprintf("Moved %d messages.", moved))
+	return nil
+}
+
+// customMessageAttributes returns the attributes the sender set on message, leaving out the
+// system attributes SQS adds on receive. It returns nil when there are none.
+func customMessageAttributes(message ReceivedMessage) map[string]string {
+	var attributes map[string]string
+	for _, attribute := range message.Attributes {
+		if systemMessageAttributes[attribute.Name] {
+			continue
+		}
+		if attributes == nil {
+			attributes = make(map[string]string, len(message.Attributes))
+		}
+		attributes[attribute.Name] = attribute.Value
+	}
+	return attributes
+}
+
+// customMessageAttributeTypes returns the data types of the attributes of customMessageAttributes
+// that are not String, so a re-sent message keeps its Number and Binary attributes. It returns nil
+// when there are none.
+func customMessageAttributeTypes(message ReceivedMessage) map[string]string {
+	var dataTypes map[string]string
+	for _, attribute := range message.Attributes {
+		if attribute.DataType == "" || systemMessageAttributes[attribute.Name] {
+			continue
+		}
+		if dataTypes == nil {
+			dataTypes = make(map[string]string, len(message.Attributes))
+		}
+		dataTypes[attribute.Name] = attribute.DataType
+	}
+	return dataTypes
+}
@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type queueMigrationPageData struct {
+	Title          string
+	ViteTags       template.HTML
+	ErrorMessage   string
+	SourceName     string
+	SourceType     string
+	EscapedURL     string
+	TargetName     string
+	TargetType     string
+	MoveMessages   bool
+	MessageGroupID string
+	// Regions are the other regions the queue can be copied to; TargetRegion is the one picked.
+	Regions      []string
+	TargetRegion string
+	Result       *queueMigrationView
+}
+
+// queueMigrationView is the created queue. A queue in another region is only shown by URL, since
+// the GUI does not browse that region.
+type queueMigrationView struct {
+	TargetName       string
+	TargetURL        string
+	EscapedTargetURL string
+	TargetRegion     string
+	Skipped          []SkippedQueueAttribute
+	JobID            string
+}
+
+// QueueMigrationHandler renders the form that creates a queue of the other type from a queue, or
+// copies it to another region.
+func (h *HandlerImpl) QueueMigrationHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	data := newQueueMigrationPageData(queueURL, h.s.MigrationRegions())
+	h.renderQueueMigration(w, http.StatusOK, data)
+}
+
+// PostQueueMigrationHandler creates the counterpart queue and, when asked, starts moving the
+// messages. The page then follows the move job.
+func (h *HandlerImpl) PostQueueMigrationHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	data := newQueueMigrationPageData(queueURL, h.s.MigrationRegions())
+	data.TargetName = strings.TrimSpace(r.FormValue("target_name"))
+	data.MoveMessages = r.FormValue("move_messages") == "on"
+	data.MessageGroupID = strings.TrimSpace(r.FormValue("message_group_id"))
+	data.TargetRegion = strings.TrimSpace(r.FormValue("target_region"))
+
+	migration, err := h.s.MigrateQueue(r.Context(), MigrateQueueInput{
+		SourceURL:      queueURL,
+		TargetName:     data.TargetName,
+		MoveMessages:   data.MoveMessages,
+		MessageGroupID: data.MessageGroupID,
+		TargetRegion:   data.TargetRegion,
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to migrate queue", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderQueueMigration(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.Result = &queueMigrationView{
+		TargetName:       migration.TargetName,
+		TargetURL:        migration.TargetURL,
+		EscapedTargetURL: url.QueryEscape(migration.TargetURL),
+		TargetRegion:     migration.TargetRegion,
+		Skipped:          migration.Skipped,
+	}
+	if migration.Job != nil {
+		data.Result.JobID = migration.Job.ID
+	}
+	h.renderQueueMigration(w, http.StatusOK, data)
+}
+
+func newQueueMigrationPageData(queueURL string, regions []string) queueMigrationPageData {
+	sourceName := extractQueueName(queueURL)
+	targetName, targetType := migrationTarget(sourceName)
+	return queueMigrationPageData{
+		Title:          "Migrate queue",
+		ViteTags:       fragments["assets/js/queue_migration.ts"].Tags,
+		SourceName:     sourceName,
+		SourceType:     strings.ToUpper(string(queueTypeOfName(sourceName))),
+		EscapedURL:     url.QueryEscape(queueURL),
+		TargetName:     targetName,
+		TargetType:     strings.ToUpper(string(targetType)),
+		MessageGroupID: defaultMigrationGroupID,
+		Regions:        regions,
+	}
+}
+
+func (h *HandlerImpl) renderQueueMigration(w http.ResponseWriter, status int, data queueMigrationPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["queue-migration"].Execute(w, data); err != nil {
+		slog.Error("failed to render queue-migration template", slog.Any("error", err))
+	}
+}
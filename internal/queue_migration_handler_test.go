@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerImpl_QueueMigrationHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders.fifo"
+	escaped := url.QueryEscape(queueURL)
+
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+	rr := httptest.NewRecorder()
+	mockService.EXPECT().MigrationRegions().Return([]string{"eu-west-1"}).Once()
+
+	var captured queueMigrationPageData
+	captureTemplate(t, "queue-migration", func(data queueMigrationPageData) { captured = data })
+	installFragment(t, "assets/js/queue_migration.ts", "")
+
+	req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/migrate", nil)
+	req.SetPathValue("url", escaped)
+	handler.QueueMigrationHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "orders.fifo", captured.SourceName)
+	assert.Equal(t, "orders", captured.TargetName)
+	assert.Equal(t, "STANDARD", captured.TargetType)
+	assert.Equal(t, "FIFO", captured.SourceType)
+	assert.Equal(t, []string{"eu-west-1"}, captured.Regions)
+	assert.Nil(t, captured.Result)
+}
+
+func TestHandlerImpl_PostQueueMigrationHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/migrate", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("creates the queue and follows the move job", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queueMigrationPageData
+		captureTemplate(t, "queue-migration", func(data queueMigrationPageData) { captured = data })
+		installFragment(t, "assets/js/queue_migration.ts", "")
+
+		skipped := []SkippedQueueAttribute{{Name: "Policy", Reason: "review it"}}
+		mockService.EXPECT().MigrationRegions().Return(nil).Once()
+		mockService.EXPECT().
+			MigrateQueue(mock.Anything, MigrateQueueInput{
+				SourceURL:      queueURL,
+				TargetName:     "orders-v2.fifo",
+				MoveMessages:   true,
+				MessageGroupID: "orders",
+			}).
+			Return(QueueMigration{
+				TargetURL:  "https://sqs.local/orders-v2.fifo",
+				TargetName: "orders-v2.fifo",
+				TargetType: QueueTypeFIFO,
+				Skipped:    skipped,
+				Job:        &Job{ID: "job-1"},
+			}, nil).
+			Once()
+
+		handler.PostQueueMigrationHandler(rr, newRequest(url.Values{
+			"target_name":      {" orders-v2.fifo "},
+			"move_messages":    {"on"},
+			"message_group_id": {"orders"},
+		}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.NotNil(t, captured.Result)
+		assert.Equal(t, queueMigrationView{
+			TargetName:       "orders-v2.fifo",
+			TargetURL:        "https://sqs.local/orders-v2.fifo",
+			EscapedTargetURL: url.QueryEscape("https://sqs.local/orders-v2.fifo"),
+			Skipped:          skipped,
+			JobID:            "job-1",
+		}, *captured.Result)
+	})
+
+	t.Run("copies the queue to another region", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queueMigrationPageData
+		captureTemplate(t, "queue-migration", func(data queueMigrationPageData) { captured = data })
+		installFragment(t, "assets/js/queue_migration.ts", "")
+
+		targetURL := "https://sqs.eu-west-1.amazonaws.com/000000000000/orders"
+		mockService.EXPECT().MigrationRegions().Return([]string{"eu-west-1"}).Once()
+		mockService.EXPECT().
+			MigrateQueue(mock.Anything, MigrateQueueInput{SourceURL: queueURL, TargetName: "orders", TargetRegion: "eu-west-1"}).
+			Return(QueueMigration{TargetURL: targetURL, TargetName: "orders", TargetType: QueueTypeStandard, TargetRegion: "eu-west-1"}, nil).
+			Once()
+
+		handler.PostQueueMigrationHandler(rr, newRequest(url.Values{"target_name": {"orders"}, "target_region": {"eu-west-1"}}))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.NotNil(t, captured.Result)
+		assert.Equal(t, "eu-west-1", captured.Result.TargetRegion)
+		assert.Equal(t, targetURL, captured.Result.TargetURL)
+	})
+
+	t.Run("shows the error and keeps the form values", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queueMigrationPageData
+		captureTemplate(t, "queue-migration", func(data queueMigrationPageData) { captured = data })
+		installFragment(t, "assets/js/queue_migration.ts", "")
+
+		mockService.EXPECT().MigrationRegions().Return(nil).Once()
+		mockService.EXPECT().
+			MigrateQueue(mock.Anything, mock.Anything).
+			Return(QueueMigration{}, fmt.Errorf("queue \"orders\" is not allowed: %w", ErrQueueAccessDenied)).
+			Once()
+
+		handler.PostQueueMigrationHandler(rr, newRequest(url.Values{"target_name": {"orders-v2"}}))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		assert.Equal(t, "orders-v2", captured.TargetName)
+		assert.False(t, captured.MoveMessages)
+		assert.Contains(t, captured.ErrorMessage, "is not allowed")
+		assert.Nil(t, captured.Result)
+	})
+}
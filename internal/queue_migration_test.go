@@ -0,0 +1,210 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_MigrateQueue(t *testing.T) {
+	ctx := context.Background()
+	sourceURL := "https://sqs.local/000000000000/orders"
+	targetURL := "https://sqs.local/000000000000/orders.fifo"
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository) {
+		repo := NewMockSqsRepository(t)
+		return &SqsServiceImpl{repo: repo, jobs: newJobRegistry(), capabilities: &capabilityCache{}}, repo
+	}
+
+	t.Run("creates a fifo counterpart with compatible attributes and tags", func(t *testing.T) {
+		service, repo := newService(t)
+		service.config.DefaultTags = map[string]string{"created-by": "sqs-gui", "team": "default"}
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, sourceURL).Return(QueueDetail{
+			Attributes: map[string]string{
+				"ApproximateNumberOfMessages": "3",
+				"DelaySeconds":                "5",
+				"VisibilityTimeout":           "60",
+				"Policy":                      `{"Statement":[]}`,
+				"RedrivePolicy":               `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:000000000000:orders-dlq","maxReceiveCount":"5"}`,
+			},
+			Tags: map[string]string{"team": "payments"},
+		}, nil).Once()
+		repo.EXPECT().CreateQueue(mock.Anything, CreateQueueRepositoryInput{
+			Name:       "orders.fifo",
+			Attributes: map[string]string{"DelaySeconds": "5", "VisibilityTimeout": "60", "FifoQueue": "true"},
+			Tags:       map[string]string{"team": "payments", "created-by": "sqs-gui"},
+		}).Return(targetURL, nil).Once()
+
+		migration, err := service.MigrateQueue(ctx, MigrateQueueInput{SourceURL: sourceURL})
+		require.NoError(t, err)
+		assert.Equal(t, targetURL, migration.TargetURL)
+		assert.Equal(t, "orders.fifo", migration.TargetName)
+		assert.Equal(t, QueueTypeFIFO, migration.TargetType)
+		assert.Nil(t, migration.Job)
+		require.Len(t, migration.Skipped, 2)
+		assert.Equal(t, "Policy", migration.Skipped[0].Name)
+		assert.Equal(t, "RedrivePolicy", migration.Skipped[1].Name)
+		assert.Equal(t, "a fifo queue needs a fifo dead-letter queue", migration.Skipped[1].Reason)
+	})
+
+	t.Run("drops fifo attributes when migrating to standard", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, targetURL).Return(QueueDetail{
+			Attributes: map[string]string{
+				"FifoQueue":                 "true",
+				"ContentBasedDeduplication": "true",
+				"FifoThroughputLimit":       "perQueue",
+				"MessageRetentionPeriod":    "86400",
+			},
+		}, nil).Once()
+		repo.EXPECT().CreateQueue(mock.Anything, CreateQueueRepositoryInput{
+			Name:       "orders-v2",
+			Attributes: map[string]string{"MessageRetentionPeriod": "86400"},
+		}).Return("https://sqs.local/000000000000/orders-v2", nil).Once()
+
+		migration, err := service.MigrateQueue(ctx, MigrateQueueInput{SourceURL: targetURL, TargetName: " orders-v2 "})
+		require.NoError(t, err)
+		assert.Equal(t, QueueTypeStandard, migration.TargetType)
+		assert.Empty(t, migration.Skipped)
+	})
+
+	t.Run("rejects a fifo name for a standard target", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.MigrateQueue(ctx, MigrateQueueInput{SourceURL: targetURL, TargetName: "orders-v2.fifo"})
+		require.EqualError(t, err, "a standard queue name must not end in .fifo")
+	})
+
+	t.Run("moves visible messages in the background", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, sourceURL).Return(QueueDetail{}, nil).Once()
+		repo.EXPECT().CreateQueue(mock.Anything, mock.Anything).Return(targetURL, nil).Once()
+		repo.EXPECT().GetQueueStats(mock.Anything, sourceURL).Return(QueueStats{MessagesAvailable: 2}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, ReceiveMessagesRepositoryInput{
+			QueueURL:        sourceURL,
+			MaxMessages:     migrationReceiveBatch,
+			WaitTimeSeconds: migrationReceiveWait,
+		}).Return([]ReceivedMessage{
+			{ID: "m-1", Body: "first", ReceiptHandle: "r-1", Attributes: []MessageAttribute{{Name: "kind", Value: "order"}, {Name: "SentTimestamp", Value: "2024-05-01T12:00:00Z"}}},
+			{ID: "m-2", Body: "second", ReceiptHandle: "r-2", Attributes: []MessageAttribute{{Name: "ApproximateReceiveCount", Value: "1"}}},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{}, nil).Once()
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{
+			QueueURL:               targetURL,
+			Body:                   "first",
+			MessageGroupID:         "orders",
+			MessageDeduplicationID: "m-1",
+			Attributes:             map[string]string{"kind": "order"},
+		}).Return(nil).Once()
+		repo.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{
+			QueueURL:               targetURL,
+			Body:                   "second",
+			MessageGroupID:         "orders",
+			MessageDeduplicationID: "m-2",
+		}).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: sourceURL, ReceiptHandle: "r-1"}).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: sourceURL, ReceiptHandle: "r-2"}).Return(nil).Once()
+
+		migration, err := service.MigrateQueue(ctx, MigrateQueueInput{SourceURL: sourceURL, MoveMessages: true, MessageGroupID: "orders"})
+		require.NoError(t, err)
+		require.NotNil(t, migration.Job)
+		assert.Equal(t, "migrate", migration.Job.Kind)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, migration.Job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(2), job.Done)
+		assert.Equal(t, int64(2), job.Total)
+		assert.Equal(t, "Moved 2 messages.", job.Message)
+	})
+
+	t.Run("copies a queue to another region with the attributes that exist there", func(t *testing.T) {
+		service, repo := newService(t)
+		regional := NewMockSqsRepository(t)
+		service.regions = map[string]SqsRepository{"eu-west-1": regional}
+		fifoURL := "https://sqs.us-east-1.amazonaws.com/000000000000/orders.fifo"
+		copyURL := "https://sqs.eu-west-1.amazonaws.com/000000000000/orders.fifo"
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, fifoURL).Return(QueueDetail{
+			Attributes: map[string]string{
+				"FifoQueue":                 "true",
+				"ContentBasedDeduplication": "true",
+				"KmsMasterKeyId":            "arn:aws:kms:us-east-1:000000000000:key/1234",
+				"RedrivePolicy":             `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo","maxReceiveCount":"5"}`,
+			},
+		}, nil).Once()
+		regional.EXPECT().CreateQueue(mock.Anything, CreateQueueRepositoryInput{
+			Name:       "orders.fifo",
+			Attributes: map[string]string{"FifoQueue": "true", "ContentBasedDeduplication": "true"},
+		}).Return(copyURL, nil).Once()
+		repo.EXPECT().GetQueueStats(mock.Anything, fifoURL).Return(QueueStats{MessagesAvailable: 1}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "m-1", Body: "first", ReceiptHandle: "r-1", Attributes: []MessageAttribute{{Name: "MessageGroupId", Value: "customer-7"}}},
+		}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{}, nil).Once()
+		regional.EXPECT().SendMessage(mock.Anything, SendMessageRepositoryInput{
+			QueueURL:               copyURL,
+			Body:                   "first",
+			MessageGroupID:         "customer-7",
+			MessageDeduplicationID: "m-1",
+		}).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, DeleteMessageRepositoryInput{QueueURL: fifoURL, ReceiptHandle: "r-1"}).Return(nil).Once()
+
+		migration, err := service.MigrateQueue(ctx, MigrateQueueInput{SourceURL: fifoURL, TargetRegion: "eu-west-1", MoveMessages: true})
+		require.NoError(t, err)
+		assert.Equal(t, QueueMigration{
+			TargetURL:    copyURL,
+			TargetName:   "orders.fifo",
+			TargetType:   QueueTypeFIFO,
+			TargetRegion: "eu-west-1",
+			Skipped: []SkippedQueueAttribute{
+				{Name: "KmsMasterKeyId", Reason: "KMS keys belong to one region; set a key of eu-west-1 on the new queue"},
+				{Name: "RedrivePolicy", Reason: "the dead-letter queue is in the source region; create one in eu-west-1"},
+			},
+			Job: migration.Job,
+		}, migration)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, migration.Job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+	})
+
+	t.Run("rejects a region that is not configured", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.MigrateQueue(ctx, MigrateQueueInput{SourceURL: sourceURL, TargetRegion: "eu-west-1"})
+		require.EqualError(t, err, `region "eu-west-1" is not one of the migration regions`)
+	})
+
+	t.Run("stops when a moved message cannot be deleted", func(t *testing.T) {
+		service, repo := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, sourceURL).Return(QueueDetail{}, nil).Once()
+		repo.EXPECT().CreateQueue(mock.Anything, mock.Anything).Return(targetURL, nil).Once()
+		repo.EXPECT().GetQueueStats(mock.Anything, sourceURL).Return(QueueStats{}, errors.New("timeout")).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{
+			{ID: "m-1", Body: "first", ReceiptHandle: "r-1"},
+			{ID: "m-2", Body: "second", ReceiptHandle: "r-2"},
+		}, nil).Once()
+		repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil).Once()
+		repo.EXPECT().DeleteMessage(mock.Anything, mock.Anything).Return(errors.New("access denied")).Once()
+
+		migration, err := service.MigrateQueue(ctx, MigrateQueueInput{SourceURL: sourceURL, MoveMessages: true})
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err := service.Job(ctx, migration.Job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusFailed, job.Status)
+		assert.Equal(t, "moved 0 messages; message m-1 was copied but is still in the source queue: access denied", job.Error)
+	})
+}
@@ -0,0 +1,184 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueuePolicy(t *testing.T) {
+	policy := QueuePolicy{
+		Allow:   []string{"dev-*", "prod-*"},
+		Deny:    []string{"prod-billing*"},
+		Protect: []string{"prod-*"},
+	}
+
+	assert.True(t, policy.Visible("dev-orders"))
+	assert.True(t, policy.Visible("prod-orders"))
+	assert.False(t, policy.Visible("prod-billing.fifo"))
+	assert.False(t, policy.Visible("staging-orders"))
+	assert.True(t, policy.Protected("prod-orders"))
+	assert.False(t, policy.Protected("dev-orders"))
+
+	assert.True(t, QueuePolicy{}.Visible("anything"))
+	assert.False(t, QueuePolicy{}.Enabled())
+}
+
+func TestPolicyRepository(t *testing.T) {
+	ctx := context.Background()
+	policy := QueuePolicy{Deny: []string{"secret-*"}, Protect: []string{"prod-*"}}
+
+	t.Run("filters listed queues", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		guarded := newPolicyRepository(repo, policy)
+
+		repo.EXPECT().ListQueues(ctx).Return([]QueueSummary{
+			{Name: "prod-orders"},
+			{Name: "secret-keys"},
+			{Name: "dev-orders"},
+		}, nil).Once()
+
+		queues, err := guarded.ListQueues(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []QueueSummary{{Name: "prod-orders"}, {Name: "dev-orders"}}, queues)
+	})
+
+	t.Run("filters a page of listed queues and keeps its token", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		guarded := newPolicyRepository(repo, policy)
+		input := ListQueuesPageRepositoryInput{MaxResults: 2}
+
+		repo.EXPECT().ListQueuesPage(ctx, input).Return(QueueSummaryPage{
+			Queues:    []QueueSummary{{Name: "secret-keys"}, {Name: "dev-orders"}},
+			NextToken: "page-2",
+		}, nil).Once()
+
+		page, err := guarded.ListQueuesPage(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, QueueSummaryPage{Queues: []QueueSummary{{Name: "dev-orders"}}, NextToken: "page-2"}, page)
+	})
+
+	t.Run("filters dead-letter source queues", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		guarded := newPolicyRepository(repo, policy)
+
+		repo.EXPECT().ListDeadLetterSourceQueues(ctx, "https://sqs.local/123/orders-dlq").Return([]string{
+			"https://sqs.local/123/secret-orders",
+			"https://sqs.local/123/dev-orders",
+		}, nil).Once()
+
+		sources, err := guarded.ListDeadLetterSourceQueues(ctx, "https://sqs.local/123/orders-dlq")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://sqs.local/123/dev-orders"}, sources)
+	})
+
+	t.Run("refuses destructive calls on protected queues", func(t *testing.T) {
+		guarded := newPolicyRepository(NewMockSqsRepository(t), policy)
+
+		err := guarded.PurgeQueue(ctx, "https://sqs.local/123/prod-orders")
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		assert.EqualError(t, err, `queue "prod-orders" is protected from delete and purge: queue access denied by policy`)
+
+		assert.ErrorIs(t, guarded.DeleteQueue(ctx, "https://sqs.local/123/prod-orders"), ErrQueueAccessDenied)
+	})
+
+	t.Run("refuses bulk deletions on protected queues", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		guarded := newPolicyRepository(repo, policy)
+		single := DeleteMessageRepositoryInput{QueueURL: "https://sqs.local/123/prod-orders", ReceiptHandle: "rh-1"}
+		bulk := single
+		bulk.Bulk = true
+
+		repo.EXPECT().DeleteMessage(ctx, single).Return(nil).Once()
+
+		require.NoError(t, guarded.DeleteMessage(ctx, single))
+		err := guarded.DeleteMessage(ctx, bulk)
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		assert.EqualError(t, err, `queue "prod-orders" is protected from delete and purge: queue access denied by policy`)
+	})
+
+	t.Run("cancels move tasks of visible queues only", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		guarded := newPolicyRepository(repo, policy)
+		handle := func(sourceArn string) string {
+			return base64.StdEncoding.EncodeToString([]byte(`{"taskId":"1","sourceArn":"` + sourceArn + `"}`))
+		}
+		visible := handle("arn:aws:sqs:us-east-1:123:dev-orders-dlq")
+
+		repo.EXPECT().CancelMessageMoveTask(ctx, visible).Return(int64(3), nil).Once()
+
+		moved, err := guarded.CancelMessageMoveTask(ctx, visible)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), moved)
+		_, err = guarded.CancelMessageMoveTask(ctx, handle("arn:aws:sqs:us-east-1:123:secret-keys"))
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		_, err = guarded.CancelMessageMoveTask(ctx, "opaque")
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+	})
+
+	t.Run("refuses every call on hidden queues", func(t *testing.T) {
+		guarded := newPolicyRepository(NewMockSqsRepository(t), policy)
+		queueURL := "https://sqs.local/123/secret-keys"
+
+		_, err := guarded.GetQueueDetail(ctx, queueURL)
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		_, err = guarded.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{QueueURL: queueURL})
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		assert.ErrorIs(t, guarded.SendMessage(ctx, SendMessageRepositoryInput{QueueURL: queueURL}), ErrQueueAccessDenied)
+		assert.ErrorIs(t, guarded.DeleteMessage(ctx, DeleteMessageRepositoryInput{QueueURL: queueURL}), ErrQueueAccessDenied)
+		assert.ErrorIs(t, guarded.ChangeMessageVisibility(ctx, ChangeMessageVisibilityRepositoryInput{QueueURL: queueURL}), ErrQueueAccessDenied)
+		_, err = guarded.GetQueueStats(ctx, queueURL)
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		_, err = guarded.SendMessageBatch(ctx, SendMessageBatchRepositoryInput{QueueURL: queueURL})
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		assert.ErrorIs(t, guarded.SetQueueAttributes(ctx, queueURL, map[string]string{"VisibilityTimeout": "30"}), ErrQueueAccessDenied)
+		_, err = guarded.CreateQueue(ctx, CreateQueueRepositoryInput{Name: "secret-new"})
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		_, err = guarded.ListMessageMoveTasks(ctx, "arn:aws:sqs:us-east-1:123:secret-keys")
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+		_, err = guarded.ListDeadLetterSourceQueues(ctx, queueURL)
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+	})
+
+	t.Run("passes allowed calls through", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		guarded := newPolicyRepository(repo, policy)
+
+		repo.EXPECT().PurgeQueue(ctx, "https://sqs.local/123/dev-orders").Return(nil).Once()
+
+		require.NoError(t, guarded.PurgeQueue(ctx, "https://sqs.local/123/dev-orders"))
+	})
+}
+
+// TestPolicyRepositoryWrapsEveryMethod keeps new SqsRepository methods from skipping the queue
+// policy by being promoted from the embedded repository unchecked.
+func TestPolicyRepositoryWrapsEveryMethod(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "queue_policy.go", nil, 0)
+	require.NoError(t, err)
+
+	wrapped := make(map[string]bool)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil {
+			continue
+		}
+		if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "policyRepository" {
+				wrapped[fn.Name.Name] = true
+			}
+		}
+	}
+
+	repository := reflect.TypeOf((*SqsRepository)(nil)).Elem()
+	for i := range repository.NumMethod() {
+		name := repository.Method(i).Name
+		assert.True(t, wrapped[name], "policyRepository does not override SqsRepository.%s", name)
+	}
+}
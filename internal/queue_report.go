@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// maxReportQueues bounds how many queues one report covers.
+const maxReportQueues = 50
+
+// QueueReport collects what a change review or audit needs to know about a set of queues.
+type QueueReport struct {
+	GeneratedAt time.Time
+	Queues      []QueueReportEntry
+}
+
+// QueueReportEntry is one queue of a report. Error is set when its attributes could not be read.
+// DeadLetterTarget is the queue its redrive policy moves failed messages to, and DeadLetterSources
+// the queues that redrive into it. Depth holds the samples of the in-memory depth history, oldest
+// first.
+type QueueReportEntry struct {
+	QueueDetail
+	Error             string
+	DeadLetterTarget  *DeadLetterSource
+	DeadLetterSources []DeadLetterSource
+	Depth             []depthSample
+}
+
+// QueueReport gathers the attributes, tags, dead-letter wiring and recent depth samples of
+// queueURLs, in the order given. Dead-letter wiring is resolved against the queues listed by
+// SQS, so a target in another account shows only its ARN.
+func (s *SqsServiceImpl) QueueReport(ctx context.Context, queueURLs []string) (QueueReport, error) {
+	selected := make([]string, 0, len(queueURLs))
+	for _, raw := range queueURLs {
+		queueURL := strings.TrimSpace(raw)
+		if queueURL != "" && !slices.Contains(selected, queueURL) {
+			selected = append(selected, queueURL)
+		}
+	}
+	if len(selected) == 0 {
+		return QueueReport{}, errors.New("choose at least one queue")
+	}
+	if len(selected) > maxReportQueues {
+		return QueueReport{}, errors.Newf("a report covers at most %d queues", maxReportQueues)
+	}
+
+	queues, err := s.Queues(ctx)
+	if err != nil {
+		return QueueReport{}, err
+	}
+	byArn := make(map[string]QueueSummary, len(queues))
+	for _, queue := range queues {
+		if queue.Arn != "" {
+			byArn[queue.Arn] = queue
+		}
+	}
+
+	report := QueueReport{GeneratedAt: s.now(), Queues: make([]QueueReportEntry, len(selected))}
+	slots := make(chan struct{}, statsConcurrency)
+	var wg sync.WaitGroup
+	for i, queueURL := range selected {
+		wg.Add(1)
+		go func(i int, queueURL string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			entry := QueueReportEntry{Depth: s.depths.history(queueURL)}
+			detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to read queue for report", slog.String("queue_url", queueURL), slog.Any("error", err))
+				entry.QueueDetail = QueueDetail{QueueSummary: QueueSummary{URL: queueURL, Name: extractQueueName(queueURL)}}
+				entry.Error = err.Error()
+				report.Queues[i] = entry
+				return
+			}
+			entry.QueueDetail = detail
+
+			if policy := detail.RedrivePolicy; policy != nil {
+				target := DeadLetterSource{Name: policy.DeadLetterTargetArn, MaxReceiveCount: policy.MaxReceiveCount}
+				if queue, ok := byArn[policy.DeadLetterTargetArn]; ok {
+					target.Name, target.URL = queue.Name, queue.URL
+				}
+				entry.DeadLetterTarget = &target
+			}
+			if detail.Arn != "" {
+				for _, queue := range queues {
+					if queue.RedrivePolicy != nil && queue.RedrivePolicy.DeadLetterTargetArn == detail.Arn {
+						entry.DeadLetterSources = append(entry.DeadLetterSources, DeadLetterSource{
+							Name:            queue.Name,
+							URL:             queue.URL,
+							MaxReceiveCount: queue.RedrivePolicy.MaxReceiveCount,
+						})
+					}
+				}
+			}
+			report.Queues[i] = entry
+		}(i, queueURL)
+	}
+	wg.Wait()
+
+	return report, nil
+}
@@ -0,0 +1,156 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type queueReportPageData struct {
+	Title        string
+	GeneratedAt  string
+	Queues       []queueReportView
+	ViteTags     template.HTML
+	ErrorMessage string
+}
+
+type queueReportView struct {
+	Name              string
+	URL               string
+	EscapedURL        string
+	Arn               string
+	Type              string
+	CreatedAt         string
+	LastModifiedAt    string
+	MessagesAvailable string
+	MessagesInFlight  string
+	MessagesDelayed   string
+	Error             string
+	Attributes        []queueAttributeView
+	Tags              []queueTagView
+	DeadLetterTarget  *queueReportLinkView
+	DeadLetterSources []queueReportLinkView
+	Depth             []queueReportDepthView
+}
+
+// queueReportLinkView is a queue of the dead-letter wiring. EscapedURL is empty for a queue that
+// is not listed by SQS, which is then named by its ARN, and MaxReceiveCount when it is unknown.
+type queueReportLinkView struct {
+	Name            string
+	EscapedURL      string
+	MaxReceiveCount string
+}
+
+type queueReportDepthView struct {
+	At        string
+	Available string
+	InFlight  string
+}
+
+// QueueReportHandler renders a print-friendly report of the queues named by the queue parameters,
+// for change reviews and audits. Browsers can save it as PDF from the print dialog.
+func (h *HandlerImpl) QueueReportHandler(w http.ResponseWriter, r *http.Request) {
+	data := queueReportPageData{
+		Title:    "Queue report",
+		ViteTags: fragments["assets/js/queue_report.ts"].Tags,
+	}
+
+	report, err := h.s.QueueReport(r.Context(), r.URL.Query()["queue"])
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to build queue report", slog.Any("error", err))
+		data.ErrorMessage = "Failed to build the report: " + err.Error()
+		h.renderQueueReport(w, r, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.GeneratedAt = report.GeneratedAt.Format("2006-01-02 15:04:05 MST")
+	for _, entry := range report.Queues {
+		data.Queues = append(data.Queues, newQueueReportView(entry))
+	}
+	h.renderQueueReport(w, r, http.StatusOK, data)
+}
+
+func newQueueReportView(entry QueueReportEntry) queueReportView {
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.Format("2006-01-02 15:04:05 MST")
+	}
+
+	view := queueReportView{
+		Name:              entry.Name,
+		URL:               entry.URL,
+		EscapedURL:        url.QueryEscape(entry.URL),
+		Arn:               entry.Arn,
+		Type:              strings.ToUpper(string(entry.Type)),
+		CreatedAt:         formatTime(entry.CreatedAt),
+		LastModifiedAt:    formatTime(entry.LastModifiedAt),
+		MessagesAvailable: strconv.FormatInt(entry.MessagesAvailable, 10),
+		MessagesInFlight:  strconv.FormatInt(entry.MessagesInFlight, 10),
+		MessagesDelayed:   strconv.FormatInt(entry.MessagesDelayed, 10),
+		Error:             entry.Error,
+	}
+
+	for key, value := range entry.Attributes {
+		view.Attributes = append(view.Attributes, queueAttributeView{Key: key, Value: value})
+	}
+	slices.SortFunc(view.Attributes, func(a, b queueAttributeView) int { return strings.Compare(a.Key, b.Key) })
+	for key, value := range entry.Tags {
+		view.Tags = append(view.Tags, queueTagView{Key: key, Value: value})
+	}
+	slices.SortFunc(view.Tags, func(a, b queueTagView) int { return strings.Compare(a.Key, b.Key) })
+
+	if target := entry.DeadLetterTarget; target != nil {
+		link := newQueueReportLinkView(*target)
+		view.DeadLetterTarget = &link
+	}
+	for _, source := range entry.DeadLetterSources {
+		view.DeadLetterSources = append(view.DeadLetterSources, newQueueReportLinkView(source))
+	}
+	for _, sample := range entry.Depth {
+		view.Depth = append(view.Depth, queueReportDepthView{
+			At:        sample.At.Format("15:04:05 MST"),
+			Available: strconv.FormatInt(sample.Available, 10),
+			InFlight:  strconv.FormatInt(sample.InFlight, 10),
+		})
+	}
+	return view
+}
+
+func newQueueReportLinkView(queue DeadLetterSource) queueReportLinkView {
+	link := queueReportLinkView{Name: queue.Name}
+	if queue.URL != "" {
+		link.EscapedURL = url.QueryEscape(queue.URL)
+	}
+	if queue.MaxReceiveCount > 0 {
+		link.MaxReceiveCount = strconv.Itoa(queue.MaxReceiveCount)
+	}
+	return link
+}
+
+// queueReportURL is the report of queues, or empty when there are none or too many for one report.
+func queueReportURL(queues []QueueSummary) string {
+	if len(queues) == 0 || len(queues) > maxReportQueues {
+		return ""
+	}
+	query := url.Values{}
+	for _, queue := range queues {
+		query.Add("queue", queue.URL)
+	}
+	return "/reports/queues?" + query.Encode()
+}
+
+func (h *HandlerImpl) renderQueueReport(w http.ResponseWriter, r *http.Request, status int, data queueReportPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["queue-report"].Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "failed to render queue report template", slog.Any("error", err))
+		http.Error(w, "template error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerImpl_QueueReportHandler(t *testing.T) {
+	t.Run("renders the selected queues", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		sampledAt := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+		mockService.EXPECT().
+			QueueReport(mock.Anything, []string{"https://sqs.local/1/orders", "https://sqs.local/1/orders-dlq"}).
+			Return(QueueReport{
+				GeneratedAt: sampledAt,
+				Queues: []QueueReportEntry{
+					{
+						QueueDetail: QueueDetail{
+							QueueSummary: QueueSummary{URL: "https://sqs.local/1/orders", Name: "orders", Type: QueueTypeStandard, MessagesDelayed: 2},
+							Attributes:   map[string]string{"VisibilityTimeout": "30", "DelaySeconds": "0"},
+							Tags:         map[string]string{"team": "core"},
+						},
+						DeadLetterTarget: &DeadLetterSource{Name: "orders-dlq", URL: "https://sqs.local/1/orders-dlq", MaxReceiveCount: 5},
+						Depth:            []depthSample{{At: sampledAt, Available: 4, InFlight: 1}},
+					},
+					{
+						QueueDetail:       QueueDetail{QueueSummary: QueueSummary{URL: "https://sqs.local/1/orders-dlq", Name: "orders-dlq"}},
+						DeadLetterSources: []DeadLetterSource{{Name: "orders", URL: "https://sqs.local/1/orders"}},
+					},
+				},
+			}, nil).
+			Once()
+
+		var captured queueReportPageData
+		captureTemplate(t, "queue-report", func(data queueReportPageData) { captured = data })
+		installFragment(t, "assets/js/queue_report.ts", "")
+
+		rr := httptest.NewRecorder()
+		handler.QueueReportHandler(rr, httptest.NewRequest(http.MethodGet, "/reports/queues?queue=https%3A%2F%2Fsqs.local%2F1%2Forders&queue=https%3A%2F%2Fsqs.local%2F1%2Forders-dlq", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "2024-05-01 12:00:00 UTC", captured.GeneratedAt)
+		require.Len(t, captured.Queues, 2)
+
+		orders := captured.Queues[0]
+		assert.Equal(t, "STANDARD", orders.Type)
+		assert.Equal(t, "-", orders.CreatedAt)
+		assert.Equal(t, "2", orders.MessagesDelayed)
+		assert.Equal(t, []queueAttributeView{{Key: "DelaySeconds", Value: "0"}, {Key: "VisibilityTimeout", Value: "30"}}, orders.Attributes)
+		assert.Equal(t, []queueTagView{{Key: "team", Value: "core"}}, orders.Tags)
+		assert.Equal(t, &queueReportLinkView{Name: "orders-dlq", EscapedURL: "https%3A%2F%2Fsqs.local%2F1%2Forders-dlq", MaxReceiveCount: "5"}, orders.DeadLetterTarget)
+		assert.Equal(t, []queueReportDepthView{{At: "12:00:00 UTC", Available: "4", InFlight: "1"}}, orders.Depth)
+
+		assert.Equal(t, []queueReportLinkView{{Name: "orders", EscapedURL: "https%3A%2F%2Fsqs.local%2F1%2Forders"}}, captured.Queues[1].DeadLetterSources)
+	})
+
+	t.Run("explains a rejected selection", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().QueueReport(mock.Anything, []string(nil)).Return(QueueReport{}, errors.New("choose at least one queue")).Once()
+
+		var captured queueReportPageData
+		captureTemplate(t, "queue-report", func(data queueReportPageData) { captured = data })
+		installFragment(t, "assets/js/queue_report.ts", "")
+
+		rr := httptest.NewRecorder()
+		handler.QueueReportHandler(rr, httptest.NewRequest(http.MethodGet, "/reports/queues", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "Failed to build the report: choose at least one queue", captured.ErrorMessage)
+	})
+}
+
+func TestQueueReportURL(t *testing.T) {
+	assert.Empty(t, queueReportURL(nil))
+	assert.Equal(t, "/reports/queues?queue=https%3A%2F%2Fsqs.local%2F1%2Fa&queue=https%3A%2F%2Fsqs.local%2F1%2Fb",
+		queueReportURL([]QueueSummary{{URL: "https://sqs.local/1/a"}, {URL: "https://sqs.local/1/b"}}))
+	assert.Empty(t, queueReportURL(make([]QueueSummary, maxReportQueues+1)))
+}
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_QueueReport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	const (
+		ordersURL = "https://sqs.local/000000000000/orders"
+		dlqURL    = "https://sqs.local/000000000000/orders-dlq"
+		retryURL  = "https://sqs.local/000000000000/retry"
+	)
+	orders := QueueSummary{
+		URL: ordersURL, Name: "orders", Arn: "arn:aws:sqs:us-east-1:000000000000:orders", MessagesAvailable: 4,
+		RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", MaxReceiveCount: 5},
+	}
+	dlq := QueueSummary{URL: dlqURL, Name: "orders-dlq", Arn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq"}
+	retry := QueueSummary{
+		URL: retryURL, Name: "retry", Arn: "arn:aws:sqs:us-east-1:000000000000:retry",
+		RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:111111111111:elsewhere", MaxReceiveCount: 2},
+	}
+
+	t.Run("collects attributes, wiring and depth", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, depths: newDepthHistory(), clock: func() time.Time { return now }}
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{orders, dlq, retry}, nil).Once()
+		repo.EXPECT().GetQueueDetail(mock.Anything, ordersURL).Return(QueueDetail{QueueSummary: orders, Tags: map[string]string{"team": "core"}}, nil).Once()
+		repo.EXPECT().GetQueueDetail(mock.Anything, dlqURL).Return(QueueDetail{QueueSummary: dlq}, nil).Once()
+		repo.EXPECT().GetQueueDetail(mock.Anything, retryURL).Return(QueueDetail{}, errors.New("denied")).Once()
+
+		report, err := service.QueueReport(ctx, []string{ordersURL, " " + dlqURL, ordersURL, retryURL})
+		require.NoError(t, err)
+		assert.Equal(t, now, report.GeneratedAt)
+		require.Len(t, report.Queues, 3)
+
+		assert.Equal(t, map[string]string{"team": "core"}, report.Queues[0].Tags)
+		assert.Equal(t, &DeadLetterSource{Name: "orders-dlq", URL: dlqURL, MaxReceiveCount: 5}, report.Queues[0].DeadLetterTarget)
+		assert.Equal(t, []depthSample{{At: now, Available: 4}}, report.Queues[0].Depth)
+
+		assert.Nil(t, report.Queues[1].DeadLetterTarget)
+		assert.Equal(t, []DeadLetterSource{{Name: "orders", URL: ordersURL, MaxReceiveCount: 5}}, report.Queues[1].DeadLetterSources)
+
+		assert.Equal(t, "retry", report.Queues[2].Name)
+		assert.Equal(t, "denied", report.Queues[2].Error)
+	})
+
+	t.Run("names a target outside the listed queues by its ARN", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, clock: func() time.Time { return now }}
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{retry}, nil).Once()
+		repo.EXPECT().GetQueueDetail(mock.Anything, retryURL).Return(QueueDetail{QueueSummary: retry}, nil).Once()
+
+		report, err := service.QueueReport(ctx, []string{retryURL})
+		require.NoError(t, err)
+		assert.Equal(t, &DeadLetterSource{Name: "arn:aws:sqs:us-east-1:111111111111:elsewhere", MaxReceiveCount: 2}, report.Queues[0].DeadLetterTarget)
+	})
+
+	t.Run("validates the selection", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.QueueReport(ctx, []string{" "})
+		assert.EqualError(t, err, "choose at least one queue")
+
+		many := make([]string, maxReportQueues+1)
+		for i := range many {
+			many[i] = ordersURL + string(rune('a'+i%26)) + string(rune('a'+i/26))
+		}
+		_, err = service.QueueReport(ctx, many)
+		assert.EqualError(t, err, "a report covers at most 50 queues")
+	})
+}
@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// maxStatsQueues bounds how many queues a single stats request may ask for.
+	maxStatsQueues = 100
+	// statsConcurrency bounds the GetQueueAttributes calls in flight for one request.
+	statsConcurrency = 10
+)
+
+// QueueStats holds the approximate message counts of a queue.
+type QueueStats struct {
+	MessagesAvailable int64
+	MessagesInFlight  int64
+	MessagesDelayed   int64
+}
+
+// QueueStatsResult is the outcome of reading one queue's counts. Error is set instead of Stats
+// when that queue could not be read.
+type QueueStatsResult struct {
+	QueueURL string
+	Stats    QueueStats
+	Error    string
+}
+
+// QueueStats reads the message counts of several queues concurrently, in the order given.
+// Blank and repeated URLs are dropped. A failure on one queue is reported in its result
+// without affecting the others.
+func (s *SqsServiceImpl) QueueStats(ctx context.Context, queueURLs []string) ([]QueueStatsResult, error) {
+	unique := make([]string, 0, len(queueURLs))
+	seen := make(map[string]struct{}, len(queueURLs))
+	for _, raw := range queueURLs {
+		queueURL := strings.TrimSpace(raw)
+		if queueURL == "" {
+			continue
+		}
+		if _, ok := seen[queueURL]; ok {
+			continue
+		}
+		seen[queueURL] = struct{}{}
+		unique = append(unique, queueURL)
+	}
+
+	if len(unique) == 0 {
+		return nil, errors.New("at least one queue url is required")
+	}
+	if len(unique) > maxStatsQueues {
+		return nil, errors.Newf("at most %d queues can be requested at once", maxStatsQueues)
+	}
+
+	results := make([]QueueStatsResult, len(unique))
+	slots := make(chan struct{}, statsConcurrency)
+	var wg sync.WaitGroup
+	for i, queueURL := range unique {
+		wg.Add(1)
+		go func(i int, queueURL string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			stats, err := s.repo.GetQueueStats(ctx, queueURL)
+			results[i] = QueueStatsResult{QueueURL: queueURL, Stats: stats}
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+		}(i, queueURL)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// liveQueueAttributes are the attributes LiveQueueStats reads: the message counts and the
+// settings shown next to them on the queue page.
+var liveQueueAttributes = []string{
+	"ApproximateNumberOfMessages",
+	"ApproximateNumberOfMessagesNotVisible",
+	"ApproximateNumberOfMessagesDelayed",
+	"DelaySeconds",
+	"LastModifiedTimestamp",
+	"MaximumMessageSize",
+	"MessageRetentionPeriod",
+	"ReceiveMessageWaitTimeSeconds",
+	"VisibilityTimeout",
+}
+
+// LiveQueueStats is a snapshot of the message counts and key settings of one queue, read
+// cheaply enough for a page to poll.
+type LiveQueueStats struct {
+	QueueStats
+	VisibilityTimeout             int64
+	DelaySeconds                  int64
+	MessageRetentionPeriod        int64
+	ReceiveMessageWaitTimeSeconds int64
+	MaximumMessageSize            int64
+	LastModifiedAt                time.Time
+	ReadAt                        time.Time
+}
+
+// LiveQueueStats reads the counts and key settings of a queue in one GetQueueAttributes call.
+func (s *SqsServiceImpl) LiveQueueStats(ctx context.Context, queueURL string) (LiveQueueStats, error) {
+	if strings.TrimSpace(queueURL) == "" {
+		return LiveQueueStats{}, errors.New("queue url is required")
+	}
+
+	attributes, err := s.repo.GetQueueAttributes(ctx, queueURL, liveQueueAttributes)
+	if err != nil {
+		return LiveQueueStats{}, err
+	}
+	return LiveQueueStats{
+		QueueStats: QueueStats{
+			MessagesAvailable: parseInt64(attributes["ApproximateNumberOfMessages"]),
+			MessagesInFlight:  parseInt64(attributes["ApproximateNumberOfMessagesNotVisible"]),
+			MessagesDelayed:   parseInt64(attributes["ApproximateNumberOfMessagesDelayed"]),
+		},
+		VisibilityTimeout:             parseInt64(attributes["VisibilityTimeout"]),
+		DelaySeconds:                  parseInt64(attributes["DelaySeconds"]),
+		MessageRetentionPeriod:        parseInt64(attributes["MessageRetentionPeriod"]),
+		ReceiveMessageWaitTimeSeconds: parseInt64(attributes["ReceiveMessageWaitTimeSeconds"]),
+		MaximumMessageSize:            parseInt64(attributes["MaximumMessageSize"]),
+		LastModifiedAt:                parseUnixTime(attributes["LastModifiedTimestamp"]),
+		ReadAt:                        s.now(),
+	}, nil
+}
@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type queueStatsRequest struct {
+	QueueURLs []string `json:"queueUrls"`
+}
+
+type queueStatsItem struct {
+	QueueURL          string `json:"queueUrl"`
+	QueueName         string `json:"queueName"`
+	MessagesAvailable int64  `json:"messagesAvailable"`
+	MessagesInFlight  int64  `json:"messagesInFlight"`
+	MessagesDelayed   int64  `json:"messagesDelayed"`
+	Error             string `json:"error,omitempty"`
+}
+
+type queueStatsResponse struct {
+	Queues []queueStatsItem `json:"queues"`
+}
+
+type liveQueueStatsResponse struct {
+	QueueURL                      string `json:"queueUrl"`
+	QueueName                     string `json:"queueName"`
+	MessagesAvailable             int64  `json:"messagesAvailable"`
+	MessagesInFlight              int64  `json:"messagesInFlight"`
+	MessagesDelayed               int64  `json:"messagesDelayed"`
+	VisibilityTimeout             int64  `json:"visibilityTimeout"`
+	DelaySeconds                  int64  `json:"delaySeconds"`
+	MessageRetentionPeriod        int64  `json:"messageRetentionPeriod"`
+	ReceiveMessageWaitTimeSeconds int64  `json:"receiveMessageWaitTimeSeconds"`
+	MaximumMessageSize            int64  `json:"maximumMessageSize"`
+	LastModifiedAt                string `json:"lastModifiedAt,omitempty"`
+	ReadAt                        string `json:"readAt"`
+}
+
+// QueueStatsAPI returns the message counts of the queues listed in the request body in one call.
+func (h *HandlerImpl) QueueStatsAPI(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	var payload queueStatsRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	results, err := h.s.QueueStats(r.Context(), payload.QueueURLs)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load queue stats", slog.Any("error", err))
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response := queueStatsResponse{Queues: make([]queueStatsItem, 0, len(results))}
+	for _, result := range results {
+		response.Queues = append(response.Queues, queueStatsItem{
+			QueueURL:          result.QueueURL,
+			QueueName:         extractQueueName(result.QueueURL),
+			MessagesAvailable: result.Stats.MessagesAvailable,
+			MessagesInFlight:  result.Stats.MessagesInFlight,
+			MessagesDelayed:   result.Stats.MessagesDelayed,
+			Error:             result.Error,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// QueueLiveStatsAPI returns the current message counts and key settings of one queue, which the
+// queue page polls to keep its counts up to date.
+func (h *HandlerImpl) QueueLiveStatsAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	stats, err := h.s.LiveQueueStats(r.Context(), queueURL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load live queue stats", slog.String("queue_url", queueURL), slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	response := liveQueueStatsResponse{
+		QueueURL:                      queueURL,
+		QueueName:                     extractQueueName(queueURL),
+		MessagesAvailable:             stats.MessagesAvailable,
+		MessagesInFlight:              stats.MessagesInFlight,
+		MessagesDelayed:               stats.MessagesDelayed,
+		VisibilityTimeout:             stats.VisibilityTimeout,
+		DelaySeconds:                  stats.DelaySeconds,
+		MessageRetentionPeriod:        stats.MessageRetentionPeriod,
+		ReceiveMessageWaitTimeSeconds: stats.ReceiveMessageWaitTimeSeconds,
+		MaximumMessageSize:            stats.MaximumMessageSize,
+		ReadAt:                        stats.ReadAt.UTC().Format(time.RFC3339),
+	}
+	if !stats.LastModifiedAt.IsZero() {
+		response.LastModifiedAt = stats.LastModifiedAt.UTC().Format(time.RFC3339)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
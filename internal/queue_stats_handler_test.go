@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_QueueStatsAPI(t *testing.T) {
+	t.Run("returns counts per queue", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/queues/stats", strings.NewReader(`{"queueUrls":["https://sqs.local/1/orders","https://sqs.local/1/billing"]}`))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			QueueStats(mock.Anything, []string{"https://sqs.local/1/orders", "https://sqs.local/1/billing"}).
+			Return([]QueueStatsResult{
+				{QueueURL: "https://sqs.local/1/orders", Stats: QueueStats{MessagesAvailable: 3, MessagesInFlight: 1, MessagesDelayed: 2}},
+				{QueueURL: "https://sqs.local/1/billing", Error: "queue \"billing\" is not accessible: queue access denied by policy"},
+			}, nil).
+			Once()
+
+		handler.QueueStatsAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"queues":[
+			{"queueUrl":"https://sqs.local/1/orders","queueName":"orders","messagesAvailable":3,"messagesInFlight":1,"messagesDelayed":2},
+			{"queueUrl":"https://sqs.local/1/billing","queueName":"billing","messagesAvailable":0,"messagesInFlight":0,"messagesDelayed":0,"error":"queue \"billing\" is not accessible: queue access denied by policy"}
+		]}`, rr.Body.String())
+	})
+
+	t.Run("rejects an empty body", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/queues/stats", strings.NewReader(""))
+		rr := httptest.NewRecorder()
+
+		handler.QueueStatsAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"request body is required"}`, rr.Body.String())
+	})
+}
+
+func TestHandlerImpl_QueueLiveStatsAPI(t *testing.T) {
+	queueURL := "https://sqs.local/1/orders"
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/queues/"+url.QueryEscape(queueURL)+"/stats", nil)
+		req.SetPathValue("url", url.QueryEscape(queueURL))
+		return req
+	}
+
+	t.Run("returns counts and settings", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			LiveQueueStats(mock.Anything, queueURL).
+			Return(LiveQueueStats{
+				QueueStats:                    QueueStats{MessagesAvailable: 7, MessagesInFlight: 2, MessagesDelayed: 1},
+				VisibilityTimeout:             30,
+				DelaySeconds:                  5,
+				MessageRetentionPeriod:        345600,
+				ReceiveMessageWaitTimeSeconds: 20,
+				MaximumMessageSize:            262144,
+				LastModifiedAt:                time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				ReadAt:                        time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+			}, nil).
+			Once()
+
+		handler.QueueLiveStatsAPI(rr, newRequest())
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{
+			"queueUrl":"https://sqs.local/1/orders","queueName":"orders",
+			"messagesAvailable":7,"messagesInFlight":2,"messagesDelayed":1,
+			"visibilityTimeout":30,"delaySeconds":5,"messageRetentionPeriod":345600,
+			"receiveMessageWaitTimeSeconds":20,"maximumMessageSize":262144,
+			"lastModifiedAt":"2026-01-01T00:00:00Z","readAt":"2026-03-01T12:00:00Z"
+		}`, rr.Body.String())
+	})
+
+	t.Run("reports a denied queue as forbidden", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			LiveQueueStats(mock.Anything, queueURL).
+			Return(LiveQueueStats{}, ErrQueueAccessDenied).
+			Once()
+
+		handler.QueueLiveStatsAPI(rr, newRequest())
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_QueueStats(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keeps request order and reports failures per queue", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().GetQueueStats(mock.Anything, "https://sqs.local/orders").
+			Return(QueueStats{MessagesAvailable: 3, MessagesInFlight: 1}, nil).Once()
+		repo.EXPECT().GetQueueStats(mock.Anything, "https://sqs.local/billing").
+			Return(QueueStats{}, errors.New("boom")).Once()
+		repo.EXPECT().GetQueueStats(mock.Anything, "https://sqs.local/audit").
+			Return(QueueStats{MessagesDelayed: 2}, nil).Once()
+
+		results, err := service.QueueStats(ctx, []string{
+			"https://sqs.local/orders",
+			" https://sqs.local/billing ",
+			"",
+			"https://sqs.local/audit",
+			"https://sqs.local/orders",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []QueueStatsResult{
+			{QueueURL: "https://sqs.local/orders", Stats: QueueStats{MessagesAvailable: 3, MessagesInFlight: 1}},
+			{QueueURL: "https://sqs.local/billing", Error: "boom"},
+			{QueueURL: "https://sqs.local/audit", Stats: QueueStats{MessagesDelayed: 2}},
+		}, results)
+	})
+
+	t.Run("requires at least one queue", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.QueueStats(ctx, []string{" "})
+		assert.EqualError(t, err, "at least one queue url is required")
+	})
+
+	t.Run("limits the number of queues", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		queueURLs := make([]string, maxStatsQueues+1)
+		for i := range queueURLs {
+			queueURLs[i] = fmt.Sprintf("https://sqs.local/queue-%d", i)
+		}
+
+		_, err := service.QueueStats(ctx, queueURLs)
+		assert.EqualError(t, err, "at most 100 queues can be requested at once")
+	})
+}
+
+func TestSqsServiceImpl_LiveQueueStats(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("reads counts and settings in one call", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, clock: func() time.Time { return now }}
+
+		repo.EXPECT().GetQueueAttributes(mock.Anything, queueURL, liveQueueAttributes).
+			Return(map[string]string{
+				"ApproximateNumberOfMessages":           "7",
+				"ApproximateNumberOfMessagesNotVisible": "2",
+				"ApproximateNumberOfMessagesDelayed":    "1",
+				"DelaySeconds":                          "5",
+				"LastModifiedTimestamp":                 "1767225600",
+				"MaximumMessageSize":                    "262144",
+				"MessageRetentionPeriod":                "345600",
+				"ReceiveMessageWaitTimeSeconds":         "20",
+				"VisibilityTimeout":                     "30",
+			}, nil).Once()
+
+		stats, err := service.LiveQueueStats(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, LiveQueueStats{
+			QueueStats:                    QueueStats{MessagesAvailable: 7, MessagesInFlight: 2, MessagesDelayed: 1},
+			VisibilityTimeout:             30,
+			DelaySeconds:                  5,
+			MessageRetentionPeriod:        345600,
+			ReceiveMessageWaitTimeSeconds: 20,
+			MaximumMessageSize:            262144,
+			LastModifiedAt:                time.Unix(1767225600, 0).UTC(),
+			ReadAt:                        now,
+		}, stats)
+	})
+
+	t.Run("requires a queue url", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.LiveQueueStats(ctx, " ")
+		assert.EqualError(t, err, "queue url is required")
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().GetQueueAttributes(mock.Anything, queueURL, liveQueueAttributes).
+			Return(nil, errors.New("boom")).Once()
+
+		_, err := service.LiveQueueStats(ctx, queueURL)
+		assert.EqualError(t, err, "boom")
+	})
+}
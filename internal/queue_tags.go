@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrTagsUnsupported is returned when the endpoint does not implement queue tags.
+var ErrTagsUnsupported = errors.New("this endpoint does not support queue tags")
+
+// TagQueue sets tags on a queue. Keys the queue already has get the new value, and the queue may
+// end up with at most 50 tags.
+func (s *SqsServiceImpl) TagQueue(ctx context.Context, queueURL string, tags map[string]string) error {
+	if strings.TrimSpace(queueURL) == "" {
+		return errors.New("queue url is required")
+	}
+	if len(tags) == 0 {
+		return errors.New("at least one tag is required")
+	}
+	for key, value := range tags {
+		if err := validateQueueTag(key, value); err != nil {
+			return err
+		}
+	}
+	if !s.EndpointCapabilities(ctx).Tags {
+		return ErrTagsUnsupported
+	}
+
+	current, err := s.repo.ListQueueTags(ctx, queueURL)
+	if err != nil {
+		return err
+	}
+	count := len(current)
+	for key := range tags {
+		if _, ok := current[key]; !ok {
+			count++
+		}
+	}
+	if count > maxQueueTags {
+		return errors.Newf("a queue can have at most %d tags", maxQueueTags)
+	}
+
+	return s.repo.TagQueue(ctx, queueURL, tags)
+}
+
+// UntagQueue removes the tags with the given keys from a queue.
+func (s *SqsServiceImpl) UntagQueue(ctx context.Context, queueURL string, keys []string) error {
+	if strings.TrimSpace(queueURL) == "" {
+		return errors.New("queue url is required")
+	}
+	if len(keys) == 0 {
+		return errors.New("at least one tag key is required")
+	}
+	if !s.EndpointCapabilities(ctx).Tags {
+		return ErrTagsUnsupported
+	}
+
+	return s.repo.UntagQueue(ctx, queueURL, keys)
+}
+
+// validateQueueTag applies the SQS tag rules: a key of 1 to 128 and a value of up to 256 letters,
+// digits, spaces and _ . : / = + - @, with the aws: key prefix reserved for AWS.
+func validateQueueTag(key, value string) error {
+	if key == "" {
+		return errors.New("tag key is required")
+	}
+	if utf8.RuneCountInString(key) > maxQueueTagKeyLength {
+		return errors.Newf("tag key must be at most %d characters", maxQueueTagKeyLength)
+	}
+	if utf8.RuneCountInString(value) > maxQueueTagValueLength {
+		return errors.Newf("tag value must be at most %d characters", maxQueueTagValueLength)
+	}
+	if strings.HasPrefix(strings.ToLower(key), "aws:") {
+		return errors.New("tag keys starting with aws: are reserved")
+	}
+	if !validTagText(key) {
+		return errors.Newf("tag key %q may only contain letters, digits, spaces and _ . : / = + - @", key)
+	}
+	if !validTagText(value) {
+		return errors.Newf("tag value of %q may only contain letters, digits, spaces and _ . : / = + - @", key)
+	}
+	return nil
+}
+
+func validTagText(text string) bool {
+	for _, r := range text {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r) && !strings.ContainsRune("_.:/=+-@", r) {
+			return false
+		}
+	}
+	return true
+}
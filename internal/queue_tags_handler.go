@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PostQueueTagHandler handles the tag forms on the queue page. It adds a tag, changes the value of
+// one, or renames one when previous_key names a different key.
+func (h *HandlerImpl) PostQueueTagHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form submission", http.StatusBadRequest)
+		return
+	}
+
+	key := strings.TrimSpace(r.PostForm.Get("tag_key"))
+	value := r.PostForm.Get("tag_value")
+	previousKey := r.PostForm.Get("previous_key")
+
+	if err := h.s.TagQueue(r.Context(), queueURL, map[string]string{key: value}); err != nil {
+		slog.ErrorContext(r.Context(), "failed to tag queue", slog.String("queue_url", queueURL), slog.Any("error", err))
+		h.renderQueueError(w, r, queueURL, serviceErrorStatus(err), err)
+		return
+	}
+	// A rename tags the new key first, so the queue never loses the value if removing the old key fails.
+	if previousKey != "" && previousKey != key {
+		if err := h.s.UntagQueue(r.Context(), queueURL, []string{previousKey}); err != nil {
+			slog.ErrorContext(r.Context(), "failed to untag queue", slog.String("queue_url", queueURL), slog.Any("error", err))
+			h.renderQueueError(w, r, queueURL, serviceErrorStatus(err), fmt.Errorf("tag %q was saved but %q could not be removed: %w", key, previousKey, err))
+			return
+		}
+	}
+
+	redirectURL := fmt.Sprintf("/queues/%s?tagged=%s", url.QueryEscape(queueURL), url.QueryEscape(key))
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+// DeleteQueueTagHandler handles POST requests to remove a tag from a queue.
+func (h *HandlerImpl) DeleteQueueTagHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form submission", http.StatusBadRequest)
+		return
+	}
+
+	key := r.PostForm.Get("tag_key")
+	if err := h.s.UntagQueue(r.Context(), queueURL, []string{key}); err != nil {
+		slog.ErrorContext(r.Context(), "failed to untag queue", slog.String("queue_url", queueURL), slog.Any("error", err))
+		h.renderQueueError(w, r, queueURL, serviceErrorStatus(err), err)
+		return
+	}
+
+	redirectURL := fmt.Sprintf("/queues/%s?untagged=%s", url.QueryEscape(queueURL), url.QueryEscape(key))
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+func (h *HandlerImpl) renderQueueError(w http.ResponseWriter, r *http.Request, queueURL string, status int, err error) {
+	data, loadErr := h.newQueuePageData(r, queueURL)
+	if loadErr != nil {
+		writeServiceError(w, loadErr, "failed to load queue detail", http.StatusInternalServerError)
+		return
+	}
+	data.ErrorMessage = err.Error()
+	h.renderQueue(w, r, status, data)
+}
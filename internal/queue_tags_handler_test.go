@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_QueueTagHandlers(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(path string, form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("adds a tag", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().TagQueue(mock.Anything, queueURL, map[string]string{"team": "payments"}).Return(nil).Once()
+
+		handler.PostQueueTagHandler(rr, newRequest("/tags", url.Values{"tag_key": {" team "}, "tag_value": {"payments"}}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues/"+escaped+"?tagged=team", rr.Header().Get("Location"))
+	})
+
+	t.Run("renames a tag by removing the old key after saving the new one", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		tag := mockService.EXPECT().TagQueue(mock.Anything, queueURL, map[string]string{"owner": "payments"}).Return(nil).Once()
+		mockService.EXPECT().UntagQueue(mock.Anything, queueURL, []string{"team"}).Return(nil).Once().NotBefore(tag)
+
+		handler.PostQueueTagHandler(rr, newRequest("/tags", url.Values{
+			"tag_key":      {"owner"},
+			"tag_value":    {"payments"},
+			"previous_key": {"team"},
+		}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues/"+escaped+"?tagged=owner", rr.Header().Get("Location"))
+	})
+
+	t.Run("shows the queue page with the error when a tag is rejected", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queuePageData
+		captureQueueTemplate(t, &captured)
+		installQueueFragment(t, "")
+		mockService.EXPECT().TagQueue(mock.Anything, queueURL, map[string]string{"aws:owner": "x"}).
+			Return(errors.New("tag keys starting with aws: are reserved")).Once()
+		mockService.EXPECT().QueueDetail(mock.Anything, queueURL).
+			Return(QueueDetail{QueueSummary: QueueSummary{URL: queueURL, Name: "orders", Type: QueueTypeStandard}}, nil).Once()
+		mockService.EXPECT().EndpointCapabilities(mock.Anything).Return(EndpointCapabilities{Tags: true}).Once()
+		mockService.EXPECT().DeadLetterCandidates(mock.Anything, queueURL).Return(nil, nil).Once()
+		mockService.EXPECT().DeadLetterSourceQueues(mock.Anything, queueURL).Return(nil, nil).Once()
+
+		handler.PostQueueTagHandler(rr, newRequest("/tags", url.Values{"tag_key": {"aws:owner"}, "tag_value": {"x"}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "tag keys starting with aws: are reserved", captured.ErrorMessage)
+		assert.Equal(t, "orders", captured.Queue.Name)
+	})
+
+	t.Run("removes a tag", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().UntagQueue(mock.Anything, queueURL, []string{"team"}).Return(nil).Once()
+
+		handler.DeleteQueueTagHandler(rr, newRequest("/tags/delete", url.Values{"tag_key": {"team"}}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues/"+escaped+"?untagged=team", rr.Header().Get("Location"))
+	})
+}
@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_TagQueue(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	newService := func(t *testing.T, tagsSupported bool) (*SqsServiceImpl, *MockSqsRepository) {
+		repo := NewMockSqsRepository(t)
+		return &SqsServiceImpl{
+			repo: repo,
+			capabilities: &capabilityCache{
+				caps:       EndpointCapabilities{Kind: EndpointSQSCompatible, Tags: tagsSupported},
+				conclusive: true,
+			},
+		}, repo
+	}
+
+	t.Run("tags the queue", func(t *testing.T) {
+		service, repo := newService(t, true)
+		repo.EXPECT().ListQueueTags(mock.Anything, queueURL).Return(map[string]string{"env": "dev"}, nil).Once()
+		repo.EXPECT().TagQueue(mock.Anything, queueURL, map[string]string{"env": "prod"}).Return(nil).Once()
+
+		require.NoError(t, service.TagQueue(ctx, queueURL, map[string]string{"env": "prod"}))
+	})
+
+	t.Run("rejects tags SQS would refuse", func(t *testing.T) {
+		service, _ := newService(t, true)
+		for _, tc := range []struct {
+			key, value, message string
+		}{
+			{"", "x", "tag key is required"},
+			{"aws:owner", "x", "tag keys starting with aws: are reserved"},
+			{"team#1", "x", `tag key "team#1" may only contain letters, digits, spaces and _ . : / = + - @`},
+			{"team", "a;b", `tag value of "team" may only contain letters, digits, spaces and _ . : / = + - @`},
+			{strings.Repeat("k", 129), "x", "tag key must be at most 128 characters"},
+			{"team", strings.Repeat("v", 257), "tag value must be at most 256 characters"},
+		} {
+			err := service.TagQueue(ctx, queueURL, map[string]string{tc.key: tc.value})
+			require.Error(t, err)
+			assert.Equal(t, tc.message, err.Error())
+		}
+	})
+
+	t.Run("keeps the queue within 50 tags", func(t *testing.T) {
+		service, repo := newService(t, true)
+		current := make(map[string]string, maxQueueTags)
+		for i := range maxQueueTags {
+			current[fmt.Sprintf("tag-%d", i)] = "x"
+		}
+		repo.EXPECT().ListQueueTags(mock.Anything, queueURL).Return(current, nil).Twice()
+		repo.EXPECT().TagQueue(mock.Anything, queueURL, map[string]string{"tag-0": "y"}).Return(nil).Once()
+
+		err := service.TagQueue(ctx, queueURL, map[string]string{"extra": "x"})
+		require.Error(t, err)
+		assert.Equal(t, "a queue can have at most 50 tags", err.Error())
+
+		require.NoError(t, service.TagQueue(ctx, queueURL, map[string]string{"tag-0": "y"}), "changing an existing tag does not count")
+	})
+
+	t.Run("reports endpoints without tag support", func(t *testing.T) {
+		service, _ := newService(t, false)
+
+		assert.ErrorIs(t, service.TagQueue(ctx, queueURL, map[string]string{"env": "prod"}), ErrTagsUnsupported)
+		assert.ErrorIs(t, service.UntagQueue(ctx, queueURL, []string{"env"}), ErrTagsUnsupported)
+	})
+}
+
+func TestSqsServiceImpl_UntagQueue(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{
+		repo:         repo,
+		capabilities: &capabilityCache{caps: EndpointCapabilities{Kind: EndpointAWS, Tags: true}, conclusive: true},
+	}
+
+	repo.EXPECT().UntagQueue(mock.Anything, "https://sqs.local/orders", []string{"env"}).Return(nil).Once()
+
+	require.NoError(t, service.UntagQueue(ctx, "https://sqs.local/orders", []string{"env"}))
+
+	err := service.UntagQueue(ctx, "https://sqs.local/orders", nil)
+	require.Error(t, err)
+	assert.Equal(t, "at least one tag key is required", err.Error())
+}
@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrTrashedQueueNotFound is returned when a trash entry ID does not exist.
+var ErrTrashedQueueNotFound = errors.New("deleted queue not found")
+
+// ErrQueueDeletedRecently is returned when SQS refuses to reuse the name of a queue deleted less
+// than a minute ago.
+var ErrQueueDeletedRecently = errors.New("a queue with this name was deleted less than 60 seconds ago; try again shortly")
+
+// maxTrashedQueues bounds the trash; the oldest entries are dropped first.
+const maxTrashedQueues = 50
+
+// restorableQueueAttributes are the attributes CreateQueue accepts. Counters, timestamps and the
+// ARN are read-only and would make CreateQueue fail, so they are not kept.
+var restorableQueueAttributes = []string{
+	"ContentBasedDeduplication",
+	"DeduplicationScope",
+	"DelaySeconds",
+	"FifoQueue",
+	"FifoThroughputLimit",
+	"KmsDataKeyReusePeriodSeconds",
+	"KmsMasterKeyId",
+	"MaximumMessageSize",
+	"MessageRetentionPeriod",
+	"Policy",
+	"ReceiveMessageWaitTimeSeconds",
+	"RedriveAllowPolicy",
+	"RedrivePolicy",
+	"SqsManagedSseEnabled",
+	"VisibilityTimeout",
+}
+
+// TrashedQueue is the configuration of a deleted queue, kept so the queue can be recreated.
+// Messages are not kept; a restored queue starts empty.
+type TrashedQueue struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	URL        string            `json:"url"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	DeletedAt  time.Time         `json:"deletedAt"`
+}
+
+// TrashedQueues returns the deleted queues that can still be restored, newest first.
+func (s *SqsServiceImpl) TrashedQueues(_ context.Context) ([]TrashedQueue, error) {
+	if s.store == nil {
+		return []TrashedQueue{}, nil
+	}
+
+	trashed, err := s.store.TrashedQueues()
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(trashed, func(a, b TrashedQueue) int {
+		return b.DeletedAt.Compare(a.DeletedAt)
+	})
+	return trashed, nil
+}
+
+// RestoreQueue recreates a deleted queue with its saved attributes and tags, removes it from the
+// trash and returns the new queue URL.
+func (s *SqsServiceImpl) RestoreQueue(ctx context.Context, id string) (string, error) {
+	if s.store == nil {
+		return "", ErrTrashedQueueNotFound
+	}
+
+	trashed, ok, err := s.store.TrashedQueue(id)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrTrashedQueueNotFound
+	}
+
+	queueURL, err := s.repo.CreateQueue(ctx, CreateQueueRepositoryInput{
+		Name:       trashed.Name,
+		Attributes: trashed.Attributes,
+		Tags:       trashed.Tags,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.store.DeleteTrashedQueue(id); err != nil {
+		slog.WarnContext(ctx, "failed to remove restored queue from trash", slog.String("trash_id", id), slog.Any("error", err))
+	}
+	return queueURL, nil
+}
+
+// DiscardTrashedQueue forgets a deleted queue for good.
+func (s *SqsServiceImpl) DiscardTrashedQueue(_ context.Context, id string) error {
+	if s.store == nil {
+		return ErrTrashedQueueNotFound
+	}
+	return s.store.DeleteTrashedQueue(id)
+}
+
+// trashQueue saves the configuration of the queue at queueURL before it is deleted.
+func (s *SqsServiceImpl) trashQueue(ctx context.Context, queueURL string) (TrashedQueue, error) {
+	detail, err := s.repo.GetQueueDetail(ctx, queueURL)
+	if err != nil {
+		return TrashedQueue{}, errors.Wrap(err, "failed to save queue configuration before deleting")
+	}
+
+	id, err := newRandomID()
+	if err != nil {
+		return TrashedQueue{}, err
+	}
+
+	attributes := make(map[string]string)
+	for _, name := range restorableQueueAttributes {
+		if value, ok := detail.Attributes[name]; ok && strings.TrimSpace(value) != "" {
+			attributes[name] = value
+		}
+	}
+
+	trashed := TrashedQueue{
+		ID:         id,
+		Name:       extractQueueName(queueURL),
+		URL:        queueURL,
+		Attributes: attributes,
+		Tags:       maps.Clone(detail.Tags),
+		DeletedAt:  s.now(),
+	}
+	if err := s.store.SaveTrashedQueue(trashed); err != nil {
+		return TrashedQueue{}, errors.Wrap(err, "failed to save queue configuration before deleting")
+	}
+
+	s.pruneTrash()
+	return trashed, nil
+}
+
+// pruneTrash drops the oldest entries once the trash holds more than maxTrashedQueues.
+func (s *SqsServiceImpl) pruneTrash() {
+	trashed, err := s.store.TrashedQueues()
+	if err != nil || len(trashed) <= maxTrashedQueues {
+		return
+	}
+
+	slices.SortFunc(trashed, func(a, b TrashedQueue) int {
+		return a.DeletedAt.Compare(b.DeletedAt)
+	})
+	for _, old := range trashed[:len(trashed)-maxTrashedQueues] {
+		if err := s.store.DeleteTrashedQueue(old.ID); err != nil {
+			slog.Warn("failed to prune trash", slog.String("trash_id", old.ID), slog.Any("error", err))
+		}
+	}
+}
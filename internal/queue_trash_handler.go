@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+)
+
+type trashPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	Flash        *pageFlash
+	ErrorMessage string
+	Queues       []trashedQueueView
+}
+
+type trashedQueueView struct {
+	ID         string
+	Name       string
+	Type       string
+	DeletedAt  string
+	Attributes []queueAttributeView
+	Tags       []queueTagView
+}
+
+// TrashHandler lists deleted queues whose configuration can still be restored.
+func (h *HandlerImpl) TrashHandler(w http.ResponseWriter, r *http.Request) {
+	var flash *pageFlash
+	if r.URL.Query().Get("done") == "discarded" {
+		flash = &pageFlash{Message: "Deleted queue was removed from the trash.", Kind: "success"}
+	}
+
+	h.renderTrash(w, r, trashPageData{Flash: flash})
+}
+
+// RestoreQueueHandler recreates a deleted queue from the trash.
+func (h *HandlerImpl) RestoreQueueHandler(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	queueURL, err := h.s.RestoreQueue(r.Context(), id)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to restore queue", slog.String("trash_id", id), slog.Any("error", err))
+		switch {
+		case errors.Is(err, ErrTrashedQueueNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case errors.Is(err, ErrQueueDeletedRecently):
+			w.WriteHeader(http.StatusConflict)
+			h.renderTrash(w, r, trashPageData{ErrorMessage: "Failed to restore queue: " + err.Error()})
+		default:
+			writeServiceError(w, err, "failed to restore queue", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	redirectURL := fmt.Sprintf("/queues?restored=%s", url.QueryEscape(extractQueueName(queueURL)))
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+// DiscardTrashedQueueHandler removes a deleted queue from the trash without restoring it.
+func (h *HandlerImpl) DiscardTrashedQueueHandler(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if err := h.s.DiscardTrashedQueue(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "failed to discard trashed queue", slog.String("trash_id", id), slog.Any("error", err))
+		if errors.Is(err, ErrTrashedQueueNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, "failed to discard deleted queue", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/trash?done=discarded", http.StatusSeeOther)
+}
+
+func (h *HandlerImpl) renderTrash(w http.ResponseWriter, r *http.Request, data trashPageData) {
+	data.Title = "Trash"
+	data.ViteTags = fragments["assets/js/trash.ts"].Tags
+
+	trashed, err := h.s.TrashedQueues(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load trash", slog.Any("error", err))
+		data.ErrorMessage = "Failed to load deleted queues."
+	}
+	for _, queue := range trashed {
+		data.Queues = append(data.Queues, newTrashedQueueView(queue))
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates["trash"].Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "failed to render trash template", slog.Any("error", err))
+		http.Error(w, "template error", http.StatusInternalServerError)
+	}
+}
+
+func newTrashedQueueView(queue TrashedQueue) trashedQueueView {
+	view := trashedQueueView{
+		ID:        url.PathEscape(queue.ID),
+		Name:      queue.Name,
+		Type:      "STANDARD",
+		DeletedAt: queue.DeletedAt.Format("2006-01-02 15:04:05 MST"),
+	}
+	if queue.Attributes["FifoQueue"] == "true" {
+		view.Type = "FIFO"
+	}
+
+	keys := make([]string, 0, len(queue.Attributes))
+	for key := range queue.Attributes {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		view.Attributes = append(view.Attributes, queueAttributeView{Key: key, Value: queue.Attributes[key]})
+	}
+
+	tagKeys := make([]string, 0, len(queue.Tags))
+	for key := range queue.Tags {
+		tagKeys = append(tagKeys, key)
+	}
+	slices.Sort(tagKeys)
+	for _, key := range tagKeys {
+		view.Tags = append(view.Tags, queueTagView{Key: key, Value: queue.Tags[key]})
+	}
+	return view
+}
@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_TrashHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/trash?done=discarded", nil)
+	rr := httptest.NewRecorder()
+
+	var captured trashPageData
+	captureTemplate(t, "trash", func(data trashPageData) { captured = data })
+	installFragment(t, "assets/js/trash.ts", template.HTML(`<script data-test="trash"></script>`))
+
+	mockService.EXPECT().TrashedQueues(mock.Anything).Return([]TrashedQueue{{
+		ID:         "abc123",
+		Name:       "orders.fifo",
+		Attributes: map[string]string{"VisibilityTimeout": "45", "FifoQueue": "true"},
+		Tags:       map[string]string{"team": "payments"},
+		DeletedAt:  time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}}, nil).Once()
+
+	handler.TrashHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "Trash", captured.Title)
+	assert.Equal(t, &pageFlash{Message: "Deleted queue was removed from the trash.", Kind: "success"}, captured.Flash)
+	assert.Equal(t, []trashedQueueView{{
+		ID:        "abc123",
+		Name:      "orders.fifo",
+		Type:      "FIFO",
+		DeletedAt: "2024-05-01 12:00:00 UTC",
+		Attributes: []queueAttributeView{
+			{Key: "FifoQueue", Value: "true"},
+			{Key: "VisibilityTimeout", Value: "45"},
+		},
+		Tags: []queueTagView{{Key: "team", Value: "payments"}},
+	}}, captured.Queues)
+}
+
+func TestHandlerImpl_RestoreQueueHandler(t *testing.T) {
+	t.Run("redirects to the queue list", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/trash/abc123/restore", nil)
+		req.SetPathValue("id", "abc123")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().RestoreQueue(mock.Anything, "abc123").Return("https://sqs.local/000000000000/orders", nil).Once()
+
+		handler.RestoreQueueHandler(rr, req)
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues?restored=orders", rr.Header().Get("Location"))
+	})
+
+	t.Run("explains the recreate delay", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/trash/abc123/restore", nil)
+		req.SetPathValue("id", "abc123")
+		rr := httptest.NewRecorder()
+
+		var captured trashPageData
+		captureTemplate(t, "trash", func(data trashPageData) { captured = data })
+		installFragment(t, "assets/js/trash.ts", template.HTML(""))
+
+		mockService.EXPECT().RestoreQueue(mock.Anything, "abc123").Return("", ErrQueueDeletedRecently).Once()
+		mockService.EXPECT().TrashedQueues(mock.Anything).Return(nil, nil).Once()
+
+		handler.RestoreQueueHandler(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, "Failed to restore queue: a queue with this name was deleted less than 60 seconds ago; try again shortly", captured.ErrorMessage)
+	})
+
+	t.Run("unknown entry", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/trash/missing/restore", nil)
+		req.SetPathValue("id", "missing")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().RestoreQueue(mock.Anything, "missing").Return("", ErrTrashedQueueNotFound).Once()
+
+		handler.RestoreQueueHandler(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Equal(t, "deleted queue not found\n", rr.Body.String())
+	})
+}
+
+func TestHandlerImpl_DiscardTrashedQueueHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodPost, "/trash/abc123/delete", nil)
+	req.SetPathValue("id", "abc123")
+	rr := httptest.NewRecorder()
+
+	mockService.EXPECT().DiscardTrashedQueue(mock.Anything, "abc123").Return(nil).Once()
+
+	handler.DiscardTrashedQueueHandler(rr, req)
+
+	assert.Equal(t, http.StatusSeeOther, rr.Code)
+	assert.Equal(t, "/trash?done=discarded", rr.Header().Get("Location"))
+}
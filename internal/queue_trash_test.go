@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_DeleteQueue_Trash(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders.fifo"
+	deleted := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *MockSqsRepository, LocalStore) {
+		repo := NewMockSqsRepository(t)
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		return &SqsServiceImpl{repo: repo, store: store, clock: func() time.Time { return deleted }}, repo, store
+	}
+
+	t.Run("keeps restorable attributes and tags", func(t *testing.T) {
+		service, repo, store := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{
+			Attributes: map[string]string{
+				"ApproximateNumberOfMessages": "12",
+				"ContentBasedDeduplication":   "true",
+				"FifoQueue":                   "true",
+				"KmsMasterKeyId":              "",
+				"QueueArn":                    "arn:aws:sqs:us-east-1:000000000000:orders.fifo",
+				"VisibilityTimeout":           "45",
+			},
+			Tags: map[string]string{"team": "payments"},
+		}, nil).Once()
+		repo.EXPECT().DeleteQueue(mock.Anything, queueURL).Return(nil).Once()
+
+		trashed, err := service.DeleteQueue(ctx, queueURL)
+		require.NoError(t, err)
+		assert.NotEmpty(t, trashed.ID)
+		assert.Equal(t, "orders.fifo", trashed.Name)
+		assert.Equal(t, map[string]string{
+			"ContentBasedDeduplication": "true",
+			"FifoQueue":                 "true",
+			"VisibilityTimeout":         "45",
+		}, trashed.Attributes)
+		assert.Equal(t, map[string]string{"team": "payments"}, trashed.Tags)
+		assert.Equal(t, deleted, trashed.DeletedAt)
+
+		stored, ok, err := store.TrashedQueue(trashed.ID)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, trashed, stored)
+	})
+
+	t.Run("does not delete when the configuration cannot be read", func(t *testing.T) {
+		service, repo, store := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{}, errors.New("boom")).Once()
+
+		_, err := service.DeleteQueue(ctx, queueURL)
+		assert.EqualError(t, err, "failed to save queue configuration before deleting: boom")
+		repo.AssertNotCalled(t, "DeleteQueue", mock.Anything, mock.Anything)
+
+		trashed, err := store.TrashedQueues()
+		require.NoError(t, err)
+		assert.Empty(t, trashed)
+	})
+
+	t.Run("drops the trash entry when delete fails", func(t *testing.T) {
+		service, repo, store := newService(t)
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{}, nil).Once()
+		repo.EXPECT().
+			DeleteQueue(mock.Anything, queueURL).
+			Return(fmt.Errorf("queue is protected: %w", ErrQueueAccessDenied)).
+			Once()
+
+		_, err := service.DeleteQueue(ctx, queueURL)
+		assert.ErrorIs(t, err, ErrQueueAccessDenied)
+
+		trashed, err := store.TrashedQueues()
+		require.NoError(t, err)
+		assert.Empty(t, trashed)
+	})
+
+	t.Run("prunes the oldest entries", func(t *testing.T) {
+		service, repo, store := newService(t)
+		for i := range maxTrashedQueues {
+			require.NoError(t, store.SaveTrashedQueue(TrashedQueue{
+				ID:        fmt.Sprintf("old-%02d", i),
+				DeletedAt: deleted.Add(-time.Duration(maxTrashedQueues-i) * time.Minute),
+			}))
+		}
+
+		repo.EXPECT().GetQueueDetail(mock.Anything, queueURL).Return(QueueDetail{}, nil).Once()
+		repo.EXPECT().DeleteQueue(mock.Anything, queueURL).Return(nil).Once()
+
+		trashed, err := service.DeleteQueue(ctx, queueURL)
+		require.NoError(t, err)
+
+		all, err := service.TrashedQueues(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, maxTrashedQueues)
+		assert.Equal(t, trashed.ID, all[0].ID)
+		_, ok, err := store.TrashedQueue("old-00")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestSqsServiceImpl_RestoreQueue(t *testing.T) {
+	ctx := context.Background()
+	trashed := TrashedQueue{
+		ID:         "abc123",
+		Name:       "orders",
+		URL:        "https://sqs.local/000000000000/orders",
+		Attributes: map[string]string{"VisibilityTimeout": "45"},
+		Tags:       map[string]string{"team": "payments"},
+	}
+
+	t.Run("recreates the queue and empties the entry", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		require.NoError(t, store.SaveTrashedQueue(trashed))
+		service := &SqsServiceImpl{repo: repo, store: store}
+
+		repo.EXPECT().CreateQueue(mock.Anything, CreateQueueRepositoryInput{
+			Name:       "orders",
+			Attributes: map[string]string{"VisibilityTimeout": "45"},
+			Tags:       map[string]string{"team": "payments"},
+		}).Return(trashed.URL, nil).Once()
+
+		queueURL, err := service.RestoreQueue(ctx, "abc123")
+		require.NoError(t, err)
+		assert.Equal(t, trashed.URL, queueURL)
+
+		_, ok, err := store.TrashedQueue("abc123")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("keeps the entry when create fails", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		require.NoError(t, store.SaveTrashedQueue(trashed))
+		service := &SqsServiceImpl{repo: repo, store: store}
+
+		repo.EXPECT().CreateQueue(mock.Anything, mock.Anything).Return("", ErrQueueDeletedRecently).Once()
+
+		_, err = service.RestoreQueue(ctx, "abc123")
+		assert.ErrorIs(t, err, ErrQueueDeletedRecently)
+
+		_, ok, err := store.TrashedQueue("abc123")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("unknown entry", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t), store: store}
+
+		_, err = service.RestoreQueue(ctx, "missing")
+		assert.ErrorIs(t, err, ErrTrashedQueueNotFound)
+		assert.ErrorIs(t, service.DiscardTrashedQueue(ctx, "missing"), ErrTrashedQueueNotFound)
+	})
+}
@@ -0,0 +1,269 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrInvalidQueueURL is returned when a queue URL does not point at the configured SQS endpoint.
+var ErrInvalidQueueURL = errors.New("queue url is not allowed")
+
+// hostRefreshInterval is the least time between the ListQueues calls unknown hosts trigger, so a
+// stream of requests with made-up hosts does not become a stream of ListQueues calls.
+const hostRefreshInterval = 30 * time.Second
+
+// QueueURLRule describes which queue URLs the service may pass to SQS.
+type QueueURLRule struct {
+	// Hosts are the host[:port] values queue URLs may use, in lower case.
+	Hosts []string
+	// AccountIDs, when set, restricts the account segment of queue URLs.
+	AccountIDs []string
+}
+
+// Enabled reports whether the rule restricts anything.
+func (r QueueURLRule) Enabled() bool {
+	return len(r.Hosts) > 0 || len(r.AccountIDs) > 0
+}
+
+// queueURLRepository refuses calls for queue URLs outside the configured endpoint, so a crafted
+// URL from a request can never make the service talk to an arbitrary host. Hosts that SQS itself
+// reports in ListQueues or CreateQueue responses are trusted as well, because local emulators often
+// advertise a different host name than the one the GUI connects to.
+type queueURLRepository struct {
+	SqsRepository
+	rule QueueURLRule
+
+	mu    sync.Mutex
+	hosts map[string]bool
+	// refreshedAt is when an unknown host last triggered a ListQueues call.
+	refreshedAt time.Time
+	clock       func() time.Time
+}
+
+func newQueueURLRepository(repo SqsRepository, rule QueueURLRule) SqsRepository {
+	hosts := make(map[string]bool, len(rule.Hosts))
+	for _, host := range rule.Hosts {
+		hosts[strings.ToLower(host)] = true
+	}
+	return &queueURLRepository{SqsRepository: repo, rule: rule, hosts: hosts, clock: time.Now}
+}
+
+func (r *queueURLRepository) ListQueues(ctx context.Context) ([]QueueSummary, error) {
+	queues, err := r.SqsRepository.ListQueues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, queue := range queues {
+		r.learnHost(queue.URL)
+	}
+	return queues, nil
+}
+
+func (r *queueURLRepository) ListQueuesPage(ctx context.Context, input ListQueuesPageRepositoryInput) (QueueSummaryPage, error) {
+	page, err := r.SqsRepository.ListQueuesPage(ctx, input)
+	if err != nil {
+		return QueueSummaryPage{}, err
+	}
+	for _, queue := range page.Queues {
+		r.learnHost(queue.URL)
+	}
+	return page, nil
+}
+
+func (r *queueURLRepository) CreateQueue(ctx context.Context, input CreateQueueRepositoryInput) (string, error) {
+	queueURL, err := r.SqsRepository.CreateQueue(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	r.learnHost(queueURL)
+	return queueURL, nil
+}
+
+func (r *queueURLRepository) GetQueueDetail(ctx context.Context, queueURL string) (QueueDetail, error) {
+	if err := r.check(ctx, queueURL); err != nil {
+		return QueueDetail{}, err
+	}
+	return r.SqsRepository.GetQueueDetail(ctx, queueURL)
+}
+
+func (r *queueURLRepository) ListQueueTags(ctx context.Context, queueURL string) (map[string]string, error) {
+	if err := r.check(ctx, queueURL); err != nil {
+		return nil, err
+	}
+	return r.SqsRepository.ListQueueTags(ctx, queueURL)
+}
+
+func (r *queueURLRepository) TagQueue(ctx context.Context, queueURL string, tags map[string]string) error {
+	if err := r.check(ctx, queueURL); err != nil {
+		return err
+	}
+	return r.SqsRepository.TagQueue(ctx, queueURL, tags)
+}
+
+func (r *queueURLRepository) UntagQueue(ctx context.Context, queueURL string, keys []string) error {
+	if err := r.check(ctx, queueURL); err != nil {
+		return err
+	}
+	return r.SqsRepository.UntagQueue(ctx, queueURL, keys)
+}
+
+func (r *queueURLRepository) GetQueueStats(ctx context.Context, queueURL string) (QueueStats, error) {
+	if err := r.check(ctx, queueURL); err != nil {
+		return QueueStats{}, err
+	}
+	return r.SqsRepository.GetQueueStats(ctx, queueURL)
+}
+
+func (r *queueURLRepository) GetQueueAttributes(ctx context.Context, queueURL string, names []string) (map[string]string, error) {
+	if err := r.check(ctx, queueURL); err != nil {
+		return nil, err
+	}
+	return r.SqsRepository.GetQueueAttributes(ctx, queueURL, names)
+}
+
+func (r *queueURLRepository) DeleteQueue(ctx context.Context, queueURL string) error {
+	if err := r.check(ctx, queueURL); err != nil {
+		return err
+	}
+	return r.SqsRepository.DeleteQueue(ctx, queueURL)
+}
+
+func (r *queueURLRepository) PurgeQueue(ctx context.Context, queueURL string) error {
+	if err := r.check(ctx, queueURL); err != nil {
+		return err
+	}
+	return r.SqsRepository.PurgeQueue(ctx, queueURL)
+}
+
+func (r *queueURLRepository) SetQueueAttributes(ctx context.Context, queueURL string, attributes map[string]string) error {
+	if err := r.check(ctx, queueURL); err != nil {
+		return err
+	}
+	return r.SqsRepository.SetQueueAttributes(ctx, queueURL, attributes)
+}
+
+func (r *queueURLRepository) SendMessage(ctx context.Context, input SendMessageRepositoryInput) error {
+	if err := r.check(ctx, input.QueueURL); err != nil {
+		return err
+	}
+	return r.SqsRepository.SendMessage(ctx, input)
+}
+
+func (r *queueURLRepository) SendMessageBatch(ctx context.Context, input SendMessageBatchRepositoryInput) ([]SendMessageBatchFailure, error) {
+	if err := r.check(ctx, input.QueueURL); err != nil {
+		return nil, err
+	}
+	return r.SqsRepository.SendMessageBatch(ctx, input)
+}
+
+func (r *queueURLRepository) ReceiveMessages(ctx context.Context, input ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error) {
+	if err := r.check(ctx, input.QueueURL); err != nil {
+		return nil, err
+	}
+	return r.SqsRepository.ReceiveMessages(ctx, input)
+}
+
+func (r *queueURLRepository) DeleteMessage(ctx context.Context, input DeleteMessageRepositoryInput) error {
+	if err := r.check(ctx, input.QueueURL); err != nil {
+		return err
+	}
+	return r.SqsRepository.DeleteMessage(ctx, input)
+}
+
+func (r *queueURLRepository) ChangeMessageVisibility(ctx context.Context, input ChangeMessageVisibilityRepositoryInput) error {
+	if err := r.check(ctx, input.QueueURL); err != nil {
+		return err
+	}
+	return r.SqsRepository.ChangeMessageVisibility(ctx, input)
+}
+
+func (r *queueURLRepository) ListDeadLetterSourceQueues(ctx context.Context, queueURL string) ([]string, error) {
+	if err := r.check(ctx, queueURL); err != nil {
+		return nil, err
+	}
+	return r.SqsRepository.ListDeadLetterSourceQueues(ctx, queueURL)
+}
+
+// check validates the shape of queueURL and that its host is trusted. An unknown host triggers one
+// ListQueues call to learn the hosts SQS currently reports before the URL is rejected, unless one
+// was made within hostRefreshInterval.
+func (r *queueURLRepository) check(ctx context.Context, queueURL string) error {
+	host, account, err := parseQueueURL(queueURL)
+	if err != nil {
+		return err
+	}
+	if len(r.rule.AccountIDs) > 0 && !slices.Contains(r.rule.AccountIDs, account) {
+		return errors.Wrapf(ErrInvalidQueueURL, "account %q is not configured", account)
+	}
+
+	if r.knownHost(host) {
+		return nil
+	}
+	if r.startRefresh() {
+		if _, err := r.ListQueues(ctx); err != nil {
+			slog.WarnContext(ctx, "failed to refresh trusted queue hosts", slog.Any("error", err))
+		}
+		if r.knownHost(host) {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidQueueURL, "host %q is not the configured SQS endpoint", host)
+}
+
+// startRefresh reports whether an unknown host may trigger a ListQueues call now, and if so
+// records the call.
+func (r *queueURLRepository) startRefresh() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.clock()
+	if !r.refreshedAt.IsZero() && now.Sub(r.refreshedAt) < hostRefreshInterval {
+		return false
+	}
+	r.refreshedAt = now
+	return true
+}
+
+func (r *queueURLRepository) knownHost(host string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.hosts[host]
+}
+
+func (r *queueURLRepository) learnHost(queueURL string) {
+	host, _, err := parseQueueURL(queueURL)
+	if err != nil {
+		return
+	}
+	r.mu.Lock()
+	r.hosts[host] = true
+	r.mu.Unlock()
+}
+
+// parseQueueURL checks that queueURL looks like scheme://host/<account>/<queue-name> and returns
+// its lower-cased host and account segment.
+func parseQueueURL(queueURL string) (string, string, error) {
+	u, err := url.Parse(queueURL)
+	if err != nil {
+		return "", "", errors.Wrap(ErrInvalidQueueURL, "malformed url")
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return "", "", errors.Wrapf(ErrInvalidQueueURL, "unsupported scheme %q", u.Scheme)
+	}
+	if u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", "", errors.Wrap(ErrInvalidQueueURL, "url must only contain a host and a path")
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", errors.Wrap(ErrInvalidQueueURL, "path must be /<account>/<queue-name>")
+	}
+
+	return strings.ToLower(u.Host), segments[0], nil
+}
@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultDrainReceiveMessages = 100
+	maxDrainReceiveMessages     = 1000
+	defaultDrainReceiveDuration = 30 * time.Second
+	maxDrainReceiveDuration     = 5 * time.Minute
+	// drainReceiveWait is the long poll of each receive; an empty answer ends the drain.
+	drainReceiveWait int32 = 2
+)
+
+// Reasons a drain-until-empty receive stopped.
+const (
+	DrainStoppedEmpty         = "empty"
+	DrainStoppedMessageBudget = "message_budget"
+	DrainStoppedTimeBudget    = "time_budget"
+)
+
+// DrainReceiveInput bounds a drain-until-empty receive. Zero values pick the defaults.
+type DrainReceiveInput struct {
+	QueueURL    string
+	MaxMessages int
+	MaxDuration time.Duration
+}
+
+// DrainReceiveResult tells how many messages a drain received and why it stopped.
+type DrainReceiveResult struct {
+	Received int
+	Reason   string
+}
+
+// DrainReceive keeps receiving from a queue until a receive comes back empty or the message or time
+// budget is used up, handing each batch to emit as it arrives. Messages are not deleted; they stay
+// in flight for the queue's visibility timeout like any other receive, so none is returned twice
+// unless the drain outlasts it.
+func (s *SqsServiceImpl) DrainReceive(ctx context.Context, input DrainReceiveInput, emit func([]ReceivedMessage) error) (DrainReceiveResult, error) {
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return DrainReceiveResult{}, errors.New("queue url is required")
+	}
+	maxMessages := input.MaxMessages
+	if maxMessages == 0 {
+		maxMessages = defaultDrainReceiveMessages
+	}
+	if maxMessages < 1 || maxMessages > maxDrainReceiveMessages {
+		return DrainReceiveResult{}, errors.Newf("max messages must be between 1 and %d", maxDrainReceiveMessages)
+	}
+	maxDuration := input.MaxDuration
+	if maxDuration == 0 {
+		maxDuration = defaultDrainReceiveDuration
+	}
+	if maxDuration < time.Second || maxDuration > maxDrainReceiveDuration {
+		return DrainReceiveResult{}, errors.Newf("time budget must be between 1s and %s", maxDrainReceiveDuration)
+	}
+
+	pollCtx, done, err := s.polls.track(ctx)
+	if err != nil {
+		return DrainReceiveResult{}, err
+	}
+	defer done()
+
+	deadline := s.now().Add(maxDuration)
+	seen := make(map[string]bool)
+	result := DrainReceiveResult{}
+	for {
+		if result.Received >= maxMessages {
+			result.Reason = DrainStoppedMessageBudget
+			return result, nil
+		}
+		if !s.now().Before(deadline) {
+			result.Reason = DrainStoppedTimeBudget
+			return result, nil
+		}
+
+		messages, err := s.repo.ReceiveMessages(pollCtx, ReceiveMessagesRepositoryInput{
+			QueueURL:        queueURL,
+			MaxMessages:     min(int32(maxMessages-result.Received), migrationReceiveBatch),
+			WaitTimeSeconds: drainReceiveWait,
+		})
+		if err != nil {
+			if pollCtx.Err() != nil && ctx.Err() == nil {
+				return result, errors.WithStack(ErrShuttingDown)
+			}
+			return result, errors.Wrapf(err, "received %d messages", result.Received)
+		}
+		if len(messages) == 0 {
+			result.Reason = DrainStoppedEmpty
+			return result, nil
+		}
+
+		fresh := make([]ReceivedMessage, 0, len(messages))
+		for _, message := range messages {
+			if seen[message.ID] {
+				continue
+			}
+			seen[message.ID] = true
+			fresh = append(fresh, message)
+		}
+		if len(fresh) == 0 {
+			continue
+		}
+		result.Received += len(fresh)
+		if err := emit(fresh); err != nil {
+			return result, err
+		}
+	}
+}
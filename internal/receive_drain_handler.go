@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// drainWriteMargin extends the write deadline of a drain past its time budget, so the last
+// receive and the closing line still reach the client.
+const drainWriteMargin = 30 * time.Second
+
+type drainReceiveRequest struct {
+	MaxMessages int `json:"maxMessages"`
+	MaxSeconds  int `json:"maxSeconds"`
+}
+
+// drainReceiveEvent is one line of a drain stream: a batch of messages, the closing summary with
+// the stop reason, or an error that ended the drain early.
+type drainReceiveEvent struct {
+	Messages []receiveMessageItem `json:"messages,omitempty"`
+	Received int                  `json:"received"`
+	Done     bool                 `json:"done,omitempty"`
+	Reason   string               `json:"reason,omitempty"`
+	Error    string               `json:"error,omitempty"`
+}
+
+// DrainReceiveAPI receives from a queue until it is empty or a budget is used up and streams
+// the messages as newline-delimited JSON, one line per batch, as they arrive. Errors found before
+// the first batch are answered like any other API error.
+func (h *HandlerImpl) DrainReceiveAPI(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		writeJSONError(w, status, err.Error())
+		return
+	}
+
+	defer func() { _ = r.Body.Close() }()
+
+	var payload drainReceiveRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&payload); err != nil && !errors.Is(err, io.EOF) {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	input := DrainReceiveInput{
+		QueueURL:    queueURL,
+		MaxMessages: payload.MaxMessages,
+		MaxDuration: time.Duration(payload.MaxSeconds) * time.Second,
+	}
+
+	stream := newNDJSONStream(w, cmp.Or(input.MaxDuration, defaultDrainReceiveDuration))
+	received := 0
+	result, err := h.s.DrainReceive(r.Context(), input, func(messages []ReceivedMessage) error {
+		received += len(messages)
+		event := drainReceiveEvent{Received: received, Messages: make([]receiveMessageItem, 0, len(messages))}
+		for _, message := range messages {
+			event.Messages = append(event.Messages, newReceiveMessageItem(message))
+		}
+		return stream.write(event)
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to drain messages", slog.String("queue_url", queueURL), slog.Any("error", err))
+		if !stream.started {
+			writeJSONError(w, serviceErrorStatus(err), err.Error())
+			return
+		}
+		_ = stream.write(drainReceiveEvent{Received: received, Done: true, Error: err.Error()})
+		return
+	}
+
+	if err := stream.write(drainReceiveEvent{Received: result.Received, Done: true, Reason: result.Reason}); err != nil {
+		slog.WarnContext(r.Context(), "failed to finish drain stream", slog.String("queue_url", queueURL), slog.Any("error", err))
+	}
+}
+
+// ndjsonStream writes the events of a long-running receive as newline-delimited JSON, flushing
+// each one. The status line is only written with the first event, so errors found before it can
+// still be answered like any other API error.
+type ndjsonStream struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	encoder    *json.Encoder
+	budget     time.Duration
+	started    bool
+}
+
+func newNDJSONStream(w http.ResponseWriter, budget time.Duration) *ndjsonStream {
+	return &ndjsonStream{w: w, controller: http.NewResponseController(w), encoder: json.NewEncoder(w), budget: budget}
+}
+
+func (s *ndjsonStream) write(event any) error {
+	if !s.started {
+		s.started = true
+		// The server's write timeout is sized for a single long poll.
+		if err := s.controller.SetWriteDeadline(time.Now().Add(s.budget + drainWriteMargin)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.w.WriteHeader(http.StatusOK)
+	}
+	if err := s.encoder.Encode(event); err != nil {
+		return err
+	}
+	if err := s.controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_DrainReceiveAPI(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/messages/drain", strings.NewReader(body))
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("streams batches and the stop reason", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			DrainReceive(mock.Anything, DrainReceiveInput{QueueURL: queueURL, MaxMessages: 50, MaxDuration: 10 * time.Second}, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ DrainReceiveInput, emit func([]ReceivedMessage) error) (DrainReceiveResult, error) {
+				if err := emit([]ReceivedMessage{{ID: "1", Body: "a", ReceiptHandle: "r-1"}}); err != nil {
+					return DrainReceiveResult{}, err
+				}
+				if err := emit([]ReceivedMessage{{ID: "2", Body: "b", ReceiptHandle: "r-2"}}); err != nil {
+					return DrainReceiveResult{}, err
+				}
+				return DrainReceiveResult{Received: 2, Reason: DrainStoppedEmpty}, nil
+			}).
+			Once()
+
+		handler.DrainReceiveAPI(rr, newRequest(`{"maxMessages":50,"maxSeconds":10}`))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/x-ndjson", rr.Header().Get("Content-Type"))
+		assert.Equal(t, strings.Join([]string{
+			`{"messages":[{"id":"1","body":"a","receiptHandle":"r-1","receiveCount":0,"bodyHash":"ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb","attributes":[]}],"received":1}`,
+			`{"messages":[{"id":"2","body":"b","receiptHandle":"r-2","receiveCount":0,"bodyHash":"3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d","attributes":[]}],"received":2}`,
+			`{"received":2,"done":true,"reason":"empty"}`,
+		}, "\n")+"\n", rr.Body.String())
+	})
+
+	t.Run("answers errors before the first batch with a status", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().DrainReceive(mock.Anything, mock.Anything, mock.Anything).
+			Return(DrainReceiveResult{}, errors.New("max messages must be between 1 and 1000")).Once()
+
+		handler.DrainReceiveAPI(rr, newRequest(`{"maxMessages":5000}`))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"max messages must be between 1 and 1000"}`, rr.Body.String())
+	})
+
+	t.Run("ends the stream with later errors", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().DrainReceive(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ DrainReceiveInput, emit func([]ReceivedMessage) error) (DrainReceiveResult, error) {
+				_ = emit([]ReceivedMessage{{ID: "1"}})
+				return DrainReceiveResult{Received: 1}, errors.New("throttled")
+			}).
+			Once()
+
+		handler.DrainReceiveAPI(rr, newRequest(""))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+		assert.Len(t, lines, 2)
+		assert.JSONEq(t, `{"received":1,"done":true,"error":"throttled"}`, lines[1])
+	})
+
+	t.Run("rejects invalid bodies", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		handler.DrainReceiveAPI(rr, newRequest(`{"unknown":1}`))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_DrainReceive(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("stops once the queue is empty", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, ReceiveMessagesRepositoryInput{
+			QueueURL:        queueURL,
+			MaxMessages:     10,
+			WaitTimeSeconds: drainReceiveWait,
+		}).Return([]ReceivedMessage{{ID: "1"}, {ID: "2"}}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{{ID: "2"}, {ID: "3"}}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		var batches [][]string
+		result, err := service.DrainReceive(ctx, DrainReceiveInput{QueueURL: queueURL}, func(messages []ReceivedMessage) error {
+			ids := make([]string, 0, len(messages))
+			for _, message := range messages {
+				ids = append(ids, message.ID)
+			}
+			batches = append(batches, ids)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, DrainReceiveResult{Received: 3, Reason: DrainStoppedEmpty}, result)
+		assert.Equal(t, [][]string{{"1", "2"}, {"3"}}, batches)
+	})
+
+	t.Run("stops at the message budget", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.MatchedBy(func(input ReceiveMessagesRepositoryInput) bool {
+			return input.MaxMessages == 3
+		})).Return([]ReceivedMessage{{ID: "1"}, {ID: "2"}, {ID: "3"}}, nil).Once()
+
+		result, err := service.DrainReceive(ctx, DrainReceiveInput{QueueURL: queueURL, MaxMessages: 3}, func([]ReceivedMessage) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, DrainReceiveResult{Received: 3, Reason: DrainStoppedMessageBudget}, result)
+	})
+
+	t.Run("stops when the time budget runs out", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		service := &SqsServiceImpl{repo: repo, clock: func() time.Time { return now }}
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).
+			Run(func(context.Context, ReceiveMessagesRepositoryInput) { now = now.Add(6 * time.Second) }).
+			Return([]ReceivedMessage{{ID: "1"}}, nil).Once()
+
+		result, err := service.DrainReceive(ctx, DrainReceiveInput{QueueURL: queueURL, MaxDuration: 5 * time.Second}, func([]ReceivedMessage) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, DrainReceiveResult{Received: 1, Reason: DrainStoppedTimeBudget}, result)
+	})
+
+	t.Run("passes on errors", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return([]ReceivedMessage{{ID: "1"}}, nil).Once()
+		repo.EXPECT().ReceiveMessages(mock.Anything, mock.Anything).Return(nil, errors.New("throttled")).Once()
+
+		result, err := service.DrainReceive(ctx, DrainReceiveInput{QueueURL: queueURL}, func([]ReceivedMessage) error { return nil })
+		assert.EqualError(t, err, "received 1 messages: throttled")
+		assert.Equal(t, 1, result.Received)
+	})
+
+	t.Run("validates the budgets", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.DrainReceive(ctx, DrainReceiveInput{QueueURL: queueURL, MaxMessages: 1001}, nil)
+		assert.EqualError(t, err, "max messages must be between 1 and 1000")
+		_, err = service.DrainReceive(ctx, DrainReceiveInput{QueueURL: queueURL, MaxDuration: time.Hour}, nil)
+		assert.EqualError(t, err, "time budget must be between 1s and 5m0s")
+	})
+}
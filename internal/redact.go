@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+)
+
+// redactedValue replaces the value of a sensitive log attribute.
+const redactedValue = "[REDACTED]"
+
+// sensitiveLogKeys are attribute keys whose values may carry message content or credentials.
+var sensitiveLogKeys = map[string]bool{
+	"body":               true,
+	"message_body":       true,
+	"attributes":         true,
+	"message_attributes": true,
+	"attribute_value":    true,
+	"draft":              true,
+	"authorization":      true,
+	"credentials":        true,
+	"webhook_url":        true,
+}
+
+// sensitiveLogKeyParts mark any key containing them as sensitive, e.g. secret_access_key or session_token.
+var sensitiveLogKeyParts = []string{"password", "secret", "token"}
+
+// redactingHandler masks sensitive attributes before records reach the wrapped handler.
+type redactingHandler struct {
+	next          slog.Handler
+	revealAtDebug bool
+}
+
+// NewRedactingHandler wraps next so message bodies, attribute values and credentials are never logged.
+// When revealAtDebug is set, records below Info keep the attributes passed with them so payloads can
+// be inspected while debugging; attributes bound with Logger.With are redacted regardless.
+func NewRedactingHandler(next slog.Handler, revealAtDebug bool) slog.Handler {
+	return &redactingHandler{next: next, revealAtDebug: revealAtDebug}
+}
+
+func (h *redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *redactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	if h.revealAtDebug && record.Level < slog.LevelInfo {
+		return h.next.Handle(ctx, record)
+	}
+
+	redacted := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(redactAttr(attr))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+func (h *redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		redacted = append(redacted, redactAttr(attr))
+	}
+	return &redactingHandler{next: h.next.WithAttrs(redacted), revealAtDebug: h.revealAtDebug}
+}
+
+func (h *redactingHandler) WithGroup(name string) slog.Handler {
+	return &redactingHandler{next: h.next.WithGroup(name), revealAtDebug: h.revealAtDebug}
+}
+
+// redactAttr masks attr if its key is sensitive and walks into groups otherwise.
+func redactAttr(attr slog.Attr) slog.Attr {
+	if isSensitiveLogKey(attr.Key) {
+		return slog.String(attr.Key, redactedValue)
+	}
+
+	attr.Value = attr.Value.Resolve()
+	if attr.Value.Kind() != slog.KindGroup {
+		return attr
+	}
+
+	group := attr.Value.Group()
+	redacted := make([]slog.Attr, 0, len(group))
+	for _, member := range group {
+		redacted = append(redacted, redactAttr(member))
+	}
+	return slog.Attr{Key: attr.Key, Value: slog.GroupValue(redacted...)}
+}
+
+func isSensitiveLogKey(key string) bool {
+	key = strings.ToLower(key)
+	if sensitiveLogKeys[key] {
+		return true
+	}
+	for _, part := range sensitiveLogKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactingHandler(t *testing.T) {
+	newLogger := func(revealAtDebug bool) (*slog.Logger, *bytes.Buffer) {
+		var buf bytes.Buffer
+		next := slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+			Level: slog.LevelDebug,
+			ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+				if attr.Key == slog.TimeKey {
+					return slog.Attr{}
+				}
+				return attr
+			},
+		})
+		return slog.New(NewRedactingHandler(next, revealAtDebug)), &buf
+	}
+	decode := func(t *testing.T, buf *bytes.Buffer) map[string]any {
+		t.Helper()
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		return record
+	}
+
+	t.Run("redacts sensitive keys at every level", func(t *testing.T) {
+		logger, buf := newLogger(false)
+
+		logger.Debug("sending message",
+			slog.String("queue_url", "https://sqs.local/orders"),
+			slog.String("body", `{"email":"a@example.com"}`),
+			slog.Group("aws", slog.String("Secret_Access_Key", "abc"), slog.String("region", "us-east-1")),
+		)
+
+		assert.Equal(t, map[string]any{
+			"level":     "DEBUG",
+			"msg":       "sending message",
+			"queue_url": "https://sqs.local/orders",
+			"body":      redactedValue,
+			"aws":       map[string]any{"Secret_Access_Key": redactedValue, "region": "us-east-1"},
+		}, decode(t, buf))
+	})
+
+	t.Run("reveals debug records when opted in", func(t *testing.T) {
+		logger, buf := newLogger(true)
+
+		logger.Debug("sending message", slog.String("body", "hello"))
+		assert.Equal(t, "hello", decode(t, buf)["body"])
+
+		buf.Reset()
+		logger.Info("sent message", slog.String("body", "hello"))
+		assert.Equal(t, redactedValue, decode(t, buf)["body"])
+	})
+
+	t.Run("redacts bound attributes", func(t *testing.T) {
+		logger, buf := newLogger(true)
+
+		logger.With(slog.String("session_token", "xyz")).WithGroup("req").Debug("request", slog.String("id", "1"))
+
+		record := decode(t, buf)
+		assert.Equal(t, redactedValue, record["session_token"])
+		assert.Equal(t, map[string]any{"id": "1"}, record["req"])
+	})
+}
@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// maxRedriveReceiveCount is the highest maxReceiveCount SQS accepts in a redrive policy.
+const maxRedriveReceiveCount = 1000
+
+// DeadLetterCandidates lists the queues that can be picked as a dead-letter queue, sorted by name.
+// When sourceURL is set, the source queue itself and queues of the other type are left out.
+func (s *SqsServiceImpl) DeadLetterCandidates(ctx context.Context, sourceURL string) ([]QueueSummary, error) {
+	queues, err := s.repo.ListQueues(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceType := QueueType("")
+	if sourceURL != "" {
+		sourceType = queueTypeOfName(extractQueueName(sourceURL))
+	}
+
+	candidates := make([]QueueSummary, 0, len(queues))
+	for _, queue := range queues {
+		if queue.Arn == "" || queue.URL == sourceURL {
+			continue
+		}
+		if sourceType != "" && queue.Type != sourceType {
+			continue
+		}
+		candidates = append(candidates, queue)
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Name < candidates[j].Name })
+	return candidates, nil
+}
+
+// SetRedrivePolicy points a queue at a dead-letter queue, or removes its redrive policy when
+// policy is nil.
+func (s *SqsServiceImpl) SetRedrivePolicy(ctx context.Context, queueURL string, policy *RedrivePolicy) error {
+	if strings.TrimSpace(queueURL) == "" {
+		return errors.New("queue url is required")
+	}
+	if policy == nil {
+		return s.repo.SetQueueAttributes(ctx, queueURL, map[string]string{"RedrivePolicy": ""})
+	}
+
+	name := extractQueueName(queueURL)
+	encoded, err := encodeRedrivePolicy(name, queueTypeOfName(name), *policy)
+	if err != nil {
+		return err
+	}
+	return s.repo.SetQueueAttributes(ctx, queueURL, map[string]string{"RedrivePolicy": encoded})
+}
+
+// encodeRedrivePolicy checks policy for the queue called sourceName and returns it as the JSON
+// SQS expects in the RedrivePolicy attribute. SQS requires the dead-letter queue to be of the
+// same type as its source.
+func encodeRedrivePolicy(sourceName string, sourceType QueueType, policy RedrivePolicy) (string, error) {
+	targetName, err := queueNameFromArn(policy.DeadLetterTargetArn)
+	if err != nil {
+		return "", err
+	}
+	if policy.MaxReceiveCount < 1 || policy.MaxReceiveCount > maxRedriveReceiveCount {
+		return "", errors.Newf("maximum receives must be between 1 and %d", maxRedriveReceiveCount)
+	}
+	if targetName == sourceName {
+		return "", errors.New("a queue cannot be its own dead-letter queue")
+	}
+	if queueTypeOfName(targetName) != sourceType {
+		if sourceType == QueueTypeFIFO {
+			return "", errors.New("the dead-letter queue of a FIFO queue must be a FIFO queue")
+		}
+		return "", errors.New("the dead-letter queue of a standard queue must be a standard queue")
+	}
+
+	encoded, err := json.Marshal(struct {
+		DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+		MaxReceiveCount     int    `json:"maxReceiveCount"`
+	}{policy.DeadLetterTargetArn, policy.MaxReceiveCount})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode redrive policy")
+	}
+	return string(encoded), nil
+}
+
+// queueNameFromArn returns the queue name of an SQS queue ARN
+// (arn:partition:sqs:region:account:name).
+func queueNameFromArn(arn string) (string, error) {
+	parts := strings.Split(strings.TrimSpace(arn), ":")
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "sqs" || parts[5] == "" {
+		return "", errors.Newf("%q is not an SQS queue ARN", arn)
+	}
+	return parts[5], nil
+}
+
+func queueTypeOfName(name string) QueueType {
+	if strings.HasSuffix(name, ".fifo") {
+		return QueueTypeFIFO
+	}
+	return QueueTypeStandard
+}
+
+// siblingQueueURL returns the URL of the queue called name next to queueURL. SQS only accepts a
+// dead-letter queue in the account and region of its source, so its URL differs in the name alone.
+func siblingQueueURL(queueURL, name string) string {
+	i := strings.LastIndex(queueURL, "/")
+	if i < 0 {
+		return ""
+	}
+	return queueURL[:i+1] + name
+}
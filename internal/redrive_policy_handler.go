@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// PostRedrivePolicyHandler handles the dead-letter queue form on the queue page. It sets the
+// redrive policy, or removes it when the form is submitted with action=remove.
+func (h *HandlerImpl) PostRedrivePolicyHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form submission", http.StatusBadRequest)
+		return
+	}
+
+	var policy *RedrivePolicy
+	outcome := "removed"
+	if r.PostForm.Get("action") != "remove" {
+		policy, err = parseRedrivePolicyForm(strings.TrimSpace(r.PostForm.Get("dead_letter_target_arn")), strings.TrimSpace(r.PostForm.Get("max_receive_count")))
+		if err == nil && policy == nil {
+			err = errors.New("Choose a dead-letter queue.")
+		}
+		if err != nil {
+			h.renderQueueError(w, r, queueURL, http.StatusBadRequest, err)
+			return
+		}
+		outcome = "saved"
+	}
+
+	if err := h.s.SetRedrivePolicy(r.Context(), queueURL, policy); err != nil {
+		slog.ErrorContext(r.Context(), "failed to set redrive policy", slog.String("queue_url", queueURL), slog.Any("error", err))
+		h.renderQueueError(w, r, queueURL, serviceErrorStatus(err), err)
+		return
+	}
+
+	redirectURL := fmt.Sprintf("/queues/%s?redrive=%s", url.QueryEscape(queueURL), outcome)
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+// parseRedrivePolicyForm reads the dead-letter queue fields of a form. No queue means no redrive
+// policy; a queue needs a maximum receive count.
+func parseRedrivePolicyForm(targetArn, maxReceiveCount string) (*RedrivePolicy, error) {
+	if targetArn == "" {
+		return nil, nil
+	}
+	count, err := parseOptionalInt32(maxReceiveCount, 1, maxRedriveReceiveCount, fmt.Sprintf("Maximum receives must be between 1 and %d.", maxRedriveReceiveCount))
+	if err != nil {
+		return nil, err
+	}
+	if count == nil {
+		return nil, errors.New("Maximum receives is required with a dead-letter queue.")
+	}
+	return &RedrivePolicy{DeadLetterTargetArn: targetArn, MaxReceiveCount: int(*count)}, nil
+}
+
+// deadLetterOptions lists the queues a dead-letter queue can be picked from. A failure only
+// leaves the list empty, so the page still shows.
+func (h *HandlerImpl) deadLetterOptions(r *http.Request, sourceURL string) []deadLetterOption {
+	queues, err := h.s.DeadLetterCandidates(r.Context(), sourceURL)
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to list dead-letter queue candidates", slog.Any("error", err))
+		return nil
+	}
+	options := make([]deadLetterOption, 0, len(queues))
+	for _, queue := range queues {
+		options = append(options, deadLetterOption{Name: queue.Name, Arn: queue.Arn, Type: string(queue.Type)})
+	}
+	return options
+}
+
+// deadLetterSources lists the queues that redrive into the queue. A failure, such as an emulator
+// without ListDeadLetterSourceQueues, only leaves the list empty, so the page still shows.
+func (h *HandlerImpl) deadLetterSources(r *http.Request, queueURL string) []deadLetterSourceView {
+	sources, err := h.s.DeadLetterSourceQueues(r.Context(), queueURL)
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to list dead-letter source queues", slog.String("queue_url", queueURL), slog.Any("error", err))
+		return nil
+	}
+	views := make([]deadLetterSourceView, 0, len(sources))
+	for _, source := range sources {
+		views = append(views, deadLetterSourceView{Name: source.Name, URL: url.QueryEscape(source.URL)})
+	}
+	return views
+}
+
+// deadLetterTarget describes the dead-letter queue of policy for the queue page.
+func deadLetterTarget(queueURL string, policy *RedrivePolicy) *deadLetterTargetView {
+	if policy == nil {
+		return nil
+	}
+	view := &deadLetterTargetView{Arn: policy.DeadLetterTargetArn, MaxReceiveCount: policy.MaxReceiveCount}
+	if name, err := queueNameFromArn(policy.DeadLetterTargetArn); err == nil {
+		view.Name = name
+		view.EscapedURL = url.QueryEscape(siblingQueueURL(queueURL, name))
+	}
+	return view
+}
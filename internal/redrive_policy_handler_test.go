@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_PostRedrivePolicyHandler(t *testing.T) {
+	queueURL := "https://sqs.local/000000000000/orders"
+	escaped := url.QueryEscape(queueURL)
+	dlqArn := "arn:aws:sqs:us-east-1:000000000000:orders-dlq"
+
+	newRequest := func(form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/redrive-policy", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("saves the dead-letter queue", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().SetRedrivePolicy(mock.Anything, queueURL, &RedrivePolicy{DeadLetterTargetArn: dlqArn, MaxReceiveCount: 4}).Return(nil).Once()
+
+		handler.PostRedrivePolicyHandler(rr, newRequest(url.Values{"dead_letter_target_arn": {dlqArn}, "max_receive_count": {"4"}}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues/"+escaped+"?redrive=saved", rr.Header().Get("Location"))
+	})
+
+	t.Run("removes the redrive policy", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().SetRedrivePolicy(mock.Anything, queueURL, (*RedrivePolicy)(nil)).Return(nil).Once()
+
+		handler.PostRedrivePolicyHandler(rr, newRequest(url.Values{"dead_letter_target_arn": {dlqArn}, "action": {"remove"}}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues/"+escaped+"?redrive=removed", rr.Header().Get("Location"))
+	})
+
+	t.Run("asks for the maximum receives", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured queuePageData
+		captureQueueTemplate(t, &captured)
+		installQueueFragment(t, "")
+		mockService.EXPECT().QueueDetail(mock.Anything, queueURL).
+			Return(QueueDetail{QueueSummary: QueueSummary{URL: queueURL, Name: "orders", Type: QueueTypeStandard}}, nil).Once()
+		mockService.EXPECT().EndpointCapabilities(mock.Anything).Return(EndpointCapabilities{}).Once()
+		mockService.EXPECT().DeadLetterCandidates(mock.Anything, queueURL).Return(nil, nil).Once()
+		mockService.EXPECT().DeadLetterSourceQueues(mock.Anything, queueURL).Return(nil, nil).Once()
+
+		handler.PostRedrivePolicyHandler(rr, newRequest(url.Values{"dead_letter_target_arn": {dlqArn}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "Maximum receives is required with a dead-letter queue.", captured.ErrorMessage)
+	})
+}
+
+func TestDeadLetterTarget(t *testing.T) {
+	assert.Nil(t, deadLetterTarget("https://sqs.local/000000000000/orders", nil))
+	assert.Equal(t, &deadLetterTargetView{
+		Name:            "orders-dlq",
+		Arn:             "arn:aws:sqs:us-east-1:000000000000:orders-dlq",
+		EscapedURL:      url.QueryEscape("https://sqs.local/000000000000/orders-dlq"),
+		MaxReceiveCount: 5,
+	}, deadLetterTarget("https://sqs.local/000000000000/orders", &RedrivePolicy{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", MaxReceiveCount: 5}))
+}
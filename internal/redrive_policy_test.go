@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_DeadLetterCandidates(t *testing.T) {
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo}
+
+	queues := []QueueSummary{
+		{Name: "orders", URL: "https://sqs.local/000000000000/orders", Arn: "arn:aws:sqs:us-east-1:000000000000:orders", Type: QueueTypeStandard},
+		{Name: "orders-dlq", URL: "https://sqs.local/000000000000/orders-dlq", Arn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", Type: QueueTypeStandard},
+		{Name: "billing-dlq", URL: "https://sqs.local/000000000000/billing-dlq", Arn: "arn:aws:sqs:us-east-1:000000000000:billing-dlq", Type: QueueTypeStandard},
+		{Name: "events.fifo", URL: "https://sqs.local/000000000000/events.fifo", Arn: "arn:aws:sqs:us-east-1:000000000000:events.fifo", Type: QueueTypeFIFO},
+	}
+	repo.EXPECT().ListQueues(mock.Anything).Return(queues, nil).Twice()
+
+	all, err := service.DeadLetterCandidates(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"billing-dlq", "events.fifo", "orders", "orders-dlq"}, queueNames(all))
+
+	forOrders, err := service.DeadLetterCandidates(context.Background(), "https://sqs.local/000000000000/orders")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"billing-dlq", "orders-dlq"}, queueNames(forOrders), "the queue itself and FIFO queues are left out")
+}
+
+func queueNames(queues []QueueSummary) []string {
+	names := make([]string, 0, len(queues))
+	for _, queue := range queues {
+		names = append(names, queue.Name)
+	}
+	return names
+}
+
+func TestSqsServiceImpl_SetRedrivePolicy(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders.fifo"
+
+	t.Run("sets the policy", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().SetQueueAttributes(mock.Anything, queueURL, map[string]string{
+			"RedrivePolicy": `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo","maxReceiveCount":3}`,
+		}).Return(nil).Once()
+
+		require.NoError(t, service.SetRedrivePolicy(ctx, queueURL, &RedrivePolicy{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo", MaxReceiveCount: 3}))
+	})
+
+	t.Run("removes the policy", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+		repo.EXPECT().SetQueueAttributes(mock.Anything, queueURL, map[string]string{"RedrivePolicy": ""}).Return(nil).Once()
+
+		require.NoError(t, service.SetRedrivePolicy(ctx, queueURL, nil))
+	})
+
+	t.Run("rejects policies SQS would refuse", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+		for policy, message := range map[RedrivePolicy]string{
+			{DeadLetterTargetArn: "orders-dlq.fifo", MaxReceiveCount: 3}:                                       `"orders-dlq.fifo" is not an SQS queue ARN`,
+			{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo", MaxReceiveCount: 0}:    "maximum receives must be between 1 and 1000",
+			{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq.fifo", MaxReceiveCount: 1001}: "maximum receives must be between 1 and 1000",
+			{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders.fifo", MaxReceiveCount: 3}:        "a queue cannot be its own dead-letter queue",
+			{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", MaxReceiveCount: 3}:         "the dead-letter queue of a FIFO queue must be a FIFO queue",
+		} {
+			err := service.SetRedrivePolicy(ctx, queueURL, &policy)
+			require.Error(t, err)
+			assert.Equal(t, message, err.Error())
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// requestIDHeader carries the request ID in both directions.
+const requestIDHeader = "X-Request-Id"
+
+// validRequestID accepts request IDs passed in by a proxy; anything else is replaced.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// requestLogKey carries the log attributes of the request being served.
+type requestLogKey struct{}
+
+// requestLogAttrs identify the request a log record was written for.
+type requestLogAttrs struct {
+	id         string
+	operation  string
+	queue      string
+	remoteAddr string
+}
+
+// requestLogMiddleware gives every request an ID, returned in X-Request-Id, and keeps it in the
+// request context with the route, the queue the request is about and the client address. Records
+// logged with that context carry them, see NewRequestLogHandler, so every log line of one user
+// action can be found by its request ID. Jobs started by a request keep its ID.
+func requestLogMiddleware(mux *http.ServeMux, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(requestIDHeader, id)
+
+		_, pattern := mux.Handler(r)
+		attrs := &requestLogAttrs{
+			id:         id,
+			operation:  pattern,
+			queue:      queueNameFromPath(r.URL.EscapedPath()),
+			remoteAddr: r.RemoteAddr,
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestLogKey{}, attrs)))
+	})
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// queueNameFromPath finds the escaped queue URL in a path such as /queues/{url}/purge.
+func queueNameFromPath(escapedPath string) string {
+	for _, segment := range strings.Split(escapedPath, "/") {
+		decoded, err := url.PathUnescape(segment)
+		if err != nil {
+			continue
+		}
+		if queueURL, err := url.QueryUnescape(decoded); err == nil {
+			decoded = queueURL
+		}
+		if parsed, err := url.Parse(decoded); err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != "" {
+			return extractQueueName(decoded)
+		}
+	}
+	return ""
+}
+
+// requestLogHandler adds the attributes of the request in the context to every record.
+type requestLogHandler struct {
+	next slog.Handler
+}
+
+// NewRequestLogHandler wraps next so records logged with a request context, through the Context
+// variants of the slog functions, carry request_id, operation, queue and remote_addr.
+func NewRequestLogHandler(next slog.Handler) slog.Handler {
+	return &requestLogHandler{next: next}
+}
+
+func (h *requestLogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *requestLogHandler) Handle(ctx context.Context, record slog.Record) error {
+	attrs, ok := ctx.Value(requestLogKey{}).(*requestLogAttrs)
+	if !ok {
+		return h.next.Handle(ctx, record)
+	}
+
+	record = record.Clone()
+	record.AddAttrs(slog.String("request_id", attrs.id))
+	if attrs.operation != "" {
+		record.AddAttrs(slog.String("operation", attrs.operation))
+	}
+	if attrs.queue != "" {
+		record.AddAttrs(slog.String("queue", attrs.queue))
+	}
+	record.AddAttrs(slog.String("remote_addr", attrs.remoteAddr))
+	return h.next.Handle(ctx, record)
+}
+
+func (h *requestLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &requestLogHandler{next: h.next.WithAttrs(attrs)}
+}
+
+func (h *requestLogHandler) WithGroup(name string) slog.Handler {
+	return &requestLogHandler{next: h.next.WithGroup(name)}
+}
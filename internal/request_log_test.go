@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLogMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewRequestLogHandler(slog.NewJSONHandler(&buf, nil)))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /queues/{url}/purge", func(w http.ResponseWriter, r *http.Request) {
+		logger.InfoContext(r.Context(), "purging")
+		logger.Info("without context")
+	})
+	handler := requestLogMiddleware(mux, mux)
+
+	readRecords := func() []map[string]any {
+		var records []map[string]any
+		decoder := json.NewDecoder(&buf)
+		for decoder.More() {
+			var record map[string]any
+			require.NoError(t, decoder.Decode(&record))
+			records = append(records, record)
+		}
+		return records
+	}
+
+	t.Run("adds the request attributes to records logged with the request context", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+url.QueryEscape("https://sqs.local/000000000000/orders")+"/purge", nil)
+		req.RemoteAddr = "192.0.2.1:51234"
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		id := rr.Header().Get(requestIDHeader)
+		assert.Len(t, id, 16)
+		records := readRecords()
+		require.Len(t, records, 2)
+		assert.Equal(t, id, records[0]["request_id"])
+		assert.Equal(t, "POST /queues/{url}/purge", records[0]["operation"])
+		assert.Equal(t, "orders", records[0]["queue"])
+		assert.Equal(t, "192.0.2.1:51234", records[0]["remote_addr"])
+		assert.NotContains(t, records[1], "request_id")
+	})
+
+	t.Run("keeps a request id passed in", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+url.QueryEscape("https://sqs.local/000000000000/orders")+"/purge", nil)
+		req.Header.Set(requestIDHeader, "proxy-123")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, "proxy-123", rr.Header().Get(requestIDHeader))
+		assert.Equal(t, "proxy-123", readRecords()[0]["request_id"])
+	})
+
+	t.Run("replaces an invalid request id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/status", nil)
+		req.Header.Set(requestIDHeader, "has spaces")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Len(t, rr.Header().Get(requestIDHeader), 16)
+		buf.Reset()
+	})
+}
+
+func TestQueueNameFromPath(t *testing.T) {
+	assert.Equal(t, "orders.fifo", queueNameFromPath("/queues/"+url.QueryEscape("https://sqs.local/000000000000/orders.fifo")+"/send-receive"))
+	assert.Equal(t, "orders", queueNameFromPath("/api/v1/queues/"+url.PathEscape(url.QueryEscape("http://localhost:9324/000000000000/orders"))+"/purge"))
+	assert.Empty(t, queueNameFromPath("/trash/abc123/restore"))
+}
@@ -0,0 +1,329 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// maxRestoreMessages bounds how many messages one file may restore, matching what a drain writes.
+	maxRestoreMessages = maxDrainToFileMessages
+	// maxRestoreLineBytes leaves room for a maximum size body with JSON escaping and attributes.
+	maxRestoreLineBytes = 4 * maxMessageBodyBytes
+	// maxRestoreFailureDetails is how many rejected messages a restore lists.
+	maxRestoreFailureDetails = 20
+)
+
+// RestoreFileInput names the queue to replay a file of messages into. File holds one JSON message
+// per line, as a drain to file writes them, or a JSON array of messages, or the output of aws sqs
+// receive-message, an object with a Messages array. A message has the shape a drain writes or the
+// one SQS returns.
+type RestoreFileInput struct {
+	QueueURL string
+	File     io.Reader
+}
+
+// restoreFile is a checked file of messages. The IDs of its entries are numbers that count unit,
+// lines of a newline-delimited file or messages of a JSON document, and MessageIDs holds the
+// original message ID of each entry that had one.
+type restoreFile struct {
+	Entries    []SendMessageBatchEntry
+	Unit       string
+	MessageIDs map[string]string
+}
+
+// restoreResult is one line of the results file of a restore: whether the message at Entry, a
+// line or position in the uploaded file, was sent, and why SQS rejected it if not.
+type restoreResult struct {
+	Entry     int    `json:"entry"`
+	MessageID string `json:"messageId,omitempty"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+// StartRestoreFromFile reads a file of messages and sends them to a queue in the background, with
+// their custom attributes and data types. FIFO queues get each message's group ID and, so that a
+// repeated restore within the deduplication interval does not send twice, its deduplication ID or
+// else its original message ID. The file is read and checked before the job starts, and the job
+// writes the outcome of every message to a newline-delimited JSON file offered as a download.
+func (s *SqsServiceImpl) StartRestoreFromFile(ctx context.Context, input RestoreFileInput) (Job, error) {
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return Job{}, errors.New("queue url is required")
+	}
+	if input.File == nil {
+		return Job{}, errors.New("a file is required")
+	}
+
+	restore, err := readRestoreFile(input.File, strings.HasSuffix(queueURL, ".fifo"))
+	if err != nil {
+		return Job{}, err
+	}
+
+	return s.jobs.start(ctx, "restore-file", queueURL, func(ctx context.Context, progress *JobProgress) error {
+		progress.SetTotal(int64(len(restore.Entries)))
+
+		file, err := os.CreateTemp("", "sqs-gui-restore-*.ndjson")
+		if err != nil {
+			return errors.Wrap(err, "failed to create the results file")
+		}
+		progress.SetFile(file.Name())
+
+		restoreErr := s.restoreFromFile(ctx, queueURL, restore, file, progress)
+		if err := file.Close(); err != nil && restoreErr == nil {
+			return errors.Wrap(err, "failed to close the results file")
+		}
+		return restoreErr
+	})
+}
+
+func (s *SqsServiceImpl) restoreFromFile(ctx context.Context, queueURL string, restore restoreFile, results io.Writer, progress *JobProgress) error {
+	writer := bufio.NewWriter(results)
+	encoder := json.NewEncoder(writer)
+	sent, failed := 0, 0
+	summary := func() string {
+		message := fmt.Sprintf("Restored %d of %d messages.", sent, len(restore.Entries))
+		if failed > 0 {
+			message += fmt.Sprintf(" SQS rejected %d.", failed)
+		}
+		return message
+	}
+
+	for start := 0; start < len(restore.Entries); start += sqsBatchSize {
+		progress.SetMessage(summary())
+		chunk := restore.Entries[start:min(start+sqsBatchSize, len(restore.Entries))]
+		failures, err := s.sendBatchWithRetry(ctx, queueURL, chunk)
+		if err != nil {
+			return errors.Wrap(err, summary())
+		}
+
+		// Entry IDs are line numbers or positions.
+		rejected := make(map[int]BatchSendFailure, len(failures))
+		for _, failure := range failures {
+			rejected[failure.Index] = failure
+			failed++
+			if failed <= maxRestoreFailureDetails {
+				progress.AddDetail(fmt.Sprintf("%s %d: %s %s", restore.Unit, failure.Index, failure.Code, failure.Message))
+			}
+		}
+		for _, entry := range chunk {
+			index, _ := strconv.Atoi(entry.ID)
+			result := restoreResult{Entry: index, MessageID: restore.MessageIDs[entry.ID], Status: "sent"}
+			if failure, ok := rejected[index]; ok {
+				result.Status = "failed"
+				result.Error = failure.Code + " " + failure.Message
+			}
+			if err := encoder.Encode(result); err != nil {
+				return errors.Wrap(err, "failed to write the results file")
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			return errors.Wrap(err, "failed to write the results file")
+		}
+		sent += len(chunk) - len(failures)
+		progress.Advance(int64(len(chunk)))
+	}
+
+	progress.SetMessage(summary())
+	return nil
+}
+
+// readRestoreFile tells a JSON document from newline-delimited JSON by how the file starts: with
+// [, or with a { alone on its line as a pretty-printed object is.
+func readRestoreFile(file io.Reader, fifo bool) (restoreFile, error) {
+	reader := bufio.NewReaderSize(file, 64*1024)
+	head, _ := reader.Peek(reader.Size())
+	head = bytes.TrimLeft(head, " \t\r\n")
+	if len(head) > 0 && head[0] == '[' {
+		return readRestoreDocument(reader, fifo)
+	}
+	if len(head) > 0 && head[0] == '{' {
+		if line, _, _ := bytes.Cut(head[1:], []byte("\n")); len(bytes.TrimSpace(line)) == 0 {
+			return readRestoreDocument(reader, fifo)
+		}
+	}
+	return readRestoreLines(reader, fifo)
+}
+
+// readRestoreLines turns the lines of a drain file into batch entries whose IDs are line numbers.
+// Blank lines are skipped.
+func readRestoreLines(file io.Reader, fifo bool) (restoreFile, error) {
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRestoreLineBytes)
+
+	restore := restoreFile{Unit: "line", MessageIDs: make(map[string]string)}
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		if err := restore.add(raw, line, fifo); err != nil {
+			return restoreFile{}, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return restoreFile{}, errors.Newf("a line of the file is longer than %d bytes", maxRestoreLineBytes)
+		}
+		return restoreFile{}, errors.Wrap(err, "failed to read the file")
+	}
+	if len(restore.Entries) == 0 {
+		return restoreFile{}, errors.New("the file has no messages")
+	}
+	return restore, nil
+}
+
+// readRestoreDocument turns a JSON array of messages, or an object with a Messages array, into
+// batch entries whose IDs are the positions of the messages, counted from 1. The messages are
+// decoded one at a time rather than the document at once.
+func readRestoreDocument(file io.Reader, fifo bool) (restoreFile, error) {
+	decoder := json.NewDecoder(file)
+	invalid := errors.New("the file is not a JSON array of messages, an object with a Messages array or one JSON message per line")
+
+	token, err := decoder.Token()
+	if err != nil {
+		return restoreFile{}, invalid
+	}
+	if token == json.Delim('{') {
+		// Skip to the Messages array of an object as aws sqs receive-message prints it.
+		for {
+			key, err := decoder.Token()
+			if err != nil || key == json.Delim('}') {
+				return restoreFile{}, invalid
+			}
+			if key == "Messages" {
+				break
+			}
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return restoreFile{}, invalid
+			}
+		}
+		if token, err = decoder.Token(); err != nil {
+			return restoreFile{}, invalid
+		}
+	}
+	if token != json.Delim('[') {
+		return restoreFile{}, invalid
+	}
+
+	restore := restoreFile{Unit: "message", MessageIDs: make(map[string]string)}
+	for position := 1; decoder.More(); position++ {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return restoreFile{}, errors.Newf("the file is not valid JSON after message %d", position-1)
+		}
+		if err := restore.add(raw, position, fifo); err != nil {
+			return restoreFile{}, err
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return restoreFile{}, invalid
+	}
+	if len(restore.Entries) == 0 {
+		return restoreFile{}, errors.New("the file has no messages")
+	}
+	return restore, nil
+}
+
+// add checks one message of the file, the one at index in its unit, and appends it as an entry.
+// A message with a Body field has the shape SQS returns, with custom attributes in
+// MessageAttributes and the group and deduplication IDs among its system Attributes; any other
+// has the shape a drain writes.
+func (r *restoreFile) add(raw []byte, index int, fifo bool) error {
+	if len(r.Entries) == maxRestoreMessages {
+		return errors.Newf("the file has more than %d messages", maxRestoreMessages)
+	}
+	where := fmt.Sprintf("%s %d", r.Unit, index)
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return errors.Newf("%s is not a JSON message", where)
+	}
+	var message drainedMessage
+	if _, ok := fields["Body"]; ok {
+		var received rawMessage
+		if err := json.Unmarshal(raw, &received); err != nil {
+			return errors.Newf("%s is not a JSON message", where)
+		}
+		converted, err := drainedMessageFromRaw(received)
+		if err != nil {
+			return errors.Wrap(err, where)
+		}
+		message = converted
+	} else if err := json.Unmarshal(raw, &message); err != nil {
+		return errors.Newf("%s is not a JSON message", where)
+	}
+	if message.Body == "" {
+		return errors.Newf("%s has no body", where)
+	}
+	for name, value := range message.Attributes {
+		if messageAttributeBaseType(message.AttributeTypes[name]) != "Binary" {
+			continue
+		}
+		if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+			return errors.Newf("%s has attribute %s, which is not valid base64", where, name)
+		}
+	}
+
+	id := strconv.Itoa(index)
+	entry := SendMessageBatchEntry{ID: id, Body: message.Body, Attributes: message.Attributes, AttributeTypes: message.AttributeTypes}
+	if fifo {
+		if message.MessageGroupID == "" {
+			return errors.Newf("%s has no messageGroupId, which a FIFO queue needs", where)
+		}
+		entry.MessageGroupID = message.MessageGroupID
+		entry.MessageDeduplicationID = message.MessageDeduplicationID
+		if entry.MessageDeduplicationID == "" {
+			entry.MessageDeduplicationID = message.MessageID
+		}
+	}
+	if message.MessageID != "" {
+		r.MessageIDs[id] = message.MessageID
+	}
+	r.Entries = append(r.Entries, entry)
+	return nil
+}
+
+// drainedMessageFromRaw turns a message in the shape SQS returns into the one a drain writes.
+// List values are reserved by SQS and cannot be sent.
+func drainedMessageFromRaw(raw rawMessage) (drainedMessage, error) {
+	message := drainedMessage{
+		MessageID:              raw.MessageID,
+		Body:                   raw.Body,
+		MessageGroupID:         raw.Attributes["MessageGroupId"],
+		MessageDeduplicationID: raw.Attributes["MessageDeduplicationId"],
+	}
+	for name, value := range raw.MessageAttributes {
+		var text string
+		switch {
+		case value.StringValue != nil:
+			text = *value.StringValue
+		case value.BinaryValue != nil:
+			text = base64.StdEncoding.EncodeToString(value.BinaryValue)
+		default:
+			return drainedMessage{}, errors.Newf("attribute %s has no string or binary value", name)
+		}
+		if message.Attributes == nil {
+			message.Attributes = make(map[string]string)
+		}
+		message.Attributes[name] = text
+		if value.DataType != "" && value.DataType != "String" {
+			if message.AttributeTypes == nil {
+				message.AttributeTypes = make(map[string]string)
+			}
+			message.AttributeTypes[name] = value.DataType
+		}
+	}
+	return message, nil
+}
@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// maxRestoreFileBytes bounds the size of an uploaded drain file.
+	maxRestoreFileBytes = 256 << 20
+	// restoreFileMemoryBytes is how much of an upload is kept in memory before it goes to a temporary file.
+	restoreFileMemoryBytes = 32 << 20
+)
+
+type restoreFilePageData struct {
+	Title        string
+	ViteTags     template.HTML
+	ErrorMessage string
+	QueueName    string
+	EscapedURL   string
+	JobID        string
+}
+
+// RestoreFileHandler renders the form that replays a drain file into a queue.
+func (h *HandlerImpl) RestoreFileHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	h.renderRestoreFile(w, http.StatusOK, newRestoreFilePageData(queueURL))
+}
+
+// PostRestoreFileHandler checks an uploaded drain file and starts sending its messages.
+func (h *HandlerImpl) PostRestoreFileHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	data := newRestoreFilePageData(queueURL)
+	r.Body = http.MaxBytesReader(w, r.Body, maxRestoreFileBytes)
+	if err := r.ParseMultipartForm(restoreFileMemoryBytes); err != nil {
+		data.ErrorMessage = "Upload a file of at most 256 MB."
+		h.renderRestoreFile(w, http.StatusBadRequest, data)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		data.ErrorMessage = "Choose a file to restore."
+		h.renderRestoreFile(w, http.StatusBadRequest, data)
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	job, err := h.s.StartRestoreFromFile(r.Context(), RestoreFileInput{QueueURL: queueURL, File: file})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to start restore from file", slog.String("queue_url", queueURL), slog.Any("error", err))
+		data.ErrorMessage = err.Error()
+		h.renderRestoreFile(w, serviceErrorStatus(err), data)
+		return
+	}
+
+	data.JobID = job.ID
+	h.renderRestoreFile(w, http.StatusOK, data)
+}
+
+func newRestoreFilePageData(queueURL string) restoreFilePageData {
+	return restoreFilePageData{
+		Title:      "Restore from file",
+		ViteTags:   fragments["assets/js/restore_file.ts"].Tags,
+		QueueName:  extractQueueName(queueURL),
+		EscapedURL: url.QueryEscape(queueURL),
+	}
+}
+
+func (h *HandlerImpl) renderRestoreFile(w http.ResponseWriter, status int, data restoreFilePageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["restore-file"].Execute(w, data); err != nil {
+		slog.Error("failed to render restore-file template", slog.Any("error", err))
+	}
+}
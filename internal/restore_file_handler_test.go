@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerImpl_PostRestoreFileHandler(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(t *testing.T, content string) *http.Request {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		if content != "" {
+			part, err := writer.CreateFormFile("file", "drain.ndjson")
+			require.NoError(t, err)
+			_, err = io.WriteString(part, content)
+			require.NoError(t, err)
+		}
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/restore-file", &body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("starts the restore", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured restoreFilePageData
+		captureTemplate(t, "restore-file", func(data restoreFilePageData) { captured = data })
+		installFragment(t, "assets/js/restore_file.ts", "")
+
+		mockService.EXPECT().StartRestoreFromFile(mock.Anything, mock.MatchedBy(func(input RestoreFileInput) bool {
+			content, err := io.ReadAll(input.File)
+			return err == nil && input.QueueURL == queueURL && string(content) == `{"body":"one"}`
+		})).Return(Job{ID: "job-1"}, nil).Once()
+
+		handler.PostRestoreFileHandler(rr, newRequest(t, `{"body":"one"}`))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "job-1", captured.JobID)
+	})
+
+	t.Run("requires a file", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		rr := httptest.NewRecorder()
+
+		var captured restoreFilePageData
+		captureTemplate(t, "restore-file", func(data restoreFilePageData) { captured = data })
+		installFragment(t, "assets/js/restore_file.ts", "")
+
+		handler.PostRestoreFileHandler(rr, newRequest(t, ""))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "Choose a file to restore.", captured.ErrorMessage)
+	})
+}
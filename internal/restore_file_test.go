@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_StartRestoreFromFile(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	ctx := context.Background()
+
+	t.Run("sends every line with its group and deduplication id", func(t *testing.T) {
+		queueURL := "https://sqs.local/orders.fifo"
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}
+
+		file := `{"messageId":"m-1","body":"one","attributes":{"kind":"order"},"messageGroupId":"customer-7","receiveCount":1}` + "\n" +
+			"\n" +
+			`{"messageId":"m-2","body":"two","messageGroupId":"customer-8","messageDeduplicationId":"d-2","receiveCount":2}` + "\n"
+
+		repo.EXPECT().SendMessageBatch(mock.Anything, SendMessageBatchRepositoryInput{
+			QueueURL: queueURL,
+			Entries: []SendMessageBatchEntry{
+				{ID: "1", Body: "one", Attributes: map[string]string{"kind": "order"}, MessageGroupID: "customer-7", MessageDeduplicationID: "m-1"},
+				{ID: "3", Body: "two", MessageGroupID: "customer-8", MessageDeduplicationID: "d-2"},
+			},
+		}).Return(nil, nil).Once()
+
+		job, err := service.StartRestoreFromFile(ctx, RestoreFileInput{QueueURL: queueURL, File: strings.NewReader(file)})
+		require.NoError(t, err)
+		assert.Equal(t, "restore-file", job.Kind)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, int64(2), job.Done)
+		assert.Equal(t, "Restored 2 of 2 messages.", job.Message)
+	})
+
+	t.Run("lists rejected lines", func(t *testing.T) {
+		queueURL := "https://sqs.local/orders"
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}
+
+		repo.EXPECT().SendMessageBatch(mock.Anything, mock.Anything).Return([]SendMessageBatchFailure{
+			{ID: "2", Code: "InvalidMessageContents", Message: "bad character", SenderFault: true},
+		}, nil).Once()
+
+		job, err := service.StartRestoreFromFile(ctx, RestoreFileInput{
+			QueueURL: queueURL,
+			File:     strings.NewReader(`{"body":"one","messageGroupId":"ignored"}` + "\n" + `{"body":"two"}`),
+		})
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, "Restored 1 of 2 messages. SQS rejected 1.", job.Message)
+		assert.Equal(t, []string{"line 2: InvalidMessageContents bad character"}, job.Details)
+
+		results, err := os.ReadFile(job.File)
+		require.NoError(t, err)
+		assert.Equal(t, `{"entry":1,"status":"sent"}`+"\n"+`{"entry":2,"status":"failed","error":"InvalidMessageContents bad character"}`+"\n", string(results))
+	})
+
+	t.Run("sends a JSON array of messages as SQS returns them", func(t *testing.T) {
+		queueURL := "https://sqs.local/orders.fifo"
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}
+
+		file := `[
+  {
+    "MessageId": "m-1",
+    "Body": "one",
+    "Attributes": {"MessageGroupId": "customer-7", "SentTimestamp": "1700000000000"},
+    "MessageAttributes": {
+      "kind": {"DataType": "String", "StringValue": "order"},
+      "total": {"DataType": "Number", "StringValue": "12.5"},
+      "blob": {"DataType": "Binary", "BinaryValue": "AQI="}
+    }
+  },
+  {"messageId": "m-2", "body": "two", "attributes": {"count": "3"}, "attributeTypes": {"count": "Number"}, "messageGroupId": "customer-8"}
+]`
+
+		repo.EXPECT().SendMessageBatch(mock.Anything, SendMessageBatchRepositoryInput{
+			QueueURL: queueURL,
+			Entries: []SendMessageBatchEntry{
+				{
+					ID:                     "1",
+					Body:                   "one",
+					Attributes:             map[string]string{"kind": "order", "total": "12.5", "blob": "AQI="},
+					AttributeTypes:         map[string]string{"total": "Number", "blob": "Binary"},
+					MessageGroupID:         "customer-7",
+					MessageDeduplicationID: "m-1",
+				},
+				{
+					ID:                     "2",
+					Body:                   "two",
+					Attributes:             map[string]string{"count": "3"},
+					AttributeTypes:         map[string]string{"count": "Number"},
+					MessageGroupID:         "customer-8",
+					MessageDeduplicationID: "m-2",
+				},
+			},
+		}).Return([]SendMessageBatchFailure{
+			{ID: "2", Code: "InvalidParameterValue", Message: "bad number", SenderFault: true},
+		}, nil).Once()
+
+		job, err := service.StartRestoreFromFile(ctx, RestoreFileInput{QueueURL: queueURL, File: strings.NewReader(file)})
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusSucceeded, job.Status)
+		assert.Equal(t, []string{"message 2: InvalidParameterValue bad number"}, job.Details)
+
+		results, err := os.ReadFile(job.File)
+		require.NoError(t, err)
+		assert.Equal(t, `{"entry":1,"messageId":"m-1","status":"sent"}`+"\n"+`{"entry":2,"messageId":"m-2","status":"failed","error":"InvalidParameterValue bad number"}`+"\n", string(results))
+	})
+
+	t.Run("sends the messages of aws sqs receive-message output", func(t *testing.T) {
+		queueURL := "https://sqs.local/orders"
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo, jobs: newJobRegistry()}
+
+		file := `{
+    "ResponseMetadata": {"RequestId": "r-1"},
+    "Messages": [
+        {"MessageId": "m-1", "ReceiptHandle": "rh-1", "Body": "one"}
+    ]
+}`
+
+		repo.EXPECT().SendMessageBatch(mock.Anything, SendMessageBatchRepositoryInput{
+			QueueURL: queueURL,
+			Entries:  []SendMessageBatchEntry{{ID: "1", Body: "one"}},
+		}).Return(nil, nil).Once()
+
+		job, err := service.StartRestoreFromFile(ctx, RestoreFileInput{QueueURL: queueURL, File: strings.NewReader(file)})
+		require.NoError(t, err)
+
+		service.jobs.wg.Wait()
+		job, err = service.Job(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Restored 1 of 1 messages.", job.Message)
+	})
+
+	t.Run("rejects a malformed file before starting", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			queueURL string
+			file     string
+			want     string
+		}{
+			{name: "not json", queueURL: "https://sqs.local/orders", file: `{"body":"one"}` + "\n" + "one", want: "line 2 is not a JSON message"},
+			{name: "no body", queueURL: "https://sqs.local/orders", file: `{"messageId":"m-1"}`, want: "line 1 has no body"},
+			{name: "no group", queueURL: "https://sqs.local/orders.fifo", file: `{"body":"one"}`, want: "line 1 has no messageGroupId, which a FIFO queue needs"},
+			{name: "empty", queueURL: "https://sqs.local/orders", file: "\n\n", want: "the file has no messages"},
+			{name: "empty array", queueURL: "https://sqs.local/orders", file: "[]", want: "the file has no messages"},
+			{name: "no body in an array", queueURL: "https://sqs.local/orders", file: `[{"body":"one"},{"messageId":"m-2"}]`, want: "message 2 has no body"},
+			{name: "unfinished array", queueURL: "https://sqs.local/orders", file: `[{"body":"one"}`, want: "the file is not valid JSON after message 1"},
+			{name: "object without messages", queueURL: "https://sqs.local/orders", file: "{\n  \"body\": \"one\"\n}", want: "the file is not a JSON array of messages, an object with a Messages array or one JSON message per line"},
+			{name: "invalid base64", queueURL: "https://sqs.local/orders", file: `{"body":"one","attributes":{"blob":"%%"},"attributeTypes":{"blob":"Binary"}}`, want: "line 1 has attribute blob, which is not valid base64"},
+			{name: "list value", queueURL: "https://sqs.local/orders", file: `[{"Body":"one","MessageAttributes":{"tags":{"DataType":"String","StringListValues":["a"]}}}]`, want: "message 1: attribute tags has no string or binary value"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				service := &SqsServiceImpl{repo: NewMockSqsRepository(t), jobs: newJobRegistry()}
+
+				_, err := service.StartRestoreFromFile(ctx, RestoreFileInput{QueueURL: tt.queueURL, File: strings.NewReader(tt.file)})
+				require.Error(t, err)
+				assert.Equal(t, tt.want, err.Error())
+			})
+		}
+	})
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"html/template"
+	"io"
 	"io/fs"
 	"log/slog"
 	"net/http"
@@ -14,8 +15,13 @@ import (
 	"github.com/shigaichi/sqs-gui"
 )
 
+// pageTemplate renders a page with the layout around it.
+type pageTemplate interface {
+	Execute(w io.Writer, data any) error
+}
+
 var (
-	templates = make(map[string]*template.Template)
+	templates = make(map[string]pageTemplate)
 	fragments = make(map[string]*vite.Fragment)
 )
 
@@ -47,6 +53,72 @@ func (i RouteImpl) InitRoute() (http.Handler, error) {
 		if err := loadTemplateFromDisk("send-receive", filepath.Join("templates", "pages", "send-receive.gohtml")); err != nil {
 			return nil, errors.Wrap(err, "failed to load send-receive template")
 		}
+		if err := loadTemplateFromDisk("schedules", filepath.Join("templates", "pages", "schedules.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load schedules template")
+		}
+		if err := loadTemplateFromDisk("dead-letter-queues", filepath.Join("templates", "pages", "dead-letter-queues.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load dead-letter-queues template")
+		}
+		if err := loadTemplateFromDisk("alerts", filepath.Join("templates", "pages", "alerts.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load alerts template")
+		}
+		if err := loadTemplateFromDisk("queue-analysis", filepath.Join("templates", "pages", "queue-analysis.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load queue-analysis template")
+		}
+		if err := loadTemplateFromDisk("queue-migration", filepath.Join("templates", "pages", "queue-migration.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load queue-migration template")
+		}
+		if err := loadTemplateFromDisk("consumer-simulator", filepath.Join("templates", "pages", "consumer-simulator.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load consumer-simulator template")
+		}
+		if err := loadTemplateFromDisk("forward", filepath.Join("templates", "pages", "forward.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load forward template")
+		}
+		if err := loadTemplateFromDisk("producer-benchmark", filepath.Join("templates", "pages", "producer-benchmark.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load producer-benchmark template")
+		}
+		if err := loadTemplateFromDisk("filtered-purge", filepath.Join("templates", "pages", "filtered-purge.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load filtered-purge template")
+		}
+		if err := loadTemplateFromDisk("drain-to-file", filepath.Join("templates", "pages", "drain-to-file.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load drain-to-file template")
+		}
+		if err := loadTemplateFromDisk("restore-file", filepath.Join("templates", "pages", "restore-file.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load restore-file template")
+		}
+		if err := loadTemplateFromDisk("trash", filepath.Join("templates", "pages", "trash.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load trash template")
+		}
+		if err := loadTemplateFromDisk("drift", filepath.Join("templates", "pages", "drift.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load drift template")
+		}
+		if err := loadTemplateFromDisk("attribute-history", filepath.Join("templates", "pages", "attribute-history.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load attribute-history template")
+		}
+		if err := loadTemplateFromDisk("status", filepath.Join("templates", "pages", "status.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load status template")
+		}
+		if err := loadTemplateFromDisk("search", filepath.Join("templates", "pages", "search.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load search template")
+		}
+		if err := loadTemplateFromDisk("queue-report", filepath.Join("templates", "pages", "queue-report.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load queue-report template")
+		}
+		if err := loadTemplateFromDisk("access-policy", filepath.Join("templates", "pages", "access-policy.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load access-policy template")
+		}
+		if err := loadTemplateFromDisk("sns-publish", filepath.Join("templates", "pages", "sns-publish.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load sns-publish template")
+		}
+		if err := loadTemplateFromDisk("event-rules", filepath.Join("templates", "pages", "event-rules.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load event-rules template")
+		}
+		if err := loadTemplateFromDisk("bulk-queues", filepath.Join("templates", "pages", "bulk-queues.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load bulk-queues template")
+		}
+		if err := loadTemplateFromDisk("import-queues", filepath.Join("templates", "pages", "import-queues.gohtml")); err != nil {
+			return nil, errors.Wrap(err, "failed to load import-queues template")
+		}
 	} else {
 		if err := loadTemplateFromEmbed("queues", "pages/queues.gohtml"); err != nil {
 			return nil, errors.Wrap(err, "failed to load queues template")
@@ -60,6 +132,72 @@ func (i RouteImpl) InitRoute() (http.Handler, error) {
 		if err := loadTemplateFromEmbed("send-receive", "pages/send-receive.gohtml"); err != nil {
 			return nil, errors.Wrap(err, "failed to load send-receive template")
 		}
+		if err := loadTemplateFromEmbed("schedules", "pages/schedules.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load schedules template")
+		}
+		if err := loadTemplateFromEmbed("dead-letter-queues", "pages/dead-letter-queues.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load dead-letter-queues template")
+		}
+		if err := loadTemplateFromEmbed("alerts", "pages/alerts.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load alerts template")
+		}
+		if err := loadTemplateFromEmbed("queue-analysis", "pages/queue-analysis.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load queue-analysis template")
+		}
+		if err := loadTemplateFromEmbed("queue-migration", "pages/queue-migration.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load queue-migration template")
+		}
+		if err := loadTemplateFromEmbed("consumer-simulator", "pages/consumer-simulator.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load consumer-simulator template")
+		}
+		if err := loadTemplateFromEmbed("forward", "pages/forward.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load forward template")
+		}
+		if err := loadTemplateFromEmbed("producer-benchmark", "pages/producer-benchmark.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load producer-benchmark template")
+		}
+		if err := loadTemplateFromEmbed("filtered-purge", "pages/filtered-purge.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load filtered-purge template")
+		}
+		if err := loadTemplateFromEmbed("drain-to-file", "pages/drain-to-file.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load drain-to-file template")
+		}
+		if err := loadTemplateFromEmbed("restore-file", "pages/restore-file.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load restore-file template")
+		}
+		if err := loadTemplateFromEmbed("trash", "pages/trash.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load trash template")
+		}
+		if err := loadTemplateFromEmbed("drift", "pages/drift.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load drift template")
+		}
+		if err := loadTemplateFromEmbed("attribute-history", "pages/attribute-history.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load attribute-history template")
+		}
+		if err := loadTemplateFromEmbed("status", "pages/status.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load status template")
+		}
+		if err := loadTemplateFromEmbed("search", "pages/search.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load search template")
+		}
+		if err := loadTemplateFromEmbed("queue-report", "pages/queue-report.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load queue-report template")
+		}
+		if err := loadTemplateFromEmbed("access-policy", "pages/access-policy.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load access-policy template")
+		}
+		if err := loadTemplateFromEmbed("sns-publish", "pages/sns-publish.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load sns-publish template")
+		}
+		if err := loadTemplateFromEmbed("event-rules", "pages/event-rules.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load event-rules template")
+		}
+		if err := loadTemplateFromEmbed("bulk-queues", "pages/bulk-queues.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load bulk-queues template")
+		}
+		if err := loadTemplateFromEmbed("import-queues", "pages/import-queues.gohtml"); err != nil {
+			return nil, errors.Wrap(err, "failed to load import-queues template")
+		}
 	}
 
 	viteConfig := vite.Config{
@@ -84,6 +222,28 @@ func (i RouteImpl) InitRoute() (http.Handler, error) {
 		"assets/js/create_queue.ts",
 		"assets/js/queue.ts",
 		"assets/js/send_receive.ts",
+		"assets/js/schedules.ts",
+		"assets/js/dead_letter_queues.ts",
+		"assets/js/alerts.ts",
+		"assets/js/queue_analysis.ts",
+		"assets/js/queue_migration.ts",
+		"assets/js/consumer_simulator.ts",
+		"assets/js/message_forwarder.ts",
+		"assets/js/producer_benchmark.ts",
+		"assets/js/filtered_purge.ts",
+		"assets/js/drain_to_file.ts",
+		"assets/js/restore_file.ts",
+		"assets/js/trash.ts",
+		"assets/js/drift.ts",
+		"assets/js/attribute_history.ts",
+		"assets/js/status.ts",
+		"assets/js/search.ts",
+		"assets/js/queue_report.ts",
+		"assets/js/access_policy.ts",
+		"assets/js/sns_publish.ts",
+		"assets/js/event_rules.ts",
+		"assets/js/bulk_queues.ts",
+		"assets/js/import_queues.ts",
 	}
 
 	for _, entry := range entries {
@@ -103,34 +263,134 @@ func (i RouteImpl) InitRoute() (http.Handler, error) {
 		f := http.FileServer(http.FS(viteConfig.FS))
 		mux.Handle("/assets/", f)
 		mux.Handle("/icon.svg", f)
+		mux.Handle("/push-worker.js", f)
 	} else {
 		assetsDir := http.Dir("assets")
 		mux.Handle("/assets/", http.StripPrefix("/assets/", http.FileServer(assetsDir)))
 		mux.Handle("/icon.svg", http.FileServer(http.Dir("public")))
+		mux.Handle("/push-worker.js", http.FileServer(http.Dir("public")))
 	}
 
 	mux.HandleFunc("/queues", i.h.QueuesHandler)
+	mux.HandleFunc("GET /queues/export.csv", i.h.ExportQueuesCSVHandler)
+	mux.HandleFunc("POST /preferences/queue-columns", i.h.PostQueueColumnsHandler)
+	mux.HandleFunc("POST /preferences/favorite-queues", i.h.PostFavoriteQueueHandler)
+	mux.HandleFunc("POST /queues/bulk", i.h.PostBulkQueuesHandler)
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		http.Redirect(w, r, "/queues", http.StatusFound)
 	})
 	mux.HandleFunc("GET /create-queue", i.h.GetCreateQueueHandler)
 	mux.HandleFunc("POST /create-queue", i.h.PostCreateQueueHandler)
+	mux.HandleFunc("GET /import-queues", i.h.ImportQueuesHandler)
+	mux.HandleFunc("POST /import-queues", i.h.PostImportQueuesHandler)
 	mux.HandleFunc("POST /queues/{url}/purge", i.h.PurgeQueueHandler)
+	mux.HandleFunc("POST /queues/{url}/redrive-policy", i.h.PostRedrivePolicyHandler)
+	mux.HandleFunc("POST /queues/{url}/fifo-throughput", i.h.PostFifoThroughputHandler)
+	mux.HandleFunc("POST /queues/{url}/tags", i.h.PostQueueTagHandler)
+	mux.HandleFunc("POST /queues/{url}/tags/delete", i.h.DeleteQueueTagHandler)
+	mux.HandleFunc("GET /queues/{url}/filtered-purge", i.h.FilteredPurgeHandler)
+	mux.HandleFunc("POST /queues/{url}/filtered-purge", i.h.PostFilteredPurgeHandler)
+	mux.HandleFunc("GET /queues/{url}/drain-to-file", i.h.DrainToFileHandler)
+	mux.HandleFunc("POST /queues/{url}/drain-to-file", i.h.PostDrainToFileHandler)
+	mux.HandleFunc("GET /queues/{url}/restore-file", i.h.RestoreFileHandler)
+	mux.HandleFunc("POST /queues/{url}/restore-file", i.h.PostRestoreFileHandler)
 	mux.HandleFunc("POST /queues/{url}/delete", i.h.DeleteQueueHandler)
 	mux.HandleFunc("/queues/{url}", i.h.QueueHandler)
 	mux.HandleFunc("/queues/{url}/send-receive", i.h.SendReceive)
+	mux.HandleFunc("POST /queues/{url}/send-receive/send", i.h.PostSendMessageFormHandler)
+	mux.HandleFunc("POST /queues/{url}/send-receive/poll", i.h.PostReceiveMessagesFormHandler)
+	mux.HandleFunc("POST /queues/{url}/send-receive/delete", i.h.PostDeleteMessageFormHandler)
+	mux.HandleFunc("GET /queues/{url}/analysis", i.h.QueueAnalysisHandler)
+	mux.HandleFunc("POST /queues/{url}/analysis/attribute-count", i.h.PostAttributeCountHandler)
+	mux.HandleFunc("GET /queues/{url}/access-policy", i.h.AccessPolicyHandler)
+	mux.HandleFunc("POST /queues/{url}/access-policy", i.h.PostAccessPolicyHandler)
+	mux.HandleFunc("GET /queues/{url}/sns", i.h.SnsPublishHandler)
+	mux.HandleFunc("POST /queues/{url}/sns", i.h.PostSnsPublishHandler)
+	mux.HandleFunc("GET /queues/{url}/event-rules", i.h.EventRulesHandler)
+	mux.HandleFunc("POST /queues/{url}/event-rules", i.h.PostEventRulesHandler)
+	mux.HandleFunc("GET /queues/{url}/migrate", i.h.QueueMigrationHandler)
+	mux.HandleFunc("POST /queues/{url}/migrate", i.h.PostQueueMigrationHandler)
+	mux.HandleFunc("GET /queues/{url}/definition", i.h.QueueDefinitionHandler)
+	mux.HandleFunc("GET /queues/{url}/simulate", i.h.ConsumerSimulatorHandler)
+	mux.HandleFunc("POST /queues/{url}/simulate", i.h.PostConsumerSimulatorHandler)
+	mux.HandleFunc("GET /queues/{url}/forward", i.h.ForwarderHandler)
+	mux.HandleFunc("POST /queues/{url}/forward", i.h.PostForwarderHandler)
+	mux.HandleFunc("GET /queues/{url}/benchmark", i.h.ProducerBenchmarkHandler)
+	mux.HandleFunc("POST /queues/{url}/benchmark", i.h.PostProducerBenchmarkHandler)
 	mux.HandleFunc("POST /queues/{url}/messages", i.h.SendMessageAPI)
+	mux.HandleFunc("POST /queues/{url}/messages/batch", i.h.SendMessageBatchAPI)
 	mux.HandleFunc("POST /queues/{url}/messages/poll", i.h.ReceiveMessagesAPI)
+	mux.HandleFunc("POST /queues/{url}/messages/drain", i.h.DrainReceiveAPI)
+	mux.HandleFunc("POST /queues/{url}/messages/search", i.h.SearchMessagesAPI)
 	mux.HandleFunc("POST /queues/{url}/messages/delete", i.h.DeleteMessageAPI)
+	mux.HandleFunc("POST /queues/{url}/messages/transfer", i.h.TransferMessagesAPI)
+	mux.HandleFunc("POST /queues/{url}/messages/redrive", i.h.RedriveMessagesAPI)
+	mux.HandleFunc("POST /queues/{url}/draft", i.h.SaveDraftAPI)
+	mux.HandleFunc("GET /api/v1/queues/{url}/contract", i.h.GetMessageContractAPI)
+	mux.HandleFunc("PUT /api/v1/queues/{url}/contract", i.h.PutMessageContractAPI)
+	mux.HandleFunc("DELETE /api/v1/queues/{url}/contract", i.h.DeleteMessageContractAPI)
+	mux.HandleFunc("POST /api/v1/queues/{url}/contract/compare", i.h.CompareMessageAPI)
+	mux.HandleFunc("POST /messages/poll", i.h.ReceiveMergedMessagesAPI)
+	mux.HandleFunc("GET /trash", i.h.TrashHandler)
+	mux.HandleFunc("POST /trash/{id}/restore", i.h.RestoreQueueHandler)
+	mux.HandleFunc("POST /trash/{id}/delete", i.h.DiscardTrashedQueueHandler)
+	mux.HandleFunc("GET /drift", i.h.DriftHandler)
+	mux.HandleFunc("POST /drift/check", i.h.PostDriftCheckHandler)
+	mux.HandleFunc("POST /queues/{url}/baseline", i.h.SaveBaselineHandler)
+	mux.HandleFunc("POST /queues/{url}/baseline/delete", i.h.DeleteBaselineHandler)
+	mux.HandleFunc("GET /queues/{url}/history", i.h.AttributeHistoryHandler)
+	mux.HandleFunc("POST /queues/{url}/history/watch", i.h.WatchAttributesHandler)
+	mux.HandleFunc("POST /queues/{url}/history/unwatch", i.h.UnwatchAttributesHandler)
+	mux.HandleFunc("GET /dead-letter-queues", i.h.DeadLetterQueuesHandler)
+	mux.HandleFunc("GET /alerts", i.h.AlertsHandler)
+	mux.HandleFunc("POST /alerts", i.h.PostAlertRuleHandler)
+	mux.HandleFunc("POST /alerts/{id}/delete", i.h.DeleteAlertRuleHandler)
+	mux.HandleFunc("POST /alerts/{id}/silence", i.h.SilenceAlertRuleHandler)
+	mux.HandleFunc("POST /alerts/{id}/unsilence", i.h.UnsilenceAlertRuleHandler)
+	mux.HandleFunc("GET /schedules", i.h.SchedulesHandler)
+	mux.HandleFunc("POST /schedules", i.h.PostScheduleHandler)
+	mux.HandleFunc("POST /schedules/{id}/delete", i.h.DeleteScheduleHandler)
+	mux.HandleFunc("POST /schedules/{id}/toggle", i.h.ToggleScheduleHandler)
+	mux.HandleFunc("GET /api/v1/schedules", i.h.ListSchedulesAPI)
+	mux.HandleFunc("POST /api/v1/schedules", i.h.CreateScheduleAPI)
+	mux.HandleFunc("GET /api/v1/schedules/{id}", i.h.GetScheduleAPI)
+	mux.HandleFunc("PATCH /api/v1/schedules/{id}", i.h.UpdateScheduleAPI)
+	mux.HandleFunc("DELETE /api/v1/schedules/{id}", i.h.DeleteScheduleAPI)
+	mux.HandleFunc("GET /api/v1/queues", i.h.ListQueuesAPI)
+	mux.HandleFunc("GET /api/v1/queues/check-name", i.h.CheckQueueNameAPI)
+	mux.HandleFunc("POST /api/v1/queues/stats", i.h.QueueStatsAPI)
+	mux.HandleFunc("GET /api/v1/queues/{url}/stats", i.h.QueueLiveStatsAPI)
+	mux.HandleFunc("GET /api/v1/queues/{url}/move-tasks", i.h.MessageMoveTasksAPI)
+	mux.HandleFunc("POST /api/v1/queues/{url}/move-tasks/cancel", i.h.CancelMessageMoveTaskAPI)
+	mux.HandleFunc("POST /api/v1/queues/latency", i.h.ProbeLatencyAPI)
+	mux.HandleFunc("POST /api/v1/messages/fan-out", i.h.FanOutMessageAPI)
+	mux.HandleFunc("PATCH /api/v1/queues/{url}/attributes", i.h.UpdateQueueAttributeAPI)
+	mux.HandleFunc("POST /api/v1/queues/{url}/purge", i.h.StartPurgeAPI)
+	mux.HandleFunc("POST /api/v1/queues/bulk", i.h.BulkQueueOperationAPI)
+	mux.HandleFunc("GET /api/v1/jobs/{id}", i.h.JobAPI)
+	mux.HandleFunc("GET /api/v1/jobs/{id}/file", i.h.JobFileAPI)
+	mux.HandleFunc("POST /ingest/{alias}", i.h.IngestAPI)
+	mux.HandleFunc("POST /slack/commands", i.h.SlackCommandHandler)
+	mux.HandleFunc("POST /api/v1/push/subscriptions", i.h.SubscribePushAPI)
+	mux.HandleFunc("DELETE /api/v1/push/subscriptions", i.h.UnsubscribePushAPI)
+	mux.HandleFunc("GET /status", i.h.StatusHandler)
+	mux.HandleFunc("GET /metrics", i.h.MetricsHandler)
+	mux.HandleFunc("GET /api/v1/capabilities", i.h.CapabilitiesAPI)
+	mux.HandleFunc("GET /search", i.h.SearchHandler)
+	mux.HandleFunc("GET /api/v1/search", i.h.SearchAPI)
+	mux.HandleFunc("GET /reports/queues", i.h.QueueReportHandler)
+	mux.HandleFunc("GET /api/v1/cleanup/report", i.h.CleanupReportAPI)
+	mux.HandleFunc("GET /api/v1/settings/export", i.h.ExportSettingsAPI)
+	mux.HandleFunc("POST /api/v1/settings/import", i.h.ImportSettingsAPI)
 
-	return logMiddleware(mux), nil
+	return requestLogMiddleware(mux, logMiddleware(traceMiddleware(mux))), nil
 }
 
 func logMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 		next.ServeHTTP(w, r)
-		slog.Info("request completed",
+		slog.InfoContext(r.Context(), "request completed",
 			slog.String("method", r.Method),
 			slog.String("path", r.URL.Path),
 			slog.Duration("duration", time.Since(start)),
@@ -138,7 +398,30 @@ func logMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// loadTemplateFromDisk checks that a page parses and registers it to be parsed again on every
+// render, so template edits show up in DEV_MODE without restarting the server.
 func loadTemplateFromDisk(tmplName string, pageFile string) error {
+	if _, err := parseTemplateFromDisk(pageFile); err != nil {
+		return err
+	}
+	templates[tmplName] = diskTemplate{pageFile: pageFile}
+	return nil
+}
+
+// diskTemplate is a page read from the templates directory each time it is rendered.
+type diskTemplate struct {
+	pageFile string
+}
+
+func (d diskTemplate) Execute(w io.Writer, data any) error {
+	tmpl, err := parseTemplateFromDisk(d.pageFile)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, data)
+}
+
+func parseTemplateFromDisk(pageFile string) (*template.Template, error) {
 	base := template.New("layout")
 	layoutFiles := []string{
 		filepath.Join("templates", "layout.gohtml"),
@@ -148,14 +431,13 @@ func loadTemplateFromDisk(tmplName string, pageFile string) error {
 	}
 	tmpl, err := base.ParseFiles(layoutFiles...)
 	if err != nil {
-		return errors.Wrap(err, "failed to parse layout")
+		return nil, errors.Wrap(err, "failed to parse layout")
 	}
 	tmpl, err = tmpl.ParseFiles(pageFile)
 	if err != nil {
-		return errors.Wrap(err, "failed to parse page template")
+		return nil, errors.Wrap(err, "failed to parse page template")
 	}
-	templates[tmplName] = tmpl
-	return nil
+	return tmpl, nil
 }
 
 func loadTemplateFromEmbed(tmplName string, pagePattern string) error {
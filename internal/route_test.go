@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTemplateFromDisk_ReloadsOnRender(t *testing.T) {
+	t.Chdir(t.TempDir())
+	write := func(name, content string) {
+		t.Helper()
+		path := filepath.Join("templates", name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	write("layout.gohtml", `{{define "layout"}}{{template "content" .}}{{end}}`)
+	write(filepath.Join("partials", "head.gohtml"), `{{define "head"}}{{end}}`)
+	write(filepath.Join("partials", "header.gohtml"), `{{define "header"}}{{end}}`)
+	write(filepath.Join("partials", "footer.gohtml"), `{{define "footer"}}{{end}}`)
+	write(filepath.Join("pages", "page.gohtml"), `{{define "content"}}before {{.}}{{end}}`)
+
+	t.Cleanup(func() { delete(templates, "page") })
+	require.NoError(t, loadTemplateFromDisk("page", filepath.Join("templates", "pages", "page.gohtml")))
+
+	var out strings.Builder
+	require.NoError(t, templates["page"].Execute(&out, "render"))
+	assert.Equal(t, "before render", out.String())
+
+	write(filepath.Join("pages", "page.gohtml"), `{{define "content"}}after {{.}}{{end}}`)
+	out.Reset()
+	require.NoError(t, templates["page"].Execute(&out, "render"))
+	assert.Equal(t, "after render", out.String())
+
+	write(filepath.Join("pages", "page.gohtml"), `{{define "content"}}{{.Missing}`)
+	assert.ErrorContains(t, templates["page"].Execute(&out, "render"), "failed to parse page template")
+}
@@ -0,0 +1,491 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/robfig/cron/v3"
+)
+
+// ScheduleAction identifies what a schedule does when it fires.
+type ScheduleAction string
+
+const (
+	// ScheduleActionPurge purges the target queue.
+	ScheduleActionPurge ScheduleAction = "purge"
+	// ScheduleActionSend sends the schedule's message to the target queue.
+	ScheduleActionSend ScheduleAction = "send"
+)
+
+// ErrScheduleNotFound is returned when a schedule ID does not exist.
+var ErrScheduleNotFound = errors.New("schedule not found")
+
+// maxScheduleHistory bounds the number of runs kept per schedule.
+const maxScheduleHistory = 20
+
+// Schedule is a job persisted in the local store. It recurs on Cron or, when RunAt is set
+// instead, runs once at RunAt, such as to send a message later than SQS's 15 minute delivery
+// delay allows. A one-off schedule is kept with its run once it has run.
+type Schedule struct {
+	ID        string            `json:"id"`
+	Action    ScheduleAction    `json:"action"`
+	QueueURL  string            `json:"queueUrl"`
+	Cron      string            `json:"cron,omitempty"`
+	RunAt     time.Time         `json:"runAt,omitzero"`
+	Message   *ScheduledMessage `json:"message,omitempty"`
+	Disabled  bool              `json:"disabled,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+	ResumedAt time.Time         `json:"resumedAt,omitzero"`
+	LastRunAt time.Time         `json:"lastRunAt,omitzero"`
+	History   []ScheduleRun     `json:"history,omitempty"`
+
+	// NextRunAt is computed when schedules are listed and is not persisted.
+	NextRunAt time.Time `json:"-"`
+}
+
+// ScheduleRun records one execution of a schedule. An empty Error means the run succeeded.
+type ScheduleRun struct {
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// ScheduledMessage is the message sent by a ScheduleActionSend schedule.
+type ScheduledMessage struct {
+	Body           string             `json:"body"`
+	MessageGroupID string             `json:"messageGroupId,omitempty"`
+	Attributes     []MessageAttribute `json:"attributes,omitempty"`
+}
+
+// CreateScheduleInput carries the user supplied fields of a new schedule. Either Cron or RunAt
+// is set.
+type CreateScheduleInput struct {
+	Action   ScheduleAction
+	QueueURL string
+	Cron     string
+	RunAt    time.Time
+	Message  *ScheduledMessage
+	Disabled bool
+}
+
+// UpdateScheduleInput changes an existing schedule. Nil fields are left untouched. Cron only
+// applies to recurring schedules and RunAt to one-off schedules that have not run yet.
+type UpdateScheduleInput struct {
+	Cron    *string
+	RunAt   *time.Time
+	Message *ScheduledMessage
+	Enabled *bool
+}
+
+// parseCron accepts standard five-field expressions and descriptors such as @daily.
+func parseCron(expr string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, errors.Newf("invalid cron expression %q", expr)
+	}
+	return schedule, nil
+}
+
+// now returns the current time, using the injected clock in tests.
+func (s *SqsServiceImpl) now() time.Time {
+	if s.clock != nil {
+		return s.clock()
+	}
+	return time.Now()
+}
+
+// Schedules lists the configured schedules ordered by creation time.
+func (s *SqsServiceImpl) Schedules(_ context.Context) ([]Schedule, error) {
+	if s.store == nil {
+		return []Schedule{}, nil
+	}
+
+	schedules, err := s.store.Schedules()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range schedules {
+		schedules[i].NextRunAt = schedules[i].nextRunAt()
+	}
+	slices.SortFunc(schedules, func(a, b Schedule) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return schedules, nil
+}
+
+// CreateSchedule validates and stores a new schedule.
+func (s *SqsServiceImpl) CreateSchedule(_ context.Context, input CreateScheduleInput) (Schedule, error) {
+	if s.store == nil {
+		return Schedule{}, errors.New("schedules are not available without a state store")
+	}
+
+	queueURL := strings.TrimSpace(input.QueueURL)
+	if queueURL == "" {
+		return Schedule{}, errors.New("queue url is required")
+	}
+
+	message, err := validateScheduleAction(input.Action, queueURL, input.Message)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	expr := strings.TrimSpace(input.Cron)
+	createdAt := s.now().UTC()
+	if input.RunAt.IsZero() {
+		if _, err := validateCron(expr); err != nil {
+			return Schedule{}, err
+		}
+	} else {
+		if expr != "" {
+			return Schedule{}, errors.New("a schedule has either a cron expression or a run time, not both")
+		}
+		if err := validateRunAt(input.RunAt, createdAt); err != nil {
+			return Schedule{}, err
+		}
+	}
+
+	id, err := newRandomID()
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	schedule := Schedule{
+		ID:        id,
+		Action:    input.Action,
+		QueueURL:  queueURL,
+		Cron:      expr,
+		Message:   message,
+		Disabled:  input.Disabled,
+		CreatedAt: createdAt,
+	}
+	if !input.RunAt.IsZero() {
+		schedule.RunAt = input.RunAt.UTC()
+	}
+	if err := s.store.SaveSchedule(schedule); err != nil {
+		return Schedule{}, err
+	}
+
+	schedule.NextRunAt = schedule.nextRunAt()
+	return schedule, nil
+}
+
+// Schedule returns a single schedule by ID.
+func (s *SqsServiceImpl) Schedule(_ context.Context, id string) (Schedule, error) {
+	if s.store == nil {
+		return Schedule{}, ErrScheduleNotFound
+	}
+
+	schedule, ok, err := s.store.Schedule(id)
+	if err != nil {
+		return Schedule{}, err
+	}
+	if !ok {
+		return Schedule{}, ErrScheduleNotFound
+	}
+
+	schedule.NextRunAt = schedule.nextRunAt()
+	return schedule, nil
+}
+
+// UpdateSchedule changes the cron expression or run time, message or enabled state of a
+// schedule. Re-enabling a recurring schedule does not replay the runs missed while it was
+// disabled, while a one-off schedule whose time passed while it was disabled runs right away.
+func (s *SqsServiceImpl) UpdateSchedule(_ context.Context, id string, input UpdateScheduleInput) (Schedule, error) {
+	if s.store == nil {
+		return Schedule{}, ErrScheduleNotFound
+	}
+
+	var updated Schedule
+	err := s.store.UpdateSchedule(id, func(schedule *Schedule) error {
+		if input.Cron != nil {
+			if schedule.isOneOff() {
+				return errors.New("a one-off schedule has no cron expression")
+			}
+			expr := strings.TrimSpace(*input.Cron)
+			if _, err := validateCron(expr); err != nil {
+				return err
+			}
+			schedule.Cron = expr
+		}
+
+		if input.RunAt != nil {
+			if !schedule.isOneOff() {
+				return errors.New("a recurring schedule has no run time")
+			}
+			if !schedule.LastRunAt.IsZero() {
+				return errors.New("the schedule has already run")
+			}
+			if err := validateRunAt(*input.RunAt, s.now()); err != nil {
+				return err
+			}
+			schedule.RunAt = input.RunAt.UTC()
+		}
+
+		if input.Message != nil {
+			message, err := validateScheduleAction(schedule.Action, schedule.QueueURL, input.Message)
+			if err != nil {
+				return err
+			}
+			schedule.Message = message
+		}
+
+		if input.Enabled != nil {
+			if schedule.Disabled && *input.Enabled {
+				schedule.ResumedAt = s.now().UTC()
+			}
+			schedule.Disabled = !*input.Enabled
+		}
+
+		updated = *schedule
+		return nil
+	})
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	updated.NextRunAt = updated.nextRunAt()
+	return updated, nil
+}
+
+// DeleteSchedule removes a schedule so it no longer fires.
+func (s *SqsServiceImpl) DeleteSchedule(_ context.Context, id string) error {
+	if strings.TrimSpace(id) == "" {
+		return errors.New("schedule id is required")
+	}
+	if s.store == nil {
+		return errors.New("schedules are not available without a state store")
+	}
+
+	return s.store.DeleteSchedule(id)
+}
+
+// RunDueSchedules executes every schedule whose next fire time has passed.
+// Runs missed while the server was down are collapsed into a single run.
+func (s *SqsServiceImpl) RunDueSchedules(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+
+	schedules, err := s.store.Schedules()
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range schedules {
+		if schedule.Disabled {
+			continue
+		}
+
+		startedAt := s.now().UTC()
+		if schedule.isOneOff() {
+			if !schedule.LastRunAt.IsZero() || startedAt.Before(schedule.RunAt) {
+				continue
+			}
+		} else {
+			parsed, err := parseCron(schedule.Cron)
+			if err != nil {
+				slog.WarnContext(ctx, "skipping schedule with invalid cron expression", slog.String("schedule_id", schedule.ID), slog.Any("error", err))
+				continue
+			}
+			if startedAt.Before(parsed.Next(schedule.lastEvaluatedAt())) {
+				continue
+			}
+		}
+
+		runErr := s.runSchedule(ctx, schedule)
+		run := ScheduleRun{StartedAt: startedAt, FinishedAt: s.now().UTC()}
+		if runErr != nil {
+			run.Error = runErr.Error()
+			slog.ErrorContext(ctx, "scheduled job failed", slog.String("schedule_id", schedule.ID), slog.String("queue_url", schedule.QueueURL), slog.Any("error", runErr))
+			s.notify(ctx, Notification{
+				Title: fmt.Sprintf("Scheduled %s failed", schedule.Action),
+				Text:  fmt.Sprintf("Schedule %s (%s) on %s failed: %v", schedule.ID, schedule.timing(), schedule.QueueURL, runErr),
+			})
+		}
+
+		// Record the run on the stored schedule rather than on the copy read before it, so a
+		// schedule deleted or changed while it ran is not brought back or reverted.
+		err := s.store.UpdateSchedule(schedule.ID, func(stored *Schedule) error {
+			stored.LastRunAt = startedAt
+			stored.History = append([]ScheduleRun{run}, stored.History...)
+			if len(stored.History) > maxScheduleHistory {
+				stored.History = stored.History[:maxScheduleHistory]
+			}
+			return nil
+		})
+		if errors.Is(err, ErrScheduleNotFound) {
+			slog.InfoContext(ctx, "schedule was deleted while it ran", slog.String("schedule_id", schedule.ID))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *SqsServiceImpl) runSchedule(ctx context.Context, schedule Schedule) error {
+	switch schedule.Action {
+	case ScheduleActionPurge:
+		return s.PurgeQueue(ctx, schedule.QueueURL)
+	case ScheduleActionSend:
+		if schedule.Message == nil {
+			return errors.New("schedule has no message to send")
+		}
+		return s.sendScheduledMessage(ctx, schedule)
+	default:
+		return errors.Newf("unsupported schedule action %q", schedule.Action)
+	}
+}
+
+// sendScheduledMessage sends directly through the repository so scheduled sends neither
+// overwrite the send form defaults nor trigger the interactive duplicate warnings.
+func (s *SqsServiceImpl) sendScheduledMessage(ctx context.Context, schedule Schedule) error {
+	attributes := make(map[string]string, len(schedule.Message.Attributes))
+	var dataTypes map[string]string
+	for _, attr := range schedule.Message.Attributes {
+		attributes[attr.Name] = attr.Value
+		if attr.DataType != "" {
+			if dataTypes == nil {
+				dataTypes = make(map[string]string)
+			}
+			dataTypes[attr.Name] = attr.DataType
+		}
+	}
+
+	input := SendMessageRepositoryInput{
+		QueueURL:       schedule.QueueURL,
+		Body:           schedule.Message.Body,
+		MessageGroupID: schedule.Message.MessageGroupID,
+		Attributes:     attributes,
+		AttributeTypes: dataTypes,
+	}
+	if strings.HasSuffix(schedule.QueueURL, ".fifo") {
+		// Each run gets its own deduplication ID so identical heartbeat bodies are not dropped.
+		input.MessageDeduplicationID = fmt.Sprintf("%s-%d", schedule.ID, s.now().UnixNano())
+	}
+
+	return s.repo.SendMessage(ctx, input)
+}
+
+// lastEvaluatedAt is the reference point for computing the next fire time.
+func (s Schedule) lastEvaluatedAt() time.Time {
+	latest := s.CreatedAt
+	for _, t := range []time.Time{s.ResumedAt, s.LastRunAt} {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
+
+// isOneOff reports whether the schedule runs once at RunAt rather than on a cron expression.
+func (s Schedule) isOneOff() bool {
+	return !s.RunAt.IsZero()
+}
+
+// timing describes when the schedule runs, for notifications.
+func (s Schedule) timing() string {
+	if s.isOneOff() {
+		return "once at " + s.RunAt.Format(time.RFC3339)
+	}
+	return s.Cron
+}
+
+// nextRunAt returns the next fire time, or the zero time for disabled or invalid schedules and
+// one-off schedules that have run. A one-off schedule that is overdue returns its past RunAt.
+func (s Schedule) nextRunAt() time.Time {
+	if s.Disabled {
+		return time.Time{}
+	}
+	if s.isOneOff() {
+		if !s.LastRunAt.IsZero() {
+			return time.Time{}
+		}
+		return s.RunAt
+	}
+	parsed, err := parseCron(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed.Next(s.lastEvaluatedAt())
+}
+
+func validateCron(expr string) (cron.Schedule, error) {
+	if expr == "" {
+		return nil, errors.New("cron expression is required")
+	}
+	return parseCron(expr)
+}
+
+// validateRunAt checks that a one-off schedule runs after now and within a year.
+func validateRunAt(runAt, now time.Time) error {
+	if !runAt.After(now) {
+		return errors.New("the run time must be in the future")
+	}
+	if runAt.After(now.AddDate(1, 0, 0)) {
+		return errors.New("the run time must be within a year")
+	}
+	return nil
+}
+
+// validateScheduleAction checks the action and returns the normalised message it needs, if any.
+func validateScheduleAction(action ScheduleAction, queueURL string, message *ScheduledMessage) (*ScheduledMessage, error) {
+	switch action {
+	case ScheduleActionPurge:
+		return nil, nil
+	case ScheduleActionSend:
+	default:
+		return nil, errors.Newf("unsupported schedule action %q", action)
+	}
+
+	if message == nil || strings.TrimSpace(message.Body) == "" {
+		return nil, errors.New("message body is required")
+	}
+	if len(message.Body) > maxMessageBodyBytes {
+		return nil, errors.New("message body exceeds the 256 KB message size limit")
+	}
+
+	normalised := &ScheduledMessage{
+		Body:           message.Body,
+		MessageGroupID: strings.TrimSpace(message.MessageGroupID),
+	}
+	if strings.HasSuffix(queueURL, ".fifo") && normalised.MessageGroupID == "" {
+		return nil, errors.New("message group id is required for fifo queues")
+	}
+	for _, attr := range message.Attributes {
+		name := strings.TrimSpace(attr.Name)
+		if name == "" {
+			continue
+		}
+		dataType, err := checkMessageAttributeValue(name, attr.DataType, attr.Value)
+		if err != nil {
+			return nil, err
+		}
+		if dataType == "String" {
+			dataType = ""
+		}
+		normalised.Attributes = append(normalised.Attributes, MessageAttribute{Name: name, Value: attr.Value, DataType: dataType})
+	}
+
+	return normalised, nil
+}
+
+// newRandomID returns a short random identifier for locally stored records.
+func newRandomID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Wrap(err, "failed to generate id")
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,393 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_CreateSchedule(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+	t.Run("stores a valid schedule", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return created }}
+
+		store.EXPECT().
+			SaveSchedule(mock.MatchedBy(func(schedule Schedule) bool {
+				return schedule.ID != "" &&
+					schedule.Action == ScheduleActionPurge &&
+					schedule.QueueURL == "https://sqs.local/tmp" &&
+					schedule.Cron == "0 3 * * *" &&
+					schedule.CreatedAt.Equal(created)
+			})).
+			Return(nil).
+			Once()
+
+		schedule, err := service.CreateSchedule(ctx, CreateScheduleInput{
+			Action:   ScheduleActionPurge,
+			QueueURL: " https://sqs.local/tmp ",
+			Cron:     " 0 3 * * * ",
+		})
+		require.NoError(t, err)
+		assert.Len(t, schedule.ID, 16)
+		assert.Equal(t, time.Date(2024, 5, 2, 3, 0, 0, 0, time.UTC), schedule.NextRunAt.UTC())
+	})
+
+	t.Run("stores a one-off send", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return created }}
+		runAt := created.Add(2 * time.Hour)
+
+		store.EXPECT().
+			SaveSchedule(mock.MatchedBy(func(schedule Schedule) bool {
+				return schedule.Cron == "" && schedule.RunAt.Equal(runAt)
+			})).
+			Return(nil).
+			Once()
+
+		schedule, err := service.CreateSchedule(ctx, CreateScheduleInput{
+			Action:   ScheduleActionSend,
+			QueueURL: "https://sqs.local/tmp",
+			RunAt:    runAt.In(time.FixedZone("JST", 9*60*60)),
+			Message:  &ScheduledMessage{Body: "later"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, time.UTC, schedule.RunAt.Location())
+		assert.Equal(t, runAt, schedule.NextRunAt)
+	})
+
+	testCases := []struct {
+		name    string
+		input   CreateScheduleInput
+		wantErr string
+	}{
+		{
+			name:    "missing queue",
+			input:   CreateScheduleInput{Action: ScheduleActionPurge, Cron: "@daily"},
+			wantErr: "queue url is required",
+		},
+		{
+			name:    "unknown action",
+			input:   CreateScheduleInput{Action: "explode", QueueURL: "https://sqs.local/tmp", Cron: "@daily"},
+			wantErr: `unsupported schedule action "explode"`,
+		},
+		{
+			name:    "send without body",
+			input:   CreateScheduleInput{Action: ScheduleActionSend, QueueURL: "https://sqs.local/tmp", Cron: "@daily"},
+			wantErr: "message body is required",
+		},
+		{
+			name: "fifo send without group",
+			input: CreateScheduleInput{
+				Action:   ScheduleActionSend,
+				QueueURL: "https://sqs.local/tmp.fifo",
+				Cron:     "@daily",
+				Message:  &ScheduledMessage{Body: "ping"},
+			},
+			wantErr: "message group id is required for fifo queues",
+		},
+		{
+			name:    "missing cron",
+			input:   CreateScheduleInput{Action: ScheduleActionPurge, QueueURL: "https://sqs.local/tmp"},
+			wantErr: "cron expression is required",
+		},
+		{
+			name:    "invalid cron",
+			input:   CreateScheduleInput{Action: ScheduleActionPurge, QueueURL: "https://sqs.local/tmp", Cron: "every night"},
+			wantErr: `invalid cron expression "every night"`,
+		},
+		{
+			name:    "run time in the past",
+			input:   CreateScheduleInput{Action: ScheduleActionPurge, QueueURL: "https://sqs.local/tmp", RunAt: created.Add(-time.Minute)},
+			wantErr: "the run time must be in the future",
+		},
+		{
+			name:    "run time too far ahead",
+			input:   CreateScheduleInput{Action: ScheduleActionPurge, QueueURL: "https://sqs.local/tmp", RunAt: created.AddDate(1, 0, 1)},
+			wantErr: "the run time must be within a year",
+		},
+		{
+			name:    "both cron and run time",
+			input:   CreateScheduleInput{Action: ScheduleActionPurge, QueueURL: "https://sqs.local/tmp", Cron: "@daily", RunAt: created.Add(time.Hour)},
+			wantErr: "a schedule has either a cron expression or a run time, not both",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &SqsServiceImpl{store: NewMockLocalStore(t), clock: func() time.Time { return created }}
+
+			_, err := service.CreateSchedule(ctx, tc.input)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestSqsServiceImpl_RunDueSchedules(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	schedule := Schedule{
+		ID:        "nightly",
+		Action:    ScheduleActionPurge,
+		QueueURL:  "https://sqs.local/tmp",
+		Cron:      "0 3 * * *",
+		CreatedAt: created,
+	}
+
+	t.Run("skips schedules that are not due", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return created.Add(time.Hour) }}
+
+		store.EXPECT().Schedules().Return([]Schedule{schedule}, nil).Once()
+
+		require.NoError(t, service.RunDueSchedules(ctx))
+	})
+
+	t.Run("purges due queues and records the run", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		repo := NewMockSqsRepository(t)
+		now := time.Date(2024, 5, 3, 9, 0, 0, 0, time.UTC)
+		service := &SqsServiceImpl{repo: repo, store: store, clock: func() time.Time { return now }}
+
+		store.EXPECT().Schedules().Return([]Schedule{schedule}, nil).Once()
+		repo.EXPECT().PurgeQueue(ctx, "https://sqs.local/tmp").Return(nil).Once()
+		saved := expectScheduleUpdate(store, schedule)
+
+		require.NoError(t, service.RunDueSchedules(ctx))
+		assert.Equal(t, now, saved.LastRunAt)
+		assert.Equal(t, []ScheduleRun{{StartedAt: now, FinishedAt: now}}, saved.History)
+	})
+
+	t.Run("notifies on failure and caps history", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		repo := NewMockSqsRepository(t)
+		notifier := NewMockNotifier(t)
+		now := time.Date(2024, 5, 3, 9, 0, 0, 0, time.UTC)
+		service := &SqsServiceImpl{repo: repo, store: store, notifiers: map[NotificationChannel]Notifier{NotificationChannelWebhook: notifier}, clock: func() time.Time { return now }}
+
+		withHistory := schedule
+		withHistory.LastRunAt = time.Date(2024, 5, 2, 3, 0, 0, 0, time.UTC)
+		withHistory.History = make([]ScheduleRun, maxScheduleHistory)
+
+		store.EXPECT().Schedules().Return([]Schedule{withHistory}, nil).Once()
+		repo.EXPECT().PurgeQueue(ctx, "https://sqs.local/tmp").Return(errors.New("access denied")).Once()
+		notifier.EXPECT().
+			Notify(ctx, mock.MatchedBy(func(n Notification) bool {
+				return n.Title == "Scheduled purge failed" && assert.Contains(t, n.Text, "access denied")
+			})).
+			Return(nil).
+			Once()
+		saved := expectScheduleUpdate(store, withHistory)
+
+		require.NoError(t, service.RunDueSchedules(ctx))
+		assert.Len(t, saved.History, maxScheduleHistory)
+		assert.Equal(t, "access denied", saved.History[0].Error)
+	})
+
+	t.Run("runs a one-off schedule once when it is due", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		repo := NewMockSqsRepository(t)
+		runAt := time.Date(2024, 5, 3, 9, 0, 0, 0, time.UTC)
+		now := runAt.Add(20 * time.Second)
+		service := &SqsServiceImpl{repo: repo, store: store, clock: func() time.Time { return now }}
+
+		oneOff := Schedule{ID: "once", Action: ScheduleActionPurge, QueueURL: "https://sqs.local/tmp", RunAt: runAt, CreatedAt: created}
+		later := oneOff
+		later.ID = "later"
+		later.RunAt = now.Add(time.Minute)
+		done := oneOff
+		done.ID = "done"
+		done.LastRunAt = runAt
+
+		store.EXPECT().Schedules().Return([]Schedule{oneOff, later, done}, nil).Once()
+		repo.EXPECT().PurgeQueue(ctx, "https://sqs.local/tmp").Return(nil).Once()
+		saved := expectScheduleUpdate(store, oneOff)
+
+		require.NoError(t, service.RunDueSchedules(ctx))
+		assert.Equal(t, now, saved.LastRunAt)
+		assert.True(t, saved.nextRunAt().IsZero())
+	})
+
+	t.Run("keeps a schedule deleted or changed while it runs", func(t *testing.T) {
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		repo := NewMockSqsRepository(t)
+		now := time.Date(2024, 5, 3, 9, 0, 0, 0, time.UTC)
+		service := &SqsServiceImpl{repo: repo, store: store, clock: func() time.Time { return now }}
+
+		paused := schedule
+		paused.ID = "paused"
+		paused.QueueURL = "https://sqs.local/other"
+		require.NoError(t, store.SaveSchedule(schedule))
+		require.NoError(t, store.SaveSchedule(paused))
+
+		repo.EXPECT().
+			PurgeQueue(ctx, "https://sqs.local/tmp").
+			RunAndReturn(func(context.Context, string) error {
+				return store.DeleteSchedule("nightly")
+			}).
+			Once()
+		repo.EXPECT().
+			PurgeQueue(ctx, "https://sqs.local/other").
+			RunAndReturn(func(context.Context, string) error {
+				enabled := false
+				_, err := service.UpdateSchedule(ctx, "paused", UpdateScheduleInput{Enabled: &enabled})
+				return err
+			}).
+			Once()
+
+		require.NoError(t, service.RunDueSchedules(ctx))
+
+		_, ok, err := store.Schedule("nightly")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		got, ok, err := store.Schedule("paused")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.True(t, got.Disabled)
+		assert.Equal(t, now, got.LastRunAt)
+		assert.Len(t, got.History, 1)
+	})
+}
+
+// expectScheduleUpdate expects one update of stored and returns the schedule the update makes of it.
+func expectScheduleUpdate(store *MockLocalStore, stored Schedule) *Schedule {
+	saved := new(Schedule)
+	store.EXPECT().
+		UpdateSchedule(stored.ID, mock.Anything).
+		RunAndReturn(func(_ string, update func(schedule *Schedule) error) error {
+			*saved = stored
+			saved.History = slices.Clone(stored.History)
+			return update(saved)
+		}).
+		Once()
+	return saved
+}
+
+func TestSqsServiceImpl_UpdateSchedule(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	resumed := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	stored := Schedule{
+		ID:        "heartbeat",
+		Action:    ScheduleActionSend,
+		QueueURL:  "https://sqs.local/tmp",
+		Cron:      "@hourly",
+		Message:   &ScheduledMessage{Body: "ping"},
+		Disabled:  true,
+		CreatedAt: created,
+	}
+
+	t.Run("re-enables without replaying missed runs", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return resumed }}
+
+		cron := " */15 * * * * "
+		enabled := true
+		saved := expectScheduleUpdate(store, stored)
+
+		schedule, err := service.UpdateSchedule(ctx, "heartbeat", UpdateScheduleInput{
+			Cron:    &cron,
+			Message: &ScheduledMessage{Body: "pong", Attributes: []MessageAttribute{{Name: " k ", Value: "v"}, {Name: ""}}},
+			Enabled: &enabled,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, resumed.Add(15*time.Minute), schedule.NextRunAt)
+		assert.False(t, saved.Disabled)
+		assert.Equal(t, "*/15 * * * *", saved.Cron)
+		assert.Equal(t, resumed, saved.ResumedAt)
+		assert.Equal(t, &ScheduledMessage{Body: "pong", Attributes: []MessageAttribute{{Name: "k", Value: "v"}}}, saved.Message)
+	})
+
+	t.Run("rejects an invalid cron expression", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store}
+
+		cron := "nope"
+		expectScheduleUpdate(store, stored)
+
+		_, err := service.UpdateSchedule(ctx, "heartbeat", UpdateScheduleInput{Cron: &cron})
+		assert.EqualError(t, err, `invalid cron expression "nope"`)
+	})
+
+	t.Run("moves a one-off schedule that has not run", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, clock: func() time.Time { return resumed }}
+
+		oneOff := stored
+		oneOff.Cron = ""
+		oneOff.RunAt = resumed.Add(time.Hour)
+		runAt := resumed.Add(48 * time.Hour)
+		saved := expectScheduleUpdate(store, oneOff)
+
+		_, err := service.UpdateSchedule(ctx, "heartbeat", UpdateScheduleInput{RunAt: &runAt})
+		require.NoError(t, err)
+		assert.Equal(t, runAt, saved.RunAt)
+
+		cron := "@daily"
+		expectScheduleUpdate(store, oneOff)
+		_, err = service.UpdateSchedule(ctx, "heartbeat", UpdateScheduleInput{Cron: &cron})
+		assert.EqualError(t, err, "a one-off schedule has no cron expression")
+
+		oneOff.LastRunAt = oneOff.RunAt
+		expectScheduleUpdate(store, oneOff)
+		_, err = service.UpdateSchedule(ctx, "heartbeat", UpdateScheduleInput{RunAt: &runAt})
+		assert.EqualError(t, err, "the schedule has already run")
+	})
+
+	t.Run("reports unknown schedules", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store}
+
+		store.EXPECT().UpdateSchedule("missing", mock.Anything).Return(ErrScheduleNotFound).Once()
+
+		_, err := service.UpdateSchedule(ctx, "missing", UpdateScheduleInput{})
+		assert.ErrorIs(t, err, ErrScheduleNotFound)
+	})
+}
+
+func TestSqsServiceImpl_RunDueSchedules_Send(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 5, 1, 13, 0, 0, 0, time.UTC)
+
+	store := NewMockLocalStore(t)
+	repo := NewMockSqsRepository(t)
+	service := &SqsServiceImpl{repo: repo, store: store, clock: func() time.Time { return now }}
+
+	heartbeat := Schedule{
+		ID:        "heartbeat",
+		Action:    ScheduleActionSend,
+		QueueURL:  "https://sqs.local/tmp.fifo",
+		Cron:      "@hourly",
+		Message:   &ScheduledMessage{Body: "ping", MessageGroupID: "g", Attributes: []MessageAttribute{{Name: "k", Value: "v"}}},
+		CreatedAt: created,
+	}
+	paused := heartbeat
+	paused.ID = "paused"
+	paused.Disabled = true
+
+	store.EXPECT().Schedules().Return([]Schedule{heartbeat, paused}, nil).Once()
+	repo.EXPECT().
+		SendMessage(ctx, SendMessageRepositoryInput{
+			QueueURL:               "https://sqs.local/tmp.fifo",
+			Body:                   "ping",
+			MessageGroupID:         "g",
+			MessageDeduplicationID: "heartbeat-" + strconv.FormatInt(now.UnixNano(), 10),
+			Attributes:             map[string]string{"k": "v"},
+		}).
+		Return(nil).
+		Once()
+	expectScheduleUpdate(store, heartbeat)
+
+	require.NoError(t, service.RunDueSchedules(ctx))
+}
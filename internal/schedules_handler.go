@@ -0,0 +1,502 @@
+package internal
+
+import (
+	"encoding/json"
+	"html/template"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	displayTimeLayout = "2006-01-02 15:04:05 MST"
+	// runAtInputLayout is the value of a datetime-local input, in server local time like cron.
+	runAtInputLayout = "2006-01-02T15:04"
+	// maxScheduleListResults bounds maxResults of /api/v1/schedules.
+	maxScheduleListResults = 1000
+)
+
+type schedulesPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	Flash        *pageFlash
+	ErrorMessage string
+	Schedules    []scheduleView
+	Queues       []queueOption
+	Actions      []selectOption
+	Timings      []selectOption
+	Form         scheduleForm
+}
+
+type scheduleView struct {
+	ID         string
+	Action     string
+	QueueName  string
+	QueueURL   string
+	Cron       string
+	RunAt      string
+	Done       bool
+	Enabled    bool
+	Body       string
+	NextRunAt  string
+	LastRunAt  string
+	LastStatus string
+	History    []scheduleRunView
+}
+
+type scheduleRunView struct {
+	StartedAt string
+	Duration  string
+	Error     string
+}
+
+type queueOption struct {
+	Name string
+	URL  string
+}
+
+type selectOption struct {
+	Value string
+	Label string
+}
+
+// scheduleForm is the create form. Timing is scheduleTimingRecurring, which uses Cron, or
+// scheduleTimingOnce, which uses RunAt.
+type scheduleForm struct {
+	Action         string
+	QueueURL       string
+	Timing         string
+	Cron           string
+	RunAt          string
+	Body           string
+	MessageGroupID string
+}
+
+const (
+	scheduleTimingRecurring = "recurring"
+	scheduleTimingOnce      = "once"
+)
+
+type scheduleRequest struct {
+	Action   string                `json:"action"`
+	QueueURL string                `json:"queueUrl"`
+	Cron     string                `json:"cron"`
+	RunAt    *time.Time            `json:"runAt"`
+	Message  *scheduledMessageItem `json:"message"`
+	Enabled  *bool                 `json:"enabled"`
+}
+
+type scheduleUpdateRequest struct {
+	Cron    *string               `json:"cron"`
+	RunAt   *time.Time            `json:"runAt"`
+	Message *scheduledMessageItem `json:"message"`
+	Enabled *bool                 `json:"enabled"`
+}
+
+type scheduledMessageItem struct {
+	Body           string                    `json:"body"`
+	MessageGroupID string                    `json:"messageGroupId,omitempty"`
+	Attributes     []messageAttributePayload `json:"attributes,omitempty"`
+}
+
+type scheduleResponse struct {
+	ID        string                `json:"id"`
+	Action    string                `json:"action"`
+	QueueURL  string                `json:"queueUrl"`
+	Cron      string                `json:"cron,omitempty"`
+	RunAt     string                `json:"runAt,omitempty"`
+	Enabled   bool                  `json:"enabled"`
+	Message   *scheduledMessageItem `json:"message,omitempty"`
+	CreatedAt string                `json:"createdAt"`
+	NextRunAt string                `json:"nextRunAt,omitempty"`
+	LastRunAt string                `json:"lastRunAt,omitempty"`
+	History   []scheduleRunItem     `json:"history"`
+}
+
+type scheduleRunItem struct {
+	StartedAt  string `json:"startedAt"`
+	FinishedAt string `json:"finishedAt"`
+	Error      string `json:"error,omitempty"`
+}
+
+type schedulesResponse struct {
+	Schedules []scheduleResponse `json:"schedules"`
+	NextToken string             `json:"nextToken,omitempty"`
+}
+
+// SchedulesHandler renders the list of scheduled jobs and the form to create one. A queue_url
+// query parameter, as the send form links it, starts the form as a one-off send to that queue.
+func (h *HandlerImpl) SchedulesHandler(w http.ResponseWriter, r *http.Request) {
+	var flash *pageFlash
+	query := r.URL.Query()
+	if query.Get("created") == "1" {
+		flash = &pageFlash{Message: "Schedule was created successfully.", Kind: "success"}
+	} else if query.Get("deleted") == "1" {
+		flash = &pageFlash{Message: "Schedule was deleted successfully.", Kind: "success"}
+	} else if query.Get("updated") == "1" {
+		flash = &pageFlash{Message: "Schedule was updated successfully.", Kind: "success"}
+	}
+
+	form := scheduleForm{Action: string(ScheduleActionPurge), Timing: scheduleTimingRecurring, Cron: "@daily"}
+	if queueURL := strings.TrimSpace(query.Get("queue_url")); queueURL != "" {
+		form.Action = string(ScheduleActionSend)
+		form.QueueURL = queueURL
+		form.Timing = scheduleTimingOnce
+	}
+
+	h.renderSchedules(w, r, schedulesPageData{Flash: flash, Form: form})
+}
+
+// PostScheduleHandler creates a schedule from the form on the schedules page.
+func (h *HandlerImpl) PostScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	form := scheduleForm{
+		Action:         r.FormValue("action"),
+		QueueURL:       strings.TrimSpace(r.FormValue("queue_url")),
+		Timing:         r.FormValue("timing"),
+		Cron:           strings.TrimSpace(r.FormValue("cron")),
+		RunAt:          strings.TrimSpace(r.FormValue("run_at")),
+		Body:           r.FormValue("body"),
+		MessageGroupID: strings.TrimSpace(r.FormValue("message_group_id")),
+	}
+	if form.Timing != scheduleTimingOnce {
+		form.Timing = scheduleTimingRecurring
+	}
+
+	input := CreateScheduleInput{
+		Action:   ScheduleAction(form.Action),
+		QueueURL: form.QueueURL,
+	}
+	if input.Action == ScheduleActionSend {
+		input.Message = &ScheduledMessage{Body: form.Body, MessageGroupID: form.MessageGroupID}
+	}
+
+	var err error
+	if form.Timing == scheduleTimingOnce {
+		input.RunAt, err = time.ParseInLocation(runAtInputLayout, form.RunAt, time.Local)
+		if err != nil {
+			err = errors.New("run time must be a date and time")
+		}
+	} else {
+		input.Cron = form.Cron
+	}
+	if err == nil {
+		_, err = h.s.CreateSchedule(r.Context(), input)
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create schedule", slog.String("queue_url", form.QueueURL), slog.Any("error", err))
+		w.WriteHeader(http.StatusBadRequest)
+		h.renderSchedules(w, r, schedulesPageData{ErrorMessage: err.Error(), Form: form})
+		return
+	}
+
+	http.Redirect(w, r, "/schedules?created=1", http.StatusSeeOther)
+}
+
+// DeleteScheduleHandler removes a schedule and returns to the schedules page.
+func (h *HandlerImpl) DeleteScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if err := h.s.DeleteSchedule(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete schedule", slog.String("schedule_id", id), slog.Any("error", err))
+		http.Error(w, "failed to delete schedule", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/schedules?deleted=1", http.StatusSeeOther)
+}
+
+// ToggleScheduleHandler enables or disables a schedule from the schedules page.
+func (h *HandlerImpl) ToggleScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	id := r.PathValue("id")
+	enabled := r.FormValue("enabled") == "true"
+	if _, err := h.s.UpdateSchedule(r.Context(), id, UpdateScheduleInput{Enabled: &enabled}); err != nil {
+		slog.ErrorContext(r.Context(), "failed to update schedule", slog.String("schedule_id", id), slog.Any("error", err))
+		http.Error(w, "failed to update schedule", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/schedules?updated=1", http.StatusSeeOther)
+}
+
+// ListSchedulesAPI returns the schedules as JSON, every one unless maxResults asks for pages that are
+// continued with nextToken.
+func (h *HandlerImpl) ListSchedulesAPI(w http.ResponseWriter, r *http.Request) {
+	paging, err := pagingParamsFromQuery(r.URL.Query(), maxScheduleListResults)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	start := 0
+	if paging.NextToken != "" {
+		if start, err = decodePageToken(paging.NextToken, "schedules"); err != nil {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	schedules, err := h.s.Schedules(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load schedules", slog.Any("error", err))
+		writeJSONError(w, http.StatusInternalServerError, "failed to load schedules")
+		return
+	}
+
+	start = min(start, len(schedules))
+	end := len(schedules)
+	if paging.MaxResults > 0 {
+		end = min(start+paging.MaxResults, end)
+	}
+	response := schedulesResponse{
+		Schedules: make([]scheduleResponse, 0, end-start),
+		NextToken: nextPageToken("schedules", end, len(schedules)),
+	}
+	for _, schedule := range schedules[start:end] {
+		response.Schedules = append(response.Schedules, newScheduleResponse(schedule))
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// GetScheduleAPI returns a single schedule as JSON.
+func (h *HandlerImpl) GetScheduleAPI(w http.ResponseWriter, r *http.Request) {
+	schedule, err := h.s.Schedule(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newScheduleResponse(schedule))
+}
+
+// CreateScheduleAPI creates a schedule from a JSON body.
+func (h *HandlerImpl) CreateScheduleAPI(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	var payload scheduleRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	input := CreateScheduleInput{
+		Action:   ScheduleAction(payload.Action),
+		QueueURL: payload.QueueURL,
+		Cron:     payload.Cron,
+		Message:  payload.Message.toScheduledMessage(),
+	}
+	if payload.RunAt != nil {
+		input.RunAt = *payload.RunAt
+	}
+	if payload.Enabled != nil {
+		input.Disabled = !*payload.Enabled
+	}
+
+	schedule, err := h.s.CreateSchedule(r.Context(), input)
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, newScheduleResponse(schedule))
+}
+
+// UpdateScheduleAPI applies a partial update from a JSON body.
+func (h *HandlerImpl) UpdateScheduleAPI(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	var payload scheduleUpdateRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	schedule, err := h.s.UpdateSchedule(r.Context(), r.PathValue("id"), UpdateScheduleInput{
+		Cron:    payload.Cron,
+		RunAt:   payload.RunAt,
+		Message: payload.Message.toScheduledMessage(),
+		Enabled: payload.Enabled,
+	})
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newScheduleResponse(schedule))
+}
+
+// DeleteScheduleAPI removes a schedule.
+func (h *HandlerImpl) DeleteScheduleAPI(w http.ResponseWriter, r *http.Request) {
+	if err := h.s.DeleteSchedule(r.Context(), r.PathValue("id")); err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deleteMessageResponse{Message: "Schedule deleted."})
+}
+
+func decodeJSONBody(w http.ResponseWriter, r *http.Request, payload any) bool {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(payload); err != nil {
+		if errors.Is(err, io.EOF) {
+			writeJSONError(w, http.StatusBadRequest, "request body is required")
+			return false
+		}
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return false
+	}
+	return true
+}
+
+func writeScheduleError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrScheduleNotFound) {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	slog.Error("schedule request failed", slog.Any("error", err))
+	writeJSONError(w, http.StatusBadRequest, err.Error())
+}
+
+func (m *scheduledMessageItem) toScheduledMessage() *ScheduledMessage {
+	if m == nil {
+		return nil
+	}
+
+	message := &ScheduledMessage{Body: m.Body, MessageGroupID: m.MessageGroupID}
+	for _, attr := range m.Attributes {
+		message.Attributes = append(message.Attributes, MessageAttribute(attr))
+	}
+	return message
+}
+
+func newScheduleResponse(schedule Schedule) scheduleResponse {
+	response := scheduleResponse{
+		ID:        schedule.ID,
+		Action:    string(schedule.Action),
+		QueueURL:  schedule.QueueURL,
+		Cron:      schedule.Cron,
+		Enabled:   !schedule.Disabled,
+		CreatedAt: schedule.CreatedAt.Format(time.RFC3339),
+		History:   make([]scheduleRunItem, 0, len(schedule.History)),
+	}
+	if schedule.isOneOff() {
+		response.RunAt = schedule.RunAt.Format(time.RFC3339)
+	}
+	if schedule.Message != nil {
+		response.Message = &scheduledMessageItem{
+			Body:           schedule.Message.Body,
+			MessageGroupID: schedule.Message.MessageGroupID,
+		}
+		for _, attr := range schedule.Message.Attributes {
+			response.Message.Attributes = append(response.Message.Attributes, messageAttributePayload(attr))
+		}
+	}
+	if !schedule.NextRunAt.IsZero() {
+		response.NextRunAt = schedule.NextRunAt.Format(time.RFC3339)
+	}
+	if !schedule.LastRunAt.IsZero() {
+		response.LastRunAt = schedule.LastRunAt.Format(time.RFC3339)
+	}
+	for _, run := range schedule.History {
+		response.History = append(response.History, scheduleRunItem{
+			StartedAt:  run.StartedAt.Format(time.RFC3339),
+			FinishedAt: run.FinishedAt.Format(time.RFC3339),
+			Error:      run.Error,
+		})
+	}
+	return response
+}
+
+func (h *HandlerImpl) renderSchedules(w http.ResponseWriter, r *http.Request, data schedulesPageData) {
+	data.Title = "Schedules"
+	data.ViteTags = fragments["assets/js/schedules.ts"].Tags
+	data.Actions = selectOptions()
+	data.Timings = []selectOption{
+		{Value: scheduleTimingRecurring, Label: "Recurring (cron)"},
+		{Value: scheduleTimingOnce, Label: "Once at"},
+	}
+
+	schedules, err := h.s.Schedules(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load schedules", slog.Any("error", err))
+		data.ErrorMessage = "Failed to load schedules."
+	}
+	for _, schedule := range schedules {
+		data.Schedules = append(data.Schedules, newScheduleView(schedule))
+	}
+
+	queues, err := h.s.Queues(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to load queue list", slog.Any("error", err))
+		data.ErrorMessage = "Failed to load queues."
+	}
+	for _, queue := range queues {
+		data.Queues = append(data.Queues, queueOption{Name: queue.Name, URL: queue.URL})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates["schedules"].Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "failed to render schedules template", slog.Any("error", err))
+		http.Error(w, "template error", http.StatusInternalServerError)
+	}
+}
+
+func newScheduleView(schedule Schedule) scheduleView {
+	view := scheduleView{
+		ID:         url.PathEscape(schedule.ID),
+		Action:     string(schedule.Action),
+		QueueName:  extractQueueName(schedule.QueueURL),
+		QueueURL:   url.QueryEscape(schedule.QueueURL),
+		Cron:       schedule.Cron,
+		Enabled:    !schedule.Disabled,
+		NextRunAt:  "-",
+		LastRunAt:  "-",
+		LastStatus: "never run",
+	}
+	if schedule.isOneOff() {
+		view.RunAt = schedule.RunAt.Local().Format(displayTimeLayout)
+		view.Done = !schedule.LastRunAt.IsZero()
+	}
+	if schedule.Message != nil {
+		view.Body = schedule.Message.Body
+	}
+	if !schedule.NextRunAt.IsZero() {
+		view.NextRunAt = schedule.NextRunAt.Local().Format(displayTimeLayout)
+	}
+	if !schedule.LastRunAt.IsZero() {
+		view.LastRunAt = schedule.LastRunAt.Local().Format(displayTimeLayout)
+	}
+	if len(schedule.History) > 0 {
+		view.LastStatus = "succeeded"
+		if schedule.History[0].Error != "" {
+			view.LastStatus = "failed"
+		}
+	}
+	for _, run := range schedule.History {
+		view.History = append(view.History, scheduleRunView{
+			StartedAt: run.StartedAt.Local().Format(displayTimeLayout),
+			Duration:  run.FinishedAt.Sub(run.StartedAt).String(),
+			Error:     run.Error,
+		})
+	}
+	return view
+}
+
+func selectOptions() []selectOption {
+	return []selectOption{
+		{Value: string(ScheduleActionPurge), Label: "Purge queue"},
+		{Value: string(ScheduleActionSend), Label: "Send message"},
+	}
+}
@@ -0,0 +1,440 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_SchedulesHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/schedules?created=1", nil)
+	rr := httptest.NewRecorder()
+
+	var captured schedulesPageData
+	captureTemplate(t, "schedules", func(data schedulesPageData) { captured = data })
+	installFragment(t, "assets/js/schedules.ts", template.HTML(`<script data-test="schedules"></script>`))
+
+	started := time.Date(2024, 5, 2, 3, 0, 0, 0, time.UTC)
+	mockService.EXPECT().
+		Schedules(mock.Anything).
+		Return([]Schedule{{
+			ID:        "nightly",
+			Action:    ScheduleActionPurge,
+			QueueURL:  "https://sqs.local/tmp",
+			Cron:      "@daily",
+			LastRunAt: started,
+			History:   []ScheduleRun{{StartedAt: started, FinishedAt: started.Add(time.Second), Error: "boom"}},
+		}}, nil).
+		Once()
+	mockService.EXPECT().
+		Queues(mock.Anything).
+		Return([]QueueSummary{{Name: "tmp", URL: "https://sqs.local/tmp"}}, nil).
+		Once()
+
+	handler.SchedulesHandler(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "Schedules", captured.Title)
+	assert.Equal(t, template.HTML(`<script data-test="schedules"></script>`), captured.ViteTags)
+	assert.Equal(t, &pageFlash{Message: "Schedule was created successfully.", Kind: "success"}, captured.Flash)
+	assert.Equal(t, []queueOption{{Name: "tmp", URL: "https://sqs.local/tmp"}}, captured.Queues)
+	if assert.Len(t, captured.Schedules, 1) {
+		view := captured.Schedules[0]
+		assert.Equal(t, "tmp", view.QueueName)
+		assert.Equal(t, url.QueryEscape("https://sqs.local/tmp"), view.QueueURL)
+		assert.Equal(t, "failed", view.LastStatus)
+		assert.Equal(t, "-", view.NextRunAt)
+		if assert.Len(t, view.History, 1) {
+			assert.Equal(t, "1s", view.History[0].Duration)
+			assert.Equal(t, "boom", view.History[0].Error)
+		}
+	}
+}
+
+func TestHandlerImpl_PostScheduleHandler(t *testing.T) {
+	newRequest := func() *http.Request {
+		form := url.Values{}
+		form.Set("action", "purge")
+		form.Set("queue_url", "https://sqs.local/tmp")
+		form.Set("cron", "0 3 * * *")
+
+		req := httptest.NewRequest(http.MethodPost, "/schedules", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+	wantInput := CreateScheduleInput{Action: ScheduleActionPurge, QueueURL: "https://sqs.local/tmp", Cron: "0 3 * * *"}
+
+	t.Run("redirects after creating", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		req := newRequest()
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			CreateSchedule(mock.MatchedBy(func(ctx context.Context) bool { return ctx == req.Context() }), wantInput).
+			Return(Schedule{ID: "nightly"}, nil).
+			Once()
+
+		handler.PostScheduleHandler(rr, req)
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/schedules?created=1", rr.Header().Get("Location"))
+	})
+
+	t.Run("re-renders the form on validation errors", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		req := newRequest()
+		rr := httptest.NewRecorder()
+
+		var captured schedulesPageData
+		captureTemplate(t, "schedules", func(data schedulesPageData) { captured = data })
+		installFragment(t, "assets/js/schedules.ts", "")
+
+		mockService.EXPECT().
+			CreateSchedule(mock.Anything, wantInput).
+			Return(Schedule{}, errors.New(`invalid cron expression "0 3 * * *"`)).
+			Once()
+		mockService.EXPECT().Schedules(mock.Anything).Return([]Schedule{}, nil).Once()
+		mockService.EXPECT().Queues(mock.Anything).Return([]QueueSummary{}, nil).Once()
+
+		handler.PostScheduleHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, `invalid cron expression "0 3 * * *"`, captured.ErrorMessage)
+		assert.Equal(t, scheduleForm{Action: "purge", QueueURL: "https://sqs.local/tmp", Timing: "recurring", Cron: "0 3 * * *"}, captured.Form)
+	})
+
+	t.Run("creates a one-off send at a local time", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		form := url.Values{
+			"action":    {"send"},
+			"queue_url": {"https://sqs.local/tmp"},
+			"timing":    {"once"},
+			"cron":      {"@daily"},
+			"run_at":    {"2024-05-02T09:30"},
+			"body":      {"later"},
+		}
+		req := httptest.NewRequest(http.MethodPost, "/schedules", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			CreateSchedule(mock.Anything, CreateScheduleInput{
+				Action:   ScheduleActionSend,
+				QueueURL: "https://sqs.local/tmp",
+				RunAt:    time.Date(2024, 5, 2, 9, 30, 0, 0, time.Local),
+				Message:  &ScheduledMessage{Body: "later"},
+			}).
+			Return(Schedule{ID: "later"}, nil).
+			Once()
+
+		handler.PostScheduleHandler(rr, req)
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/schedules?created=1", rr.Header().Get("Location"))
+	})
+
+	t.Run("rejects a one-off schedule without a run time", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		form := url.Values{"action": {"purge"}, "queue_url": {"https://sqs.local/tmp"}, "timing": {"once"}}
+		req := httptest.NewRequest(http.MethodPost, "/schedules", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+
+		var captured schedulesPageData
+		captureTemplate(t, "schedules", func(data schedulesPageData) { captured = data })
+		installFragment(t, "assets/js/schedules.ts", "")
+		mockService.EXPECT().Schedules(mock.Anything).Return([]Schedule{}, nil).Once()
+		mockService.EXPECT().Queues(mock.Anything).Return([]QueueSummary{}, nil).Once()
+
+		handler.PostScheduleHandler(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "run time must be a date and time", captured.ErrorMessage)
+		assert.Equal(t, "once", captured.Form.Timing)
+	})
+}
+
+func TestHandlerImpl_DeleteScheduleHandler(t *testing.T) {
+	t.Run("redirects after deleting", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/schedules/nightly/delete", nil)
+		req.SetPathValue("id", "nightly")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().DeleteSchedule(mock.Anything, "nightly").Return(nil).Once()
+
+		handler.DeleteScheduleHandler(rr, req)
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/schedules?deleted=1", rr.Header().Get("Location"))
+	})
+
+	t.Run("reports service errors", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/schedules/missing/delete", nil)
+		req.SetPathValue("id", "missing")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().DeleteSchedule(mock.Anything, "missing").Return(errors.New("not found")).Once()
+
+		handler.DeleteScheduleHandler(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, "failed to delete schedule\n", rr.Body.String())
+	})
+}
+
+func TestHandlerImpl_ToggleScheduleHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodPost, "/schedules/nightly/toggle", strings.NewReader("enabled=false"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("id", "nightly")
+	rr := httptest.NewRecorder()
+
+	disabled := false
+	mockService.EXPECT().
+		UpdateSchedule(mock.Anything, "nightly", UpdateScheduleInput{Enabled: &disabled}).
+		Return(Schedule{ID: "nightly", Disabled: true}, nil).
+		Once()
+
+	handler.ToggleScheduleHandler(rr, req)
+
+	assert.Equal(t, http.StatusSeeOther, rr.Code)
+	assert.Equal(t, "/schedules?updated=1", rr.Header().Get("Location"))
+}
+
+func TestHandlerImpl_CreateScheduleAPI(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("creates a recurring send", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		body := `{"action":"send","queueUrl":"https://sqs.local/tmp","cron":"@hourly","enabled":false,` +
+			`"message":{"body":"ping","attributes":[{"name":"k","value":"v"}]}}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/schedules", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		message := &ScheduledMessage{Body: "ping", Attributes: []MessageAttribute{{Name: "k", Value: "v"}}}
+		mockService.EXPECT().
+			CreateSchedule(mock.Anything, CreateScheduleInput{
+				Action:   ScheduleActionSend,
+				QueueURL: "https://sqs.local/tmp",
+				Cron:     "@hourly",
+				Message:  message,
+				Disabled: true,
+			}).
+			Return(Schedule{
+				ID:        "heartbeat",
+				Action:    ScheduleActionSend,
+				QueueURL:  "https://sqs.local/tmp",
+				Cron:      "@hourly",
+				Message:   message,
+				Disabled:  true,
+				CreatedAt: created,
+			}, nil).
+			Once()
+
+		handler.CreateScheduleAPI(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.JSONEq(t, `{
+			"id":"heartbeat","action":"send","queueUrl":"https://sqs.local/tmp","cron":"@hourly","enabled":false,
+			"message":{"body":"ping","attributes":[{"name":"k","value":"v"}]},
+			"createdAt":"2024-05-01T12:00:00Z","history":[]
+		}`, rr.Body.String())
+	})
+
+	t.Run("creates a one-off send", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		body := `{"action":"send","queueUrl":"https://sqs.local/tmp","runAt":"2024-05-02T09:30:00+09:00","message":{"body":"later"}}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/schedules", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+
+		runAt := time.Date(2024, 5, 2, 0, 30, 0, 0, time.UTC)
+		mockService.EXPECT().
+			CreateSchedule(mock.Anything, mock.MatchedBy(func(input CreateScheduleInput) bool {
+				return input.Cron == "" && input.RunAt.Equal(runAt) && input.Message.Body == "later"
+			})).
+			Return(Schedule{
+				ID:        "later",
+				Action:    ScheduleActionSend,
+				QueueURL:  "https://sqs.local/tmp",
+				RunAt:     runAt,
+				Message:   &ScheduledMessage{Body: "later"},
+				CreatedAt: created,
+				NextRunAt: runAt,
+			}, nil).
+			Once()
+
+		handler.CreateScheduleAPI(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.JSONEq(t, `{
+			"id":"later","action":"send","queueUrl":"https://sqs.local/tmp","runAt":"2024-05-02T00:30:00Z","enabled":true,
+			"message":{"body":"later"},
+			"createdAt":"2024-05-01T12:00:00Z","nextRunAt":"2024-05-02T00:30:00Z","history":[]
+		}`, rr.Body.String())
+	})
+
+	t.Run("rejects an empty body", func(t *testing.T) {
+		handler := NewHandler(NewMockSqsService(t))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/schedules", strings.NewReader(""))
+		rr := httptest.NewRecorder()
+
+		handler.CreateScheduleAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"request body is required"}`, rr.Body.String())
+	})
+
+	t.Run("reports validation errors", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/schedules", strings.NewReader(`{"action":"send"}`))
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			CreateSchedule(mock.Anything, CreateScheduleInput{Action: ScheduleActionSend}).
+			Return(Schedule{}, errors.New("queue url is required")).
+			Once()
+
+		handler.CreateScheduleAPI(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"queue url is required"}`, rr.Body.String())
+	})
+}
+
+func TestHandlerImpl_ScheduleAPI_ByID(t *testing.T) {
+	t.Run("get returns 404 for unknown schedules", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules/missing", nil)
+		req.SetPathValue("id", "missing")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().Schedule(mock.Anything, "missing").Return(Schedule{}, ErrScheduleNotFound).Once()
+
+		handler.GetScheduleAPI(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.JSONEq(t, `{"error":"schedule not found"}`, rr.Body.String())
+	})
+
+	t.Run("patch forwards partial updates", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/schedules/heartbeat", strings.NewReader(`{"enabled":true}`))
+		req.SetPathValue("id", "heartbeat")
+		rr := httptest.NewRecorder()
+
+		enabled := true
+		nextRun := time.Date(2024, 5, 1, 13, 0, 0, 0, time.UTC)
+		mockService.EXPECT().
+			UpdateSchedule(mock.Anything, "heartbeat", UpdateScheduleInput{Enabled: &enabled}).
+			Return(Schedule{ID: "heartbeat", Action: ScheduleActionPurge, Cron: "@hourly", NextRunAt: nextRun}, nil).
+			Once()
+
+		handler.UpdateScheduleAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"nextRunAt":"2024-05-01T13:00:00Z"`)
+		assert.Contains(t, rr.Body.String(), `"enabled":true`)
+	})
+
+	t.Run("delete removes the schedule", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/schedules/heartbeat", nil)
+		req.SetPathValue("id", "heartbeat")
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().DeleteSchedule(mock.Anything, "heartbeat").Return(nil).Once()
+
+		handler.DeleteScheduleAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"message":"Schedule deleted."}`, rr.Body.String())
+	})
+
+	t.Run("list returns every schedule", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules", nil)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			Schedules(mock.Anything).
+			Return([]Schedule{{ID: "a", Action: ScheduleActionPurge}, {ID: "b", Action: ScheduleActionSend}}, nil).
+			Once()
+
+		handler.ListSchedulesAPI(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var response schedulesResponse
+		if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+			t.Fatalf("unmarshal response: %v", err)
+		}
+		if assert.Len(t, response.Schedules, 2) {
+			assert.Equal(t, "a", response.Schedules[0].ID)
+			assert.Equal(t, "send", response.Schedules[1].Action)
+		}
+	})
+
+	t.Run("list pages with maxResults and nextToken", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		mockService.EXPECT().
+			Schedules(mock.Anything).
+			Return([]Schedule{{ID: "a"}, {ID: "b"}, {ID: "c"}}, nil).
+			Times(2)
+
+		rr := httptest.NewRecorder()
+		handler.ListSchedulesAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/schedules?maxResults=2", nil))
+		var first schedulesResponse
+		if err := json.Unmarshal(rr.Body.Bytes(), &first); err != nil {
+			t.Fatalf("unmarshal response: %v", err)
+		}
+		assert.Len(t, first.Schedules, 2)
+		assert.Equal(t, encodePageToken("schedules", 2), first.NextToken)
+
+		rr = httptest.NewRecorder()
+		handler.ListSchedulesAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/schedules?maxResults=2&nextToken="+first.NextToken, nil))
+		var second schedulesResponse
+		if err := json.Unmarshal(rr.Body.Bytes(), &second); err != nil {
+			t.Fatalf("unmarshal response: %v", err)
+		}
+		if assert.Len(t, second.Schedules, 1) {
+			assert.Equal(t, "c", second.Schedules[0].ID)
+		}
+		assert.Empty(t, second.NextToken)
+	})
+}
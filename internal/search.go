@@ -0,0 +1,278 @@
+package internal
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Kinds of search result.
+const (
+	SearchKindQueue          = "queue"
+	SearchKindTag            = "tag"
+	SearchKindDeletedQueue   = "deleted-queue"
+	SearchKindJobFileMessage = "job-file-message"
+)
+
+const (
+	// minSearchQueryLength keeps one-letter queries from matching nearly everything.
+	minSearchQueryLength = 2
+	// maxSearchResultsPerKind bounds the results of each kind, so a common word in job files does
+	// not crowd out the queues.
+	maxSearchResultsPerKind = 50
+	// maxSearchTagQueues bounds how many queues have their tags listed for one search.
+	maxSearchTagQueues = 100
+	// maxJobFileLineBytes bounds one line of a drain file; a 256 KiB body escaped as JSON fits.
+	maxJobFileLineBytes = 2 << 20
+	// searchSnippetRunes is how much of a message body is shown around a match.
+	searchSnippetRunes = 120
+)
+
+// SearchResult is one match of a global search. QueueURL is set for live queues and messages in
+// job files, TrashID for deleted queues and JobID for the drain job whose file holds a message.
+// Match is the text that matched, such as a tag or an excerpt of a message body.
+type SearchResult struct {
+	Kind      string
+	Name      string
+	QueueURL  string
+	TrashID   string
+	JobID     string
+	MessageID string
+	Match     string
+}
+
+// SearchResults holds the matches of a search grouped by kind in the order queues, tags, deleted
+// queues, messages in job files. Notices explain where the search was cut short.
+type SearchResults struct {
+	Query   string
+	Results []SearchResult
+	Notices []string
+}
+
+// Search looks for query, case-insensitively, in the names and tags of the queues, the deleted
+// queues kept in the trash and the message bodies in the files of the drain to file and purge with
+// backup jobs still remembered, so one query finds a queue or message without going through each
+// list. Those files are job output rather than an archive: they are removed with the job once
+// maxFinishedJobs newer jobs have finished, and the jobs are forgotten on restart.
+func (s *SqsServiceImpl) Search(ctx context.Context, query string) (SearchResults, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < minSearchQueryLength {
+		return SearchResults{}, errors.Newf("search for at least %d characters", minSearchQueryLength)
+	}
+	results := SearchResults{Query: query, Results: []SearchResult{}}
+	needle := strings.ToLower(query)
+	limited := func(kind string, matches []SearchResult) []SearchResult {
+		if len(matches) > maxSearchResultsPerKind {
+			results.Notices = append(results.Notices, fmt.Sprintf("Only the first %d %s matches are shown.", maxSearchResultsPerKind, searchKindLabels[kind]))
+			return matches[:maxSearchResultsPerKind]
+		}
+		return matches
+	}
+
+	queues, err := s.repo.ListQueues(ctx)
+	if err != nil {
+		return SearchResults{}, err
+	}
+	var names []SearchResult
+	for _, queue := range queues {
+		name := extractQueueName(queue.URL)
+		if strings.Contains(strings.ToLower(name), needle) {
+			names = append(names, SearchResult{Kind: SearchKindQueue, Name: name, QueueURL: queue.URL})
+		}
+	}
+	results.Results = append(results.Results, limited(SearchKindQueue, names)...)
+
+	if s.EndpointCapabilities(ctx).Tags {
+		tagged := queues
+		if len(tagged) > maxSearchTagQueues {
+			tagged = tagged[:maxSearchTagQueues]
+			results.Notices = append(results.Notices, fmt.Sprintf("Tags were searched on the first %d of %d queues.", maxSearchTagQueues, len(queues)))
+		}
+		tags, failed := s.searchTags(ctx, tagged, needle)
+		if failed > 0 {
+			results.Notices = append(results.Notices, fmt.Sprintf("The tags of %d queues could not be read.", failed))
+		}
+		results.Results = append(results.Results, limited(SearchKindTag, tags)...)
+	}
+
+	trashed, err := s.TrashedQueues(ctx)
+	if err != nil {
+		return SearchResults{}, err
+	}
+	var deleted []SearchResult
+	for _, queue := range trashed {
+		if match, ok := matchQueueTags(queue.Tags, needle); strings.Contains(strings.ToLower(queue.Name), needle) || ok {
+			deleted = append(deleted, SearchResult{Kind: SearchKindDeletedQueue, Name: queue.Name, TrashID: queue.ID, Match: match})
+		}
+	}
+	results.Results = append(results.Results, limited(SearchKindDeletedQueue, deleted)...)
+
+	drained, err := s.searchJobFiles(ctx, needle)
+	if err != nil {
+		return SearchResults{}, err
+	}
+	results.Results = append(results.Results, limited(SearchKindJobFileMessage, drained)...)
+
+	return results, nil
+}
+
+// searchKindLabels name the result kinds in notices and on the search page.
+var searchKindLabels = map[string]string{
+	SearchKindQueue:          "queue",
+	SearchKindTag:            "tag",
+	SearchKindDeletedQueue:   "deleted queue",
+	SearchKindJobFileMessage: "job file message",
+}
+
+// searchTags lists the tags of queues and returns the queues with a tag key or value containing
+// needle, in the order given, and how many queues could not be read.
+func (s *SqsServiceImpl) searchTags(ctx context.Context, queues []QueueSummary, needle string) ([]SearchResult, int) {
+	queueURLs := make([]string, len(queues))
+	for i, queue := range queues {
+		queueURLs[i] = queue.URL
+	}
+	tags, errs := s.listQueueTags(ctx, queueURLs)
+
+	var results []SearchResult
+	failed := 0
+	for i, queueURL := range queueURLs {
+		if errs[i] != nil {
+			failed++
+			continue
+		}
+		if match, ok := matchQueueTags(tags[i], needle); ok {
+			results = append(results, SearchResult{Kind: SearchKindTag, Name: extractQueueName(queueURL), QueueURL: queueURL, Match: match})
+		}
+	}
+	return results, failed
+}
+
+// listQueueTags lists the tags of queueURLs concurrently. The tags and the error of each queue are
+// at its index.
+func (s *SqsServiceImpl) listQueueTags(ctx context.Context, queueURLs []string) ([]map[string]string, []error) {
+	tags := make([]map[string]string, len(queueURLs))
+	errs := make([]error, len(queueURLs))
+	slots := make(chan struct{}, statsConcurrency)
+	var wg sync.WaitGroup
+	for i, queueURL := range queueURLs {
+		wg.Add(1)
+		go func(i int, queueURL string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			tags[i], errs[i] = s.repo.ListQueueTags(ctx, queueURL)
+		}(i, queueURL)
+	}
+	wg.Wait()
+	return tags, errs
+}
+
+// matchQueueTags returns the first tag, by key, whose key or value contains needle, as key=value.
+func matchQueueTags(tags map[string]string, needle string) (string, bool) {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if strings.Contains(strings.ToLower(key), needle) || strings.Contains(strings.ToLower(tags[key]), needle) {
+			return key + "=" + tags[key], true
+		}
+	}
+	return "", false
+}
+
+// searchJobFiles reads the files of the drain to file and purge with backup jobs still kept,
+// newest first, and returns the messages whose body contains needle. It stops once one more match
+// than the limit is found, so the caller can tell the results were cut short.
+func (s *SqsServiceImpl) searchJobFiles(ctx context.Context, needle string) ([]SearchResult, error) {
+	var results []SearchResult
+	for _, job := range s.jobs.list() {
+		if (job.Kind != "drain-to-file" && job.Kind != "purge-with-backup") || job.File == "" || job.Status == JobStatusRunning {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		matches, err := searchJobFile(job, needle, maxSearchResultsPerKind+1-len(results))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, matches...)
+		if len(results) > maxSearchResultsPerKind {
+			break
+		}
+	}
+	return results, nil
+}
+
+func searchJobFile(job Job, needle string, limit int) ([]SearchResult, error) {
+	file, err := os.Open(job.File)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to open the job file")
+	}
+	defer func() { _ = file.Close() }()
+
+	var results []SearchResult
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxJobFileLineBytes)
+	for scanner.Scan() && len(results) < limit {
+		var message drainedMessage
+		if json.Unmarshal(scanner.Bytes(), &message) != nil {
+			continue
+		}
+		lower := strings.ToLower(message.Body)
+		at := strings.Index(lower, needle)
+		if at < 0 {
+			continue
+		}
+		results = append(results, SearchResult{
+			Kind:      SearchKindJobFileMessage,
+			Name:      extractQueueName(job.QueueURL),
+			QueueURL:  job.QueueURL,
+			JobID:     job.ID,
+			MessageID: message.MessageID,
+			Match:     searchSnippet(message.Body, lower, at, len(needle)),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read the job file")
+	}
+	return results, nil
+}
+
+// searchSnippet cuts the part of body around the match at byte offset at, marking cut ends with
+// an ellipsis. lower is body in lower case; offsets are only used on it when both agree in length.
+func searchSnippet(body, lower string, at, length int) string {
+	if len(lower) != len(body) {
+		// Lower-casing changed the byte length, so the offset does not apply to body.
+		at, length = 0, 0
+	}
+	runes := []rune(body)
+	start := utf8.RuneCountInString(body[:at])
+	end := start + utf8.RuneCountInString(body[at:at+length])
+	around := max(0, (searchSnippetRunes-(end-start))/2)
+	from, to := max(0, start-around), min(len(runes), end+around)
+
+	snippet := string(runes[from:to])
+	if from > 0 {
+		snippet = "…" + snippet
+	}
+	if to < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
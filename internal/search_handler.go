@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type searchResultItem struct {
+	Kind      string `json:"kind"`
+	Title     string `json:"title"`
+	Detail    string `json:"detail,omitempty"`
+	Link      string `json:"link"`
+	QueueURL  string `json:"queueUrl,omitempty"`
+	MessageID string `json:"messageId,omitempty"`
+}
+
+type searchResponse struct {
+	Query   string             `json:"query"`
+	Results []searchResultItem `json:"results"`
+	Notices []string           `json:"notices,omitempty"`
+}
+
+type searchPageData struct {
+	Title        string
+	ViteTags     template.HTML
+	Query        string
+	ErrorMessage string
+	Groups       []searchResultGroup
+	Notices      []string
+}
+
+type searchResultGroup struct {
+	Kind    string
+	Label   string
+	Results []searchResultItem
+}
+
+// searchGroupLabels head the groups of results on the search page.
+var searchGroupLabels = map[string]string{
+	SearchKindQueue:          "Queues",
+	SearchKindTag:            "Queue tags",
+	SearchKindDeletedQueue:   "Deleted queues",
+	SearchKindJobFileMessage: "Messages in job files",
+}
+
+// SearchHandler renders the results of a global search for the q parameter.
+func (h *HandlerImpl) SearchHandler(w http.ResponseWriter, r *http.Request) {
+	data := searchPageData{
+		Title:    "Search",
+		ViteTags: fragments["assets/js/search.ts"].Tags,
+		Query:    strings.TrimSpace(r.URL.Query().Get("q")),
+	}
+
+	if data.Query != "" {
+		results, err := h.s.Search(r.Context(), data.Query)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "failed to search", slog.String("query", data.Query), slog.Any("error", err))
+			data.ErrorMessage = "Search failed: " + err.Error()
+		}
+		data.Notices = results.Notices
+		for _, item := range newSearchResultItems(results.Results) {
+			if len(data.Groups) == 0 || data.Groups[len(data.Groups)-1].Kind != item.Kind {
+				data.Groups = append(data.Groups, searchResultGroup{Kind: item.Kind, Label: searchGroupLabels[item.Kind]})
+			}
+			group := &data.Groups[len(data.Groups)-1]
+			group.Results = append(group.Results, item)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates["search"].Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "failed to render search template", slog.Any("error", err))
+		http.Error(w, "template error", http.StatusInternalServerError)
+	}
+}
+
+// SearchAPI returns the results of a global search for the q parameter as JSON.
+func (h *HandlerImpl) SearchAPI(w http.ResponseWriter, r *http.Request) {
+	results, err := h.s.Search(r.Context(), r.URL.Query().Get("q"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to search", slog.Any("error", err))
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, searchResponse{
+		Query:   results.Query,
+		Results: newSearchResultItems(results.Results),
+		Notices: results.Notices,
+	})
+}
+
+// newSearchResultItems links each result to the page it was found on: the queue, the trash, or
+// the download of the drain file that holds a message.
+func newSearchResultItems(results []SearchResult) []searchResultItem {
+	items := make([]searchResultItem, 0, len(results))
+	for _, result := range results {
+		item := searchResultItem{Kind: result.Kind, Title: result.Name, Detail: result.Match, QueueURL: result.QueueURL}
+		switch result.Kind {
+		case SearchKindQueue, SearchKindTag:
+			item.Link = "/queues/" + url.QueryEscape(result.QueueURL)
+		case SearchKindDeletedQueue:
+			item.Link = "/trash#trash-" + url.PathEscape(result.TrashID)
+		case SearchKindJobFileMessage:
+			item.Title = result.MessageID + " in " + result.Name
+			item.MessageID = result.MessageID
+			item.Link = "/api/v1/jobs/" + url.PathEscape(result.JobID) + "/file"
+		}
+		items = append(items, item)
+	}
+	return items
+}
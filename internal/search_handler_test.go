@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSearchResults() SearchResults {
+	return SearchResults{
+		Query: "order",
+		Results: []SearchResult{
+			{Kind: SearchKindQueue, Name: "orders", QueueURL: "https://sqs.local/000000000000/orders"},
+			{Kind: SearchKindTag, Name: "billing", QueueURL: "https://sqs.local/000000000000/billing", Match: "source=orders-api"},
+			{Kind: SearchKindDeletedQueue, Name: "old-orders", TrashID: "t1"},
+			{Kind: SearchKindJobFileMessage, Name: "billing", QueueURL: "https://sqs.local/000000000000/billing", JobID: "j1", MessageID: "m-1", Match: "order 42"},
+		},
+		Notices: []string{"The tags of 1 queues could not be read."},
+	}
+}
+
+func TestHandlerImpl_SearchHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	mockService.EXPECT().Search(mock.Anything, "order").Return(newTestSearchResults(), nil).Once()
+
+	var captured searchPageData
+	captureTemplate(t, "search", func(data searchPageData) { captured = data })
+	installFragment(t, "assets/js/search.ts", "")
+
+	rr := httptest.NewRecorder()
+	handler.SearchHandler(rr, httptest.NewRequest(http.MethodGet, "/search?q=order", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "order", captured.Query)
+	assert.Equal(t, []string{"The tags of 1 queues could not be read."}, captured.Notices)
+	assert.Equal(t, []searchResultGroup{
+		{Kind: SearchKindQueue, Label: "Queues", Results: []searchResultItem{{
+			Kind: SearchKindQueue, Title: "orders", QueueURL: "https://sqs.local/000000000000/orders",
+			Link: "/queues/https%3A%2F%2Fsqs.local%2F000000000000%2Forders",
+		}}},
+		{Kind: SearchKindTag, Label: "Queue tags", Results: []searchResultItem{{
+			Kind: SearchKindTag, Title: "billing", Detail: "source=orders-api", QueueURL: "https://sqs.local/000000000000/billing",
+			Link: "/queues/https%3A%2F%2Fsqs.local%2F000000000000%2Fbilling",
+		}}},
+		{Kind: SearchKindDeletedQueue, Label: "Deleted queues", Results: []searchResultItem{{
+			Kind: SearchKindDeletedQueue, Title: "old-orders", Link: "/trash#trash-t1",
+		}}},
+		{Kind: SearchKindJobFileMessage, Label: "Messages in job files", Results: []searchResultItem{{
+			Kind: SearchKindJobFileMessage, Title: "m-1 in billing", Detail: "order 42", MessageID: "m-1",
+			QueueURL: "https://sqs.local/000000000000/billing", Link: "/api/v1/jobs/j1/file",
+		}}},
+	}, captured.Groups)
+}
+
+func TestHandlerImpl_SearchHandler_WithoutQuery(t *testing.T) {
+	handler := NewHandler(NewMockSqsService(t))
+
+	var captured searchPageData
+	captureTemplate(t, "search", func(data searchPageData) { captured = data })
+	installFragment(t, "assets/js/search.ts", "")
+
+	rr := httptest.NewRecorder()
+	handler.SearchHandler(rr, httptest.NewRequest(http.MethodGet, "/search", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "Search", captured.Title)
+	assert.Empty(t, captured.Groups)
+}
+
+func TestHandlerImpl_SearchAPI(t *testing.T) {
+	t.Run("returns typed results with links", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		mockService.EXPECT().Search(mock.Anything, "order").Return(newTestSearchResults(), nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.SearchAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/search?q=order", nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response searchResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "order", response.Query)
+		require.Len(t, response.Results, 4)
+		assert.Equal(t, "/trash#trash-t1", response.Results[2].Link)
+		assert.Equal(t, "job-file-message", response.Results[3].Kind)
+		assert.Equal(t, []string{"The tags of 1 queues could not be read."}, response.Notices)
+	})
+
+	t.Run("rejects short queries", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		mockService.EXPECT().Search(mock.Anything, "a").Return(SearchResults{}, errors.New("search for at least 2 characters")).Once()
+
+		rr := httptest.NewRecorder()
+		handler.SearchAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/search?q=a", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"error":"search for at least 2 characters"}`, rr.Body.String())
+	})
+}
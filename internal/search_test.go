@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_Search(t *testing.T) {
+	ctx := context.Background()
+	orders := "https://sqs.local/000000000000/orders"
+	billing := "https://sqs.local/000000000000/billing"
+	audit := "https://sqs.local/000000000000/audit"
+
+	newService := func(t *testing.T, tags bool) (*SqsServiceImpl, *MockSqsRepository) {
+		repo := NewMockSqsRepository(t)
+		store, err := NewLocalStore("")
+		require.NoError(t, err)
+		require.NoError(t, store.SaveTrashedQueue(TrashedQueue{
+			ID:        "t1",
+			Name:      "old-orders",
+			Tags:      map[string]string{"team": "payments"},
+			DeletedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		}))
+		return &SqsServiceImpl{
+			repo:         repo,
+			store:        store,
+			jobs:         newJobRegistry(),
+			capabilities: &capabilityCache{conclusive: true, caps: EndpointCapabilities{Tags: tags}},
+		}, repo
+	}
+	jobFile := func(t *testing.T, service *SqsServiceImpl, queueURL string, lines ...string) string {
+		path := filepath.Join(t.TempDir(), "drain.ndjson")
+		require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o600))
+		job, err := service.jobs.start(ctx, "drain-to-file", queueURL, func(_ context.Context, progress *JobProgress) error {
+			progress.SetFile(path)
+			return nil
+		})
+		require.NoError(t, err)
+		service.jobs.wg.Wait()
+		return job.ID
+	}
+
+	t.Run("finds queues, tags, deleted queues and messages in job files", func(t *testing.T) {
+		service, repo := newService(t, true)
+		jobID := jobFile(t, service, billing,
+			`{"messageId":"m-1","body":"{\"orderId\":\"ORDER-42\"}","receiveCount":1}`,
+			`{"messageId":"m-2","body":"refund","receiveCount":1}`,
+			`not json`,
+		)
+
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{{URL: orders}, {URL: billing}, {URL: audit}}, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, orders).Return(map[string]string{}, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, billing).Return(map[string]string{"source": "Orders-API"}, nil).Once()
+		repo.EXPECT().ListQueueTags(mock.Anything, audit).Return(nil, errors.New("throttled")).Once()
+
+		results, err := service.Search(ctx, " Order ")
+		require.NoError(t, err)
+		assert.Equal(t, SearchResults{
+			Query: "Order",
+			Results: []SearchResult{
+				{Kind: SearchKindQueue, Name: "orders", QueueURL: orders},
+				{Kind: SearchKindTag, Name: "billing", QueueURL: billing, Match: "source=Orders-API"},
+				{Kind: SearchKindDeletedQueue, Name: "old-orders", TrashID: "t1"},
+				{Kind: SearchKindJobFileMessage, Name: "billing", QueueURL: billing, JobID: jobID, MessageID: "m-1", Match: `{"orderId":"ORDER-42"}`},
+			},
+			Notices: []string{"The tags of 1 queues could not be read."},
+		}, results)
+	})
+
+	t.Run("skips tags the endpoint does not support", func(t *testing.T) {
+		service, repo := newService(t, false)
+
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{{URL: orders}}, nil).Once()
+
+		results, err := service.Search(ctx, "payments")
+		require.NoError(t, err)
+		assert.Equal(t, []SearchResult{{Kind: SearchKindDeletedQueue, Name: "old-orders", TrashID: "t1", Match: "team=payments"}}, results.Results)
+	})
+
+	t.Run("caps messages in job files", func(t *testing.T) {
+		service, repo := newService(t, false)
+		lines := make([]string, maxSearchResultsPerKind+5)
+		for i := range lines {
+			lines[i] = `{"messageId":"m","body":"match","receiveCount":1}`
+		}
+		jobFile(t, service, billing, lines...)
+
+		repo.EXPECT().ListQueues(mock.Anything).Return(nil, nil).Once()
+
+		results, err := service.Search(ctx, "match")
+		require.NoError(t, err)
+		assert.Len(t, results.Results, maxSearchResultsPerKind)
+		assert.Equal(t, []string{"Only the first 50 job file message matches are shown."}, results.Notices)
+	})
+
+	t.Run("rejects short queries", func(t *testing.T) {
+		service, _ := newService(t, true)
+
+		_, err := service.Search(ctx, " a ")
+		require.EqualError(t, err, "search for at least 2 characters")
+	})
+}
+
+func TestSearchSnippet(t *testing.T) {
+	body := strings.Repeat("a", 200) + "NEEDLE" + strings.Repeat("b", 200)
+	lower := strings.ToLower(body)
+
+	snippet := searchSnippet(body, lower, strings.Index(lower, "needle"), len("needle"))
+	assert.Equal(t, "…"+strings.Repeat("a", 57)+"NEEDLE"+strings.Repeat("b", 57)+"…", snippet)
+	assert.Equal(t, "short", searchSnippet("short", "short", 0, 5))
+}
@@ -0,0 +1,365 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// formPollWindow is how long the messages of a poll made through the plain HTML form can be
+// viewed again. Their receipt handles outlive it only for queues with long visibility timeouts.
+const formPollWindow = 10 * time.Minute
+
+// maxFormPolls bounds the polls kept in memory; the oldest is forgotten first.
+const maxFormPolls = 100
+
+type formPoll struct {
+	queueURL string
+	messages []receiveMessageItem
+	storedAt time.Time
+}
+
+// formPollCache keeps the messages of polls made without JavaScript, so the poll form can redirect
+// to the page that shows them instead of answering the POST with the page itself. A reload of that
+// page then shows the same messages instead of resubmitting the poll.
+type formPollCache struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entries map[string]formPoll
+}
+
+func newFormPollCache() *formPollCache {
+	return &formPollCache{
+		now:     time.Now,
+		entries: make(map[string]formPoll),
+	}
+}
+
+// save stores the messages of a poll of queueURL and returns the token that shows them.
+func (c *formPollCache) save(queueURL string, messages []receiveMessageItem) (string, error) {
+	token, err := newRandomID()
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pruneLocked()
+	for len(c.entries) >= maxFormPolls {
+		oldest := ""
+		for key, entry := range c.entries {
+			if oldest == "" || entry.storedAt.Before(c.entries[oldest].storedAt) {
+				oldest = key
+			}
+		}
+		delete(c.entries, oldest)
+	}
+	c.entries[token] = formPoll{queueURL: queueURL, messages: messages, storedAt: c.now()}
+	return token, nil
+}
+
+// messages returns the messages of the poll token made on queueURL.
+func (c *formPollCache) messages(token, queueURL string) ([]receiveMessageItem, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pruneLocked()
+	entry, ok := c.entries[token]
+	if !ok || entry.queueURL != queueURL {
+		return nil, false
+	}
+	return slices.Clone(entry.messages), true
+}
+
+// forget drops a deleted message from the poll token, so the page no longer offers to delete it.
+func (c *formPollCache) forget(token, queueURL, receiptHandle string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[token]
+	if !ok || entry.queueURL != queueURL {
+		return
+	}
+	entry.messages = slices.DeleteFunc(slices.Clone(entry.messages), func(message receiveMessageItem) bool {
+		return message.ReceiptHandle == receiptHandle
+	})
+	c.entries[token] = entry
+}
+
+func (c *formPollCache) pruneLocked() {
+	cutoff := c.now().Add(-formPollWindow)
+	for key, entry := range c.entries {
+		if entry.storedAt.Before(cutoff) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// PostSendMessageFormHandler sends a message from the send form when it is submitted without
+// JavaScript, then redirects back to the send/receive page.
+func (h *HandlerImpl) PostSendMessageFormHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, 2*maxMessageBodyBytes)
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form submission", http.StatusBadRequest)
+		return
+	}
+
+	form := r.PostForm
+	input := SendMessageInput{
+		QueueURL:               queueURL,
+		Body:                   form.Get("message_body"),
+		MessageGroupID:         strings.TrimSpace(form.Get("message_group_id")),
+		MessageDeduplicationID: strings.TrimSpace(form.Get("message_deduplication_id")),
+		Attributes:             convertPayloadAttributes(formAttributes(form)),
+	}
+	input.DelaySeconds, err = parseOptionalInt32(strings.TrimSpace(form.Get("delivery_delay")), 0, 900, "delivery delay must be a whole number between 0 and 900 seconds")
+	if err != nil {
+		h.renderSendFormError(w, r, input, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := h.s.SendMessage(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to send message", slog.String("queue_url", queueURL), slog.Any("error", err))
+		h.renderSendFormError(w, r, input, serviceErrorStatus(err), err)
+		return
+	}
+
+	redirectURL := sendReceiveURL(queueURL, url.Values{"sent": {"1"}, "warning": {result.Warning}})
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+// renderSendFormError shows the send/receive page with err and the send form filled in as it was
+// submitted, so nothing typed is lost.
+func (h *HandlerImpl) renderSendFormError(w http.ResponseWriter, r *http.Request, input SendMessageInput, status int, err error) {
+	data, loadErr := h.newSendReceivePageData(r, input.QueueURL)
+	if loadErr != nil {
+		writeServiceError(w, loadErr, "failed to load queue detail", http.StatusInternalServerError)
+		return
+	}
+	data.Draft = &messageDraftView{Body: input.Body, AttributesJSON: "[]"}
+	if raw, marshalErr := json.Marshal(input.Attributes); marshalErr == nil && len(input.Attributes) > 0 {
+		data.Draft.AttributesJSON = string(raw)
+	}
+	data.AttributeRows = input.Attributes
+	data.Defaults.MessageGroupID = input.MessageGroupID
+	data.Defaults.DelaySeconds = r.PostForm.Get("delivery_delay")
+	data.ErrorMessage = err.Error()
+	h.renderSendReceive(w, r, status, data)
+}
+
+// PostReceiveMessagesFormHandler polls the queue from the receive form when it is submitted without
+// JavaScript and redirects to the page listing the messages.
+func (h *HandlerImpl) PostReceiveMessagesFormHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form submission", http.StatusBadRequest)
+		return
+	}
+
+	input := ReceiveMessagesInput{
+		QueueURL: queueURL,
+		Filter: MessageFilter{
+			BodyPattern: r.PostForm.Get("body_pattern"),
+			JSONPath:    r.PostForm.Get("json_path"),
+			JSONValue:   r.PostForm.Get("json_value"),
+		},
+	}
+	if project := strings.TrimSpace(r.PostForm.Get("project")); project != "" {
+		input.Projection = strings.Split(project, ",")
+	}
+	maxMessages, err := parseOptionalInt32(strings.TrimSpace(r.PostForm.Get("max_messages")), 1, 10, "max messages must be a whole number between 1 and 10")
+	if err == nil && maxMessages != nil {
+		input.MaxMessages = *maxMessages
+		input.MaxMessagesProvided = true
+	}
+	var waitTime, visibilityTimeout *int32
+	if err == nil {
+		waitTime, err = parseOptionalInt32(strings.TrimSpace(r.PostForm.Get("wait_time_seconds")), 0, 20, "wait time must be a whole number between 0 and 20 seconds")
+	}
+	if err == nil {
+		visibilityTimeout, err = parseOptionalInt32(strings.TrimSpace(r.PostForm.Get("visibility_timeout")), 0, 43200, "visibility timeout must be a whole number between 0 and 43200 seconds")
+	}
+	if err != nil {
+		h.renderSendReceiveError(w, r, queueURL, http.StatusBadRequest, err)
+		return
+	}
+	if waitTime != nil {
+		input.WaitTimeSeconds = *waitTime
+		input.WaitTimeProvided = true
+	}
+	if visibilityTimeout != nil {
+		input.VisibilityTimeout = *visibilityTimeout
+		input.VisibilityTimeoutProvided = true
+	}
+
+	result, err := h.s.ReceiveMessages(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to receive messages", slog.String("queue_url", queueURL), slog.Any("error", err))
+		h.renderSendReceiveError(w, r, queueURL, serviceErrorStatus(err), err)
+		return
+	}
+
+	messages := make([]receiveMessageItem, 0, len(result.Messages))
+	for _, message := range result.Messages {
+		messages = append(messages, newReceiveResultItem(result, message))
+	}
+	token, err := h.polls.save(queueURL, messages)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to keep polled messages", slog.String("queue_url", queueURL), slog.Any("error", err))
+		http.Error(w, "failed to keep polled messages", http.StatusInternalServerError)
+		return
+	}
+
+	query := url.Values{"poll": {token}}
+	if result.FilteredOut > 0 {
+		query.Set("filtered", strconv.Itoa(result.FilteredOut))
+	}
+	http.Redirect(w, r, sendReceiveURL(queueURL, query), http.StatusSeeOther)
+}
+
+// PostDeleteMessageFormHandler deletes a message listed by a poll made without JavaScript and
+// redirects back to the rest of that poll.
+func (h *HandlerImpl) PostDeleteMessageFormHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form submission", http.StatusBadRequest)
+		return
+	}
+
+	receiptHandle := strings.TrimSpace(r.PostForm.Get("receipt_handle"))
+	if receiptHandle == "" {
+		h.renderSendReceiveError(w, r, queueURL, http.StatusBadRequest, fmt.Errorf("receipt handle is required"))
+		return
+	}
+
+	if err := h.s.DeleteMessage(r.Context(), DeleteMessageInput{QueueURL: queueURL, ReceiptHandle: receiptHandle}); err != nil {
+		slog.ErrorContext(r.Context(), "failed to delete message", slog.String("queue_url", queueURL), slog.Any("error", err))
+		h.renderSendReceiveError(w, r, queueURL, serviceErrorStatus(err), err)
+		return
+	}
+
+	query := url.Values{"deleted": {"1"}}
+	if token := r.PostForm.Get("poll"); token != "" {
+		h.polls.forget(token, queueURL, receiptHandle)
+		query.Set("poll", token)
+	}
+	http.Redirect(w, r, sendReceiveURL(queueURL, query), http.StatusSeeOther)
+}
+
+// renderSendReceiveError shows the send/receive page with err above the forms. The messages of the
+// poll the form came from stay listed.
+func (h *HandlerImpl) renderSendReceiveError(w http.ResponseWriter, r *http.Request, queueURL string, status int, err error) {
+	data, loadErr := h.newSendReceivePageData(r, queueURL)
+	if loadErr != nil {
+		writeServiceError(w, loadErr, "failed to load queue detail", http.StatusInternalServerError)
+		return
+	}
+	if token := r.PostForm.Get("poll"); token != "" {
+		data.Received = h.receivedPollView(token, queueURL)
+	}
+	data.ErrorMessage = err.Error()
+	h.renderSendReceive(w, r, status, data)
+}
+
+// receivedPollView returns the messages of the poll token, or nil when it expired.
+func (h *HandlerImpl) receivedPollView(token, queueURL string) *receivedPollView {
+	messages, ok := h.polls.messages(token, queueURL)
+	if !ok {
+		return nil
+	}
+	return &receivedPollView{Token: token, Messages: messages}
+}
+
+// sendReceiveFlash reports the outcome of a form the page redirected from.
+func sendReceiveFlash(query url.Values, received *receivedPollView) *pageFlash {
+	switch {
+	case query.Get("sent") != "":
+		if warning := strings.TrimSpace(query.Get("warning")); warning != "" {
+			return &pageFlash{Message: "Message sent successfully. " + warning, Kind: "warning"}
+		}
+		return &pageFlash{Message: "Message sent successfully.", Kind: "success"}
+	case query.Get("deleted") != "":
+		return &pageFlash{Message: "Message deleted successfully.", Kind: "success"}
+	case query.Get("poll") != "" && received == nil:
+		return &pageFlash{Message: "These poll results have expired. Poll the queue again.", Kind: "warning"}
+	case received != nil && len(received.Messages) == 0:
+		return &pageFlash{Message: "No messages were returned." + filteredOutNote(query), Kind: "success"}
+	case received != nil:
+		return &pageFlash{Message: fmt.Sprintf("Retrieved %d message%s.", len(received.Messages), pluralSuffix(len(received.Messages))) + filteredOutNote(query), Kind: "success"}
+	}
+	return nil
+}
+
+// filteredOutNote tells how many received messages the filter of a poll left out.
+func filteredOutNote(query url.Values) string {
+	filtered, err := strconv.Atoi(query.Get("filtered"))
+	if err != nil || filtered <= 0 {
+		return ""
+	}
+	if filtered == 1 {
+		return " 1 message did not match the filter and is visible again."
+	}
+	return fmt.Sprintf(" %d messages did not match the filter and are visible again.", filtered)
+}
+
+func pluralSuffix(count int) string {
+	if count == 1 {
+		return ""
+	}
+	return "s"
+}
+
+// formAttributes pairs the attribute_name[], attribute_value[] and attribute_type[] fields of the
+// send form. A row without a type is a String.
+func formAttributes(form url.Values) []messageAttributePayload {
+	names := form["attribute_name[]"]
+	values := form["attribute_value[]"]
+	dataTypes := form["attribute_type[]"]
+	attributes := make([]messageAttributePayload, 0, len(names))
+	for i, name := range names {
+		if i >= len(values) {
+			break
+		}
+		attribute := messageAttributePayload{Name: name, Value: values[i]}
+		if i < len(dataTypes) {
+			attribute.DataType = dataTypes[i]
+		}
+		attributes = append(attributes, attribute)
+	}
+	return attributes
+}
+
+func sendReceiveURL(queueURL string, query url.Values) string {
+	for key, values := range query {
+		if len(values) == 0 || values[0] == "" {
+			query.Del(key)
+		}
+	}
+	redirectURL := "/queues/" + url.QueryEscape(queueURL) + "/send-receive"
+	if encoded := query.Encode(); encoded != "" {
+		redirectURL += "?" + encoded
+	}
+	return redirectURL
+}
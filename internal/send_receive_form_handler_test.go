@@ -0,0 +1,269 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerImpl_SendReceiveForms(t *testing.T) {
+	queueURL := "https://sqs.local/orders"
+	escaped := url.QueryEscape(queueURL)
+
+	newRequest := func(action string, form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/send-receive/"+action, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+	expectPage := func(mockService *MockSqsService) {
+		mockService.EXPECT().QueueDetail(mock.Anything, queueURL).
+			Return(QueueDetail{QueueSummary: QueueSummary{URL: queueURL, Name: "orders", Type: QueueTypeStandard}}, nil).Once()
+		mockService.EXPECT().SendDefaults(mock.Anything, queueURL).Return(SendDefaults{}, nil).Once()
+		mockService.EXPECT().Draft(mock.Anything, queueURL).Return(MessageDraft{}, false, nil).Once()
+		mockService.EXPECT().MessageContract(mock.Anything, queueURL).Return(MessageContract{}, false, nil).Once()
+	}
+
+	t.Run("sends the message and redirects with its outcome", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		mockService.EXPECT().
+			SendMessage(mock.Anything, SendMessageInput{
+				QueueURL:     queueURL,
+				Body:         `{"id":1}`,
+				DelaySeconds: ptrInt32(5),
+				Attributes: []MessageAttribute{
+					{Name: "traceId", Value: "abc", DataType: "String"},
+					{Name: "retries", Value: "3", DataType: "Number"},
+				},
+			}).
+			Return(SendMessageResult{Warning: "The body is not valid JSON."}, nil).
+			Once()
+
+		handler.PostSendMessageFormHandler(rr, newRequest("send", url.Values{
+			"message_body":      {`{"id":1}`},
+			"delivery_delay":    {"5"},
+			"attribute_name[]":  {"traceId", "retries", ""},
+			"attribute_value[]": {"abc", "3", ""},
+			"attribute_type[]":  {"String", "Number", "String"},
+		}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues/"+escaped+"/send-receive?sent=1&warning=The+body+is+not+valid+JSON.", rr.Header().Get("Location"))
+	})
+
+	t.Run("gives the send form back when the message is rejected", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured sendReceivePageData
+		captureSendReceiveTemplate(t, &captured)
+		installSendReceiveFragment(t, "")
+		expectPage(mockService)
+
+		handler.PostSendMessageFormHandler(rr, newRequest("send", url.Values{
+			"message_body":      {"hello"},
+			"delivery_delay":    {"901"},
+			"attribute_name[]":  {"traceId"},
+			"attribute_value[]": {"abc"},
+		}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "delivery delay must be a whole number between 0 and 900 seconds", captured.ErrorMessage)
+		require.NotNil(t, captured.Draft)
+		assert.Equal(t, "hello", captured.Draft.Body)
+		assert.Equal(t, "901", captured.Defaults.DelaySeconds)
+		assert.Equal(t, []MessageAttribute{{Name: "traceId", Value: "abc"}}, captured.AttributeRows)
+	})
+
+	t.Run("polls and shows the messages after the redirect", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		mockService.EXPECT().
+			ReceiveMessages(mock.Anything, ReceiveMessagesInput{
+				QueueURL:            queueURL,
+				MaxMessages:         3,
+				MaxMessagesProvided: true,
+				WaitTimeSeconds:     0,
+				WaitTimeProvided:    true,
+			}).
+			Return(ReceiveMessagesResult{Messages: []ReceivedMessage{
+				{ID: "m-1", Body: "one", ReceiptHandle: "rh-1", ReceiveCount: 1},
+				{ID: "m-2", Body: "two", ReceiptHandle: "rh-2", ReceiveCount: 2},
+			}}, nil).
+			Once()
+
+		rr := httptest.NewRecorder()
+		handler.PostReceiveMessagesFormHandler(rr, newRequest("poll", url.Values{"max_messages": {"3"}, "wait_time_seconds": {"0"}}))
+		require.Equal(t, http.StatusSeeOther, rr.Code)
+		location, err := url.Parse(rr.Header().Get("Location"))
+		require.NoError(t, err)
+		token := location.Query().Get("poll")
+		require.NotEmpty(t, token)
+
+		var captured sendReceivePageData
+		captureSendReceiveTemplate(t, &captured)
+		installSendReceiveFragment(t, "")
+		expectPage(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, location.String(), nil)
+		req.SetPathValue("url", escaped)
+		rr = httptest.NewRecorder()
+		handler.SendReceive(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		require.NotNil(t, captured.Received)
+		assert.Equal(t, token, captured.Received.Token)
+		require.Len(t, captured.Received.Messages, 2)
+		assert.Equal(t, "rh-2", captured.Received.Messages[1].ReceiptHandle)
+		assert.Equal(t, &pageFlash{Message: "Retrieved 2 messages.", Kind: "success"}, captured.Flash)
+	})
+
+	t.Run("filters the poll and notes the messages left out", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+
+		mockService.EXPECT().
+			ReceiveMessages(mock.Anything, ReceiveMessagesInput{
+				QueueURL:   queueURL,
+				Filter:     MessageFilter{JSONPath: "$.type", JSONValue: "order"},
+				Projection: []string{"$.order.id", " $.order.status"},
+			}).
+			Return(ReceiveMessagesResult{
+				Messages:    []ReceivedMessage{{ID: "m-1", Body: `{"type":"order"}`, ReceiptHandle: "rh-1"}},
+				Projections: map[string]json.RawMessage{"m-1": json.RawMessage(`{"$.order.id": 1}`)},
+				FilteredOut: 1,
+			}, nil).
+			Once()
+
+		rr := httptest.NewRecorder()
+		handler.PostReceiveMessagesFormHandler(rr, newRequest("poll", url.Values{
+			"json_path":  {"$.type"},
+			"json_value": {"order"},
+			"project":    {"$.order.id, $.order.status"},
+		}))
+		require.Equal(t, http.StatusSeeOther, rr.Code)
+		location, err := url.Parse(rr.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, "1", location.Query().Get("filtered"))
+
+		var captured sendReceivePageData
+		captureSendReceiveTemplate(t, &captured)
+		installSendReceiveFragment(t, "")
+		expectPage(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, location.String(), nil)
+		req.SetPathValue("url", escaped)
+		handler.SendReceive(httptest.NewRecorder(), req)
+
+		require.NotNil(t, captured.Received)
+		require.Len(t, captured.Received.Messages, 1)
+		assert.JSONEq(t, `{"$.order.id": 1}`, string(captured.Received.Messages[0].Projection))
+		assert.Equal(t, &pageFlash{Message: "Retrieved 1 message. 1 message did not match the filter and is visible again.", Kind: "success"}, captured.Flash)
+	})
+
+	t.Run("rejects an out of range poll", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		rr := httptest.NewRecorder()
+
+		var captured sendReceivePageData
+		captureSendReceiveTemplate(t, &captured)
+		installSendReceiveFragment(t, "")
+		expectPage(mockService)
+
+		handler.PostReceiveMessagesFormHandler(rr, newRequest("poll", url.Values{"max_messages": {"11"}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "max messages must be a whole number between 1 and 10", captured.ErrorMessage)
+	})
+
+	t.Run("deletes a message and returns to the rest of the poll", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		token, err := handler.polls.save(queueURL, []receiveMessageItem{
+			{ID: "m-1", ReceiptHandle: "rh-1"},
+			{ID: "m-2", ReceiptHandle: "rh-2"},
+		})
+		require.NoError(t, err)
+
+		mockService.EXPECT().
+			DeleteMessage(mock.Anything, DeleteMessageInput{QueueURL: queueURL, ReceiptHandle: "rh-1"}).
+			Return(nil).
+			Once()
+
+		rr := httptest.NewRecorder()
+		handler.PostDeleteMessageFormHandler(rr, newRequest("delete", url.Values{"receipt_handle": {"rh-1"}, "poll": {token}}))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		assert.Equal(t, "/queues/"+escaped+"/send-receive?deleted=1&poll="+token, rr.Header().Get("Location"))
+		messages, ok := handler.polls.messages(token, queueURL)
+		require.True(t, ok)
+		assert.Equal(t, []receiveMessageItem{{ID: "m-2", ReceiptHandle: "rh-2"}}, messages)
+	})
+
+	t.Run("keeps the poll listed when a delete fails", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		token, err := handler.polls.save(queueURL, []receiveMessageItem{{ID: "m-1", ReceiptHandle: "rh-1"}})
+		require.NoError(t, err)
+
+		var captured sendReceivePageData
+		captureSendReceiveTemplate(t, &captured)
+		installSendReceiveFragment(t, "")
+		mockService.EXPECT().
+			DeleteMessage(mock.Anything, DeleteMessageInput{QueueURL: queueURL, ReceiptHandle: "rh-1"}).
+			Return(errors.New("receipt handle has expired")).
+			Once()
+		expectPage(mockService)
+
+		rr := httptest.NewRecorder()
+		handler.PostDeleteMessageFormHandler(rr, newRequest("delete", url.Values{"receipt_handle": {"rh-1"}, "poll": {token}}))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "receipt handle has expired", captured.ErrorMessage)
+		require.NotNil(t, captured.Received)
+		assert.Len(t, captured.Received.Messages, 1)
+	})
+}
+
+func TestFormPollCache(t *testing.T) {
+	now := time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)
+	cache := newFormPollCache()
+	cache.now = func() time.Time { return now }
+
+	token, err := cache.save("https://sqs.local/orders", []receiveMessageItem{{ID: "m-1"}})
+	require.NoError(t, err)
+
+	_, ok := cache.messages(token, "https://sqs.local/billing")
+	assert.False(t, ok, "a poll is only shown on the page of its queue")
+
+	messages, ok := cache.messages(token, "https://sqs.local/orders")
+	assert.True(t, ok)
+	assert.Len(t, messages, 1)
+
+	now = now.Add(formPollWindow + time.Second)
+	_, ok = cache.messages(token, "https://sqs.local/orders")
+	assert.False(t, ok, "polls expire")
+}
+
+func TestSendReceiveFlash(t *testing.T) {
+	assert.Equal(t, &pageFlash{Message: "Message sent successfully.", Kind: "success"}, sendReceiveFlash(url.Values{"sent": {"1"}}, nil))
+	assert.Equal(t, &pageFlash{Message: "These poll results have expired. Poll the queue again.", Kind: "warning"}, sendReceiveFlash(url.Values{"poll": {"gone"}}, nil))
+	assert.Equal(t, &pageFlash{Message: "No messages were returned.", Kind: "success"}, sendReceiveFlash(url.Values{"poll": {"t"}}, &receivedPollView{Token: "t"}))
+	assert.Equal(t, &pageFlash{Message: "Retrieved 1 message. 2 messages did not match the filter and are visible again.", Kind: "success"}, sendReceiveFlash(url.Values{"poll": {"t"}, "filtered": {"2"}}, &receivedPollView{Token: "t", Messages: []receiveMessageItem{{ID: "m"}}}))
+	assert.Nil(t, sendReceiveFlash(url.Values{}, nil))
+}
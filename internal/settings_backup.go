@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// settingsBundleVersion is bumped whenever the bundle layout changes incompatibly.
+const settingsBundleVersion = 1
+
+// SettingsBundle is the portable form of the local state used to move a configured instance between machines.
+type SettingsBundle struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+	StateSnapshot
+}
+
+// ExportSettings returns the whole local state as a bundle.
+func (s *SqsServiceImpl) ExportSettings(_ context.Context) (SettingsBundle, error) {
+	if s.store == nil {
+		return SettingsBundle{}, errors.New("settings are not available without a state store")
+	}
+
+	snapshot, err := s.store.Snapshot()
+	if err != nil {
+		return SettingsBundle{}, err
+	}
+	// Push subscriptions belong to the browsers of this instance and stop working elsewhere.
+	snapshot.PushSubscriptions = nil
+
+	return SettingsBundle{
+		Version:       settingsBundleVersion,
+		ExportedAt:    s.now().UTC(),
+		StateSnapshot: snapshot,
+	}, nil
+}
+
+// ImportSettings validates bundle and replaces the local state with it, keeping the browser push
+// subscriptions of this instance. Nothing is written unless every record in the bundle is valid.
+func (s *SqsServiceImpl) ImportSettings(_ context.Context, bundle SettingsBundle) error {
+	if s.store == nil {
+		return errors.New("settings are not available without a state store")
+	}
+	if bundle.Version != settingsBundleVersion {
+		return errors.Newf("unsupported settings bundle version %d", bundle.Version)
+	}
+
+	for id, schedule := range bundle.Schedules {
+		if err := validateImportedSchedule(id, schedule); err != nil {
+			return errors.Wrapf(err, "schedule %q", id)
+		}
+	}
+	for id, rule := range bundle.AlertRules {
+		if id == "" || rule.ID != id {
+			return errors.Newf("alert rule %q: id does not match its key", id)
+		}
+		if strings.TrimSpace(rule.QueueURL) == "" {
+			return errors.Newf("alert rule %q: queue url is required", id)
+		}
+		if err := validateAlertCondition(rule.Metric, rule.Threshold, rule.ResolveThreshold, rule.For); err != nil {
+			return errors.Wrapf(err, "alert rule %q", id)
+		}
+		if _, err := validateNotificationChannels(rule.Channels); err != nil {
+			return errors.Wrapf(err, "alert rule %q", id)
+		}
+	}
+
+	for queueURL, baseline := range bundle.Baselines {
+		if queueURL == "" || baseline.QueueURL != queueURL {
+			return errors.Newf("baseline %q: queue url does not match its key", queueURL)
+		}
+	}
+	for queueURL, history := range bundle.AttributeHistory {
+		if queueURL == "" || history.QueueURL != queueURL {
+			return errors.Newf("attribute history %q: queue url does not match its key", queueURL)
+		}
+	}
+	for queueURL, contract := range bundle.MessageContracts {
+		if queueURL == "" || contract.QueueURL != queueURL {
+			return errors.Newf("message contract %q: queue url does not match its key", queueURL)
+		}
+	}
+	if bundle.Preferences != nil && len(bundle.Preferences.QueueListColumns) > 0 {
+		if _, err := normalizeQueueColumns(bundle.Preferences.QueueListColumns); err != nil {
+			return errors.Wrap(err, "preferences")
+		}
+	}
+
+	current, err := s.store.Snapshot()
+	if err != nil {
+		return err
+	}
+	bundle.PushSubscriptions = current.PushSubscriptions
+	if err := s.store.Restore(bundle.StateSnapshot); err != nil {
+		return err
+	}
+
+	// Evaluation and drift check state belong to the rules and baselines that were just replaced.
+	if s.alerts != nil {
+		s.alerts.mu.Lock()
+		s.alerts.states = make(map[string]AlertRuleState)
+		s.alerts.mu.Unlock()
+	}
+	if s.drift != nil {
+		s.drift.mu.Lock()
+		s.drift.states = make(map[string]QueueDriftState)
+		s.drift.mu.Unlock()
+	}
+
+	return nil
+}
+
+func validateImportedSchedule(id string, schedule Schedule) error {
+	if id == "" || schedule.ID != id {
+		return errors.New("id does not match its key")
+	}
+	if strings.TrimSpace(schedule.QueueURL) == "" {
+		return errors.New("queue url is required")
+	}
+	if !schedule.isOneOff() {
+		if _, err := validateCron(schedule.Cron); err != nil {
+			return err
+		}
+	}
+	_, err := validateScheduleAction(schedule.Action, schedule.QueueURL, schedule.Message)
+	return err
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqsServiceImpl_ExportSettings(t *testing.T) {
+	store := NewMockLocalStore(t)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	service := &SqsServiceImpl{store: store, clock: func() time.Time { return now }}
+
+	snapshot := StateSnapshot{
+		Drafts: map[string]MessageDraft{"https://sqs.local/orders": {Body: "{}"}},
+	}
+	withSubscriptions := snapshot
+	withSubscriptions.PushSubscriptions = map[string]PushSubscription{"https://push.local/1": {Endpoint: "https://push.local/1"}}
+	store.EXPECT().Snapshot().Return(withSubscriptions, nil).Once()
+
+	bundle, err := service.ExportSettings(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, SettingsBundle{Version: 1, ExportedAt: now, StateSnapshot: snapshot}, bundle)
+}
+
+func TestSqsServiceImpl_ImportSettings(t *testing.T) {
+	ctx := context.Background()
+	schedule := Schedule{ID: "nightly", Action: ScheduleActionPurge, QueueURL: "https://sqs.local/orders", Cron: "0 3 * * *"}
+	rule := AlertRule{
+		ID:               "depth",
+		QueueURL:         "https://sqs.local/orders",
+		Metric:           AlertMetricMessagesAvailable,
+		Threshold:        100,
+		ResolveThreshold: 50,
+	}
+
+	t.Run("restores a valid bundle, keeps push subscriptions and resets alert state", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, alerts: newAlertTracker()}
+		service.alerts.states["stale"] = AlertRuleState{Status: AlertStatusFiring}
+
+		bundle := SettingsBundle{
+			Version: 1,
+			StateSnapshot: StateSnapshot{
+				Schedules:  map[string]Schedule{"nightly": schedule},
+				AlertRules: map[string]AlertRule{"depth": rule},
+			},
+		}
+		subscriptions := map[string]PushSubscription{"https://push.local/1": {Endpoint: "https://push.local/1"}}
+		store.EXPECT().Snapshot().Return(StateSnapshot{PushSubscriptions: subscriptions}, nil).Once()
+		restored := bundle.StateSnapshot
+		restored.PushSubscriptions = subscriptions
+		store.EXPECT().Restore(restored).Return(nil).Once()
+
+		require.NoError(t, service.ImportSettings(ctx, bundle))
+		assert.Empty(t, service.alerts.states)
+	})
+
+	brokenRule := rule
+	brokenRule.ResolveThreshold = 100
+	badCron := schedule
+	badCron.Cron = "whenever"
+
+	testCases := []struct {
+		name    string
+		bundle  SettingsBundle
+		wantErr string
+	}{
+		{
+			name:    "unknown version",
+			bundle:  SettingsBundle{Version: 2},
+			wantErr: "unsupported settings bundle version 2",
+		},
+		{
+			name: "schedule id mismatch",
+			bundle: SettingsBundle{Version: 1, StateSnapshot: StateSnapshot{
+				Schedules: map[string]Schedule{"other": schedule},
+			}},
+			wantErr: `schedule "other": id does not match its key`,
+		},
+		{
+			name: "invalid cron",
+			bundle: SettingsBundle{Version: 1, StateSnapshot: StateSnapshot{
+				Schedules: map[string]Schedule{"nightly": badCron},
+			}},
+			wantErr: `schedule "nightly": invalid cron expression`,
+		},
+		{
+			name: "invalid alert rule",
+			bundle: SettingsBundle{Version: 1, StateSnapshot: StateSnapshot{
+				AlertRules: map[string]AlertRule{"depth": brokenRule},
+			}},
+			wantErr: `alert rule "depth": resolve threshold must be between 0 and the threshold minus one`,
+		},
+		{
+			name: "message contract queue mismatch",
+			bundle: SettingsBundle{Version: 1, StateSnapshot: StateSnapshot{
+				MessageContracts: map[string]MessageContract{"https://sqs.local/orders": {QueueURL: "https://sqs.local/billing"}},
+			}},
+			wantErr: `message contract "https://sqs.local/orders": queue url does not match its key`,
+		},
+		{
+			name: "unknown queue list column",
+			bundle: SettingsBundle{Version: 1, StateSnapshot: StateSnapshot{
+				Preferences: &Preferences{QueueListColumns: []string{"size"}},
+			}},
+			wantErr: `preferences: unknown queue list column "size"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &SqsServiceImpl{store: NewMockLocalStore(t)}
+
+			err := service.ImportSettings(ctx, tc.bundle)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// maxSettingsBundleBytes bounds the size of an uploaded settings bundle.
+const maxSettingsBundleBytes = 10 << 20
+
+type importSettingsResponse struct {
+	Message      string `json:"message"`
+	SendDefaults int    `json:"sendDefaults"`
+	Drafts       int    `json:"drafts"`
+	Schedules    int    `json:"schedules"`
+	AlertRules   int    `json:"alertRules"`
+	Trash        int    `json:"trash"`
+}
+
+// ExportSettingsAPI downloads the local state as a JSON bundle.
+func (h *HandlerImpl) ExportSettingsAPI(w http.ResponseWriter, r *http.Request) {
+	bundle, err := h.s.ExportSettings(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to export settings", slog.Any("error", err))
+		writeJSONError(w, http.StatusInternalServerError, "failed to export settings")
+		return
+	}
+
+	filename := fmt.Sprintf("sqs-gui-settings-%s.json", bundle.ExportedAt.Format("20060102-150405"))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	writeJSON(w, http.StatusOK, bundle)
+}
+
+// ImportSettingsAPI replaces the local state with an uploaded bundle.
+func (h *HandlerImpl) ImportSettingsAPI(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxSettingsBundleBytes)
+
+	var bundle SettingsBundle
+	if !decodeJSONBody(w, r, &bundle) {
+		return
+	}
+
+	if err := h.s.ImportSettings(r.Context(), bundle); err != nil {
+		slog.ErrorContext(r.Context(), "failed to import settings", slog.Any("error", err))
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, importSettingsResponse{
+		Message:      "Settings imported.",
+		SendDefaults: len(bundle.SendDefaults),
+		Drafts:       len(bundle.Drafts),
+		Schedules:    len(bundle.Schedules),
+		AlertRules:   len(bundle.AlertRules),
+		Trash:        len(bundle.Trash),
+	})
+}
@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerImpl_ExportSettingsAPI(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	bundle := SettingsBundle{
+		Version:    1,
+		ExportedAt: time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+		StateSnapshot: StateSnapshot{
+			AlertRules: map[string]AlertRule{"depth": {ID: "depth", Threshold: 10}},
+		},
+	}
+	mockService.EXPECT().ExportSettings(mock.Anything).Return(bundle, nil).Once()
+
+	rr := httptest.NewRecorder()
+	handler.ExportSettingsAPI(rr, httptest.NewRequest(http.MethodGet, "/api/v1/settings/export", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, `attachment; filename="sqs-gui-settings-20240501-123000.json"`, rr.Header().Get("Content-Disposition"))
+
+	var decoded SettingsBundle
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &decoded))
+	assert.Equal(t, bundle, decoded)
+}
+
+func TestHandlerImpl_ImportSettingsAPI(t *testing.T) {
+	testCases := []struct {
+		name       string
+		body       string
+		setup      func(*MockSqsService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "imports the bundle",
+			body: `{"version":1,"exportedAt":"2024-05-01T12:00:00Z","drafts":{"https://sqs.local/orders":{"body":"{}","savedAt":"2024-05-01T11:00:00Z"}}}`,
+			setup: func(m *MockSqsService) {
+				m.EXPECT().
+					ImportSettings(mock.Anything, SettingsBundle{
+						Version:    1,
+						ExportedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+						StateSnapshot: StateSnapshot{
+							Drafts: map[string]MessageDraft{
+								"https://sqs.local/orders": {Body: "{}", SavedAt: time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)},
+							},
+						},
+					}).
+					Return(nil).
+					Once()
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"message":"Settings imported.","sendDefaults":0,"drafts":1,"schedules":0,"alertRules":0,"trash":0}`,
+		},
+		{
+			name:       "rejects unknown fields",
+			body:       `{"version":1,"connections":[]}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"invalid request body"}`,
+		},
+		{
+			name: "reports validation errors",
+			body: `{"version":3}`,
+			setup: func(m *MockSqsService) {
+				m.EXPECT().ImportSettings(mock.Anything, SettingsBundle{Version: 3}).Return(errors.New("unsupported settings bundle version 3")).Once()
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"unsupported settings bundle version 3"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSqsService(t)
+			if tc.setup != nil {
+				tc.setup(mockService)
+			}
+			handler := NewHandler(mockService)
+
+			rr := httptest.NewRecorder()
+			handler.ImportSettingsAPI(rr, httptest.NewRequest(http.MethodPost, "/api/v1/settings/import", strings.NewReader(tc.body)))
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+			assert.JSONEq(t, tc.wantBody, rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package internal
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// SlackRole is what a Slack user may do through the slash command.
+type SlackRole string
+
+const (
+	// SlackRoleViewer may run the commands that only read queues.
+	SlackRoleViewer SlackRole = "viewer"
+	// SlackRoleOperator may also run the commands that change queues, such as purge.
+	SlackRoleOperator SlackRole = "operator"
+)
+
+// slackRoles lists the roles from least to most privileged.
+var slackRoles = []SlackRole{SlackRoleViewer, SlackRoleOperator}
+
+var (
+	// ErrSlackDisabled is returned when no Slack signing secret is configured.
+	ErrSlackDisabled = errors.New("the Slack command is not configured")
+	// ErrSlackSignature is returned when a request was not signed by the Slack app or is too old.
+	ErrSlackSignature = errors.New("invalid Slack request signature")
+)
+
+// slackRequestMaxAge is how far a request timestamp may be from now before the request is treated
+// as a replay.
+const slackRequestMaxAge = 5 * time.Minute
+
+// SlackCommand is one invocation of the slash command. Command is the command as typed, such as
+// /sqs, and Text what followed it.
+type SlackCommand struct {
+	Command string
+	UserID  string
+	Text    string
+}
+
+// SlackReply answers a slash command. InChannel replies are shown to the whole channel instead of
+// only to the user who ran the command.
+type SlackReply struct {
+	Text      string
+	InChannel bool
+}
+
+// slackSubcommand is one subcommand of the slash command. Queue is set when it takes a queue name.
+type slackSubcommand struct {
+	name    string
+	summary string
+	queue   bool
+	role    SlackRole
+	run     func(s *SqsServiceImpl, ctx context.Context, cmd SlackCommand, queueName string) SlackReply
+}
+
+// slackSubcommands are listed by help in this order.
+var slackSubcommands = []slackSubcommand{
+	{name: "depth", summary: "message counts of a queue", queue: true, role: SlackRoleViewer, run: (*SqsServiceImpl).slackDepth},
+	{name: "dlq", summary: "dead-letter queues and their depth", role: SlackRoleViewer, run: (*SqsServiceImpl).slackDeadLetterQueues},
+	{name: "purge", summary: "delete every message in a queue", queue: true, role: SlackRoleOperator, run: (*SqsServiceImpl).slackPurge},
+}
+
+// VerifySlackRequest checks that body was signed with the signing secret of the Slack app at
+// timestamp, as described in Slack's "Verifying requests from Slack".
+func (s *SqsServiceImpl) VerifySlackRequest(timestamp, signature string, body []byte) error {
+	secret := s.config.Slack.SigningSecret
+	if secret == "" {
+		return errors.WithStack(ErrSlackDisabled)
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.WithStack(ErrSlackSignature)
+	}
+	if age := s.now().Sub(time.Unix(seconds, 0)); age > slackRequestMaxAge || age < -slackRequestMaxAge {
+		return errors.WithStack(ErrSlackSignature)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errors.WithStack(ErrSlackSignature)
+	}
+	return nil
+}
+
+// RunSlackCommand runs a verified slash command for its Slack user, within the user's role.
+// Failures are answered in the reply, since Slack shows the user nothing else.
+func (s *SqsServiceImpl) RunSlackCommand(ctx context.Context, cmd SlackCommand) (SlackReply, error) {
+	if !s.config.Slack.Enabled() {
+		return SlackReply{}, errors.WithStack(ErrSlackDisabled)
+	}
+
+	role, ok := s.config.Slack.Roles[cmd.UserID]
+	if !ok {
+		role, ok = s.config.Slack.Roles["*"]
+	}
+	if !ok {
+		return SlackReply{Text: fmt.Sprintf("You may not use %s. Ask an administrator to add your Slack user ID %s to SQS_GUI_SLACK_ROLES.", cmd.Command, cmd.UserID)}, nil
+	}
+
+	fields := strings.Fields(cmd.Text)
+	if len(fields) == 0 || fields[0] == "help" {
+		return SlackReply{Text: slackHelp(cmd.Command, role)}, nil
+	}
+
+	index := slices.IndexFunc(slackSubcommands, func(sub slackSubcommand) bool { return sub.name == fields[0] })
+	if index < 0 {
+		return SlackReply{Text: fmt.Sprintf("Unknown command %q.\n%s", fields[0], slackHelp(cmd.Command, role))}, nil
+	}
+	sub := slackSubcommands[index]
+	if slices.Index(slackRoles, role) < slices.Index(slackRoles, sub.role) {
+		return SlackReply{Text: fmt.Sprintf("`%s` needs the %s role; you are a %s.", sub.name, sub.role, role)}, nil
+	}
+
+	var queueName string
+	if sub.queue {
+		if len(fields) != 2 {
+			return SlackReply{Text: fmt.Sprintf("Usage: `%s %s <queue name>`", cmd.Command, sub.name)}, nil
+		}
+		queueName = fields[1]
+	} else if len(fields) != 1 {
+		return SlackReply{Text: fmt.Sprintf("Usage: `%s %s`", cmd.Command, sub.name)}, nil
+	}
+
+	return sub.run(s, ctx, cmd, queueName), nil
+}
+
+// slackHelp lists the subcommands the role may run.
+func slackHelp(command string, role SlackRole) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Commands available to you as %s:", role)
+	for _, sub := range slackSubcommands {
+		if slices.Index(slackRoles, role) < slices.Index(slackRoles, sub.role) {
+			continue
+		}
+		usage := command + " " + sub.name
+		if sub.queue {
+			usage += " <queue name>"
+		}
+		fmt.Fprintf(&b, "\n• `%s` – %s", usage, sub.summary)
+	}
+	return b.String()
+}
+
+// slackQueueURL resolves a queue name typed in Slack.
+func (s *SqsServiceImpl) slackQueueURL(ctx context.Context, name string) (string, error) {
+	queueURL, exists, err := s.repo.QueueURL(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", errors.Newf("queue %s does not exist", name)
+	}
+	return queueURL, nil
+}
+
+func (s *SqsServiceImpl) slackDepth(ctx context.Context, _ SlackCommand, queueName string) SlackReply {
+	queueURL, err := s.slackQueueURL(ctx, queueName)
+	if err != nil {
+		return SlackReply{Text: fmt.Sprintf("Could not read %s: %v", queueName, err)}
+	}
+	results, err := s.QueueStats(ctx, []string{queueURL})
+	if err != nil {
+		return SlackReply{Text: fmt.Sprintf("Could not read %s: %v", queueName, err)}
+	}
+	if results[0].Error != "" {
+		return SlackReply{Text: fmt.Sprintf("Could not read %s: %s", queueName, results[0].Error)}
+	}
+	stats := results[0].Stats
+	return SlackReply{Text: fmt.Sprintf("*%s*: %d available, %d in flight, %d delayed",
+		queueName, stats.MessagesAvailable, stats.MessagesInFlight, stats.MessagesDelayed)}
+}
+
+func (s *SqsServiceImpl) slackDeadLetterQueues(ctx context.Context, _ SlackCommand, _ string) SlackReply {
+	queues, err := s.DeadLetterQueues(ctx, false)
+	if err != nil {
+		return SlackReply{Text: fmt.Sprintf("Could not list dead-letter queues: %v", err)}
+	}
+	if len(queues) == 0 {
+		return SlackReply{Text: "No queue has a dead-letter queue."}
+	}
+
+	lines := make([]string, 0, len(queues))
+	for _, queue := range queues {
+		sources := make([]string, 0, len(queue.SourceQueues))
+		for _, source := range queue.SourceQueues {
+			sources = append(sources, source.Name)
+		}
+		lines = append(lines, fmt.Sprintf("• *%s*: %d available (from %s)", queue.Name, queue.MessagesAvailable, strings.Join(sources, ", ")))
+	}
+	return SlackReply{Text: strings.Join(lines, "\n")}
+}
+
+// slackPurge purges a queue and tells the channel who did it, since a purge cannot be undone.
+func (s *SqsServiceImpl) slackPurge(ctx context.Context, cmd SlackCommand, queueName string) SlackReply {
+	queueURL, err := s.slackQueueURL(ctx, queueName)
+	if err == nil {
+		err = s.PurgeQueue(ctx, queueURL)
+	}
+	if err != nil {
+		return SlackReply{Text: fmt.Sprintf("Could not purge %s: %v", queueName, err)}
+	}
+
+	slog.InfoContext(ctx, "purged queue from slack", slog.String("queue_url", queueURL), slog.String("slack_user_id", cmd.UserID))
+	return SlackReply{
+		Text:      fmt.Sprintf("<@%s> purged *%s*. SQS may take up to 60 seconds to delete every message.", cmd.UserID, queueName),
+		InChannel: true,
+	}
+}
@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// signSlackRequest signs body the way Slack does.
+func signSlackRequest(secret string, timestamp time.Time, body string) (string, string) {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + ts + ":" + body))
+	return ts, "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestSqsServiceImpl_VerifySlackRequest(t *testing.T) {
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	service := &SqsServiceImpl{
+		config: ServiceConfig{Slack: SlackConfig{SigningSecret: "secret", Roles: map[string]SlackRole{"*": SlackRoleViewer}}},
+		clock:  func() time.Time { return now },
+	}
+	body := "command=%2Fsqs&text=depth+orders&user_id=U1"
+
+	t.Run("accepts a signed request", func(t *testing.T) {
+		timestamp, signature := signSlackRequest("secret", now.Add(-time.Minute), body)
+		assert.NoError(t, service.VerifySlackRequest(timestamp, signature, []byte(body)))
+	})
+
+	t.Run("rejects another secret, a changed body and an old request", func(t *testing.T) {
+		timestamp, signature := signSlackRequest("other", now, body)
+		assert.ErrorIs(t, service.VerifySlackRequest(timestamp, signature, []byte(body)), ErrSlackSignature)
+
+		timestamp, signature = signSlackRequest("secret", now, body)
+		assert.ErrorIs(t, service.VerifySlackRequest(timestamp, signature, []byte(body+"x")), ErrSlackSignature)
+
+		timestamp, signature = signSlackRequest("secret", now.Add(-6*time.Minute), body)
+		assert.ErrorIs(t, service.VerifySlackRequest(timestamp, signature, []byte(body)), ErrSlackSignature)
+
+		assert.ErrorIs(t, service.VerifySlackRequest("", signature, []byte(body)), ErrSlackSignature)
+	})
+
+	t.Run("is disabled without a signing secret", func(t *testing.T) {
+		assert.ErrorIs(t, (&SqsServiceImpl{}).VerifySlackRequest("1", "v0=", nil), ErrSlackDisabled)
+	})
+}
+
+func TestSqsServiceImpl_RunSlackCommand(t *testing.T) {
+	ctx := context.Background()
+	slack := SlackConfig{
+		SigningSecret: "secret",
+		Roles:         map[string]SlackRole{"UOPS": SlackRoleOperator, "UDEV": SlackRoleViewer},
+	}
+	const ordersURL = "https://sqs.local/000000000000/orders"
+
+	newService := func(t *testing.T, slack SlackConfig) (*SqsServiceImpl, *MockSqsRepository) {
+		repo := NewMockSqsRepository(t)
+		return &SqsServiceImpl{repo: repo, config: ServiceConfig{Slack: slack}}, repo
+	}
+
+	t.Run("depth reads the counts of a queue", func(t *testing.T) {
+		service, repo := newService(t, slack)
+		repo.EXPECT().QueueURL(mock.Anything, "orders").Return(ordersURL, true, nil).Once()
+		repo.EXPECT().GetQueueStats(mock.Anything, ordersURL).Return(QueueStats{MessagesAvailable: 12, MessagesInFlight: 3, MessagesDelayed: 1}, nil).Once()
+
+		reply, err := service.RunSlackCommand(ctx, SlackCommand{Command: "/sqs", UserID: "UDEV", Text: " depth  orders "})
+		require.NoError(t, err)
+		assert.Equal(t, SlackReply{Text: "*orders*: 12 available, 3 in flight, 1 delayed"}, reply)
+	})
+
+	t.Run("depth of a missing queue", func(t *testing.T) {
+		service, repo := newService(t, slack)
+		repo.EXPECT().QueueURL(mock.Anything, "nope").Return("", false, nil).Once()
+
+		reply, err := service.RunSlackCommand(ctx, SlackCommand{Command: "/sqs", UserID: "UDEV", Text: "depth nope"})
+		require.NoError(t, err)
+		assert.Equal(t, "Could not read nope: queue nope does not exist", reply.Text)
+	})
+
+	t.Run("dlq lists dead-letter queues", func(t *testing.T) {
+		service, repo := newService(t, slack)
+		repo.EXPECT().ListQueues(mock.Anything).Return([]QueueSummary{
+			{URL: ordersURL, Name: "orders", Arn: "arn:orders", RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:orders-dlq", MaxReceiveCount: 5}},
+			{URL: ordersURL + "-dlq", Name: "orders-dlq", Arn: "arn:orders-dlq", MessagesAvailable: 4},
+		}, nil).Once()
+
+		reply, err := service.RunSlackCommand(ctx, SlackCommand{Command: "/sqs", UserID: "UDEV", Text: "dlq"})
+		require.NoError(t, err)
+		assert.Equal(t, "• *orders-dlq*: 4 available (from orders)", reply.Text)
+	})
+
+	t.Run("operators purge in the channel", func(t *testing.T) {
+		service, repo := newService(t, slack)
+		repo.EXPECT().QueueURL(mock.Anything, "orders").Return(ordersURL, true, nil).Once()
+		repo.EXPECT().PurgeQueue(mock.Anything, ordersURL).Return(nil).Once()
+
+		reply, err := service.RunSlackCommand(ctx, SlackCommand{Command: "/sqs", UserID: "UOPS", Text: "purge orders"})
+		require.NoError(t, err)
+		assert.Equal(t, SlackReply{Text: "<@UOPS> purged *orders*. SQS may take up to 60 seconds to delete every message.", InChannel: true}, reply)
+	})
+
+	t.Run("viewers may not purge", func(t *testing.T) {
+		service, _ := newService(t, slack)
+
+		reply, err := service.RunSlackCommand(ctx, SlackCommand{Command: "/sqs", UserID: "UDEV", Text: "purge orders"})
+		require.NoError(t, err)
+		assert.Equal(t, SlackReply{Text: "`purge` needs the operator role; you are a viewer."}, reply)
+	})
+
+	t.Run("unlisted users are refused unless * has a role", func(t *testing.T) {
+		service, _ := newService(t, slack)
+
+		reply, err := service.RunSlackCommand(ctx, SlackCommand{Command: "/sqs", UserID: "UOTHER", Text: "help"})
+		require.NoError(t, err)
+		assert.Equal(t, "You may not use /sqs. Ask an administrator to add your Slack user ID UOTHER to SQS_GUI_SLACK_ROLES.", reply.Text)
+
+		service, _ = newService(t, SlackConfig{SigningSecret: "secret", Roles: map[string]SlackRole{"*": SlackRoleViewer}})
+		reply, err = service.RunSlackCommand(ctx, SlackCommand{Command: "/sqs", UserID: "UOTHER", Text: ""})
+		require.NoError(t, err)
+		assert.Equal(t, "Commands available to you as viewer:\n• `/sqs depth <queue name>` – message counts of a queue\n• `/sqs dlq` – dead-letter queues and their depth", reply.Text)
+	})
+
+	t.Run("explains usage", func(t *testing.T) {
+		service, _ := newService(t, slack)
+
+		reply, err := service.RunSlackCommand(ctx, SlackCommand{Command: "/sqs", UserID: "UOPS", Text: "purge"})
+		require.NoError(t, err)
+		assert.Equal(t, "Usage: `/sqs purge <queue name>`", reply.Text)
+
+		reply, err = service.RunSlackCommand(ctx, SlackCommand{Command: "/sqs", UserID: "UOPS", Text: "delete orders"})
+		require.NoError(t, err)
+		assert.Contains(t, reply.Text, `Unknown command "delete".`)
+		assert.Contains(t, reply.Text, "`/sqs purge <queue name>`")
+	})
+}
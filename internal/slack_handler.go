@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+)
+
+// maxSlackCommandBytes bounds the form Slack posts for a slash command.
+const maxSlackCommandBytes = 64 << 10
+
+type slackCommandResponse struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+// SlackCommandHandler answers the /sqs slash command of a Slack app. The request must carry a
+// valid Slack signature; the reply is shown only to the caller unless the command changed a queue.
+func (h *HandlerImpl) SlackCommandHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSlackCommandBytes))
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.s.VerifySlackRequest(r.Header.Get("X-Slack-Request-Timestamp"), r.Header.Get("X-Slack-Signature"), body); err != nil {
+		switch {
+		case errors.Is(err, ErrSlackDisabled):
+			http.NotFound(w, r)
+		default:
+			slog.WarnContext(r.Context(), "rejected slack command", slog.Any("error", err))
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		}
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	reply, err := h.s.RunSlackCommand(r.Context(), SlackCommand{
+		Command: form.Get("command"),
+		UserID:  form.Get("user_id"),
+		Text:    form.Get("text"),
+	})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to run slack command", slog.Any("error", err))
+		http.Error(w, "failed to run command", http.StatusInternalServerError)
+		return
+	}
+
+	response := slackCommandResponse{ResponseType: "ephemeral", Text: reply.Text}
+	if reply.InChannel {
+		response.ResponseType = "in_channel"
+	}
+	writeJSON(w, http.StatusOK, response)
+}
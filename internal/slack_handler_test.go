@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_SlackCommandHandler(t *testing.T) {
+	body := "command=%2Fsqs&text=purge+tmp-queue&user_id=U1&user_name=alice"
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("X-Slack-Request-Timestamp", "1714564800")
+		req.Header.Set("X-Slack-Signature", "v0=abc")
+		return req
+	}
+
+	t.Run("answers a verified command", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().VerifySlackRequest("1714564800", "v0=abc", []byte(body)).Return(nil).Once()
+		mockService.EXPECT().
+			RunSlackCommand(mock.Anything, SlackCommand{Command: "/sqs", UserID: "U1", Text: "purge tmp-queue"}).
+			Return(SlackReply{Text: "<@U1> purged *tmp-queue*.", InChannel: true}, nil).
+			Once()
+
+		rr := httptest.NewRecorder()
+		handler.SlackCommandHandler(rr, newRequest())
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"response_type":"in_channel","text":"<@U1> purged *tmp-queue*."}`, rr.Body.String())
+	})
+
+	t.Run("rejects a bad signature", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().VerifySlackRequest(mock.Anything, mock.Anything, mock.Anything).Return(errors.WithStack(ErrSlackSignature)).Once()
+
+		rr := httptest.NewRecorder()
+		handler.SlackCommandHandler(rr, newRequest())
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("is not found when Slack is not configured", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().VerifySlackRequest(mock.Anything, mock.Anything, mock.Anything).Return(errors.WithStack(ErrSlackDisabled)).Once()
+
+		rr := httptest.NewRecorder()
+		handler.SlackCommandHandler(rr, newRequest())
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
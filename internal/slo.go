@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"slices"
+	"strconv"
+	"time"
+)
+
+// burnRateWindows are the periods burn rates are reported for: a short one that reacts quickly
+// to an outage and a long one that shows whether it lasts.
+var burnRateWindows = []time.Duration{5 * time.Minute, time.Hour}
+
+// OperationObjective is a service level objective for one SQS operation: Target is the share of
+// calls, between 0 and 1, that must succeed, within Latency when it is set. Latency is one of
+// the histogram bounds, so the objective can be checked against the same buckets in Prometheus.
+type OperationObjective struct {
+	Operation string
+	Target    float64
+	Latency   time.Duration
+}
+
+// String describes the objective, such as "99% within 2.5s".
+func (o OperationObjective) String() string {
+	target := strconv.FormatFloat(o.Target*100, 'g', 10, 64) + "%"
+	if o.Latency == 0 {
+		return target + " succeed"
+	}
+	return target + " within " + o.Latency.String()
+}
+
+// good counts the calls that met the objective in Good buckets of successful calls, given the
+// number of calls and of errors among them.
+func (o OperationObjective) good(requests, errs int64, good []int64) int64 {
+	if o.Latency == 0 {
+		return requests - errs
+	}
+	i := slices.Index(latencyBuckets, o.Latency)
+	if i < 0 || i >= len(good) {
+		return 0
+	}
+	return good[i]
+}
+
+// BurnRate is how fast an objective's error budget was spent over Window: 1 spends exactly the
+// budget the target allows, 10 spends it ten times as fast. It is 0 without calls in the window.
+type BurnRate struct {
+	Window   time.Duration
+	Requests int64
+	Rate     float64
+}
+
+// ObjectiveStatus measures an objective against the calls made since startup and in the recent
+// burn rate windows.
+type ObjectiveStatus struct {
+	Objective OperationObjective
+	Requests  int64
+	Good      int64
+	BurnRates []BurnRate
+}
+
+// Compliance is the share of calls since startup that met the objective, 1 without calls.
+func (s ObjectiveStatus) Compliance() float64 {
+	if s.Requests == 0 {
+		return 1
+	}
+	return float64(s.Good) / float64(s.Requests)
+}
+
+// Met reports whether the calls since startup met the target.
+func (s ObjectiveStatus) Met() bool {
+	return s.Compliance() >= s.Objective.Target
+}
+
+// evaluateObjectives measures each objective against the operation it names. Objectives for
+// operations not called yet are reported with no calls.
+func evaluateObjectives(metrics APIMetrics, objectives []OperationObjective) []ObjectiveStatus {
+	if len(objectives) == 0 {
+		return nil
+	}
+
+	statuses := make([]ObjectiveStatus, 0, len(objectives))
+	for _, objective := range objectives {
+		status := ObjectiveStatus{Objective: objective, BurnRates: make([]BurnRate, 0, len(burnRateWindows))}
+		i := slices.IndexFunc(metrics.Operations, func(m OperationMetrics) bool {
+			return m.Operation == objective.Operation
+		})
+		var operation OperationMetrics
+		if i >= 0 {
+			operation = metrics.Operations[i]
+			status.Requests = operation.Requests
+			status.Good = objective.good(operation.Requests, operation.Errors, operation.Good)
+		}
+
+		for _, window := range burnRateWindows {
+			// The current minute counts towards the window although it is not over yet.
+			from := metrics.TakenAt.Truncate(time.Minute).Add(-window + time.Minute)
+			var requests, good int64
+			for _, minute := range operation.Recent {
+				if minute.Start.Before(from) {
+					continue
+				}
+				requests += minute.Requests
+				good += objective.good(minute.Requests, minute.Errors, minute.Good)
+			}
+			burn := BurnRate{Window: window, Requests: requests}
+			if requests > 0 && objective.Target < 1 {
+				burn.Rate = float64(requests-good) / float64(requests) / (1 - objective.Target)
+			}
+			status.BurnRates = append(status.BurnRates, burn)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateObjectives(t *testing.T) {
+	takenAt := time.Date(2024, time.May, 1, 13, 0, 20, 0, time.UTC)
+	good := func(withinSecond int64) []int64 {
+		counts := make([]int64, len(latencyBuckets))
+		for i, bound := range latencyBuckets {
+			if bound >= time.Second {
+				counts[i] = withinSecond
+			}
+		}
+		return counts
+	}
+	metrics := APIMetrics{
+		TakenAt: takenAt,
+		Operations: []OperationMetrics{{
+			Operation: "ReceiveMessage",
+			Requests:  1000,
+			Errors:    10,
+			Good:      good(980),
+			Recent: []MinuteMetrics{
+				// Older than five minutes, so only in the hour window.
+				{Start: takenAt.Truncate(time.Minute).Add(-30 * time.Minute), Requests: 100, Good: good(100)},
+				{Start: takenAt.Truncate(time.Minute).Add(-4 * time.Minute), Requests: 50, Errors: 5, Good: good(40)},
+				{Start: takenAt.Truncate(time.Minute), Requests: 50, Good: good(50)},
+			},
+		}},
+	}
+
+	statuses := evaluateObjectives(metrics, []OperationObjective{
+		{Operation: "ReceiveMessage", Target: 0.99, Latency: time.Second},
+		{Operation: "ReceiveMessage", Target: 0.9},
+		{Operation: "SendMessage", Target: 0.999},
+	})
+	require.Len(t, statuses, 3)
+
+	latency := statuses[0]
+	assert.Equal(t, "99% within 1s", latency.Objective.String())
+	assert.Equal(t, int64(1000), latency.Requests)
+	assert.Equal(t, int64(980), latency.Good)
+	assert.InDelta(t, 0.98, latency.Compliance(), 1e-9)
+	assert.False(t, latency.Met())
+	require.Len(t, latency.BurnRates, 2)
+	// 10 of 100 calls in the last five minutes were bad against a budget of 1%.
+	assert.Equal(t, 5*time.Minute, latency.BurnRates[0].Window)
+	assert.Equal(t, int64(100), latency.BurnRates[0].Requests)
+	assert.InDelta(t, 10, latency.BurnRates[0].Rate, 1e-9)
+	assert.Equal(t, int64(200), latency.BurnRates[1].Requests)
+	assert.InDelta(t, 5, latency.BurnRates[1].Rate, 1e-9)
+
+	success := statuses[1]
+	assert.Equal(t, "90% succeed", success.Objective.String())
+	assert.Equal(t, int64(990), success.Good)
+	assert.True(t, success.Met())
+	// 5 of 100 calls failed against a budget of 10%.
+	assert.InDelta(t, 0.5, success.BurnRates[0].Rate, 1e-9)
+
+	idle := statuses[2]
+	assert.Equal(t, int64(0), idle.Requests)
+	assert.True(t, idle.Met())
+	assert.Equal(t, []BurnRate{{Window: 5 * time.Minute}, {Window: time.Hour}}, idle.BurnRates)
+}
+
+func TestEvaluateObjectives_WithoutObjectives(t *testing.T) {
+	assert.Nil(t, evaluateObjectives(APIMetrics{Operations: []OperationMetrics{{Operation: "SendMessage", Requests: 1}}}, nil))
+}
@@ -0,0 +1,343 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// matchFilterPolicy reports whether an SNS subscription filter policy lets a message through, and
+// when it does not, which key stopped it. scope is MessageAttributes or MessageBody, as in the
+// FilterPolicyScope attribute of the subscription. An empty policy lets every message through.
+//
+// The conditions SNS supports are evaluated locally: exact strings and numbers, prefix, suffix,
+// equals-ignore-case, anything-but, numeric ranges, exists, cidr, and $or.
+func matchFilterPolicy(policy, scope string, attributes []MessageAttribute, body string) (bool, string, error) {
+	if strings.TrimSpace(policy) == "" {
+		return true, "", nil
+	}
+	root, ok, err := decodeFilterJSON(policy)
+	if err != nil {
+		return false, "", errors.Wrap(err, "the filter policy is not valid JSON")
+	}
+	rootObject, isObject := root.(map[string]any)
+	if !ok || !isObject {
+		return false, "", errors.New("the filter policy must be a JSON object")
+	}
+
+	var message map[string]any
+	label := "attribute"
+	if scope == FilterPolicyScopeMessageBody {
+		label = "field"
+		decoded, ok, err := decodeFilterJSON(body)
+		message, _ = decoded.(map[string]any)
+		if err != nil || !ok || message == nil {
+			return false, "the body is not a JSON object, so a MessageBody filter policy never matches it", nil
+		}
+	} else {
+		message = filterAttributeValues(attributes)
+	}
+
+	reason, err := matchFilterObject(rootObject, message, "", label)
+	if err != nil {
+		return false, "", err
+	}
+	return reason == "", reason, nil
+}
+
+// decodeFilterJSON decodes a single JSON value keeping numbers as json.Number. ok is false when
+// there is more content after the value.
+func decodeFilterJSON(raw string) (any, bool, error) {
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return value, false, nil
+	}
+	return value, true, nil
+}
+
+// filterAttributeValues returns the message attributes the way a filter policy sees them: Number
+// values as numbers and String.Array values as lists. Binary attributes are never matched.
+func filterAttributeValues(attributes []MessageAttribute) map[string]any {
+	values := make(map[string]any, len(attributes))
+	for _, attribute := range attributes {
+		dataType := attribute.DataType
+		switch {
+		case messageAttributeBaseType(dataType) == "Binary":
+		case messageAttributeBaseType(dataType) == "Number":
+			values[attribute.Name] = json.Number(attribute.Value)
+		case dataType == "String.Array":
+			if list, ok, err := decodeFilterJSON(attribute.Value); err == nil && ok {
+				if elements, isList := list.([]any); isList {
+					values[attribute.Name] = elements
+					continue
+				}
+			}
+			values[attribute.Name] = attribute.Value
+		default:
+			values[attribute.Name] = attribute.Value
+		}
+	}
+	return values
+}
+
+// matchFilterObject matches every key of a policy object against message and returns why the
+// first key that fails does not match, or an empty string when all of them match. prefix is the
+// path of nested body fields.
+func matchFilterObject(policy map[string]any, message map[string]any, prefix, label string) (string, error) {
+	keys := make([]string, 0, len(policy))
+	for key := range policy {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		reason, err := matchFilterKey(key, policy[key], message, prefix, label)
+		if err != nil || reason != "" {
+			return reason, err
+		}
+	}
+	return "", nil
+}
+
+func matchFilterKey(key string, rule any, message map[string]any, prefix, label string) (string, error) {
+	if key == "$or" {
+		alternatives, ok := rule.([]any)
+		if !ok || len(alternatives) < 2 {
+			return "", errors.Newf("$or in %q must list at least two alternatives", prefix)
+		}
+		reasons := make([]string, 0, len(alternatives))
+		for _, alternative := range alternatives {
+			object, ok := alternative.(map[string]any)
+			if !ok {
+				return "", errors.New("every $or alternative must be a JSON object")
+			}
+			reason, err := matchFilterObject(object, message, prefix, label)
+			if err != nil {
+				return "", err
+			}
+			if reason == "" {
+				return "", nil
+			}
+			reasons = append(reasons, reason)
+		}
+		return "no $or alternative matches: " + strings.Join(reasons, "; "), nil
+	}
+
+	name := prefix + key
+	value, present := message[key]
+	switch rule := rule.(type) {
+	case map[string]any:
+		if label != "field" {
+			return "", errors.Newf("the filter policy for attribute %q must be a list of conditions; nested keys only apply to the MessageBody scope", name)
+		}
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Sprintf("field %q is not a JSON object", name), nil
+		}
+		return matchFilterObject(rule, nested, name+".", label)
+	case []any:
+		for _, condition := range rule {
+			matched, err := matchFilterCondition(name, condition, value, present)
+			if err != nil {
+				return "", err
+			}
+			if matched {
+				return "", nil
+			}
+		}
+		conditions, _ := json.Marshal(rule)
+		if !present {
+			return fmt.Sprintf("%s %q is missing, and %s needs it", label, name, conditions), nil
+		}
+		return fmt.Sprintf("%s %q does not match %s", label, name, conditions), nil
+	default:
+		return "", errors.Newf("the filter policy for %s %q must be a list of conditions", label, name)
+	}
+}
+
+// matchFilterCondition evaluates one condition of a key. A list value matches when any of its
+// elements does.
+func matchFilterCondition(name string, condition, value any, present bool) (bool, error) {
+	operator, isOperator := condition.(map[string]any)
+	if isOperator {
+		if len(operator) != 1 {
+			return false, errors.Newf("each condition for %q must have exactly one operator", name)
+		}
+		if exists, ok := operator["exists"]; ok {
+			want, ok := exists.(bool)
+			if !ok {
+				return false, errors.Newf("exists for %q must be true or false", name)
+			}
+			return present == want, nil
+		}
+	}
+	if !present {
+		return false, nil
+	}
+
+	candidates := []any{value}
+	if list, ok := value.([]any); ok {
+		candidates = list
+	}
+	for _, candidate := range candidates {
+		var matched bool
+		var err error
+		if isOperator {
+			matched, err = matchFilterOperator(name, operator, candidate)
+		} else {
+			matched, err = matchFilterLiteral(name, condition, candidate)
+		}
+		if err != nil || matched {
+			return matched, err
+		}
+	}
+	return false, nil
+}
+
+func matchFilterLiteral(name string, literal, value any) (bool, error) {
+	switch literal := literal.(type) {
+	case string:
+		text, ok := value.(string)
+		return ok && text == literal, nil
+	case json.Number:
+		return numbersEqual(literal, value), nil
+	case bool:
+		flag, ok := value.(bool)
+		return ok && flag == literal, nil
+	case nil:
+		return value == nil, nil
+	default:
+		return false, errors.Newf("the conditions for %q must be strings, numbers, booleans, null or operators", name)
+	}
+}
+
+func matchFilterOperator(name string, operator map[string]any, value any) (bool, error) {
+	for op, argument := range operator {
+		text, isText := value.(string)
+		switch op {
+		case "prefix", "suffix", "equals-ignore-case":
+			want, ok := argument.(string)
+			if !ok {
+				return false, errors.Newf("%s for %q must be a string", op, name)
+			}
+			if !isText {
+				return false, nil
+			}
+			switch op {
+			case "prefix":
+				return strings.HasPrefix(text, want), nil
+			case "suffix":
+				return strings.HasSuffix(text, want), nil
+			default:
+				return strings.EqualFold(text, want), nil
+			}
+		case "anything-but":
+			matched, err := matchAnythingBut(name, argument, value)
+			return !matched, err
+		case "numeric":
+			return matchNumericRange(name, argument, value)
+		case "cidr":
+			want, ok := argument.(string)
+			if !ok {
+				return false, errors.Newf("cidr for %q must be a string", name)
+			}
+			network, err := netip.ParsePrefix(want)
+			if err != nil {
+				return false, errors.Newf("cidr for %q must be an IP prefix such as 10.0.0.0/24", name)
+			}
+			address, err := netip.ParseAddr(text)
+			return isText && err == nil && network.Contains(address), nil
+		default:
+			return false, errors.Newf("%q is not a filter policy operator SNS supports", op)
+		}
+	}
+	return false, nil
+}
+
+// matchAnythingBut reports whether value matches what anything-but excludes: a literal, a list of
+// literals, or a prefix or suffix operator.
+func matchAnythingBut(name string, excluded, value any) (bool, error) {
+	switch excluded := excluded.(type) {
+	case []any:
+		for _, literal := range excluded {
+			matched, err := matchFilterLiteral(name, literal, value)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	case map[string]any:
+		for op := range excluded {
+			if op != "prefix" && op != "suffix" {
+				return false, errors.Newf("anything-but for %q only takes prefix or suffix operators", name)
+			}
+		}
+		return matchFilterOperator(name, excluded, value)
+	default:
+		return matchFilterLiteral(name, excluded, value)
+	}
+}
+
+// matchNumericRange evaluates a numeric condition such as [">=", 10, "<", 20].
+func matchNumericRange(name string, argument, value any) (bool, error) {
+	terms, ok := argument.([]any)
+	if !ok || len(terms) == 0 || len(terms)%2 != 0 {
+		return false, errors.Newf("numeric for %q must list operator and number pairs", name)
+	}
+	number, isNumber := filterNumber(value)
+	for i := 0; i < len(terms); i += 2 {
+		op, ok := terms[i].(string)
+		bound, isBound := filterNumber(terms[i+1])
+		if !ok || !isBound {
+			return false, errors.Newf("numeric for %q must list operator and number pairs", name)
+		}
+		if !isNumber {
+			return false, nil
+		}
+		var holds bool
+		switch op {
+		case "=":
+			holds = number == bound
+		case "<":
+			holds = number < bound
+		case "<=":
+			holds = number <= bound
+		case ">":
+			holds = number > bound
+		case ">=":
+			holds = number >= bound
+		default:
+			return false, errors.Newf("%q is not a numeric operator; use =, <, <=, > or >=", op)
+		}
+		if !holds {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func numbersEqual(literal json.Number, value any) bool {
+	want, ok := filterNumber(literal)
+	got, isNumber := filterNumber(value)
+	return ok && isNumber && want == got
+}
+
+func filterNumber(value any) (float64, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	parsed, err := strconv.ParseFloat(number.String(), 64)
+	return parsed, err == nil
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchFilterPolicy(t *testing.T) {
+	attributes := []MessageAttribute{
+		{Name: "store", Value: "example_corp"},
+		{Name: "event", Value: "order_placed"},
+		{Name: "price", Value: "210.75", DataType: "Number"},
+		{Name: "colors", Value: `["red","blue"]`, DataType: "String.Array"},
+		{Name: "source_ip", Value: "10.0.0.12"},
+		{Name: "payload", Value: "AAEC", DataType: "Binary"},
+	}
+
+	tests := []struct {
+		name       string
+		policy     string
+		scope      string
+		body       string
+		want       bool
+		wantReason string
+	}{
+		{name: "lets everything through without a policy", policy: "", want: true},
+		{name: "matches exact values", policy: `{"store":["example_corp"],"event":["order_cancelled","order_placed"]}`, want: true},
+		{
+			name:       "reports the key that does not match",
+			policy:     `{"store":["example_corp"],"event":["order_cancelled"]}`,
+			wantReason: `attribute "event" does not match ["order_cancelled"]`,
+		},
+		{
+			name:       "reports a missing attribute",
+			policy:     `{"customer_interests":["rugby"]}`,
+			wantReason: `attribute "customer_interests" is missing, and ["rugby"] needs it`,
+		},
+		{name: "matches numbers numerically", policy: `{"price":[210.750]}`, want: true},
+		{name: "does not match numbers as strings", policy: `{"price":["210.75"]}`, wantReason: `attribute "price" does not match ["210.75"]`},
+		{name: "matches numeric ranges", policy: `{"price":[{"numeric":[">",100,"<=",300]}]}`, want: true},
+		{name: "matches any element of a string array", policy: `{"colors":["blue"]}`, want: true},
+		{name: "matches prefixes, suffixes and case-insensitive values", policy: `{"store":[{"prefix":"example"}],"event":[{"suffix":"_placed"}],"colors":[{"equals-ignore-case":"RED"}]}`, want: true},
+		{name: "excludes values with anything-but", policy: `{"event":[{"anything-but":["order_cancelled","order_returned"]}]}`, want: true},
+		{name: "excludes prefixes with anything-but", policy: `{"store":[{"anything-but":{"prefix":"example"}}]}`, wantReason: `attribute "store" does not match [{"anything-but":{"prefix":"example"}}]`},
+		{name: "checks that attributes exist", policy: `{"store":[{"exists":true}],"coupon":[{"exists":false}]}`, want: true},
+		{name: "never matches binary attributes", policy: `{"payload":[{"exists":true}]}`, wantReason: `attribute "payload" is missing, and [{"exists":true}] needs it`},
+		{name: "matches addresses in a cidr block", policy: `{"source_ip":[{"cidr":"10.0.0.0/24"}]}`, want: true},
+		{name: "matches any $or alternative", policy: `{"$or":[{"store":["other_corp"]},{"price":[{"numeric":[">=",200]}]}]}`, want: true},
+		{
+			name:       "explains why no $or alternative matches",
+			policy:     `{"$or":[{"store":["other_corp"]},{"event":["order_cancelled"]}]}`,
+			wantReason: `no $or alternative matches: attribute "store" does not match ["other_corp"]; attribute "event" does not match ["order_cancelled"]`,
+		},
+		{
+			name:   "matches nested body fields",
+			policy: `{"order":{"status":["paid"],"total":[{"numeric":[">",10]}]},"gift":[false]}`,
+			scope:  FilterPolicyScopeMessageBody,
+			body:   `{"order":{"status":"paid","total":25},"gift":false}`,
+			want:   true,
+		},
+		{
+			name:       "reports the body field that does not match",
+			policy:     `{"order":{"status":["paid"]}}`,
+			scope:      FilterPolicyScopeMessageBody,
+			body:       `{"order":{"status":"pending"}}`,
+			wantReason: `field "order.status" does not match ["paid"]`,
+		},
+		{
+			name:       "never matches a body that is not JSON",
+			policy:     `{"order":["paid"]}`,
+			scope:      FilterPolicyScopeMessageBody,
+			body:       "order paid",
+			wantReason: "the body is not a JSON object, so a MessageBody filter policy never matches it",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := tt.scope
+			if scope == "" {
+				scope = FilterPolicyScopeMessageAttributes
+			}
+			matched, reason, err := matchFilterPolicy(tt.policy, scope, attributes, tt.body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, matched)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestMatchFilterPolicy_InvalidPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{name: "rejects invalid JSON", policy: `{"store":`, wantErr: "the filter policy is not valid JSON"},
+		{name: "requires an object", policy: `["store"]`, wantErr: "the filter policy must be a JSON object"},
+		{name: "requires lists of conditions", policy: `{"store":"example_corp"}`, wantErr: `the filter policy for attribute "store" must be a list of conditions`},
+		{name: "rejects unknown operators", policy: `{"store":[{"wildcard":"ex*"}]}`, wantErr: `"wildcard" is not a filter policy operator SNS supports`},
+		{name: "rejects nested attribute keys", policy: `{"store":{"name":["example_corp"]}}`, wantErr: "nested keys only apply to the MessageBody scope"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := matchFilterPolicy(tt.policy, FilterPolicyScopeMessageAttributes, []MessageAttribute{{Name: "store", Value: "example_corp"}}, "")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrSnsUnavailable is returned by the SNS features when the service was built without an SNS
+// client.
+var ErrSnsUnavailable = errors.New("SNS is not configured")
+
+// QueueTopics lists the SNS topics a queue is subscribed to.
+type QueueTopics struct {
+	QueueName     string
+	QueueArn      string
+	Subscriptions []TopicSubscription
+}
+
+// PublishToTopicInput is a test message for a topic the queue is subscribed to. Preview only
+// evaluates the filter policy of the queue's subscription and publishes nothing.
+type PublishToTopicInput struct {
+	QueueURL               string
+	TopicArn               string
+	Subject                string
+	Body                   string
+	MessageGroupID         string
+	MessageDeduplicationID string
+	Attributes             []MessageAttribute
+	Preview                bool
+}
+
+// PublishToTopicResult reports whether the subscription of the queue lets a message through.
+// MessageID is empty for a preview. FilterReason explains why the filter policy drops the message
+// when Delivered is false.
+type PublishToTopicResult struct {
+	MessageID    string
+	Subscription TopicSubscription
+	Delivered    bool
+	FilterReason string
+}
+
+// QueueTopics lists the SNS subscriptions that deliver to a queue.
+func (s *SqsServiceImpl) QueueTopics(ctx context.Context, queueURL string) (QueueTopics, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return QueueTopics{}, errors.New("queue url is required")
+	}
+	if s.topics == nil {
+		return QueueTopics{}, ErrSnsUnavailable
+	}
+	attributes, err := s.repo.GetQueueAttributes(ctx, queueURL, []string{"QueueArn"})
+	if err != nil {
+		return QueueTopics{}, err
+	}
+	queueArn := attributes["QueueArn"]
+	subscriptions, err := s.topics.QueueSubscriptions(ctx, queueArn)
+	if err != nil {
+		return QueueTopics{}, err
+	}
+	return QueueTopics{QueueName: extractQueueName(queueURL), QueueArn: queueArn, Subscriptions: subscriptions}, nil
+}
+
+// PublishToTopic publishes a test message to a topic the queue is subscribed to, so it travels the
+// whole SNS to SQS path, and reports whether the subscription filter policy delivers it to the
+// queue. Messages are published even when the policy drops them, to test that it does.
+func (s *SqsServiceImpl) PublishToTopic(ctx context.Context, input PublishToTopicInput) (PublishToTopicResult, error) {
+	topicArn := strings.TrimSpace(input.TopicArn)
+	if topicArn == "" {
+		return PublishToTopicResult{}, errors.New("topic is required")
+	}
+	if strings.TrimSpace(input.Body) == "" {
+		return PublishToTopicResult{}, errors.New("message body is required")
+	}
+	messageGroupID := strings.TrimSpace(input.MessageGroupID)
+	if strings.HasSuffix(topicArn, ".fifo") && messageGroupID == "" {
+		return PublishToTopicResult{}, errors.New("message group id is required for fifo topics")
+	}
+
+	attributes := make(map[string]string)
+	var dataTypes map[string]string
+	published := make([]MessageAttribute, 0, len(input.Attributes))
+	for _, attr := range input.Attributes {
+		name := strings.TrimSpace(attr.Name)
+		if name == "" {
+			continue
+		}
+		dataType, err := checkMessageAttributeValue(name, attr.DataType, attr.Value)
+		if err != nil {
+			return PublishToTopicResult{}, err
+		}
+		attributes[name] = attr.Value
+		if dataType != "String" {
+			if dataTypes == nil {
+				dataTypes = make(map[string]string)
+			}
+			dataTypes[name] = dataType
+		}
+		published = append(published, MessageAttribute{Name: name, Value: attr.Value, DataType: dataType})
+	}
+
+	topics, err := s.QueueTopics(ctx, input.QueueURL)
+	if err != nil {
+		return PublishToTopicResult{}, err
+	}
+	var result PublishToTopicResult
+	subscribed := false
+	for _, subscription := range topics.Subscriptions {
+		if subscription.TopicArn == topicArn {
+			result.Subscription, subscribed = subscription, true
+			break
+		}
+	}
+	if !subscribed {
+		return PublishToTopicResult{}, errors.Newf("queue %s is not subscribed to topic %s", topics.QueueName, topicArn)
+	}
+
+	if result.Subscription.Pending {
+		result.FilterReason = "the subscription is waiting for confirmation, so SNS delivers nothing to the queue yet"
+	} else {
+		result.Delivered, result.FilterReason, err = matchFilterPolicy(result.Subscription.FilterPolicy, result.Subscription.FilterPolicyScope, published, input.Body)
+		if err != nil {
+			return PublishToTopicResult{}, err
+		}
+	}
+	if input.Preview {
+		return result, nil
+	}
+
+	result.MessageID, err = s.topics.Publish(ctx, PublishRepositoryInput{
+		TopicArn:               topicArn,
+		Subject:                strings.TrimSpace(input.Subject),
+		Body:                   input.Body,
+		MessageGroupID:         messageGroupID,
+		MessageDeduplicationID: strings.TrimSpace(input.MessageDeduplicationID),
+		Attributes:             attributes,
+		AttributeTypes:         dataTypes,
+	})
+	if err != nil {
+		return PublishToTopicResult{}, err
+	}
+	slog.InfoContext(ctx, "published test message to topic",
+		slog.String("topic_arn", topicArn),
+		slog.String("queue_url", input.QueueURL),
+		slog.String("message_id", result.MessageID),
+		slog.Bool("delivered", result.Delivered),
+	)
+	return result, nil
+}
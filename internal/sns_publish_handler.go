@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type snsPublishPageData struct {
+	Title         string
+	ViteTags      template.HTML
+	QueueName     string
+	QueueArn      string
+	EscapedURL    string
+	Subscriptions []TopicSubscription
+	Form          PublishToTopicInput
+	// Result is the filter policy preview of the submitted form.
+	Result *PublishToTopicResult
+	// PublishedID, PublishedTopic and FilterReason describe the message published before the
+	// redirect back to the page.
+	PublishedID    string
+	PublishedTopic string
+	FilterReason   string
+	ErrorMessage   string
+}
+
+// SnsPublishHandler renders the page that publishes test messages to the SNS topics a queue is
+// subscribed to.
+func (h *HandlerImpl) SnsPublishHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	query := r.URL.Query()
+	data := snsPublishPageData{
+		Form:           PublishToTopicInput{QueueURL: queueURL, TopicArn: query.Get("topic")},
+		PublishedID:    query.Get("published"),
+		PublishedTopic: query.Get("topic"),
+		FilterReason:   query.Get("filtered"),
+	}
+	h.renderSnsPublish(w, r, http.StatusOK, data)
+}
+
+// PostSnsPublishHandler previews the subscription filter policy for the submitted message, or
+// publishes it when the form is submitted with action=publish and redirects back to the page.
+func (h *HandlerImpl) PostSnsPublishHandler(w http.ResponseWriter, r *http.Request) {
+	queueURL, status, err := h.queueURLFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, 2*maxMessageBodyBytes)
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form submission", http.StatusBadRequest)
+		return
+	}
+
+	form := r.PostForm
+	input := PublishToTopicInput{
+		QueueURL:               queueURL,
+		TopicArn:               strings.TrimSpace(form.Get("topic_arn")),
+		Subject:                strings.TrimSpace(form.Get("subject")),
+		Body:                   form.Get("message_body"),
+		MessageGroupID:         strings.TrimSpace(form.Get("message_group_id")),
+		MessageDeduplicationID: strings.TrimSpace(form.Get("message_deduplication_id")),
+		Attributes:             convertPayloadAttributes(formAttributes(form)),
+		Preview:                form.Get("action") != "publish",
+	}
+
+	result, err := h.s.PublishToTopic(r.Context(), input)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to publish to topic", slog.String("queue_url", queueURL), slog.String("topic_arn", input.TopicArn), slog.Any("error", err))
+		h.renderSnsPublish(w, r, serviceErrorStatus(err), snsPublishPageData{Form: input, ErrorMessage: err.Error()})
+		return
+	}
+	if input.Preview {
+		h.renderSnsPublish(w, r, http.StatusOK, snsPublishPageData{Form: input, Result: &result})
+		return
+	}
+
+	query := url.Values{"published": {result.MessageID}, "topic": {input.TopicArn}}
+	if !result.Delivered {
+		query.Set("filtered", result.FilterReason)
+	}
+	http.Redirect(w, r, "/queues/"+url.QueryEscape(queueURL)+"/sns?"+query.Encode(), http.StatusSeeOther)
+}
+
+// renderSnsPublish fills in the subscriptions of the queue and renders the page. When they cannot
+// be listed, for example because the endpoint has no SNS, the page explains why instead.
+func (h *HandlerImpl) renderSnsPublish(w http.ResponseWriter, r *http.Request, status int, data snsPublishPageData) {
+	queueURL := data.Form.QueueURL
+	data.Title = "Publish through SNS"
+	data.ViteTags = fragments["assets/js/sns_publish.ts"].Tags
+	data.QueueName = extractQueueName(queueURL)
+	data.EscapedURL = url.QueryEscape(queueURL)
+
+	topics, err := h.s.QueueTopics(r.Context(), queueURL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list topic subscriptions", slog.String("queue_url", queueURL), slog.Any("error", err))
+		if data.ErrorMessage == "" {
+			data.ErrorMessage = "Failed to list the SNS subscriptions of this queue: " + err.Error()
+			status = http.StatusInternalServerError
+		}
+	} else {
+		data.QueueArn = topics.QueueArn
+		data.Subscriptions = topics.Subscriptions
+		if data.Form.TopicArn == "" && len(topics.Subscriptions) > 0 {
+			data.Form.TopicArn = topics.Subscriptions[0].TopicArn
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := templates["sns-publish"].Execute(w, data); err != nil {
+		slog.Error("failed to render sns-publish template", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func captureSnsPublishTemplate(t *testing.T, captured *snsPublishPageData) {
+	t.Helper()
+	captureTemplate(t, "sns-publish", func(data snsPublishPageData) { *captured = data })
+	installFragment(t, "assets/js/sns_publish.ts", "")
+}
+
+func TestHandlerImpl_SnsPublishHandler(t *testing.T) {
+	queueURL := "https://sqs.local/000000000000/orders"
+	escaped := url.QueryEscape(queueURL)
+	subscriptions := []TopicSubscription{{TopicArn: testTopicArn}, {TopicArn: testTopicArn + "-audit"}}
+
+	newRequest := func(query string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/queues/"+escaped+"/sns"+query, nil)
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("lists the subscriptions and selects the first topic", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		var captured snsPublishPageData
+		captureSnsPublishTemplate(t, &captured)
+		mockService.EXPECT().QueueTopics(mock.Anything, queueURL).
+			Return(QueueTopics{QueueName: "orders", QueueArn: testQueueArn, Subscriptions: subscriptions}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.SnsPublishHandler(rr, newRequest(""))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "orders", captured.QueueName)
+		assert.Equal(t, testQueueArn, captured.QueueArn)
+		assert.Equal(t, subscriptions, captured.Subscriptions)
+		assert.Equal(t, testTopicArn, captured.Form.TopicArn)
+	})
+
+	t.Run("shows the published message", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		var captured snsPublishPageData
+		captureSnsPublishTemplate(t, &captured)
+		mockService.EXPECT().QueueTopics(mock.Anything, queueURL).
+			Return(QueueTopics{QueueName: "orders", Subscriptions: subscriptions}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		query := url.Values{"published": {"message-1"}, "topic": {testTopicArn + "-audit"}, "filtered": {"attribute \"event\" is missing"}}
+		handler.SnsPublishHandler(rr, newRequest("?"+query.Encode()))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "message-1", captured.PublishedID)
+		assert.Equal(t, testTopicArn+"-audit", captured.PublishedTopic)
+		assert.Equal(t, testTopicArn+"-audit", captured.Form.TopicArn, "the topic stays selected")
+		assert.Equal(t, `attribute "event" is missing`, captured.FilterReason)
+	})
+
+	t.Run("explains why subscriptions cannot be listed", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		var captured snsPublishPageData
+		captureSnsPublishTemplate(t, &captured)
+		mockService.EXPECT().QueueTopics(mock.Anything, queueURL).Return(QueueTopics{}, errors.New("failed to call ListSubscriptions API")).Once()
+
+		rr := httptest.NewRecorder()
+		handler.SnsPublishHandler(rr, newRequest(""))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, "Failed to list the SNS subscriptions of this queue: failed to call ListSubscriptions API", captured.ErrorMessage)
+		assert.Equal(t, "orders", captured.QueueName)
+	})
+}
+
+func TestHandlerImpl_PostSnsPublishHandler(t *testing.T) {
+	queueURL := "https://sqs.local/000000000000/orders"
+	escaped := url.QueryEscape(queueURL)
+	topics := QueueTopics{QueueName: "orders", QueueArn: testQueueArn, Subscriptions: []TopicSubscription{{TopicArn: testTopicArn}}}
+	form := url.Values{
+		"topic_arn":         {testTopicArn},
+		"subject":           {" test "},
+		"message_body":      {`{"id":1}`},
+		"attribute_name[]":  {"event", ""},
+		"attribute_value[]": {"order_placed", ""},
+		"attribute_type[]":  {"String", "String"},
+	}
+	input := PublishToTopicInput{
+		QueueURL:   queueURL,
+		TopicArn:   testTopicArn,
+		Subject:    "test",
+		Body:       `{"id":1}`,
+		Attributes: []MessageAttribute{{Name: "event", Value: "order_placed", DataType: "String"}},
+	}
+
+	newRequest := func(action string) *http.Request {
+		values := url.Values{"action": {action}}
+		for key, value := range form {
+			values[key] = value
+		}
+		req := httptest.NewRequest(http.MethodPost, "/queues/"+escaped+"/sns", strings.NewReader(values.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetPathValue("url", escaped)
+		return req
+	}
+
+	t.Run("publishes and redirects with the filter outcome", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().PublishToTopic(mock.Anything, input).
+			Return(PublishToTopicResult{MessageID: "message-1", FilterReason: `attribute "store" is missing`}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.PostSnsPublishHandler(rr, newRequest("publish"))
+
+		assert.Equal(t, http.StatusSeeOther, rr.Code)
+		want := url.Values{"published": {"message-1"}, "topic": {testTopicArn}, "filtered": {`attribute "store" is missing`}}
+		assert.Equal(t, "/queues/"+escaped+"/sns?"+want.Encode(), rr.Header().Get("Location"))
+	})
+
+	t.Run("shows the preview with the form", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		var captured snsPublishPageData
+		captureSnsPublishTemplate(t, &captured)
+		preview := input
+		preview.Preview = true
+		result := PublishToTopicResult{Subscription: topics.Subscriptions[0], Delivered: true}
+		mockService.EXPECT().PublishToTopic(mock.Anything, preview).Return(result, nil).Once()
+		mockService.EXPECT().QueueTopics(mock.Anything, queueURL).Return(topics, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.PostSnsPublishHandler(rr, newRequest("preview"))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, &result, captured.Result)
+		assert.Equal(t, preview, captured.Form)
+		assert.Equal(t, topics.Subscriptions, captured.Subscriptions)
+	})
+
+	t.Run("keeps the form when publishing fails", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		var captured snsPublishPageData
+		captureSnsPublishTemplate(t, &captured)
+		mockService.EXPECT().PublishToTopic(mock.Anything, input).Return(PublishToTopicResult{}, errors.New("message body is required")).Once()
+		mockService.EXPECT().QueueTopics(mock.Anything, queueURL).Return(topics, nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.PostSnsPublishHandler(rr, newRequest("publish"))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "message body is required", captured.ErrorMessage)
+		assert.Equal(t, input, captured.Form)
+	})
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testTopicArn = "arn:aws:sns:us-east-1:000000000000:order-events"
+
+func TestSqsServiceImpl_QueueTopics(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+
+	t.Run("lists the subscriptions of the queue", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		topics := NewMockSnsRepository(t)
+		service := &SqsServiceImpl{repo: repo, topics: topics}
+		subscriptions := []TopicSubscription{{TopicArn: testTopicArn, FilterPolicyScope: FilterPolicyScopeMessageAttributes}}
+		repo.EXPECT().GetQueueAttributes(mock.Anything, queueURL, []string{"QueueArn"}).Return(map[string]string{"QueueArn": testQueueArn}, nil).Once()
+		topics.EXPECT().QueueSubscriptions(mock.Anything, testQueueArn).Return(subscriptions, nil).Once()
+
+		got, err := service.QueueTopics(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, QueueTopics{QueueName: "orders", QueueArn: testQueueArn, Subscriptions: subscriptions}, got)
+	})
+
+	t.Run("fails without an SNS client", func(t *testing.T) {
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t)}
+
+		_, err := service.QueueTopics(ctx, queueURL)
+		assert.ErrorIs(t, err, ErrSnsUnavailable)
+	})
+}
+
+func TestSqsServiceImpl_PublishToTopic(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders"
+	subscription := TopicSubscription{
+		SubscriptionArn:   testTopicArn + ":1",
+		TopicArn:          testTopicArn,
+		FilterPolicy:      `{"event":["order_placed"]}`,
+		FilterPolicyScope: FilterPolicyScopeMessageAttributes,
+	}
+
+	newService := func(t *testing.T, subscriptions ...TopicSubscription) (*SqsServiceImpl, *MockSnsRepository) {
+		repo := NewMockSqsRepository(t)
+		topics := NewMockSnsRepository(t)
+		repo.EXPECT().GetQueueAttributes(mock.Anything, queueURL, []string{"QueueArn"}).Return(map[string]string{"QueueArn": testQueueArn}, nil).Once()
+		topics.EXPECT().QueueSubscriptions(mock.Anything, testQueueArn).Return(subscriptions, nil).Once()
+		return &SqsServiceImpl{repo: repo, topics: topics}, topics
+	}
+
+	t.Run("publishes a message the filter policy delivers", func(t *testing.T) {
+		service, topics := newService(t, subscription)
+		topics.EXPECT().Publish(mock.Anything, PublishRepositoryInput{
+			TopicArn:       testTopicArn,
+			Subject:        "test",
+			Body:           `{"id":1}`,
+			Attributes:     map[string]string{"event": "order_placed", "total": "12"},
+			AttributeTypes: map[string]string{"total": "Number"},
+		}).Return("message-1", nil).Once()
+
+		result, err := service.PublishToTopic(ctx, PublishToTopicInput{
+			QueueURL: queueURL,
+			TopicArn: testTopicArn,
+			Subject:  " test ",
+			Body:     `{"id":1}`,
+			Attributes: []MessageAttribute{
+				{Name: "event", Value: "order_placed"},
+				{Name: "total", Value: "12", DataType: "Number"},
+				{Name: " ", Value: "ignored"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, PublishToTopicResult{MessageID: "message-1", Subscription: subscription, Delivered: true}, result)
+	})
+
+	t.Run("previews a message the filter policy drops without publishing", func(t *testing.T) {
+		service, _ := newService(t, subscription)
+
+		result, err := service.PublishToTopic(ctx, PublishToTopicInput{
+			QueueURL:   queueURL,
+			TopicArn:   testTopicArn,
+			Body:       "hello",
+			Attributes: []MessageAttribute{{Name: "event", Value: "order_cancelled"}},
+			Preview:    true,
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Delivered)
+		assert.Empty(t, result.MessageID)
+		assert.Equal(t, `attribute "event" does not match ["order_placed"]`, result.FilterReason)
+	})
+
+	t.Run("reports pending subscriptions as not delivering", func(t *testing.T) {
+		service, _ := newService(t, TopicSubscription{TopicArn: testTopicArn, Pending: true})
+
+		result, err := service.PublishToTopic(ctx, PublishToTopicInput{QueueURL: queueURL, TopicArn: testTopicArn, Body: "hello", Preview: true})
+		require.NoError(t, err)
+		assert.False(t, result.Delivered)
+		assert.Contains(t, result.FilterReason, "waiting for confirmation")
+	})
+
+	t.Run("only publishes to topics the queue is subscribed to", func(t *testing.T) {
+		service, _ := newService(t, subscription)
+
+		_, err := service.PublishToTopic(ctx, PublishToTopicInput{QueueURL: queueURL, TopicArn: "arn:aws:sns:us-east-1:000000000000:other", Body: "hello"})
+		assert.EqualError(t, err, "queue orders is not subscribed to topic arn:aws:sns:us-east-1:000000000000:other")
+	})
+
+	t.Run("validates the message before looking up the topic", func(t *testing.T) {
+		service := &SqsServiceImpl{}
+
+		_, err := service.PublishToTopic(ctx, PublishToTopicInput{QueueURL: queueURL, TopicArn: testTopicArn + ".fifo", Body: "hello"})
+		assert.EqualError(t, err, "message group id is required for fifo topics")
+
+		_, err = service.PublishToTopic(ctx, PublishToTopicInput{QueueURL: queueURL, TopicArn: testTopicArn, Body: "hello", Attributes: []MessageAttribute{{Name: "total", Value: "many", DataType: "Number"}}})
+		assert.EqualError(t, err, "attribute total must be a number")
+	})
+}
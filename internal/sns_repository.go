@@ -0,0 +1,187 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/cockroachdb/errors"
+)
+
+type snsAPI interface {
+	ListSubscriptions(ctx context.Context, params *sns.ListSubscriptionsInput, optFns ...func(*sns.Options)) (*sns.ListSubscriptionsOutput, error)
+	GetSubscriptionAttributes(ctx context.Context, params *sns.GetSubscriptionAttributesInput, optFns ...func(*sns.Options)) (*sns.GetSubscriptionAttributesOutput, error)
+	Publish(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options)) (*sns.PublishOutput, error)
+}
+
+// SnsRepository centralises access to the SNS APIs used to test the topics a queue is subscribed to.
+type SnsRepository interface {
+	QueueSubscriptions(ctx context.Context, queueArn string) ([]TopicSubscription, error)
+	Publish(ctx context.Context, input PublishRepositoryInput) (string, error)
+}
+
+// SnsRepositoryImpl uses the AWS SDK to talk to SNS.
+type SnsRepositoryImpl struct {
+	snsClient snsAPI
+}
+
+// pendingSubscriptionArn is the ARN SNS lists for a subscription that is not confirmed yet.
+const pendingSubscriptionArn = "PendingConfirmation"
+
+// Scopes of a subscription filter policy.
+const (
+	FilterPolicyScopeMessageAttributes = "MessageAttributes"
+	FilterPolicyScopeMessageBody       = "MessageBody"
+)
+
+// TopicSubscription is an SNS subscription that delivers the messages of a topic to a queue.
+type TopicSubscription struct {
+	SubscriptionArn string
+	TopicArn        string
+	// Pending is set while the subscription waits for confirmation; SNS delivers nothing until then.
+	Pending bool
+	// FilterPolicy is empty when every message of the topic is delivered.
+	FilterPolicy string
+	// FilterPolicyScope is MessageAttributes or MessageBody.
+	FilterPolicyScope string
+	// RawMessageDelivery is set when the queue receives the published body instead of the SNS
+	// JSON envelope.
+	RawMessageDelivery bool
+}
+
+// TopicName returns the last part of the topic ARN.
+func (s TopicSubscription) TopicName() string {
+	return s.TopicArn[strings.LastIndex(s.TopicArn, ":")+1:]
+}
+
+// PublishRepositoryInput carries the parameters of a Publish call.
+type PublishRepositoryInput struct {
+	TopicArn               string
+	Subject                string
+	Body                   string
+	MessageGroupID         string
+	MessageDeduplicationID string
+	Attributes             map[string]string
+	// AttributeTypes holds the data type of the attributes that are not plain strings, by name.
+	AttributeTypes map[string]string
+}
+
+// NewSnsRepository creates a repository backed by the provided SNS client.
+func NewSnsRepository(c snsAPI) SnsRepository {
+	return &SnsRepositoryImpl{snsClient: c}
+}
+
+// QueueSubscriptions lists the SQS subscriptions delivering to the queue with the given ARN,
+// sorted by topic, with their filter policies.
+func (s *SnsRepositoryImpl) QueueSubscriptions(ctx context.Context, queueArn string) ([]TopicSubscription, error) {
+	input := &sns.ListSubscriptionsInput{}
+	subscriptions := make([]TopicSubscription, 0)
+	for {
+		resp, err := s.snsClient.ListSubscriptions(ctx, input)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call ListSubscriptions API")
+		}
+		for _, listed := range resp.Subscriptions {
+			if aws.ToString(listed.Protocol) != "sqs" || aws.ToString(listed.Endpoint) != queueArn {
+				continue
+			}
+			subscription := TopicSubscription{
+				SubscriptionArn: aws.ToString(listed.SubscriptionArn),
+				TopicArn:        aws.ToString(listed.TopicArn),
+			}
+			if subscription.SubscriptionArn == pendingSubscriptionArn {
+				subscription.Pending = true
+			} else if err := s.readSubscriptionAttributes(ctx, &subscription); err != nil {
+				return nil, err
+			}
+			subscriptions = append(subscriptions, subscription)
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].TopicArn < subscriptions[j].TopicArn
+	})
+	return subscriptions, nil
+}
+
+func (s *SnsRepositoryImpl) readSubscriptionAttributes(ctx context.Context, subscription *TopicSubscription) error {
+	resp, err := s.snsClient.GetSubscriptionAttributes(ctx, &sns.GetSubscriptionAttributesInput{
+		SubscriptionArn: aws.String(subscription.SubscriptionArn),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to call GetSubscriptionAttributes API")
+	}
+	subscription.FilterPolicy = resp.Attributes["FilterPolicy"]
+	subscription.FilterPolicyScope = cmp.Or(resp.Attributes["FilterPolicyScope"], FilterPolicyScopeMessageAttributes)
+	subscription.RawMessageDelivery = resp.Attributes["RawMessageDelivery"] == "true"
+	subscription.Pending = resp.Attributes["PendingConfirmation"] == "true"
+	return nil
+}
+
+// Publish publishes a message to a topic and returns the message ID SNS assigned to it.
+func (s *SnsRepositoryImpl) Publish(ctx context.Context, input PublishRepositoryInput) (string, error) {
+	attributes, err := snsMessageAttributeValues(input.Attributes, input.AttributeTypes)
+	if err != nil {
+		return "", err
+	}
+
+	req := &sns.PublishInput{
+		TopicArn:          aws.String(input.TopicArn),
+		Message:           aws.String(input.Body),
+		MessageAttributes: attributes,
+	}
+	if input.Subject != "" {
+		req.Subject = aws.String(input.Subject)
+	}
+	if input.MessageGroupID != "" {
+		req.MessageGroupId = aws.String(input.MessageGroupID)
+	}
+	if input.MessageDeduplicationID != "" {
+		req.MessageDeduplicationId = aws.String(input.MessageDeduplicationID)
+	}
+
+	resp, err := s.snsClient.Publish(ctx, req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to call Publish API")
+	}
+	return aws.ToString(resp.MessageId), nil
+}
+
+// snsMessageAttributeValues converts attribute values to SNS message attributes the same way
+// messageAttributeValues does for SQS.
+func snsMessageAttributeValues(attributes map[string]string, dataTypes map[string]string) (map[string]types.MessageAttributeValue, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	values := make(map[string]types.MessageAttributeValue, len(attributes))
+	for key, value := range attributes {
+		if strings.TrimSpace(key) == "" {
+			continue
+		}
+		dataType := cmp.Or(dataTypes[key], "String")
+		if messageAttributeBaseType(dataType) == "Binary" {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "attribute %s is not valid base64", key)
+			}
+			values[key] = types.MessageAttributeValue{DataType: aws.String(dataType), BinaryValue: decoded}
+			continue
+		}
+		values[key] = types.MessageAttributeValue{
+			DataType:    aws.String(dataType),
+			StringValue: aws.String(value),
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnsRepositoryImpl_QueueSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	api := newMocksnsAPI(t)
+	repo := &SnsRepositoryImpl{snsClient: api}
+
+	api.EXPECT().ListSubscriptions(mock.Anything, &sns.ListSubscriptionsInput{}).Return(&sns.ListSubscriptionsOutput{
+		Subscriptions: []types.Subscription{
+			{Protocol: aws.String("sqs"), Endpoint: aws.String(testQueueArn), TopicArn: aws.String(testTopicArn), SubscriptionArn: aws.String(testTopicArn + ":1")},
+			{Protocol: aws.String("sqs"), Endpoint: aws.String("arn:aws:sqs:us-east-1:000000000000:billing"), TopicArn: aws.String(testTopicArn)},
+			{Protocol: aws.String("email"), Endpoint: aws.String("ops@example.com"), TopicArn: aws.String(testTopicArn)},
+		},
+		NextToken: aws.String("page-2"),
+	}, nil).Once()
+	api.EXPECT().ListSubscriptions(mock.Anything, &sns.ListSubscriptionsInput{NextToken: aws.String("page-2")}).Return(&sns.ListSubscriptionsOutput{
+		Subscriptions: []types.Subscription{
+			{Protocol: aws.String("sqs"), Endpoint: aws.String(testQueueArn), TopicArn: aws.String("arn:aws:sns:us-east-1:111122223333:audit"), SubscriptionArn: aws.String("PendingConfirmation")},
+		},
+	}, nil).Once()
+	api.EXPECT().GetSubscriptionAttributes(mock.Anything, &sns.GetSubscriptionAttributesInput{SubscriptionArn: aws.String(testTopicArn + ":1")}).
+		Return(&sns.GetSubscriptionAttributesOutput{Attributes: map[string]string{
+			"FilterPolicy":        `{"event":["order_placed"]}`,
+			"RawMessageDelivery":  "true",
+			"PendingConfirmation": "false",
+		}}, nil).Once()
+
+	subscriptions, err := repo.QueueSubscriptions(ctx, testQueueArn)
+	require.NoError(t, err)
+	assert.Equal(t, []TopicSubscription{
+		{
+			SubscriptionArn:    testTopicArn + ":1",
+			TopicArn:           testTopicArn,
+			FilterPolicy:       `{"event":["order_placed"]}`,
+			FilterPolicyScope:  FilterPolicyScopeMessageAttributes,
+			RawMessageDelivery: true,
+		},
+		{SubscriptionArn: "PendingConfirmation", TopicArn: "arn:aws:sns:us-east-1:111122223333:audit", Pending: true},
+	}, subscriptions)
+	assert.Equal(t, "order-events", subscriptions[0].TopicName())
+}
+
+func TestSnsRepositoryImpl_Publish(t *testing.T) {
+	api := newMocksnsAPI(t)
+	repo := &SnsRepositoryImpl{snsClient: api}
+
+	api.EXPECT().Publish(mock.Anything, &sns.PublishInput{
+		TopicArn:               aws.String(testTopicArn + ".fifo"),
+		Message:                aws.String("hello"),
+		MessageGroupId:         aws.String("group-1"),
+		MessageDeduplicationId: aws.String("dedup-1"),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"event":   {DataType: aws.String("String"), StringValue: aws.String("order_placed")},
+			"payload": {DataType: aws.String("Binary"), BinaryValue: []byte{0, 1, 2}},
+		},
+	}).Return(&sns.PublishOutput{MessageId: aws.String("message-1")}, nil).Once()
+
+	id, err := repo.Publish(context.Background(), PublishRepositoryInput{
+		TopicArn:               testTopicArn + ".fifo",
+		Body:                   "hello",
+		MessageGroupID:         "group-1",
+		MessageDeduplicationID: "dedup-1",
+		Attributes:             map[string]string{"event": "order_placed", "payload": "AAEC"},
+		AttributeTypes:         map[string]string{"payload": "Binary"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "message-1", id)
+}
@@ -0,0 +1,383 @@
+package internal
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+// latencyBuckets are the upper bounds of the request duration histogram. The last ones cover
+// ReceiveMessage, whose latency includes up to 20 seconds of long polling.
+var latencyBuckets = []time.Duration{
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+	25 * time.Second,
+}
+
+// recentMinutes is how far back per-minute counts are kept for burn rates.
+const recentMinutes = 60
+
+// OperationMetrics summarises the SQS calls of one API operation since the process started.
+// Buckets holds the cumulative number of calls that finished within each of LatencyBuckets, and
+// Good the number of those that also succeeded.
+type OperationMetrics struct {
+	Operation    string
+	Requests     int64
+	Errors       int64
+	TotalLatency time.Duration
+	MaxLatency   time.Duration
+	Buckets      []int64
+	Good         []int64
+	// Recent counts the calls of each minute in the last hour that had any, oldest first.
+	Recent []MinuteMetrics
+}
+
+// MinuteMetrics counts the calls of an operation that started in the minute from Start. Good
+// holds the successful calls within each of LatencyBuckets, like OperationMetrics.Good.
+type MinuteMetrics struct {
+	Start    time.Time
+	Requests int64
+	Errors   int64
+	Good     []int64
+}
+
+// AverageLatency is the mean duration of the operation's calls.
+func (m OperationMetrics) AverageLatency() time.Duration {
+	if m.Requests == 0 {
+		return 0
+	}
+	return m.TotalLatency / time.Duration(m.Requests)
+}
+
+// ErrorRate is the share of the operation's calls that failed, between 0 and 1.
+func (m OperationMetrics) ErrorRate() float64 {
+	if m.Requests == 0 {
+		return 0
+	}
+	return float64(m.Errors) / float64(m.Requests)
+}
+
+// QueueRequestMetrics counts the SQS requests issued for one queue. QueueURL is empty for
+// requests that are not tied to a queue, such as ListQueues.
+type QueueRequestMetrics struct {
+	QueueURL string
+	Requests int64
+}
+
+// APIMetrics is a snapshot of the SQS API calls issued by this process, taken at TakenAt.
+type APIMetrics struct {
+	Since          time.Time
+	TakenAt        time.Time
+	LatencyBuckets []time.Duration
+	Operations     []OperationMetrics
+	Queues         []QueueRequestMetrics
+	// Objectives reports the configured service level objectives against these calls.
+	Objectives []ObjectiveStatus
+}
+
+// apiMetrics accumulates per-operation call counts and latencies.
+type apiMetrics struct {
+	mu            sync.Mutex
+	now           func() time.Time
+	since         time.Time
+	operations    map[string]*OperationMetrics
+	queueRequests map[string]int64
+	// minutes is a ring of per-minute counts for each operation, indexed by minute of the hour.
+	minutes map[string]*[recentMinutes]MinuteMetrics
+}
+
+func newAPIMetrics() *apiMetrics {
+	return &apiMetrics{
+		now:           time.Now,
+		since:         time.Now(),
+		operations:    make(map[string]*OperationMetrics),
+		queueRequests: make(map[string]int64),
+		minutes:       make(map[string]*[recentMinutes]MinuteMetrics),
+	}
+}
+
+func (m *apiMetrics) record(operation, queueURL string, start time.Time, elapsed time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.queueRequests[queueURL]++
+
+	stats, ok := m.operations[operation]
+	if !ok {
+		stats = &OperationMetrics{
+			Operation: operation,
+			Buckets:   make([]int64, len(latencyBuckets)),
+			Good:      make([]int64, len(latencyBuckets)),
+		}
+		m.operations[operation] = stats
+	}
+	stats.Requests++
+	if err != nil {
+		stats.Errors++
+	}
+	stats.TotalLatency += elapsed
+	stats.MaxLatency = max(stats.MaxLatency, elapsed)
+
+	minute := m.minute(operation, start)
+	minute.Requests++
+	if err != nil {
+		minute.Errors++
+	}
+	for i, bound := range latencyBuckets {
+		if elapsed <= bound {
+			stats.Buckets[i]++
+			if err == nil {
+				stats.Good[i]++
+				minute.Good[i]++
+			}
+		}
+	}
+}
+
+// minute returns the counts of operation for the minute containing start, clearing the slot when
+// it still holds a minute from an hour before.
+func (m *apiMetrics) minute(operation string, start time.Time) *MinuteMetrics {
+	ring, ok := m.minutes[operation]
+	if !ok {
+		ring = new([recentMinutes]MinuteMetrics)
+		m.minutes[operation] = ring
+	}
+	start = start.Truncate(time.Minute)
+	slot := &ring[start.Unix()/60%recentMinutes]
+	if !slot.Start.Equal(start) {
+		*slot = MinuteMetrics{Start: start, Good: make([]int64, len(latencyBuckets))}
+	}
+	return slot
+}
+
+func (m *apiMetrics) snapshot() APIMetrics {
+	if m == nil {
+		return APIMetrics{LatencyBuckets: latencyBuckets, Operations: []OperationMetrics{}, Queues: []QueueRequestMetrics{}}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := APIMetrics{
+		Since:          m.since,
+		TakenAt:        m.now(),
+		LatencyBuckets: latencyBuckets,
+		Operations:     make([]OperationMetrics, 0, len(m.operations)),
+		Queues:         make([]QueueRequestMetrics, 0, len(m.queueRequests)),
+	}
+	oldest := snapshot.TakenAt.Truncate(time.Minute).Add(-(recentMinutes - 1) * time.Minute)
+	for _, stats := range m.operations {
+		operation := *stats
+		operation.Buckets = slices.Clone(stats.Buckets)
+		operation.Good = slices.Clone(stats.Good)
+		operation.Recent = []MinuteMetrics{}
+		for _, minute := range m.minutes[stats.Operation] {
+			if minute.Requests == 0 || minute.Start.Before(oldest) {
+				continue
+			}
+			minute.Good = slices.Clone(minute.Good)
+			operation.Recent = append(operation.Recent, minute)
+		}
+		slices.SortFunc(operation.Recent, func(a, b MinuteMetrics) int {
+			return a.Start.Compare(b.Start)
+		})
+		snapshot.Operations = append(snapshot.Operations, operation)
+	}
+	slices.SortFunc(snapshot.Operations, func(a, b OperationMetrics) int {
+		return strings.Compare(a.Operation, b.Operation)
+	})
+	for queueURL, requests := range m.queueRequests {
+		snapshot.Queues = append(snapshot.Queues, QueueRequestMetrics{QueueURL: queueURL, Requests: requests})
+	}
+	slices.SortFunc(snapshot.Queues, func(a, b QueueRequestMetrics) int {
+		if a.Requests != b.Requests {
+			return cmp.Compare(b.Requests, a.Requests)
+		}
+		return strings.Compare(a.QueueURL, b.QueueURL)
+	})
+	return snapshot
+}
+
+const (
+	// Request prices in USD per million requests, as listed for us-east-1. Each API call is counted
+	// once, so payloads SQS bills as several 64 KiB chunks and the free tier are not accounted for.
+	standardRequestPrice = 0.40
+	fifoRequestPrice     = 0.50
+	// hoursPerMonth is the average length of a month.
+	hoursPerMonth = 730
+	// minProjectionWindow is how long metrics must be collected before they are projected.
+	minProjectionWindow = time.Minute
+)
+
+// MonthlyRequests projects requests observed since Since to a full month at the same rate. It
+// reports false while too little time has passed for a meaningful projection.
+func (m APIMetrics) MonthlyRequests(requests int64) (float64, bool) {
+	elapsed := m.TakenAt.Sub(m.Since)
+	if elapsed < minProjectionWindow {
+		return 0, false
+	}
+	return float64(requests) / elapsed.Hours() * hoursPerMonth, true
+}
+
+// EstimatedMonthlyCost projects the USD cost of a queue's requests for a month at the current
+// rate. Requests not tied to a queue are priced as standard requests.
+func (m APIMetrics) EstimatedMonthlyCost(queue QueueRequestMetrics) (float64, bool) {
+	monthly, ok := m.MonthlyRequests(queue.Requests)
+	if !ok {
+		return 0, false
+	}
+	price := standardRequestPrice
+	if strings.HasSuffix(queue.QueueURL, ".fifo") {
+		price = fifoRequestPrice
+	}
+	return monthly / 1_000_000 * price, true
+}
+
+// metricsAPI times every call to the SQS client.
+type metricsAPI struct {
+	next    sqsAPI
+	metrics *apiMetrics
+}
+
+func newMetricsAPI(next sqsAPI, metrics *apiMetrics) *metricsAPI {
+	return &metricsAPI{next: next, metrics: metrics}
+}
+
+// observe runs call and records how long it took under operation. queueURL is empty for calls
+// that do not target a single queue.
+func observe[T any](m *metricsAPI, operation, queueURL string, call func() (T, error)) (T, error) {
+	start := m.metrics.now()
+	out, err := call()
+	m.metrics.record(operation, queueURL, start, m.metrics.now().Sub(start), err)
+	return out, err
+}
+
+func (m *metricsAPI) ListQueues(ctx context.Context, params *sqs.ListQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error) {
+	return observe(m, "ListQueues", "", func() (*sqs.ListQueuesOutput, error) {
+		return m.next.ListQueues(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	return observe(m, "GetQueueAttributes", aws.ToString(params.QueueUrl), func() (*sqs.GetQueueAttributesOutput, error) {
+		return m.next.GetQueueAttributes(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) CreateQueue(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error) {
+	return observe(m, "CreateQueue", "", func() (*sqs.CreateQueueOutput, error) {
+		return m.next.CreateQueue(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) ListQueueTags(ctx context.Context, params *sqs.ListQueueTagsInput, optFns ...func(*sqs.Options)) (*sqs.ListQueueTagsOutput, error) {
+	return observe(m, "ListQueueTags", aws.ToString(params.QueueUrl), func() (*sqs.ListQueueTagsOutput, error) {
+		return m.next.ListQueueTags(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) TagQueue(ctx context.Context, params *sqs.TagQueueInput, optFns ...func(*sqs.Options)) (*sqs.TagQueueOutput, error) {
+	return observe(m, "TagQueue", aws.ToString(params.QueueUrl), func() (*sqs.TagQueueOutput, error) {
+		return m.next.TagQueue(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) UntagQueue(ctx context.Context, params *sqs.UntagQueueInput, optFns ...func(*sqs.Options)) (*sqs.UntagQueueOutput, error) {
+	return observe(m, "UntagQueue", aws.ToString(params.QueueUrl), func() (*sqs.UntagQueueOutput, error) {
+		return m.next.UntagQueue(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) DeleteQueue(ctx context.Context, params *sqs.DeleteQueueInput, optFns ...func(*sqs.Options)) (*sqs.DeleteQueueOutput, error) {
+	return observe(m, "DeleteQueue", aws.ToString(params.QueueUrl), func() (*sqs.DeleteQueueOutput, error) {
+		return m.next.DeleteQueue(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) PurgeQueue(ctx context.Context, params *sqs.PurgeQueueInput, optFns ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error) {
+	return observe(m, "PurgeQueue", aws.ToString(params.QueueUrl), func() (*sqs.PurgeQueueOutput, error) {
+		return m.next.PurgeQueue(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+	return observe(m, "SendMessage", aws.ToString(params.QueueUrl), func() (*sqs.SendMessageOutput, error) {
+		return m.next.SendMessage(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) SendMessageBatch(ctx context.Context, params *sqs.SendMessageBatchInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error) {
+	return observe(m, "SendMessageBatch", aws.ToString(params.QueueUrl), func() (*sqs.SendMessageBatchOutput, error) {
+		return m.next.SendMessageBatch(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	return observe(m, "ReceiveMessage", aws.ToString(params.QueueUrl), func() (*sqs.ReceiveMessageOutput, error) {
+		return m.next.ReceiveMessage(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) DeleteMessage(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error) {
+	return observe(m, "DeleteMessage", aws.ToString(params.QueueUrl), func() (*sqs.DeleteMessageOutput, error) {
+		return m.next.DeleteMessage(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) ChangeMessageVisibility(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error) {
+	return observe(m, "ChangeMessageVisibility", aws.ToString(params.QueueUrl), func() (*sqs.ChangeMessageVisibilityOutput, error) {
+		return m.next.ChangeMessageVisibility(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) GetQueueUrl(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+	return observe(m, "GetQueueUrl", "", func() (*sqs.GetQueueUrlOutput, error) {
+		return m.next.GetQueueUrl(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) SetQueueAttributes(ctx context.Context, params *sqs.SetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error) {
+	return observe(m, "SetQueueAttributes", aws.ToString(params.QueueUrl), func() (*sqs.SetQueueAttributesOutput, error) {
+		return m.next.SetQueueAttributes(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) ListMessageMoveTasks(ctx context.Context, params *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error) {
+	return observe(m, "ListMessageMoveTasks", "", func() (*sqs.ListMessageMoveTasksOutput, error) {
+		return m.next.ListMessageMoveTasks(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) CancelMessageMoveTask(ctx context.Context, params *sqs.CancelMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error) {
+	return observe(m, "CancelMessageMoveTask", "", func() (*sqs.CancelMessageMoveTaskOutput, error) {
+		return m.next.CancelMessageMoveTask(ctx, params, optFns...)
+	})
+}
+
+func (m *metricsAPI) ListDeadLetterSourceQueues(ctx context.Context, params *sqs.ListDeadLetterSourceQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListDeadLetterSourceQueuesOutput, error) {
+	return observe(m, "ListDeadLetterSourceQueues", aws.ToString(params.QueueUrl), func() (*sqs.ListDeadLetterSourceQueuesOutput, error) {
+		return m.next.ListDeadLetterSourceQueues(ctx, params, optFns...)
+	})
+}
+
+// APIMetrics reports the latency and error counts of the SQS calls made by this process, and how
+// they measure up to the configured objectives.
+func (s *SqsServiceImpl) APIMetrics(_ context.Context) APIMetrics {
+	metrics := s.repo.APIMetrics()
+	metrics.Objectives = evaluateObjectives(metrics, s.config.Objectives)
+	return metrics
+}
@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsAPI(t *testing.T) {
+	ctx := context.Background()
+	api := newMocksqsAPI(t)
+	metrics := newAPIMetrics()
+
+	// Each call advances the clock by the next latency in the list; the last one is the snapshot.
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	latencies := []time.Duration{0, 40 * time.Millisecond, 0, 3 * time.Second, 0, 20 * time.Millisecond, time.Hour}
+	metrics.now = func() time.Time {
+		now = now.Add(latencies[0])
+		latencies = latencies[1:]
+		return now
+	}
+	instrumented := newMetricsAPI(api, metrics)
+
+	api.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(&sqs.SendMessageOutput{}, nil).Once()
+	api.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil, errors.New("throttled")).Once()
+	api.EXPECT().ListQueues(mock.Anything, mock.Anything).Return(&sqs.ListQueuesOutput{}, nil).Once()
+
+	_, err := instrumented.SendMessage(ctx, &sqs.SendMessageInput{QueueUrl: aws.String("https://sqs.local/orders")})
+	require.NoError(t, err)
+	_, err = instrumented.SendMessage(ctx, &sqs.SendMessageInput{QueueUrl: aws.String("https://sqs.local/orders")})
+	require.EqualError(t, err, "throttled")
+	_, err = instrumented.ListQueues(ctx, &sqs.ListQueuesInput{})
+	require.NoError(t, err)
+
+	snapshot := metrics.snapshot()
+	require.Len(t, snapshot.Operations, 2)
+
+	list := snapshot.Operations[0]
+	assert.Equal(t, "ListQueues", list.Operation)
+	assert.Equal(t, int64(1), list.Requests)
+	assert.Equal(t, int64(0), list.Errors)
+	assert.Equal(t, 20*time.Millisecond, list.MaxLatency)
+
+	send := snapshot.Operations[1]
+	assert.Equal(t, "SendMessage", send.Operation)
+	assert.Equal(t, int64(2), send.Requests)
+	assert.Equal(t, int64(1), send.Errors)
+	assert.InDelta(t, 0.5, send.ErrorRate(), 0.0001)
+	assert.Equal(t, 1520*time.Millisecond, send.AverageLatency())
+	assert.Equal(t, 3*time.Second, send.MaxLatency)
+	// 40ms falls into the 50ms bucket and above; 3s only into the 5s bucket and above.
+	assert.Equal(t, []int64{0, 0, 1, 1, 1, 1, 1, 1, 2, 2, 2}, send.Buckets)
+
+	assert.Equal(t, []QueueRequestMetrics{
+		{QueueURL: "https://sqs.local/orders", Requests: 2},
+		{QueueURL: "", Requests: 1},
+	}, snapshot.Queues)
+}
+
+func TestAPIMetrics_EstimatedMonthlyCost(t *testing.T) {
+	since := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	metrics := APIMetrics{Since: since, TakenAt: since.Add(73 * time.Hour)}
+
+	// 73 hours are a tenth of a month, so 100,000 requests project to a million.
+	monthly, ok := metrics.MonthlyRequests(100_000)
+	require.True(t, ok)
+	assert.InDelta(t, 1_000_000, monthly, 0.001)
+
+	cost, ok := metrics.EstimatedMonthlyCost(QueueRequestMetrics{QueueURL: "https://sqs.local/orders", Requests: 100_000})
+	require.True(t, ok)
+	assert.InDelta(t, 0.40, cost, 0.0001)
+
+	cost, ok = metrics.EstimatedMonthlyCost(QueueRequestMetrics{QueueURL: "https://sqs.local/orders.fifo", Requests: 100_000})
+	require.True(t, ok)
+	assert.InDelta(t, 0.50, cost, 0.0001)
+
+	metrics.TakenAt = since.Add(30 * time.Second)
+	_, ok = metrics.EstimatedMonthlyCost(QueueRequestMetrics{QueueURL: "https://sqs.local/orders", Requests: 10})
+	assert.False(t, ok)
+}
+
+func TestApiMetrics_SnapshotWithoutMetrics(t *testing.T) {
+	var metrics *apiMetrics
+
+	snapshot := metrics.snapshot()
+	assert.Empty(t, snapshot.Operations)
+	assert.Equal(t, latencyBuckets, snapshot.LatencyBuckets)
+}
+
+func TestApiMetrics_RecentMinutes(t *testing.T) {
+	metrics := newAPIMetrics()
+	start := time.Date(2024, time.May, 1, 12, 0, 30, 0, time.UTC)
+
+	metrics.record("ReceiveMessage", "", start.Add(-2*time.Hour), time.Second, nil)
+	metrics.record("ReceiveMessage", "", start, 40*time.Millisecond, nil)
+	metrics.record("ReceiveMessage", "", start.Add(10*time.Second), 3*time.Second, nil)
+	metrics.record("ReceiveMessage", "", start.Add(time.Minute), 20*time.Millisecond, errors.New("throttled"))
+	metrics.now = func() time.Time { return start.Add(59 * time.Minute) }
+
+	snapshot := metrics.snapshot()
+	require.Len(t, snapshot.Operations, 1)
+	receive := snapshot.Operations[0]
+	// The call two hours ago shares its slot with 12:00 and is overwritten; the error is not good.
+	assert.Equal(t, []int64{0, 0, 1, 1, 1, 1, 2, 2, 3, 3, 3}, receive.Good)
+	assert.Equal(t, []MinuteMetrics{
+		{Start: start.Truncate(time.Minute), Requests: 2, Good: []int64{0, 0, 1, 1, 1, 1, 1, 1, 2, 2, 2}},
+		{Start: start.Truncate(time.Minute).Add(time.Minute), Requests: 1, Errors: 1, Good: make([]int64, len(latencyBuckets))},
+	}, receive.Recent)
+
+	// Minutes older than an hour are left out.
+	metrics.now = func() time.Time { return start.Add(time.Hour) }
+	assert.Len(t, metrics.snapshot().Operations[0].Recent, 1)
+}
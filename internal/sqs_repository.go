@@ -1,8 +1,10 @@
 package internal
 
 import (
+	"cmp"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"log/slog"
 	"sort"
 	"strconv"
@@ -12,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/smithy-go"
 	"github.com/cockroachdb/errors"
 )
 
@@ -20,34 +23,73 @@ type sqsAPI interface {
 	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
 	CreateQueue(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error)
 	ListQueueTags(ctx context.Context, params *sqs.ListQueueTagsInput, optFns ...func(*sqs.Options)) (*sqs.ListQueueTagsOutput, error)
+	TagQueue(ctx context.Context, params *sqs.TagQueueInput, optFns ...func(*sqs.Options)) (*sqs.TagQueueOutput, error)
+	UntagQueue(ctx context.Context, params *sqs.UntagQueueInput, optFns ...func(*sqs.Options)) (*sqs.UntagQueueOutput, error)
 	DeleteQueue(ctx context.Context, params *sqs.DeleteQueueInput, optFns ...func(*sqs.Options)) (*sqs.DeleteQueueOutput, error)
 	PurgeQueue(ctx context.Context, params *sqs.PurgeQueueInput, optFns ...func(*sqs.Options)) (*sqs.PurgeQueueOutput, error)
 	SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)
+	SendMessageBatch(ctx context.Context, params *sqs.SendMessageBatchInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error)
 	ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
 	DeleteMessage(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)
+	ChangeMessageVisibility(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error)
+	GetQueueUrl(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error)
+	SetQueueAttributes(ctx context.Context, params *sqs.SetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error)
+	ListMessageMoveTasks(ctx context.Context, params *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error)
+	CancelMessageMoveTask(ctx context.Context, params *sqs.CancelMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error)
+	ListDeadLetterSourceQueues(ctx context.Context, params *sqs.ListDeadLetterSourceQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListDeadLetterSourceQueuesOutput, error)
 }
 
 // SqsRepository centralises access to SQS APIs.
 type SqsRepository interface {
 	ListQueues(ctx context.Context) ([]QueueSummary, error)
+	ListQueuesPage(ctx context.Context, input ListQueuesPageRepositoryInput) (QueueSummaryPage, error)
 	CreateQueue(ctx context.Context, input CreateQueueRepositoryInput) (string, error)
 	GetQueueDetail(ctx context.Context, queueURL string) (QueueDetail, error)
+	ListQueueTags(ctx context.Context, queueURL string) (map[string]string, error)
+	TagQueue(ctx context.Context, queueURL string, tags map[string]string) error
+	UntagQueue(ctx context.Context, queueURL string, keys []string) error
+	GetQueueStats(ctx context.Context, queueURL string) (QueueStats, error)
+	GetQueueAttributes(ctx context.Context, queueURL string, names []string) (map[string]string, error)
 	DeleteQueue(ctx context.Context, queueURL string) error
 	PurgeQueue(ctx context.Context, queueURL string) error
+	SetQueueAttributes(ctx context.Context, queueURL string, attributes map[string]string) error
 	SendMessage(ctx context.Context, input SendMessageRepositoryInput) error
+	SendMessageBatch(ctx context.Context, input SendMessageBatchRepositoryInput) ([]SendMessageBatchFailure, error)
 	ReceiveMessages(ctx context.Context, input ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error)
 	DeleteMessage(ctx context.Context, input DeleteMessageRepositoryInput) error
+	ChangeMessageVisibility(ctx context.Context, input ChangeMessageVisibilityRepositoryInput) error
+	QueueURL(ctx context.Context, name string) (string, bool, error)
+	ListMessageMoveTasks(ctx context.Context, sourceArn string) ([]MessageMoveTask, error)
+	CancelMessageMoveTask(ctx context.Context, taskHandle string) (int64, error)
+	ListDeadLetterSourceQueues(ctx context.Context, queueURL string) ([]string, error)
+	APIMetrics() APIMetrics
 }
 
 // SqsRepositoryImpl uses the AWS SDK to talk to SQS.
 type SqsRepositoryImpl struct {
 	sqsClient sqsAPI
+	metrics   *apiMetrics
+}
+
+// ListQueuesPageRepositoryInput asks for one page of ListQueues.
+type ListQueuesPageRepositoryInput struct {
+	// MaxResults caps the page; SQS accepts 1 to 1000 and 0 leaves the default.
+	MaxResults int32
+	// NextToken is the token of the previous page; "" starts from the first queue.
+	NextToken string
+}
+
+// QueueSummaryPage is one page of ListQueues. NextToken is "" on the last page.
+type QueueSummaryPage struct {
+	Queues    []QueueSummary
+	NextToken string
 }
 
 // CreateQueueRepositoryInput holds attributes for CreateQueue.
 type CreateQueueRepositoryInput struct {
 	Name       string
 	Attributes map[string]string
+	Tags       map[string]string
 }
 
 type SendMessageRepositoryInput struct {
@@ -57,6 +99,35 @@ type SendMessageRepositoryInput struct {
 	MessageDeduplicationID string
 	DelaySeconds           *int32
 	Attributes             map[string]string
+	// AttributeTypes holds the data type of the attributes that are not plain strings, by name.
+	AttributeTypes map[string]string
+}
+
+// SendMessageBatchRepositoryInput carries up to ten messages for one SendMessageBatch call.
+type SendMessageBatchRepositoryInput struct {
+	QueueURL string
+	Entries  []SendMessageBatchEntry
+}
+
+// SendMessageBatchEntry is one message of a batch. ID must be unique within the batch.
+type SendMessageBatchEntry struct {
+	ID                     string
+	Body                   string
+	MessageGroupID         string
+	MessageDeduplicationID string
+	DelaySeconds           *int32
+	Attributes             map[string]string
+	// AttributeTypes holds the data type of the attributes that are not plain strings, by name.
+	AttributeTypes map[string]string
+}
+
+// SendMessageBatchFailure describes an entry SQS did not accept. SenderFault is set when the entry
+// itself is invalid, so sending it again cannot succeed.
+type SendMessageBatchFailure struct {
+	ID          string
+	Code        string
+	Message     string
+	SenderFault bool
 }
 
 // ReceiveMessagesRepositoryInput governs how ReceiveMessage API is called.
@@ -64,29 +135,55 @@ type ReceiveMessagesRepositoryInput struct {
 	QueueURL        string
 	MaxMessages     int32
 	WaitTimeSeconds int32
+	// VisibilityTimeout overrides the queue's visibility timeout in seconds when it is not zero.
+	VisibilityTimeout int32
 }
 
 // DeleteMessageRepositoryInput carries the data required to issue a DeleteMessage call.
 type DeleteMessageRepositoryInput struct {
 	QueueURL      string
 	ReceiptHandle string
+	// Bulk marks a deletion that is part of emptying the queue message by message, such as a
+	// drain or a filtered purge. The queue policy refuses these on protected queues, as it does
+	// a purge.
+	Bulk bool
+}
+
+// ChangeMessageVisibilityRepositoryInput carries the data required to issue a ChangeMessageVisibility call.
+type ChangeMessageVisibilityRepositoryInput struct {
+	QueueURL      string
+	ReceiptHandle string
+	// VisibilityTimeout is in seconds; 0 makes the message visible again right away.
+	VisibilityTimeout int32
 }
 
 // NewSqsRepository constructs a repository instance.
 func NewSqsRepository(c sqsAPI) SqsRepository {
-	return &SqsRepositoryImpl{sqsClient: c}
+	metrics := newAPIMetrics()
+	return &SqsRepositoryImpl{sqsClient: newMetricsAPI(c, metrics), metrics: metrics}
+}
+
+// APIMetrics returns the latency and error counts of the SQS calls made so far.
+func (s *SqsRepositoryImpl) APIMetrics() APIMetrics {
+	return s.metrics.snapshot()
+}
+
+// queueSummaryAttributeNames are the attributes read for every queue of a listing; fifo queues
+// also read FifoQueue and ContentBasedDeduplication.
+var queueSummaryAttributeNames = []types.QueueAttributeName{
+	types.QueueAttributeNameCreatedTimestamp,
+	types.QueueAttributeNameApproximateNumberOfMessages,
+	types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+	types.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+	types.QueueAttributeNameKmsMasterKeyId,
+	types.QueueAttributeNameQueueArn,
+	types.QueueAttributeNameRedrivePolicy,
+	types.QueueAttributeNameVisibilityTimeout,
 }
 
 // ListQueues fetches available queues.
 func (s *SqsRepositoryImpl) ListQueues(ctx context.Context) ([]QueueSummary, error) {
 	input := &sqs.ListQueuesInput{}
-	baseAttributeNames := []types.QueueAttributeName{
-		types.QueueAttributeNameCreatedTimestamp,
-		types.QueueAttributeNameApproximateNumberOfMessages,
-		types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
-		types.QueueAttributeNameKmsMasterKeyId,
-	}
-
 	queues := make([]QueueSummary, 0)
 
 	for {
@@ -95,34 +192,7 @@ func (s *SqsRepositoryImpl) ListQueues(ctx context.Context) ([]QueueSummary, err
 			return nil, errors.Wrap(err, "failed to call ListQueues API")
 		}
 
-		for _, url := range resp.QueueUrls {
-			isFIFO := strings.HasSuffix(url, ".fifo")
-			attributeNames := make([]types.QueueAttributeName, len(baseAttributeNames), len(baseAttributeNames)+2)
-			copy(attributeNames, baseAttributeNames)
-			if isFIFO {
-				attributeNames = append(attributeNames, types.QueueAttributeNameFifoQueue, types.QueueAttributeNameContentBasedDeduplication)
-			}
-
-			attrs, err := s.sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
-				QueueUrl:       aws.String(url),
-				AttributeNames: attributeNames,
-			})
-			if err != nil {
-				slog.Warn("failed to retrieve queue attributes", slog.String("queue_url", url), slog.Any("error", err))
-				continue
-			}
-
-			attrMap := make(map[string]string, len(attrs.Attributes)+2)
-			for key, value := range attrs.Attributes {
-				attrMap[key] = value
-			}
-
-			if isFIFO {
-				attrMap[string(types.QueueAttributeNameFifoQueue)] = "true"
-			}
-
-			queues = append(queues, buildQueueSummary(url, attrMap))
-		}
+		queues = append(queues, s.queueSummaries(ctx, resp.QueueUrls)...)
 
 		if resp.NextToken == nil {
 			break
@@ -137,13 +207,69 @@ func (s *SqsRepositoryImpl) ListQueues(ctx context.Context) ([]QueueSummary, err
 	return queues, nil
 }
 
+// ListQueuesPage fetches one page of ListQueues. The queues keep the order SQS returned them in.
+func (s *SqsRepositoryImpl) ListQueuesPage(ctx context.Context, input ListQueuesPageRepositoryInput) (QueueSummaryPage, error) {
+	request := &sqs.ListQueuesInput{}
+	if input.MaxResults > 0 {
+		request.MaxResults = aws.Int32(input.MaxResults)
+	}
+	if input.NextToken != "" {
+		request.NextToken = aws.String(input.NextToken)
+	}
+
+	resp, err := s.sqsClient.ListQueues(ctx, request)
+	if err != nil {
+		return QueueSummaryPage{}, errors.Wrap(err, "failed to call ListQueues API")
+	}
+	return QueueSummaryPage{Queues: s.queueSummaries(ctx, resp.QueueUrls), NextToken: aws.ToString(resp.NextToken)}, nil
+}
+
+// queueSummaries reads the attributes of each queue. Queues whose attributes cannot be read are
+// left out.
+func (s *SqsRepositoryImpl) queueSummaries(ctx context.Context, urls []string) []QueueSummary {
+	queues := make([]QueueSummary, 0, len(urls))
+	for _, url := range urls {
+		isFIFO := strings.HasSuffix(url, ".fifo")
+		attributeNames := make([]types.QueueAttributeName, len(queueSummaryAttributeNames), len(queueSummaryAttributeNames)+2)
+		copy(attributeNames, queueSummaryAttributeNames)
+		if isFIFO {
+			attributeNames = append(attributeNames, types.QueueAttributeNameFifoQueue, types.QueueAttributeNameContentBasedDeduplication)
+		}
+
+		attrs, err := s.sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(url),
+			AttributeNames: attributeNames,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "failed to retrieve queue attributes", slog.String("queue_url", url), slog.Any("error", err))
+			continue
+		}
+
+		attrMap := make(map[string]string, len(attrs.Attributes)+2)
+		for key, value := range attrs.Attributes {
+			attrMap[key] = value
+		}
+
+		if isFIFO {
+			attrMap[string(types.QueueAttributeNameFifoQueue)] = "true"
+		}
+
+		queues = append(queues, buildQueueSummary(url, attrMap))
+	}
+	return queues
+}
+
 // CreateQueue creates a new queue.
 func (s *SqsRepositoryImpl) CreateQueue(ctx context.Context, input CreateQueueRepositoryInput) (string, error) {
 	resp, err := s.sqsClient.CreateQueue(ctx, &sqs.CreateQueueInput{
 		QueueName:  aws.String(input.Name),
 		Attributes: input.Attributes,
+		Tags:       input.Tags,
 	})
 	if err != nil {
+		if isQueueDeletedRecently(err) {
+			return "", errors.WithStack(ErrQueueDeletedRecently)
+		}
 		return "", errors.Wrap(err, "failed to call CreateQueue API")
 	}
 	if resp.QueueUrl == nil {
@@ -170,31 +296,155 @@ func (s *SqsRepositoryImpl) GetQueueDetail(ctx context.Context, queueURL string)
 
 	summary := buildQueueSummary(queueURL, attributes)
 	lastModified := parseUnixTime(attributes[string(types.QueueAttributeNameLastModifiedTimestamp)])
-	arn := attributes[string(types.QueueAttributeNameQueueArn)]
 
 	detail := QueueDetail{
 		QueueSummary:   summary,
-		Arn:            arn,
 		LastModifiedAt: lastModified,
 		Attributes:     attributes,
 	}
 
-	tagResp, err := s.sqsClient.ListQueueTags(ctx, &sqs.ListQueueTagsInput{QueueUrl: aws.String(queueURL)})
+	tags, err := s.ListQueueTags(ctx, queueURL)
 	if err != nil {
-		slog.Warn("failed to retrieve queue tags", slog.String("queue_url", queueURL), slog.Any("error", err))
-	} else {
-		if len(tagResp.Tags) > 0 {
-			tags := make(map[string]string, len(tagResp.Tags))
-			for key, value := range tagResp.Tags {
-				tags[key] = value
-			}
-			detail.Tags = tags
-		}
+		slog.WarnContext(ctx, "failed to retrieve queue tags", slog.String("queue_url", queueURL), slog.Any("error", err))
+	} else if len(tags) > 0 {
+		detail.Tags = tags
 	}
 
 	return detail, nil
 }
 
+// ListQueueTags reads the tags of a queue. Unlike GetQueueDetail it reports a failure, so callers
+// can tell an endpoint without tag support from a queue without tags.
+func (s *SqsRepositoryImpl) ListQueueTags(ctx context.Context, queueURL string) (map[string]string, error) {
+	resp, err := s.sqsClient.ListQueueTags(ctx, &sqs.ListQueueTagsInput{QueueUrl: aws.String(queueURL)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call ListQueueTags API")
+	}
+
+	tags := make(map[string]string, len(resp.Tags))
+	for key, value := range resp.Tags {
+		tags[key] = value
+	}
+	return tags, nil
+}
+
+// TagQueue adds tags to a queue, replacing the values of keys it already has.
+func (s *SqsRepositoryImpl) TagQueue(ctx context.Context, queueURL string, tags map[string]string) error {
+	_, err := s.sqsClient.TagQueue(ctx, &sqs.TagQueueInput{QueueUrl: aws.String(queueURL), Tags: tags})
+	if err != nil {
+		return errors.Wrap(err, "failed to call TagQueue API")
+	}
+	return nil
+}
+
+// UntagQueue removes the tags with the given keys from a queue.
+func (s *SqsRepositoryImpl) UntagQueue(ctx context.Context, queueURL string, keys []string) error {
+	_, err := s.sqsClient.UntagQueue(ctx, &sqs.UntagQueueInput{QueueUrl: aws.String(queueURL), TagKeys: keys})
+	if err != nil {
+		return errors.Wrap(err, "failed to call UntagQueue API")
+	}
+	return nil
+}
+
+// GetQueueStats reads only the approximate message counts of a queue.
+func (s *SqsRepositoryImpl) GetQueueStats(ctx context.Context, queueURL string) (QueueStats, error) {
+	resp, err := s.sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: aws.String(queueURL),
+		AttributeNames: []types.QueueAttributeName{
+			types.QueueAttributeNameApproximateNumberOfMessages,
+			types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+			types.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+		},
+	})
+	if err != nil {
+		return QueueStats{}, errors.Wrap(err, "failed to call GetQueueAttributes API")
+	}
+
+	return QueueStats{
+		MessagesAvailable: parseInt64(resp.Attributes[string(types.QueueAttributeNameApproximateNumberOfMessages)]),
+		MessagesInFlight:  parseInt64(resp.Attributes[string(types.QueueAttributeNameApproximateNumberOfMessagesNotVisible)]),
+		MessagesDelayed:   parseInt64(resp.Attributes[string(types.QueueAttributeNameApproximateNumberOfMessagesDelayed)]),
+	}, nil
+}
+
+// GetQueueAttributes reads the named attributes of a queue, without the tags GetQueueDetail also
+// fetches.
+func (s *SqsRepositoryImpl) GetQueueAttributes(ctx context.Context, queueURL string, names []string) (map[string]string, error) {
+	attributeNames := make([]types.QueueAttributeName, 0, len(names))
+	for _, name := range names {
+		attributeNames = append(attributeNames, types.QueueAttributeName(name))
+	}
+	resp, err := s.sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(queueURL),
+		AttributeNames: attributeNames,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call GetQueueAttributes API")
+	}
+	return resp.Attributes, nil
+}
+
+// ListMessageMoveTasks lists the most recent message move tasks, up to ten, of the dead-letter
+// queue with the given ARN, newest first.
+func (s *SqsRepositoryImpl) ListMessageMoveTasks(ctx context.Context, sourceArn string) ([]MessageMoveTask, error) {
+	resp, err := s.sqsClient.ListMessageMoveTasks(ctx, &sqs.ListMessageMoveTasksInput{
+		SourceArn:  aws.String(sourceArn),
+		MaxResults: aws.Int32(10),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call ListMessageMoveTasks API")
+	}
+
+	tasks := make([]MessageMoveTask, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		task := MessageMoveTask{
+			TaskHandle:           aws.ToString(result.TaskHandle),
+			Status:               aws.ToString(result.Status),
+			SourceArn:            aws.ToString(result.SourceArn),
+			DestinationArn:       aws.ToString(result.DestinationArn),
+			MaxMessagesPerSecond: aws.ToInt32(result.MaxNumberOfMessagesPerSecond),
+			MessagesMoved:        result.ApproximateNumberOfMessagesMoved,
+			MessagesToMove:       aws.ToInt64(result.ApproximateNumberOfMessagesToMove),
+			FailureReason:        aws.ToString(result.FailureReason),
+		}
+		if result.StartedTimestamp > 0 {
+			task.StartedAt = time.UnixMilli(result.StartedTimestamp)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// CancelMessageMoveTask stops a running message move task and returns roughly how many messages
+// it had moved. Messages already moved stay in the destination.
+func (s *SqsRepositoryImpl) CancelMessageMoveTask(ctx context.Context, taskHandle string) (int64, error) {
+	resp, err := s.sqsClient.CancelMessageMoveTask(ctx, &sqs.CancelMessageMoveTaskInput{TaskHandle: aws.String(taskHandle)})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to call CancelMessageMoveTask API")
+	}
+	return resp.ApproximateNumberOfMessagesMoved, nil
+}
+
+// ListDeadLetterSourceQueues returns the URLs of the queues whose redrive policy names the given
+// queue as their dead-letter queue.
+func (s *SqsRepositoryImpl) ListDeadLetterSourceQueues(ctx context.Context, queueURL string) ([]string, error) {
+	input := &sqs.ListDeadLetterSourceQueuesInput{QueueUrl: aws.String(queueURL), MaxResults: aws.Int32(1000)}
+	sources := make([]string, 0)
+	for {
+		resp, err := s.sqsClient.ListDeadLetterSourceQueues(ctx, input)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call ListDeadLetterSourceQueues API")
+		}
+		sources = append(sources, resp.QueueUrls...)
+
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return sources, nil
+}
+
 // DeleteQueue deletes the specified queue.
 func (s *SqsRepositoryImpl) DeleteQueue(ctx context.Context, queueURL string) error {
 	_, err := s.sqsClient.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)})
@@ -209,12 +459,73 @@ func (s *SqsRepositoryImpl) DeleteQueue(ctx context.Context, queueURL string) er
 func (s *SqsRepositoryImpl) PurgeQueue(ctx context.Context, queueURL string) error {
 	_, err := s.sqsClient.PurgeQueue(ctx, &sqs.PurgeQueueInput{QueueUrl: aws.String(queueURL)})
 	if err != nil {
+		if isPurgeInProgress(err) {
+			return errors.WithStack(ErrPurgeInProgress)
+		}
 		return errors.Wrap(err, "failed to call PurgeQueue API")
 	}
 
 	return nil
 }
 
+// SetQueueAttributes changes the given attributes of a queue.
+func (s *SqsRepositoryImpl) SetQueueAttributes(ctx context.Context, queueURL string, attributes map[string]string) error {
+	_, err := s.sqsClient.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl:   aws.String(queueURL),
+		Attributes: attributes,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to call SetQueueAttributes API")
+	}
+
+	return nil
+}
+
+// QueueURL looks up a queue by name with GetQueueUrl. The boolean is false when no such queue exists.
+func (s *SqsRepositoryImpl) QueueURL(ctx context.Context, name string) (string, bool, error) {
+	resp, err := s.sqsClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(name)})
+	if err != nil {
+		if isQueueDoesNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, errors.Wrap(err, "failed to call GetQueueUrl API")
+	}
+
+	return aws.ToString(resp.QueueUrl), true, nil
+}
+
+// isQueueDoesNotExist recognises the modelled error as well as the legacy query-protocol code
+// that some SQS emulators still return.
+func isQueueDoesNotExist(err error) bool {
+	var notFound *types.QueueDoesNotExist
+	if errors.As(err, &notFound) {
+		return true
+	}
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "AWS.SimpleQueueService.NonExistentQueue"
+}
+
+// isPurgeInProgress reports the error SQS returns for a second purge within 60 seconds.
+func isPurgeInProgress(err error) bool {
+	var inProgress *types.PurgeQueueInProgress
+	if errors.As(err, &inProgress) {
+		return true
+	}
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "AWS.SimpleQueueService.PurgeQueueInProgress"
+}
+
+// isQueueDeletedRecently reports the error SQS returns when a name is reused within 60 seconds of
+// deleting the queue that had it.
+func isQueueDeletedRecently(err error) bool {
+	var deletedRecently *types.QueueDeletedRecently
+	if errors.As(err, &deletedRecently) {
+		return true
+	}
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "AWS.SimpleQueueService.QueueDeletedRecently"
+}
+
 // buildQueueSummary normalises queue attributes for presentation.
 func buildQueueSummary(queueURL string, attributes map[string]string) QueueSummary {
 	name := queueURL
@@ -231,6 +542,7 @@ func buildQueueSummary(queueURL string, attributes map[string]string) QueueSumma
 
 	messagesAvailable := parseInt64(attributes[string(types.QueueAttributeNameApproximateNumberOfMessages)])
 	messagesInFlight := parseInt64(attributes[string(types.QueueAttributeNameApproximateNumberOfMessagesNotVisible)])
+	messagesDelayed := parseInt64(attributes[string(types.QueueAttributeNameApproximateNumberOfMessagesDelayed)])
 	contentDedup := attributes[string(types.QueueAttributeNameContentBasedDeduplication)] == "true"
 	kmsKey := attributes[string(types.QueueAttributeNameKmsMasterKeyId)]
 	fifoFlag := attributes[string(types.QueueAttributeNameFifoQueue)] == "true"
@@ -252,11 +564,39 @@ func buildQueueSummary(queueURL string, attributes map[string]string) QueueSumma
 		CreatedAt:                 createdAt,
 		MessagesAvailable:         messagesAvailable,
 		MessagesInFlight:          messagesInFlight,
+		MessagesDelayed:           messagesDelayed,
 		Encryption:                encryption,
 		ContentBasedDeduplication: contentDedup,
+		Arn:                       attributes[string(types.QueueAttributeNameQueueArn)],
+		RedrivePolicy:             parseRedrivePolicy(attributes[string(types.QueueAttributeNameRedrivePolicy)]),
+		VisibilityTimeout:         parseInt64(attributes[string(types.QueueAttributeNameVisibilityTimeout)]),
 	}
 }
 
+// parseRedrivePolicy decodes the RedrivePolicy attribute. Some SQS-compatible services
+// encode maxReceiveCount as a string, so both forms are accepted.
+func parseRedrivePolicy(raw string) *RedrivePolicy {
+	if raw == "" {
+		return nil
+	}
+
+	var policy struct {
+		DeadLetterTargetArn string          `json:"deadLetterTargetArn"`
+		MaxReceiveCount     json.RawMessage `json:"maxReceiveCount"`
+	}
+	if err := json.Unmarshal([]byte(raw), &policy); err != nil || policy.DeadLetterTargetArn == "" {
+		slog.Debug("failed to parse redrive policy", slog.String("value", raw), slog.Any("error", err))
+		return nil
+	}
+
+	maxReceiveCount, err := strconv.Atoi(strings.Trim(string(policy.MaxReceiveCount), `"`))
+	if err != nil {
+		maxReceiveCount = 0
+	}
+
+	return &RedrivePolicy{DeadLetterTargetArn: policy.DeadLetterTargetArn, MaxReceiveCount: maxReceiveCount}
+}
+
 // parseInt64 converts optional numeric attributes safely.
 func parseInt64(raw string) int64 {
 	if raw == "" {
@@ -308,18 +648,12 @@ func (s *SqsRepositoryImpl) SendMessage(ctx context.Context, input SendMessageRe
 		req.MessageDeduplicationId = aws.String(messageDeduplicationID)
 	}
 
-	if len(input.Attributes) > 0 {
-		req.MessageAttributes = make(map[string]types.MessageAttributeValue, len(input.Attributes))
-		for key, value := range input.Attributes {
-			if strings.TrimSpace(key) == "" {
-				continue
-			}
-			req.MessageAttributes[key] = types.MessageAttributeValue{
-				DataType:    aws.String("String"),
-				StringValue: aws.String(value),
-			}
-		}
+	attributes, err := messageAttributeValues(input.Attributes, input.AttributeTypes)
+	if err != nil {
+		return err
 	}
+	req.MessageAttributes = attributes
+	req.MessageSystemAttributes = traceSystemAttributes(ctx)
 
 	if _, err := s.sqsClient.SendMessage(ctx, req); err != nil {
 		return errors.Wrap(err, "failed to call SendMessage API")
@@ -328,20 +662,98 @@ func (s *SqsRepositoryImpl) SendMessage(ctx context.Context, input SendMessageRe
 	return nil
 }
 
+// SendMessageBatch enqueues up to ten messages in one call and returns the entries SQS rejected.
+// An error is only returned when the call as a whole failed.
+func (s *SqsRepositoryImpl) SendMessageBatch(ctx context.Context, input SendMessageBatchRepositoryInput) ([]SendMessageBatchFailure, error) {
+	req := &sqs.SendMessageBatchInput{
+		QueueUrl: aws.String(input.QueueURL),
+		Entries:  make([]types.SendMessageBatchRequestEntry, 0, len(input.Entries)),
+	}
+	for _, entry := range input.Entries {
+		attributes, err := messageAttributeValues(entry.Attributes, entry.AttributeTypes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "entry %s", entry.ID)
+		}
+		requestEntry := types.SendMessageBatchRequestEntry{
+			Id:                      aws.String(entry.ID),
+			MessageBody:             aws.String(entry.Body),
+			MessageAttributes:       attributes,
+			MessageSystemAttributes: traceSystemAttributes(ctx),
+		}
+		if entry.DelaySeconds != nil {
+			requestEntry.DelaySeconds = *entry.DelaySeconds
+		}
+		if messageGroupID := strings.TrimSpace(entry.MessageGroupID); messageGroupID != "" {
+			requestEntry.MessageGroupId = aws.String(messageGroupID)
+		}
+		if messageDeduplicationID := strings.TrimSpace(entry.MessageDeduplicationID); messageDeduplicationID != "" {
+			requestEntry.MessageDeduplicationId = aws.String(messageDeduplicationID)
+		}
+		req.Entries = append(req.Entries, requestEntry)
+	}
+
+	out, err := s.sqsClient.SendMessageBatch(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call SendMessageBatch API")
+	}
+
+	failures := make([]SendMessageBatchFailure, 0, len(out.Failed))
+	for _, failed := range out.Failed {
+		failures = append(failures, SendMessageBatchFailure{
+			ID:          aws.ToString(failed.Id),
+			Code:        aws.ToString(failed.Code),
+			Message:     aws.ToString(failed.Message),
+			SenderFault: failed.SenderFault,
+		})
+	}
+	return failures, nil
+}
+
+// messageAttributeValues converts attribute values to message attributes of the given data types,
+// String when none is given, skipping blank names. Binary values are decoded from base64.
+func messageAttributeValues(attributes map[string]string, dataTypes map[string]string) (map[string]types.MessageAttributeValue, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	values := make(map[string]types.MessageAttributeValue, len(attributes))
+	for key, value := range attributes {
+		if strings.TrimSpace(key) == "" {
+			continue
+		}
+		dataType := cmp.Or(dataTypes[key], "String")
+		if messageAttributeBaseType(dataType) == "Binary" {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "attribute %s is not valid base64", key)
+			}
+			values[key] = types.MessageAttributeValue{DataType: aws.String(dataType), BinaryValue: decoded}
+			continue
+		}
+		values[key] = types.MessageAttributeValue{
+			DataType:    aws.String(dataType),
+			StringValue: aws.String(value),
+		}
+	}
+	return values, nil
+}
+
 // ReceiveMessages fetches messages from the specified queue using ReceiveMessage.
 func (s *SqsRepositoryImpl) ReceiveMessages(ctx context.Context, input ReceiveMessagesRepositoryInput) ([]ReceivedMessage, error) {
 	req := &sqs.ReceiveMessageInput{
 		QueueUrl:              aws.String(input.QueueURL),
 		MaxNumberOfMessages:   input.MaxMessages,
 		WaitTimeSeconds:       input.WaitTimeSeconds,
-		VisibilityTimeout:     0,
+		VisibilityTimeout:     input.VisibilityTimeout,
 		MessageAttributeNames: []string{"All"},
 		MessageSystemAttributeNames: []types.MessageSystemAttributeName{
 			types.MessageSystemAttributeNameApproximateReceiveCount,
+			types.MessageSystemAttributeNameApproximateFirstReceiveTimestamp,
 			types.MessageSystemAttributeNameSentTimestamp,
 			types.MessageSystemAttributeNameMessageGroupId,
 			types.MessageSystemAttributeNameMessageDeduplicationId,
 			types.MessageSystemAttributeNameSequenceNumber,
+			types.MessageSystemAttributeNameDeadLetterQueueSourceArn,
 		},
 	}
 
@@ -368,16 +780,20 @@ func (s *SqsRepositoryImpl) ReceiveMessages(ctx context.Context, input ReceiveMe
 		attributes := make([]MessageAttribute, 0, len(msg.MessageAttributes)+len(msg.Attributes))
 		for _, key := range customKeys {
 			value := msg.MessageAttributes[key]
+			dataType := aws.ToString(value.DataType)
+			if dataType == "String" {
+				dataType = ""
+			}
 			if value.StringValue != nil {
-				attributes = append(attributes, MessageAttribute{Name: key, Value: aws.ToString(value.StringValue)})
+				attributes = append(attributes, MessageAttribute{Name: key, Value: aws.ToString(value.StringValue), DataType: dataType})
 				continue
 			}
 			if len(value.StringListValues) > 0 {
-				attributes = append(attributes, MessageAttribute{Name: key, Value: strings.Join(value.StringListValues, ", ")})
+				attributes = append(attributes, MessageAttribute{Name: key, Value: strings.Join(value.StringListValues, ", "), DataType: dataType})
 				continue
 			}
 			if len(value.BinaryValue) > 0 {
-				attributes = append(attributes, MessageAttribute{Name: key, Value: base64.StdEncoding.EncodeToString(value.BinaryValue)})
+				attributes = append(attributes, MessageAttribute{Name: key, Value: base64.StdEncoding.EncodeToString(value.BinaryValue), DataType: dataType})
 				continue
 			}
 			if len(value.BinaryListValues) > 0 {
@@ -385,7 +801,7 @@ func (s *SqsRepositoryImpl) ReceiveMessages(ctx context.Context, input ReceiveMe
 				for i, b := range value.BinaryListValues {
 					encoded[i] = base64.StdEncoding.EncodeToString(b)
 				}
-				attributes = append(attributes, MessageAttribute{Name: key, Value: strings.Join(encoded, ", ")})
+				attributes = append(attributes, MessageAttribute{Name: key, Value: strings.Join(encoded, ", "), DataType: dataType})
 			}
 		}
 
@@ -398,6 +814,11 @@ func (s *SqsRepositoryImpl) ReceiveMessages(ctx context.Context, input ReceiveMe
 			attributes = append(attributes, MessageAttribute{Name: key, Value: formatSystemAttribute(key, msg.Attributes[key])})
 		}
 
+		raw, err := rawReceivedMessage(msg)
+		if err != nil {
+			return nil, err
+		}
+
 		messageID := aws.ToString(msg.MessageId)
 		body := aws.ToString(msg.Body)
 		messages = append(messages, ReceivedMessage{
@@ -406,12 +827,63 @@ func (s *SqsRepositoryImpl) ReceiveMessages(ctx context.Context, input ReceiveMe
 			ReceiptHandle: aws.ToString(msg.ReceiptHandle),
 			ReceiveCount:  receiveCount,
 			Attributes:    attributes,
+			Raw:           raw,
 		})
 	}
 
 	return messages, nil
 }
 
+// rawMessage is a received message in the JSON shape of the ReceiveMessage API.
+type rawMessage struct {
+	MessageID              string                              `json:"MessageId"`
+	ReceiptHandle          string                              `json:"ReceiptHandle"`
+	MD5OfBody              string                              `json:"MD5OfBody,omitempty"`
+	MD5OfMessageAttributes string                              `json:"MD5OfMessageAttributes,omitempty"`
+	Body                   string                              `json:"Body"`
+	Attributes             map[string]string                   `json:"Attributes,omitempty"`
+	MessageAttributes      map[string]rawMessageAttributeValue `json:"MessageAttributes,omitempty"`
+}
+
+// rawMessageAttributeValue is a custom attribute of a rawMessage. Binary values are base64
+// encoded, as encoding/json writes byte slices.
+type rawMessageAttributeValue struct {
+	DataType         string   `json:"DataType"`
+	StringValue      *string  `json:"StringValue,omitempty"`
+	BinaryValue      []byte   `json:"BinaryValue,omitempty"`
+	StringListValues []string `json:"StringListValues,omitempty"`
+	BinaryListValues [][]byte `json:"BinaryListValues,omitempty"`
+}
+
+// rawReceivedMessage encodes msg as indented JSON, so it can be shown as it is.
+func rawReceivedMessage(msg types.Message) (json.RawMessage, error) {
+	raw := rawMessage{
+		MessageID:              aws.ToString(msg.MessageId),
+		ReceiptHandle:          aws.ToString(msg.ReceiptHandle),
+		MD5OfBody:              aws.ToString(msg.MD5OfBody),
+		MD5OfMessageAttributes: aws.ToString(msg.MD5OfMessageAttributes),
+		Body:                   aws.ToString(msg.Body),
+		Attributes:             msg.Attributes,
+	}
+	if len(msg.MessageAttributes) > 0 {
+		raw.MessageAttributes = make(map[string]rawMessageAttributeValue, len(msg.MessageAttributes))
+		for key, value := range msg.MessageAttributes {
+			raw.MessageAttributes[key] = rawMessageAttributeValue{
+				DataType:         aws.ToString(value.DataType),
+				StringValue:      value.StringValue,
+				BinaryValue:      value.BinaryValue,
+				StringListValues: value.StringListValues,
+				BinaryListValues: value.BinaryListValues,
+			}
+		}
+	}
+	encoded, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode the received message")
+	}
+	return encoded, nil
+}
+
 // DeleteMessage removes a message from the queue using its receipt handle.
 func (s *SqsRepositoryImpl) DeleteMessage(ctx context.Context, input DeleteMessageRepositoryInput) error {
 	_, err := s.sqsClient.DeleteMessage(ctx, &sqs.DeleteMessageInput{
@@ -425,6 +897,20 @@ func (s *SqsRepositoryImpl) DeleteMessage(ctx context.Context, input DeleteMessa
 	return nil
 }
 
+// ChangeMessageVisibility changes how long a received message stays hidden from other consumers.
+func (s *SqsRepositoryImpl) ChangeMessageVisibility(ctx context.Context, input ChangeMessageVisibilityRepositoryInput) error {
+	_, err := s.sqsClient.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          aws.String(input.QueueURL),
+		ReceiptHandle:     aws.String(input.ReceiptHandle),
+		VisibilityTimeout: input.VisibilityTimeout,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to call ChangeMessageVisibility API")
+	}
+
+	return nil
+}
+
 func formatSystemAttribute(key, value string) string {
 	switch key {
 	case string(types.MessageSystemAttributeNameSentTimestamp),
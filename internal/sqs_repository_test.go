@@ -54,7 +54,11 @@ func TestSqsRepositoryImpl_ListQueues(t *testing.T) {
 					types.QueueAttributeNameCreatedTimestamp,
 					types.QueueAttributeNameApproximateNumberOfMessages,
 					types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+					types.QueueAttributeNameApproximateNumberOfMessagesDelayed,
 					types.QueueAttributeNameKmsMasterKeyId,
+					types.QueueAttributeNameQueueArn,
+					types.QueueAttributeNameRedrivePolicy,
+					types.QueueAttributeNameVisibilityTimeout,
 				}, input.AttributeNames)
 			}).
 			Return(&sqs.GetQueueAttributesOutput{
@@ -96,7 +100,11 @@ func TestSqsRepositoryImpl_ListQueues(t *testing.T) {
 					types.QueueAttributeNameCreatedTimestamp,
 					types.QueueAttributeNameApproximateNumberOfMessages,
 					types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+					types.QueueAttributeNameApproximateNumberOfMessagesDelayed,
 					types.QueueAttributeNameKmsMasterKeyId,
+					types.QueueAttributeNameQueueArn,
+					types.QueueAttributeNameRedrivePolicy,
+					types.QueueAttributeNameVisibilityTimeout,
 					types.QueueAttributeNameFifoQueue,
 					types.QueueAttributeNameContentBasedDeduplication,
 				}, input.AttributeNames)
@@ -159,6 +167,39 @@ func TestSqsRepositoryImpl_ListQueues(t *testing.T) {
 	})
 }
 
+func TestSqsRepositoryImpl_ListQueuesPage(t *testing.T) {
+	ctx := context.Background()
+	api := newMocksqsAPI(t)
+	repo := &SqsRepositoryImpl{sqsClient: api}
+
+	api.EXPECT().
+		ListQueues(mock.Anything, &sqs.ListQueuesInput{MaxResults: aws.Int32(2), NextToken: aws.String("page-2")}).
+		Return(&sqs.ListQueuesOutput{
+			QueueUrls: []string{"https://sqs.local/000000000000/queue-z", "https://sqs.local/000000000000/queue-a"},
+			NextToken: aws.String("page-3"),
+		}, nil).
+		Once()
+	api.EXPECT().
+		GetQueueAttributes(mock.Anything, mock.MatchedBy(func(input *sqs.GetQueueAttributesInput) bool {
+			return aws.ToString(input.QueueUrl) == "https://sqs.local/000000000000/queue-z"
+		})).
+		Return(&sqs.GetQueueAttributesOutput{Attributes: map[string]string{string(types.QueueAttributeNameApproximateNumberOfMessages): "3"}}, nil).
+		Once()
+	api.EXPECT().
+		GetQueueAttributes(mock.Anything, mock.MatchedBy(func(input *sqs.GetQueueAttributesInput) bool {
+			return aws.ToString(input.QueueUrl) == "https://sqs.local/000000000000/queue-a"
+		})).
+		Return(nil, errors.New("boom")).
+		Once()
+
+	page, err := repo.ListQueuesPage(ctx, ListQueuesPageRepositoryInput{MaxResults: 2, NextToken: "page-2"})
+	require.NoError(t, err)
+	assert.Equal(t, "page-3", page.NextToken)
+	require.Len(t, page.Queues, 1)
+	assert.Equal(t, "queue-z", page.Queues[0].Name)
+	assert.Equal(t, int64(3), page.Queues[0].MessagesAvailable)
+}
+
 func TestSqsRepositoryImpl_CreateQueue(t *testing.T) {
 	ctx := context.Background()
 
@@ -176,6 +217,7 @@ func TestSqsRepositoryImpl_CreateQueue(t *testing.T) {
 				Attributes: map[string]string{
 					"VisibilityTimeout": "30",
 				},
+				Tags: map[string]string{"team": "payments"},
 			},
 			arrange: func(api *mocksqsAPI) {
 				api.EXPECT().
@@ -185,6 +227,7 @@ func TestSqsRepositoryImpl_CreateQueue(t *testing.T) {
 						require.NotNil(t, params.QueueName)
 						assert.Equal(t, "orders", aws.ToString(params.QueueName))
 						assert.Equal(t, map[string]string{"VisibilityTimeout": "30"}, params.Attributes)
+						assert.Equal(t, map[string]string{"team": "payments"}, params.Tags)
 					}).
 					Return(&sqs.CreateQueueOutput{QueueUrl: aws.String("https://sqs.local/orders")}, nil).
 					Once()
@@ -202,6 +245,17 @@ func TestSqsRepositoryImpl_CreateQueue(t *testing.T) {
 			},
 			wantErr: "failed to call CreateQueue API",
 		},
+		{
+			name:  "reports recently deleted name",
+			input: CreateQueueRepositoryInput{Name: "orders"},
+			arrange: func(api *mocksqsAPI) {
+				api.EXPECT().
+					CreateQueue(mock.Anything, mock.Anything).
+					Return(nil, &types.QueueDeletedRecently{}).
+					Once()
+			},
+			wantErr: "a queue with this name was deleted less than 60 seconds ago",
+		},
 		{
 			name:  "returns error when queue url is missing",
 			input: CreateQueueRepositoryInput{Name: "orders"},
@@ -289,11 +343,11 @@ func TestSqsRepositoryImpl_GetQueueDetail(t *testing.T) {
 			MessagesInFlight:          1,
 			Encryption:                "KMS",
 			ContentBasedDeduplication: true,
+			Arn:                       "arn:aws:sqs:region:acct:queue.fifo",
 		}
 
 		expectedDetail := QueueDetail{
 			QueueSummary:   expectedSummary,
-			Arn:            "arn:aws:sqs:region:acct:queue.fifo",
 			LastModifiedAt: time.Unix(1700000500, 0).UTC(),
 			Attributes:     attrs,
 			Tags:           map[string]string{"env": "dev", "team": "platform"},
@@ -382,6 +436,232 @@ func TestSqsRepositoryImpl_DeleteQueue(t *testing.T) {
 	})
 }
 
+func TestSqsRepositoryImpl_SendMessageBatch(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders.fifo"
+
+	t.Run("sends entries and returns failures", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+		delay := int32(5)
+
+		api.EXPECT().
+			SendMessageBatch(mock.Anything, mock.Anything).
+			Run(func(callCtx context.Context, input *sqs.SendMessageBatchInput, optFns ...func(*sqs.Options)) {
+				assert.Equal(t, aws.String(queueURL), input.QueueUrl)
+				if assert.Len(t, input.Entries, 2) {
+					first := input.Entries[0]
+					assert.Equal(t, aws.String("0"), first.Id)
+					assert.Equal(t, aws.String("hello"), first.MessageBody)
+					assert.Equal(t, aws.String("group"), first.MessageGroupId)
+					assert.Equal(t, aws.String("dedup"), first.MessageDeduplicationId)
+					assert.Equal(t, int32(5), first.DelaySeconds)
+					assert.Equal(t, aws.String("test"), first.MessageAttributes["kind"].StringValue)
+
+					second := input.Entries[1]
+					assert.Nil(t, second.MessageDeduplicationId)
+					assert.Nil(t, second.MessageAttributes)
+				}
+			}).
+			Return(&sqs.SendMessageBatchOutput{
+				Failed: []types.BatchResultErrorEntry{{
+					Id:          aws.String("1"),
+					Code:        aws.String("InternalError"),
+					Message:     aws.String("try again"),
+					SenderFault: false,
+				}},
+			}, nil).
+			Once()
+
+		failures, err := repo.SendMessageBatch(ctx, SendMessageBatchRepositoryInput{
+			QueueURL: queueURL,
+			Entries: []SendMessageBatchEntry{
+				{ID: "0", Body: "hello", MessageGroupID: "group", MessageDeduplicationID: "dedup", DelaySeconds: &delay, Attributes: map[string]string{"kind": "test"}},
+				{ID: "1", Body: "world", MessageGroupID: "group"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []SendMessageBatchFailure{{ID: "1", Code: "InternalError", Message: "try again"}}, failures)
+	})
+
+	t.Run("wraps api error", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			SendMessageBatch(mock.Anything, mock.Anything).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		_, err := repo.SendMessageBatch(ctx, SendMessageBatchRepositoryInput{QueueURL: queueURL})
+		assert.ErrorContains(t, err, "failed to call SendMessageBatch API")
+	})
+}
+
+func TestSqsRepositoryImpl_GetQueueStats(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("reads message counts", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			GetQueueAttributes(mock.Anything, mock.Anything).
+			Run(func(callCtx context.Context, input *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) {
+				assert.Equal(t, aws.String(queueURL), input.QueueUrl)
+				assert.Len(t, input.AttributeNames, 3)
+			}).
+			Return(&sqs.GetQueueAttributesOutput{Attributes: map[string]string{
+				"ApproximateNumberOfMessages":           "5",
+				"ApproximateNumberOfMessagesNotVisible": "2",
+				"ApproximateNumberOfMessagesDelayed":    "1",
+			}}, nil).
+			Once()
+
+		stats, err := repo.GetQueueStats(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, QueueStats{MessagesAvailable: 5, MessagesInFlight: 2, MessagesDelayed: 1}, stats)
+	})
+
+	t.Run("wraps api error", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			GetQueueAttributes(mock.Anything, mock.Anything).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		_, err := repo.GetQueueStats(ctx, queueURL)
+		assert.ErrorContains(t, err, "failed to call GetQueueAttributes API")
+	})
+}
+
+func TestSqsRepositoryImpl_GetQueueAttributes(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("reads the named attributes", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			GetQueueAttributes(mock.Anything, mock.Anything).
+			Run(func(callCtx context.Context, input *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) {
+				assert.Equal(t, aws.String(queueURL), input.QueueUrl)
+				assert.Equal(t, []types.QueueAttributeName{"VisibilityTimeout", "DelaySeconds"}, input.AttributeNames)
+			}).
+			Return(&sqs.GetQueueAttributesOutput{Attributes: map[string]string{
+				"VisibilityTimeout": "30",
+				"DelaySeconds":      "0",
+			}}, nil).
+			Once()
+
+		attributes, err := repo.GetQueueAttributes(ctx, queueURL, []string{"VisibilityTimeout", "DelaySeconds"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"VisibilityTimeout": "30", "DelaySeconds": "0"}, attributes)
+	})
+
+	t.Run("wraps api error", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			GetQueueAttributes(mock.Anything, mock.Anything).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		_, err := repo.GetQueueAttributes(ctx, queueURL, []string{"VisibilityTimeout"})
+		assert.ErrorContains(t, err, "failed to call GetQueueAttributes API")
+	})
+}
+
+func TestSqsRepositoryImpl_SetQueueAttributes(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("calls set queue attributes", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			SetQueueAttributes(mock.Anything, mock.Anything).
+			Run(func(callCtx context.Context, input *sqs.SetQueueAttributesInput, optFns ...func(*sqs.Options)) {
+				assert.Equal(t, aws.String(queueURL), input.QueueUrl)
+				assert.Equal(t, map[string]string{"VisibilityTimeout": "45"}, input.Attributes)
+			}).
+			Return(&sqs.SetQueueAttributesOutput{}, nil).
+			Once()
+
+		err := repo.SetQueueAttributes(ctx, queueURL, map[string]string{"VisibilityTimeout": "45"})
+		require.NoError(t, err)
+	})
+
+	t.Run("wraps api error", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			SetQueueAttributes(mock.Anything, mock.Anything).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		err := repo.SetQueueAttributes(ctx, queueURL, map[string]string{"VisibilityTimeout": "45"})
+		assert.ErrorContains(t, err, "failed to call SetQueueAttributes API")
+	})
+}
+
+func TestSqsRepositoryImpl_QueueURL(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns url of existing queue", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			GetQueueUrl(mock.Anything, mock.Anything).
+			Run(func(callCtx context.Context, input *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) {
+				assert.Equal(t, aws.String("orders"), input.QueueName)
+			}).
+			Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("https://sqs.local/orders")}, nil).
+			Once()
+
+		got, exists, err := repo.QueueURL(ctx, "orders")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, "https://sqs.local/orders", got)
+	})
+
+	t.Run("reports missing queue", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			GetQueueUrl(mock.Anything, mock.Anything).
+			Return(nil, &types.QueueDoesNotExist{}).
+			Once()
+
+		got, exists, err := repo.QueueURL(ctx, "orders")
+		require.NoError(t, err)
+		assert.False(t, exists)
+		assert.Empty(t, got)
+	})
+
+	t.Run("wraps api error", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			GetQueueUrl(mock.Anything, mock.Anything).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		_, _, err := repo.QueueURL(ctx, "orders")
+		assert.ErrorContains(t, err, "failed to call GetQueueUrl API")
+	})
+}
+
 func TestSqsRepositoryImpl_PurgeQueue(t *testing.T) {
 	ctx := context.Background()
 	queueURL := "https://sqs.local/orders"
@@ -416,6 +696,52 @@ func TestSqsRepositoryImpl_PurgeQueue(t *testing.T) {
 		require.Error(t, err)
 		assert.ErrorContains(t, err, "failed to call PurgeQueue API")
 	})
+
+	t.Run("reports purge in progress", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			PurgeQueue(mock.Anything, mock.Anything).
+			Return(nil, &types.PurgeQueueInProgress{}).
+			Once()
+
+		err := repo.PurgeQueue(ctx, queueURL)
+		assert.ErrorIs(t, err, ErrPurgeInProgress)
+	})
+}
+
+func TestSqsRepositoryImpl_TagQueue(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("tags and untags queue", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			TagQueue(mock.Anything, &sqs.TagQueueInput{QueueUrl: aws.String(queueURL), Tags: map[string]string{"env": "prod"}}).
+			Return(&sqs.TagQueueOutput{}, nil).
+			Once()
+		api.EXPECT().
+			UntagQueue(mock.Anything, &sqs.UntagQueueInput{QueueUrl: aws.String(queueURL), TagKeys: []string{"team"}}).
+			Return(&sqs.UntagQueueOutput{}, nil).
+			Once()
+
+		require.NoError(t, repo.TagQueue(ctx, queueURL, map[string]string{"env": "prod"}))
+		require.NoError(t, repo.UntagQueue(ctx, queueURL, []string{"team"}))
+	})
+
+	t.Run("wraps api error", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().TagQueue(mock.Anything, mock.Anything).Return(nil, errors.New("boom")).Once()
+		api.EXPECT().UntagQueue(mock.Anything, mock.Anything).Return(nil, errors.New("boom")).Once()
+
+		assert.ErrorContains(t, repo.TagQueue(ctx, queueURL, map[string]string{"env": "prod"}), "failed to call TagQueue API")
+		assert.ErrorContains(t, repo.UntagQueue(ctx, queueURL, []string{"team"}), "failed to call UntagQueue API")
+	})
 }
 
 func TestSqsRepositoryImpl_SendMessage(t *testing.T) {
@@ -456,6 +782,7 @@ func TestSqsRepositoryImpl_SendMessage(t *testing.T) {
 				assert.Equal(t, aws.String("123"), attr.StringValue)
 				_, hasBlank := params.MessageAttributes[""]
 				assert.False(t, hasBlank)
+				assert.Nil(t, params.MessageSystemAttributes)
 			}).
 			Return(&sqs.SendMessageOutput{}, nil).
 			Once()
@@ -464,6 +791,49 @@ func TestSqsRepositoryImpl_SendMessage(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("sends typed attributes", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			SendMessage(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, params *sqs.SendMessageInput, _ ...func(*sqs.Options)) {
+				assert.Equal(t, map[string]types.MessageAttributeValue{
+					"retries": {DataType: aws.String("Number"), StringValue: aws.String("3")},
+					"payload": {DataType: aws.String("Binary.gzip"), BinaryValue: []byte("hello")},
+				}, params.MessageAttributes)
+			}).
+			Return(&sqs.SendMessageOutput{}, nil).
+			Once()
+
+		err := repo.SendMessage(ctx, SendMessageRepositoryInput{
+			QueueURL:       "https://sqs.local/orders",
+			Body:           "hello",
+			Attributes:     map[string]string{"retries": "3", "payload": "aGVsbG8="},
+			AttributeTypes: map[string]string{"retries": "Number", "payload": "Binary.gzip"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("passes the trace header on", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+		header := "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1"
+
+		api.EXPECT().
+			SendMessage(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, params *sqs.SendMessageInput, _ ...func(*sqs.Options)) {
+				assert.Equal(t, map[string]types.MessageSystemAttributeValue{
+					"AWSTraceHeader": {DataType: aws.String("String"), StringValue: aws.String(header)},
+				}, params.MessageSystemAttributes)
+			}).
+			Return(&sqs.SendMessageOutput{}, nil).
+			Once()
+
+		err := repo.SendMessage(withTraceHeader(ctx, header), SendMessageRepositoryInput{QueueURL: "https://sqs.local/orders", Body: "hello"})
+		require.NoError(t, err)
+	})
+
 	t.Run("wraps api error", func(t *testing.T) {
 		api := newMocksqsAPI(t)
 		repo := &SqsRepositoryImpl{sqsClient: api}
@@ -500,13 +870,16 @@ func TestSqsRepositoryImpl_ReceiveMessages(t *testing.T) {
 				assert.Equal(t, input.MaxMessages, params.MaxNumberOfMessages)
 				assert.Equal(t, input.WaitTimeSeconds, params.WaitTimeSeconds)
 				assert.Equal(t, []string{"All"}, params.MessageAttributeNames)
+				assert.Contains(t, params.MessageSystemAttributeNames, types.MessageSystemAttributeNameDeadLetterQueueSourceArn)
 			}).
 			Return(&sqs.ReceiveMessageOutput{
 				Messages: []types.Message{
 					{
-						MessageId:     aws.String("msg-1"),
-						ReceiptHandle: aws.String("receipt-1"),
-						Body:          aws.String("hello"),
+						MessageId:              aws.String("msg-1"),
+						ReceiptHandle:          aws.String("receipt-1"),
+						Body:                   aws.String("hello"),
+						MD5OfBody:              aws.String("5d41402abc4b2a76b9719d911017c592"),
+						MD5OfMessageAttributes: aws.String("0b0a5b1e4c5f2d3f8f2a0d3a6c9e1b7a"),
 						Attributes: map[string]string{
 							string(types.MessageSystemAttributeNameApproximateReceiveCount):          "2",
 							string(types.MessageSystemAttributeNameApproximateFirstReceiveTimestamp): "1700001000000",
@@ -516,6 +889,7 @@ func TestSqsRepositoryImpl_ReceiveMessages(t *testing.T) {
 						},
 						MessageAttributes: map[string]types.MessageAttributeValue{
 							"CustomBinary": {
+								DataType:    aws.String("Binary.gzip"),
 								BinaryValue: []byte{0x01, 0x02},
 							},
 							"CustomBinaryList": {
@@ -524,7 +898,12 @@ func TestSqsRepositoryImpl_ReceiveMessages(t *testing.T) {
 							"CustomList": {
 								StringListValues: []string{"hello", "world"},
 							},
+							"CustomNumber": {
+								DataType:    aws.String("Number"),
+								StringValue: aws.String("42"),
+							},
 							"CustomString": {
+								DataType:    aws.String("String"),
 								StringValue: aws.String("value"),
 							},
 						},
@@ -543,9 +922,10 @@ func TestSqsRepositoryImpl_ReceiveMessages(t *testing.T) {
 				ReceiptHandle: "receipt-1",
 				ReceiveCount:  2,
 				Attributes: []MessageAttribute{
-					{Name: "CustomBinary", Value: base64.StdEncoding.EncodeToString([]byte{0x01, 0x02})},
+					{Name: "CustomBinary", Value: base64.StdEncoding.EncodeToString([]byte{0x01, 0x02}), DataType: "Binary.gzip"},
 					{Name: "CustomBinaryList", Value: base64.StdEncoding.EncodeToString([]byte{0x03}) + ", " + base64.StdEncoding.EncodeToString([]byte{0x04})},
 					{Name: "CustomList", Value: "hello, world"},
+					{Name: "CustomNumber", Value: "42", DataType: "Number"},
 					{Name: "CustomString", Value: "value"},
 					{Name: string(types.MessageSystemAttributeNameApproximateFirstReceiveTimestamp), Value: time.UnixMilli(1700001000000).UTC().Format(time.RFC3339)},
 					{Name: string(types.MessageSystemAttributeNameApproximateReceiveCount), Value: "2"},
@@ -556,6 +936,29 @@ func TestSqsRepositoryImpl_ReceiveMessages(t *testing.T) {
 			},
 		}
 
+		require.Len(t, messages, 1)
+		assert.JSONEq(t, `{
+			"MessageId": "msg-1",
+			"ReceiptHandle": "receipt-1",
+			"MD5OfBody": "5d41402abc4b2a76b9719d911017c592",
+			"MD5OfMessageAttributes": "0b0a5b1e4c5f2d3f8f2a0d3a6c9e1b7a",
+			"Body": "hello",
+			"Attributes": {
+				"ApproximateFirstReceiveTimestamp": "1700001000000",
+				"ApproximateReceiveCount": "2",
+				"MessageDeduplicationId": "dedup-1",
+				"MessageGroupId": "group-1",
+				"SentTimestamp": "1700002000000"
+			},
+			"MessageAttributes": {
+				"CustomBinary": {"DataType": "Binary.gzip", "BinaryValue": "AQI="},
+				"CustomBinaryList": {"DataType": "", "BinaryListValues": ["Aw==", "BA=="]},
+				"CustomList": {"DataType": "", "StringListValues": ["hello", "world"]},
+				"CustomNumber": {"DataType": "Number", "StringValue": "42"},
+				"CustomString": {"DataType": "String", "StringValue": "value"}
+			}
+		}`, string(messages[0].Raw))
+		expected[0].Raw = messages[0].Raw
 		assert.Equal(t, expected, messages)
 	})
 
@@ -611,3 +1014,170 @@ func TestSqsRepositoryImpl_DeleteMessage(t *testing.T) {
 		assert.ErrorContains(t, err, "failed to call DeleteMessage API")
 	})
 }
+
+func TestSqsRepositoryImpl_ChangeMessageVisibility(t *testing.T) {
+	ctx := context.Background()
+	input := ChangeMessageVisibilityRepositoryInput{QueueURL: "https://sqs.local/orders", ReceiptHandle: "abc", VisibilityTimeout: 0}
+
+	t.Run("changes visibility", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			ChangeMessageVisibility(mock.Anything, mock.Anything).
+			Run(func(callCtx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) {
+				assert.Equal(t, ctx, callCtx)
+				assert.Equal(t, aws.String(input.QueueURL), params.QueueUrl)
+				assert.Equal(t, aws.String(input.ReceiptHandle), params.ReceiptHandle)
+				assert.Equal(t, int32(0), params.VisibilityTimeout)
+			}).
+			Return(&sqs.ChangeMessageVisibilityOutput{}, nil).
+			Once()
+
+		err := repo.ChangeMessageVisibility(ctx, input)
+		require.NoError(t, err)
+	})
+
+	t.Run("wraps api error", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			ChangeMessageVisibility(mock.Anything, mock.Anything).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		err := repo.ChangeMessageVisibility(ctx, input)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to call ChangeMessageVisibility API")
+	})
+}
+
+func TestSqsRepositoryImpl_ListMessageMoveTasks(t *testing.T) {
+	ctx := context.Background()
+	sourceArn := "arn:aws:sqs:us-east-1:000000000000:orders-dlq"
+
+	t.Run("maps the tasks", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			ListMessageMoveTasks(mock.Anything, mock.Anything).
+			Run(func(callCtx context.Context, params *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options)) {
+				assert.Equal(t, aws.String(sourceArn), params.SourceArn)
+				assert.Equal(t, aws.Int32(10), params.MaxResults)
+			}).
+			Return(&sqs.ListMessageMoveTasksOutput{Results: []types.ListMessageMoveTasksResultEntry{
+				{
+					TaskHandle:                        aws.String("handle-1"),
+					Status:                            aws.String("RUNNING"),
+					SourceArn:                         aws.String(sourceArn),
+					MaxNumberOfMessagesPerSecond:      aws.Int32(50),
+					ApproximateNumberOfMessagesMoved:  40,
+					ApproximateNumberOfMessagesToMove: aws.Int64(100),
+					StartedTimestamp:                  1700000000000,
+				},
+				{Status: aws.String("FAILED"), SourceArn: aws.String(sourceArn), FailureReason: aws.String("AWS.SimpleQueueService.NonExistentQueue")},
+			}}, nil).
+			Once()
+
+		tasks, err := repo.ListMessageMoveTasks(ctx, sourceArn)
+		require.NoError(t, err)
+		assert.Equal(t, []MessageMoveTask{
+			{
+				TaskHandle:           "handle-1",
+				Status:               MessageMoveTaskRunning,
+				SourceArn:            sourceArn,
+				MaxMessagesPerSecond: 50,
+				MessagesMoved:        40,
+				MessagesToMove:       100,
+				StartedAt:            time.UnixMilli(1700000000000),
+			},
+			{Status: MessageMoveTaskFailed, SourceArn: sourceArn, FailureReason: "AWS.SimpleQueueService.NonExistentQueue"},
+		}, tasks)
+	})
+
+	t.Run("wraps api error", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			ListMessageMoveTasks(mock.Anything, mock.Anything).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		_, err := repo.ListMessageMoveTasks(ctx, sourceArn)
+		assert.ErrorContains(t, err, "failed to call ListMessageMoveTasks API")
+	})
+}
+
+func TestSqsRepositoryImpl_CancelMessageMoveTask(t *testing.T) {
+	ctx := context.Background()
+	api := newMocksqsAPI(t)
+	repo := &SqsRepositoryImpl{sqsClient: api}
+
+	api.EXPECT().
+		CancelMessageMoveTask(mock.Anything, &sqs.CancelMessageMoveTaskInput{TaskHandle: aws.String("handle-1")}).
+		Return(&sqs.CancelMessageMoveTaskOutput{ApproximateNumberOfMessagesMoved: 42}, nil).
+		Once()
+
+	moved, err := repo.CancelMessageMoveTask(ctx, "handle-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), moved)
+}
+
+func TestSqsRepositoryImpl_ListDeadLetterSourceQueues(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/000000000000/orders-dlq"
+
+	t.Run("follows the pages", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			ListDeadLetterSourceQueues(mock.Anything, &sqs.ListDeadLetterSourceQueuesInput{QueueUrl: aws.String(queueURL), MaxResults: aws.Int32(1000)}).
+			Return(&sqs.ListDeadLetterSourceQueuesOutput{QueueUrls: []string{"https://sqs.local/000000000000/orders"}, NextToken: aws.String("next")}, nil).
+			Once()
+		api.EXPECT().
+			ListDeadLetterSourceQueues(mock.Anything, &sqs.ListDeadLetterSourceQueuesInput{QueueUrl: aws.String(queueURL), MaxResults: aws.Int32(1000), NextToken: aws.String("next")}).
+			Return(&sqs.ListDeadLetterSourceQueuesOutput{QueueUrls: []string{"https://sqs.local/000000000000/payments"}}, nil).
+			Once()
+
+		sources, err := repo.ListDeadLetterSourceQueues(ctx, queueURL)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://sqs.local/000000000000/orders", "https://sqs.local/000000000000/payments"}, sources)
+	})
+
+	t.Run("wraps api error", func(t *testing.T) {
+		api := newMocksqsAPI(t)
+		repo := &SqsRepositoryImpl{sqsClient: api}
+
+		api.EXPECT().
+			ListDeadLetterSourceQueues(mock.Anything, mock.Anything).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		_, err := repo.ListDeadLetterSourceQueues(ctx, queueURL)
+		assert.ErrorContains(t, err, "failed to call ListDeadLetterSourceQueues API")
+	})
+}
+
+func TestParseRedrivePolicy(t *testing.T) {
+	testCases := []struct {
+		name string
+		raw  string
+		want *RedrivePolicy
+	}{
+		{name: "empty", raw: "", want: nil},
+		{name: "numeric count", raw: `{"deadLetterTargetArn":"arn:dlq","maxReceiveCount":5}`, want: &RedrivePolicy{DeadLetterTargetArn: "arn:dlq", MaxReceiveCount: 5}},
+		{name: "string count", raw: `{"deadLetterTargetArn":"arn:dlq","maxReceiveCount":"3"}`, want: &RedrivePolicy{DeadLetterTargetArn: "arn:dlq", MaxReceiveCount: 3}},
+		{name: "missing target", raw: `{"maxReceiveCount":5}`, want: nil},
+		{name: "invalid json", raw: `{`, want: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, parseRedrivePolicy(tc.raw))
+		})
+	}
+}
@@ -2,8 +2,20 @@ package internal
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/netip"
+	"os"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
 
 	"github.com/cockroachdb/errors"
 )
@@ -11,54 +23,252 @@ import (
 // SqsService encapsulates business logic.
 type SqsService interface {
 	Queues(ctx context.Context) ([]QueueSummary, error)
+	QueuesPage(ctx context.Context, maxResults int, nextToken string) (QueueSummaryPage, error)
+	FindQueues(ctx context.Context, opts QueueListOptions) (QueueListPage, error)
+	ExportQueues(ctx context.Context, opts QueueListOptions) ([]QueueExport, error)
+	QueueReport(ctx context.Context, queueURLs []string) (QueueReport, error)
 	CreateQueue(ctx context.Context, input CreateQueueInput) (CreateQueueResult, error)
+	CreateQueueFromAttributes(ctx context.Context, input RawCreateQueueInput) (CreateQueueResult, error)
+	StartQueueImport(ctx context.Context, queues []RawCreateQueueInput) (Job, error)
+	SetFifoThroughput(ctx context.Context, queueURL string, throughput FifoThroughput) error
+	CheckQueueName(ctx context.Context, name string, queueType QueueType) (QueueNameCheck, error)
 	QueueDetail(ctx context.Context, queueURL string) (QueueDetail, error)
-	DeleteQueue(ctx context.Context, queueURL string) error
+	QueueStats(ctx context.Context, queueURLs []string) ([]QueueStatsResult, error)
+	LiveQueueStats(ctx context.Context, queueURL string) (LiveQueueStats, error)
+	ProbeLatency(ctx context.Context, input LatencyProbeInput) ([]QueueLatency, error)
+	DeleteQueue(ctx context.Context, queueURL string) (TrashedQueue, error)
+	TrashedQueues(ctx context.Context) ([]TrashedQueue, error)
+	RestoreQueue(ctx context.Context, id string) (string, error)
+	DiscardTrashedQueue(ctx context.Context, id string) error
 	PurgeQueue(ctx context.Context, queueURL string) error
-	SendMessage(ctx context.Context, input SendMessageInput) error
+	TagQueue(ctx context.Context, queueURL string, tags map[string]string) error
+	DeadLetterCandidates(ctx context.Context, sourceURL string) ([]QueueSummary, error)
+	DeadLetterSourceQueues(ctx context.Context, queueURL string) ([]DeadLetterSource, error)
+	SetRedrivePolicy(ctx context.Context, queueURL string, policy *RedrivePolicy) error
+	QueueAccessPolicy(ctx context.Context, queueURL string) (QueueAccessPolicy, error)
+	SetQueueAccessPolicy(ctx context.Context, queueURL, policy string) ([]PolicyFinding, error)
+	QueueTopics(ctx context.Context, queueURL string) (QueueTopics, error)
+	PublishToTopic(ctx context.Context, input PublishToTopicInput) (PublishToTopicResult, error)
+	QueueEventRules(ctx context.Context, queueURL, eventBus string) (QueueEventRules, error)
+	CreateEventRule(ctx context.Context, input CreateEventRuleInput) (EventRule, error)
+	TestEventPattern(ctx context.Context, pattern, event string) (bool, error)
+	UntagQueue(ctx context.Context, queueURL string, keys []string) error
+	StartPurge(ctx context.Context, queueURL string) (Job, error)
+	StartPurgeWithBackup(ctx context.Context, queueURL string) (Job, error)
+	StartBulkQueueOperation(ctx context.Context, input BulkQueueInput) (Job, error)
+	StartFilteredPurge(ctx context.Context, input FilteredPurgeInput) (Job, error)
+	MigrateQueue(ctx context.Context, input MigrateQueueInput) (QueueMigration, error)
+	MigrationRegions() []string
+	QueueDefinition(ctx context.Context, queueURL string, format QueueDefinitionFormat) (QueueDefinition, error)
+	SimulateConsumer(ctx context.Context, input SimulateConsumerInput) (Job, error)
+	BenchmarkProducer(ctx context.Context, input ProducerBenchmarkInput) (Job, error)
+	Job(ctx context.Context, id string) (Job, error)
+	OpenJobFile(ctx context.Context, id string) (Job, *os.File, error)
+	StartDrainToFile(ctx context.Context, queueURL string) (Job, error)
+	StartRestoreFromFile(ctx context.Context, input RestoreFileInput) (Job, error)
+	IngestMessage(ctx context.Context, input IngestInput) (IngestResult, error)
+	UpdateQueueAttribute(ctx context.Context, queueURL, name, value string) (string, error)
+	SendMessage(ctx context.Context, input SendMessageInput) (SendMessageResult, error)
+	SendMessageBatch(ctx context.Context, input SendMessageBatchInput) (SendMessageBatchResult, error)
+	FanOutMessage(ctx context.Context, input FanOutInput) ([]FanOutQueueResult, error)
+	TransferMessages(ctx context.Context, input TransferMessagesInput) ([]TransferredMessage, error)
+	RedriveMessages(ctx context.Context, queueURL string, messages []ReceivedMessage) ([]RedrivenMessage, error)
+	MessageMoveTasks(ctx context.Context, queueURL string) ([]MessageMoveTask, error)
+	CancelMessageMoveTask(ctx context.Context, queueURL, taskHandle string) (int64, error)
 	ReceiveMessages(ctx context.Context, input ReceiveMessagesInput) (ReceiveMessagesResult, error)
+	DrainReceive(ctx context.Context, input DrainReceiveInput, emit func([]ReceivedMessage) error) (DrainReceiveResult, error)
+	SearchMessages(ctx context.Context, input SearchMessagesInput, emit func([]ReceivedMessage, int) error) (SearchMessagesResult, error)
 	DeleteMessage(ctx context.Context, input DeleteMessageInput) error
+	ReceiveMergedMessages(ctx context.Context, input MergedReceiveInput) (MergedReceiveResult, error)
+	DrainPolls() int
+	APIMetrics(ctx context.Context) APIMetrics
+	EndpointCapabilities(ctx context.Context) EndpointCapabilities
+	Search(ctx context.Context, query string) (SearchResults, error)
+	QueueListColumns(ctx context.Context) ([]string, error)
+	SaveQueueListColumns(ctx context.Context, columns []string) ([]string, error)
+	FavoriteQueues(ctx context.Context) ([]string, error)
+	SetFavoriteQueue(ctx context.Context, queueURL string, favorite bool) error
+	SendDefaults(ctx context.Context, queueURL string) (SendDefaults, error)
+	Draft(ctx context.Context, queueURL string) (MessageDraft, bool, error)
+	SaveDraft(ctx context.Context, queueURL string, draft MessageDraft) (MessageDraft, error)
+	MessageContract(ctx context.Context, queueURL string) (MessageContract, bool, error)
+	SaveMessageContract(ctx context.Context, contract MessageContract) (MessageContract, error)
+	DeleteMessageContract(ctx context.Context, queueURL string) error
+	CompareMessage(ctx context.Context, queueURL, body string) (MessageComparison, error)
+	SweepTemporaryQueues(ctx context.Context) (CleanupReport, error)
+	CleanupReport(ctx context.Context) (CleanupReport, error)
+	Schedules(ctx context.Context) ([]Schedule, error)
+	Schedule(ctx context.Context, id string) (Schedule, error)
+	CreateSchedule(ctx context.Context, input CreateScheduleInput) (Schedule, error)
+	UpdateSchedule(ctx context.Context, id string, input UpdateScheduleInput) (Schedule, error)
+	DeleteSchedule(ctx context.Context, id string) error
+	RunDueSchedules(ctx context.Context) error
+	DeadLetterQueues(ctx context.Context, sampleAge bool) ([]DeadLetterQueueSummary, error)
+	AlertRules(ctx context.Context) ([]AlertRuleState, error)
+	CreateAlertRule(ctx context.Context, input CreateAlertRuleInput) (AlertRule, error)
+	DeleteAlertRule(ctx context.Context, id string) error
+	SilenceAlertRule(ctx context.Context, id string, duration time.Duration, reason string) (AlertRule, error)
+	UnsilenceAlertRule(ctx context.Context, id string) (AlertRule, error)
+	EvaluateAlerts(ctx context.Context) error
+	AnalyzeMessageSizes(ctx context.Context, queueURL string, samples int) (MessageSizeReport, error)
+	AnalyzeMessageFields(ctx context.Context, queueURL string, samples int) (MessageFieldReport, error)
+	AnalyzeMessageGroups(ctx context.Context, queueURL string, samples int) (MessageGroupReport, error)
+	FindDuplicateMessages(ctx context.Context, queueURL string, samples int) (DuplicateMessageReport, error)
+	CountMessagesByAttribute(ctx context.Context, queueURL, attribute string, samples int) (AttributeCountReport, error)
+	StartAttributeCount(ctx context.Context, queueURL, attribute string) (Job, error)
+	ExportSettings(ctx context.Context) (SettingsBundle, error)
+	ImportSettings(ctx context.Context, bundle SettingsBundle) error
+	StartForwarder(ctx context.Context, input ForwardMessagesInput) (Job, error)
+	SaveQueueBaseline(ctx context.Context, queueURL string) (QueueBaseline, error)
+	DeleteQueueBaseline(ctx context.Context, queueURL string) error
+	QueueDrift(ctx context.Context) ([]QueueDriftState, error)
+	CheckDrift(ctx context.Context) error
+	WatchQueueAttributes(ctx context.Context, queueURL string) (AttributeHistory, error)
+	UnwatchQueueAttributes(ctx context.Context, queueURL string) error
+	QueueAttributeHistory(ctx context.Context, queueURL string) (AttributeHistory, error)
+	RecordAttributeHistory(ctx context.Context) error
+	SendDueEmailReport(ctx context.Context) error
+	VerifySlackRequest(timestamp, signature string, body []byte) error
+	RunSlackCommand(ctx context.Context, cmd SlackCommand) (SlackReply, error)
+	NotificationChannels(ctx context.Context) []NotificationChannel
+	WebPushKey(ctx context.Context) string
+	SubscribePush(ctx context.Context, subscription PushSubscription) error
+	UnsubscribePush(ctx context.Context, endpoint string) error
+	CheckDeadLetterArrivals(ctx context.Context) error
 }
 
 // SqsServiceImpl is the concrete service implementation.
 type SqsServiceImpl struct {
 	repo SqsRepository
+	// topics reaches the SNS topics queues are subscribed to; nil when SNS is not configured.
+	topics SnsRepository
+	// events creates EventBridge rules that target queues; nil when EventBridge is not configured.
+	events EventBridgeRepository
+	// regions reaches the other regions queues can be copied to, by region name.
+	regions map[string]SqsRepository
+	store   LocalStore
+	config  ServiceConfig
+	// notifiers holds a notifier for every configured notification channel.
+	notifiers map[NotificationChannel]Notifier
+	mailer    Mailer
+	// pusher sends browser push notifications; nil unless a VAPID key is configured.
+	pusher WebPusher
+	// lookupNetIP resolves the hosts of push endpoints; nil uses the default resolver.
+	lookupNetIP func(ctx context.Context, host string) ([]netip.Addr, error)
+	clock       func() time.Time
+	dedup       *dedupHistory
+	cleanup     *cleanupTracker
+	alerts      *alertTracker
+	drift       *driftTracker
+	// capabilities caches what the configured endpoint supports.
+	capabilities *capabilityCache
+	jobs         *jobRegistry
+	polls        *pollTracker
+	depths       *depthHistory
+	emailReports *emailReportTracker
+	dlqArrivals  *dlqArrivalTracker
+	// idempotency remembers recent sends by their client supplied idempotency key.
+	idempotency *idempotencyCache
+	// sendRetryDelay is the first backoff before failed batch entries are sent again; it doubles
+	// on every attempt.
+	sendRetryDelay time.Duration
+	// forwardRetryDelay is the first backoff before a forwarder POSTs a message again; it doubles
+	// on every attempt.
+	forwardRetryDelay time.Duration
 }
 
 // NewSqsService constructs a new service instance.
-func NewSqsService(s SqsRepository) SqsService {
-	return &SqsServiceImpl{repo: s}
+func NewSqsService(s SqsRepository, topics SnsRepository, events EventBridgeRepository, regions map[string]SqsRepository, store LocalStore, config ServiceConfig) SqsService {
+	if config.QueueURLs.Enabled() {
+		s = newQueueURLRepository(s, config.QueueURLs)
+	}
+	if config.QueuePolicy.Enabled() {
+		s = newPolicyRepository(s, config.QueuePolicy)
+		// The other regions are only given the URLs their own CreateQueue returned, so they need
+		// no URL check, but the queue names they create must still be visible.
+		guarded := make(map[string]SqsRepository, len(regions))
+		for region, repo := range regions {
+			guarded[region] = newPolicyRepository(repo, config.QueuePolicy)
+		}
+		regions = guarded
+	}
+	service := &SqsServiceImpl{
+		repo:              s,
+		topics:            topics,
+		events:            events,
+		regions:           regions,
+		store:             store,
+		config:            config,
+		dedup:             newDedupHistory(),
+		cleanup:           newCleanupTracker(),
+		alerts:            newAlertTracker(),
+		drift:             newDriftTracker(),
+		capabilities:      &capabilityCache{},
+		jobs:              newJobRegistry(),
+		polls:             newPollTracker(),
+		depths:            newDepthHistory(),
+		emailReports:      &emailReportTracker{lastSentAt: time.Now()},
+		dlqArrivals:       &dlqArrivalTracker{},
+		notifiers:         newNotifiers(config),
+		idempotency:       newIdempotencyCache(),
+		sendRetryDelay:    200 * time.Millisecond,
+		forwardRetryDelay: time.Second,
+	}
+	if config.EmailReport.Enabled() {
+		service.mailer = NewSMTPMailer(config.EmailReport.SMTP, config.EmailReport.From)
+	}
+	if config.WebPush.Enabled() {
+		pusher, err := NewVAPIDPusher(config.WebPush)
+		if err != nil {
+			slog.Warn("browser push notifications are disabled", slog.Any("error", err))
+		} else {
+			service.pusher = pusher
+		}
+	}
+	return service
 }
 
 // Queues retrieves queue summaries.
 func (s *SqsServiceImpl) Queues(ctx context.Context) ([]QueueSummary, error) {
-	return s.repo.ListQueues(ctx)
-}
-
-// CreateQueue validates the request and delegates queue creation.
-func (s *SqsServiceImpl) CreateQueue(ctx context.Context, input CreateQueueInput) (CreateQueueResult, error) {
-	name := strings.TrimSpace(input.Name)
-	if name == "" {
-		return CreateQueueResult{}, errors.New("queue name is required")
+	queues, err := s.repo.ListQueues(ctx)
+	if err != nil {
+		return nil, err
 	}
+	s.trackDepths(queues)
+	return queues, nil
+}
 
-	queueType := input.Type
-	if queueType == "" {
-		queueType = QueueTypeStandard
+// QueuesPage retrieves one page of the queue summaries as SQS pages them: maxResults caps the page
+// and nextToken is the NextToken of the previous page. The depth history is not updated from a
+// page, since it would forget the queues on the other pages.
+func (s *SqsServiceImpl) QueuesPage(ctx context.Context, maxResults int, nextToken string) (QueueSummaryPage, error) {
+	if maxResults < 0 || maxResults > maxQueueListLimit {
+		return QueueSummaryPage{}, errors.Newf("maxResults must be between 1 and %d", maxQueueListLimit)
 	}
-
-	if queueType == QueueTypeFIFO && !strings.HasSuffix(name, ".fifo") {
-		name += ".fifo"
+	page, err := s.repo.ListQueuesPage(ctx, ListQueuesPageRepositoryInput{MaxResults: int32(maxResults), NextToken: nextToken})
+	if err != nil {
+		return QueueSummaryPage{}, err
 	}
+	s.describeDepths(page.Queues)
+	return page, nil
+}
 
-	if queueType == QueueTypeStandard && strings.HasSuffix(name, ".fifo") {
-		queueType = QueueTypeFIFO
+// CreateQueue validates the request and delegates queue creation. The configured default tags
+// are applied to the new queue.
+func (s *SqsServiceImpl) CreateQueue(ctx context.Context, input CreateQueueInput) (CreateQueueResult, error) {
+	name, queueType := normalizeQueueName(input.Name, input.Type)
+	if name == "" {
+		return CreateQueueResult{}, errors.New("queue name is required")
 	}
 
 	if queueType != QueueTypeStandard && queueType != QueueTypeFIFO {
 		return CreateQueueResult{}, errors.New("invalid queue type")
 	}
 
+	if err := validateQueueName(name); err != nil {
+		return CreateQueueResult{}, err
+	}
+
 	attributes := map[string]string{}
 
 	if input.DelaySeconds != nil {
@@ -73,21 +283,64 @@ func (s *SqsServiceImpl) CreateQueue(ctx context.Context, input CreateQueueInput
 		attributes["VisibilityTimeout"] = strconv.FormatInt(int64(*input.VisibilityTimeout), 10)
 	}
 
+	if input.MaximumMessageSize != nil {
+		attributes["MaximumMessageSize"] = strconv.FormatInt(int64(*input.MaximumMessageSize), 10)
+	}
+
+	if input.ReceiveMessageWaitTimeSeconds != nil {
+		attributes["ReceiveMessageWaitTimeSeconds"] = strconv.FormatInt(int64(*input.ReceiveMessageWaitTimeSeconds), 10)
+	}
+
 	switch queueType {
 	case QueueTypeFIFO:
 		attributes["FifoQueue"] = "true"
 		if input.ContentBasedDeduplication {
 			attributes["ContentBasedDeduplication"] = "true"
 		}
+		if err := validateFifoThroughput(input.Throughput); err != nil {
+			return CreateQueueResult{}, err
+		}
+		if input.Throughput.DeduplicationScope != "" {
+			attributes["DeduplicationScope"] = input.Throughput.DeduplicationScope
+		}
+		if input.Throughput.FifoThroughputLimit != "" {
+			attributes["FifoThroughputLimit"] = input.Throughput.FifoThroughputLimit
+		}
 	case QueueTypeStandard:
 		if input.ContentBasedDeduplication {
 			return CreateQueueResult{}, errors.New("content-based deduplication is only available for FIFO queues")
 		}
+		if input.Throughput != (FifoThroughput{}) {
+			return CreateQueueResult{}, errors.New("throughput settings are only available for FIFO queues")
+		}
+	}
+
+	kmsKeyID := strings.TrimSpace(input.KmsMasterKeyID)
+	if strings.ContainsFunc(kmsKeyID, unicode.IsSpace) {
+		return CreateQueueResult{}, errors.New("KMS key must not contain spaces")
+	}
+	if kmsKeyID != "" {
+		attributes["KmsMasterKeyId"] = kmsKeyID
+	}
+	if input.KmsDataKeyReusePeriodSeconds != nil {
+		if kmsKeyID == "" {
+			return CreateQueueResult{}, errors.New("data key reuse period requires a KMS key")
+		}
+		attributes["KmsDataKeyReusePeriodSeconds"] = strconv.FormatInt(int64(*input.KmsDataKeyReusePeriodSeconds), 10)
+	}
+
+	if input.RedrivePolicy != nil {
+		encoded, err := encodeRedrivePolicy(name, queueType, *input.RedrivePolicy)
+		if err != nil {
+			return CreateQueueResult{}, err
+		}
+		attributes["RedrivePolicy"] = encoded
 	}
 
 	queueURL, err := s.repo.CreateQueue(ctx, CreateQueueRepositoryInput{
 		Name:       name,
 		Attributes: attributes,
+		Tags:       s.creatableTags(ctx, maps.Clone(s.config.DefaultTags)),
 	})
 	if err != nil {
 		return CreateQueueResult{}, err
@@ -96,6 +349,71 @@ func (s *SqsServiceImpl) CreateQueue(ctx context.Context, input CreateQueueInput
 	return CreateQueueResult{QueueURL: queueURL}, nil
 }
 
+// CheckQueueName reports whether a queue called name could be created, without creating it.
+// Names the queue policy forbids are reported as invalid rather than as an error.
+func (s *SqsServiceImpl) CheckQueueName(ctx context.Context, name string, queueType QueueType) (QueueNameCheck, error) {
+	name, _ = normalizeQueueName(name, queueType)
+	check := QueueNameCheck{Name: name}
+	if name == "" {
+		check.Message = "queue name is required"
+		return check, nil
+	}
+	if err := validateQueueName(name); err != nil {
+		check.Message = err.Error()
+		return check, nil
+	}
+
+	_, exists, err := s.repo.QueueURL(ctx, name)
+	if errors.Is(err, ErrQueueAccessDenied) {
+		check.Message = err.Error()
+		return check, nil
+	}
+	if err != nil {
+		return QueueNameCheck{}, err
+	}
+
+	check.Valid = true
+	check.Available = !exists
+	if exists {
+		check.Message = fmt.Sprintf("a queue named %q already exists", name)
+	}
+	return check, nil
+}
+
+// normalizeQueueName trims name and reconciles it with queueType: FIFO queues get the .fifo
+// suffix, and a .fifo name makes a standard request FIFO.
+func normalizeQueueName(name string, queueType QueueType) (string, QueueType) {
+	name = strings.TrimSpace(name)
+	if queueType == "" {
+		queueType = QueueTypeStandard
+	}
+	if name == "" {
+		return "", queueType
+	}
+
+	if queueType == QueueTypeFIFO && !strings.HasSuffix(name, ".fifo") {
+		name += ".fifo"
+	}
+	if queueType == QueueTypeStandard && strings.HasSuffix(name, ".fifo") {
+		queueType = QueueTypeFIFO
+	}
+	return name, queueType
+}
+
+// validateQueueName applies the SQS naming rules: up to 80 characters of letters, digits,
+// hyphens and underscores, with .fifo counted towards the limit for FIFO queues.
+func validateQueueName(name string) error {
+	if len(name) > 80 {
+		return errors.New("queue name must be at most 80 characters")
+	}
+	for _, r := range strings.TrimSuffix(name, ".fifo") {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return errors.New("queue name may only contain letters, digits, hyphens and underscores")
+		}
+	}
+	return nil
+}
+
 // QueueDetail returns detailed information for a specific queue URL.
 func (s *SqsServiceImpl) QueueDetail(ctx context.Context, queueURL string) (QueueDetail, error) {
 	if strings.TrimSpace(queueURL) == "" {
@@ -106,12 +424,29 @@ func (s *SqsServiceImpl) QueueDetail(ctx context.Context, queueURL string) (Queu
 }
 
 // DeleteQueue deletes the queue identified by queueURL.
-func (s *SqsServiceImpl) DeleteQueue(ctx context.Context, queueURL string) error {
+// The queue's attributes and tags are moved to the trash first when local state is available, so
+// the returned entry can be used to restore it.
+func (s *SqsServiceImpl) DeleteQueue(ctx context.Context, queueURL string) (TrashedQueue, error) {
 	if strings.TrimSpace(queueURL) == "" {
-		return errors.New("queue url is required")
+		return TrashedQueue{}, errors.New("queue url is required")
+	}
+
+	if s.store == nil {
+		return TrashedQueue{}, s.repo.DeleteQueue(ctx, queueURL)
+	}
+
+	trashed, err := s.trashQueue(ctx, queueURL)
+	if err != nil {
+		return TrashedQueue{}, err
 	}
 
-	return s.repo.DeleteQueue(ctx, queueURL)
+	if err := s.repo.DeleteQueue(ctx, queueURL); err != nil {
+		if discardErr := s.store.DeleteTrashedQueue(trashed.ID); discardErr != nil {
+			slog.WarnContext(ctx, "failed to remove trash entry of undeleted queue", slog.String("trash_id", trashed.ID), slog.Any("error", discardErr))
+		}
+		return TrashedQueue{}, err
+	}
+	return trashed, nil
 }
 
 // PurgeQueue removes all messages currently stored in the queue.
@@ -124,50 +459,274 @@ func (s *SqsServiceImpl) PurgeQueue(ctx context.Context, queueURL string) error
 }
 
 // SendMessage validates input and delegates to the repository to enqueue a message.
-func (s *SqsServiceImpl) SendMessage(ctx context.Context, input SendMessageInput) error {
+// For FIFO queues it warns when the deduplication ID was already used within the deduplication window,
+// in the same message group when the queue deduplicates per message group.
+func (s *SqsServiceImpl) SendMessage(ctx context.Context, input SendMessageInput) (SendMessageResult, error) {
 	queueURL := strings.TrimSpace(input.QueueURL)
 	if queueURL == "" {
-		return errors.New("queue url is required")
+		return SendMessageResult{}, errors.New("queue url is required")
 	}
 
-	if strings.TrimSpace(input.Body) == "" {
-		return errors.New("message body is required")
+	message, sentAttributes, err := prepareMessage(queueURL, input)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+
+	idempotencyKey := strings.TrimSpace(input.IdempotencyKey)
+	cached, replay, err := s.idempotency.begin(idempotencyKey, message)
+	if err != nil {
+		return SendMessageResult{}, err
+	}
+	if replay {
+		cached.Replayed = true
+		return cached, nil
 	}
 
-	isFIFO := strings.HasSuffix(queueURL, ".fifo")
+	slog.DebugContext(ctx, "sending message",
+		slog.String("queue_url", queueURL),
+		slog.String("body", input.Body),
+		slog.Any("attributes", sentAttributes),
+	)
 
-	messageGroupID := strings.TrimSpace(input.MessageGroupID)
-	if isFIFO && messageGroupID == "" {
-		return errors.New("message group id is required for fifo queues")
+	if err := s.repo.SendMessage(ctx, message); err != nil {
+		s.idempotency.abandon(idempotencyKey)
+		return SendMessageResult{}, err
 	}
 
-	messageDeduplicationID := strings.TrimSpace(input.MessageDeduplicationID)
+	s.rememberSendDefaults(queueURL, SendDefaults{
+		MessageGroupID: message.MessageGroupID,
+		DelaySeconds:   message.DelaySeconds,
+		Attributes:     sentAttributes,
+	})
+	s.discardDraft(queueURL)
+
+	var result SendMessageResult
+	if strings.HasSuffix(queueURL, ".fifo") {
+		// Without an explicit ID the send only succeeds on queues with content-based deduplication,
+		// where SQS derives the ID from the body hash.
+		dedupID := message.MessageDeduplicationID
+		if dedupID == "" {
+			dedupID = contentDeduplicationID(input.Body)
+		}
+		firstSentAt, used := s.dedup.lastUsed(queueURL, dedupID, message.MessageGroupID)
+		if !used {
+			// High throughput queues only drop a reused ID within the same message group, so the
+			// scope is only looked up when the ID was sent to another group.
+			firstSentAt, used = s.dedup.lastUsed(queueURL, dedupID, "")
+			used = used && !s.deduplicatesPerMessageGroup(ctx, queueURL)
+		}
+		if used {
+			result.Warning = duplicateSendWarning(message.MessageDeduplicationID, s.dedup.now().Sub(firstSentAt))
+		}
+		s.dedup.record(queueURL, dedupID, message.MessageGroupID)
+	}
+
+	s.idempotency.complete(idempotencyKey, result)
+	return result, nil
+}
+
+// deduplicatesPerMessageGroup reports whether the FIFO queue's DeduplicationScope is messageGroup.
+// When it cannot be read, the queue is taken to deduplicate across groups, as SQS does by default.
+func (s *SqsServiceImpl) deduplicatesPerMessageGroup(ctx context.Context, queueURL string) bool {
+	attributes, err := s.repo.GetQueueAttributes(ctx, queueURL, []string{"DeduplicationScope"})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to read deduplication scope", slog.String("queue_url", queueURL), slog.Any("error", err))
+		return false
+	}
+	return attributes["DeduplicationScope"] == DeduplicationScopeMessageGroup
+}
+
+// prepareMessage validates a message for queueURL and returns the repository input together with
+// the attributes that will be sent, in their original order.
+func prepareMessage(queueURL string, input SendMessageInput) (SendMessageRepositoryInput, []MessageAttribute, error) {
+	if strings.TrimSpace(input.Body) == "" {
+		return SendMessageRepositoryInput{}, nil, errors.New("message body is required")
+	}
+
+	messageGroupID := strings.TrimSpace(input.MessageGroupID)
+	if strings.HasSuffix(queueURL, ".fifo") && messageGroupID == "" {
+		return SendMessageRepositoryInput{}, nil, errors.New("message group id is required for fifo queues")
+	}
 
 	var delay *int32
 	if input.DelaySeconds != nil {
 		if *input.DelaySeconds < 0 || *input.DelaySeconds > 900 {
-			return errors.New("delay seconds must be between 0 and 900")
+			return SendMessageRepositoryInput{}, nil, errors.New("delay seconds must be between 0 and 900")
 		}
 		delay = input.DelaySeconds
 	}
 
 	attributes := make(map[string]string)
+	var dataTypes map[string]string
+	sentAttributes := make([]MessageAttribute, 0, len(input.Attributes))
 	for _, attr := range input.Attributes {
 		name := strings.TrimSpace(attr.Name)
 		if name == "" {
 			continue
 		}
+		dataType, err := checkMessageAttributeValue(name, attr.DataType, attr.Value)
+		if err != nil {
+			return SendMessageRepositoryInput{}, nil, err
+		}
 		attributes[name] = attr.Value
+		if dataType != "String" {
+			if dataTypes == nil {
+				dataTypes = make(map[string]string)
+			}
+			dataTypes[name] = dataType
+		} else {
+			dataType = ""
+		}
+		sentAttributes = append(sentAttributes, MessageAttribute{Name: name, Value: attr.Value, DataType: dataType})
 	}
 
-	return s.repo.SendMessage(ctx, SendMessageRepositoryInput{
+	return SendMessageRepositoryInput{
 		QueueURL:               queueURL,
 		Body:                   input.Body,
 		MessageGroupID:         messageGroupID,
-		MessageDeduplicationID: messageDeduplicationID,
+		MessageDeduplicationID: strings.TrimSpace(input.MessageDeduplicationID),
 		DelaySeconds:           delay,
 		Attributes:             attributes,
-	})
+		AttributeTypes:         dataTypes,
+	}, sentAttributes, nil
+}
+
+// numberAttributePattern matches the numbers SQS accepts as Number attribute values.
+var numberAttributePattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+
+// checkMessageAttributeValue validates the value of the attribute called name against its data
+// type and returns the type, String when dataType is blank. A custom type such as Number.float is
+// checked as its base type.
+func checkMessageAttributeValue(name, dataType, value string) (string, error) {
+	dataType = strings.TrimSpace(dataType)
+	if dataType == "" {
+		dataType = "String"
+	}
+	switch messageAttributeBaseType(dataType) {
+	case "String":
+	case "Number":
+		if !numberAttributePattern.MatchString(value) {
+			return "", errors.Newf("attribute %s must be a number", name)
+		}
+	case "Binary":
+		if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+			return "", errors.Newf("attribute %s must be base64 encoded binary data", name)
+		}
+	default:
+		return "", errors.Newf("attribute %s must have the type String, Number or Binary", name)
+	}
+	if len(dataType) > 256 {
+		return "", errors.Newf("the type of attribute %s must be at most 256 characters", name)
+	}
+	return dataType, nil
+}
+
+// messageAttributeBaseType returns String, Number or Binary of a data type that may carry a custom
+// suffix, as in Number.float.
+func messageAttributeBaseType(dataType string) string {
+	base, _, _ := strings.Cut(dataType, ".")
+	return base
+}
+
+// rememberSendDefaults stores the values of a successful send so the form can be prefilled next time.
+// Failing to persist them must not fail the send itself.
+func (s *SqsServiceImpl) rememberSendDefaults(queueURL string, defaults SendDefaults) {
+	if s.store == nil {
+		return
+	}
+	if err := s.store.SaveSendDefaults(queueURL, defaults); err != nil {
+		slog.Warn("failed to save send defaults", slog.String("queue_url", queueURL), slog.Any("error", err))
+	}
+}
+
+// discardDraft removes the draft of a queue once its message was sent, so the page does not offer
+// the sent body again before the next autosave. Failing to remove it must not fail the send itself.
+func (s *SqsServiceImpl) discardDraft(queueURL string) {
+	if s.store == nil {
+		return
+	}
+	if err := s.store.DeleteDraft(queueURL); err != nil {
+		slog.Warn("failed to discard draft", slog.String("queue_url", queueURL), slog.Any("error", err))
+	}
+}
+
+// SendDefaults returns the last-used send form values for a queue, or empty defaults if none were stored.
+func (s *SqsServiceImpl) SendDefaults(_ context.Context, queueURL string) (SendDefaults, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return SendDefaults{}, errors.New("queue url is required")
+	}
+	if s.store == nil {
+		return SendDefaults{}, nil
+	}
+
+	defaults, _, err := s.store.SendDefaults(queueURL)
+	if err != nil {
+		return SendDefaults{}, err
+	}
+	return defaults, nil
+}
+
+// maxMessageBodyBytes is the largest message body SQS accepts.
+const maxMessageBodyBytes = 256 * 1024
+
+// Draft returns the autosaved send form draft for a queue.
+func (s *SqsServiceImpl) Draft(_ context.Context, queueURL string) (MessageDraft, bool, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return MessageDraft{}, false, errors.New("queue url is required")
+	}
+	if s.store == nil {
+		return MessageDraft{}, false, nil
+	}
+
+	return s.store.Draft(queueURL)
+}
+
+// SaveDraft stores the in-progress send form for a queue. A draft without a body or attributes
+// is treated as cleared and removed from the store.
+func (s *SqsServiceImpl) SaveDraft(_ context.Context, queueURL string, draft MessageDraft) (MessageDraft, error) {
+	queueURL = strings.TrimSpace(queueURL)
+	if queueURL == "" {
+		return MessageDraft{}, errors.New("queue url is required")
+	}
+	if len(draft.Body) > maxMessageBodyBytes {
+		return MessageDraft{}, errors.New("draft body exceeds the 256 KB message size limit")
+	}
+	if s.store == nil {
+		return MessageDraft{}, errors.New("local state store is not configured")
+	}
+
+	attributes := make([]MessageAttribute, 0, len(draft.Attributes))
+	for _, attr := range draft.Attributes {
+		if strings.TrimSpace(attr.Name) == "" && attr.Value == "" {
+			continue
+		}
+		attributes = append(attributes, attr)
+	}
+
+	if strings.TrimSpace(draft.Body) == "" && len(attributes) == 0 {
+		return MessageDraft{}, s.store.DeleteDraft(queueURL)
+	}
+
+	saved := MessageDraft{
+		Body:       draft.Body,
+		Attributes: attributes,
+		SavedAt:    time.Now().UTC(),
+	}
+	if err := s.store.SaveDraft(queueURL, saved); err != nil {
+		return MessageDraft{}, err
+	}
+
+	return saved, nil
+}
+
+func duplicateSendWarning(messageDeduplicationID string, age time.Duration) string {
+	ago := age.Round(time.Second)
+	if messageDeduplicationID == "" {
+		return fmt.Sprintf("An identical message body was sent %s ago. SQS accepted the message but will discard it as a duplicate until the 5-minute deduplication window expires.", ago)
+	}
+	return fmt.Sprintf("Deduplication ID %q was already used %s ago. SQS accepted the message but will discard it as a duplicate until the 5-minute deduplication window expires.", messageDeduplicationID, ago)
 }
 
 // ReceiveMessages retrieves messages from SQS applying sensible defaults.
@@ -209,16 +768,143 @@ func (s *SqsServiceImpl) ReceiveMessages(ctx context.Context, input ReceiveMessa
 		}
 	}
 
-	messages, err := s.repo.ReceiveMessages(ctx, ReceiveMessagesRepositoryInput{
-		QueueURL:        queueURL,
-		MaxMessages:     maxMessages,
-		WaitTimeSeconds: waitTime,
+	if input.GroupByMessageGroup && !strings.HasSuffix(queueURL, ".fifo") {
+		return ReceiveMessagesResult{}, errors.New("message grouping is only available for fifo queues")
+	}
+
+	visibility := editableQueueAttributes["VisibilityTimeout"]
+	if input.VisibilityTimeoutProvided && (int64(input.VisibilityTimeout) < visibility.min || int64(input.VisibilityTimeout) > visibility.max) {
+		return ReceiveMessagesResult{}, errors.Newf("visibility timeout must be between %d and %d seconds", visibility.min, visibility.max)
+	}
+
+	var match func(body string) bool
+	if input.Filter != (MessageFilter{}) {
+		compiled, err := compileMessageFilter(input.Filter)
+		if err != nil {
+			return ReceiveMessagesResult{}, err
+		}
+		match = compiled
+	}
+	var project func(body string) json.RawMessage
+	if len(input.Projection) > 0 {
+		compiled, err := compileJSONProjection(input.Projection)
+		if err != nil {
+			return ReceiveMessagesResult{}, err
+		}
+		project = compiled
+	}
+
+	pollCtx, done, err := s.polls.track(ctx)
+	if err != nil {
+		return ReceiveMessagesResult{}, err
+	}
+	defer done()
+
+	// ReceiveMessage cannot be asked for a visibility timeout of 0, so a peek receives with the
+	// queue's timeout and releases the messages afterwards.
+	messages, err := s.repo.ReceiveMessages(pollCtx, ReceiveMessagesRepositoryInput{
+		QueueURL:          queueURL,
+		MaxMessages:       maxMessages,
+		WaitTimeSeconds:   waitTime,
+		VisibilityTimeout: input.VisibilityTimeout,
 	})
 	if err != nil {
+		if pollCtx.Err() != nil && ctx.Err() == nil {
+			return ReceiveMessagesResult{}, errors.WithStack(ErrShuttingDown)
+		}
 		return ReceiveMessagesResult{}, err
 	}
 
-	return ReceiveMessagesResult{Messages: messages}, nil
+	// A peek releases every message; a filter releases the ones it leaves out.
+	peek := input.VisibilityTimeoutProvided && input.VisibilityTimeout == 0
+	kept := make([]ReceivedMessage, 0, len(messages))
+	release := make(map[string]string)
+	for _, message := range messages {
+		matched := match == nil || match(message.Body)
+		if matched {
+			kept = append(kept, message)
+		}
+		if peek || !matched {
+			release[message.ID] = message.ReceiptHandle
+		}
+	}
+	if len(release) > 0 {
+		s.restoreVisibility(ctx, queueURL, release)
+	}
+
+	result := ReceiveMessagesResult{
+		Messages:    kept,
+		DeadLetter:  s.deadLetterContexts(ctx, kept),
+		FilteredOut: len(messages) - len(kept),
+	}
+	if project != nil {
+		result.Projections = make(map[string]json.RawMessage)
+		for _, message := range kept {
+			if projection := project(message.Body); projection != nil {
+				result.Projections[message.ID] = projection
+			}
+		}
+	}
+	if input.GroupByMessageGroup {
+		result.Groups = groupMessagesBySequence(kept)
+	}
+
+	return result, nil
+}
+
+// groupMessagesBySequence buckets FIFO messages by MessageGroupId and orders each bucket by SequenceNumber.
+// Groups are returned in the order in which their first message was received.
+func groupMessagesBySequence(messages []ReceivedMessage) []MessageGroup {
+	groups := make([]MessageGroup, 0)
+	index := make(map[string]int)
+	for _, message := range messages {
+		groupID := messageAttributeValue(message, "MessageGroupId")
+		i, ok := index[groupID]
+		if !ok {
+			i = len(groups)
+			index[groupID] = i
+			groups = append(groups, MessageGroup{GroupID: groupID})
+		}
+		groups[i].Messages = append(groups[i].Messages, message)
+	}
+
+	for i := range groups {
+		received := groups[i].Messages
+		ordered := make([]ReceivedMessage, len(received))
+		copy(ordered, received)
+		sort.SliceStable(ordered, func(a, b int) bool {
+			return compareSequenceNumbers(
+				messageAttributeValue(ordered[a], "SequenceNumber"),
+				messageAttributeValue(ordered[b], "SequenceNumber"),
+			) < 0
+		})
+
+		inOrder := true
+		for j := range received {
+			if received[j].ID != ordered[j].ID {
+				inOrder = false
+				break
+			}
+		}
+
+		groups[i].Messages = ordered
+		groups[i].InOrder = inOrder
+	}
+
+	return groups
+}
+
+// compareSequenceNumbers compares two decimal SQS sequence numbers without overflowing int64.
+func compareSequenceNumbers(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
 }
 
 // DeleteMessage removes a message from the queue using its receipt handle.
@@ -238,3 +924,100 @@ func (s *SqsServiceImpl) DeleteMessage(ctx context.Context, input DeleteMessageI
 		ReceiptHandle: receiptHandle,
 	})
 }
+
+// maxMergedQueues bounds how many queues a single merged receive may poll.
+const maxMergedQueues = 10
+
+// ReceiveMergedMessages polls several queues concurrently and merges their messages by sent time.
+// A failure on one queue does not abort the others; it is reported alongside the messages instead.
+func (s *SqsServiceImpl) ReceiveMergedMessages(ctx context.Context, input MergedReceiveInput) (MergedReceiveResult, error) {
+	queueURLs := make([]string, 0, len(input.QueueURLs))
+	seen := make(map[string]struct{}, len(input.QueueURLs))
+	for _, raw := range input.QueueURLs {
+		queueURL := strings.TrimSpace(raw)
+		if queueURL == "" {
+			continue
+		}
+		if _, ok := seen[queueURL]; ok {
+			continue
+		}
+		seen[queueURL] = struct{}{}
+		queueURLs = append(queueURLs, queueURL)
+	}
+
+	if len(queueURLs) == 0 {
+		return MergedReceiveResult{}, errors.New("at least one queue url is required")
+	}
+	if len(queueURLs) > maxMergedQueues {
+		return MergedReceiveResult{}, errors.Newf("at most %d queues can be polled at once", maxMergedQueues)
+	}
+
+	type queueResult struct {
+		messages []ReceivedMessage
+		err      error
+	}
+
+	results := make([]queueResult, len(queueURLs))
+	var wg sync.WaitGroup
+	for i, queueURL := range queueURLs {
+		wg.Add(1)
+		go func(i int, queueURL string) {
+			defer wg.Done()
+			result, err := s.ReceiveMessages(ctx, ReceiveMessagesInput{
+				QueueURL:            queueURL,
+				MaxMessages:         input.MaxMessages,
+				WaitTimeSeconds:     input.WaitTimeSeconds,
+				MaxMessagesProvided: input.MaxMessagesProvided,
+				WaitTimeProvided:    input.WaitTimeProvided,
+			})
+			results[i] = queueResult{messages: result.Messages, err: err}
+		}(i, queueURL)
+	}
+	wg.Wait()
+
+	merged := MergedReceiveResult{Messages: make([]SourcedMessage, 0)}
+	for i, queueURL := range queueURLs {
+		if results[i].err != nil {
+			merged.Failures = append(merged.Failures, QueueReceiveFailure{QueueURL: queueURL, Error: results[i].err.Error()})
+			continue
+		}
+		queueName := extractQueueName(queueURL)
+		for _, message := range results[i].messages {
+			merged.Messages = append(merged.Messages, SourcedMessage{
+				ReceivedMessage: message,
+				QueueURL:        queueURL,
+				QueueName:       queueName,
+			})
+		}
+	}
+
+	sort.SliceStable(merged.Messages, func(i, j int) bool {
+		return messageSentAt(merged.Messages[i].ReceivedMessage).Before(messageSentAt(merged.Messages[j].ReceivedMessage))
+	})
+
+	return merged, nil
+}
+
+// messageAttributeValue looks up a message or system attribute by name.
+func messageAttributeValue(message ReceivedMessage, name string) string {
+	for _, attribute := range message.Attributes {
+		if attribute.Name == name {
+			return attribute.Value
+		}
+	}
+	return ""
+}
+
+// messageSentAt returns the SentTimestamp of a message, or the zero time when it is unavailable.
+func messageSentAt(message ReceivedMessage) time.Time {
+	return messageTimestamp(message, "SentTimestamp")
+}
+
+// messageTimestamp parses a timestamp system attribute, or returns the zero time when it is unavailable.
+func messageTimestamp(message ReceivedMessage, name string) time.Time {
+	value, err := time.Parse(time.RFC3339, messageAttributeValue(message, name))
+	if err != nil {
+		return time.Time{}
+	}
+	return value
+}
@@ -2,11 +2,17 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func int32Ptr(v int32) *int32 {
@@ -158,6 +164,95 @@ func TestSqsServiceImpl_CreateQueue(t *testing.T) {
 				repo.AssertNotCalled(t, "CreateQueue", mock.Anything, mock.Anything)
 			},
 		},
+		{
+			name: "returns error when queue name has invalid characters",
+			args: args{
+				ctx: context.Background(),
+				input: CreateQueueInput{
+					Name: "orders.v2",
+				},
+			},
+			wantErr: "queue name may only contain letters, digits, hyphens and underscores",
+			assertMock: func(t *testing.T, repo *MockSqsRepository) {
+				repo.AssertNotCalled(t, "CreateQueue", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "sets maximum message size and receive wait time",
+			args: args{
+				ctx: context.Background(),
+				input: CreateQueueInput{
+					Name:                          "orders",
+					MaximumMessageSize:            int32Ptr(65536),
+					ReceiveMessageWaitTimeSeconds: int32Ptr(20),
+				},
+			},
+			arrange: func(t *testing.T, repo *MockSqsRepository, args args) {
+				repo.EXPECT().
+					CreateQueue(mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, input CreateQueueRepositoryInput) {
+						assert.Equal(t, map[string]string{
+							"MaximumMessageSize":            "65536",
+							"ReceiveMessageWaitTimeSeconds": "20",
+						}, input.Attributes)
+					}).
+					Return("https://sqs.local/orders", nil).
+					Once()
+			},
+			want: CreateQueueResult{QueueURL: "https://sqs.local/orders"},
+		},
+		{
+			name: "creates fifo queue in high throughput mode",
+			args: args{
+				ctx: context.Background(),
+				input: CreateQueueInput{
+					Name: "payments.fifo",
+					Type: QueueTypeFIFO,
+					Throughput: FifoThroughput{
+						DeduplicationScope:  DeduplicationScopeMessageGroup,
+						FifoThroughputLimit: FifoThroughputLimitPerMessageGroupID,
+					},
+				},
+			},
+			arrange: func(t *testing.T, repo *MockSqsRepository, args args) {
+				repo.EXPECT().
+					CreateQueue(mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, input CreateQueueRepositoryInput) {
+						assert.Equal(t, map[string]string{
+							"FifoQueue":           "true",
+							"DeduplicationScope":  "messageGroup",
+							"FifoThroughputLimit": "perMessageGroupId",
+						}, input.Attributes)
+					}).
+					Return("https://sqs.local/payments.fifo", nil).
+					Once()
+			},
+			want: CreateQueueResult{QueueURL: "https://sqs.local/payments.fifo"},
+		},
+		{
+			name: "returns error when per message group limit lacks the matching scope",
+			args: args{
+				ctx: context.Background(),
+				input: CreateQueueInput{
+					Name:       "payments.fifo",
+					Type:       QueueTypeFIFO,
+					Throughput: FifoThroughput{FifoThroughputLimit: FifoThroughputLimitPerMessageGroupID},
+				},
+			},
+			wantErr: "a perMessageGroupId throughput limit requires the messageGroup deduplication scope",
+		},
+		{
+			name: "returns error when throughput settings requested on standard queue",
+			args: args{
+				ctx: context.Background(),
+				input: CreateQueueInput{
+					Name:       "orders",
+					Type:       QueueTypeStandard,
+					Throughput: FifoThroughput{DeduplicationScope: DeduplicationScopeQueue},
+				},
+			},
+			wantErr: "throughput settings are only available for FIFO queues",
+		},
 		{
 			name: "returns error when content based deduplication requested on standard queue",
 			args: args{
@@ -205,6 +300,81 @@ func TestSqsServiceImpl_CreateQueue(t *testing.T) {
 			},
 			want: CreateQueueResult{QueueURL: "https://sqs.local/events.fifo"},
 		},
+		{
+			name: "encrypts with a customer-managed KMS key",
+			args: args{
+				ctx: context.Background(),
+				input: CreateQueueInput{
+					Name:                         "orders",
+					KmsMasterKeyID:               " alias/orders ",
+					KmsDataKeyReusePeriodSeconds: int32Ptr(600),
+				},
+			},
+			arrange: func(t *testing.T, repo *MockSqsRepository, args args) {
+				repo.EXPECT().
+					CreateQueue(mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, input CreateQueueRepositoryInput) {
+						assert.Equal(t, map[string]string{
+							"KmsMasterKeyId":               "alias/orders",
+							"KmsDataKeyReusePeriodSeconds": "600",
+						}, input.Attributes)
+					}).
+					Return("https://sqs.local/orders", nil).
+					Once()
+			},
+			want: CreateQueueResult{QueueURL: "https://sqs.local/orders"},
+		},
+		{
+			name: "returns error when data key reuse period is set without a KMS key",
+			args: args{
+				ctx: context.Background(),
+				input: CreateQueueInput{
+					Name:                         "orders",
+					KmsDataKeyReusePeriodSeconds: int32Ptr(600),
+				},
+			},
+			wantErr: "data key reuse period requires a KMS key",
+			assertMock: func(t *testing.T, repo *MockSqsRepository) {
+				repo.AssertNotCalled(t, "CreateQueue", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "sets redrive policy",
+			args: args{
+				ctx: context.Background(),
+				input: CreateQueueInput{
+					Name:          "orders",
+					RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", MaxReceiveCount: 5},
+				},
+			},
+			arrange: func(t *testing.T, repo *MockSqsRepository, args args) {
+				repo.EXPECT().
+					CreateQueue(mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, input CreateQueueRepositoryInput) {
+						assert.Equal(t, map[string]string{
+							"RedrivePolicy": `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:000000000000:orders-dlq","maxReceiveCount":5}`,
+						}, input.Attributes)
+					}).
+					Return("https://sqs.local/orders", nil).
+					Once()
+			},
+			want: CreateQueueResult{QueueURL: "https://sqs.local/orders"},
+		},
+		{
+			name: "returns error when dead-letter queue type differs",
+			args: args{
+				ctx: context.Background(),
+				input: CreateQueueInput{
+					Name:          "orders",
+					Type:          QueueTypeFIFO,
+					RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:aws:sqs:us-east-1:000000000000:orders-dlq", MaxReceiveCount: 5},
+				},
+			},
+			wantErr: "the dead-letter queue of a FIFO queue must be a FIFO queue",
+			assertMock: func(t *testing.T, repo *MockSqsRepository) {
+				repo.AssertNotCalled(t, "CreateQueue", mock.Anything, mock.Anything)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -232,6 +402,123 @@ func TestSqsServiceImpl_CreateQueue(t *testing.T) {
 	}
 }
 
+func TestSqsServiceImpl_CreateQueue_DefaultTags(t *testing.T) {
+	repo := NewMockSqsRepository(t)
+	defaultTags := map[string]string{"created-by": "sqs-gui", "environment": "dev"}
+	service := &SqsServiceImpl{repo: repo, config: ServiceConfig{DefaultTags: defaultTags}, capabilities: &capabilityCache{}}
+
+	repo.EXPECT().
+		CreateQueue(mock.Anything, CreateQueueRepositoryInput{
+			Name:       "orders",
+			Attributes: map[string]string{},
+			Tags:       map[string]string{"created-by": "sqs-gui", "environment": "dev"},
+		}).
+		Return("https://sqs.local/orders", nil).
+		Once()
+
+	got, err := service.CreateQueue(context.Background(), CreateQueueInput{Name: "orders"})
+	require.NoError(t, err)
+	assert.Equal(t, CreateQueueResult{QueueURL: "https://sqs.local/orders"}, got)
+}
+
+func TestSqsServiceImpl_CheckQueueName(t *testing.T) {
+	tests := []struct {
+		name      string
+		queueName string
+		queueType QueueType
+		arrange   func(repo *MockSqsRepository)
+		want      QueueNameCheck
+		wantErr   string
+	}{
+		{
+			name:      "reports free name as available",
+			queueName: " orders ",
+			arrange: func(repo *MockSqsRepository) {
+				repo.EXPECT().QueueURL(mock.Anything, "orders").Return("", false, nil).Once()
+			},
+			want: QueueNameCheck{Name: "orders", Valid: true, Available: true},
+		},
+		{
+			name:      "checks fifo name with suffix",
+			queueName: "payments",
+			queueType: QueueTypeFIFO,
+			arrange: func(repo *MockSqsRepository) {
+				repo.EXPECT().
+					QueueURL(mock.Anything, "payments.fifo").
+					Return("https://sqs.local/payments.fifo", true, nil).
+					Once()
+			},
+			want: QueueNameCheck{
+				Name:    "payments.fifo",
+				Valid:   true,
+				Message: `a queue named "payments.fifo" already exists`,
+			},
+		},
+		{
+			name:      "rejects blank name",
+			queueName: "  ",
+			want:      QueueNameCheck{Message: "queue name is required"},
+		},
+		{
+			name:      "rejects invalid characters",
+			queueName: "orders!",
+			want: QueueNameCheck{
+				Name:    "orders!",
+				Message: "queue name may only contain letters, digits, hyphens and underscores",
+			},
+		},
+		{
+			name:      "rejects overlong name",
+			queueName: strings.Repeat("a", 81),
+			want: QueueNameCheck{
+				Name:    strings.Repeat("a", 81),
+				Message: "queue name must be at most 80 characters",
+			},
+		},
+		{
+			name:      "reports policy denial as invalid",
+			queueName: "prod-orders",
+			arrange: func(repo *MockSqsRepository) {
+				repo.EXPECT().
+					QueueURL(mock.Anything, "prod-orders").
+					Return("", false, fmt.Errorf("queue \"prod-orders\" is not allowed: %w", ErrQueueAccessDenied)).
+					Once()
+			},
+			want: QueueNameCheck{
+				Name:    "prod-orders",
+				Message: `queue "prod-orders" is not allowed: queue access denied by policy`,
+			},
+		},
+		{
+			name:      "returns repository error",
+			queueName: "orders",
+			arrange: func(repo *MockSqsRepository) {
+				repo.EXPECT().QueueURL(mock.Anything, "orders").Return("", false, errors.New("boom")).Once()
+			},
+			wantErr: "boom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockSqsRepository(t)
+			if tt.arrange != nil {
+				tt.arrange(repo)
+			}
+
+			service := &SqsServiceImpl{repo: repo}
+
+			got, err := service.CheckQueueName(context.Background(), tt.queueName, tt.queueType)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestSqsServiceImpl_QueueDetail(t *testing.T) {
 	type args struct {
 		ctx      context.Context
@@ -258,8 +545,8 @@ func TestSqsServiceImpl_QueueDetail(t *testing.T) {
 						URL:  args.queueURL,
 						Name: "orders",
 						Type: QueueTypeStandard,
+						Arn:  "arn:aws:sqs:local:000000000000:orders",
 					},
-					Arn:            "arn:aws:sqs:local:000000000000:orders",
 					LastModifiedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
 					Attributes:     map[string]string{"VisibilityTimeout": "30"},
 					Tags:           map[string]string{"env": "dev"},
@@ -275,8 +562,8 @@ func TestSqsServiceImpl_QueueDetail(t *testing.T) {
 					URL:  "https://sqs.local/orders",
 					Name: "orders",
 					Type: QueueTypeStandard,
+					Arn:  "arn:aws:sqs:local:000000000000:orders",
 				},
-				Arn:            "arn:aws:sqs:local:000000000000:orders",
 				LastModifiedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
 				Attributes:     map[string]string{"VisibilityTimeout": "30"},
 				Tags:           map[string]string{"env": "dev"},
@@ -368,7 +655,8 @@ func TestSqsServiceImpl_DeleteQueue(t *testing.T) {
 
 			service := &SqsServiceImpl{repo: repo}
 
-			err := service.DeleteQueue(tt.args.ctx, tt.args.queueURL)
+			trashed, err := service.DeleteQueue(tt.args.ctx, tt.args.queueURL)
+			assert.Empty(t, trashed.ID)
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
@@ -563,6 +851,68 @@ func TestSqsServiceImpl_SendMessage(t *testing.T) {
 				repo.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
 			},
 		},
+		{
+			name: "sends typed attributes",
+			args: args{
+				ctx: context.Background(),
+				input: SendMessageInput{
+					QueueURL: "https://sqs.local/queue",
+					Body:     "event",
+					Attributes: []MessageAttribute{
+						{Name: "retries", Value: "-1.5e3", DataType: "Number"},
+						{Name: "price", Value: "12", DataType: "Number.float"},
+						{Name: "payload", Value: "aGVsbG8=", DataType: "Binary"},
+						{Name: "kind", Value: "order", DataType: "String"},
+					},
+				},
+			},
+			arrange: func(t *testing.T, repo *MockSqsRepository, args args) {
+				repo.EXPECT().
+					SendMessage(mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, input SendMessageRepositoryInput) {
+						assert.Equal(t, map[string]string{"retries": "-1.5e3", "price": "12", "payload": "aGVsbG8=", "kind": "order"}, input.Attributes)
+						assert.Equal(t, map[string]string{"retries": "Number", "price": "Number.float", "payload": "Binary"}, input.AttributeTypes)
+					}).
+					Return(nil).
+					Once()
+			},
+		},
+		{
+			name: "rejects a number attribute that is not a number",
+			args: args{
+				ctx: context.Background(),
+				input: SendMessageInput{
+					QueueURL:   "https://sqs.local/queue",
+					Body:       "event",
+					Attributes: []MessageAttribute{{Name: "retries", Value: "three", DataType: "Number"}},
+				},
+			},
+			wantErr: "attribute retries must be a number",
+		},
+		{
+			name: "rejects a binary attribute that is not base64",
+			args: args{
+				ctx: context.Background(),
+				input: SendMessageInput{
+					QueueURL:   "https://sqs.local/queue",
+					Body:       "event",
+					Attributes: []MessageAttribute{{Name: "payload", Value: "not base64!", DataType: "Binary"}},
+				},
+			},
+			wantErr: "attribute payload must be base64 encoded binary data",
+		},
+		{
+			name: "rejects an unknown data type",
+			args: args{
+				ctx: context.Background(),
+				input: SendMessageInput{
+					QueueURL:   "https://sqs.local/queue",
+					Body:       "event",
+					Attributes: []MessageAttribute{{Name: "flag", Value: "true", DataType: "Boolean"}},
+				},
+			},
+			wantErr: "attribute flag must have the type String, Number or Binary",
+		},
 	}
 
 	for _, tt := range tests {
@@ -574,7 +924,7 @@ func TestSqsServiceImpl_SendMessage(t *testing.T) {
 
 			service := &SqsServiceImpl{repo: repo}
 
-			err := service.SendMessage(tt.args.ctx, tt.args.input)
+			_, err := service.SendMessage(tt.args.ctx, tt.args.input)
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
@@ -588,6 +938,220 @@ func TestSqsServiceImpl_SendMessage(t *testing.T) {
 	}
 }
 
+func TestSqsServiceImpl_SendMessage_DeduplicationHistory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)
+
+	newService := func(t *testing.T) (*SqsServiceImpl, *dedupHistory) {
+		repo := NewMockSqsRepository(t)
+		repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil)
+		history := newDedupHistory()
+		history.now = func() time.Time { return now }
+		return &SqsServiceImpl{repo: repo, dedup: history}, history
+	}
+
+	t.Run("warns when an explicit deduplication id is reused within the window", func(t *testing.T) {
+		service, history := newService(t)
+		input := SendMessageInput{QueueURL: "https://sqs.local/orders.fifo", Body: "a", MessageGroupID: "g", MessageDeduplicationID: "dedup-1"}
+
+		first, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.Empty(t, first.Warning)
+
+		input.Body = "b"
+		second, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.Contains(t, second.Warning, `Deduplication ID "dedup-1" was already used`)
+
+		history.now = func() time.Time { return now.Add(fifoDeduplicationWindow + time.Second) }
+		third, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.Empty(t, third.Warning)
+	})
+
+	t.Run("warns when an identical body is resent with content-based deduplication", func(t *testing.T) {
+		service, _ := newService(t)
+		input := SendMessageInput{QueueURL: "https://sqs.local/orders.fifo", Body: "same", MessageGroupID: "g"}
+
+		_, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+
+		result, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.Contains(t, result.Warning, "An identical message body was sent")
+	})
+
+	t.Run("does not track standard queues", func(t *testing.T) {
+		service, history := newService(t)
+		input := SendMessageInput{QueueURL: "https://sqs.local/orders", Body: "same", MessageDeduplicationID: "dedup-1"}
+
+		_, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		result, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.Empty(t, result.Warning)
+		assert.Empty(t, history.entries)
+	})
+
+	t.Run("only warns within the message group when deduplicating per group", func(t *testing.T) {
+		service, _ := newService(t)
+		repo := service.repo.(*MockSqsRepository)
+		repo.EXPECT().
+			GetQueueAttributes(mock.Anything, "https://sqs.local/orders.fifo", []string{"DeduplicationScope"}).
+			Return(map[string]string{"DeduplicationScope": DeduplicationScopeMessageGroup}, nil).
+			Once()
+		input := SendMessageInput{QueueURL: "https://sqs.local/orders.fifo", Body: "a", MessageGroupID: "g1", MessageDeduplicationID: "dedup-1"}
+
+		_, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+
+		input.MessageGroupID = "g2"
+		other, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.Empty(t, other.Warning)
+
+		input.MessageGroupID = "g1"
+		same, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.Contains(t, same.Warning, `Deduplication ID "dedup-1" was already used`)
+	})
+
+	t.Run("warns across message groups when deduplicating per queue", func(t *testing.T) {
+		service, _ := newService(t)
+		repo := service.repo.(*MockSqsRepository)
+		repo.EXPECT().
+			GetQueueAttributes(mock.Anything, "https://sqs.local/orders.fifo", []string{"DeduplicationScope"}).
+			Return(map[string]string{"DeduplicationScope": DeduplicationScopeQueue}, nil).
+			Once()
+		input := SendMessageInput{QueueURL: "https://sqs.local/orders.fifo", Body: "a", MessageGroupID: "g1", MessageDeduplicationID: "dedup-1"}
+
+		_, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+
+		input.MessageGroupID = "g2"
+		result, err := service.SendMessage(ctx, input)
+		require.NoError(t, err)
+		assert.Contains(t, result.Warning, `Deduplication ID "dedup-1" was already used`)
+	})
+}
+
+func TestSqsServiceImpl_SendMessage_RemembersDefaults(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockSqsRepository(t)
+	store := NewMockLocalStore(t)
+	service := &SqsServiceImpl{repo: repo, store: store}
+
+	repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil).Once()
+	store.EXPECT().
+		SaveSendDefaults("https://sqs.local/orders.fifo", SendDefaults{
+			MessageGroupID: "group",
+			DelaySeconds:   int32Ptr(5),
+			Attributes:     []MessageAttribute{{Name: "TraceId", Value: "123"}},
+		}).
+		Return(errors.New("disk full")).
+		Once()
+	store.EXPECT().DeleteDraft("https://sqs.local/orders.fifo").Return(errors.New("disk full")).Once()
+
+	_, err := service.SendMessage(ctx, SendMessageInput{
+		QueueURL:               "https://sqs.local/orders.fifo",
+		Body:                   "event",
+		MessageGroupID:         " group ",
+		MessageDeduplicationID: "dedup",
+		DelaySeconds:           int32Ptr(5),
+		Attributes:             []MessageAttribute{{Name: " TraceId ", Value: "123"}, {Name: " ", Value: "ignored"}},
+	})
+	assert.NoError(t, err, "a failure to persist defaults must not fail the send")
+}
+
+func TestSqsServiceImpl_SendMessage_DiscardsDraft(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockSqsRepository(t)
+	store, err := NewLocalStore("")
+	require.NoError(t, err)
+	service := &SqsServiceImpl{repo: repo, store: store}
+	queueURL := "https://sqs.local/orders"
+	require.NoError(t, store.SaveDraft(queueURL, MessageDraft{Body: "event"}))
+
+	repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(errors.New("throttled")).Once()
+	_, err = service.SendMessage(ctx, SendMessageInput{QueueURL: queueURL, Body: "event"})
+	require.Error(t, err)
+	_, ok, err := store.Draft(queueURL)
+	require.NoError(t, err)
+	assert.True(t, ok, "a failed send keeps the draft")
+
+	repo.EXPECT().SendMessage(mock.Anything, mock.Anything).Return(nil).Once()
+	_, err = service.SendMessage(ctx, SendMessageInput{QueueURL: queueURL, Body: "event"})
+	require.NoError(t, err)
+	_, ok, err = store.Draft(queueURL)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestSqsServiceImpl_SendDefaults(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns stored defaults", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store}
+		want := SendDefaults{MessageGroupID: "group"}
+		store.EXPECT().SendDefaults("https://sqs.local/orders").Return(want, true, nil).Once()
+
+		got, err := service.SendDefaults(ctx, " https://sqs.local/orders ")
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("requires queue url", func(t *testing.T) {
+		service := &SqsServiceImpl{}
+		_, err := service.SendDefaults(ctx, " ")
+		assert.EqualError(t, err, "queue url is required")
+	})
+}
+
+func TestSqsServiceImpl_SaveDraft(t *testing.T) {
+	ctx := context.Background()
+	queueURL := "https://sqs.local/orders"
+
+	t.Run("saves draft without blank attribute rows", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store}
+
+		store.EXPECT().
+			SaveDraft(queueURL, mock.MatchedBy(func(draft MessageDraft) bool {
+				return draft.Body == "hello" &&
+					assert.Equal(t, []MessageAttribute{{Name: "k", Value: "v"}}, draft.Attributes) &&
+					!draft.SavedAt.IsZero()
+			})).
+			Return(nil).
+			Once()
+
+		saved, err := service.SaveDraft(ctx, queueURL, MessageDraft{
+			Body:       "hello",
+			Attributes: []MessageAttribute{{Name: "k", Value: "v"}, {Name: " ", Value: ""}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", saved.Body)
+	})
+
+	t.Run("deletes empty drafts", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store}
+
+		store.EXPECT().DeleteDraft(queueURL).Return(nil).Once()
+
+		saved, err := service.SaveDraft(ctx, queueURL, MessageDraft{Body: "  "})
+		require.NoError(t, err)
+		assert.True(t, saved.SavedAt.IsZero())
+	})
+
+	t.Run("rejects oversized bodies", func(t *testing.T) {
+		service := &SqsServiceImpl{store: NewMockLocalStore(t)}
+
+		_, err := service.SaveDraft(ctx, queueURL, MessageDraft{Body: strings.Repeat("a", maxMessageBodyBytes+1)})
+		assert.EqualError(t, err, "draft body exceeds the 256 KB message size limit")
+	})
+}
+
 func TestSqsServiceImpl_ReceiveMessages(t *testing.T) {
 	type args struct {
 		ctx   context.Context
@@ -676,6 +1240,126 @@ func TestSqsServiceImpl_ReceiveMessages(t *testing.T) {
 			},
 			want: ReceiveMessagesResult{Messages: []ReceivedMessage{{ID: "1"}}},
 		},
+		{
+			name: "locks messages for the provided visibility timeout",
+			args: args{
+				ctx: context.Background(),
+				input: ReceiveMessagesInput{
+					QueueURL:                  "https://sqs.local/queue",
+					VisibilityTimeout:         300,
+					VisibilityTimeoutProvided: true,
+				},
+			},
+			arrange: func(t *testing.T, repo *MockSqsRepository, args args) {
+				repo.EXPECT().
+					ReceiveMessages(mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, input ReceiveMessagesRepositoryInput) {
+						assert.Equal(t, int32(300), input.VisibilityTimeout)
+					}).
+					Return([]ReceivedMessage{{ID: "1", ReceiptHandle: "rh-1"}}, nil).
+					Once()
+			},
+			want: ReceiveMessagesResult{Messages: []ReceivedMessage{{ID: "1", ReceiptHandle: "rh-1"}}},
+			assertMock: func(t *testing.T, repo *MockSqsRepository) {
+				repo.AssertNotCalled(t, "ChangeMessageVisibility", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "peeks by releasing the messages right after receiving them",
+			args: args{
+				ctx: context.Background(),
+				input: ReceiveMessagesInput{
+					QueueURL:                  "https://sqs.local/queue",
+					VisibilityTimeout:         0,
+					VisibilityTimeoutProvided: true,
+				},
+			},
+			arrange: func(t *testing.T, repo *MockSqsRepository, args args) {
+				repo.EXPECT().
+					ReceiveMessages(mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, input ReceiveMessagesRepositoryInput) {
+						assert.Equal(t, int32(0), input.VisibilityTimeout)
+					}).
+					Return([]ReceivedMessage{{ID: "1", ReceiptHandle: "rh-1"}, {ID: "2", ReceiptHandle: "rh-2"}}, nil).
+					Once()
+				repo.EXPECT().
+					ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: "https://sqs.local/queue", ReceiptHandle: "rh-1"}).
+					Return(nil).
+					Once()
+				repo.EXPECT().
+					ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: "https://sqs.local/queue", ReceiptHandle: "rh-2"}).
+					Return(errors.New("receipt handle expired")).
+					Once()
+			},
+			want: ReceiveMessagesResult{Messages: []ReceivedMessage{{ID: "1", ReceiptHandle: "rh-1"}, {ID: "2", ReceiptHandle: "rh-2"}}},
+		},
+		{
+			name: "keeps the messages that match the filter and projects their bodies",
+			args: args{
+				ctx: context.Background(),
+				input: ReceiveMessagesInput{
+					QueueURL:   "https://sqs.local/queue",
+					Filter:     MessageFilter{JSONPath: "$.type", JSONValue: "order"},
+					Projection: []string{"$.order.id", " $.order.missing ", "$.order.id"},
+				},
+			},
+			arrange: func(t *testing.T, repo *MockSqsRepository, args args) {
+				repo.EXPECT().
+					ReceiveMessages(mock.Anything, mock.Anything).
+					Return([]ReceivedMessage{
+						{ID: "1", ReceiptHandle: "rh-1", Body: `{"type":"order","order":{"id":42}}`},
+						{ID: "2", ReceiptHandle: "rh-2", Body: `{"type":"refund"}`},
+						{ID: "3", ReceiptHandle: "rh-3", Body: "not json"},
+						{ID: "4", ReceiptHandle: "rh-4", Body: `{"type":"order"}`},
+					}, nil).
+					Once()
+				repo.EXPECT().
+					ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: "https://sqs.local/queue", ReceiptHandle: "rh-2"}).
+					Return(nil).
+					Once()
+				repo.EXPECT().
+					ChangeMessageVisibility(mock.Anything, ChangeMessageVisibilityRepositoryInput{QueueURL: "https://sqs.local/queue", ReceiptHandle: "rh-3"}).
+					Return(nil).
+					Once()
+			},
+			want: ReceiveMessagesResult{
+				Messages: []ReceivedMessage{
+					{ID: "1", ReceiptHandle: "rh-1", Body: `{"type":"order","order":{"id":42}}`},
+					{ID: "4", ReceiptHandle: "rh-4", Body: `{"type":"order"}`},
+				},
+				Projections: map[string]json.RawMessage{"1": json.RawMessage("{\n  \"$.order.id\": 42\n}")},
+				FilteredOut: 2,
+			},
+		},
+		{
+			name: "returns error when the filter is invalid",
+			args: args{
+				ctx: context.Background(),
+				input: ReceiveMessagesInput{
+					QueueURL: "https://sqs.local/queue",
+					Filter:   MessageFilter{JSONPath: "type"},
+				},
+			},
+			wantErr: `JSON path "type" must start with $`,
+			assertMock: func(t *testing.T, repo *MockSqsRepository) {
+				repo.AssertNotCalled(t, "ReceiveMessages", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "returns error when visibility timeout is out of range",
+			args: args{
+				ctx: context.Background(),
+				input: ReceiveMessagesInput{
+					QueueURL:                  "https://sqs.local/queue",
+					VisibilityTimeout:         43201,
+					VisibilityTimeoutProvided: true,
+				},
+			},
+			wantErr: "visibility timeout must be between 0 and 43200 seconds",
+			assertMock: func(t *testing.T, repo *MockSqsRepository) {
+				repo.AssertNotCalled(t, "ReceiveMessages", mock.Anything, mock.Anything)
+			},
+		},
 		{
 			name: "returns error when queue url is blank",
 			args: args{
@@ -716,6 +1400,56 @@ func TestSqsServiceImpl_ReceiveMessages(t *testing.T) {
 	}
 }
 
+func TestSqsServiceImpl_ReceiveMessages_GroupByMessageGroup(t *testing.T) {
+	ctx := context.Background()
+	message := func(id, group, sequence string) ReceivedMessage {
+		return ReceivedMessage{ID: id, Attributes: []MessageAttribute{
+			{Name: "MessageGroupId", Value: group},
+			{Name: "SequenceNumber", Value: sequence},
+		}}
+	}
+
+	t.Run("groups messages and orders them by sequence number", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		received := []ReceivedMessage{
+			message("b-2", "b", "18889548858596941825"),
+			message("a-1", "a", "9999999999999999999"),
+			message("b-1", "b", "18889548858596941824"),
+			message("a-2", "a", "10000000000000000000"),
+		}
+		repo.EXPECT().
+			ReceiveMessages(mock.Anything, mock.Anything).
+			Return(received, nil).
+			Once()
+
+		got, err := service.ReceiveMessages(ctx, ReceiveMessagesInput{
+			QueueURL:            "https://sqs.local/orders.fifo",
+			GroupByMessageGroup: true,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, received, got.Messages)
+		assert.Equal(t, []MessageGroup{
+			{GroupID: "b", Messages: []ReceivedMessage{received[2], received[0]}, InOrder: false},
+			{GroupID: "a", Messages: []ReceivedMessage{received[1], received[3]}, InOrder: true},
+		}, got.Groups)
+	})
+
+	t.Run("rejects grouping for standard queues", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		_, err := service.ReceiveMessages(ctx, ReceiveMessagesInput{
+			QueueURL:            "https://sqs.local/orders",
+			GroupByMessageGroup: true,
+		})
+		assert.EqualError(t, err, "message grouping is only available for fifo queues")
+		repo.AssertNotCalled(t, "ReceiveMessages", mock.Anything, mock.Anything)
+	})
+}
+
 func TestSqsServiceImpl_DeleteMessage(t *testing.T) {
 	type args struct {
 		ctx   context.Context
@@ -802,3 +1536,79 @@ func TestSqsServiceImpl_DeleteMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestSqsServiceImpl_ReceiveMergedMessages(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("merges messages by sent time and reports failing queues", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		repo.EXPECT().
+			ReceiveMessages(mock.Anything, mock.MatchedBy(func(input ReceiveMessagesRepositoryInput) bool {
+				return input.QueueURL == "https://sqs.local/orders"
+			})).
+			Run(func(_ context.Context, input ReceiveMessagesRepositoryInput) {
+				assert.Equal(t, int32(5), input.MaxMessages)
+				assert.Equal(t, int32(0), input.WaitTimeSeconds)
+			}).
+			Return([]ReceivedMessage{
+				{ID: "late", Attributes: []MessageAttribute{{Name: "SentTimestamp", Value: "2024-05-01T10:00:02Z"}}},
+				{ID: "early", Attributes: []MessageAttribute{{Name: "SentTimestamp", Value: "2024-05-01T10:00:00Z"}}},
+			}, nil).
+			Once()
+		repo.EXPECT().
+			ReceiveMessages(mock.Anything, mock.MatchedBy(func(input ReceiveMessagesRepositoryInput) bool {
+				return input.QueueURL == "https://sqs.local/payments"
+			})).
+			Return([]ReceivedMessage{
+				{ID: "middle", Attributes: []MessageAttribute{{Name: "SentTimestamp", Value: "2024-05-01T10:00:01Z"}}},
+			}, nil).
+			Once()
+		repo.EXPECT().
+			ReceiveMessages(mock.Anything, mock.MatchedBy(func(input ReceiveMessagesRepositoryInput) bool {
+				return input.QueueURL == "https://sqs.local/broken"
+			})).
+			Return(nil, errors.New("boom")).
+			Once()
+
+		got, err := service.ReceiveMergedMessages(ctx, MergedReceiveInput{
+			QueueURLs:           []string{" https://sqs.local/orders ", "https://sqs.local/payments", "https://sqs.local/orders", "", "https://sqs.local/broken"},
+			MaxMessages:         5,
+			WaitTimeSeconds:     0,
+			MaxMessagesProvided: true,
+			WaitTimeProvided:    true,
+		})
+		require.NoError(t, err)
+
+		ids := make([]string, 0, len(got.Messages))
+		for _, message := range got.Messages {
+			ids = append(ids, message.ID)
+		}
+		assert.Equal(t, []string{"early", "middle", "late"}, ids)
+		assert.Equal(t, "payments", got.Messages[1].QueueName)
+		assert.Equal(t, "https://sqs.local/payments", got.Messages[1].QueueURL)
+		assert.Equal(t, []QueueReceiveFailure{{QueueURL: "https://sqs.local/broken", Error: "boom"}}, got.Failures)
+	})
+
+	t.Run("requires at least one queue url", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		_, err := service.ReceiveMergedMessages(ctx, MergedReceiveInput{QueueURLs: []string{" "}})
+		assert.EqualError(t, err, "at least one queue url is required")
+	})
+
+	t.Run("rejects too many queues", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		service := &SqsServiceImpl{repo: repo}
+
+		queueURLs := make([]string, 0, maxMergedQueues+1)
+		for i := 0; i <= maxMergedQueues; i++ {
+			queueURLs = append(queueURLs, "https://sqs.local/queue-"+strconv.Itoa(i))
+		}
+
+		_, err := service.ReceiveMergedMessages(ctx, MergedReceiveInput{QueueURLs: queueURLs})
+		assert.EqualError(t, err, "at most 10 queues can be polled at once")
+	})
+}
@@ -1,6 +1,9 @@
 package internal
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // QueueType represents the queue category (standard or FIFO).
 type QueueType string
@@ -20,14 +23,32 @@ type QueueSummary struct {
 	CreatedAt                 time.Time
 	MessagesAvailable         int64
 	MessagesInFlight          int64
+	MessagesDelayed           int64
 	Encryption                string
 	ContentBasedDeduplication bool
+	Arn                       string
+	RedrivePolicy             *RedrivePolicy
+	VisibilityTimeout         int64
+	// Anomalies lists what the recent depth history flags about the queue. It is only set by
+	// the service.
+	Anomalies []QueueAnomaly
+	// BacklogAge estimates the age of the oldest message, which SQS only reports to CloudWatch,
+	// as the time since the depth history last found the queue empty. BacklogAgeExceeded is set
+	// when the queue was never found empty, so the backlog may be older. Both are only set by the
+	// service, and are zero for an empty queue or one sampled once.
+	BacklogAge         time.Duration
+	BacklogAgeExceeded bool
+}
+
+// RedrivePolicy is the dead-letter configuration of a source queue.
+type RedrivePolicy struct {
+	DeadLetterTargetArn string
+	MaxReceiveCount     int
 }
 
 // QueueDetail provides an extended view of a queue, including raw attributes and tags.
 type QueueDetail struct {
 	QueueSummary
-	Arn            string
 	LastModifiedAt time.Time
 	Attributes     map[string]string
 	Tags           map[string]string
@@ -41,6 +62,20 @@ type CreateQueueInput struct {
 	MessageRetentionPeriod    *int32
 	VisibilityTimeout         *int32
 	ContentBasedDeduplication bool
+	// MaximumMessageSize is in bytes; ReceiveMessageWaitTimeSeconds is the long poll receives
+	// use when they do not set their own wait time.
+	MaximumMessageSize            *int32
+	ReceiveMessageWaitTimeSeconds *int32
+	// Throughput sets the throughput mode of a FIFO queue; the zero value leaves the SQS default.
+	Throughput FifoThroughput
+	// KmsMasterKeyID encrypts the queue with a customer-managed KMS key (a key ID, key ARN, alias
+	// name, or alias ARN). KmsDataKeyReusePeriodSeconds is how long SQS reuses a data key before
+	// calling KMS again, and can only be set with a key.
+	KmsMasterKeyID               string
+	KmsDataKeyReusePeriodSeconds *int32
+	// RedrivePolicy sends messages received too often to a dead-letter queue. Nil leaves the
+	// queue without one.
+	RedrivePolicy *RedrivePolicy
 }
 
 // CreateQueueResult reports the outcome of a queue creation request.
@@ -48,10 +83,22 @@ type CreateQueueResult struct {
 	QueueURL string
 }
 
-// MessageAttribute represents a single name/value pair returned with a message.
+// QueueNameCheck reports whether a queue name is valid and still free.
+type QueueNameCheck struct {
+	Name      string
+	Valid     bool
+	Available bool
+	Message   string
+}
+
+// MessageAttribute represents a single name/value pair returned with a message. DataType is
+// String, Number or Binary, optionally followed by a custom type such as Number.float, and empty
+// for String and for the system attributes of a received message. Binary values are base64
+// encoded.
 type MessageAttribute struct {
-	Name  string
-	Value string
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	DataType string `json:"dataType,omitempty"`
 }
 
 // SendMessageInput carries the parameters necessary to enqueue a message.
@@ -62,6 +109,16 @@ type SendMessageInput struct {
 	MessageDeduplicationID string
 	DelaySeconds           *int32
 	Attributes             []MessageAttribute
+	// IdempotencyKey makes retries of the same send return the first result instead of sending again.
+	IdempotencyKey string
+}
+
+// SendMessageResult reports the outcome of a send. Warning is set when the message was accepted
+// by SQS but is expected to be discarded, e.g. because its deduplication ID was recently used.
+// Replayed is set when the result was returned for a repeated idempotency key without sending.
+type SendMessageResult struct {
+	Warning  string
+	Replayed bool
 }
 
 // ReceiveMessagesInput controls how messages are fetched from a queue.
@@ -71,11 +128,40 @@ type ReceiveMessagesInput struct {
 	WaitTimeSeconds     int32
 	MaxMessagesProvided bool
 	WaitTimeProvided    bool
+	GroupByMessageGroup bool
+
+	// VisibilityTimeout hides the received messages from other consumers for this many seconds
+	// when VisibilityTimeoutProvided is set. 0 peeks: the messages are made visible again right
+	// after they are received. Otherwise the queue's visibility timeout applies.
+	VisibilityTimeout         int32
+	VisibilityTimeoutProvided bool
+
+	// Filter, when it has a body pattern or a JSON path, keeps only the messages whose body
+	// matches; the others are made visible again right away. Projection lists JSON paths whose
+	// values are picked out of each JSON body and returned next to it.
+	Filter     MessageFilter
+	Projection []string
 }
 
 // ReceiveMessagesResult contains the messages retrieved from a queue.
+// Groups is only populated when the caller asked for FIFO message grouping.
 type ReceiveMessagesResult struct {
 	Messages []ReceivedMessage
+	Groups   []MessageGroup
+	// DeadLetter holds, by message ID, why messages that SQS moved to this dead-letter queue are here.
+	DeadLetter map[string]DeadLetterContext
+	// Projections holds, by message ID, the projected fields of the bodies that have any.
+	Projections map[string]json.RawMessage
+	// FilteredOut is how many received messages the filter left out.
+	FilteredOut int
+}
+
+// MessageGroup holds the received messages of a single FIFO message group ordered by sequence number.
+// InOrder reports whether the messages were received in the same order as their sequence numbers.
+type MessageGroup struct {
+	GroupID  string
+	Messages []ReceivedMessage
+	InOrder  bool
 }
 
 // DeleteMessageInput carries the parameters required to remove a message from a queue.
@@ -84,11 +170,42 @@ type DeleteMessageInput struct {
 	ReceiptHandle string
 }
 
-// ReceivedMessage represents a single message retrieved from SQS.
+// ReceivedMessage represents a single message retrieved from SQS. Raw is the message as SQS
+// returned it, in the JSON shape of the ReceiveMessage API, with the MD5 digests and the system
+// attributes unformatted, so nothing is lost in Attributes.
 type ReceivedMessage struct {
 	ID            string
 	Body          string
 	ReceiptHandle string
 	ReceiveCount  int32
 	Attributes    []MessageAttribute
+	Raw           json.RawMessage
+}
+
+// MergedReceiveInput controls how messages are fetched from several queues at once.
+type MergedReceiveInput struct {
+	QueueURLs           []string
+	MaxMessages         int32
+	WaitTimeSeconds     int32
+	MaxMessagesProvided bool
+	WaitTimeProvided    bool
+}
+
+// SourcedMessage is a received message annotated with the queue it came from.
+type SourcedMessage struct {
+	ReceivedMessage
+	QueueURL  string
+	QueueName string
+}
+
+// QueueReceiveFailure records a queue that could not be polled during a merged receive.
+type QueueReceiveFailure struct {
+	QueueURL string
+	Error    string
+}
+
+// MergedReceiveResult contains the messages retrieved from several queues, ordered by sent time.
+type MergedReceiveResult struct {
+	Messages []SourcedMessage
+	Failures []QueueReceiveFailure
 }
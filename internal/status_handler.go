@@ -0,0 +1,252 @@
+package internal
+
+import (
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type statusOperationView struct {
+	Operation      string
+	Requests       string
+	Errors         string
+	ErrorRate      string
+	AverageLatency string
+	MaxLatency     string
+}
+
+type statusObjectiveView struct {
+	Operation  string
+	Objective  string
+	Requests   string
+	Compliance string
+	Met        bool
+	BurnRates  []statusBurnRateView
+}
+
+type statusBurnRateView struct {
+	Window string
+	Rate   string
+	// Burning is set when the error budget is spent faster than the objective allows.
+	Burning bool
+}
+
+type statusQueueView struct {
+	QueueURL        string
+	EscapedURL      string
+	QueueName       string
+	Requests        string
+	MonthlyRequests string
+	MonthlyCost     string
+}
+
+type statusPageData struct {
+	Title       string
+	ViteTags    template.HTML
+	Since       string
+	Endpoint    statusEndpointView
+	Operations  []statusOperationView
+	Objectives  []statusObjectiveView
+	Queues      []statusQueueView
+	MonthlyCost string
+}
+
+type statusEndpointView struct {
+	Kind          string
+	URL           string
+	Tags          bool
+	CostEstimates bool
+}
+
+// endpointKindLabels names the endpoint kinds on the status page.
+var endpointKindLabels = map[string]string{
+	EndpointAWS:           "Amazon SQS",
+	EndpointElasticMQ:     "ElasticMQ",
+	EndpointLocalStack:    "LocalStack",
+	EndpointSQSCompatible: "SQS compatible server",
+}
+
+// StatusHandler renders the SQS API latency and error rates observed since startup.
+func (h *HandlerImpl) StatusHandler(w http.ResponseWriter, r *http.Request) {
+	metrics := h.s.APIMetrics(r.Context())
+	capabilities := h.s.EndpointCapabilities(r.Context())
+
+	data := statusPageData{
+		Title:    "Status",
+		ViteTags: fragments["assets/js/status.ts"].Tags,
+		Since:    metrics.Since.Format("2006-01-02 15:04:05 MST"),
+		Endpoint: statusEndpointView{
+			Kind:          endpointKindLabels[capabilities.Kind],
+			URL:           capabilities.Endpoint,
+			Tags:          capabilities.Tags,
+			CostEstimates: capabilities.CostEstimates,
+		},
+		Operations: make([]statusOperationView, 0, len(metrics.Operations)),
+	}
+	for _, operation := range metrics.Operations {
+		data.Operations = append(data.Operations, statusOperationView{
+			Operation:      operation.Operation,
+			Requests:       strconv.FormatInt(operation.Requests, 10),
+			Errors:         strconv.FormatInt(operation.Errors, 10),
+			ErrorRate:      fmt.Sprintf("%.1f%%", operation.ErrorRate()*100),
+			AverageLatency: formatLatency(operation.AverageLatency()),
+			MaxLatency:     formatLatency(operation.MaxLatency),
+		})
+	}
+
+	data.Objectives = make([]statusObjectiveView, 0, len(metrics.Objectives))
+	for _, status := range metrics.Objectives {
+		view := statusObjectiveView{
+			Operation:  status.Objective.Operation,
+			Objective:  status.Objective.String(),
+			Requests:   strconv.FormatInt(status.Requests, 10),
+			Compliance: "-",
+			Met:        status.Met(),
+			BurnRates:  make([]statusBurnRateView, 0, len(status.BurnRates)),
+		}
+		if status.Requests > 0 {
+			view.Compliance = fmt.Sprintf("%.2f%%", status.Compliance()*100)
+		}
+		for _, burn := range status.BurnRates {
+			rate := statusBurnRateView{Window: formatWindow(burn.Window), Rate: "-"}
+			if burn.Requests > 0 {
+				rate.Rate = strconv.FormatFloat(burn.Rate, 'f', 2, 64)
+				rate.Burning = burn.Rate > 1
+			}
+			view.BurnRates = append(view.BurnRates, rate)
+		}
+		data.Objectives = append(data.Objectives, view)
+	}
+
+	data.Queues = make([]statusQueueView, 0, len(metrics.Queues))
+	var total float64
+	projected := true
+	for _, queue := range metrics.Queues {
+		view := statusQueueView{
+			QueueURL:        queue.QueueURL,
+			EscapedURL:      url.QueryEscape(queue.QueueURL),
+			QueueName:       extractQueueName(queue.QueueURL),
+			Requests:        strconv.FormatInt(queue.Requests, 10),
+			MonthlyRequests: "-",
+			MonthlyCost:     "-",
+		}
+		if queue.QueueURL == "" {
+			view.QueueName = "(not queue specific)"
+		}
+		if monthly, ok := metrics.MonthlyRequests(queue.Requests); ok {
+			view.MonthlyRequests = strconv.FormatFloat(monthly, 'f', 0, 64)
+		}
+		if cost, ok := metrics.EstimatedMonthlyCost(queue); ok {
+			view.MonthlyCost = formatUSD(cost)
+			total += cost
+		} else {
+			projected = false
+		}
+		data.Queues = append(data.Queues, view)
+	}
+	if projected && len(metrics.Queues) > 0 && capabilities.CostEstimates {
+		data.MonthlyCost = formatUSD(total)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates["status"].Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "failed to render status template", slog.Any("error", err))
+		http.Error(w, "template error", http.StatusInternalServerError)
+	}
+}
+
+func formatUSD(amount float64) string {
+	return fmt.Sprintf("$%.2f", amount)
+}
+
+func formatLatency(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(100 * time.Microsecond).String()
+	}
+	return d.Round(time.Millisecond).String()
+}
+
+// formatWindow names a burn rate window the way Prometheus range selectors do, such as 5m or 1h.
+func formatWindow(d time.Duration) string {
+	if d%time.Hour == 0 {
+		return strconv.FormatInt(int64(d/time.Hour), 10) + "h"
+	}
+	return strconv.FormatInt(int64(d/time.Minute), 10) + "m"
+}
+
+// CapabilitiesAPI returns what the configured SQS endpoint supports.
+func (h *HandlerImpl) CapabilitiesAPI(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.s.EndpointCapabilities(r.Context()))
+}
+
+// MetricsHandler exposes the SQS API metrics in the Prometheus text format.
+func (h *HandlerImpl) MetricsHandler(w http.ResponseWriter, r *http.Request) {
+	metrics := h.s.APIMetrics(r.Context())
+
+	var b strings.Builder
+	b.WriteString("# HELP sqs_gui_sqs_requests_total SQS API calls issued by the GUI.\n")
+	b.WriteString("# TYPE sqs_gui_sqs_requests_total counter\n")
+	for _, operation := range metrics.Operations {
+		fmt.Fprintf(&b, "sqs_gui_sqs_requests_total{operation=%q} %d\n", operation.Operation, operation.Requests)
+	}
+
+	b.WriteString("# HELP sqs_gui_sqs_request_errors_total SQS API calls that returned an error.\n")
+	b.WriteString("# TYPE sqs_gui_sqs_request_errors_total counter\n")
+	for _, operation := range metrics.Operations {
+		fmt.Fprintf(&b, "sqs_gui_sqs_request_errors_total{operation=%q} %d\n", operation.Operation, operation.Errors)
+	}
+
+	b.WriteString("# HELP sqs_gui_sqs_queue_requests_total SQS API calls issued by the GUI per queue.\n")
+	b.WriteString("# TYPE sqs_gui_sqs_queue_requests_total counter\n")
+	for _, queue := range metrics.Queues {
+		fmt.Fprintf(&b, "sqs_gui_sqs_queue_requests_total{queue_url=%q} %d\n", queue.QueueURL, queue.Requests)
+	}
+
+	b.WriteString("# HELP sqs_gui_sqs_request_duration_seconds Duration of SQS API calls, including long poll waits.\n")
+	b.WriteString("# TYPE sqs_gui_sqs_request_duration_seconds histogram\n")
+	for _, operation := range metrics.Operations {
+		for i, bound := range metrics.LatencyBuckets {
+			fmt.Fprintf(&b, "sqs_gui_sqs_request_duration_seconds_bucket{operation=%q,le=%q} %d\n",
+				operation.Operation, strconv.FormatFloat(bound.Seconds(), 'g', -1, 64), operation.Buckets[i])
+		}
+		fmt.Fprintf(&b, "sqs_gui_sqs_request_duration_seconds_bucket{operation=%q,le=\"+Inf\"} %d\n", operation.Operation, operation.Requests)
+		fmt.Fprintf(&b, "sqs_gui_sqs_request_duration_seconds_sum{operation=%q} %s\n",
+			operation.Operation, strconv.FormatFloat(operation.TotalLatency.Seconds(), 'g', -1, 64))
+		fmt.Fprintf(&b, "sqs_gui_sqs_request_duration_seconds_count{operation=%q} %d\n", operation.Operation, operation.Requests)
+	}
+
+	if len(metrics.Objectives) > 0 {
+		b.WriteString("# HELP sqs_gui_slo_target Share of SQS API calls that must meet the objective.\n")
+		b.WriteString("# TYPE sqs_gui_slo_target gauge\n")
+		for _, status := range metrics.Objectives {
+			fmt.Fprintf(&b, "sqs_gui_slo_target{operation=%q,objective=%q} %s\n",
+				status.Objective.Operation, status.Objective.String(), strconv.FormatFloat(status.Objective.Target, 'g', -1, 64))
+		}
+
+		b.WriteString("# HELP sqs_gui_slo_compliance Share of SQS API calls since startup that met the objective.\n")
+		b.WriteString("# TYPE sqs_gui_slo_compliance gauge\n")
+		for _, status := range metrics.Objectives {
+			fmt.Fprintf(&b, "sqs_gui_slo_compliance{operation=%q} %s\n",
+				status.Objective.Operation, strconv.FormatFloat(status.Compliance(), 'g', -1, 64))
+		}
+
+		b.WriteString("# HELP sqs_gui_slo_burn_rate How fast the error budget of the objective is spent; 1 spends exactly the budget.\n")
+		b.WriteString("# TYPE sqs_gui_slo_burn_rate gauge\n")
+		for _, status := range metrics.Objectives {
+			for _, burn := range status.BurnRates {
+				fmt.Fprintf(&b, "sqs_gui_slo_burn_rate{operation=%q,window=%q} %s\n",
+					status.Objective.Operation, formatWindow(burn.Window), strconv.FormatFloat(burn.Rate, 'g', -1, 64))
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if _, err := w.Write([]byte(b.String())); err != nil {
+		slog.WarnContext(r.Context(), "failed to write metrics", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,226 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAPIMetrics() APIMetrics {
+	return APIMetrics{
+		Since:          time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC),
+		TakenAt:        time.Date(2024, time.May, 4, 13, 0, 0, 0, time.UTC),
+		LatencyBuckets: []time.Duration{100 * time.Millisecond, time.Second},
+		Operations: []OperationMetrics{{
+			Operation:    "SendMessage",
+			Requests:     4,
+			Errors:       1,
+			TotalLatency: 600 * time.Millisecond,
+			MaxLatency:   1500 * time.Millisecond,
+			Buckets:      []int64{2, 3},
+		}},
+		Queues: []QueueRequestMetrics{
+			{QueueURL: "https://sqs.local/orders", Requests: 100_000},
+			{QueueURL: "", Requests: 7300},
+		},
+	}
+}
+
+func TestHandlerImpl_StatusHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	mockService.EXPECT().APIMetrics(mock.Anything).Return(newTestAPIMetrics()).Once()
+	mockService.EXPECT().EndpointCapabilities(mock.Anything).Return(EndpointCapabilities{Kind: EndpointAWS, Tags: true, CostEstimates: true}).Once()
+
+	var captured statusPageData
+	captureTemplate(t, "status", func(data statusPageData) { captured = data })
+	installFragment(t, "assets/js/status.ts", "")
+
+	rr := httptest.NewRecorder()
+	handler.StatusHandler(rr, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "Status", captured.Title)
+	assert.Equal(t, "2024-05-01 12:00:00 UTC", captured.Since)
+	assert.Equal(t, []statusOperationView{{
+		Operation:      "SendMessage",
+		Requests:       "4",
+		Errors:         "1",
+		ErrorRate:      "25.0%",
+		AverageLatency: "150ms",
+		MaxLatency:     "1.5s",
+	}}, captured.Operations)
+	// 73 hours of metrics are a tenth of a month.
+	assert.Equal(t, []statusQueueView{
+		{QueueURL: "https://sqs.local/orders", EscapedURL: "https%3A%2F%2Fsqs.local%2Forders", QueueName: "orders", Requests: "100000", MonthlyRequests: "1000000", MonthlyCost: "$0.40"},
+		{QueueURL: "", QueueName: "(not queue specific)", Requests: "7300", MonthlyRequests: "73000", MonthlyCost: "$0.03"},
+	}, captured.Queues)
+	assert.Equal(t, "$0.43", captured.MonthlyCost)
+	assert.Equal(t, statusEndpointView{Kind: "Amazon SQS", Tags: true, CostEstimates: true}, captured.Endpoint)
+}
+
+func TestHandlerImpl_StatusHandler_EmulatorHasNoCost(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	mockService.EXPECT().APIMetrics(mock.Anything).Return(newTestAPIMetrics()).Once()
+	mockService.EXPECT().EndpointCapabilities(mock.Anything).
+		Return(EndpointCapabilities{Kind: EndpointElasticMQ, Endpoint: "http://localhost:9324"}).
+		Once()
+
+	var captured statusPageData
+	captureTemplate(t, "status", func(data statusPageData) { captured = data })
+	installFragment(t, "assets/js/status.ts", "")
+
+	rr := httptest.NewRecorder()
+	handler.StatusHandler(rr, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, statusEndpointView{Kind: "ElasticMQ", URL: "http://localhost:9324"}, captured.Endpoint)
+	assert.Empty(t, captured.MonthlyCost)
+}
+
+func TestHandlerImpl_StatusHandler_TooEarlyToProject(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	metrics := newTestAPIMetrics()
+	metrics.TakenAt = metrics.Since.Add(10 * time.Second)
+	mockService.EXPECT().APIMetrics(mock.Anything).Return(metrics).Once()
+	mockService.EXPECT().EndpointCapabilities(mock.Anything).Return(EndpointCapabilities{Kind: EndpointAWS, Tags: true, CostEstimates: true}).Once()
+
+	var captured statusPageData
+	captureTemplate(t, "status", func(data statusPageData) { captured = data })
+	installFragment(t, "assets/js/status.ts", "")
+
+	rr := httptest.NewRecorder()
+	handler.StatusHandler(rr, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	require.Len(t, captured.Queues, 2)
+	assert.Equal(t, "-", captured.Queues[0].MonthlyRequests)
+	assert.Equal(t, "-", captured.Queues[0].MonthlyCost)
+	assert.Empty(t, captured.MonthlyCost)
+}
+
+func TestHandlerImpl_MetricsHandler(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	mockService.EXPECT().APIMetrics(mock.Anything).Return(newTestAPIMetrics()).Once()
+
+	rr := httptest.NewRecorder()
+	handler.MetricsHandler(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rr.Header().Get("Content-Type"))
+	assert.Equal(t, strings.Join([]string{
+		"# HELP sqs_gui_sqs_requests_total SQS API calls issued by the GUI.",
+		"# TYPE sqs_gui_sqs_requests_total counter",
+		`sqs_gui_sqs_requests_total{operation="SendMessage"} 4`,
+		"# HELP sqs_gui_sqs_request_errors_total SQS API calls that returned an error.",
+		"# TYPE sqs_gui_sqs_request_errors_total counter",
+		`sqs_gui_sqs_request_errors_total{operation="SendMessage"} 1`,
+		"# HELP sqs_gui_sqs_queue_requests_total SQS API calls issued by the GUI per queue.",
+		"# TYPE sqs_gui_sqs_queue_requests_total counter",
+		`sqs_gui_sqs_queue_requests_total{queue_url="https://sqs.local/orders"} 100000`,
+		`sqs_gui_sqs_queue_requests_total{queue_url=""} 7300`,
+		"# HELP sqs_gui_sqs_request_duration_seconds Duration of SQS API calls, including long poll waits.",
+		"# TYPE sqs_gui_sqs_request_duration_seconds histogram",
+		`sqs_gui_sqs_request_duration_seconds_bucket{operation="SendMessage",le="0.1"} 2`,
+		`sqs_gui_sqs_request_duration_seconds_bucket{operation="SendMessage",le="1"} 3`,
+		`sqs_gui_sqs_request_duration_seconds_bucket{operation="SendMessage",le="+Inf"} 4`,
+		`sqs_gui_sqs_request_duration_seconds_sum{operation="SendMessage"} 0.6`,
+		`sqs_gui_sqs_request_duration_seconds_count{operation="SendMessage"} 4`,
+		"",
+	}, "\n"), rr.Body.String())
+}
+
+func newTestObjectives() []ObjectiveStatus {
+	return []ObjectiveStatus{
+		{
+			Objective: OperationObjective{Operation: "ReceiveMessage", Target: 0.99, Latency: 2500 * time.Millisecond},
+			Requests:  200,
+			Good:      190,
+			BurnRates: []BurnRate{{Window: 5 * time.Minute, Requests: 20, Rate: 15}, {Window: time.Hour, Requests: 200, Rate: 5}},
+		},
+		{
+			Objective: OperationObjective{Operation: "SendMessage", Target: 0.999},
+			BurnRates: []BurnRate{{Window: 5 * time.Minute}, {Window: time.Hour}},
+		},
+	}
+}
+
+func TestHandlerImpl_StatusHandler_Objectives(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	metrics := newTestAPIMetrics()
+	metrics.Objectives = newTestObjectives()
+	mockService.EXPECT().APIMetrics(mock.Anything).Return(metrics).Once()
+	mockService.EXPECT().EndpointCapabilities(mock.Anything).Return(EndpointCapabilities{Kind: EndpointAWS, Tags: true, CostEstimates: true}).Once()
+
+	var captured statusPageData
+	captureTemplate(t, "status", func(data statusPageData) { captured = data })
+	installFragment(t, "assets/js/status.ts", "")
+
+	rr := httptest.NewRecorder()
+	handler.StatusHandler(rr, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, []statusObjectiveView{
+		{
+			Operation:  "ReceiveMessage",
+			Objective:  "99% within 2.5s",
+			Requests:   "200",
+			Compliance: "95.00%",
+			BurnRates:  []statusBurnRateView{{Window: "5m", Rate: "15.00", Burning: true}, {Window: "1h", Rate: "5.00", Burning: true}},
+		},
+		{
+			Operation:  "SendMessage",
+			Objective:  "99.9% succeed",
+			Requests:   "0",
+			Compliance: "-",
+			Met:        true,
+			BurnRates:  []statusBurnRateView{{Window: "5m", Rate: "-"}, {Window: "1h", Rate: "-"}},
+		},
+	}, captured.Objectives)
+}
+
+func TestHandlerImpl_MetricsHandler_Objectives(t *testing.T) {
+	mockService := NewMockSqsService(t)
+	handler := NewHandler(mockService)
+
+	metrics := newTestAPIMetrics()
+	metrics.Objectives = newTestObjectives()
+	mockService.EXPECT().APIMetrics(mock.Anything).Return(metrics).Once()
+
+	rr := httptest.NewRecorder()
+	handler.MetricsHandler(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), strings.Join([]string{
+		"# HELP sqs_gui_slo_target Share of SQS API calls that must meet the objective.",
+		"# TYPE sqs_gui_slo_target gauge",
+		`sqs_gui_slo_target{operation="ReceiveMessage",objective="99% within 2.5s"} 0.99`,
+		`sqs_gui_slo_target{operation="SendMessage",objective="99.9% succeed"} 0.999`,
+		"# HELP sqs_gui_slo_compliance Share of SQS API calls since startup that met the objective.",
+		"# TYPE sqs_gui_slo_compliance gauge",
+		`sqs_gui_slo_compliance{operation="ReceiveMessage"} 0.95`,
+		`sqs_gui_slo_compliance{operation="SendMessage"} 1`,
+		"# HELP sqs_gui_slo_burn_rate How fast the error budget of the objective is spent; 1 spends exactly the budget.",
+		"# TYPE sqs_gui_slo_burn_rate gauge",
+		`sqs_gui_slo_burn_rate{operation="ReceiveMessage",window="5m"} 15`,
+		`sqs_gui_slo_burn_rate{operation="ReceiveMessage",window="1h"} 5`,
+		`sqs_gui_slo_burn_rate{operation="SendMessage",window="5m"} 0`,
+		`sqs_gui_slo_burn_rate{operation="SendMessage",window="1h"} 0`,
+		"",
+	}, "\n"))
+}
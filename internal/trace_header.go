@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const (
+	// traceHeaderName is the header X-Ray uses to carry the trace of a request.
+	traceHeaderName = "X-Amzn-Trace-Id"
+	// maxTraceHeaderBytes bounds the header taken from a request; X-Ray headers are far shorter.
+	maxTraceHeaderBytes = 1024
+)
+
+// traceHeaderKey carries the X-Amzn-Trace-Id of the request being served.
+type traceHeaderKey struct{}
+
+// withTraceHeader returns ctx carrying an X-Ray trace header.
+func withTraceHeader(ctx context.Context, header string) context.Context {
+	return context.WithValue(ctx, traceHeaderKey{}, header)
+}
+
+// traceHeaderFrom returns the X-Ray trace header carried by ctx, if any.
+func traceHeaderFrom(ctx context.Context) string {
+	header, _ := ctx.Value(traceHeaderKey{}).(string)
+	return header
+}
+
+// traceMiddleware keeps the X-Amzn-Trace-Id of incoming requests in their context, so the SQS calls
+// made for them join the caller's X-Ray trace. Values that are not X-Ray headers are ignored.
+func traceMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := strings.TrimSpace(r.Header.Get(traceHeaderName))
+		if header != "" && len(header) <= maxTraceHeaderBytes && strings.Contains(header, "Root=") {
+			r = r.WithContext(withTraceHeader(r.Context(), header))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// AddTraceHeader is an AWS client API option that sends the trace header carried by the context of
+// each call as X-Amzn-Trace-Id.
+func AddTraceHeader(stack *middleware.Stack) error {
+	return stack.Build.Add(middleware.BuildMiddlewareFunc("SqsGuiTraceHeader", func(
+		ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+	) (middleware.BuildOutput, middleware.Metadata, error) {
+		if header := traceHeaderFrom(ctx); header != "" {
+			if request, ok := in.Request.(*smithyhttp.Request); ok {
+				request.Header.Set(traceHeaderName, header)
+			}
+		}
+		return next.HandleBuild(ctx, in)
+	}), middleware.After)
+}
+
+// traceSystemAttributes sets the AWSTraceHeader of a new message to the trace header carried by
+// ctx, so consumers continue the trace. It returns nil without one.
+func traceSystemAttributes(ctx context.Context) map[string]types.MessageSystemAttributeValue {
+	header := traceHeaderFrom(ctx)
+	if header == "" {
+		return nil
+	}
+	return map[string]types.MessageSystemAttributeValue{
+		string(types.MessageSystemAttributeNameForSendsAWSTraceHeader): {
+			DataType:    aws.String("String"),
+			StringValue: aws.String(header),
+		},
+	}
+}
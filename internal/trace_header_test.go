@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTraceHeader = "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1"
+
+func TestTraceMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{name: "keeps an x-ray header", header: testTraceHeader, want: testTraceHeader},
+		{name: "ignores a missing header"},
+		{name: "ignores a header without a root", header: "Self=1-abc"},
+		{name: "ignores an oversized header", header: "Root=" + strings.Repeat("a", maxTraceHeaderBytes)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			handler := traceMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = traceHeaderFrom(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/queues", nil)
+			if tt.header != "" {
+				req.Header.Set(traceHeaderName, tt.header)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddTraceHeader(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get(traceHeaderName))
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		_, _ = w.Write([]byte(`{"QueueUrls":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := sqs.New(sqs.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  aws.AnonymousCredentials{},
+		APIOptions:   []func(*middleware.Stack) error{AddTraceHeader},
+	})
+
+	_, err := client.ListQueues(withTraceHeader(context.Background(), testTraceHeader), &sqs.ListQueuesInput{})
+	require.NoError(t, err)
+	_, err = client.ListQueues(context.Background(), &sqs.ListQueuesInput{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{testTraceHeader, ""}, got)
+}
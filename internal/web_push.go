@@ -0,0 +1,497 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+var (
+	// ErrWebPushDisabled is returned when no VAPID key is configured.
+	ErrWebPushDisabled = errors.New("browser push notifications are not configured")
+	// ErrPushSubscriptionNotFound is returned when no browser is subscribed with an endpoint.
+	ErrPushSubscriptionNotFound = errors.New("push subscription not found")
+	// ErrPushSubscriptionGone is returned when the push service no longer knows a subscription,
+	// because the browser unsubscribed or the subscription expired.
+	ErrPushSubscriptionGone = errors.New("the push subscription has expired or was removed")
+)
+
+const (
+	// pushRecordSize is the record size announced in the aes128gcm header. Messages are sent as a
+	// single record, so it also bounds the payload.
+	pushRecordSize = 4096
+	// pushTTL is how long a push service keeps a message for a browser that is offline.
+	pushTTL = 24 * time.Hour
+	// vapidTokenLifetime is how long the signed VAPID token of a request is valid; push services
+	// refuse tokens valid for more than 24 hours.
+	vapidTokenLifetime = 12 * time.Hour
+)
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598, which netip does not count as
+// private.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// PushSubscription is a browser subscribed to push notifications, as produced by the
+// PushSubscription.toJSON() of the Push API.
+type PushSubscription struct {
+	Endpoint     string               `json:"endpoint"`
+	Keys         PushSubscriptionKeys `json:"keys"`
+	SubscribedAt time.Time            `json:"subscribedAt"`
+}
+
+// PushSubscriptionKeys are the base64url encoded P-256 public key of the browser and the
+// authentication secret that push messages are encrypted for.
+type PushSubscriptionKeys struct {
+	P256dh string `json:"p256dh"`
+	Auth   string `json:"auth"`
+}
+
+// PushMessage is the payload the service worker turns into a notification. URL is opened when the
+// notification is clicked, and a newer message with the same Tag replaces an older one.
+type PushMessage struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	URL   string `json:"url,omitempty"`
+	Tag   string `json:"tag,omitempty"`
+}
+
+// WebPusher delivers push messages to subscribed browsers. PublicKey is the base64url encoded
+// application server key the browsers subscribe with.
+type WebPusher interface {
+	PublicKey() string
+	Push(ctx context.Context, subscription PushSubscription, message PushMessage) error
+}
+
+// VAPIDPusher sends push messages encrypted as RFC 8291 describes and signed with a VAPID key
+// (RFC 8292), which is how the push services of all major browsers accept them.
+type VAPIDPusher struct {
+	key       *ecdsa.PrivateKey
+	publicKey string
+	subject   string
+	client    *http.Client
+	clock     func() time.Time
+}
+
+// NewVAPIDPusher creates a pusher signing with the key pair and contact of config.
+func NewVAPIDPusher(config WebPushConfig) (*VAPIDPusher, error) {
+	key, err := parseVAPIDPrivateKey(config.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	// x509 is the only way before Go 1.25 to use an ecdh key for ECDSA signatures.
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode vapid key")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode vapid key")
+	}
+	signingKey, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("vapid key is not an ECDSA key")
+	}
+
+	return &VAPIDPusher{
+		key:       signingKey,
+		publicKey: base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		subject:   config.Subject,
+		client:    newPushClient(),
+		clock:     time.Now,
+	}, nil
+}
+
+// newPushClient returns the client push messages are posted with. It refuses to connect to an
+// address pushAddressAllowed rejects. The check runs on every connection, so a host that resolved
+// to a public address when the browser subscribed cannot later be pointed at the local network.
+// Push messages do not go through HTTP(S)_PROXY, which would connect past the check.
+func newPushClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return errors.Wrap(err, "invalid push service address")
+			}
+			if !pushAddressAllowed(addrPort.Addr()) {
+				return errors.Newf("push service address %s is not a public address", addrPort.Addr())
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: 10 * time.Second, Transport: transport}
+}
+
+// pushAddressAllowed reports whether push messages may be sent to addr. Push services are on the
+// internet, so loopback, private, link-local and other non-public addresses are refused, which
+// keeps a subscription from making the server post into its own network.
+func pushAddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// PublicKey is the base64url encoded application server key browsers subscribe with.
+func (p *VAPIDPusher) PublicKey() string {
+	return p.publicKey
+}
+
+// Push encrypts message for subscription and posts it to the subscription's push service. It
+// returns ErrPushSubscriptionGone when the push service answers that the subscription is gone.
+func (p *VAPIDPusher) Push(ctx context.Context, subscription PushSubscription, message PushMessage) error {
+	plaintext, err := json.Marshal(message)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode push message")
+	}
+	body, err := encryptPushPayload(subscription.Keys, plaintext)
+	if err != nil {
+		return err
+	}
+	token, err := p.vapidToken(subscription.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to build push request")
+	}
+	req.Header.Set("Authorization", "vapid t="+token+", k="+p.publicKey)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(pushTTL.Seconds())))
+	req.Header.Set("Urgency", "high")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send push message")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return errors.WithStack(ErrPushSubscriptionGone)
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return errors.Newf("push service responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// vapidToken signs the JWT that identifies this server to the push service of endpoint.
+func (p *VAPIDPusher) vapidToken(endpoint string) (string, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid push endpoint")
+	}
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"typ":"JWT","alg":"ES256"}`))
+	claims, err := json.Marshal(map[string]any{
+		"aud": parsed.Scheme + "://" + parsed.Host,
+		"exp": p.clock().Add(vapidTokenLifetime).Unix(),
+		"sub": p.subject,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode vapid claims")
+	}
+	signed := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(signed))
+	r, s, err := ecdsa.Sign(rand.Reader, p.key, digest[:])
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign vapid token")
+	}
+	// JWS wants the raw 32 byte r and s rather than the ASN.1 form.
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// encryptPushPayload encrypts plaintext for the browser holding keys with the aes128gcm content
+// coding of RFC 8188, keyed as RFC 8291 describes.
+func encryptPushPayload(keys PushSubscriptionKeys, plaintext []byte) ([]byte, error) {
+	browserKey, authSecret, err := decodePushKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+	// The record holds the plaintext, a delimiter byte and the 16 byte authentication tag.
+	if len(plaintext)+17 > pushRecordSize {
+		return nil, errors.Newf("push message of %d bytes is too large", len(plaintext))
+	}
+
+	serverKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate push encryption key")
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate push salt")
+	}
+	return sealPushPayload(browserKey, authSecret, serverKey, salt, plaintext)
+}
+
+// sealPushPayload encrypts plaintext as one aes128gcm record with the one-off serverKey and salt.
+func sealPushPayload(browserKey *ecdh.PublicKey, authSecret []byte, serverKey *ecdh.PrivateKey, salt, plaintext []byte) ([]byte, error) {
+	shared, err := serverKey.ECDH(browserKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive push encryption key")
+	}
+	serverPublic := serverKey.PublicKey().Bytes()
+
+	authPRK, err := hkdf.Extract(sha256.New, shared, authSecret)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	keyInfo := "WebPush: info\x00" + string(browserKey.Bytes()) + string(serverPublic)
+	ikm, err := hkdf.Expand(sha256.New, authPRK, keyInfo, 32)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	contentKey, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	header := make([]byte, 0, 16+4+1+len(serverPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, pushRecordSize)
+	header = append(header, byte(len(serverPublic)))
+	header = append(header, serverPublic...)
+
+	// 0x02 marks the last (and only) record.
+	record := append(append(make([]byte, 0, len(plaintext)+1), plaintext...), 0x02)
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+// decodePushKeys checks and decodes the keys of a subscription.
+func decodePushKeys(keys PushSubscriptionKeys) (*ecdh.PublicKey, []byte, error) {
+	rawKey, err := decodeBase64URL(keys.P256dh)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid p256dh key")
+	}
+	browserKey, err := ecdh.P256().NewPublicKey(rawKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid p256dh key")
+	}
+	authSecret, err := decodeBase64URL(keys.Auth)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid auth secret")
+	}
+	if len(authSecret) != 16 {
+		return nil, nil, errors.New("invalid auth secret: must be 16 bytes")
+	}
+	return browserKey, authSecret, nil
+}
+
+// parseVAPIDPrivateKey decodes a base64url encoded P-256 private key, as printed by tools such as
+// web-push generate-vapid-keys.
+func parseVAPIDPrivateKey(raw string) (*ecdh.PrivateKey, error) {
+	decoded, err := decodeBase64URL(raw)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ecdh.P256().NewPrivateKey(decoded)
+	if err != nil {
+		return nil, errors.New("must be a base64url encoded 32 byte P-256 private key")
+	}
+	return key, nil
+}
+
+// decodeBase64URL decodes base64url with or without padding; browsers leave the padding out.
+func decodeBase64URL(raw string) ([]byte, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(raw, "="))
+	if err != nil {
+		return nil, errors.New("must be base64url encoded")
+	}
+	return decoded, nil
+}
+
+// dlqArrivalTracker remembers the depth of each watched dead-letter queue at the last check, so
+// the first message arriving in an empty queue can be told apart from a queue that already had
+// messages when it was first seen.
+type dlqArrivalTracker struct {
+	mu     sync.Mutex
+	depths map[string]int64
+}
+
+// WebPushKey returns the application server key browsers subscribe with, or an empty string when
+// browser push notifications are not configured.
+func (s *SqsServiceImpl) WebPushKey(_ context.Context) string {
+	if s.pusher == nil {
+		return ""
+	}
+	return s.pusher.PublicKey()
+}
+
+// SubscribePush saves a browser's push subscription. Subscribing the same endpoint again replaces
+// its keys. Endpoints whose host resolves to an address that is not public are refused.
+func (s *SqsServiceImpl) SubscribePush(ctx context.Context, subscription PushSubscription) error {
+	if s.pusher == nil || s.store == nil {
+		return errors.WithStack(ErrWebPushDisabled)
+	}
+
+	endpoint, err := url.Parse(strings.TrimSpace(subscription.Endpoint))
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		return errors.New("push endpoint must be an https URL")
+	}
+	if _, _, err := decodePushKeys(subscription.Keys); err != nil {
+		return err
+	}
+	if err := s.checkPushHost(ctx, endpoint.Hostname()); err != nil {
+		return err
+	}
+
+	subscription.Endpoint = endpoint.String()
+	subscription.SubscribedAt = s.now().UTC()
+	return s.store.SavePushSubscription(subscription)
+}
+
+// checkPushHost resolves the host of a push endpoint and refuses it unless every address is one
+// pushAddressAllowed accepts.
+func (s *SqsServiceImpl) checkPushHost(ctx context.Context, host string) error {
+	lookup := s.lookupNetIP
+	if lookup == nil {
+		lookup = func(ctx context.Context, host string) ([]netip.Addr, error) {
+			return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		}
+	}
+	addrs, err := lookup(ctx, host)
+	if err != nil {
+		return errors.Newf("push endpoint host %s cannot be resolved", host)
+	}
+	for _, addr := range addrs {
+		if !pushAddressAllowed(addr) {
+			return errors.Newf("push endpoint host %s resolves to %s, which is not a public address", host, addr.Unmap())
+		}
+	}
+	return nil
+}
+
+// UnsubscribePush forgets the subscription of endpoint.
+func (s *SqsServiceImpl) UnsubscribePush(_ context.Context, endpoint string) error {
+	if s.pusher == nil || s.store == nil {
+		return errors.WithStack(ErrWebPushDisabled)
+	}
+	return s.store.DeletePushSubscription(strings.TrimSpace(endpoint))
+}
+
+// CheckDeadLetterArrivals pushes a notification to every subscribed browser when a watched
+// dead-letter queue that was empty at the previous check holds messages. Queues are watched from
+// their attribute history page. Subscriptions the push service reports as gone are removed.
+func (s *SqsServiceImpl) CheckDeadLetterArrivals(ctx context.Context) error {
+	if s.pusher == nil || s.store == nil {
+		return nil
+	}
+
+	subscriptions, err := s.store.PushSubscriptions()
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		// Nobody would be told, and stale depths would announce old messages to the next subscriber.
+		s.dlqArrivals.mu.Lock()
+		s.dlqArrivals.depths = nil
+		s.dlqArrivals.mu.Unlock()
+		return nil
+	}
+
+	dlqs, err := s.DeadLetterQueues(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	var arrivals []DeadLetterQueueSummary
+	s.dlqArrivals.mu.Lock()
+	depths := make(map[string]int64, len(dlqs))
+	for _, dlq := range dlqs {
+		if !dlq.Watched {
+			continue
+		}
+		// In-flight messages count, so peeking at a message does not make the queue look empty.
+		depth := dlq.MessagesAvailable + dlq.MessagesInFlight
+		depths[dlq.URL] = depth
+		if previous, seen := s.dlqArrivals.depths[dlq.URL]; seen && previous == 0 && depth > 0 {
+			arrivals = append(arrivals, dlq)
+		}
+	}
+	s.dlqArrivals.depths = depths
+	s.dlqArrivals.mu.Unlock()
+
+	for _, dlq := range arrivals {
+		message := deadLetterArrivalMessage(dlq)
+		for _, subscription := range subscriptions {
+			err := s.pusher.Push(ctx, subscription, message)
+			switch {
+			case errors.Is(err, ErrPushSubscriptionGone):
+				slog.InfoContext(ctx, "removing expired push subscription", slog.String("endpoint", subscription.Endpoint))
+				if err := s.store.DeletePushSubscription(subscription.Endpoint); err != nil && !errors.Is(err, ErrPushSubscriptionNotFound) {
+					return err
+				}
+			case err != nil:
+				slog.WarnContext(ctx, "failed to deliver push notification", slog.String("queue_url", dlq.URL), slog.String("endpoint", subscription.Endpoint), slog.Any("error", err))
+			}
+		}
+	}
+	return nil
+}
+
+func deadLetterArrivalMessage(dlq DeadLetterQueueSummary) PushMessage {
+	sources := make([]string, 0, len(dlq.SourceQueues))
+	for _, source := range dlq.SourceQueues {
+		sources = append(sources, source.Name)
+	}
+	count := "1 message"
+	if depth := dlq.MessagesAvailable + dlq.MessagesInFlight; depth != 1 {
+		count = fmt.Sprintf("%d messages", depth)
+	}
+	return PushMessage{
+		Title: fmt.Sprintf("Messages arrived in %s", dlq.Name),
+		Body:  fmt.Sprintf("The dead-letter queue of %s was empty and now holds %s.", strings.Join(sources, ", "), count),
+		URL:   "/queues/" + url.QueryEscape(dlq.URL),
+		Tag:   "dlq-arrival:" + dlq.URL,
+	}
+}
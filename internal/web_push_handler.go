@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+)
+
+// maxPushSubscriptionBytes bounds the subscription JSON posted by a browser.
+const maxPushSubscriptionBytes = 8 << 10
+
+// pushSubscriptionRequest is the JSON of PushSubscription.toJSON() in the browser.
+type pushSubscriptionRequest struct {
+	Endpoint       string               `json:"endpoint"`
+	ExpirationTime *float64             `json:"expirationTime"`
+	Keys           PushSubscriptionKeys `json:"keys"`
+}
+
+type pushUnsubscribeRequest struct {
+	Endpoint string `json:"endpoint"`
+}
+
+// SubscribePushAPI subscribes a browser to notifications about watched dead-letter queues.
+func (h *HandlerImpl) SubscribePushAPI(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxPushSubscriptionBytes)
+	var payload pushSubscriptionRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	err := h.s.SubscribePush(r.Context(), PushSubscription{Endpoint: payload.Endpoint, Keys: payload.Keys})
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to subscribe to push notifications", slog.Any("error", err))
+		writePushError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, deleteMessageResponse{Message: "This browser will be notified when a watched dead-letter queue receives messages."})
+}
+
+// UnsubscribePushAPI stops notifying the browser subscribed with the endpoint in the body.
+func (h *HandlerImpl) UnsubscribePushAPI(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxPushSubscriptionBytes)
+	var payload pushUnsubscribeRequest
+	if !decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	if err := h.s.UnsubscribePush(r.Context(), payload.Endpoint); err != nil {
+		writePushError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deleteMessageResponse{Message: "This browser will no longer be notified."})
+}
+
+func writePushError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrWebPushDisabled), errors.Is(err, ErrPushSubscriptionNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		writeJSONError(w, serviceErrorStatus(err), err.Error())
+	}
+}
@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandlerImpl_SubscribePushAPI(t *testing.T) {
+	keys := PushSubscriptionKeys{P256dh: "BKey", Auth: "secret"}
+	testCases := []struct {
+		name       string
+		body       string
+		setup      func(*MockSqsService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "subscribes the browser",
+			body: `{"endpoint":"https://push.local/send/abc","expirationTime":null,"keys":{"p256dh":"BKey","auth":"secret"}}`,
+			setup: func(m *MockSqsService) {
+				m.EXPECT().SubscribePush(mock.Anything, PushSubscription{Endpoint: "https://push.local/send/abc", Keys: keys}).Return(nil).Once()
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"message":"This browser will be notified when a watched dead-letter queue receives messages."}`,
+		},
+		{
+			name: "disabled",
+			body: `{"endpoint":"https://push.local/send/abc","keys":{"p256dh":"BKey","auth":"secret"}}`,
+			setup: func(m *MockSqsService) {
+				m.EXPECT().SubscribePush(mock.Anything, mock.Anything).Return(errors.WithStack(ErrWebPushDisabled)).Once()
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error":"browser push notifications are not configured"}`,
+		},
+		{
+			name: "invalid subscription",
+			body: `{"endpoint":"http://push.local/send/abc","keys":{"p256dh":"BKey","auth":"secret"}}`,
+			setup: func(m *MockSqsService) {
+				m.EXPECT().SubscribePush(mock.Anything, mock.Anything).Return(errors.New("push endpoint must be an https URL")).Once()
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"push endpoint must be an https URL"}`,
+		},
+		{
+			name:       "rejects unknown fields",
+			body:       `{"endpoint":"https://push.local/send/abc","queue":"orders"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"invalid request body"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := NewMockSqsService(t)
+			if tc.setup != nil {
+				tc.setup(mockService)
+			}
+			handler := NewHandler(mockService)
+
+			rr := httptest.NewRecorder()
+			handler.SubscribePushAPI(rr, httptest.NewRequest(http.MethodPost, "/api/v1/push/subscriptions", strings.NewReader(tc.body)))
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+			assert.JSONEq(t, tc.wantBody, rr.Body.String())
+		})
+	}
+}
+
+func TestHandlerImpl_UnsubscribePushAPI(t *testing.T) {
+	t.Run("unsubscribes the browser", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().UnsubscribePush(mock.Anything, "https://push.local/send/abc").Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+		handler.UnsubscribePushAPI(rr, httptest.NewRequest(http.MethodDelete, "/api/v1/push/subscriptions", strings.NewReader(`{"endpoint":"https://push.local/send/abc"}`)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"message":"This browser will no longer be notified."}`, rr.Body.String())
+	})
+
+	t.Run("unknown endpoint", func(t *testing.T) {
+		mockService := NewMockSqsService(t)
+		handler := NewHandler(mockService)
+		mockService.EXPECT().UnsubscribePush(mock.Anything, "https://push.local/send/abc").Return(ErrPushSubscriptionNotFound).Once()
+
+		rr := httptest.NewRecorder()
+		handler.UnsubscribePushAPI(rr, httptest.NewRequest(http.MethodDelete, "/api/v1/push/subscriptions", strings.NewReader(`{"endpoint":"https://push.local/send/abc"}`)))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.JSONEq(t, `{"error":"push subscription not found"}`, rr.Body.String())
+	})
+}
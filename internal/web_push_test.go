@@ -0,0 +1,328 @@
+package internal
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeTestKey(t *testing.T, raw string) []byte {
+	t.Helper()
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	require.NoError(t, err)
+	return decoded
+}
+
+// newTestPushKeys returns subscription keys for a new browser key pair.
+func newTestPushKeys(t *testing.T) PushSubscriptionKeys {
+	t.Helper()
+	browserKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return PushSubscriptionKeys{
+		P256dh: base64.RawURLEncoding.EncodeToString(browserKey.PublicKey().Bytes()),
+		Auth:   base64.RawURLEncoding.EncodeToString(make([]byte, 16)),
+	}
+}
+
+func TestSealPushPayload(t *testing.T) {
+	// The example of RFC 8291, Appendix A.
+	browserKey, err := ecdh.P256().NewPublicKey(decodeTestKey(t, "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"))
+	require.NoError(t, err)
+	serverKey, err := ecdh.P256().NewPrivateKey(decodeTestKey(t, "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+	require.NoError(t, err)
+
+	body, err := sealPushPayload(
+		browserKey,
+		decodeTestKey(t, "BTBZMqHH6r4Tts7J_aSIgg"),
+		serverKey,
+		decodeTestKey(t, "DGv6ra1nlYgDCS1FRnbzlw"),
+		[]byte("When I grow up, I want to be a watermelon"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN",
+		base64.RawURLEncoding.EncodeToString(body))
+}
+
+func TestEncryptPushPayload(t *testing.T) {
+	keys := newTestPushKeys(t)
+
+	testCases := []struct {
+		name      string
+		keys      PushSubscriptionKeys
+		plaintext []byte
+		wantErr   string
+	}{
+		{name: "invalid browser key", keys: PushSubscriptionKeys{P256dh: "AAAA", Auth: keys.Auth}, wantErr: "invalid p256dh key: crypto/ecdh: invalid public key"},
+		{name: "short auth secret", keys: PushSubscriptionKeys{P256dh: keys.P256dh, Auth: "AAAA"}, wantErr: "invalid auth secret: must be 16 bytes"},
+		{name: "too large", keys: keys, plaintext: make([]byte, pushRecordSize), wantErr: "push message of 4096 bytes is too large"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := encryptPushPayload(tc.keys, tc.plaintext)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+
+	t.Run("uses a new key and salt for every message", func(t *testing.T) {
+		first, err := encryptPushPayload(keys, []byte("hello"))
+		require.NoError(t, err)
+		second, err := encryptPushPayload(keys, []byte("hello"))
+		require.NoError(t, err)
+		assert.NotEqual(t, first[:86], second[:86])
+		// Salt, record size, key length, key, then the record with its delimiter and tag.
+		assert.Len(t, first, 16+4+1+65+len("hello")+1+16)
+	})
+}
+
+func TestVAPIDPusher_Push(t *testing.T) {
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecdhKey, err := signingKey.ECDH()
+	require.NoError(t, err)
+	pusher, err := NewVAPIDPusher(WebPushConfig{
+		PrivateKey: base64.RawURLEncoding.EncodeToString(ecdhKey.Bytes()),
+		Subject:    "mailto:ops@example.com",
+	})
+	require.NoError(t, err)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	pusher.clock = func() time.Time { return now }
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(ecdhKey.PublicKey().Bytes()), pusher.PublicKey())
+
+	t.Run("refuses to connect to a push service on the local network", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			t.Error("the push service was reached")
+		}))
+		defer server.Close()
+
+		err := pusher.Push(context.Background(), PushSubscription{Endpoint: server.URL, Keys: newTestPushKeys(t)}, PushMessage{Title: "title"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "push service address 127.0.0.1 is not a public address")
+	})
+
+	// The test servers listen on loopback, which the pusher's own client refuses.
+	pusher.client = &http.Client{Timeout: 10 * time.Second}
+
+	message := PushMessage{Title: "title", Body: "body"}
+
+	t.Run("posts an encrypted message with a signed token", func(t *testing.T) {
+		var serverURL string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+			assert.Equal(t, "86400", r.Header.Get("TTL"))
+
+			token, key, ok := strings.Cut(strings.TrimPrefix(r.Header.Get("Authorization"), "vapid t="), ", k=")
+			require.True(t, ok)
+			assert.Equal(t, pusher.PublicKey(), key)
+
+			parts := strings.Split(token, ".")
+			require.Len(t, parts, 3)
+			digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+			signature := decodeTestKey(t, parts[2])
+			require.Len(t, signature, 64)
+			r1, s1 := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+			assert.True(t, ecdsa.Verify(&signingKey.PublicKey, digest[:], r1, s1))
+
+			var claims map[string]any
+			require.NoError(t, json.Unmarshal(decodeTestKey(t, parts[1]), &claims))
+			assert.Equal(t, serverURL, claims["aud"])
+			assert.Equal(t, "mailto:ops@example.com", claims["sub"])
+			assert.InDelta(t, float64(now.Add(12*time.Hour).Unix()), claims["exp"], 0)
+
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Len(t, body, 86+len(`{"title":"title","body":"body"}`)+17)
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+		serverURL = server.URL
+
+		err := pusher.Push(context.Background(), PushSubscription{Endpoint: server.URL + "/send/abc", Keys: newTestPushKeys(t)}, message)
+		require.NoError(t, err)
+	})
+
+	for _, status := range []int{http.StatusNotFound, http.StatusGone} {
+		t.Run("reports a gone subscription on "+http.StatusText(status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(status)
+			}))
+			defer server.Close()
+
+			err := pusher.Push(context.Background(), PushSubscription{Endpoint: server.URL, Keys: newTestPushKeys(t)}, message)
+			assert.ErrorIs(t, err, ErrPushSubscriptionGone)
+		})
+	}
+
+	t.Run("fails on error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		err := pusher.Push(context.Background(), PushSubscription{Endpoint: server.URL, Keys: newTestPushKeys(t)}, message)
+		assert.EqualError(t, err, "push service responded with status 429")
+	})
+}
+
+func TestSqsServiceImpl_SubscribePush(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	keys := newTestPushKeys(t)
+	resolve := func(addrs ...string) func(context.Context, string) ([]netip.Addr, error) {
+		return func(_ context.Context, host string) ([]netip.Addr, error) {
+			assert.Equal(t, "push.local", host)
+			resolved := make([]netip.Addr, len(addrs))
+			for i, addr := range addrs {
+				resolved[i] = netip.MustParseAddr(addr)
+			}
+			return resolved, nil
+		}
+	}
+
+	t.Run("saves the subscription", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{store: store, pusher: NewMockWebPusher(t), clock: func() time.Time { return now }, lookupNetIP: resolve("142.250.0.95")}
+
+		store.EXPECT().SavePushSubscription(PushSubscription{Endpoint: "https://push.local/send/abc", Keys: keys, SubscribedAt: now}).Return(nil).Once()
+
+		require.NoError(t, service.SubscribePush(ctx, PushSubscription{Endpoint: " https://push.local/send/abc ", Keys: keys}))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		service := &SqsServiceImpl{store: NewMockLocalStore(t)}
+
+		err := service.SubscribePush(ctx, PushSubscription{Endpoint: "https://push.local/send/abc", Keys: keys})
+		assert.ErrorIs(t, err, ErrWebPushDisabled)
+	})
+
+	testCases := []struct {
+		name         string
+		subscription PushSubscription
+		wantErr      string
+	}{
+		{name: "http endpoint", subscription: PushSubscription{Endpoint: "http://push.local/send/abc", Keys: keys}, wantErr: "push endpoint must be an https URL"},
+		{name: "missing keys", subscription: PushSubscription{Endpoint: "https://push.local/send/abc"}, wantErr: "invalid p256dh key: crypto/ecdh: invalid public key"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &SqsServiceImpl{store: NewMockLocalStore(t), pusher: NewMockWebPusher(t), lookupNetIP: resolve("142.250.0.95")}
+
+			assert.EqualError(t, service.SubscribePush(ctx, tc.subscription), tc.wantErr)
+		})
+	}
+
+	t.Run("refuses hosts on the local network", func(t *testing.T) {
+		for _, addr := range []string{"127.0.0.1", "::1", "10.0.0.8", "172.16.4.2", "192.168.1.10", "169.254.169.254", "fe80::1", "fd00::1", "100.64.0.1", "0.0.0.0", "::ffff:10.0.0.8"} {
+			service := &SqsServiceImpl{store: NewMockLocalStore(t), pusher: NewMockWebPusher(t), lookupNetIP: resolve("142.250.0.95", addr)}
+
+			err := service.SubscribePush(ctx, PushSubscription{Endpoint: "https://push.local/send/abc", Keys: keys})
+			assert.EqualError(t, err, "push endpoint host push.local resolves to "+netip.MustParseAddr(addr).Unmap().String()+", which is not a public address", addr)
+		}
+	})
+
+	t.Run("refuses hosts that do not resolve", func(t *testing.T) {
+		service := &SqsServiceImpl{store: NewMockLocalStore(t), pusher: NewMockWebPusher(t), lookupNetIP: func(context.Context, string) ([]netip.Addr, error) {
+			return nil, &net.DNSError{Err: "no such host", Name: "push.local", IsNotFound: true}
+		}}
+
+		err := service.SubscribePush(ctx, PushSubscription{Endpoint: "https://push.local/send/abc", Keys: keys})
+		assert.EqualError(t, err, "push endpoint host push.local cannot be resolved")
+	})
+}
+
+func TestSqsServiceImpl_CheckDeadLetterArrivals(t *testing.T) {
+	ctx := context.Background()
+	queues := func(watchedDepth, unwatchedDepth int64) []QueueSummary {
+		return []QueueSummary{
+			{Name: "orders", URL: "https://sqs.local/orders", Arn: "arn:orders", RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:orders-dlq"}},
+			{Name: "orders-dlq", URL: "https://sqs.local/orders-dlq", Arn: "arn:orders-dlq", MessagesAvailable: watchedDepth},
+			{Name: "billing", URL: "https://sqs.local/billing", Arn: "arn:billing", RedrivePolicy: &RedrivePolicy{DeadLetterTargetArn: "arn:billing-dlq"}},
+			{Name: "billing-dlq", URL: "https://sqs.local/billing-dlq", Arn: "arn:billing-dlq", MessagesAvailable: unwatchedDepth},
+		}
+	}
+	subscriptions := []PushSubscription{{Endpoint: "https://push.local/1"}, {Endpoint: "https://push.local/2"}}
+
+	t.Run("pushes the first arrival in a watched dlq", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		store := NewMockLocalStore(t)
+		pusher := NewMockWebPusher(t)
+		service := &SqsServiceImpl{repo: repo, store: store, pusher: pusher, dlqArrivals: &dlqArrivalTracker{}}
+
+		store.EXPECT().PushSubscriptions().Return(subscriptions, nil).Times(3)
+		store.EXPECT().AttributeHistories().Return([]AttributeHistory{{QueueURL: "https://sqs.local/orders-dlq"}}, nil).Times(3)
+
+		// The first check only learns the depths.
+		repo.EXPECT().ListQueues(ctx).Return(queues(0, 0), nil).Once()
+		require.NoError(t, service.CheckDeadLetterArrivals(ctx))
+
+		repo.EXPECT().ListQueues(ctx).Return(queues(2, 5), nil).Once()
+		want := PushMessage{
+			Title: "Messages arrived in orders-dlq",
+			Body:  "The dead-letter queue of orders was empty and now holds 2 messages.",
+			URL:   "/queues/https%3A%2F%2Fsqs.local%2Forders-dlq",
+			Tag:   "dlq-arrival:https://sqs.local/orders-dlq",
+		}
+		pusher.EXPECT().Push(ctx, subscriptions[0], want).Return(nil).Once()
+		pusher.EXPECT().Push(ctx, subscriptions[1], want).Return(ErrPushSubscriptionGone).Once()
+		store.EXPECT().DeletePushSubscription("https://push.local/2").Return(nil).Once()
+		require.NoError(t, service.CheckDeadLetterArrivals(ctx))
+
+		// Messages that stay are not announced again.
+		repo.EXPECT().ListQueues(ctx).Return(queues(3, 5), nil).Once()
+		require.NoError(t, service.CheckDeadLetterArrivals(ctx))
+	})
+
+	t.Run("does not look at queues without subscribers", func(t *testing.T) {
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{repo: NewMockSqsRepository(t), store: store, pusher: NewMockWebPusher(t), dlqArrivals: &dlqArrivalTracker{depths: map[string]int64{"https://sqs.local/orders-dlq": 0}}}
+
+		store.EXPECT().PushSubscriptions().Return(nil, nil).Once()
+
+		require.NoError(t, service.CheckDeadLetterArrivals(ctx))
+		assert.Nil(t, service.dlqArrivals.depths)
+	})
+
+	t.Run("fails when queues cannot be listed", func(t *testing.T) {
+		repo := NewMockSqsRepository(t)
+		store := NewMockLocalStore(t)
+		service := &SqsServiceImpl{repo: repo, store: store, pusher: NewMockWebPusher(t), dlqArrivals: &dlqArrivalTracker{}}
+
+		store.EXPECT().PushSubscriptions().Return(subscriptions, nil).Once()
+		repo.EXPECT().ListQueues(ctx).Return(nil, errors.New("denied")).Once()
+
+		assert.EqualError(t, service.CheckDeadLetterArrivals(ctx), "denied")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		service := &SqsServiceImpl{store: NewMockLocalStore(t)}
+
+		require.NoError(t, service.CheckDeadLetterArrivals(ctx))
+	})
+}
+
+func TestDeadLetterArrivalMessage(t *testing.T) {
+	message := deadLetterArrivalMessage(DeadLetterQueueSummary{
+		QueueSummary: QueueSummary{Name: "shared-dlq", URL: "https://sqs.local/shared-dlq", MessagesAvailable: 0, MessagesInFlight: 1},
+		SourceQueues: []DeadLetterSource{{Name: "billing"}, {Name: "orders"}},
+	})
+	assert.Equal(t, "The dead-letter queue of billing, orders was empty and now holds 1 message.", message.Body)
+}
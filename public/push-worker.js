@@ -0,0 +1,18 @@
+// Service worker that shows the push notifications sent for watched dead-letter queues.
+
+self.addEventListener("push", (event) => {
+	const message = event.data ? event.data.json() : {};
+	event.waitUntil(
+		self.registration.showNotification(message.title || "SQS GUI", {
+			body: message.body,
+			tag: message.tag,
+			icon: "/icon.svg",
+			data: { url: message.url || "/dead-letter-queues" },
+		}),
+	);
+});
+
+self.addEventListener("notificationclick", (event) => {
+	event.notification.close();
+	event.waitUntil(self.clients.openWindow(event.notification.data.url));
+});
@@ -18,6 +18,31 @@ export default defineConfig({
 				queue: resolve(__dirname, "assets/js/queue.ts"),
 				create_queue: resolve(__dirname, "assets/js/create_queue.ts"),
 				send_receive: resolve(__dirname, "assets/js/send_receive.ts"),
+				schedules: resolve(__dirname, "assets/js/schedules.ts"),
+				dead_letter_queues: resolve(
+					__dirname,
+					"assets/js/dead_letter_queues.ts",
+				),
+				alerts: resolve(__dirname, "assets/js/alerts.ts"),
+				queue_analysis: resolve(__dirname, "assets/js/queue_analysis.ts"),
+				queue_migration: resolve(__dirname, "assets/js/queue_migration.ts"),
+				consumer_simulator: resolve(__dirname, "assets/js/consumer_simulator.ts"),
+				message_forwarder: resolve(__dirname, "assets/js/message_forwarder.ts"),
+				producer_benchmark: resolve(__dirname, "assets/js/producer_benchmark.ts"),
+				filtered_purge: resolve(__dirname, "assets/js/filtered_purge.ts"),
+				drain_to_file: resolve(__dirname, "assets/js/drain_to_file.ts"),
+				restore_file: resolve(__dirname, "assets/js/restore_file.ts"),
+				trash: resolve(__dirname, "assets/js/trash.ts"),
+				drift: resolve(__dirname, "assets/js/drift.ts"),
+				attribute_history: resolve(__dirname, "assets/js/attribute_history.ts"),
+				status: resolve(__dirname, "assets/js/status.ts"),
+				search: resolve(__dirname, "assets/js/search.ts"),
+				queue_report: resolve(__dirname, "assets/js/queue_report.ts"),
+				access_policy: resolve(__dirname, "assets/js/access_policy.ts"),
+				sns_publish: resolve(__dirname, "assets/js/sns_publish.ts"),
+				event_rules: resolve(__dirname, "assets/js/event_rules.ts"),
+				bulk_queues: resolve(__dirname, "assets/js/bulk_queues.ts"),
+				import_queues: resolve(__dirname, "assets/js/import_queues.ts"),
 			},
 		},
 	},